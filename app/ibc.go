@@ -5,7 +5,11 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/ibc/gmp"
+	"github.com/allora-network/allora-chain/x/ibc/ratelimit"
+	ratelimitkeeper "github.com/allora-network/allora-chain/x/ibc/ratelimit/keeper"
+	ratelimittypes "github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -45,6 +49,7 @@ func (app *AlloraApp) registerIBCModules() {
 		storetypes.NewKVStoreKey(ibcfeetypes.StoreKey),
 		storetypes.NewKVStoreKey(icahosttypes.StoreKey),
 		storetypes.NewKVStoreKey(icacontrollertypes.StoreKey),
+		storetypes.NewKVStoreKey(ratelimittypes.StoreKey),
 		storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey),
 		storetypes.NewTransientStoreKey(paramstypes.TStoreKey),
 	); err != nil {
@@ -97,12 +102,22 @@ func (app *AlloraApp) registerIBCModules() {
 		app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
 	)
 
+	// Create rate limit keeper, wrapping ics29 fee as ics4Wrapper so that
+	// outgoing transfers are checked against their rate limit
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(ratelimittypes.StoreKey)),
+		app.BankKeeper,
+		app.IBCFeeKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create IBC transfer keeper
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		app.appCodec,
 		app.GetKey(ibctransfertypes.StoreKey),
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCFeeKeeper,
+		app.RateLimitKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -140,6 +155,9 @@ func (app *AlloraApp) registerIBCModules() {
 	// Create IBC modules
 	var transferIBCModule porttypes.IBCModule
 	transferIBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
+	// rate limit sits inside ics29 fee, mirroring the ics4Wrapper order of the keepers,
+	// so that it sees plain ics20 acknowledgements rather than incentivized ones
+	transferIBCModule = ratelimit.NewIBCMiddleware(transferIBCModule, app.RateLimitKeeper)
 	transferIBCModule = ibcfee.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
 	transferIBCModule = gmp.NewIBCMiddleware(transferIBCModule)
 
@@ -174,6 +192,7 @@ func (app *AlloraApp) registerIBCModules() {
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctransfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		ratelimit.NewAppModule(app.appCodec, app.RateLimitKeeper),
 		icamodule.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		capability.NewAppModule(app.appCodec, *app.CapabilityKeeper, false),
 		ibctm.AppModule{},
@@ -190,6 +209,7 @@ func RegisterIBC(registry cdctypes.InterfaceRegistry) map[string]appmodule.AppMo
 		ibcexported.ModuleName:      ibc.AppModule{},
 		ibctransfertypes.ModuleName: ibctransfer.AppModule{},
 		ibcfeetypes.ModuleName:      ibcfee.AppModule{},
+		ratelimittypes.ModuleName:   ratelimit.AppModule{},
 		icatypes.ModuleName:         icamodule.AppModule{},
 		capabilitytypes.ModuleName:  capability.AppModule{},
 		ibctm.ModuleName:            ibctm.AppModule{},
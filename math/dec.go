@@ -39,6 +39,7 @@ var (
 	ErrUnexpectedRounding = errors.Register(mathCodespace, 2, "unexpected rounding")
 	ErrNonIntegeral       = errors.Register(mathCodespace, 3, "value is non-integral")
 	ErrInfiniteString     = errors.Register(mathCodespace, 4, "value is infinite")
+	ErrInvalidWeights     = errors.Register(mathCodespace, 7, "expected one non-negative weight per value")
 )
 
 // The number 0 encoded as Dec
@@ -2,7 +2,10 @@ package math
 
 import (
 	"cmp"
+	"math/big"
 	"sort"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 // all exponential moving average functions take the form
@@ -94,20 +97,37 @@ func GetSortedElementsByDecWeightDesc[K cmp.Ordered](l []K, m map[K]*Dec) []K {
 	return newL
 }
 
-// StdDev calculates the standard deviation of a slice of `Dec`
-// stdDev = sqrt((Σ(x - μ))^2/ N)
-// where μ is mean and N is number of elements
-func StdDev(data []Dec) (Dec, error) {
-	mean := ZeroDec()
+// Sum adds up a slice of `Dec`. Add is exact, so the sum does not depend on the order of the slice.
+func Sum(data []Dec) (Dec, error) {
+	sum := ZeroDec()
 	var err error = nil
 	for _, v := range data {
-		mean, err = mean.Add(v)
+		sum, err = sum.Add(v)
 		if err != nil {
 			return Dec{}, err
 		}
 	}
+	return sum, nil
+}
+
+// StdDev calculates the standard deviation of a slice of `Dec`
+// stdDev = sqrt((Σ(x - μ))^2/ N)
+// where μ is mean and N is number of elements
+func StdDev(data []Dec) (Dec, error) {
+	sum, err := Sum(data)
+	if err != nil {
+		return Dec{}, err
+	}
+	return StdDevGivenSum(data, sum)
+}
+
+// StdDevGivenSum calculates the standard deviation of a slice of `Dec` whose sum is known,
+// taking the same steps as StdDev once it has summed the slice.
+// Add and Sub are exact, so the sum of a slice less one of its elements can be taken out
+// of the sum of the whole slice with the same result as StdDev of the smaller slice.
+func StdDevGivenSum(data []Dec, sum Dec) (Dec, error) {
 	lenData := NewDecFromInt64(int64(len(data)))
-	mean, err = mean.Quo(lenData)
+	mean, err := sum.Quo(lenData)
 	if err != nil {
 		return Dec{}, err
 	}
@@ -233,3 +253,191 @@ func Phi(p, c, x Dec) (Dec, error) {
 
 	return result, nil
 }
+
+// Sorts values ascending along with their weights and sums the weights.
+// Leaves the inputs untouched.
+func sortWeightedValues(values, weights []Dec) ([]Dec, []Dec, Dec, error) {
+	if len(values) != len(weights) {
+		return nil, nil, Dec{}, ErrInvalidWeights
+	}
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]].Lt(values[indices[j]])
+	})
+	sortedValues := make([]Dec, len(values))
+	sortedWeights := make([]Dec, len(weights))
+	totalWeight := ZeroDec()
+	for i, index := range indices {
+		if weights[index].IsNegative() {
+			return nil, nil, Dec{}, ErrInvalidWeights
+		}
+		sortedValues[i] = values[index]
+		sortedWeights[i] = weights[index]
+		var err error
+		totalWeight, err = totalWeight.Add(weights[index])
+		if err != nil {
+			return nil, nil, Dec{}, err
+		}
+	}
+	return sortedValues, sortedWeights, totalWeight, nil
+}
+
+// Smallest of the sorted values at which the cumulative weight reaches the given weight,
+// or exceeds it if strict
+func weightedQuantileOfSorted(sortedValues, sortedWeights []Dec, cumulativeWeight Dec, strict bool) (Dec, error) {
+	running := ZeroDec()
+	for i, weight := range sortedWeights {
+		var err error
+		running, err = running.Add(weight)
+		if err != nil {
+			return Dec{}, err
+		}
+		if weight.IsZero() {
+			continue
+		}
+		if running.Gt(cumulativeWeight) || (!strict && running.Equal(cumulativeWeight)) {
+			return sortedValues[i], nil
+		}
+	}
+	return sortedValues[len(sortedValues)-1], nil
+}
+
+// WeightedMedian calculates the (lower) weighted median of values: the smallest value at
+// which the cumulative weight of the values sorted ascending reaches half of the total weight.
+// Returns zero if the total weight is zero.
+func WeightedMedian(values, weights []Dec) (Dec, error) {
+	sortedValues, sortedWeights, totalWeight, err := sortWeightedValues(values, weights)
+	if err != nil {
+		return Dec{}, err
+	}
+	if totalWeight.IsZero() {
+		return ZeroDec(), nil
+	}
+	halfWeight, err := totalWeight.Quo(NewDecFromInt64(2))
+	if err != nil {
+		return Dec{}, err
+	}
+	return weightedQuantileOfSorted(sortedValues, sortedWeights, halfWeight, false)
+}
+
+// WeightedTrimmedMean calculates the weighted mean of values once the given fraction α of the
+// total weight W is cut off each tail. Values straddling a cut keep the part of their weight
+// that lies within [αW, (1 - α)W]. Returns zero if the total weight is zero.
+func WeightedTrimmedMean(values, weights []Dec, fraction Dec) (Dec, error) {
+	sortedValues, sortedWeights, totalWeight, err := sortWeightedValues(values, weights)
+	if err != nil {
+		return Dec{}, err
+	}
+	if totalWeight.IsZero() {
+		return ZeroDec(), nil
+	}
+	lowerCut, err := totalWeight.Mul(fraction)
+	if err != nil {
+		return Dec{}, err
+	}
+	upperCut, err := totalWeight.Sub(lowerCut)
+	if err != nil {
+		return Dec{}, err
+	}
+
+	weightedSum := ZeroDec()
+	keptWeight := ZeroDec()
+	cumulativeBefore := ZeroDec()
+	for i, weight := range sortedWeights {
+		cumulativeAfter, err := cumulativeBefore.Add(weight)
+		if err != nil {
+			return Dec{}, err
+		}
+		// Overlap of [cumulativeBefore, cumulativeAfter] with [lowerCut, upperCut]
+		start := cumulativeBefore
+		if lowerCut.Gt(start) {
+			start = lowerCut
+		}
+		end := cumulativeAfter
+		if upperCut.Lt(end) {
+			end = upperCut
+		}
+		if end.Gt(start) {
+			kept, err := end.Sub(start)
+			if err != nil {
+				return Dec{}, err
+			}
+			keptTimesValue, err := kept.Mul(sortedValues[i])
+			if err != nil {
+				return Dec{}, err
+			}
+			weightedSum, err = weightedSum.Add(keptTimesValue)
+			if err != nil {
+				return Dec{}, err
+			}
+			keptWeight, err = keptWeight.Add(kept)
+			if err != nil {
+				return Dec{}, err
+			}
+		}
+		cumulativeBefore = cumulativeAfter
+	}
+	if keptWeight.IsZero() {
+		// The cuts meet, so nothing but the weighted median is left
+		return weightedQuantileOfSorted(sortedValues, sortedWeights, lowerCut, false)
+	}
+	return weightedSum.Quo(keptWeight)
+}
+
+// WeightedWinsorizedMean calculates the weighted mean of values once the values below the
+// weighted α-quantile are raised to it and the values above the weighted (1 - α)-quantile are
+// lowered to it, for the given fraction α. Returns zero if the total weight is zero.
+func WeightedWinsorizedMean(values, weights []Dec, fraction Dec) (Dec, error) {
+	sortedValues, sortedWeights, totalWeight, err := sortWeightedValues(values, weights)
+	if err != nil {
+		return Dec{}, err
+	}
+	if totalWeight.IsZero() {
+		return ZeroDec(), nil
+	}
+	lowerCut, err := totalWeight.Mul(fraction)
+	if err != nil {
+		return Dec{}, err
+	}
+	upperCut, err := totalWeight.Sub(lowerCut)
+	if err != nil {
+		return Dec{}, err
+	}
+	// Values wholly within a tail are clamped to the first value past it
+	lowerBound, err := weightedQuantileOfSorted(sortedValues, sortedWeights, lowerCut, true)
+	if err != nil {
+		return Dec{}, err
+	}
+	upperBound, err := weightedQuantileOfSorted(sortedValues, sortedWeights, upperCut, false)
+	if err != nil {
+		return Dec{}, err
+	}
+
+	weightedSum := ZeroDec()
+	for i, weight := range sortedWeights {
+		value := sortedValues[i]
+		if value.Lt(lowerBound) {
+			value = lowerBound
+		} else if value.Gt(upperBound) {
+			value = upperBound
+		}
+		weightTimesValue, err := weight.Mul(value)
+		if err != nil {
+			return Dec{}, err
+		}
+		weightedSum, err = weightedSum.Add(weightTimesValue)
+		if err != nil {
+			return Dec{}, err
+		}
+	}
+	return weightedSum.Quo(totalWeight)
+}
+
+// Lossy conversion of an sdk int to a float32, only good enough for telemetry
+func SdkIntToFloat32(i sdkmath.Int) float32 {
+	f, _ := new(big.Float).SetInt(i.BigInt()).Float32()
+	return f
+}
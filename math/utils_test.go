@@ -3,6 +3,7 @@ package math_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/stretchr/testify/require"
 )
@@ -106,6 +107,47 @@ func TestStdDevOneValueShouldBeZero(t *testing.T) {
 	)
 }
 
+func TestStdDevGivenSumOfSliceLessOneValueMatchesStdDev(t *testing.T) {
+	data := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("-0.00675"),
+		alloraMath.MustNewDecFromString("-0.00622"),
+		alloraMath.MustNewDecFromString("-0.01502"),
+		alloraMath.MustNewDecFromString("0.0438"),
+		alloraMath.MustNewDecFromString("0.09719"),
+		alloraMath.MustNewDecFromString("0.3333333333333333333333333333333333"),
+	}
+	sum := alloraMath.ZeroDec()
+	for _, v := range data {
+		var err error
+		sum, err = sum.Add(v)
+		require.NoError(t, err)
+	}
+
+	for i := range data {
+		rest := append(append([]alloraMath.Dec{}, data[:i]...), data[i+1:]...)
+		want, err := alloraMath.StdDev(rest)
+		require.NoError(t, err)
+		restSum, err := sum.Sub(data[i])
+		require.NoError(t, err)
+		got, err := alloraMath.StdDevGivenSum(rest, restSum)
+		require.NoError(t, err)
+		require.True(t, want.Equal(got), "%s != %s", want, got)
+	}
+}
+
+func TestStdDevOfCloseLargeValues(t *testing.T) {
+	data := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("1000000000.000000001"),
+		alloraMath.MustNewDecFromString("1000000000.000000002"),
+		alloraMath.MustNewDecFromString("1000000000.000000003"),
+		alloraMath.MustNewDecFromString("1000000000.000000004"),
+	}
+	stdDev, err := alloraMath.StdDev(data)
+	require.NoError(t, err)
+	// sqrt(1.25) * 1e-9
+	require.True(t, alloraMath.InDelta(alloraMath.MustNewDecFromString("0.000000001118033988749894848"), stdDev, alloraMath.MustNewDecFromString("1e-27")), stdDev.String())
+}
+
 func TestPhiSimple(t *testing.T) {
 	x := alloraMath.MustNewDecFromString("7.9997")
 	p := alloraMath.NewDecFromInt64(3)
@@ -240,3 +282,74 @@ func TestMedian(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedMedian(t *testing.T) {
+	values := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("100"),
+		alloraMath.MustNewDecFromString("1"),
+		alloraMath.MustNewDecFromString("3"),
+		alloraMath.MustNewDecFromString("2"),
+	}
+
+	median, err := alloraMath.WeightedMedian(values, []alloraMath.Dec{
+		alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(),
+	})
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("2").Equal(median), median.String())
+
+	// Enough weight on the outlier moves the median onto it
+	median, err = alloraMath.WeightedMedian(values, []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(4), alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(),
+	})
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("100").Equal(median), median.String())
+
+	_, err = alloraMath.WeightedMedian(values, []alloraMath.Dec{alloraMath.OneDec()})
+	require.ErrorIs(t, err, alloraMath.ErrInvalidWeights)
+}
+
+func TestWeightedTrimmedAndWinsorizedMeans(t *testing.T) {
+	values := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("1"),
+		alloraMath.MustNewDecFromString("2"),
+		alloraMath.MustNewDecFromString("3"),
+		alloraMath.MustNewDecFromString("4"),
+		alloraMath.MustNewDecFromString("100"),
+	}
+	weights := []alloraMath.Dec{
+		alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(),
+	}
+	fraction := alloraMath.MustNewDecFromString("0.2")
+
+	// 1 and 100 are cut off: (2 + 3 + 4) / 3
+	trimmed, err := alloraMath.WeightedTrimmedMean(values, weights, fraction)
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("3").Equal(trimmed), trimmed.String())
+
+	// 1 and 100 are clamped to 2 and 4: (2 + 2 + 3 + 4 + 4) / 5
+	winsorized, err := alloraMath.WeightedWinsorizedMean(values, weights, fraction)
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("3").Equal(winsorized), winsorized.String())
+
+	// Cutting half of the weight of the outer values: (0.5*1 + 2 + 3 + 4 + 0.5*100) / 4
+	trimmed, err = alloraMath.WeightedTrimmedMean(values, weights, alloraMath.MustNewDecFromString("0.1"))
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("14.875").Equal(trimmed), trimmed.String())
+
+	// Without weight there is nothing to average
+	zeroWeights := []alloraMath.Dec{
+		alloraMath.ZeroDec(), alloraMath.ZeroDec(), alloraMath.ZeroDec(), alloraMath.ZeroDec(), alloraMath.ZeroDec(),
+	}
+	trimmed, err = alloraMath.WeightedTrimmedMean(values, zeroWeights, fraction)
+	require.NoError(t, err)
+	require.True(t, trimmed.IsZero())
+}
+
+func TestSdkIntToFloat32(t *testing.T) {
+	require.Equal(t, float32(0), alloraMath.SdkIntToFloat32(sdkmath.ZeroInt()))
+	require.Equal(t, float32(1234), alloraMath.SdkIntToFloat32(sdkmath.NewInt(1234)))
+	// large amounts lose precision rather than overflow
+	oneE30, ok := sdkmath.NewIntFromString("1000000000000000000000000000000")
+	require.True(t, ok)
+	require.InEpsilon(t, float32(1e30), alloraMath.SdkIntToFloat32(oneE30), 1e-6)
+}
@@ -44,6 +44,8 @@ func UpdateParamsChecks(m testCommon.TestConfig) {
 			// These are set for subsequent tests
 			MaxTopReputersToReward: []uint64{24},
 			MinEpochLength:         []int64{1},
+			// Epochs of one block leave no room for a submission window
+			PayloadSubmissionWindow: []int64{0},
 		},
 	}
 	txResp, err := m.Client.BroadcastTx(ctx, m.AliceAcc, updateParamRequest)
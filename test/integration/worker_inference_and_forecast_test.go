@@ -83,9 +83,8 @@ func InsertSingleWorkerBulk(m testCommon.TestConfig, topic *types.Topic, blockHe
 		},
 	}
 	// Sign
-	src := make([]byte, 0)
-	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.XXX_Marshal(src, true)
-	require.NoError(m.T, err, "Marshall reputer value bundle should not return an error")
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.SignBytes(m.Client.Context().ChainID)
+	require.NoError(m.T, err, "SignBytes should not return an error")
 
 	sig, pubKey, err := m.Client.Context().Keyring.Sign(m.BobAcc.Name, src, signing.SignMode_SIGN_MODE_DIRECT)
 	require.NoError(m.T, err, "Sign should not return an error")
@@ -96,8 +95,9 @@ func InsertSingleWorkerBulk(m testCommon.TestConfig, topic *types.Topic, blockHe
 	txResp, err := m.Client.BroadcastTx(ctx, m.BobAcc, workerMsg)
 	require.NoError(m.T, err)
 
-	_, err = m.Client.WaitForTx(ctx, txResp.TxHash)
+	txResult, err := m.Client.WaitForTx(ctx, txResp.TxHash)
 	require.NoError(m.T, err)
+	waitForPayloadSubmissionWindow(m, txResult.Height)
 
 	// Latest inference
 	latestInference, err := m.Client.QueryEmissions().GetWorkerLatestInferenceByTopicId(
@@ -114,6 +114,13 @@ func InsertSingleWorkerBulk(m testCommon.TestConfig, topic *types.Topic, blockHe
 	require.Equal(m.T, latestInference.LatestInference.Inferer, InfererAddress1)
 }
 
+// Bulk payloads are ranked when their nonce closes, at most the submission window after they land
+func waitForPayloadSubmissionWindow(m testCommon.TestConfig, txHeight int64) {
+	window := GetEmissionsParams(m).PayloadSubmissionWindow
+	err := m.Client.WaitForBlockHeight(context.Background(), txHeight+window+1)
+	require.NoError(m.T, err)
+}
+
 // Worker Bob inserts bulk inference and forecast
 func InsertWorkerBulk(m testCommon.TestConfig, topic *types.Topic) (int64, int64) {
 	ctx := context.Background()
@@ -194,9 +201,8 @@ func InsertReputerBulk(m testCommon.TestConfig, topic *types.Topic, BlockHeightC
 	}
 
 	// Sign
-	src := make([]byte, 0)
-	src, err := reputerValueBundle.XXX_Marshal(src, true)
-	require.NoError(m.T, err, "Marshall reputer value bundle should not return an error")
+	src, err := reputerValueBundle.SignBytes(m.Client.Context().ChainID)
+	require.NoError(m.T, err, "SignBytes should not return an error")
 
 	valueBundleSignature, pubKey, err := m.Client.Context().Keyring.Sign(m.AliceAcc.Name, src, signing.SignMode_SIGN_MODE_DIRECT)
 	require.NoError(m.T, err, "Sign should not return an error")
@@ -221,8 +227,9 @@ func InsertReputerBulk(m testCommon.TestConfig, topic *types.Topic, BlockHeightC
 
 	txResp, err := m.Client.BroadcastTx(ctx, m.AliceAcc, lossesMsg)
 	require.NoError(m.T, err)
-	_, err = m.Client.WaitForTx(ctx, txResp.TxHash)
+	txResult, err := m.Client.WaitForTx(ctx, txResp.TxHash)
 	require.NoError(m.T, err)
+	waitForPayloadSubmissionWindow(m, txResult.Height)
 
 	result, err := m.Client.QueryEmissions().GetNetworkLossBundleAtBlock(ctx,
 		&types.QueryNetworkLossBundleAtBlockRequest{
@@ -224,9 +224,8 @@ func generateSingleReputerValueBundle(
 ) *emissionstypes.ReputerValueBundle {
 	valueBundle.Reputer = reputerAddress
 	// Sign
-	src := make([]byte, 0)
-	src, err := valueBundle.XXX_Marshal(src, true)
-	require.NoError(m.T, err, "Marshall reputer value bundle should not return an error")
+	src, err := valueBundle.SignBytes(m.Client.Context().ChainID)
+	require.NoError(m.T, err, "SignBytes should not return an error")
 
 	valueBundleSignature, pubKey, err := m.Client.Context().Keyring.Sign(reputerAddressName, src, signing.SignMode_SIGN_MODE_DIRECT)
 	require.NoError(m.T, err, "Sign should not return an error")
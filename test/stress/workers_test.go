@@ -198,9 +198,8 @@ func generateSingleWorkerBundle(
 	}
 
 	// Sign
-	src := make([]byte, 0)
-	src, err := workerDataBundle.InferenceForecastsBundle.XXX_Marshal(src, true)
-	require.NoError(m.T, err, "Marshall reputer value bundle should not return an error")
+	src, err := workerDataBundle.InferenceForecastsBundle.SignBytes(m.Client.Context().ChainID)
+	require.NoError(m.T, err, "SignBytes should not return an error")
 
 	sig, pubKey, err := m.Client.Context().Keyring.Sign(workerAddressName, src, signing.SignMode_SIGN_MODE_DIRECT)
 	require.NoError(m.T, err, "Sign should not return an error")
@@ -28,6 +28,7 @@ const (
 	Query_GetForecastsAtBlock_FullMethodName                   = "/emissions.v1.Query/GetForecastsAtBlock"
 	Query_GetNetworkLossBundleAtBlock_FullMethodName           = "/emissions.v1.Query/GetNetworkLossBundleAtBlock"
 	Query_GetTotalStake_FullMethodName                         = "/emissions.v1.Query/GetTotalStake"
+	Query_GetTopicRevenueBurned_FullMethodName                 = "/emissions.v1.Query/GetTopicRevenueBurned"
 	Query_GetReputerStakeInTopic_FullMethodName                = "/emissions.v1.Query/GetReputerStakeInTopic"
 	Query_GetMultiReputerStakeInTopic_FullMethodName           = "/emissions.v1.Query/GetMultiReputerStakeInTopic"
 	Query_GetStakeFromReputerInTopicInSelf_FullMethodName      = "/emissions.v1.Query/GetStakeFromReputerInTopicInSelf"
@@ -48,8 +49,18 @@ const (
 	Query_GetNetworkInferencesAtBlock_FullMethodName           = "/emissions.v1.Query/GetNetworkInferencesAtBlock"
 	Query_GetLatestNetworkInference_FullMethodName             = "/emissions.v1.Query/GetLatestNetworkInference"
 	Query_IsWhitelistAdmin_FullMethodName                      = "/emissions.v1.Query/IsWhitelistAdmin"
+	Query_GetTopicParams_FullMethodName                        = "/emissions.v1.Query/GetTopicParams"
+	Query_GetScheduledParamsChanges_FullMethodName             = "/emissions.v1.Query/GetScheduledParamsChanges"
+	Query_GetInferenceRequest_FullMethodName                   = "/emissions.v1.Query/GetInferenceRequest"
+	Query_GetOpenInferenceRequests_FullMethodName              = "/emissions.v1.Query/GetOpenInferenceRequests"
+	Query_GetTopicSubscriptions_FullMethodName                 = "/emissions.v1.Query/GetTopicSubscriptions"
+	Query_GetConsumerSubscriptions_FullMethodName              = "/emissions.v1.Query/GetConsumerSubscriptions"
+	Query_GetRoleMembers_FullMethodName                        = "/emissions.v1.Query/GetRoleMembers"
+	Query_GetAddressRoles_FullMethodName                       = "/emissions.v1.Query/GetAddressRoles"
 	Query_GetTopicLastWorkerCommitInfo_FullMethodName          = "/emissions.v1.Query/GetTopicLastWorkerCommitInfo"
 	Query_GetTopicLastReputerCommitInfo_FullMethodName         = "/emissions.v1.Query/GetTopicLastReputerCommitInfo"
+	Query_GetRegretHistory_FullMethodName                      = "/emissions.v1.Query/GetRegretHistory"
+	Query_GetPayoutAddress_FullMethodName                      = "/emissions.v1.Query/GetPayoutAddress"
 )
 
 // QueryClient is the client API for Query service.
@@ -66,6 +77,7 @@ type QueryClient interface {
 	GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error)
 	GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error)
 	GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error)
+	GetTopicRevenueBurned(ctx context.Context, in *QueryTopicRevenueBurnedRequest, opts ...grpc.CallOption) (*QueryTopicRevenueBurnedResponse, error)
 	GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error)
 	GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error)
 	GetStakeFromReputerInTopicInSelf(ctx context.Context, in *QueryStakeFromReputerInTopicInSelfRequest, opts ...grpc.CallOption) (*QueryStakeFromReputerInTopicInSelfResponse, error)
@@ -86,8 +98,18 @@ type QueryClient interface {
 	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
 	GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesAtBlockResponse, error)
 	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error)
+	GetScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error)
+	GetInferenceRequest(ctx context.Context, in *QueryInferenceRequestRequest, opts ...grpc.CallOption) (*QueryInferenceRequestResponse, error)
+	GetOpenInferenceRequests(ctx context.Context, in *QueryOpenInferenceRequestsRequest, opts ...grpc.CallOption) (*QueryOpenInferenceRequestsResponse, error)
+	GetTopicSubscriptions(ctx context.Context, in *QueryTopicSubscriptionsRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error)
+	GetConsumerSubscriptions(ctx context.Context, in *QueryConsumerSubscriptionsRequest, opts ...grpc.CallOption) (*QueryConsumerSubscriptionsResponse, error)
+	GetRoleMembers(ctx context.Context, in *QueryRoleMembersRequest, opts ...grpc.CallOption) (*QueryRoleMembersResponse, error)
+	GetAddressRoles(ctx context.Context, in *QueryAddressRolesRequest, opts ...grpc.CallOption) (*QueryAddressRolesResponse, error)
 	GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
 	GetTopicLastReputerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
+	GetRegretHistory(ctx context.Context, in *QueryRegretHistoryRequest, opts ...grpc.CallOption) (*QueryRegretHistoryResponse, error)
+	GetPayoutAddress(ctx context.Context, in *QueryPayoutAddressRequest, opts ...grpc.CallOption) (*QueryPayoutAddressResponse, error)
 }
 
 type queryClient struct {
@@ -179,6 +201,15 @@ func (c *queryClient) GetTotalStake(ctx context.Context, in *QueryTotalStakeRequ
 	return out, nil
 }
 
+func (c *queryClient) GetTopicRevenueBurned(ctx context.Context, in *QueryTopicRevenueBurnedRequest, opts ...grpc.CallOption) (*QueryTopicRevenueBurnedResponse, error) {
+	out := new(QueryTopicRevenueBurnedResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicRevenueBurned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error) {
 	out := new(QueryReputerStakeInTopicResponse)
 	err := c.cc.Invoke(ctx, Query_GetReputerStakeInTopic_FullMethodName, in, out, opts...)
@@ -359,6 +390,78 @@ func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelist
 	return out, nil
 }
 
+func (c *queryClient) GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error) {
+	out := new(QueryTopicParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error) {
+	out := new(QueryScheduledParamsChangesResponse)
+	err := c.cc.Invoke(ctx, Query_GetScheduledParamsChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetInferenceRequest(ctx context.Context, in *QueryInferenceRequestRequest, opts ...grpc.CallOption) (*QueryInferenceRequestResponse, error) {
+	out := new(QueryInferenceRequestResponse)
+	err := c.cc.Invoke(ctx, Query_GetInferenceRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOpenInferenceRequests(ctx context.Context, in *QueryOpenInferenceRequestsRequest, opts ...grpc.CallOption) (*QueryOpenInferenceRequestsResponse, error) {
+	out := new(QueryOpenInferenceRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_GetOpenInferenceRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicSubscriptions(ctx context.Context, in *QueryTopicSubscriptionsRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error) {
+	out := new(QueryTopicSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetConsumerSubscriptions(ctx context.Context, in *QueryConsumerSubscriptionsRequest, opts ...grpc.CallOption) (*QueryConsumerSubscriptionsResponse, error) {
+	out := new(QueryConsumerSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_GetConsumerSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRoleMembers(ctx context.Context, in *QueryRoleMembersRequest, opts ...grpc.CallOption) (*QueryRoleMembersResponse, error) {
+	out := new(QueryRoleMembersResponse)
+	err := c.cc.Invoke(ctx, Query_GetRoleMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAddressRoles(ctx context.Context, in *QueryAddressRolesRequest, opts ...grpc.CallOption) (*QueryAddressRolesResponse, error) {
+	out := new(QueryAddressRolesResponse)
+	err := c.cc.Invoke(ctx, Query_GetAddressRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error) {
 	out := new(QueryTopicLastCommitResponse)
 	err := c.cc.Invoke(ctx, Query_GetTopicLastWorkerCommitInfo_FullMethodName, in, out, opts...)
@@ -377,6 +480,24 @@ func (c *queryClient) GetTopicLastReputerCommitInfo(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) GetRegretHistory(ctx context.Context, in *QueryRegretHistoryRequest, opts ...grpc.CallOption) (*QueryRegretHistoryResponse, error) {
+	out := new(QueryRegretHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetRegretHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPayoutAddress(ctx context.Context, in *QueryPayoutAddressRequest, opts ...grpc.CallOption) (*QueryPayoutAddressResponse, error) {
+	out := new(QueryPayoutAddressResponse)
+	err := c.cc.Invoke(ctx, Query_GetPayoutAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -391,6 +512,7 @@ type QueryServer interface {
 	GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error)
 	GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error)
 	GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error)
+	GetTopicRevenueBurned(context.Context, *QueryTopicRevenueBurnedRequest) (*QueryTopicRevenueBurnedResponse, error)
 	GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error)
 	GetMultiReputerStakeInTopic(context.Context, *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error)
 	GetStakeFromReputerInTopicInSelf(context.Context, *QueryStakeFromReputerInTopicInSelfRequest) (*QueryStakeFromReputerInTopicInSelfResponse, error)
@@ -411,8 +533,18 @@ type QueryServer interface {
 	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
 	GetLatestNetworkInference(context.Context, *QueryLatestNetworkInferencesAtBlockRequest) (*QueryLatestNetworkInferencesAtBlockResponse, error)
 	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicParams(context.Context, *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error)
+	GetScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error)
+	GetInferenceRequest(context.Context, *QueryInferenceRequestRequest) (*QueryInferenceRequestResponse, error)
+	GetOpenInferenceRequests(context.Context, *QueryOpenInferenceRequestsRequest) (*QueryOpenInferenceRequestsResponse, error)
+	GetTopicSubscriptions(context.Context, *QueryTopicSubscriptionsRequest) (*QueryTopicSubscriptionsResponse, error)
+	GetConsumerSubscriptions(context.Context, *QueryConsumerSubscriptionsRequest) (*QueryConsumerSubscriptionsResponse, error)
+	GetRoleMembers(context.Context, *QueryRoleMembersRequest) (*QueryRoleMembersResponse, error)
+	GetAddressRoles(context.Context, *QueryAddressRolesRequest) (*QueryAddressRolesResponse, error)
 	GetTopicLastWorkerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
 	GetTopicLastReputerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
+	GetRegretHistory(context.Context, *QueryRegretHistoryRequest) (*QueryRegretHistoryResponse, error)
+	GetPayoutAddress(context.Context, *QueryPayoutAddressRequest) (*QueryPayoutAddressResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -447,6 +579,9 @@ func (UnimplementedQueryServer) GetNetworkLossBundleAtBlock(context.Context, *Qu
 func (UnimplementedQueryServer) GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalStake not implemented")
 }
+func (UnimplementedQueryServer) GetTopicRevenueBurned(context.Context, *QueryTopicRevenueBurnedRequest) (*QueryTopicRevenueBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRevenueBurned not implemented")
+}
 func (UnimplementedQueryServer) GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakeInTopic not implemented")
 }
@@ -507,12 +642,42 @@ func (UnimplementedQueryServer) GetLatestNetworkInference(context.Context, *Quer
 func (UnimplementedQueryServer) IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
 }
+func (UnimplementedQueryServer) GetTopicParams(context.Context, *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicParams not implemented")
+}
+func (UnimplementedQueryServer) GetScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledParamsChanges not implemented")
+}
+func (UnimplementedQueryServer) GetInferenceRequest(context.Context, *QueryInferenceRequestRequest) (*QueryInferenceRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInferenceRequest not implemented")
+}
+func (UnimplementedQueryServer) GetOpenInferenceRequests(context.Context, *QueryOpenInferenceRequestsRequest) (*QueryOpenInferenceRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInferenceRequests not implemented")
+}
+func (UnimplementedQueryServer) GetTopicSubscriptions(context.Context, *QueryTopicSubscriptionsRequest) (*QueryTopicSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSubscriptions not implemented")
+}
+func (UnimplementedQueryServer) GetConsumerSubscriptions(context.Context, *QueryConsumerSubscriptionsRequest) (*QueryConsumerSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerSubscriptions not implemented")
+}
+func (UnimplementedQueryServer) GetRoleMembers(context.Context, *QueryRoleMembersRequest) (*QueryRoleMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleMembers not implemented")
+}
+func (UnimplementedQueryServer) GetAddressRoles(context.Context, *QueryAddressRolesRequest) (*QueryAddressRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressRoles not implemented")
+}
 func (UnimplementedQueryServer) GetTopicLastWorkerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastWorkerCommitInfo not implemented")
 }
 func (UnimplementedQueryServer) GetTopicLastReputerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastReputerCommitInfo not implemented")
 }
+func (UnimplementedQueryServer) GetRegretHistory(context.Context, *QueryRegretHistoryRequest) (*QueryRegretHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegretHistory not implemented")
+}
+func (UnimplementedQueryServer) GetPayoutAddress(context.Context, *QueryPayoutAddressRequest) (*QueryPayoutAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutAddress not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -688,6 +853,24 @@ func _Query_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTopicRevenueBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicRevenueBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicRevenueBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicRevenueBurned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicRevenueBurned(ctx, req.(*QueryTopicRevenueBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryReputerStakeInTopicRequest)
 	if err := dec(in); err != nil {
@@ -1048,6 +1231,150 @@ func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTopicParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicParams(ctx, req.(*QueryTopicParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetScheduledParamsChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetScheduledParamsChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetScheduledParamsChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetScheduledParamsChanges(ctx, req.(*QueryScheduledParamsChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetInferenceRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferenceRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetInferenceRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetInferenceRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetInferenceRequest(ctx, req.(*QueryInferenceRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOpenInferenceRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenInferenceRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOpenInferenceRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetOpenInferenceRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOpenInferenceRequests(ctx, req.(*QueryOpenInferenceRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicSubscriptions(ctx, req.(*QueryTopicSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetConsumerSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetConsumerSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetConsumerSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetConsumerSubscriptions(ctx, req.(*QueryConsumerSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRoleMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRoleMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRoleMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRoleMembers(ctx, req.(*QueryRoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAddressRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAddressRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAddressRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAddressRoles(ctx, req.(*QueryAddressRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetTopicLastWorkerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTopicLastCommitRequest)
 	if err := dec(in); err != nil {
@@ -1084,6 +1411,42 @@ func _Query_GetTopicLastReputerCommitInfo_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetRegretHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegretHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRegretHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRegretHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRegretHistory(ctx, req.(*QueryRegretHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPayoutAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPayoutAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPayoutAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPayoutAddress(ctx, req.(*QueryPayoutAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1127,6 +1490,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTotalStake",
 			Handler:    _Query_GetTotalStake_Handler,
 		},
+		{
+			MethodName: "GetTopicRevenueBurned",
+			Handler:    _Query_GetTopicRevenueBurned_Handler,
+		},
 		{
 			MethodName: "GetReputerStakeInTopic",
 			Handler:    _Query_GetReputerStakeInTopic_Handler,
@@ -1207,6 +1574,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsWhitelistAdmin",
 			Handler:    _Query_IsWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "GetTopicParams",
+			Handler:    _Query_GetTopicParams_Handler,
+		},
+		{
+			MethodName: "GetScheduledParamsChanges",
+			Handler:    _Query_GetScheduledParamsChanges_Handler,
+		},
+		{
+			MethodName: "GetInferenceRequest",
+			Handler:    _Query_GetInferenceRequest_Handler,
+		},
+		{
+			MethodName: "GetOpenInferenceRequests",
+			Handler:    _Query_GetOpenInferenceRequests_Handler,
+		},
+		{
+			MethodName: "GetTopicSubscriptions",
+			Handler:    _Query_GetTopicSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetConsumerSubscriptions",
+			Handler:    _Query_GetConsumerSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetRoleMembers",
+			Handler:    _Query_GetRoleMembers_Handler,
+		},
+		{
+			MethodName: "GetAddressRoles",
+			Handler:    _Query_GetAddressRoles_Handler,
+		},
 		{
 			MethodName: "GetTopicLastWorkerCommitInfo",
 			Handler:    _Query_GetTopicLastWorkerCommitInfo_Handler,
@@ -1215,6 +1614,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopicLastReputerCommitInfo",
 			Handler:    _Query_GetTopicLastReputerCommitInfo_Handler,
 		},
+		{
+			MethodName: "GetRegretHistory",
+			Handler:    _Query_GetRegretHistory_Handler,
+		},
+		{
+			MethodName: "GetPayoutAddress",
+			Handler:    _Query_GetPayoutAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/query.proto",
@@ -0,0 +1,1013 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package emissionsv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_BundleSignDoc              protoreflect.MessageDescriptor
+	fd_BundleSignDoc_domain       protoreflect.FieldDescriptor
+	fd_BundleSignDoc_chain_id     protoreflect.FieldDescriptor
+	fd_BundleSignDoc_payload_kind protoreflect.FieldDescriptor
+	fd_BundleSignDoc_topic_id     protoreflect.FieldDescriptor
+	fd_BundleSignDoc_nonce        protoreflect.FieldDescriptor
+	fd_BundleSignDoc_payload      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_signing_proto_init()
+	md_BundleSignDoc = File_emissions_v1_signing_proto.Messages().ByName("BundleSignDoc")
+	fd_BundleSignDoc_domain = md_BundleSignDoc.Fields().ByName("domain")
+	fd_BundleSignDoc_chain_id = md_BundleSignDoc.Fields().ByName("chain_id")
+	fd_BundleSignDoc_payload_kind = md_BundleSignDoc.Fields().ByName("payload_kind")
+	fd_BundleSignDoc_topic_id = md_BundleSignDoc.Fields().ByName("topic_id")
+	fd_BundleSignDoc_nonce = md_BundleSignDoc.Fields().ByName("nonce")
+	fd_BundleSignDoc_payload = md_BundleSignDoc.Fields().ByName("payload")
+}
+
+var _ protoreflect.Message = (*fastReflection_BundleSignDoc)(nil)
+
+type fastReflection_BundleSignDoc BundleSignDoc
+
+func (x *BundleSignDoc) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BundleSignDoc)(x)
+}
+
+func (x *BundleSignDoc) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_signing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BundleSignDoc_messageType fastReflection_BundleSignDoc_messageType
+var _ protoreflect.MessageType = fastReflection_BundleSignDoc_messageType{}
+
+type fastReflection_BundleSignDoc_messageType struct{}
+
+func (x fastReflection_BundleSignDoc_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BundleSignDoc)(nil)
+}
+func (x fastReflection_BundleSignDoc_messageType) New() protoreflect.Message {
+	return new(fastReflection_BundleSignDoc)
+}
+func (x fastReflection_BundleSignDoc_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BundleSignDoc
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BundleSignDoc) Descriptor() protoreflect.MessageDescriptor {
+	return md_BundleSignDoc
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BundleSignDoc) Type() protoreflect.MessageType {
+	return _fastReflection_BundleSignDoc_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BundleSignDoc) New() protoreflect.Message {
+	return new(fastReflection_BundleSignDoc)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BundleSignDoc) Interface() protoreflect.ProtoMessage {
+	return (*BundleSignDoc)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BundleSignDoc) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Domain != "" {
+		value := protoreflect.ValueOfString(x.Domain)
+		if !f(fd_BundleSignDoc_domain, value) {
+			return
+		}
+	}
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_BundleSignDoc_chain_id, value) {
+			return
+		}
+	}
+	if x.PayloadKind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PayloadKind))
+		if !f(fd_BundleSignDoc_payload_kind, value) {
+			return
+		}
+	}
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_BundleSignDoc_topic_id, value) {
+			return
+		}
+	}
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_BundleSignDoc_nonce, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_BundleSignDoc_payload, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BundleSignDoc) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.BundleSignDoc.domain":
+		return x.Domain != ""
+	case "emissions.v1.BundleSignDoc.chain_id":
+		return x.ChainId != ""
+	case "emissions.v1.BundleSignDoc.payload_kind":
+		return x.PayloadKind != 0
+	case "emissions.v1.BundleSignDoc.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.BundleSignDoc.nonce":
+		return x.Nonce != int64(0)
+	case "emissions.v1.BundleSignDoc.payload":
+		return len(x.Payload) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleSignDoc"))
+		}
+		panic(fmt.Errorf("message emissions.v1.BundleSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundleSignDoc) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.BundleSignDoc.domain":
+		x.Domain = ""
+	case "emissions.v1.BundleSignDoc.chain_id":
+		x.ChainId = ""
+	case "emissions.v1.BundleSignDoc.payload_kind":
+		x.PayloadKind = 0
+	case "emissions.v1.BundleSignDoc.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.BundleSignDoc.nonce":
+		x.Nonce = int64(0)
+	case "emissions.v1.BundleSignDoc.payload":
+		x.Payload = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleSignDoc"))
+		}
+		panic(fmt.Errorf("message emissions.v1.BundleSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BundleSignDoc) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.BundleSignDoc.domain":
+		value := x.Domain
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.BundleSignDoc.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.BundleSignDoc.payload_kind":
+		value := x.PayloadKind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.BundleSignDoc.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.BundleSignDoc.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.BundleSignDoc.payload":
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleSignDoc"))
+		}
+		panic(fmt.Errorf("message emissions.v1.BundleSignDoc does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundleSignDoc) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.BundleSignDoc.domain":
+		x.Domain = value.Interface().(string)
+	case "emissions.v1.BundleSignDoc.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "emissions.v1.BundleSignDoc.payload_kind":
+		x.PayloadKind = (PayloadKind)(value.Enum())
+	case "emissions.v1.BundleSignDoc.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.BundleSignDoc.nonce":
+		x.Nonce = value.Int()
+	case "emissions.v1.BundleSignDoc.payload":
+		x.Payload = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleSignDoc"))
+		}
+		panic(fmt.Errorf("message emissions.v1.BundleSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundleSignDoc) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.BundleSignDoc.domain":
+		panic(fmt.Errorf("field domain of message emissions.v1.BundleSignDoc is not mutable"))
+	case "emissions.v1.BundleSignDoc.chain_id":
+		panic(fmt.Errorf("field chain_id of message emissions.v1.BundleSignDoc is not mutable"))
+	case "emissions.v1.BundleSignDoc.payload_kind":
+		panic(fmt.Errorf("field payload_kind of message emissions.v1.BundleSignDoc is not mutable"))
+	case "emissions.v1.BundleSignDoc.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.BundleSignDoc is not mutable"))
+	case "emissions.v1.BundleSignDoc.nonce":
+		panic(fmt.Errorf("field nonce of message emissions.v1.BundleSignDoc is not mutable"))
+	case "emissions.v1.BundleSignDoc.payload":
+		panic(fmt.Errorf("field payload of message emissions.v1.BundleSignDoc is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleSignDoc"))
+		}
+		panic(fmt.Errorf("message emissions.v1.BundleSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BundleSignDoc) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.BundleSignDoc.domain":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.BundleSignDoc.chain_id":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.BundleSignDoc.payload_kind":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.BundleSignDoc.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.BundleSignDoc.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.BundleSignDoc.payload":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleSignDoc"))
+		}
+		panic(fmt.Errorf("message emissions.v1.BundleSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BundleSignDoc) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.BundleSignDoc", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BundleSignDoc) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundleSignDoc) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BundleSignDoc) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BundleSignDoc) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BundleSignDoc)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Domain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PayloadKind != 0 {
+			n += 1 + runtime.Sov(uint64(x.PayloadKind))
+		}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BundleSignDoc)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PayloadKind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayloadKind))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Domain) > 0 {
+			i -= len(x.Domain)
+			copy(dAtA[i:], x.Domain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Domain)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BundleSignDoc)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundleSignDoc: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundleSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Domain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayloadKind", wireType)
+				}
+				x.PayloadKind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayloadKind |= PayloadKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/signing.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of the public key that signed a worker or reputer bundle
+type PubKeyType int32
+
+const (
+	PubKeyType_PUB_KEY_TYPE_SECP256K1 PubKeyType = 0
+	PubKeyType_PUB_KEY_TYPE_ED25519   PubKeyType = 1
+	PubKeyType_PUB_KEY_TYPE_SECP256R1 PubKeyType = 2
+)
+
+// Enum value maps for PubKeyType.
+var (
+	PubKeyType_name = map[int32]string{
+		0: "PUB_KEY_TYPE_SECP256K1",
+		1: "PUB_KEY_TYPE_ED25519",
+		2: "PUB_KEY_TYPE_SECP256R1",
+	}
+	PubKeyType_value = map[string]int32{
+		"PUB_KEY_TYPE_SECP256K1": 0,
+		"PUB_KEY_TYPE_ED25519":   1,
+		"PUB_KEY_TYPE_SECP256R1": 2,
+	}
+)
+
+func (x PubKeyType) Enum() *PubKeyType {
+	p := new(PubKeyType)
+	*p = x
+	return p
+}
+
+func (x PubKeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PubKeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_signing_proto_enumTypes[0].Descriptor()
+}
+
+func (PubKeyType) Type() protoreflect.EnumType {
+	return &file_emissions_v1_signing_proto_enumTypes[0]
+}
+
+func (x PubKeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PubKeyType.Descriptor instead.
+func (PubKeyType) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_signing_proto_rawDescGZIP(), []int{0}
+}
+
+// Kind of payload a bundle signature commits to
+type PayloadKind int32
+
+const (
+	PayloadKind_PAYLOAD_KIND_UNSPECIFIED          PayloadKind = 0
+	PayloadKind_PAYLOAD_KIND_WORKER_DATA_BUNDLE   PayloadKind = 1
+	PayloadKind_PAYLOAD_KIND_REPUTER_VALUE_BUNDLE PayloadKind = 2
+)
+
+// Enum value maps for PayloadKind.
+var (
+	PayloadKind_name = map[int32]string{
+		0: "PAYLOAD_KIND_UNSPECIFIED",
+		1: "PAYLOAD_KIND_WORKER_DATA_BUNDLE",
+		2: "PAYLOAD_KIND_REPUTER_VALUE_BUNDLE",
+	}
+	PayloadKind_value = map[string]int32{
+		"PAYLOAD_KIND_UNSPECIFIED":          0,
+		"PAYLOAD_KIND_WORKER_DATA_BUNDLE":   1,
+		"PAYLOAD_KIND_REPUTER_VALUE_BUNDLE": 2,
+	}
+)
+
+func (x PayloadKind) Enum() *PayloadKind {
+	p := new(PayloadKind)
+	*p = x
+	return p
+}
+
+func (x PayloadKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayloadKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_signing_proto_enumTypes[1].Descriptor()
+}
+
+func (PayloadKind) Type() protoreflect.EnumType {
+	return &file_emissions_v1_signing_proto_enumTypes[1]
+}
+
+func (x PayloadKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayloadKind.Descriptor instead.
+func (PayloadKind) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_signing_proto_rawDescGZIP(), []int{1}
+}
+
+// Document whose deterministic proto encoding is signed by workers and reputers.
+// It binds the payload to a chain, topic and nonce so that a signature cannot be
+// replayed on another chain or for another kind of payload.
+type BundleSignDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain      string      `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // always "allora/emissions/bundle/v1"
+	ChainId     string      `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	PayloadKind PayloadKind `protobuf:"varint,3,opt,name=payload_kind,json=payloadKind,proto3,enum=emissions.v1.PayloadKind" json:"payload_kind,omitempty"`
+	TopicId     uint64      `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Nonce       int64       `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`    // block height of the worker or reputer nonce
+	Payload     []byte      `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"` // deterministic proto encoding of the signed bundle
+}
+
+func (x *BundleSignDoc) Reset() {
+	*x = BundleSignDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_signing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleSignDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleSignDoc) ProtoMessage() {}
+
+// Deprecated: Use BundleSignDoc.ProtoReflect.Descriptor instead.
+func (*BundleSignDoc) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_signing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BundleSignDoc) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *BundleSignDoc) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *BundleSignDoc) GetPayloadKind() PayloadKind {
+	if x != nil {
+		return x.PayloadKind
+	}
+	return PayloadKind_PAYLOAD_KIND_UNSPECIFIED
+}
+
+func (x *BundleSignDoc) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *BundleSignDoc) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *BundleSignDoc) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_emissions_v1_signing_proto protoreflect.FileDescriptor
+
+var file_emissions_v1_signing_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x44, 0x6f, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x5e, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x42, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x55, 0x42, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x50, 0x55, 0x42, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x43,
+	0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x10, 0x02, 0x2a, 0x77, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x59, 0x4c, 0x4f,
+	0x41, 0x44, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x41,
+	0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x50, 0x55, 0x54,
+	0x45, 0x52, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x10,
+	0x02, 0x42, 0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78,
+	0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_emissions_v1_signing_proto_rawDescOnce sync.Once
+	file_emissions_v1_signing_proto_rawDescData = file_emissions_v1_signing_proto_rawDesc
+)
+
+func file_emissions_v1_signing_proto_rawDescGZIP() []byte {
+	file_emissions_v1_signing_proto_rawDescOnce.Do(func() {
+		file_emissions_v1_signing_proto_rawDescData = protoimpl.X.CompressGZIP(file_emissions_v1_signing_proto_rawDescData)
+	})
+	return file_emissions_v1_signing_proto_rawDescData
+}
+
+var file_emissions_v1_signing_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_emissions_v1_signing_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_emissions_v1_signing_proto_goTypes = []interface{}{
+	(PubKeyType)(0),       // 0: emissions.v1.PubKeyType
+	(PayloadKind)(0),      // 1: emissions.v1.PayloadKind
+	(*BundleSignDoc)(nil), // 2: emissions.v1.BundleSignDoc
+}
+var file_emissions_v1_signing_proto_depIdxs = []int32{
+	1, // 0: emissions.v1.BundleSignDoc.payload_kind:type_name -> emissions.v1.PayloadKind
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_emissions_v1_signing_proto_init() }
+func file_emissions_v1_signing_proto_init() {
+	if File_emissions_v1_signing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_emissions_v1_signing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleSignDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_emissions_v1_signing_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_emissions_v1_signing_proto_goTypes,
+		DependencyIndexes: file_emissions_v1_signing_proto_depIdxs,
+		EnumInfos:         file_emissions_v1_signing_proto_enumTypes,
+		MessageInfos:      file_emissions_v1_signing_proto_msgTypes,
+	}.Build()
+	File_emissions_v1_signing_proto = out.File
+	file_emissions_v1_signing_proto_rawDesc = nil
+	file_emissions_v1_signing_proto_goTypes = nil
+	file_emissions_v1_signing_proto_depIdxs = nil
+}
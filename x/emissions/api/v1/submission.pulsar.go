@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package emissionsv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_SubmissionOutcome              protoreflect.MessageDescriptor
+	fd_SubmissionOutcome_bundle_index protoreflect.FieldDescriptor
+	fd_SubmissionOutcome_actor        protoreflect.FieldDescriptor
+	fd_SubmissionOutcome_code         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_submission_proto_init()
+	md_SubmissionOutcome = File_emissions_v1_submission_proto.Messages().ByName("SubmissionOutcome")
+	fd_SubmissionOutcome_bundle_index = md_SubmissionOutcome.Fields().ByName("bundle_index")
+	fd_SubmissionOutcome_actor = md_SubmissionOutcome.Fields().ByName("actor")
+	fd_SubmissionOutcome_code = md_SubmissionOutcome.Fields().ByName("code")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubmissionOutcome)(nil)
+
+type fastReflection_SubmissionOutcome SubmissionOutcome
+
+func (x *SubmissionOutcome) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubmissionOutcome)(x)
+}
+
+func (x *SubmissionOutcome) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_submission_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubmissionOutcome_messageType fastReflection_SubmissionOutcome_messageType
+var _ protoreflect.MessageType = fastReflection_SubmissionOutcome_messageType{}
+
+type fastReflection_SubmissionOutcome_messageType struct{}
+
+func (x fastReflection_SubmissionOutcome_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubmissionOutcome)(nil)
+}
+func (x fastReflection_SubmissionOutcome_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubmissionOutcome)
+}
+func (x fastReflection_SubmissionOutcome_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubmissionOutcome
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubmissionOutcome) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubmissionOutcome
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubmissionOutcome) Type() protoreflect.MessageType {
+	return _fastReflection_SubmissionOutcome_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubmissionOutcome) New() protoreflect.Message {
+	return new(fastReflection_SubmissionOutcome)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubmissionOutcome) Interface() protoreflect.ProtoMessage {
+	return (*SubmissionOutcome)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubmissionOutcome) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BundleIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BundleIndex)
+		if !f(fd_SubmissionOutcome_bundle_index, value) {
+			return
+		}
+	}
+	if x.Actor != "" {
+		value := protoreflect.ValueOfString(x.Actor)
+		if !f(fd_SubmissionOutcome_actor, value) {
+			return
+		}
+	}
+	if x.Code != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Code))
+		if !f(fd_SubmissionOutcome_code, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubmissionOutcome) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.SubmissionOutcome.bundle_index":
+		return x.BundleIndex != uint32(0)
+	case "emissions.v1.SubmissionOutcome.actor":
+		return x.Actor != ""
+	case "emissions.v1.SubmissionOutcome.code":
+		return x.Code != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.SubmissionOutcome"))
+		}
+		panic(fmt.Errorf("message emissions.v1.SubmissionOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmissionOutcome) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.SubmissionOutcome.bundle_index":
+		x.BundleIndex = uint32(0)
+	case "emissions.v1.SubmissionOutcome.actor":
+		x.Actor = ""
+	case "emissions.v1.SubmissionOutcome.code":
+		x.Code = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.SubmissionOutcome"))
+		}
+		panic(fmt.Errorf("message emissions.v1.SubmissionOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubmissionOutcome) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.SubmissionOutcome.bundle_index":
+		value := x.BundleIndex
+		return protoreflect.ValueOfUint32(value)
+	case "emissions.v1.SubmissionOutcome.actor":
+		value := x.Actor
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.SubmissionOutcome.code":
+		value := x.Code
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.SubmissionOutcome"))
+		}
+		panic(fmt.Errorf("message emissions.v1.SubmissionOutcome does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmissionOutcome) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.SubmissionOutcome.bundle_index":
+		x.BundleIndex = uint32(value.Uint())
+	case "emissions.v1.SubmissionOutcome.actor":
+		x.Actor = value.Interface().(string)
+	case "emissions.v1.SubmissionOutcome.code":
+		x.Code = (SubmissionOutcomeCode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.SubmissionOutcome"))
+		}
+		panic(fmt.Errorf("message emissions.v1.SubmissionOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmissionOutcome) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.SubmissionOutcome.bundle_index":
+		panic(fmt.Errorf("field bundle_index of message emissions.v1.SubmissionOutcome is not mutable"))
+	case "emissions.v1.SubmissionOutcome.actor":
+		panic(fmt.Errorf("field actor of message emissions.v1.SubmissionOutcome is not mutable"))
+	case "emissions.v1.SubmissionOutcome.code":
+		panic(fmt.Errorf("field code of message emissions.v1.SubmissionOutcome is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.SubmissionOutcome"))
+		}
+		panic(fmt.Errorf("message emissions.v1.SubmissionOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubmissionOutcome) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.SubmissionOutcome.bundle_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "emissions.v1.SubmissionOutcome.actor":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.SubmissionOutcome.code":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.SubmissionOutcome"))
+		}
+		panic(fmt.Errorf("message emissions.v1.SubmissionOutcome does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubmissionOutcome) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.SubmissionOutcome", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubmissionOutcome) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmissionOutcome) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubmissionOutcome) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubmissionOutcome) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubmissionOutcome)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BundleIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.BundleIndex))
+		}
+		l = len(x.Actor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Code != 0 {
+			n += 1 + runtime.Sov(uint64(x.Code))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubmissionOutcome)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Code != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Code))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Actor) > 0 {
+			i -= len(x.Actor)
+			copy(dAtA[i:], x.Actor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BundleIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BundleIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubmissionOutcome)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmissionOutcome: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmissionOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundleIndex", wireType)
+				}
+				x.BundleIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BundleIndex |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				x.Code = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Code |= SubmissionOutcomeCode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/submission.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What became of a submission in a bulk payload.
+// The codes are stable: new codes are only ever appended.
+type SubmissionOutcomeCode int32
+
+const (
+	// Never set by the chain, so that an outcome without a code is not read as accepted
+	SubmissionOutcomeCode_SUBMISSION_OUTCOME_UNSPECIFIED SubmissionOutcomeCode = 0
+	SubmissionOutcomeCode_SUBMISSION_ACCEPTED            SubmissionOutcomeCode = 1
+	// The bundle failed validation, e.g. a bad signature or a malformed value
+	SubmissionOutcomeCode_SUBMISSION_INVALID                 SubmissionOutcomeCode = 2
+	SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH SubmissionOutcomeCode = 3
+	// Only the first submission of an actor in a payload is considered
+	SubmissionOutcomeCode_SUBMISSION_DUPLICATE          SubmissionOutcomeCode = 4
+	SubmissionOutcomeCode_SUBMISSION_UNREGISTERED       SubmissionOutcomeCode = 5
+	SubmissionOutcomeCode_SUBMISSION_INSUFFICIENT_STAKE SubmissionOutcomeCode = 6
+	// None of the workers the forecast or losses are about had their inferences accepted
+	SubmissionOutcomeCode_SUBMISSION_NO_ACCEPTED_WORKERS SubmissionOutcomeCode = 7
+	// Left out for actors with a higher score, only the top actors of a topic are rewarded
+	SubmissionOutcomeCode_SUBMISSION_NOT_TOP_RANKED SubmissionOutcomeCode = 8
+	// The chain failed to read the state needed to check the submission
+	SubmissionOutcomeCode_SUBMISSION_STORE_ERROR SubmissionOutcomeCode = 9
+	// The inference does not have the number of values the topic declares
+	SubmissionOutcomeCode_SUBMISSION_WRONG_DIMENSION SubmissionOutcomeCode = 10
+	// The quantiles of the inference decrease as the quantile level increases
+	SubmissionOutcomeCode_SUBMISSION_NON_MONOTONIC_QUANTILES SubmissionOutcomeCode = 11
+	// The probabilities of the inference are negative or do not sum to one
+	SubmissionOutcomeCode_SUBMISSION_INVALID_PROBABILITIES SubmissionOutcomeCode = 12
+)
+
+// Enum value maps for SubmissionOutcomeCode.
+var (
+	SubmissionOutcomeCode_name = map[int32]string{
+		0:  "SUBMISSION_OUTCOME_UNSPECIFIED",
+		1:  "SUBMISSION_ACCEPTED",
+		2:  "SUBMISSION_INVALID",
+		3:  "SUBMISSION_TOPIC_OR_NONCE_MISMATCH",
+		4:  "SUBMISSION_DUPLICATE",
+		5:  "SUBMISSION_UNREGISTERED",
+		6:  "SUBMISSION_INSUFFICIENT_STAKE",
+		7:  "SUBMISSION_NO_ACCEPTED_WORKERS",
+		8:  "SUBMISSION_NOT_TOP_RANKED",
+		9:  "SUBMISSION_STORE_ERROR",
+		10: "SUBMISSION_WRONG_DIMENSION",
+		11: "SUBMISSION_NON_MONOTONIC_QUANTILES",
+		12: "SUBMISSION_INVALID_PROBABILITIES",
+	}
+	SubmissionOutcomeCode_value = map[string]int32{
+		"SUBMISSION_OUTCOME_UNSPECIFIED":     0,
+		"SUBMISSION_ACCEPTED":                1,
+		"SUBMISSION_INVALID":                 2,
+		"SUBMISSION_TOPIC_OR_NONCE_MISMATCH": 3,
+		"SUBMISSION_DUPLICATE":               4,
+		"SUBMISSION_UNREGISTERED":            5,
+		"SUBMISSION_INSUFFICIENT_STAKE":      6,
+		"SUBMISSION_NO_ACCEPTED_WORKERS":     7,
+		"SUBMISSION_NOT_TOP_RANKED":          8,
+		"SUBMISSION_STORE_ERROR":             9,
+		"SUBMISSION_WRONG_DIMENSION":         10,
+		"SUBMISSION_NON_MONOTONIC_QUANTILES": 11,
+		"SUBMISSION_INVALID_PROBABILITIES":   12,
+	}
+)
+
+func (x SubmissionOutcomeCode) Enum() *SubmissionOutcomeCode {
+	p := new(SubmissionOutcomeCode)
+	*p = x
+	return p
+}
+
+func (x SubmissionOutcomeCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionOutcomeCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_submission_proto_enumTypes[0].Descriptor()
+}
+
+func (SubmissionOutcomeCode) Type() protoreflect.EnumType {
+	return &file_emissions_v1_submission_proto_enumTypes[0]
+}
+
+func (x SubmissionOutcomeCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionOutcomeCode.Descriptor instead.
+func (SubmissionOutcomeCode) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_submission_proto_rawDescGZIP(), []int{0}
+}
+
+type SubmissionOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position of the bundle in the payload
+	BundleIndex uint32                `protobuf:"varint,1,opt,name=bundle_index,json=bundleIndex,proto3" json:"bundle_index,omitempty"`
+	Actor       string                `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Code        SubmissionOutcomeCode `protobuf:"varint,3,opt,name=code,proto3,enum=emissions.v1.SubmissionOutcomeCode" json:"code,omitempty"`
+}
+
+func (x *SubmissionOutcome) Reset() {
+	*x = SubmissionOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_submission_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionOutcome) ProtoMessage() {}
+
+// Deprecated: Use SubmissionOutcome.ProtoReflect.Descriptor instead.
+func (*SubmissionOutcome) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_submission_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmissionOutcome) GetBundleIndex() uint32 {
+	if x != nil {
+		return x.BundleIndex
+	}
+	return 0
+}
+
+func (x *SubmissionOutcome) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SubmissionOutcome) GetCode() SubmissionOutcomeCode {
+	if x != nil {
+		return x.Code
+	}
+	return SubmissionOutcomeCode_SUBMISSION_OUTCOME_UNSPECIFIED
+}
+
+var File_emissions_v1_submission_proto protoreflect.FileDescriptor
+
+var file_emissions_v1_submission_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x85, 0x01,
+	0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xbb, 0x03, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x22, 0x0a, 0x1e, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x43,
+	0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x4b, 0x45, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44,
+	0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x53, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x55,
+	0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x54, 0x4f, 0x50,
+	0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x55, 0x42,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x09, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x4e, 0x4f, 0x54, 0x4f, 0x4e, 0x49,
+	0x43, 0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x4c, 0x45, 0x53, 0x10, 0x0b, 0x12, 0x24, 0x0a,
+	0x20, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x49, 0x45,
+	0x53, 0x10, 0x0c, 0x42, 0xc5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31,
+	0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45,
+	0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_emissions_v1_submission_proto_rawDescOnce sync.Once
+	file_emissions_v1_submission_proto_rawDescData = file_emissions_v1_submission_proto_rawDesc
+)
+
+func file_emissions_v1_submission_proto_rawDescGZIP() []byte {
+	file_emissions_v1_submission_proto_rawDescOnce.Do(func() {
+		file_emissions_v1_submission_proto_rawDescData = protoimpl.X.CompressGZIP(file_emissions_v1_submission_proto_rawDescData)
+	})
+	return file_emissions_v1_submission_proto_rawDescData
+}
+
+var file_emissions_v1_submission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_emissions_v1_submission_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_emissions_v1_submission_proto_goTypes = []interface{}{
+	(SubmissionOutcomeCode)(0), // 0: emissions.v1.SubmissionOutcomeCode
+	(*SubmissionOutcome)(nil),  // 1: emissions.v1.SubmissionOutcome
+}
+var file_emissions_v1_submission_proto_depIdxs = []int32{
+	0, // 0: emissions.v1.SubmissionOutcome.code:type_name -> emissions.v1.SubmissionOutcomeCode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_emissions_v1_submission_proto_init() }
+func file_emissions_v1_submission_proto_init() {
+	if File_emissions_v1_submission_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_emissions_v1_submission_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_emissions_v1_submission_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_emissions_v1_submission_proto_goTypes,
+		DependencyIndexes: file_emissions_v1_submission_proto_depIdxs,
+		EnumInfos:         file_emissions_v1_submission_proto_enumTypes,
+		MessageInfos:      file_emissions_v1_submission_proto_msgTypes,
+	}.Build()
+	File_emissions_v1_submission_proto = out.File
+	file_emissions_v1_submission_proto_rawDesc = nil
+	file_emissions_v1_submission_proto_goTypes = nil
+	file_emissions_v1_submission_proto_depIdxs = nil
+}
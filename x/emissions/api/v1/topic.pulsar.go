@@ -15,23 +15,120 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Topic_17_list)(nil)
+
+type _Topic_17_list struct {
+	list *[]string
+}
+
+func (x *_Topic_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Topic_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_17_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field QuantileLevels as it is not of Message kind"))
+}
+
+func (x *_Topic_17_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_17_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Topic_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Topic_18_list)(nil)
+
+type _Topic_18_list struct {
+	list *[]string
+}
+
+func (x *_Topic_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Topic_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_18_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field CategoryLabels as it is not of Message kind"))
+}
+
+func (x *_Topic_18_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_18_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Topic_18_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Topic                  protoreflect.MessageDescriptor
-	fd_Topic_id               protoreflect.FieldDescriptor
-	fd_Topic_creator          protoreflect.FieldDescriptor
-	fd_Topic_metadata         protoreflect.FieldDescriptor
-	fd_Topic_loss_logic       protoreflect.FieldDescriptor
-	fd_Topic_loss_method      protoreflect.FieldDescriptor
-	fd_Topic_inference_logic  protoreflect.FieldDescriptor
-	fd_Topic_inference_method protoreflect.FieldDescriptor
-	fd_Topic_epoch_last_ended protoreflect.FieldDescriptor
-	fd_Topic_epoch_length     protoreflect.FieldDescriptor
-	fd_Topic_ground_truth_lag protoreflect.FieldDescriptor
-	fd_Topic_default_arg      protoreflect.FieldDescriptor
-	fd_Topic_p_norm           protoreflect.FieldDescriptor
-	fd_Topic_alpha_regret     protoreflect.FieldDescriptor
-	fd_Topic_allow_negative   protoreflect.FieldDescriptor
-	fd_Topic_epsilon          protoreflect.FieldDescriptor
+	md_Topic                           protoreflect.MessageDescriptor
+	fd_Topic_id                        protoreflect.FieldDescriptor
+	fd_Topic_creator                   protoreflect.FieldDescriptor
+	fd_Topic_metadata                  protoreflect.FieldDescriptor
+	fd_Topic_loss_logic                protoreflect.FieldDescriptor
+	fd_Topic_loss_method               protoreflect.FieldDescriptor
+	fd_Topic_inference_logic           protoreflect.FieldDescriptor
+	fd_Topic_inference_method          protoreflect.FieldDescriptor
+	fd_Topic_epoch_last_ended          protoreflect.FieldDescriptor
+	fd_Topic_epoch_length              protoreflect.FieldDescriptor
+	fd_Topic_ground_truth_lag          protoreflect.FieldDescriptor
+	fd_Topic_default_arg               protoreflect.FieldDescriptor
+	fd_Topic_p_norm                    protoreflect.FieldDescriptor
+	fd_Topic_alpha_regret              protoreflect.FieldDescriptor
+	fd_Topic_allow_negative            protoreflect.FieldDescriptor
+	fd_Topic_epsilon                   protoreflect.FieldDescriptor
+	fd_Topic_inference_dimension       protoreflect.FieldDescriptor
+	fd_Topic_quantile_levels           protoreflect.FieldDescriptor
+	fd_Topic_category_labels           protoreflect.FieldDescriptor
+	fd_Topic_aggregation_strategy      protoreflect.FieldDescriptor
+	fd_Topic_aggregation_trim_fraction protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -52,6 +149,11 @@ func init() {
 	fd_Topic_alpha_regret = md_Topic.Fields().ByName("alpha_regret")
 	fd_Topic_allow_negative = md_Topic.Fields().ByName("allow_negative")
 	fd_Topic_epsilon = md_Topic.Fields().ByName("epsilon")
+	fd_Topic_inference_dimension = md_Topic.Fields().ByName("inference_dimension")
+	fd_Topic_quantile_levels = md_Topic.Fields().ByName("quantile_levels")
+	fd_Topic_category_labels = md_Topic.Fields().ByName("category_labels")
+	fd_Topic_aggregation_strategy = md_Topic.Fields().ByName("aggregation_strategy")
+	fd_Topic_aggregation_trim_fraction = md_Topic.Fields().ByName("aggregation_trim_fraction")
 }
 
 var _ protoreflect.Message = (*fastReflection_Topic)(nil)
@@ -209,6 +311,36 @@ func (x *fastReflection_Topic) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.InferenceDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InferenceDimension)
+		if !f(fd_Topic_inference_dimension, value) {
+			return
+		}
+	}
+	if len(x.QuantileLevels) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_17_list{list: &x.QuantileLevels})
+		if !f(fd_Topic_quantile_levels, value) {
+			return
+		}
+	}
+	if len(x.CategoryLabels) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_18_list{list: &x.CategoryLabels})
+		if !f(fd_Topic_category_labels, value) {
+			return
+		}
+	}
+	if x.AggregationStrategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationStrategy))
+		if !f(fd_Topic_aggregation_strategy, value) {
+			return
+		}
+	}
+	if x.AggregationTrimFraction != "" {
+		value := protoreflect.ValueOfString(x.AggregationTrimFraction)
+		if !f(fd_Topic_aggregation_trim_fraction, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -254,6 +386,16 @@ func (x *fastReflection_Topic) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AllowNegative != false
 	case "emissions.v1.Topic.epsilon":
 		return x.Epsilon != ""
+	case "emissions.v1.Topic.inference_dimension":
+		return x.InferenceDimension != uint64(0)
+	case "emissions.v1.Topic.quantile_levels":
+		return len(x.QuantileLevels) != 0
+	case "emissions.v1.Topic.category_labels":
+		return len(x.CategoryLabels) != 0
+	case "emissions.v1.Topic.aggregation_strategy":
+		return x.AggregationStrategy != 0
+	case "emissions.v1.Topic.aggregation_trim_fraction":
+		return x.AggregationTrimFraction != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -300,6 +442,16 @@ func (x *fastReflection_Topic) Clear(fd protoreflect.FieldDescriptor) {
 		x.AllowNegative = false
 	case "emissions.v1.Topic.epsilon":
 		x.Epsilon = ""
+	case "emissions.v1.Topic.inference_dimension":
+		x.InferenceDimension = uint64(0)
+	case "emissions.v1.Topic.quantile_levels":
+		x.QuantileLevels = nil
+	case "emissions.v1.Topic.category_labels":
+		x.CategoryLabels = nil
+	case "emissions.v1.Topic.aggregation_strategy":
+		x.AggregationStrategy = 0
+	case "emissions.v1.Topic.aggregation_trim_fraction":
+		x.AggregationTrimFraction = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -361,6 +513,27 @@ func (x *fastReflection_Topic) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "emissions.v1.Topic.epsilon":
 		value := x.Epsilon
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.inference_dimension":
+		value := x.InferenceDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Topic.quantile_levels":
+		if len(x.QuantileLevels) == 0 {
+			return protoreflect.ValueOfList(&_Topic_17_list{})
+		}
+		listValue := &_Topic_17_list{list: &x.QuantileLevels}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.Topic.category_labels":
+		if len(x.CategoryLabels) == 0 {
+			return protoreflect.ValueOfList(&_Topic_18_list{})
+		}
+		listValue := &_Topic_18_list{list: &x.CategoryLabels}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.Topic.aggregation_strategy":
+		value := x.AggregationStrategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.aggregation_trim_fraction":
+		value := x.AggregationTrimFraction
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -411,6 +584,20 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 		x.AllowNegative = value.Bool()
 	case "emissions.v1.Topic.epsilon":
 		x.Epsilon = value.Interface().(string)
+	case "emissions.v1.Topic.inference_dimension":
+		x.InferenceDimension = value.Uint()
+	case "emissions.v1.Topic.quantile_levels":
+		lv := value.List()
+		clv := lv.(*_Topic_17_list)
+		x.QuantileLevels = *clv.list
+	case "emissions.v1.Topic.category_labels":
+		lv := value.List()
+		clv := lv.(*_Topic_18_list)
+		x.CategoryLabels = *clv.list
+	case "emissions.v1.Topic.aggregation_strategy":
+		x.AggregationStrategy = (AggregationStrategy)(value.Enum())
+	case "emissions.v1.Topic.aggregation_trim_fraction":
+		x.AggregationTrimFraction = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -431,6 +618,18 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.Topic.quantile_levels":
+		if x.QuantileLevels == nil {
+			x.QuantileLevels = []string{}
+		}
+		value := &_Topic_17_list{list: &x.QuantileLevels}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.Topic.category_labels":
+		if x.CategoryLabels == nil {
+			x.CategoryLabels = []string{}
+		}
+		value := &_Topic_18_list{list: &x.CategoryLabels}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.Topic.id":
 		panic(fmt.Errorf("field id of message emissions.v1.Topic is not mutable"))
 	case "emissions.v1.Topic.creator":
@@ -461,6 +660,12 @@ func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field allow_negative of message emissions.v1.Topic is not mutable"))
 	case "emissions.v1.Topic.epsilon":
 		panic(fmt.Errorf("field epsilon of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.inference_dimension":
+		panic(fmt.Errorf("field inference_dimension of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.aggregation_strategy":
+		panic(fmt.Errorf("field aggregation_strategy of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.aggregation_trim_fraction":
+		panic(fmt.Errorf("field aggregation_trim_fraction of message emissions.v1.Topic is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -504,6 +709,18 @@ func (x *fastReflection_Topic) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfBool(false)
 	case "emissions.v1.Topic.epsilon":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.inference_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Topic.quantile_levels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Topic_17_list{list: &list})
+	case "emissions.v1.Topic.category_labels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Topic_18_list{list: &list})
+	case "emissions.v1.Topic.aggregation_strategy":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.aggregation_trim_fraction":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -628,6 +845,28 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.InferenceDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.InferenceDimension))
+		}
+		if len(x.QuantileLevels) > 0 {
+			for _, s := range x.QuantileLevels {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CategoryLabels) > 0 {
+			for _, s := range x.CategoryLabels {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AggregationStrategy != 0 {
+			n += 2 + runtime.Sov(uint64(x.AggregationStrategy))
+		}
+		l = len(x.AggregationTrimFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -657,6 +896,51 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AggregationTrimFraction) > 0 {
+			i -= len(x.AggregationTrimFraction)
+			copy(dAtA[i:], x.AggregationTrimFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AggregationTrimFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.AggregationStrategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationStrategy))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.CategoryLabels) > 0 {
+			for iNdEx := len(x.CategoryLabels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CategoryLabels[iNdEx])
+				copy(dAtA[i:], x.CategoryLabels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CategoryLabels[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.QuantileLevels) > 0 {
+			for iNdEx := len(x.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.QuantileLevels[iNdEx])
+				copy(dAtA[i:], x.QuantileLevels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuantileLevels[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.InferenceDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InferenceDimension))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
 		if len(x.Epsilon) > 0 {
 			i -= len(x.Epsilon)
 			copy(dAtA[i:], x.Epsilon)
@@ -1222,6 +1506,140 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				}
 				x.Epsilon = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceDimension", wireType)
+				}
+				x.InferenceDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InferenceDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuantileLevels = append(x.QuantileLevels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CategoryLabels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CategoryLabels = append(x.CategoryLabels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
+				}
+				x.AggregationStrategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationStrategy |= AggregationStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationTrimFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AggregationTrimFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2298,39 +2716,3388 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v1/topic.proto
+var _ protoreflect.List = (*_TopicParamsOverrides_1_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _TopicParamsOverrides_1_list struct {
+	list *[]string
+}
 
-type Topic struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_TopicParamsOverrides_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator         string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata        string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossLogic       string `protobuf:"bytes,4,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
-	LossMethod      string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	InferenceLogic  string `protobuf:"bytes,6,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
-	InferenceMethod string `protobuf:"bytes,7,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
-	EpochLastEnded  int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
-	EpochLength     int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag  int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+func (x *_TopicParamsOverrides_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field PRewardInference as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_2_list)(nil)
+
+type _TopicParamsOverrides_2_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field PRewardForecast as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_3_list)(nil)
+
+type _TopicParamsOverrides_3_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field PRewardReputer as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_4_list)(nil)
+
+type _TopicParamsOverrides_4_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field CRewardInference as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_5_list)(nil)
+
+type _TopicParamsOverrides_5_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field CRewardForecast as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_6_list)(nil)
+
+type _TopicParamsOverrides_6_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field CNorm as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_7_list)(nil)
+
+type _TopicParamsOverrides_7_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParamsOverrides_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field MaxTopInferersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_7_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParamsOverrides_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_8_list)(nil)
+
+type _TopicParamsOverrides_8_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParamsOverrides_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field MaxTopForecastersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_8_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParamsOverrides_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_9_list)(nil)
+
+type _TopicParamsOverrides_9_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParamsOverrides_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field MaxTopReputersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_9_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParamsOverrides_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_10_list)(nil)
+
+type _TopicParamsOverrides_10_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field BetaEntropy as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_11_list)(nil)
+
+type _TopicParamsOverrides_11_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field LearningRate as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_12_list)(nil)
+
+type _TopicParamsOverrides_12_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field TaskRewardAlpha as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamsOverrides_13_list)(nil)
+
+type _TopicParamsOverrides_13_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamsOverrides_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamsOverrides_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamsOverrides_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamsOverrides_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamsOverrides_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamsOverrides at list field EpsilonReputer as it is not of Message kind"))
+}
+
+func (x *_TopicParamsOverrides_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamsOverrides_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamsOverrides_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TopicParamsOverrides                               protoreflect.MessageDescriptor
+	fd_TopicParamsOverrides_p_reward_inference            protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_p_reward_forecast             protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_p_reward_reputer              protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_c_reward_inference            protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_c_reward_forecast             protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_c_norm                        protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_max_top_inferers_to_reward    protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_max_top_forecasters_to_reward protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_max_top_reputers_to_reward    protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_beta_entropy                  protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_learning_rate                 protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_task_reward_alpha             protoreflect.FieldDescriptor
+	fd_TopicParamsOverrides_epsilon_reputer               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicParamsOverrides = File_emissions_v1_topic_proto.Messages().ByName("TopicParamsOverrides")
+	fd_TopicParamsOverrides_p_reward_inference = md_TopicParamsOverrides.Fields().ByName("p_reward_inference")
+	fd_TopicParamsOverrides_p_reward_forecast = md_TopicParamsOverrides.Fields().ByName("p_reward_forecast")
+	fd_TopicParamsOverrides_p_reward_reputer = md_TopicParamsOverrides.Fields().ByName("p_reward_reputer")
+	fd_TopicParamsOverrides_c_reward_inference = md_TopicParamsOverrides.Fields().ByName("c_reward_inference")
+	fd_TopicParamsOverrides_c_reward_forecast = md_TopicParamsOverrides.Fields().ByName("c_reward_forecast")
+	fd_TopicParamsOverrides_c_norm = md_TopicParamsOverrides.Fields().ByName("c_norm")
+	fd_TopicParamsOverrides_max_top_inferers_to_reward = md_TopicParamsOverrides.Fields().ByName("max_top_inferers_to_reward")
+	fd_TopicParamsOverrides_max_top_forecasters_to_reward = md_TopicParamsOverrides.Fields().ByName("max_top_forecasters_to_reward")
+	fd_TopicParamsOverrides_max_top_reputers_to_reward = md_TopicParamsOverrides.Fields().ByName("max_top_reputers_to_reward")
+	fd_TopicParamsOverrides_beta_entropy = md_TopicParamsOverrides.Fields().ByName("beta_entropy")
+	fd_TopicParamsOverrides_learning_rate = md_TopicParamsOverrides.Fields().ByName("learning_rate")
+	fd_TopicParamsOverrides_task_reward_alpha = md_TopicParamsOverrides.Fields().ByName("task_reward_alpha")
+	fd_TopicParamsOverrides_epsilon_reputer = md_TopicParamsOverrides.Fields().ByName("epsilon_reputer")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicParamsOverrides)(nil)
+
+type fastReflection_TopicParamsOverrides TopicParamsOverrides
+
+func (x *TopicParamsOverrides) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicParamsOverrides)(x)
+}
+
+func (x *TopicParamsOverrides) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicParamsOverrides_messageType fastReflection_TopicParamsOverrides_messageType
+var _ protoreflect.MessageType = fastReflection_TopicParamsOverrides_messageType{}
+
+type fastReflection_TopicParamsOverrides_messageType struct{}
+
+func (x fastReflection_TopicParamsOverrides_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicParamsOverrides)(nil)
+}
+func (x fastReflection_TopicParamsOverrides_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicParamsOverrides)
+}
+func (x fastReflection_TopicParamsOverrides_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicParamsOverrides
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicParamsOverrides) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicParamsOverrides
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicParamsOverrides) Type() protoreflect.MessageType {
+	return _fastReflection_TopicParamsOverrides_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicParamsOverrides) New() protoreflect.Message {
+	return new(fastReflection_TopicParamsOverrides)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicParamsOverrides) Interface() protoreflect.ProtoMessage {
+	return (*TopicParamsOverrides)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicParamsOverrides) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PRewardInference) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_1_list{list: &x.PRewardInference})
+		if !f(fd_TopicParamsOverrides_p_reward_inference, value) {
+			return
+		}
+	}
+	if len(x.PRewardForecast) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_2_list{list: &x.PRewardForecast})
+		if !f(fd_TopicParamsOverrides_p_reward_forecast, value) {
+			return
+		}
+	}
+	if len(x.PRewardReputer) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_3_list{list: &x.PRewardReputer})
+		if !f(fd_TopicParamsOverrides_p_reward_reputer, value) {
+			return
+		}
+	}
+	if len(x.CRewardInference) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_4_list{list: &x.CRewardInference})
+		if !f(fd_TopicParamsOverrides_c_reward_inference, value) {
+			return
+		}
+	}
+	if len(x.CRewardForecast) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_5_list{list: &x.CRewardForecast})
+		if !f(fd_TopicParamsOverrides_c_reward_forecast, value) {
+			return
+		}
+	}
+	if len(x.CNorm) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_6_list{list: &x.CNorm})
+		if !f(fd_TopicParamsOverrides_c_norm, value) {
+			return
+		}
+	}
+	if len(x.MaxTopInferersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_7_list{list: &x.MaxTopInferersToReward})
+		if !f(fd_TopicParamsOverrides_max_top_inferers_to_reward, value) {
+			return
+		}
+	}
+	if len(x.MaxTopForecastersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_8_list{list: &x.MaxTopForecastersToReward})
+		if !f(fd_TopicParamsOverrides_max_top_forecasters_to_reward, value) {
+			return
+		}
+	}
+	if len(x.MaxTopReputersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_9_list{list: &x.MaxTopReputersToReward})
+		if !f(fd_TopicParamsOverrides_max_top_reputers_to_reward, value) {
+			return
+		}
+	}
+	if len(x.BetaEntropy) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_10_list{list: &x.BetaEntropy})
+		if !f(fd_TopicParamsOverrides_beta_entropy, value) {
+			return
+		}
+	}
+	if len(x.LearningRate) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_11_list{list: &x.LearningRate})
+		if !f(fd_TopicParamsOverrides_learning_rate, value) {
+			return
+		}
+	}
+	if len(x.TaskRewardAlpha) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_12_list{list: &x.TaskRewardAlpha})
+		if !f(fd_TopicParamsOverrides_task_reward_alpha, value) {
+			return
+		}
+	}
+	if len(x.EpsilonReputer) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamsOverrides_13_list{list: &x.EpsilonReputer})
+		if !f(fd_TopicParamsOverrides_epsilon_reputer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicParamsOverrides) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamsOverrides.p_reward_inference":
+		return len(x.PRewardInference) != 0
+	case "emissions.v1.TopicParamsOverrides.p_reward_forecast":
+		return len(x.PRewardForecast) != 0
+	case "emissions.v1.TopicParamsOverrides.p_reward_reputer":
+		return len(x.PRewardReputer) != 0
+	case "emissions.v1.TopicParamsOverrides.c_reward_inference":
+		return len(x.CRewardInference) != 0
+	case "emissions.v1.TopicParamsOverrides.c_reward_forecast":
+		return len(x.CRewardForecast) != 0
+	case "emissions.v1.TopicParamsOverrides.c_norm":
+		return len(x.CNorm) != 0
+	case "emissions.v1.TopicParamsOverrides.max_top_inferers_to_reward":
+		return len(x.MaxTopInferersToReward) != 0
+	case "emissions.v1.TopicParamsOverrides.max_top_forecasters_to_reward":
+		return len(x.MaxTopForecastersToReward) != 0
+	case "emissions.v1.TopicParamsOverrides.max_top_reputers_to_reward":
+		return len(x.MaxTopReputersToReward) != 0
+	case "emissions.v1.TopicParamsOverrides.beta_entropy":
+		return len(x.BetaEntropy) != 0
+	case "emissions.v1.TopicParamsOverrides.learning_rate":
+		return len(x.LearningRate) != 0
+	case "emissions.v1.TopicParamsOverrides.task_reward_alpha":
+		return len(x.TaskRewardAlpha) != 0
+	case "emissions.v1.TopicParamsOverrides.epsilon_reputer":
+		return len(x.EpsilonReputer) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamsOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamsOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamsOverrides) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamsOverrides.p_reward_inference":
+		x.PRewardInference = nil
+	case "emissions.v1.TopicParamsOverrides.p_reward_forecast":
+		x.PRewardForecast = nil
+	case "emissions.v1.TopicParamsOverrides.p_reward_reputer":
+		x.PRewardReputer = nil
+	case "emissions.v1.TopicParamsOverrides.c_reward_inference":
+		x.CRewardInference = nil
+	case "emissions.v1.TopicParamsOverrides.c_reward_forecast":
+		x.CRewardForecast = nil
+	case "emissions.v1.TopicParamsOverrides.c_norm":
+		x.CNorm = nil
+	case "emissions.v1.TopicParamsOverrides.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = nil
+	case "emissions.v1.TopicParamsOverrides.max_top_forecasters_to_reward":
+		x.MaxTopForecastersToReward = nil
+	case "emissions.v1.TopicParamsOverrides.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = nil
+	case "emissions.v1.TopicParamsOverrides.beta_entropy":
+		x.BetaEntropy = nil
+	case "emissions.v1.TopicParamsOverrides.learning_rate":
+		x.LearningRate = nil
+	case "emissions.v1.TopicParamsOverrides.task_reward_alpha":
+		x.TaskRewardAlpha = nil
+	case "emissions.v1.TopicParamsOverrides.epsilon_reputer":
+		x.EpsilonReputer = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamsOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamsOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicParamsOverrides) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicParamsOverrides.p_reward_inference":
+		if len(x.PRewardInference) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_1_list{})
+		}
+		listValue := &_TopicParamsOverrides_1_list{list: &x.PRewardInference}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.p_reward_forecast":
+		if len(x.PRewardForecast) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_2_list{})
+		}
+		listValue := &_TopicParamsOverrides_2_list{list: &x.PRewardForecast}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.p_reward_reputer":
+		if len(x.PRewardReputer) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_3_list{})
+		}
+		listValue := &_TopicParamsOverrides_3_list{list: &x.PRewardReputer}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.c_reward_inference":
+		if len(x.CRewardInference) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_4_list{})
+		}
+		listValue := &_TopicParamsOverrides_4_list{list: &x.CRewardInference}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.c_reward_forecast":
+		if len(x.CRewardForecast) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_5_list{})
+		}
+		listValue := &_TopicParamsOverrides_5_list{list: &x.CRewardForecast}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.c_norm":
+		if len(x.CNorm) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_6_list{})
+		}
+		listValue := &_TopicParamsOverrides_6_list{list: &x.CNorm}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.max_top_inferers_to_reward":
+		if len(x.MaxTopInferersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_7_list{})
+		}
+		listValue := &_TopicParamsOverrides_7_list{list: &x.MaxTopInferersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.max_top_forecasters_to_reward":
+		if len(x.MaxTopForecastersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_8_list{})
+		}
+		listValue := &_TopicParamsOverrides_8_list{list: &x.MaxTopForecastersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.max_top_reputers_to_reward":
+		if len(x.MaxTopReputersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_9_list{})
+		}
+		listValue := &_TopicParamsOverrides_9_list{list: &x.MaxTopReputersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.beta_entropy":
+		if len(x.BetaEntropy) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_10_list{})
+		}
+		listValue := &_TopicParamsOverrides_10_list{list: &x.BetaEntropy}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.learning_rate":
+		if len(x.LearningRate) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_11_list{})
+		}
+		listValue := &_TopicParamsOverrides_11_list{list: &x.LearningRate}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.task_reward_alpha":
+		if len(x.TaskRewardAlpha) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_12_list{})
+		}
+		listValue := &_TopicParamsOverrides_12_list{list: &x.TaskRewardAlpha}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamsOverrides.epsilon_reputer":
+		if len(x.EpsilonReputer) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamsOverrides_13_list{})
+		}
+		listValue := &_TopicParamsOverrides_13_list{list: &x.EpsilonReputer}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamsOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamsOverrides does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamsOverrides) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamsOverrides.p_reward_inference":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_1_list)
+		x.PRewardInference = *clv.list
+	case "emissions.v1.TopicParamsOverrides.p_reward_forecast":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_2_list)
+		x.PRewardForecast = *clv.list
+	case "emissions.v1.TopicParamsOverrides.p_reward_reputer":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_3_list)
+		x.PRewardReputer = *clv.list
+	case "emissions.v1.TopicParamsOverrides.c_reward_inference":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_4_list)
+		x.CRewardInference = *clv.list
+	case "emissions.v1.TopicParamsOverrides.c_reward_forecast":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_5_list)
+		x.CRewardForecast = *clv.list
+	case "emissions.v1.TopicParamsOverrides.c_norm":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_6_list)
+		x.CNorm = *clv.list
+	case "emissions.v1.TopicParamsOverrides.max_top_inferers_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_7_list)
+		x.MaxTopInferersToReward = *clv.list
+	case "emissions.v1.TopicParamsOverrides.max_top_forecasters_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_8_list)
+		x.MaxTopForecastersToReward = *clv.list
+	case "emissions.v1.TopicParamsOverrides.max_top_reputers_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_9_list)
+		x.MaxTopReputersToReward = *clv.list
+	case "emissions.v1.TopicParamsOverrides.beta_entropy":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_10_list)
+		x.BetaEntropy = *clv.list
+	case "emissions.v1.TopicParamsOverrides.learning_rate":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_11_list)
+		x.LearningRate = *clv.list
+	case "emissions.v1.TopicParamsOverrides.task_reward_alpha":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_12_list)
+		x.TaskRewardAlpha = *clv.list
+	case "emissions.v1.TopicParamsOverrides.epsilon_reputer":
+		lv := value.List()
+		clv := lv.(*_TopicParamsOverrides_13_list)
+		x.EpsilonReputer = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamsOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamsOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamsOverrides) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamsOverrides.p_reward_inference":
+		if x.PRewardInference == nil {
+			x.PRewardInference = []string{}
+		}
+		value := &_TopicParamsOverrides_1_list{list: &x.PRewardInference}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.p_reward_forecast":
+		if x.PRewardForecast == nil {
+			x.PRewardForecast = []string{}
+		}
+		value := &_TopicParamsOverrides_2_list{list: &x.PRewardForecast}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.p_reward_reputer":
+		if x.PRewardReputer == nil {
+			x.PRewardReputer = []string{}
+		}
+		value := &_TopicParamsOverrides_3_list{list: &x.PRewardReputer}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.c_reward_inference":
+		if x.CRewardInference == nil {
+			x.CRewardInference = []string{}
+		}
+		value := &_TopicParamsOverrides_4_list{list: &x.CRewardInference}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.c_reward_forecast":
+		if x.CRewardForecast == nil {
+			x.CRewardForecast = []string{}
+		}
+		value := &_TopicParamsOverrides_5_list{list: &x.CRewardForecast}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.c_norm":
+		if x.CNorm == nil {
+			x.CNorm = []string{}
+		}
+		value := &_TopicParamsOverrides_6_list{list: &x.CNorm}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.max_top_inferers_to_reward":
+		if x.MaxTopInferersToReward == nil {
+			x.MaxTopInferersToReward = []uint64{}
+		}
+		value := &_TopicParamsOverrides_7_list{list: &x.MaxTopInferersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.max_top_forecasters_to_reward":
+		if x.MaxTopForecastersToReward == nil {
+			x.MaxTopForecastersToReward = []uint64{}
+		}
+		value := &_TopicParamsOverrides_8_list{list: &x.MaxTopForecastersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.max_top_reputers_to_reward":
+		if x.MaxTopReputersToReward == nil {
+			x.MaxTopReputersToReward = []uint64{}
+		}
+		value := &_TopicParamsOverrides_9_list{list: &x.MaxTopReputersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.beta_entropy":
+		if x.BetaEntropy == nil {
+			x.BetaEntropy = []string{}
+		}
+		value := &_TopicParamsOverrides_10_list{list: &x.BetaEntropy}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.learning_rate":
+		if x.LearningRate == nil {
+			x.LearningRate = []string{}
+		}
+		value := &_TopicParamsOverrides_11_list{list: &x.LearningRate}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.task_reward_alpha":
+		if x.TaskRewardAlpha == nil {
+			x.TaskRewardAlpha = []string{}
+		}
+		value := &_TopicParamsOverrides_12_list{list: &x.TaskRewardAlpha}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamsOverrides.epsilon_reputer":
+		if x.EpsilonReputer == nil {
+			x.EpsilonReputer = []string{}
+		}
+		value := &_TopicParamsOverrides_13_list{list: &x.EpsilonReputer}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamsOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamsOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicParamsOverrides) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamsOverrides.p_reward_inference":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_1_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.p_reward_forecast":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_2_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.p_reward_reputer":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_3_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.c_reward_inference":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_4_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.c_reward_forecast":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_5_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.c_norm":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_6_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.max_top_inferers_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_7_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.max_top_forecasters_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_8_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.max_top_reputers_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_9_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.beta_entropy":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_10_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.learning_rate":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_11_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.task_reward_alpha":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_12_list{list: &list})
+	case "emissions.v1.TopicParamsOverrides.epsilon_reputer":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamsOverrides_13_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamsOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamsOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicParamsOverrides) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicParamsOverrides", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicParamsOverrides) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamsOverrides) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicParamsOverrides) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicParamsOverrides) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicParamsOverrides)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PRewardInference) > 0 {
+			for _, s := range x.PRewardInference {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PRewardForecast) > 0 {
+			for _, s := range x.PRewardForecast {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PRewardReputer) > 0 {
+			for _, s := range x.PRewardReputer {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CRewardInference) > 0 {
+			for _, s := range x.CRewardInference {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CRewardForecast) > 0 {
+			for _, s := range x.CRewardForecast {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CNorm) > 0 {
+			for _, s := range x.CNorm {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MaxTopInferersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopInferersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.MaxTopForecastersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopForecastersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.MaxTopReputersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopReputersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.BetaEntropy) > 0 {
+			for _, s := range x.BetaEntropy {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LearningRate) > 0 {
+			for _, s := range x.LearningRate {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TaskRewardAlpha) > 0 {
+			for _, s := range x.TaskRewardAlpha {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EpsilonReputer) > 0 {
+			for _, s := range x.EpsilonReputer {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicParamsOverrides)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EpsilonReputer) > 0 {
+			for iNdEx := len(x.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EpsilonReputer[iNdEx])
+				copy(dAtA[i:], x.EpsilonReputer[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EpsilonReputer[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.TaskRewardAlpha) > 0 {
+			for iNdEx := len(x.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TaskRewardAlpha[iNdEx])
+				copy(dAtA[i:], x.TaskRewardAlpha[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskRewardAlpha[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.LearningRate) > 0 {
+			for iNdEx := len(x.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.LearningRate[iNdEx])
+				copy(dAtA[i:], x.LearningRate[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LearningRate[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.BetaEntropy) > 0 {
+			for iNdEx := len(x.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BetaEntropy[iNdEx])
+				copy(dAtA[i:], x.BetaEntropy[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BetaEntropy[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.MaxTopReputersToReward) > 0 {
+			var pksize2 int
+			for _, num := range x.MaxTopReputersToReward {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.MaxTopReputersToReward {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.MaxTopForecastersToReward) > 0 {
+			var pksize4 int
+			for _, num := range x.MaxTopForecastersToReward {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num := range x.MaxTopForecastersToReward {
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MaxTopInferersToReward) > 0 {
+			var pksize6 int
+			for _, num := range x.MaxTopInferersToReward {
+				pksize6 += runtime.Sov(uint64(num))
+			}
+			i -= pksize6
+			j5 := i
+			for _, num := range x.MaxTopInferersToReward {
+				for num >= 1<<7 {
+					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j5++
+				}
+				dAtA[j5] = uint8(num)
+				j5++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.CNorm) > 0 {
+			for iNdEx := len(x.CNorm) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CNorm[iNdEx])
+				copy(dAtA[i:], x.CNorm[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CNorm[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.CRewardForecast) > 0 {
+			for iNdEx := len(x.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CRewardForecast[iNdEx])
+				copy(dAtA[i:], x.CRewardForecast[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CRewardForecast[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CRewardInference) > 0 {
+			for iNdEx := len(x.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CRewardInference[iNdEx])
+				copy(dAtA[i:], x.CRewardInference[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CRewardInference[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PRewardReputer) > 0 {
+			for iNdEx := len(x.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PRewardReputer[iNdEx])
+				copy(dAtA[i:], x.PRewardReputer[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardReputer[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.PRewardForecast) > 0 {
+			for iNdEx := len(x.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PRewardForecast[iNdEx])
+				copy(dAtA[i:], x.PRewardForecast[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardForecast[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.PRewardInference) > 0 {
+			for iNdEx := len(x.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PRewardInference[iNdEx])
+				copy(dAtA[i:], x.PRewardInference[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardInference[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicParamsOverrides)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicParamsOverrides: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicParamsOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardInference = append(x.PRewardInference, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardForecast = append(x.PRewardForecast, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardReputer = append(x.PRewardReputer, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CRewardInference = append(x.CRewardInference, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CRewardForecast = append(x.CRewardForecast, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CNorm = append(x.CNorm, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopInferersToReward = append(x.MaxTopInferersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopInferersToReward) == 0 {
+						x.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopInferersToReward = append(x.MaxTopInferersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+				}
+			case 8:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopForecastersToReward = append(x.MaxTopForecastersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopForecastersToReward) == 0 {
+						x.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopForecastersToReward = append(x.MaxTopForecastersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+				}
+			case 9:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopReputersToReward = append(x.MaxTopReputersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopReputersToReward) == 0 {
+						x.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopReputersToReward = append(x.MaxTopReputersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BetaEntropy = append(x.BetaEntropy, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LearningRate = append(x.LearningRate, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskRewardAlpha = append(x.TaskRewardAlpha, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpsilonReputer = append(x.EpsilonReputer, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EffectiveTopicParams                               protoreflect.MessageDescriptor
+	fd_EffectiveTopicParams_p_reward_inference            protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_p_reward_forecast             protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_p_reward_reputer              protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_c_reward_inference            protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_c_reward_forecast             protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_c_norm                        protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_max_top_inferers_to_reward    protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_max_top_forecasters_to_reward protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_max_top_reputers_to_reward    protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_beta_entropy                  protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_learning_rate                 protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_task_reward_alpha             protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_epsilon_reputer               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_EffectiveTopicParams = File_emissions_v1_topic_proto.Messages().ByName("EffectiveTopicParams")
+	fd_EffectiveTopicParams_p_reward_inference = md_EffectiveTopicParams.Fields().ByName("p_reward_inference")
+	fd_EffectiveTopicParams_p_reward_forecast = md_EffectiveTopicParams.Fields().ByName("p_reward_forecast")
+	fd_EffectiveTopicParams_p_reward_reputer = md_EffectiveTopicParams.Fields().ByName("p_reward_reputer")
+	fd_EffectiveTopicParams_c_reward_inference = md_EffectiveTopicParams.Fields().ByName("c_reward_inference")
+	fd_EffectiveTopicParams_c_reward_forecast = md_EffectiveTopicParams.Fields().ByName("c_reward_forecast")
+	fd_EffectiveTopicParams_c_norm = md_EffectiveTopicParams.Fields().ByName("c_norm")
+	fd_EffectiveTopicParams_max_top_inferers_to_reward = md_EffectiveTopicParams.Fields().ByName("max_top_inferers_to_reward")
+	fd_EffectiveTopicParams_max_top_forecasters_to_reward = md_EffectiveTopicParams.Fields().ByName("max_top_forecasters_to_reward")
+	fd_EffectiveTopicParams_max_top_reputers_to_reward = md_EffectiveTopicParams.Fields().ByName("max_top_reputers_to_reward")
+	fd_EffectiveTopicParams_beta_entropy = md_EffectiveTopicParams.Fields().ByName("beta_entropy")
+	fd_EffectiveTopicParams_learning_rate = md_EffectiveTopicParams.Fields().ByName("learning_rate")
+	fd_EffectiveTopicParams_task_reward_alpha = md_EffectiveTopicParams.Fields().ByName("task_reward_alpha")
+	fd_EffectiveTopicParams_epsilon_reputer = md_EffectiveTopicParams.Fields().ByName("epsilon_reputer")
+}
+
+var _ protoreflect.Message = (*fastReflection_EffectiveTopicParams)(nil)
+
+type fastReflection_EffectiveTopicParams EffectiveTopicParams
+
+func (x *EffectiveTopicParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EffectiveTopicParams)(x)
+}
+
+func (x *EffectiveTopicParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EffectiveTopicParams_messageType fastReflection_EffectiveTopicParams_messageType
+var _ protoreflect.MessageType = fastReflection_EffectiveTopicParams_messageType{}
+
+type fastReflection_EffectiveTopicParams_messageType struct{}
+
+func (x fastReflection_EffectiveTopicParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EffectiveTopicParams)(nil)
+}
+func (x fastReflection_EffectiveTopicParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_EffectiveTopicParams)
+}
+func (x fastReflection_EffectiveTopicParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EffectiveTopicParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EffectiveTopicParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_EffectiveTopicParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EffectiveTopicParams) Type() protoreflect.MessageType {
+	return _fastReflection_EffectiveTopicParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EffectiveTopicParams) New() protoreflect.Message {
+	return new(fastReflection_EffectiveTopicParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EffectiveTopicParams) Interface() protoreflect.ProtoMessage {
+	return (*EffectiveTopicParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EffectiveTopicParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PRewardInference != "" {
+		value := protoreflect.ValueOfString(x.PRewardInference)
+		if !f(fd_EffectiveTopicParams_p_reward_inference, value) {
+			return
+		}
+	}
+	if x.PRewardForecast != "" {
+		value := protoreflect.ValueOfString(x.PRewardForecast)
+		if !f(fd_EffectiveTopicParams_p_reward_forecast, value) {
+			return
+		}
+	}
+	if x.PRewardReputer != "" {
+		value := protoreflect.ValueOfString(x.PRewardReputer)
+		if !f(fd_EffectiveTopicParams_p_reward_reputer, value) {
+			return
+		}
+	}
+	if x.CRewardInference != "" {
+		value := protoreflect.ValueOfString(x.CRewardInference)
+		if !f(fd_EffectiveTopicParams_c_reward_inference, value) {
+			return
+		}
+	}
+	if x.CRewardForecast != "" {
+		value := protoreflect.ValueOfString(x.CRewardForecast)
+		if !f(fd_EffectiveTopicParams_c_reward_forecast, value) {
+			return
+		}
+	}
+	if x.CNorm != "" {
+		value := protoreflect.ValueOfString(x.CNorm)
+		if !f(fd_EffectiveTopicParams_c_norm, value) {
+			return
+		}
+	}
+	if x.MaxTopInferersToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopInferersToReward)
+		if !f(fd_EffectiveTopicParams_max_top_inferers_to_reward, value) {
+			return
+		}
+	}
+	if x.MaxTopForecastersToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopForecastersToReward)
+		if !f(fd_EffectiveTopicParams_max_top_forecasters_to_reward, value) {
+			return
+		}
+	}
+	if x.MaxTopReputersToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopReputersToReward)
+		if !f(fd_EffectiveTopicParams_max_top_reputers_to_reward, value) {
+			return
+		}
+	}
+	if x.BetaEntropy != "" {
+		value := protoreflect.ValueOfString(x.BetaEntropy)
+		if !f(fd_EffectiveTopicParams_beta_entropy, value) {
+			return
+		}
+	}
+	if x.LearningRate != "" {
+		value := protoreflect.ValueOfString(x.LearningRate)
+		if !f(fd_EffectiveTopicParams_learning_rate, value) {
+			return
+		}
+	}
+	if x.TaskRewardAlpha != "" {
+		value := protoreflect.ValueOfString(x.TaskRewardAlpha)
+		if !f(fd_EffectiveTopicParams_task_reward_alpha, value) {
+			return
+		}
+	}
+	if x.EpsilonReputer != "" {
+		value := protoreflect.ValueOfString(x.EpsilonReputer)
+		if !f(fd_EffectiveTopicParams_epsilon_reputer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EffectiveTopicParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.p_reward_inference":
+		return x.PRewardInference != ""
+	case "emissions.v1.EffectiveTopicParams.p_reward_forecast":
+		return x.PRewardForecast != ""
+	case "emissions.v1.EffectiveTopicParams.p_reward_reputer":
+		return x.PRewardReputer != ""
+	case "emissions.v1.EffectiveTopicParams.c_reward_inference":
+		return x.CRewardInference != ""
+	case "emissions.v1.EffectiveTopicParams.c_reward_forecast":
+		return x.CRewardForecast != ""
+	case "emissions.v1.EffectiveTopicParams.c_norm":
+		return x.CNorm != ""
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		return x.MaxTopInferersToReward != uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		return x.MaxTopForecastersToReward != uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		return x.MaxTopReputersToReward != uint64(0)
+	case "emissions.v1.EffectiveTopicParams.beta_entropy":
+		return x.BetaEntropy != ""
+	case "emissions.v1.EffectiveTopicParams.learning_rate":
+		return x.LearningRate != ""
+	case "emissions.v1.EffectiveTopicParams.task_reward_alpha":
+		return x.TaskRewardAlpha != ""
+	case "emissions.v1.EffectiveTopicParams.epsilon_reputer":
+		return x.EpsilonReputer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.p_reward_inference":
+		x.PRewardInference = ""
+	case "emissions.v1.EffectiveTopicParams.p_reward_forecast":
+		x.PRewardForecast = ""
+	case "emissions.v1.EffectiveTopicParams.p_reward_reputer":
+		x.PRewardReputer = ""
+	case "emissions.v1.EffectiveTopicParams.c_reward_inference":
+		x.CRewardInference = ""
+	case "emissions.v1.EffectiveTopicParams.c_reward_forecast":
+		x.CRewardForecast = ""
+	case "emissions.v1.EffectiveTopicParams.c_norm":
+		x.CNorm = ""
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		x.MaxTopForecastersToReward = uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = uint64(0)
+	case "emissions.v1.EffectiveTopicParams.beta_entropy":
+		x.BetaEntropy = ""
+	case "emissions.v1.EffectiveTopicParams.learning_rate":
+		x.LearningRate = ""
+	case "emissions.v1.EffectiveTopicParams.task_reward_alpha":
+		x.TaskRewardAlpha = ""
+	case "emissions.v1.EffectiveTopicParams.epsilon_reputer":
+		x.EpsilonReputer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EffectiveTopicParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EffectiveTopicParams.p_reward_inference":
+		value := x.PRewardInference
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.p_reward_forecast":
+		value := x.PRewardForecast
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.p_reward_reputer":
+		value := x.PRewardReputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.c_reward_inference":
+		value := x.CRewardInference
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.c_reward_forecast":
+		value := x.CRewardForecast
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.c_norm":
+		value := x.CNorm
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		value := x.MaxTopInferersToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		value := x.MaxTopForecastersToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		value := x.MaxTopReputersToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EffectiveTopicParams.beta_entropy":
+		value := x.BetaEntropy
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.learning_rate":
+		value := x.LearningRate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.task_reward_alpha":
+		value := x.TaskRewardAlpha
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.epsilon_reputer":
+		value := x.EpsilonReputer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.p_reward_inference":
+		x.PRewardInference = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.p_reward_forecast":
+		x.PRewardForecast = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.p_reward_reputer":
+		x.PRewardReputer = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.c_reward_inference":
+		x.CRewardInference = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.c_reward_forecast":
+		x.CRewardForecast = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.c_norm":
+		x.CNorm = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = value.Uint()
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		x.MaxTopForecastersToReward = value.Uint()
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = value.Uint()
+	case "emissions.v1.EffectiveTopicParams.beta_entropy":
+		x.BetaEntropy = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.learning_rate":
+		x.LearningRate = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.task_reward_alpha":
+		x.TaskRewardAlpha = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.epsilon_reputer":
+		x.EpsilonReputer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.p_reward_inference":
+		panic(fmt.Errorf("field p_reward_inference of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.p_reward_forecast":
+		panic(fmt.Errorf("field p_reward_forecast of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.p_reward_reputer":
+		panic(fmt.Errorf("field p_reward_reputer of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.c_reward_inference":
+		panic(fmt.Errorf("field c_reward_inference of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.c_reward_forecast":
+		panic(fmt.Errorf("field c_reward_forecast of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.c_norm":
+		panic(fmt.Errorf("field c_norm of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		panic(fmt.Errorf("field max_top_inferers_to_reward of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		panic(fmt.Errorf("field max_top_forecasters_to_reward of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		panic(fmt.Errorf("field max_top_reputers_to_reward of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.beta_entropy":
+		panic(fmt.Errorf("field beta_entropy of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.learning_rate":
+		panic(fmt.Errorf("field learning_rate of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.task_reward_alpha":
+		panic(fmt.Errorf("field task_reward_alpha of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.epsilon_reputer":
+		panic(fmt.Errorf("field epsilon_reputer of message emissions.v1.EffectiveTopicParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EffectiveTopicParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.p_reward_inference":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.p_reward_forecast":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.p_reward_reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.c_reward_inference":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.c_reward_forecast":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.c_norm":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EffectiveTopicParams.beta_entropy":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.learning_rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.task_reward_alpha":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.epsilon_reputer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EffectiveTopicParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EffectiveTopicParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EffectiveTopicParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EffectiveTopicParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EffectiveTopicParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EffectiveTopicParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PRewardInference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PRewardForecast)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PRewardReputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CRewardInference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CRewardForecast)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CNorm)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTopInferersToReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTopInferersToReward))
+		}
+		if x.MaxTopForecastersToReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTopForecastersToReward))
+		}
+		if x.MaxTopReputersToReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTopReputersToReward))
+		}
+		l = len(x.BetaEntropy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskRewardAlpha)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EpsilonReputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EffectiveTopicParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EpsilonReputer) > 0 {
+			i -= len(x.EpsilonReputer)
+			copy(dAtA[i:], x.EpsilonReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EpsilonReputer)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.TaskRewardAlpha) > 0 {
+			i -= len(x.TaskRewardAlpha)
+			copy(dAtA[i:], x.TaskRewardAlpha)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskRewardAlpha)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.LearningRate) > 0 {
+			i -= len(x.LearningRate)
+			copy(dAtA[i:], x.LearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LearningRate)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.BetaEntropy) > 0 {
+			i -= len(x.BetaEntropy)
+			copy(dAtA[i:], x.BetaEntropy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BetaEntropy)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.MaxTopReputersToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopReputersToReward))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxTopForecastersToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopForecastersToReward))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxTopInferersToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopInferersToReward))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.CNorm) > 0 {
+			i -= len(x.CNorm)
+			copy(dAtA[i:], x.CNorm)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CNorm)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.CRewardForecast) > 0 {
+			i -= len(x.CRewardForecast)
+			copy(dAtA[i:], x.CRewardForecast)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CRewardForecast)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.CRewardInference) > 0 {
+			i -= len(x.CRewardInference)
+			copy(dAtA[i:], x.CRewardInference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CRewardInference)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PRewardReputer) > 0 {
+			i -= len(x.PRewardReputer)
+			copy(dAtA[i:], x.PRewardReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardReputer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PRewardForecast) > 0 {
+			i -= len(x.PRewardForecast)
+			copy(dAtA[i:], x.PRewardForecast)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardForecast)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PRewardInference) > 0 {
+			i -= len(x.PRewardInference)
+			copy(dAtA[i:], x.PRewardInference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardInference)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EffectiveTopicParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EffectiveTopicParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EffectiveTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardInference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardForecast = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CRewardInference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CRewardForecast = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CNorm = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+				}
+				x.MaxTopInferersToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopInferersToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+				}
+				x.MaxTopForecastersToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopForecastersToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+				}
+				x.MaxTopReputersToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopReputersToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BetaEntropy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskRewardAlpha = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpsilonReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/topic.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How the network combines the inferences of a topic, given the regret-informed weights of
+// its workers
+type AggregationStrategy int32
+
+const (
+	// Σ_l w_l I_l / Σ_l w_l
+	AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN AggregationStrategy = 0
+	// The inference at which the cumulative weight reaches half of the total weight
+	AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEDIAN AggregationStrategy = 1
+	// Weighted mean once the trim fraction of the total weight is cut off each tail
+	AggregationStrategy_AGGREGATION_TRIMMED_MEAN AggregationStrategy = 2
+	// Weighted mean once the inferences in either tail of the trim fraction of the total weight
+	// are clamped to the edge of that tail
+	AggregationStrategy_AGGREGATION_WINSORIZED_MEAN AggregationStrategy = 3
+)
+
+// Enum value maps for AggregationStrategy.
+var (
+	AggregationStrategy_name = map[int32]string{
+		0: "AGGREGATION_REGRET_WEIGHTED_MEAN",
+		1: "AGGREGATION_REGRET_WEIGHTED_MEDIAN",
+		2: "AGGREGATION_TRIMMED_MEAN",
+		3: "AGGREGATION_WINSORIZED_MEAN",
+	}
+	AggregationStrategy_value = map[string]int32{
+		"AGGREGATION_REGRET_WEIGHTED_MEAN":   0,
+		"AGGREGATION_REGRET_WEIGHTED_MEDIAN": 1,
+		"AGGREGATION_TRIMMED_MEAN":           2,
+		"AGGREGATION_WINSORIZED_MEAN":        3,
+	}
+)
+
+func (x AggregationStrategy) Enum() *AggregationStrategy {
+	p := new(AggregationStrategy)
+	*p = x
+	return p
+}
+
+func (x AggregationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[0].Descriptor()
+}
+
+func (AggregationStrategy) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[0]
+}
+
+func (x AggregationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationStrategy.Descriptor instead.
+func (AggregationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{0}
+}
+
+type Topic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator         string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata        string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossLogic       string `protobuf:"bytes,4,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
+	LossMethod      string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	InferenceLogic  string `protobuf:"bytes,6,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
+	InferenceMethod string `protobuf:"bytes,7,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
+	EpochLastEnded  int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
+	EpochLength     int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag  int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
 	DefaultArg      string `protobuf:"bytes,11,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
 	PNorm           string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
 	AlphaRegret     string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
 	AllowNegative   bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
 	Epsilon         string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	// Length of the inferences of a vector topic, 0 for a topic of scalar inferences.
+	// Fixed at topic creation.
+	InferenceDimension uint64 `protobuf:"varint,16,opt,name=inference_dimension,json=inferenceDimension,proto3" json:"inference_dimension,omitempty"`
+	// Quantile levels, strictly increasing in (0, 1), of a topic whose inferences are quantiles
+	// of a distribution, e.g. 0.05, 0.5, 0.95. The inferences of such a topic carry one value per
+	// level, so its inference dimension is the number of levels.
+	QuantileLevels []string `protobuf:"bytes,17,rep,name=quantile_levels,json=quantileLevels,proto3" json:"quantile_levels,omitempty"`
+	// Labels of the outcomes of a topic predicting one of several discrete outcomes, e.g. the
+	// candidates of an election. The inferences of such a topic are probability vectors with one
+	// probability per label, so its inference dimension is the number of labels.
+	CategoryLabels      []string            `protobuf:"bytes,18,rep,name=category_labels,json=categoryLabels,proto3" json:"category_labels,omitempty"`
+	AggregationStrategy AggregationStrategy `protobuf:"varint,19,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=emissions.v1.AggregationStrategy" json:"aggregation_strategy,omitempty"`
+	// Fraction of the total weight in each tail, in (0, 0.5), for trimmed and winsorized means
+	AggregationTrimFraction string `protobuf:"bytes,20,opt,name=aggregation_trim_fraction,json=aggregationTrimFraction,proto3" json:"aggregation_trim_fraction,omitempty"`
 }
 
 func (x *Topic) Reset() {
@@ -2458,6 +6225,41 @@ func (x *Topic) GetEpsilon() string {
 	return ""
 }
 
+func (x *Topic) GetInferenceDimension() uint64 {
+	if x != nil {
+		return x.InferenceDimension
+	}
+	return 0
+}
+
+func (x *Topic) GetQuantileLevels() []string {
+	if x != nil {
+		return x.QuantileLevels
+	}
+	return nil
+}
+
+func (x *Topic) GetCategoryLabels() []string {
+	if x != nil {
+		return x.CategoryLabels
+	}
+	return nil
+}
+
+func (x *Topic) GetAggregationStrategy() AggregationStrategy {
+	if x != nil {
+		return x.AggregationStrategy
+	}
+	return AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN
+}
+
+func (x *Topic) GetAggregationTrimFraction() string {
+	if x != nil {
+		return x.AggregationTrimFraction
+	}
+	return ""
+}
+
 type TopicList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2544,6 +6346,274 @@ func (x *TimestampedActorNonce) GetNonce() *Nonce {
 	return nil
 }
 
+// Per topic overrides of a subset of the global params, used to tune topics with very
+// different worker counts or loss scales independently. Like OptionalParams, every
+// field is a repeated field with at most one element and an empty field means the
+// global param applies.
+type TopicParamsOverrides struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PRewardInference          []string `protobuf:"bytes,1,rep,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
+	PRewardForecast           []string `protobuf:"bytes,2,rep,name=p_reward_forecast,json=pRewardForecast,proto3" json:"p_reward_forecast,omitempty"`
+	PRewardReputer            []string `protobuf:"bytes,3,rep,name=p_reward_reputer,json=pRewardReputer,proto3" json:"p_reward_reputer,omitempty"`
+	CRewardInference          []string `protobuf:"bytes,4,rep,name=c_reward_inference,json=cRewardInference,proto3" json:"c_reward_inference,omitempty"`
+	CRewardForecast           []string `protobuf:"bytes,5,rep,name=c_reward_forecast,json=cRewardForecast,proto3" json:"c_reward_forecast,omitempty"`
+	CNorm                     []string `protobuf:"bytes,6,rep,name=c_norm,json=cNorm,proto3" json:"c_norm,omitempty"`
+	MaxTopInferersToReward    []uint64 `protobuf:"varint,7,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward []uint64 `protobuf:"varint,8,rep,packed,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    []uint64 `protobuf:"varint,9,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	BetaEntropy               []string `protobuf:"bytes,10,rep,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`
+	LearningRate              []string `protobuf:"bytes,11,rep,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
+	TaskRewardAlpha           []string `protobuf:"bytes,12,rep,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha,omitempty"`
+	EpsilonReputer            []string `protobuf:"bytes,13,rep,name=epsilon_reputer,json=epsilonReputer,proto3" json:"epsilon_reputer,omitempty"`
+}
+
+func (x *TopicParamsOverrides) Reset() {
+	*x = TopicParamsOverrides{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicParamsOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicParamsOverrides) ProtoMessage() {}
+
+// Deprecated: Use TopicParamsOverrides.ProtoReflect.Descriptor instead.
+func (*TopicParamsOverrides) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopicParamsOverrides) GetPRewardInference() []string {
+	if x != nil {
+		return x.PRewardInference
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetPRewardForecast() []string {
+	if x != nil {
+		return x.PRewardForecast
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetPRewardReputer() []string {
+	if x != nil {
+		return x.PRewardReputer
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetCRewardInference() []string {
+	if x != nil {
+		return x.CRewardInference
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetCRewardForecast() []string {
+	if x != nil {
+		return x.CRewardForecast
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetCNorm() []string {
+	if x != nil {
+		return x.CNorm
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetMaxTopInferersToReward() []uint64 {
+	if x != nil {
+		return x.MaxTopInferersToReward
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetMaxTopForecastersToReward() []uint64 {
+	if x != nil {
+		return x.MaxTopForecastersToReward
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetMaxTopReputersToReward() []uint64 {
+	if x != nil {
+		return x.MaxTopReputersToReward
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetBetaEntropy() []string {
+	if x != nil {
+		return x.BetaEntropy
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetLearningRate() []string {
+	if x != nil {
+		return x.LearningRate
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetTaskRewardAlpha() []string {
+	if x != nil {
+		return x.TaskRewardAlpha
+	}
+	return nil
+}
+
+func (x *TopicParamsOverrides) GetEpsilonReputer() []string {
+	if x != nil {
+		return x.EpsilonReputer
+	}
+	return nil
+}
+
+// The values of the overridable params that apply to a topic,
+// the override when set and the global param otherwise.
+type EffectiveTopicParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PRewardInference          string `protobuf:"bytes,1,opt,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
+	PRewardForecast           string `protobuf:"bytes,2,opt,name=p_reward_forecast,json=pRewardForecast,proto3" json:"p_reward_forecast,omitempty"`
+	PRewardReputer            string `protobuf:"bytes,3,opt,name=p_reward_reputer,json=pRewardReputer,proto3" json:"p_reward_reputer,omitempty"`
+	CRewardInference          string `protobuf:"bytes,4,opt,name=c_reward_inference,json=cRewardInference,proto3" json:"c_reward_inference,omitempty"`
+	CRewardForecast           string `protobuf:"bytes,5,opt,name=c_reward_forecast,json=cRewardForecast,proto3" json:"c_reward_forecast,omitempty"`
+	CNorm                     string `protobuf:"bytes,6,opt,name=c_norm,json=cNorm,proto3" json:"c_norm,omitempty"`
+	MaxTopInferersToReward    uint64 `protobuf:"varint,7,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward uint64 `protobuf:"varint,8,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    uint64 `protobuf:"varint,9,opt,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	BetaEntropy               string `protobuf:"bytes,10,opt,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`
+	LearningRate              string `protobuf:"bytes,11,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
+	TaskRewardAlpha           string `protobuf:"bytes,12,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha,omitempty"`
+	EpsilonReputer            string `protobuf:"bytes,13,opt,name=epsilon_reputer,json=epsilonReputer,proto3" json:"epsilon_reputer,omitempty"`
+}
+
+func (x *EffectiveTopicParams) Reset() {
+	*x = EffectiveTopicParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveTopicParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveTopicParams) ProtoMessage() {}
+
+// Deprecated: Use EffectiveTopicParams.ProtoReflect.Descriptor instead.
+func (*EffectiveTopicParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EffectiveTopicParams) GetPRewardInference() string {
+	if x != nil {
+		return x.PRewardInference
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetPRewardForecast() string {
+	if x != nil {
+		return x.PRewardForecast
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetPRewardReputer() string {
+	if x != nil {
+		return x.PRewardReputer
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetCRewardInference() string {
+	if x != nil {
+		return x.CRewardInference
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetCRewardForecast() string {
+	if x != nil {
+		return x.CRewardForecast
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetCNorm() string {
+	if x != nil {
+		return x.CNorm
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetMaxTopInferersToReward() uint64 {
+	if x != nil {
+		return x.MaxTopInferersToReward
+	}
+	return 0
+}
+
+func (x *EffectiveTopicParams) GetMaxTopForecastersToReward() uint64 {
+	if x != nil {
+		return x.MaxTopForecastersToReward
+	}
+	return 0
+}
+
+func (x *EffectiveTopicParams) GetMaxTopReputersToReward() uint64 {
+	if x != nil {
+		return x.MaxTopReputersToReward
+	}
+	return 0
+}
+
+func (x *EffectiveTopicParams) GetBetaEntropy() string {
+	if x != nil {
+		return x.BetaEntropy
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetLearningRate() string {
+	if x != nil {
+		return x.LearningRate
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetTaskRewardAlpha() string {
+	if x != nil {
+		return x.TaskRewardAlpha
+	}
+	return ""
+}
+
+func (x *EffectiveTopicParams) GetEpsilonReputer() string {
+	if x != nil {
+		return x.EpsilonReputer
+	}
+	return ""
+}
+
 var File_emissions_v1_topic_proto protoreflect.FileDescriptor
 
 var file_emissions_v1_topic_proto_rawDesc = []byte{
@@ -2555,7 +6625,7 @@ var file_emissions_v1_topic_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d,
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x05, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x08, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
@@ -2597,32 +6667,214 @@ var file_emissions_v1_topic_proto_rawDesc = []byte{
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
 	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x54, 0x0a, 0x14, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x13, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x73, 0x0a, 0x19, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9c, 0x09,
+	0x0a, 0x14, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x70, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x63, 0x0a,
+	0x11, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x61, 0x0a, 0x10, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x12, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
-	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x63, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x11,
+	0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0f, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x12, 0x4e, 0x0a, 0x06, 0x63, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x63, 0x4e, 0x6f, 0x72,
+	0x6d, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x40, 0x0a,
+	0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x5a, 0x0a, 0x0c, 0x62,
+	0x65, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x62, 0x65, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x12, 0x5c, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x60, 0x0a, 0x0f, 0x65, 0x70,
+	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x65, 0x70,
+	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x22, 0x9c, 0x09, 0x0a,
+	0x14, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x70, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x11,
+	0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x12, 0x61, 0x0a, 0x10, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x12, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x63, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x63,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0f, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x4e, 0x0a, 0x06, 0x63, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x63, 0x4e, 0x6f, 0x72, 0x6d,
+	0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x1d,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x3a,
+	0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x5a, 0x0a, 0x0c, 0x62, 0x65,
+	0x74, 0x61, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x62, 0x65, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x12, 0x5c, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x60, 0x0a, 0x0f, 0x65, 0x70, 0x73,
+	0x69, 0x6c, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x65, 0x70, 0x73,
+	0x69, 0x6c, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x2a, 0xa2, 0x01, 0x0a, 0x13,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x47, 0x52, 0x45, 0x54, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54,
+	0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x47, 0x47,
+	0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x47, 0x52, 0x45, 0x54, 0x5f,
+	0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x02, 0x12,
+	0x1f, 0x0a, 0x1b, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57,
+	0x49, 0x4e, 0x53, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x03,
+	0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2637,21 +6889,26 @@ func file_emissions_v1_topic_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_topic_proto_rawDescData
 }
 
-var file_emissions_v1_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_emissions_v1_topic_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_emissions_v1_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_emissions_v1_topic_proto_goTypes = []interface{}{
-	(*Topic)(nil),                 // 0: emissions.v1.Topic
-	(*TopicList)(nil),             // 1: emissions.v1.TopicList
-	(*TimestampedActorNonce)(nil), // 2: emissions.v1.TimestampedActorNonce
-	(*Nonce)(nil),                 // 3: emissions.v1.Nonce
+	(AggregationStrategy)(0),      // 0: emissions.v1.AggregationStrategy
+	(*Topic)(nil),                 // 1: emissions.v1.Topic
+	(*TopicList)(nil),             // 2: emissions.v1.TopicList
+	(*TimestampedActorNonce)(nil), // 3: emissions.v1.TimestampedActorNonce
+	(*TopicParamsOverrides)(nil),  // 4: emissions.v1.TopicParamsOverrides
+	(*EffectiveTopicParams)(nil),  // 5: emissions.v1.EffectiveTopicParams
+	(*Nonce)(nil),                 // 6: emissions.v1.Nonce
 }
 var file_emissions_v1_topic_proto_depIdxs = []int32{
-	0, // 0: emissions.v1.TopicList.topics:type_name -> emissions.v1.Topic
-	3, // 1: emissions.v1.TimestampedActorNonce.nonce:type_name -> emissions.v1.Nonce
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: emissions.v1.Topic.aggregation_strategy:type_name -> emissions.v1.AggregationStrategy
+	1, // 1: emissions.v1.TopicList.topics:type_name -> emissions.v1.Topic
+	6, // 2: emissions.v1.TimestampedActorNonce.nonce:type_name -> emissions.v1.Nonce
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_topic_proto_init() }
@@ -2697,19 +6954,44 @@ func file_emissions_v1_topic_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v1_topic_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicParamsOverrides); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveTopicParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_topic_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_emissions_v1_topic_proto_goTypes,
 		DependencyIndexes: file_emissions_v1_topic_proto_depIdxs,
+		EnumInfos:         file_emissions_v1_topic_proto_enumTypes,
 		MessageInfos:      file_emissions_v1_topic_proto_msgTypes,
 	}.Build()
 	File_emissions_v1_topic_proto = out.File
@@ -19,22 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName              = "/emissions.v1.Msg/UpdateParams"
-	Msg_InsertBulkWorkerPayload_FullMethodName   = "/emissions.v1.Msg/InsertBulkWorkerPayload"
-	Msg_CreateNewTopic_FullMethodName            = "/emissions.v1.Msg/CreateNewTopic"
-	Msg_Register_FullMethodName                  = "/emissions.v1.Msg/Register"
-	Msg_RemoveRegistration_FullMethodName        = "/emissions.v1.Msg/RemoveRegistration"
-	Msg_InsertBulkReputerPayload_FullMethodName  = "/emissions.v1.Msg/InsertBulkReputerPayload"
-	Msg_AddStake_FullMethodName                  = "/emissions.v1.Msg/AddStake"
-	Msg_RemoveStake_FullMethodName               = "/emissions.v1.Msg/RemoveStake"
-	Msg_CancelRemoveStake_FullMethodName         = "/emissions.v1.Msg/CancelRemoveStake"
-	Msg_DelegateStake_FullMethodName             = "/emissions.v1.Msg/DelegateStake"
-	Msg_RewardDelegateStake_FullMethodName       = "/emissions.v1.Msg/RewardDelegateStake"
-	Msg_RemoveDelegateStake_FullMethodName       = "/emissions.v1.Msg/RemoveDelegateStake"
-	Msg_CancelRemoveDelegateStake_FullMethodName = "/emissions.v1.Msg/CancelRemoveDelegateStake"
-	Msg_FundTopic_FullMethodName                 = "/emissions.v1.Msg/FundTopic"
-	Msg_AddToWhitelistAdmin_FullMethodName       = "/emissions.v1.Msg/AddToWhitelistAdmin"
-	Msg_RemoveFromWhitelistAdmin_FullMethodName  = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_UpdateParams_FullMethodName               = "/emissions.v1.Msg/UpdateParams"
+	Msg_ScheduleParamsChange_FullMethodName       = "/emissions.v1.Msg/ScheduleParamsChange"
+	Msg_CancelParamsChange_FullMethodName         = "/emissions.v1.Msg/CancelParamsChange"
+	Msg_InsertBulkWorkerPayload_FullMethodName    = "/emissions.v1.Msg/InsertBulkWorkerPayload"
+	Msg_InsertWorkerPayload_FullMethodName        = "/emissions.v1.Msg/InsertWorkerPayload"
+	Msg_CreateNewTopic_FullMethodName             = "/emissions.v1.Msg/CreateNewTopic"
+	Msg_UpdateTopicParamsOverrides_FullMethodName = "/emissions.v1.Msg/UpdateTopicParamsOverrides"
+	Msg_Register_FullMethodName                   = "/emissions.v1.Msg/Register"
+	Msg_RemoveRegistration_FullMethodName         = "/emissions.v1.Msg/RemoveRegistration"
+	Msg_UpdateNodeInfo_FullMethodName             = "/emissions.v1.Msg/UpdateNodeInfo"
+	Msg_SetPayoutAddress_FullMethodName           = "/emissions.v1.Msg/SetPayoutAddress"
+	Msg_InsertBulkReputerPayload_FullMethodName   = "/emissions.v1.Msg/InsertBulkReputerPayload"
+	Msg_InsertReputerPayload_FullMethodName       = "/emissions.v1.Msg/InsertReputerPayload"
+	Msg_AddStake_FullMethodName                   = "/emissions.v1.Msg/AddStake"
+	Msg_RemoveStake_FullMethodName                = "/emissions.v1.Msg/RemoveStake"
+	Msg_CancelRemoveStake_FullMethodName          = "/emissions.v1.Msg/CancelRemoveStake"
+	Msg_DelegateStake_FullMethodName              = "/emissions.v1.Msg/DelegateStake"
+	Msg_RewardDelegateStake_FullMethodName        = "/emissions.v1.Msg/RewardDelegateStake"
+	Msg_RemoveDelegateStake_FullMethodName        = "/emissions.v1.Msg/RemoveDelegateStake"
+	Msg_CancelRemoveDelegateStake_FullMethodName  = "/emissions.v1.Msg/CancelRemoveDelegateStake"
+	Msg_FundTopic_FullMethodName                  = "/emissions.v1.Msg/FundTopic"
+	Msg_RequestInference_FullMethodName           = "/emissions.v1.Msg/RequestInference"
+	Msg_CreateSubscription_FullMethodName         = "/emissions.v1.Msg/CreateSubscription"
+	Msg_CancelSubscription_FullMethodName         = "/emissions.v1.Msg/CancelSubscription"
+	Msg_AddToWhitelistAdmin_FullMethodName        = "/emissions.v1.Msg/AddToWhitelistAdmin"
+	Msg_RemoveFromWhitelistAdmin_FullMethodName   = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_GrantRole_FullMethodName                  = "/emissions.v1.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName                 = "/emissions.v1.Msg/RevokeRole"
 )
 
 // MsgClient is the client API for Msg service.
@@ -42,11 +54,18 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error)
+	CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error)
 	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
+	InsertWorkerPayload(ctx context.Context, in *MsgInsertWorkerPayload, opts ...grpc.CallOption) (*MsgInsertWorkerPayloadResponse, error)
 	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
+	UpdateTopicParamsOverrides(ctx context.Context, in *MsgUpdateTopicParamsOverrides, opts ...grpc.CallOption) (*MsgUpdateTopicParamsOverridesResponse, error)
 	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error)
+	SetPayoutAddress(ctx context.Context, in *MsgSetPayoutAddress, opts ...grpc.CallOption) (*MsgSetPayoutAddressResponse, error)
 	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
+	InsertReputerPayload(ctx context.Context, in *MsgInsertReputerPayload, opts ...grpc.CallOption) (*MsgInsertReputerPayloadResponse, error)
 	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
 	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
 	CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error)
@@ -55,8 +74,13 @@ type MsgClient interface {
 	RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error)
 	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
+	RequestInference(ctx context.Context, in *MsgRequestInference, opts ...grpc.CallOption) (*MsgRequestInferenceResponse, error)
+	CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
 }
 
 type msgClient struct {
@@ -76,6 +100,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error) {
+	out := new(MsgScheduleParamsChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParamsChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error) {
+	out := new(MsgCancelParamsChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelParamsChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error) {
 	out := new(MsgInsertBulkWorkerPayloadResponse)
 	err := c.cc.Invoke(ctx, Msg_InsertBulkWorkerPayload_FullMethodName, in, out, opts...)
@@ -85,6 +127,15 @@ func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBu
 	return out, nil
 }
 
+func (c *msgClient) InsertWorkerPayload(ctx context.Context, in *MsgInsertWorkerPayload, opts ...grpc.CallOption) (*MsgInsertWorkerPayloadResponse, error) {
+	out := new(MsgInsertWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, Msg_InsertWorkerPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
 	out := new(MsgCreateNewTopicResponse)
 	err := c.cc.Invoke(ctx, Msg_CreateNewTopic_FullMethodName, in, out, opts...)
@@ -94,6 +145,15 @@ func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateTopicParamsOverrides(ctx context.Context, in *MsgUpdateTopicParamsOverrides, opts ...grpc.CallOption) (*MsgUpdateTopicParamsOverridesResponse, error) {
+	out := new(MsgUpdateTopicParamsOverridesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTopicParamsOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
 	out := new(MsgRegisterResponse)
 	err := c.cc.Invoke(ctx, Msg_Register_FullMethodName, in, out, opts...)
@@ -112,6 +172,24 @@ func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistr
 	return out, nil
 }
 
+func (c *msgClient) UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error) {
+	out := new(MsgUpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNodeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPayoutAddress(ctx context.Context, in *MsgSetPayoutAddress, opts ...grpc.CallOption) (*MsgSetPayoutAddressResponse, error) {
+	out := new(MsgSetPayoutAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPayoutAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
 	out := new(MsgInsertBulkReputerPayloadResponse)
 	err := c.cc.Invoke(ctx, Msg_InsertBulkReputerPayload_FullMethodName, in, out, opts...)
@@ -121,6 +199,15 @@ func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertB
 	return out, nil
 }
 
+func (c *msgClient) InsertReputerPayload(ctx context.Context, in *MsgInsertReputerPayload, opts ...grpc.CallOption) (*MsgInsertReputerPayloadResponse, error) {
+	out := new(MsgInsertReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, Msg_InsertReputerPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error) {
 	out := new(MsgAddStakeResponse)
 	err := c.cc.Invoke(ctx, Msg_AddStake_FullMethodName, in, out, opts...)
@@ -193,6 +280,33 @@ func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) RequestInference(ctx context.Context, in *MsgRequestInference, opts ...grpc.CallOption) (*MsgRequestInferenceResponse, error) {
+	out := new(MsgRequestInferenceResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestInference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error) {
+	out := new(MsgCreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error) {
+	out := new(MsgCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error) {
 	out := new(MsgAddToWhitelistAdminResponse)
 	err := c.cc.Invoke(ctx, Msg_AddToWhitelistAdmin_FullMethodName, in, out, opts...)
@@ -211,16 +325,41 @@ func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveF
 	return out, nil
 }
 
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ScheduleParamsChange(context.Context, *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error)
+	CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error)
 	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
+	InsertWorkerPayload(context.Context, *MsgInsertWorkerPayload) (*MsgInsertWorkerPayloadResponse, error)
 	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
+	UpdateTopicParamsOverrides(context.Context, *MsgUpdateTopicParamsOverrides) (*MsgUpdateTopicParamsOverridesResponse, error)
 	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
 	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
+	UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error)
+	SetPayoutAddress(context.Context, *MsgSetPayoutAddress) (*MsgSetPayoutAddressResponse, error)
 	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
+	InsertReputerPayload(context.Context, *MsgInsertReputerPayload) (*MsgInsertReputerPayloadResponse, error)
 	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
 	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
 	CancelRemoveStake(context.Context, *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error)
@@ -229,8 +368,13 @@ type MsgServer interface {
 	RemoveDelegateStake(context.Context, *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error)
 	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
+	RequestInference(context.Context, *MsgRequestInference) (*MsgRequestInferenceResponse, error)
+	CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error)
+	CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error)
 	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -241,21 +385,42 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ScheduleParamsChange(context.Context, *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsChange not implemented")
+}
+func (UnimplementedMsgServer) CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamsChange not implemented")
+}
 func (UnimplementedMsgServer) InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
 }
+func (UnimplementedMsgServer) InsertWorkerPayload(context.Context, *MsgInsertWorkerPayload) (*MsgInsertWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+}
 func (UnimplementedMsgServer) CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
+func (UnimplementedMsgServer) UpdateTopicParamsOverrides(context.Context, *MsgUpdateTopicParamsOverrides) (*MsgUpdateTopicParamsOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicParamsOverrides not implemented")
+}
 func (UnimplementedMsgServer) Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
 func (UnimplementedMsgServer) RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
+func (UnimplementedMsgServer) UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
+func (UnimplementedMsgServer) SetPayoutAddress(context.Context, *MsgSetPayoutAddress) (*MsgSetPayoutAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPayoutAddress not implemented")
+}
 func (UnimplementedMsgServer) InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
 }
+func (UnimplementedMsgServer) InsertReputerPayload(context.Context, *MsgInsertReputerPayload) (*MsgInsertReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+}
 func (UnimplementedMsgServer) AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
 }
@@ -280,12 +445,27 @@ func (UnimplementedMsgServer) CancelRemoveDelegateStake(context.Context, *MsgCan
 func (UnimplementedMsgServer) FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
 }
+func (UnimplementedMsgServer) RequestInference(context.Context, *MsgRequestInference) (*MsgRequestInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestInference not implemented")
+}
+func (UnimplementedMsgServer) CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedMsgServer) CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
 func (UnimplementedMsgServer) AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
 }
 func (UnimplementedMsgServer) RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
 }
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -317,6 +497,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParamsChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, req.(*MsgScheduleParamsChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelParamsChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamsChange(ctx, req.(*MsgCancelParamsChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInsertBulkWorkerPayload)
 	if err := dec(in); err != nil {
@@ -335,6 +551,24 @@ func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InsertWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertWorkerPayload(ctx, req.(*MsgInsertWorkerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateNewTopic)
 	if err := dec(in); err != nil {
@@ -353,6 +587,24 @@ func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTopicParamsOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicParamsOverrides)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicParamsOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTopicParamsOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicParamsOverrides(ctx, req.(*MsgUpdateTopicParamsOverrides))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegister)
 	if err := dec(in); err != nil {
@@ -389,6 +641,42 @@ func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNodeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNodeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, req.(*MsgUpdateNodeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPayoutAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPayoutAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPayoutAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPayoutAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPayoutAddress(ctx, req.(*MsgSetPayoutAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInsertBulkReputerPayload)
 	if err := dec(in); err != nil {
@@ -407,6 +695,24 @@ func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertReputerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InsertReputerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertReputerPayload(ctx, req.(*MsgInsertReputerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddStake)
 	if err := dec(in); err != nil {
@@ -551,6 +857,60 @@ func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestInference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestInference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestInference(ctx, req.(*MsgRequestInference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubscription(ctx, req.(*MsgCreateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubscription(ctx, req.(*MsgCancelSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddToWhitelistAdmin)
 	if err := dec(in); err != nil {
@@ -587,6 +947,42 @@ func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -598,14 +994,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleParamsChange",
+			Handler:    _Msg_ScheduleParamsChange_Handler,
+		},
+		{
+			MethodName: "CancelParamsChange",
+			Handler:    _Msg_CancelParamsChange_Handler,
+		},
 		{
 			MethodName: "InsertBulkWorkerPayload",
 			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
 		},
+		{
+			MethodName: "InsertWorkerPayload",
+			Handler:    _Msg_InsertWorkerPayload_Handler,
+		},
 		{
 			MethodName: "CreateNewTopic",
 			Handler:    _Msg_CreateNewTopic_Handler,
 		},
+		{
+			MethodName: "UpdateTopicParamsOverrides",
+			Handler:    _Msg_UpdateTopicParamsOverrides_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _Msg_Register_Handler,
@@ -614,10 +1026,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRegistration",
 			Handler:    _Msg_RemoveRegistration_Handler,
 		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _Msg_UpdateNodeInfo_Handler,
+		},
+		{
+			MethodName: "SetPayoutAddress",
+			Handler:    _Msg_SetPayoutAddress_Handler,
+		},
 		{
 			MethodName: "InsertBulkReputerPayload",
 			Handler:    _Msg_InsertBulkReputerPayload_Handler,
 		},
+		{
+			MethodName: "InsertReputerPayload",
+			Handler:    _Msg_InsertReputerPayload_Handler,
+		},
 		{
 			MethodName: "AddStake",
 			Handler:    _Msg_AddStake_Handler,
@@ -650,6 +1074,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FundTopic",
 			Handler:    _Msg_FundTopic_Handler,
 		},
+		{
+			MethodName: "RequestInference",
+			Handler:    _Msg_RequestInference_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Msg_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Msg_CancelSubscription_Handler,
+		},
 		{
 			MethodName: "AddToWhitelistAdmin",
 			Handler:    _Msg_AddToWhitelistAdmin_Handler,
@@ -658,6 +1094,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromWhitelistAdmin",
 			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/tx.proto",
@@ -14,6 +14,10 @@ type NetworkInferenceBuilder struct {
 	ctx     sdk.Context
 	logger  log.Logger
 	palette SynthPalette
+	// Sums of the regrets of all inferers and of all forecasters of the palette, out of which
+	// the weight calculations of every subset of workers that leaves one of them out take its regret
+	infererRegretSum    alloraMath.Dec
+	forecasterRegretSum alloraMath.Dec
 	// Network Inferences Properties
 	inferences                 []*emissions.WorkerAttributedValue
 	forecastImpliedInferences  []*emissions.WorkerAttributedValue
@@ -23,6 +27,8 @@ type NetworkInferenceBuilder struct {
 	oneOutInfererInferences    []*emissions.WithheldWorkerAttributedValue
 	oneOutForecasterInferences []*emissions.WithheldWorkerAttributedValue
 	oneInInferences            []*emissions.WorkerAttributedValue
+	// Network inferences of each element of a vector topic
+	elementBundles []*emissions.ValueBundle
 }
 
 func NewNetworkInferenceBuilderFromSynthRequest(
@@ -33,19 +39,38 @@ func NewNetworkInferenceBuilderFromSynthRequest(
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "Error building palette from request")
 	}
+	return newNetworkInferenceBuilder(req.Ctx, Logger(req.Ctx), palette)
+}
+
+func newNetworkInferenceBuilder(ctx sdk.Context, logger log.Logger, palette SynthPalette) (*NetworkInferenceBuilder, error) {
+	infererRegretSum, err := alloraMath.Sum(palette.GetInfererRegretsSlice())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error summing inferer regrets")
+	}
+	forecasterRegretSum, err := alloraMath.Sum(palette.GetForecasterRegretsSlice())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error summing forecaster regrets")
+	}
 	return &NetworkInferenceBuilder{
-		ctx:     req.Ctx,
-		logger:  Logger(req.Ctx),
-		palette: palette,
+		ctx:                 ctx,
+		logger:              logger,
+		palette:             palette,
+		infererRegretSum:    infererRegretSum,
+		forecasterRegretSum: forecasterRegretSum,
 	}, nil
 }
 
 // Calculates the network combined inference I_i, Equation 9
 func (b *NetworkInferenceBuilder) SetCombinedValue() *NetworkInferenceBuilder {
 	b.logger.Debug(fmt.Sprintf("Calculating combined inference for topic %v", b.palette.TopicId))
-	palette := b.palette.Clone()
+	palette := b.palette
 
-	weights, err := palette.CalcWeightsGivenWorkers()
+	regretSum, err := b.infererRegretSum.Add(b.forecasterRegretSum)
+	if err != nil {
+		b.logger.Warn(fmt.Sprintf("Error summing regrets for combined inference: %s", err.Error()))
+		return b
+	}
+	weights, err := palette.CalcWeightsGivenRegretSum(regretSum)
 	if err != nil {
 		b.logger.Warn(fmt.Sprintf("Error calculating weights for combined inference: %s", err.Error()))
 		return b
@@ -96,11 +121,12 @@ func (b *NetworkInferenceBuilder) SetForecasterValues() *NetworkInferenceBuilder
 // Calculates the network naive inference I^-_i
 func (b *NetworkInferenceBuilder) SetNaiveValue() *NetworkInferenceBuilder {
 	b.logger.Debug(fmt.Sprintf("Calculating naive inference for topic %v", b.palette.TopicId))
-	palette := b.palette.Clone()
+	// Shallow copies of the palette suffice below as its maps are replaced rather than mutated
+	palette := b.palette
 
 	palette.Forecasters = nil
 	palette.ForecasterRegrets = make(map[string]*StatefulRegret, 0)
-	weights, err := palette.CalcWeightsGivenWorkers()
+	weights, err := palette.CalcWeightsGivenRegretSum(b.infererRegretSum)
 	if err != nil {
 		b.logger.Warn(fmt.Sprintf("Error calculating weights for naive inference: %s", err.Error()))
 		return b
@@ -120,16 +146,16 @@ func (b *NetworkInferenceBuilder) SetNaiveValue() *NetworkInferenceBuilder {
 // Calculate the one-out inference given a withheld inferer
 func (b *NetworkInferenceBuilder) calcOneOutInfererInference(withheldInferer Worker) (alloraMath.Dec, error) {
 	b.logger.Debug(fmt.Sprintf("Calculating one-out inference for topic %v withheld inferer %s", b.palette.TopicId, withheldInferer))
-	palette := b.palette.Clone()
 
 	// Check if withheld inferer is new
-	withheldInfererRegret, ok := palette.InfererRegrets[withheldInferer]
+	withheldInfererRegret, ok := b.palette.InfererRegrets[withheldInferer]
 	if !ok || withheldInfererRegret.noPriorRegret {
 		return alloraMath.NewNaN(), nil
 	}
 
 	// Remove the inferer from the palette's inferers
-	remainingInferers := make([]Worker, 0)
+	palette := b.palette
+	remainingInferers := make([]Worker, 0, len(palette.Inferers))
 	for _, inferer := range palette.Inferers {
 		if inferer != withheldInferer {
 			remainingInferers = append(remainingInferers, inferer)
@@ -140,22 +166,29 @@ func (b *NetworkInferenceBuilder) calcOneOutInfererInference(withheldInferer Wor
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error updating inferers")
 	}
-	paletteCopy := palette.Clone()
 
 	// Recalculate the forecast-implied inferences without the worker's inference
 	// This is necessary because the forecast-implied inferences are calculated based on the inferences of the inferers
-	err = palette.UpdateForecastImpliedInferences()
+	forecastImpliedInferences, err := b.calcOneOutForecastImpliedInferences(&palette, withheldInferer)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error recalculating forecast-implied inferences")
 	}
 
-	paletteCopy.ForecastImpliedInferenceByWorker = palette.ForecastImpliedInferenceByWorker
-	weights, err := paletteCopy.CalcWeightsGivenWorkers()
+	palette.ForecastImpliedInferenceByWorker = forecastImpliedInferences
+	infererRegretSum, err := b.infererRegretSum.Sub(withheldInfererRegret.regret)
+	if err != nil {
+		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error withholding inferer regret")
+	}
+	regretSum, err := infererRegretSum.Add(b.forecasterRegretSum)
+	if err != nil {
+		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error summing regrets")
+	}
+	weights, err := palette.CalcWeightsGivenRegretSum(regretSum)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for forecaster")
 	}
 
-	oneOutNetworkInferenceWithoutInferer, err := paletteCopy.CalcWeightedInference(weights)
+	oneOutNetworkInferenceWithoutInferer, err := palette.CalcWeightedInference(weights)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for inferer")
 	}
@@ -164,6 +197,47 @@ func (b *NetworkInferenceBuilder) calcOneOutInfererInference(withheldInferer Wor
 	return oneOutNetworkInferenceWithoutInferer, nil
 }
 
+// Calculate the forecast-implied inferences of a palette from which the withheld inferer was removed.
+// A forecast-implied inference only depends on the forecasted inferers and on whether all inferers
+// are new, so unless withholding the inferer changes the latter, only those of the forecasters that
+// forecasted the withheld inferer are recalculated and the others are taken from the full palette.
+func (b *NetworkInferenceBuilder) calcOneOutForecastImpliedInferences(
+	palette *SynthPalette,
+	withheldInferer Worker,
+) (map[Worker]*emissions.Inference, error) {
+	if palette.InferersNewStatus != b.palette.InferersNewStatus {
+		return palette.CalcForecastImpliedInferences()
+	}
+
+	I_i := make(map[Worker]*emissions.Inference, len(palette.Forecasters))
+	for _, forecaster := range palette.Forecasters {
+		forecastImpliedInference := b.palette.ForecastImpliedInferenceByWorker[forecaster]
+		if forecastIncludesInferer(palette.ForecastByWorker[forecaster], withheldInferer) {
+			var err error
+			forecastImpliedInference, err = palette.calcForecastImpliedInference(forecaster)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if forecastImpliedInference != nil {
+			I_i[forecaster] = forecastImpliedInference
+		}
+	}
+	return I_i, nil
+}
+
+func forecastIncludesInferer(forecast *emissions.Forecast, inferer Worker) bool {
+	if forecast == nil {
+		return false
+	}
+	for _, el := range forecast.ForecastElements {
+		if el.Inferer == inferer {
+			return true
+		}
+	}
+	return false
+}
+
 // Set all one-out-inferer inferences that are possible given the provided input
 // Assumed that there is at most 1 inference per inferer
 // Loop over all inferences and withold one, then calculate the network inference less that witheld inference
@@ -195,9 +269,9 @@ func (b *NetworkInferenceBuilder) SetOneOutInfererValues() *NetworkInferenceBuil
 func (b *NetworkInferenceBuilder) calcOneOutForecasterInference(withheldForecaster Worker) (alloraMath.Dec, error) {
 	b.logger.Debug(fmt.Sprintf("Calculating one-out inference for topic %v withheld forecaster %s", b.palette.TopicId, withheldForecaster))
 
-	palette := b.palette.Clone()
+	palette := b.palette
 	// Remove the withheldForecaster from the palette's forecasters
-	remainingForecasters := make([]Worker, 0)
+	remainingForecasters := make([]Worker, 0, len(palette.Forecasters))
 	for _, forecaster := range palette.Forecasters {
 		if forecaster != withheldForecaster {
 			remainingForecasters = append(remainingForecasters, forecaster)
@@ -209,7 +283,18 @@ func (b *NetworkInferenceBuilder) calcOneOutForecasterInference(withheldForecast
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error updating forecasters")
 	}
 
-	weights, err := palette.CalcWeightsGivenWorkers()
+	forecasterRegretSum := b.forecasterRegretSum
+	if withheldForecasterRegret, ok := b.palette.ForecasterRegrets[withheldForecaster]; ok {
+		forecasterRegretSum, err = forecasterRegretSum.Sub(withheldForecasterRegret.regret)
+		if err != nil {
+			return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error withholding forecaster regret")
+		}
+	}
+	regretSum, err := b.infererRegretSum.Add(forecasterRegretSum)
+	if err != nil {
+		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error summing regrets")
+	}
+	weights, err := palette.CalcWeightsGivenRegretSum(regretSum)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for forecaster")
 	}
@@ -250,12 +335,7 @@ func (b *NetworkInferenceBuilder) SetOneOutForecasterValues() *NetworkInferenceB
 
 func (b *NetworkInferenceBuilder) calcOneInValue(oneInForecaster Worker) (alloraMath.Dec, error) {
 	b.logger.Debug(fmt.Sprintf("Calculating one-in inference for forecaster: %s", oneInForecaster))
-	palette := b.palette.Clone()
-
-	// In each loop, remove all forecast-implied inferences except one
-	forecastImpliedInferencesWithForecaster := make(map[Worker]*emissions.Inference)
-	forecastImpliedInferencesWithForecaster[oneInForecaster] = palette.ForecastImpliedInferenceByWorker[oneInForecaster]
-	palette.ForecastImpliedInferenceByWorker = forecastImpliedInferencesWithForecaster
+	palette := b.palette
 
 	regret, noPriorRegret, err := palette.K.GetOneInForecasterSelfNetworkRegret(palette.Ctx, palette.TopicId, oneInForecaster)
 	if err != nil {
@@ -266,9 +346,16 @@ func (b *NetworkInferenceBuilder) calcOneInValue(oneInForecaster Worker) (allora
 		return alloraMath.NewNaN(), nil
 	}
 
-	palette.ForecasterRegrets[oneInForecaster] = &StatefulRegret{
-		regret:        regret.Value,
-		noPriorRegret: noPriorRegret,
+	// In each loop, remove all forecast-implied inferences except one
+	forecastImpliedInferencesWithForecaster := make(map[Worker]*emissions.Inference)
+	forecastImpliedInferencesWithForecaster[oneInForecaster] = palette.ForecastImpliedInferenceByWorker[oneInForecaster]
+	palette.ForecastImpliedInferenceByWorker = forecastImpliedInferencesWithForecaster
+
+	palette.ForecasterRegrets = map[Worker]*StatefulRegret{
+		oneInForecaster: {
+			regret:        regret.Value,
+			noPriorRegret: noPriorRegret,
+		},
 	}
 
 	remainingForecaster := []Worker{oneInForecaster}
@@ -278,6 +365,7 @@ func (b *NetworkInferenceBuilder) calcOneInValue(oneInForecaster Worker) (allora
 	}
 
 	// Get one-in regrets for the forecaster and the inferers they provided forecasts for
+	palette.InfererRegrets = make(map[Worker]*StatefulRegret, len(palette.Inferers))
 	for _, inferer := range palette.Inferers {
 		regret, noPriorRegret, err := palette.K.GetOneInForecasterNetworkRegret(palette.Ctx, palette.TopicId, oneInForecaster, inferer)
 		if err != nil {
@@ -332,6 +420,9 @@ func (b *NetworkInferenceBuilder) SetOneInValues() *NetworkInferenceBuilder {
 }
 
 func (b *NetworkInferenceBuilder) CalcAndSetNetworkInferences() *NetworkInferenceBuilder {
+	if b.palette.InferenceDimension > 0 {
+		return b.SetElementValues()
+	}
 	return b.SetCombinedValue().
 		SetInfererValues().
 		SetForecasterValues().
@@ -341,10 +432,142 @@ func (b *NetworkInferenceBuilder) CalcAndSetNetworkInferences() *NetworkInferenc
 		SetOneInValues()
 }
 
+// Calculates the network inferences of a vector topic one element at a time, by synthesizing
+// the projection of the palette onto each element as if it were a scalar topic.
+// For quantile topics this is regret-weighted quantile averaging, and as the weights are
+// non-negative the combined quantiles stay monotonic. For categorical topics this is a weighted
+// ensemble of the probability vectors, which still sums to one as the weights are normalized.
+func (b *NetworkInferenceBuilder) SetElementValues() *NetworkInferenceBuilder {
+	b.logger.Debug(fmt.Sprintf("Calculating element-wise inferences for topic %v with dimension %v", b.palette.TopicId, b.palette.InferenceDimension))
+	elementBundles := make([]*emissions.ValueBundle, 0, b.palette.InferenceDimension)
+	forecastImpliedValues := make(map[Worker][]alloraMath.Dec, len(b.palette.Forecasters))
+	for element := 0; element < int(b.palette.InferenceDimension); element++ {
+		palette, err := b.palette.ElementPalette(element)
+		if err != nil {
+			b.logger.Warn(fmt.Sprintf("Error projecting palette onto element %d: %s", element, err.Error()))
+			return b
+		}
+		elementBuilder, err := newNetworkInferenceBuilder(b.ctx, b.logger, palette)
+		if err != nil {
+			b.logger.Warn(fmt.Sprintf("Error building network inferences of element %d: %s", element, err.Error()))
+			return b
+		}
+		elementBundles = append(elementBundles, elementBuilder.CalcAndSetNetworkInferences().Build())
+		// Weights are the same for every element
+		b.weights = elementBuilder.weights
+		for forecaster, inference := range palette.ForecastImpliedInferenceByWorker {
+			forecastImpliedValues[forecaster] = append(forecastImpliedValues[forecaster], inference.Value)
+		}
+	}
+
+	forecastImpliedInferenceByWorker := make(map[Worker]*emissions.Inference, len(forecastImpliedValues))
+	for forecaster, values := range forecastImpliedValues {
+		if len(values) != int(b.palette.InferenceDimension) {
+			continue
+		}
+		forecastImpliedInferenceByWorker[forecaster] = &emissions.Inference{
+			TopicId: b.palette.TopicId,
+			Inferer: forecaster,
+			Values:  values,
+		}
+	}
+	b.palette.ForecastImpliedInferenceByWorker = forecastImpliedInferenceByWorker
+	b.elementBundles = elementBundles
+	return b
+}
+
+// Zips the values of the same worker across elements into one vector per worker.
+// Workers missing from any of the elements are left out.
+func zipWorkerAttributedValues(
+	elementBundles []*emissions.ValueBundle,
+	valuesOf func(*emissions.ValueBundle) []*emissions.WorkerAttributedValue,
+) []*emissions.WorkerAttributedValue {
+	zipped := make([]*emissions.WorkerAttributedValue, 0)
+	if len(elementBundles) == 0 {
+		return zipped
+	}
+	valuesByWorker := make(map[Worker][]alloraMath.Dec)
+	for _, bundle := range elementBundles {
+		for _, value := range valuesOf(bundle) {
+			valuesByWorker[value.Worker] = append(valuesByWorker[value.Worker], value.Value)
+		}
+	}
+	// Keep the order of the workers in the first element
+	for _, value := range valuesOf(elementBundles[0]) {
+		if len(valuesByWorker[value.Worker]) != len(elementBundles) {
+			continue
+		}
+		zipped = append(zipped, &emissions.WorkerAttributedValue{
+			Worker: value.Worker,
+			Values: valuesByWorker[value.Worker],
+		})
+	}
+	return zipped
+}
+
+func zipWithheldWorkerAttributedValues(
+	elementBundles []*emissions.ValueBundle,
+	valuesOf func(*emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue,
+) []*emissions.WithheldWorkerAttributedValue {
+	zipped := make([]*emissions.WithheldWorkerAttributedValue, 0)
+	if len(elementBundles) == 0 {
+		return zipped
+	}
+	valuesByWorker := make(map[Worker][]alloraMath.Dec)
+	for _, bundle := range elementBundles {
+		for _, value := range valuesOf(bundle) {
+			valuesByWorker[value.Worker] = append(valuesByWorker[value.Worker], value.Value)
+		}
+	}
+	for _, value := range valuesOf(elementBundles[0]) {
+		if len(valuesByWorker[value.Worker]) != len(elementBundles) {
+			continue
+		}
+		zipped = append(zipped, &emissions.WithheldWorkerAttributedValue{
+			Worker: value.Worker,
+			Values: valuesByWorker[value.Worker],
+		})
+	}
+	return zipped
+}
+
+// Builds the value bundle of a vector topic out of the value bundles of its elements
+func (b *NetworkInferenceBuilder) buildElementWise() *emissions.ValueBundle {
+	combinedValues := make([]alloraMath.Dec, 0, len(b.elementBundles))
+	naiveValues := make([]alloraMath.Dec, 0, len(b.elementBundles))
+	for _, bundle := range b.elementBundles {
+		combinedValues = append(combinedValues, bundle.CombinedValue)
+		naiveValues = append(naiveValues, bundle.NaiveValue)
+	}
+	return &emissions.ValueBundle{
+		TopicId:        b.palette.TopicId,
+		CombinedValues: combinedValues,
+		NaiveValues:    naiveValues,
+		InfererValues: zipWorkerAttributedValues(b.elementBundles, func(bundle *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+			return bundle.InfererValues
+		}),
+		ForecasterValues: zipWorkerAttributedValues(b.elementBundles, func(bundle *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+			return bundle.ForecasterValues
+		}),
+		OneOutInfererValues: zipWithheldWorkerAttributedValues(b.elementBundles, func(bundle *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+			return bundle.OneOutInfererValues
+		}),
+		OneOutForecasterValues: zipWithheldWorkerAttributedValues(b.elementBundles, func(bundle *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+			return bundle.OneOutForecasterValues
+		}),
+		OneInForecasterValues: zipWorkerAttributedValues(b.elementBundles, func(bundle *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+			return bundle.OneInForecasterValues
+		}),
+	}
+}
+
 // Calculates all network inferences in the set I_i given historical state (e.g. regrets)
 // and data from workers (e.g. inferences, forecast-implied inferences).
 // Could improve this with Builder pattern, as for other instances of generated ValueBundles.
 func (b *NetworkInferenceBuilder) Build() *emissions.ValueBundle {
+	if b.palette.InferenceDimension > 0 {
+		return b.buildElementWise()
+	}
 	// Build value bundle to return all the calculated inferences
 	return &emissions.ValueBundle{
 		TopicId:                b.palette.TopicId,
@@ -0,0 +1,196 @@
+package inference_synthesis_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Stores deterministic pseudo-random regrets for a topic and returns a synth request over them.
+// Each forecaster forecasts the losses of `forecastElements` consecutive inferers, and a few
+// inferers and forecasters are left without prior regrets so that every branch is exercised.
+func setUpSynthRequest(
+	tb testing.TB,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	numInferers int,
+	numForecasters int,
+	forecastElements int,
+) inferencesynthesis.SynthRequest {
+	inferer := func(i int) string { return fmt.Sprintf("inferer%04d", i) }
+	forecaster := func(f int) string { return fmt.Sprintf("forecaster%04d", f) }
+
+	inferences := make([]*emissionstypes.Inference, 0, numInferers)
+	for i := 0; i < numInferers; i++ {
+		inferences = append(inferences, &emissionstypes.Inference{
+			TopicId: topicId,
+			Inferer: inferer(i),
+			Value:   alloraMath.NewDecFinite(int64(1000+(i*7919)%9973), -4),
+		})
+		if i%7 == 3 {
+			continue
+		}
+		err := k.SetInfererNetworkRegret(ctx, topicId, inferer(i), emissionstypes.TimestampedValue{
+			Value: alloraMath.NewDecFinite(int64((i*4093)%2011-1000), -4),
+		}, 0)
+		require.NoError(tb, err)
+	}
+
+	forecasts := make([]*emissionstypes.Forecast, 0, numForecasters)
+	for f := 0; f < numForecasters; f++ {
+		elements := make([]*emissionstypes.ForecastElement, 0, forecastElements)
+		for e := 0; e < forecastElements && e < numInferers; e++ {
+			i := (f + e) % numInferers
+			elements = append(elements, &emissionstypes.ForecastElement{
+				Inferer: inferer(i),
+				Value:   alloraMath.NewDecFinite(int64((f*131+i*71)%997), -3),
+			})
+			if (f+i)%11 == 5 {
+				continue
+			}
+			err := k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster(f), inferer(i), emissionstypes.TimestampedValue{
+				Value: alloraMath.NewDecFinite(int64((f*53+i*97)%1999-1000), -4),
+			}, 0)
+			require.NoError(tb, err)
+		}
+		forecasts = append(forecasts, &emissionstypes.Forecast{
+			TopicId:          topicId,
+			Forecaster:       forecaster(f),
+			ForecastElements: elements,
+		})
+		if f%5 == 2 {
+			continue
+		}
+		err := k.SetForecasterNetworkRegret(ctx, topicId, forecaster(f), emissionstypes.TimestampedValue{
+			Value: alloraMath.NewDecFinite(int64((f*389)%1777-900), -4),
+		}, 0)
+		require.NoError(tb, err)
+		err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, forecaster(f), emissionstypes.TimestampedValue{
+			Value: alloraMath.NewDecFinite(int64((f*271)%1301-650), -4),
+		}, 0)
+		require.NoError(tb, err)
+	}
+
+	return inferencesynthesis.SynthRequest{
+		Ctx:                 ctx,
+		K:                   k,
+		TopicId:             topicId,
+		Inferences:          &emissionstypes.Inferences{Inferences: inferences},
+		Forecasts:           &emissionstypes.Forecasts{Forecasts: forecasts},
+		NetworkCombinedLoss: alloraMath.MustNewDecFromString("0.2"),
+		Epsilon:             alloraMath.MustNewDecFromString("0.0001"),
+		PNorm:               alloraMath.MustNewDecFromString("3"),
+		CNorm:               alloraMath.MustNewDecFromString("0.75"),
+	}
+}
+
+// The digests were recorded from the builder that cloned the palette and recomputed everything
+// from scratch for every withheld and one-in worker, including the standard deviation of the
+// regrets of every subset of workers. Any change of the synthesis arithmetic, including the
+// representation of the resulting decimals, changes the digest.
+func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesMatchesReferenceDigest() {
+	testCases := []struct {
+		name             string
+		strategy         emissionstypes.AggregationStrategy
+		fraction         alloraMath.Dec
+		numInferers      int
+		numForecasters   int
+		forecastElements int
+		digest           string
+	}{
+		{
+			name:             "regret-weighted mean",
+			strategy:         emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN,
+			fraction:         alloraMath.ZeroDec(),
+			numInferers:      12,
+			numForecasters:   6,
+			forecastElements: 4,
+			digest:           "70b214fd12cb4a8640cf436cc273bdfdec48bfcfb3dfc0efcb18fddf7acfb826",
+		},
+		{
+			name:             "trimmed mean",
+			strategy:         emissionstypes.AggregationStrategy_AGGREGATION_TRIMMED_MEAN,
+			fraction:         alloraMath.MustNewDecFromString("0.2"),
+			numInferers:      12,
+			numForecasters:   6,
+			forecastElements: 4,
+			digest:           "975dab28c53c696dfcbbe903453a80051e9037c37dd0fbe2712c41cc5f0c8ab8",
+		},
+		{
+			name:             "regret-weighted mean of forecasters forecasting every inferer",
+			strategy:         emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN,
+			fraction:         alloraMath.ZeroDec(),
+			numInferers:      8,
+			numForecasters:   2,
+			forecastElements: 8,
+			digest:           "c1f3ed874b5437852cecb92856bd3de2f64a7a66aaf80ee481988d9f0b6c73b4",
+		},
+		{
+			name:             "regret-weighted median",
+			strategy:         emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEDIAN,
+			fraction:         alloraMath.ZeroDec(),
+			numInferers:      40,
+			numForecasters:   10,
+			forecastElements: 10,
+			digest:           "ab021766a22aa50691551c47964cbb70248d630e535d65926894cd730b70fe97",
+		},
+		{
+			name:             "winsorized mean",
+			strategy:         emissionstypes.AggregationStrategy_AGGREGATION_WINSORIZED_MEAN,
+			fraction:         alloraMath.MustNewDecFromString("0.1"),
+			numInferers:      64,
+			numForecasters:   16,
+			forecastElements: 10,
+			digest:           "836a1e2806d2b6cc08d598e936af8b93780e8e987c6981d7ff83cdfa1d7dd593",
+		},
+	}
+
+	for i, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := setUpSynthRequest(s.T(), s.ctx, s.emissionsKeeper, uint64(i+1), tc.numInferers, tc.numForecasters, tc.forecastElements)
+			req.AggregationStrategy = tc.strategy
+			req.AggregationTrimFraction = tc.fraction
+			builder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(req)
+			s.Require().NoError(err)
+			valueBundle := builder.CalcAndSetNetworkInferences().Build()
+			s.Require().Len(valueBundle.OneOutInfererValues, tc.numInferers)
+			s.Require().Len(valueBundle.OneOutForecasterValues, tc.numForecasters)
+			s.Require().Len(valueBundle.OneInForecasterValues, tc.numForecasters)
+
+			bz, err := valueBundle.Marshal()
+			s.Require().NoError(err)
+			digest := sha256.Sum256(bz)
+			s.Require().Equal(tc.digest, hex.EncodeToString(digest[:]))
+		})
+	}
+}
+
+func BenchmarkCalcAndSetNetworkInferences(b *testing.B) {
+	for _, numWorkers := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("workers=%d", numWorkers), func(b *testing.B) {
+			s := new(InferenceSynthesisTestSuite)
+			s.setUp(b)
+			// A fifth of the workers are forecasters, each forecasting ten inferers
+			numForecasters := numWorkers / 5
+			req := setUpSynthRequest(b, s.ctx, s.emissionsKeeper, 1, numWorkers-numForecasters, numForecasters, 10)
+
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				builder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(req)
+				if err != nil {
+					b.Fatal(err)
+				}
+				builder.CalcAndSetNetworkInferences().Build()
+			}
+		})
+	}
+}
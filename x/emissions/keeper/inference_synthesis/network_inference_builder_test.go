@@ -58,9 +58,14 @@ type InferenceSynthesisTestSuite struct {
 }
 
 func (s *InferenceSynthesisTestSuite) SetupTest() {
+	s.setUp(s.T())
+}
+
+// Sets up the keepers against a fresh store, also usable from benchmarks
+func (s *InferenceSynthesisTestSuite) setUp(tb testing.TB) {
 	key := storetypes.NewKVStoreKey("emissions")
 	storeService := runtime.NewKVStoreService(key)
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	testCtx := testutil.DefaultContextWithDB(tb, key, storetypes.NewTransientStoreKey("transient_test"))
 	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
 	addressCodec := address.NewBech32Codec(params.Bech32PrefixAccAddr)
@@ -169,6 +174,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				topicId,
 				inferer,
 				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+				0,
 			)
 		}
 
@@ -185,6 +191,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				topicId,
 				forecaster,
 				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+				0,
 			)
 		}
 
@@ -203,6 +210,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 					BlockHeight: blockHeight,
 					Value:       epochGetter(headerName),
 				},
+				0,
 			)
 		}
 
@@ -219,6 +227,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 					BlockHeight: blockHeight,
 					Value:       epochGet(headerName),
 				},
+				0,
 			)
 		}
 
@@ -558,25 +567,25 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesTwoWorkerTwoForec
 	cNorm := alloraMath.MustNewDecFromString("0.75")
 
 	// Set inferer network regrets
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")}, 0)
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")}, 0)
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")}, 0)
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker4, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker4, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5")}, 0)
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.6")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.6")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")}, 0)
 	s.Require().NoError(err)
 
 	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
@@ -662,41 +671,41 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerThreeF
 	cNorm := alloraMath.MustNewDecFromString("0.75")
 
 	// Set inferer network regrets
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002")})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002")}, 0)
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003")})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003")}, 0)
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")}, 0)
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")}, 0)
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006")}, 0)
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.007")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.007")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.009")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.009")}, 0)
 	s.Require().NoError(err)
 
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003")}, 0)
 	s.Require().NoError(err)
 
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006")}, 0)
 	s.Require().NoError(err)
 
 	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
@@ -766,19 +775,19 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOneOl
 	cNorm := alloraMath.MustNewDecFromString("0.75")
 
 	// Set inferer network regrets
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002")})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002")}, 0)
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets - just worker1 has a previous regret
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")}, 0)
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008")}, 0)
 	s.Require().NoError(err)
 
 	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
@@ -851,9 +860,9 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOldTw
 	cNorm := alloraMath.MustNewDecFromString("0.75")
 
 	// Set forecaster network regrets
-	err := k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")})
+	err := k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")}, 0)
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")}, 0)
 	s.Require().NoError(err)
 
 	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
@@ -926,21 +935,21 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOldTw
 	cNorm := alloraMath.MustNewDecFromString("0.75")
 
 	// Set inferer network regrets - Just for worker1
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")}, 0)
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")}, 0)
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008")})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008")}, 0)
 	s.Require().NoError(err)
 
 	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
@@ -1013,13 +1022,13 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neOutInfererInferencesTwoInferers
 	cNorm := alloraMath.MustNewDecFromString("0.75")
 
 	// Set inferer network regrets - Just for worker1
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001")}, 0)
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004")}, 0)
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005")}, 0)
 	s.Require().NoError(err)
 
 	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
@@ -1050,3 +1059,255 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neOutInfererInferencesTwoInferers
 		}
 	}
 }
+
+func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesVectorTopicMatchesScalarElements() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+
+	worker1 := "worker1"
+	worker2 := "worker2"
+	worker3 := "worker3"
+	forecaster1 := "forecaster1"
+	forecaster2 := "forecaster2"
+
+	elementValues := [][]string{
+		{"100", "200", "300"},
+		{"-10", "50", "20"},
+	}
+	vectorInferences := &emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{Inferer: worker1, Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("100"), alloraMath.MustNewDecFromString("-10")}},
+			{Inferer: worker2, Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("200"), alloraMath.MustNewDecFromString("50")}},
+			{Inferer: worker3, Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("300"), alloraMath.MustNewDecFromString("20")}},
+		},
+	}
+	forecasts := &emissionstypes.Forecasts{
+		Forecasts: []*emissionstypes.Forecast{
+			{
+				Forecaster: forecaster1,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("400")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500")},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("600")},
+				},
+			},
+			{
+				Forecaster: forecaster2,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("800")},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("900")},
+				},
+			},
+		},
+	}
+
+	regrets := map[string]string{worker1: "0.1", worker2: "0.2", worker3: "0.3"}
+	for inferer, regret := range regrets {
+		err := k.SetInfererNetworkRegret(ctx, topicId, inferer, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString(regret)}, 0)
+		s.Require().NoError(err)
+		err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, inferer, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString(regret)}, 0)
+		s.Require().NoError(err)
+		err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, inferer, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString(regret)}, 0)
+		s.Require().NoError(err)
+	}
+	err := k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")}, 0)
+	s.Require().NoError(err)
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5")}, 0)
+	s.Require().NoError(err)
+	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")}, 0)
+	s.Require().NoError(err)
+	err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5")}, 0)
+	s.Require().NoError(err)
+
+	synthRequest := func(inferenceDimension uint64, inferences *emissionstypes.Inferences) inferencesynthesis.SynthRequest {
+		return inferencesynthesis.SynthRequest{
+			Ctx:                 ctx,
+			K:                   k,
+			TopicId:             topicId,
+			InferenceDimension:  inferenceDimension,
+			Inferences:          inferences,
+			Forecasts:           forecasts,
+			NetworkCombinedLoss: alloraMath.MustNewDecFromString("1000"),
+			Epsilon:             alloraMath.MustNewDecFromString("0.001"),
+			PNorm:               alloraMath.MustNewDecFromString("2"),
+			CNorm:               alloraMath.MustNewDecFromString("0.75"),
+		}
+	}
+
+	vectorBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(synthRequest(2, vectorInferences))
+	s.Require().NoError(err)
+	vectorBundle := vectorBuilder.CalcAndSetNetworkInferences().Build()
+	s.Require().Len(vectorBundle.CombinedValues, 2)
+	s.Require().Len(vectorBundle.NaiveValues, 2)
+	s.Require().Len(vectorBundle.InfererValues, 3)
+	s.Require().Len(vectorBundle.ForecasterValues, 2)
+	s.Require().Len(vectorBundle.OneOutInfererValues, 3)
+	s.Require().Len(vectorBundle.OneOutForecasterValues, 2)
+	s.Require().Len(vectorBundle.OneInForecasterValues, 2)
+
+	// Each element of the vector topic is synthesized as the scalar topic of that element would be
+	for element, values := range elementValues {
+		scalarInferences := &emissionstypes.Inferences{
+			Inferences: []*emissionstypes.Inference{
+				{Inferer: worker1, Value: alloraMath.MustNewDecFromString(values[0])},
+				{Inferer: worker2, Value: alloraMath.MustNewDecFromString(values[1])},
+				{Inferer: worker3, Value: alloraMath.MustNewDecFromString(values[2])},
+			},
+		}
+		scalarBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(synthRequest(0, scalarInferences))
+		s.Require().NoError(err)
+		scalarBundle := scalarBuilder.CalcAndSetNetworkInferences().Build()
+
+		s.Require().True(scalarBundle.CombinedValue.Equal(vectorBundle.CombinedValues[element]))
+		s.Require().True(scalarBundle.NaiveValue.Equal(vectorBundle.NaiveValues[element]))
+		for i, value := range scalarBundle.InfererValues {
+			s.Require().Equal(value.Worker, vectorBundle.InfererValues[i].Worker)
+			s.Require().True(value.Value.Equal(vectorBundle.InfererValues[i].Values[element]))
+		}
+		for i, value := range scalarBundle.ForecasterValues {
+			s.Require().Equal(value.Worker, vectorBundle.ForecasterValues[i].Worker)
+			s.Require().True(value.Value.Equal(vectorBundle.ForecasterValues[i].Values[element]))
+		}
+		for i, value := range scalarBundle.OneOutInfererValues {
+			s.Require().Equal(value.Worker, vectorBundle.OneOutInfererValues[i].Worker)
+			s.Require().True(value.Value.Equal(vectorBundle.OneOutInfererValues[i].Values[element]))
+		}
+		for i, value := range scalarBundle.OneOutForecasterValues {
+			s.Require().Equal(value.Worker, vectorBundle.OneOutForecasterValues[i].Worker)
+			s.Require().True(value.Value.Equal(vectorBundle.OneOutForecasterValues[i].Values[element]))
+		}
+		for i, value := range scalarBundle.OneInForecasterValues {
+			s.Require().Equal(value.Worker, vectorBundle.OneInForecasterValues[i].Worker)
+			s.Require().True(value.Value.Equal(vectorBundle.OneInForecasterValues[i].Values[element]))
+		}
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesCategoricalTopicIsProbabilityDistribution() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+
+	worker1 := "worker1"
+	worker2 := "worker2"
+	worker3 := "worker3"
+
+	inferences := &emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{Inferer: worker1, Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.7"), alloraMath.MustNewDecFromString("0.2"), alloraMath.MustNewDecFromString("0.1")}},
+			{Inferer: worker2, Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.3"), alloraMath.MustNewDecFromString("0.3"), alloraMath.MustNewDecFromString("0.4")}},
+			{Inferer: worker3, Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.1"), alloraMath.MustNewDecFromString("0.8"), alloraMath.MustNewDecFromString("0.1")}},
+		},
+	}
+	forecasts := &emissionstypes.Forecasts{Forecasts: []*emissionstypes.Forecast{}}
+
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5")}, 0)
+	s.Require().NoError(err)
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.1")}, 0)
+	s.Require().NoError(err)
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("-0.2")}, 0)
+	s.Require().NoError(err)
+
+	networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
+		inferencesynthesis.SynthRequest{
+			Ctx:                 ctx,
+			K:                   k,
+			TopicId:             topicId,
+			InferenceDimension:  3,
+			Inferences:          inferences,
+			Forecasts:           forecasts,
+			NetworkCombinedLoss: alloraMath.MustNewDecFromString("0.5"),
+			Epsilon:             alloraMath.MustNewDecFromString("0.0001"),
+			PNorm:               alloraMath.MustNewDecFromString("2"),
+			CNorm:               alloraMath.MustNewDecFromString("0.75"),
+		},
+	)
+	s.Require().NoError(err)
+	valueBundle := networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
+
+	// The weighted ensemble of probability vectors is a probability vector
+	s.Require().Len(valueBundle.CombinedValues, 3)
+	sum := alloraMath.ZeroDec()
+	for _, probability := range valueBundle.CombinedValues {
+		s.Require().False(probability.IsNegative())
+		sum, err = sum.Add(probability)
+		s.Require().NoError(err)
+	}
+	s.Require().True(alloraMath.InDelta(alloraMath.OneDec(), sum, alloraMath.MustNewDecFromString("0.0000001")), sum.String())
+}
+
+func (s *InferenceSynthesisTestSuite) TestRobustAggregationStrategiesResistAdversarialInferer() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+
+	workers := []string{"worker1", "worker2", "worker3", "worker4", "attacker"}
+	values := []string{"100", "101", "102", "103", "1000000"}
+	inferences := &emissionstypes.Inferences{Inferences: make([]*emissionstypes.Inference, 0)}
+	for i, worker := range workers {
+		inferences.Inferences = append(inferences.Inferences, &emissionstypes.Inference{
+			Inferer: worker,
+			Value:   alloraMath.MustNewDecFromString(values[i]),
+		})
+	}
+	forecasts := &emissionstypes.Forecasts{Forecasts: []*emissionstypes.Forecast{}}
+
+	trimFraction := alloraMath.MustNewDecFromString("0.2")
+	strategies := []struct {
+		strategy emissionstypes.AggregationStrategy
+		expected string
+	}{
+		// The attacker drags the mean far away from the honest inferences
+		{emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN, "200081.2"},
+		{emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEDIAN, "102"},
+		// (101 + 102 + 103) / 3
+		{emissionstypes.AggregationStrategy_AGGREGATION_TRIMMED_MEAN, "102"},
+		// (101 + 101 + 102 + 103 + 103) / 5
+		{emissionstypes.AggregationStrategy_AGGREGATION_WINSORIZED_MEAN, "102"},
+	}
+
+	// All inferers new, then all with the same prior regret: either way the weights are equal
+	for _, withRegrets := range []bool{false, true} {
+		topicId := uint64(1)
+		if withRegrets {
+			topicId = 2
+			for _, worker := range workers {
+				err := k.SetInfererNetworkRegret(ctx, topicId, worker, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.1")}, 0)
+				s.Require().NoError(err)
+			}
+		}
+
+		for _, tc := range strategies {
+			fraction := alloraMath.ZeroDec()
+			if tc.strategy == emissionstypes.AggregationStrategy_AGGREGATION_TRIMMED_MEAN ||
+				tc.strategy == emissionstypes.AggregationStrategy_AGGREGATION_WINSORIZED_MEAN {
+				fraction = trimFraction
+			}
+			networkInferenceBuilder, err := inferencesynthesis.NewNetworkInferenceBuilderFromSynthRequest(
+				inferencesynthesis.SynthRequest{
+					Ctx:                     ctx,
+					K:                       k,
+					TopicId:                 topicId,
+					Inferences:              inferences,
+					Forecasts:               forecasts,
+					NetworkCombinedLoss:     alloraMath.MustNewDecFromString("1"),
+					Epsilon:                 alloraMath.MustNewDecFromString("0.0001"),
+					PNorm:                   alloraMath.MustNewDecFromString("3"),
+					CNorm:                   alloraMath.MustNewDecFromString("0.75"),
+					AggregationStrategy:     tc.strategy,
+					AggregationTrimFraction: fraction,
+				},
+			)
+			s.Require().NoError(err)
+			valueBundle := networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
+
+			expected := alloraMath.MustNewDecFromString(tc.expected)
+			tolerance := alloraMath.MustNewDecFromString("0.000001")
+			s.Require().True(alloraMath.InDelta(expected, valueBundle.CombinedValue, tolerance),
+				"%s combined value %s with regrets %v", tc.strategy, valueBundle.CombinedValue, withRegrets)
+			s.Require().True(alloraMath.InDelta(expected, valueBundle.NaiveValue, tolerance),
+				"%s naive value %s with regrets %v", tc.strategy, valueBundle.NaiveValue, withRegrets)
+		}
+	}
+}
@@ -50,6 +50,7 @@ func GetNetworkInferencesAtBlock(
 		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
 			Worker: infererence.Inferer,
 			Value:  infererence.Value,
+			Values: infererence.Values,
 		})
 	}
 
@@ -65,7 +66,7 @@ func GetNetworkInferencesAtBlock(
 	}
 
 	if len(inferences.Inferences) > 1 {
-		moduleParams, err := k.GetParams(ctx)
+		topicParams, err := k.GetEffectiveTopicParams(ctx, topicId)
 		if err != nil {
 			return nil, nil, infererWeights, forecasterWeights, err
 		}
@@ -88,12 +89,16 @@ func GetNetworkInferencesAtBlock(
 				Ctx:                 ctx,
 				K:                   k,
 				TopicId:             topicId,
+				InferenceDimension:  topic.InferenceDimension,
 				Inferences:          inferences,
 				Forecasts:           forecasts,
 				NetworkCombinedLoss: networkLosses.CombinedValue,
 				Epsilon:             topic.Epsilon,
 				PNorm:               topic.PNorm,
-				CNorm:               moduleParams.CNorm,
+				CNorm:               topicParams.CNorm,
+				// Robust aggregation of the topic, if any
+				AggregationStrategy:     topic.AggregationStrategy,
+				AggregationTrimFraction: topic.AggregationTrimFraction,
 			},
 		)
 		if err != nil {
@@ -111,16 +116,19 @@ func GetNetworkInferencesAtBlock(
 		singleInference := inferences.Inferences[0]
 
 		networkInferences = &emissions.ValueBundle{
-			TopicId:       topicId,
-			CombinedValue: singleInference.Value,
+			TopicId:        topicId,
+			CombinedValue:  singleInference.Value,
+			CombinedValues: singleInference.Values,
 			InfererValues: []*emissions.WorkerAttributedValue{
 				{
 					Worker: singleInference.Inferer,
 					Value:  singleInference.Value,
+					Values: singleInference.Values,
 				},
 			},
 			ForecasterValues:       []*emissions.WorkerAttributedValue{},
 			NaiveValue:             singleInference.Value,
+			NaiveValues:            singleInference.Values,
 			OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
 			OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
 			OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
@@ -161,6 +169,7 @@ func GetLatestNetworkInference(
 		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
 			Worker: infererence.Inferer,
 			Value:  infererence.Value,
+			Values: infererence.Values,
 		})
 	}
 
@@ -177,7 +186,7 @@ func GetLatestNetworkInference(
 	}
 
 	if len(inferences.Inferences) > 1 {
-		moduleParams, err := k.GetParams(ctx)
+		topicParams, err := k.GetEffectiveTopicParams(ctx, topicId)
 		if err != nil {
 			return networkInferences, nil, infererWeights, forecasterWeights, err
 		}
@@ -201,12 +210,16 @@ func GetLatestNetworkInference(
 				Ctx:                 ctx,
 				K:                   k,
 				TopicId:             topicId,
+				InferenceDimension:  topic.InferenceDimension,
 				Inferences:          inferences,
 				Forecasts:           forecasts,
 				NetworkCombinedLoss: networkLosses.CombinedValue,
 				Epsilon:             topic.Epsilon,
 				PNorm:               topic.PNorm,
-				CNorm:               moduleParams.CNorm,
+				CNorm:               topicParams.CNorm,
+				// Robust aggregation of the topic, if any
+				AggregationStrategy:     topic.AggregationStrategy,
+				AggregationTrimFraction: topic.AggregationTrimFraction,
 			},
 		)
 		if err != nil {
@@ -223,16 +236,19 @@ func GetLatestNetworkInference(
 		singleInference := inferences.Inferences[0]
 
 		networkInferences = &emissions.ValueBundle{
-			TopicId:       topicId,
-			CombinedValue: singleInference.Value,
+			TopicId:        topicId,
+			CombinedValue:  singleInference.Value,
+			CombinedValues: singleInference.Values,
 			InfererValues: []*emissions.WorkerAttributedValue{
 				{
 					Worker: singleInference.Inferer,
 					Value:  singleInference.Value,
+					Values: singleInference.Values,
 				},
 			},
 			ForecasterValues:       []*emissions.WorkerAttributedValue{},
 			NaiveValue:             singleInference.Value,
+			NaiveValues:            singleInference.Values,
 			OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
 			OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
 			OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
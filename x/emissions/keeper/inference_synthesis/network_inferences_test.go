@@ -268,6 +268,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 			topicId,
 			inferer,
 			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+			0,
 		)
 	}
 
@@ -284,6 +285,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 			topicId,
 			forecaster,
 			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+			0,
 		)
 	}
 
@@ -298,6 +300,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 				BlockHeight: blockHeight,
 				Value:       alloraMath.MustNewDecFromString(value),
 			},
+			0,
 		)
 	}
 
@@ -311,7 +314,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 	keeper.SetOneInForecasterSelfNetworkRegret(s.ctx, topicId, forecaster0, emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       epoch2Get("inference_regret_worker_5_onein_0"),
-	})
+	}, 0)
 
 	setOneInForecasterNetworkRegret(forecaster1, reputer0, epoch2Get("inference_regret_worker_0_onein_1").String())
 	setOneInForecasterNetworkRegret(forecaster1, reputer1, epoch2Get("inference_regret_worker_1_onein_1").String())
@@ -322,7 +325,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 	keeper.SetOneInForecasterSelfNetworkRegret(s.ctx, topicId, forecaster1, emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       epoch2Get("inference_regret_worker_5_onein_1"),
-	})
+	}, 0)
 
 	setOneInForecasterNetworkRegret(forecaster2, reputer0, epoch2Get("inference_regret_worker_0_onein_2").String())
 	setOneInForecasterNetworkRegret(forecaster2, reputer1, epoch2Get("inference_regret_worker_1_onein_2").String())
@@ -333,7 +336,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 	keeper.SetOneInForecasterSelfNetworkRegret(s.ctx, topicId, forecaster2, emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       epoch2Get("inference_regret_worker_5_onein_2"),
-	})
+	}, 0)
 
 	// Calculate
 	valueBundle, _, _, _, err :=
@@ -613,6 +616,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 			topicId,
 			inferer,
 			emissionstypes.TimestampedValue{BlockHeight: blockHeightInferences, Value: regret},
+			0,
 		)
 	}
 
@@ -629,6 +633,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 			topicId,
 			forecaster,
 			emissionstypes.TimestampedValue{BlockHeight: blockHeightInferences, Value: regret},
+			0,
 		)
 	}
 
@@ -643,6 +648,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 				BlockHeight: blockHeightInferences,
 				Value:       alloraMath.MustNewDecFromString(value),
 			},
+			0,
 		)
 	}
 
@@ -656,7 +662,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 	keeper.SetOneInForecasterSelfNetworkRegret(s.ctx, topicId, forecaster0, emissionstypes.TimestampedValue{
 		BlockHeight: blockHeightInferences,
 		Value:       epoch2Get("inference_regret_worker_5_onein_0"),
-	})
+	}, 0)
 
 	setOneInForecasterNetworkRegret(forecaster1, reputer0, epoch2Get("inference_regret_worker_0_onein_1").String())
 	setOneInForecasterNetworkRegret(forecaster1, reputer1, epoch2Get("inference_regret_worker_1_onein_1").String())
@@ -667,7 +673,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 	keeper.SetOneInForecasterSelfNetworkRegret(s.ctx, topicId, forecaster1, emissionstypes.TimestampedValue{
 		BlockHeight: blockHeightInferences,
 		Value:       epoch2Get("inference_regret_worker_5_onein_1"),
-	})
+	}, 0)
 
 	setOneInForecasterNetworkRegret(forecaster2, reputer0, epoch2Get("inference_regret_worker_0_onein_2").String())
 	setOneInForecasterNetworkRegret(forecaster2, reputer1, epoch2Get("inference_regret_worker_1_onein_2").String())
@@ -678,7 +684,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 	keeper.SetOneInForecasterSelfNetworkRegret(s.ctx, topicId, forecaster2, emissionstypes.TimestampedValue{
 		BlockHeight: blockHeightInferences,
 		Value:       epoch2Get("inference_regret_worker_5_onein_2"),
-	})
+	}, 0)
 
 	// Calculate
 	valueBundle, _, _, _, err :=
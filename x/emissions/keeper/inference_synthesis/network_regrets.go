@@ -94,6 +94,13 @@ func GetCalcSetNetworkRegrets(
 	nonce emissions.Nonce,
 	alpha alloraMath.Dec,
 ) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Error getting module params")
+	}
+	// Read once, as every regret set below is appended to its history
+	maxRegretHistoryLength := moduleParams.MaxRegretHistoryLength
+
 	// Convert the network losses to a networkLossesByWorker
 	networkLossesByWorker := ConvertValueBundleToNetworkLossesByWorker(networkLosses)
 	blockHeight := nonce.BlockHeight
@@ -118,7 +125,10 @@ func GetCalcSetNetworkRegrets(
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building inferer regret")
 		}
-		k.SetInfererNetworkRegret(ctx, topicId, infererLoss.Worker, newInfererRegret)
+		err = k.SetInfererNetworkRegret(ctx, topicId, infererLoss.Worker, newInfererRegret, maxRegretHistoryLength)
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error setting inferer regret")
+		}
 	}
 
 	// Get old regret R_{i-1,k} and Calculate then Set the new regrets R_ik for forecasters
@@ -141,7 +151,10 @@ func GetCalcSetNetworkRegrets(
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building forecaster regret")
 		}
-		k.SetForecasterNetworkRegret(ctx, topicId, forecasterLoss.Worker, newForecasterRegret)
+		err = k.SetForecasterNetworkRegret(ctx, topicId, forecasterLoss.Worker, newForecasterRegret, maxRegretHistoryLength)
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error setting forecaster regret")
+		}
 	}
 
 	// Calculate the new one-in regrets for the forecasters R^+_ij'k where j' includes all j and forecast implied inference from forecaster k
@@ -166,7 +179,10 @@ func GetCalcSetNetworkRegrets(
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error computing and building one-in forecaster regret")
 			}
-			k.SetOneInForecasterNetworkRegret(ctx, topicId, oneInForecasterLoss.Worker, infererLoss.Worker, newOneInForecasterRegret)
+			err = k.SetOneInForecasterNetworkRegret(ctx, topicId, oneInForecasterLoss.Worker, infererLoss.Worker, newOneInForecasterRegret, maxRegretHistoryLength)
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error setting one-in forecaster regret")
+			}
 		}
 		// Self-regret for the forecaster given their own regret
 		lastRegret, noPriorRegret, err := k.GetOneInForecasterSelfNetworkRegret(ctx, topicId, oneInForecasterLoss.Worker)
@@ -184,7 +200,10 @@ func GetCalcSetNetworkRegrets(
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building one-in forecaster self regret")
 		}
-		k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, oneInForecasterLoss.Worker, oneInForecasterSelfRegret)
+		err = k.SetOneInForecasterSelfNetworkRegret(ctx, topicId, oneInForecasterLoss.Worker, oneInForecasterSelfRegret, maxRegretHistoryLength)
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error setting one-in forecaster self regret")
+		}
 	}
 
 	return nil
@@ -108,14 +108,14 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsTwoWorkers() {
 		Value:       alloraMath.MustNewDecFromString("200"),
 	}
 
-	k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue)
-	k.SetInfererNetworkRegret(s.ctx, topicId, worker2, timestampedValue)
-	k.SetForecasterNetworkRegret(s.ctx, topicId, worker1, timestampedValue)
-	k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker1, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker2, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker1, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker2, timestampedValue)
+	k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue, 0)
+	k.SetInfererNetworkRegret(s.ctx, topicId, worker2, timestampedValue, 0)
+	k.SetForecasterNetworkRegret(s.ctx, topicId, worker1, timestampedValue, 0)
+	k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker1, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker2, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker1, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker2, timestampedValue, 0)
 
 	err := inference_synthesis.GetCalcSetNetworkRegrets(
 		s.ctx,
@@ -213,25 +213,25 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsThreeWorkers()
 		Value:       alloraMath.MustNewDecFromString("200"),
 	}
 
-	k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue)
-	k.SetInfererNetworkRegret(s.ctx, topicId, worker2, timestampedValue)
-	k.SetInfererNetworkRegret(s.ctx, topicId, worker3, timestampedValue)
+	k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue, 0)
+	k.SetInfererNetworkRegret(s.ctx, topicId, worker2, timestampedValue, 0)
+	k.SetInfererNetworkRegret(s.ctx, topicId, worker3, timestampedValue, 0)
 
-	k.SetForecasterNetworkRegret(s.ctx, topicId, worker1, timestampedValue)
-	k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue)
-	k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue)
+	k.SetForecasterNetworkRegret(s.ctx, topicId, worker1, timestampedValue, 0)
+	k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue, 0)
+	k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue, 0)
 
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker1, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker2, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker3, timestampedValue)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker1, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker2, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker1, worker3, timestampedValue, 0)
 
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker1, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker2, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker3, timestampedValue)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker1, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker2, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker2, worker3, timestampedValue, 0)
 
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker3, worker1, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker3, worker2, timestampedValue)
-	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker3, worker3, timestampedValue)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker3, worker1, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker3, worker2, timestampedValue, 0)
+	k.SetOneInForecasterNetworkRegret(s.ctx, topicId, worker3, worker3, timestampedValue, 0)
 
 	err := inference_synthesis.GetCalcSetNetworkRegrets(
 		s.ctx,
@@ -336,13 +336,13 @@ func (s *InferenceSynthesisTestSuite) TestHigherLossesLowerRegret() {
 			Value:       alloraMath.MustNewDecFromString("0.3"),
 		}
 
-		k.SetInfererNetworkRegret(s.ctx, topicId, worker0, timestampedValue0_1)
-		k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue0_2)
-		k.SetInfererNetworkRegret(s.ctx, topicId, worker2, timestampedValue0_3)
+		k.SetInfererNetworkRegret(s.ctx, topicId, worker0, timestampedValue0_1, 0)
+		k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue0_2, 0)
+		k.SetInfererNetworkRegret(s.ctx, topicId, worker2, timestampedValue0_3, 0)
 
-		k.SetForecasterNetworkRegret(s.ctx, topicId, worker0, timestampedValue0_1)
-		k.SetForecasterNetworkRegret(s.ctx, topicId, worker1, timestampedValue0_2)
-		k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue0_3)
+		k.SetForecasterNetworkRegret(s.ctx, topicId, worker0, timestampedValue0_1, 0)
+		k.SetForecasterNetworkRegret(s.ctx, topicId, worker1, timestampedValue0_2, 0)
+		k.SetForecasterNetworkRegret(s.ctx, topicId, worker2, timestampedValue0_3, 0)
 	}
 
 	// Test 0
@@ -427,3 +427,52 @@ func (s *InferenceSynthesisTestSuite) TestHigherLossesLowerRegret() {
 	require.Equal(forecasterRegret0_1.Value, forecasterRegret1_1.Value)
 	require.Equal(forecasterRegret0_2.Value, forecasterRegret1_2.Value)
 }
+
+func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsKeepsRegretHistoriesOfTheMaxLength() {
+	require := s.Require()
+	k := s.emissionsKeeper
+	topicId := uint64(1)
+	worker := "worker1"
+
+	params, err := k.GetParams(s.ctx)
+	require.NoError(err)
+	params.MaxRegretHistoryLength = 2
+	require.NoError(k.SetParams(s.ctx, params))
+
+	valueBundle := types.ValueBundle{
+		CombinedValue: alloraMath.MustNewDecFromString("500"),
+		InfererValues: []*types.WorkerAttributedValue{
+			{Worker: worker, Value: alloraMath.MustNewDecFromString("200")},
+		},
+		ForecasterValues: []*types.WorkerAttributedValue{
+			{Worker: worker, Value: alloraMath.MustNewDecFromString("200")},
+		},
+		OneInForecasterValues: []*types.WorkerAttributedValue{
+			{Worker: worker, Value: alloraMath.MustNewDecFromString("200")},
+		},
+	}
+	for blockHeight := int64(1); blockHeight <= 3; blockHeight++ {
+		err = inference_synthesis.GetCalcSetNetworkRegrets(
+			s.ctx,
+			k,
+			topicId,
+			valueBundle,
+			types.Nonce{BlockHeight: blockHeight},
+			alloraMath.MustNewDecFromString("0.1"),
+		)
+		require.NoError(err)
+	}
+
+	for _, role := range []types.RegretRole{
+		types.RegretRole_REGRET_ROLE_INFERER,
+		types.RegretRole_REGRET_ROLE_FORECASTER,
+		types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER,
+		types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER_SELF,
+	} {
+		regrets, _, err := k.GetRegretHistory(s.ctx, topicId, role, worker, worker, nil)
+		require.NoError(err)
+		require.Len(regrets, 2, role)
+		require.Equal(int64(2), regrets[0].BlockHeight, role)
+		require.Equal(int64(3), regrets[1].BlockHeight, role)
+	}
+}
@@ -110,6 +110,7 @@ func (p SynthPalette) Clone() SynthPalette {
 		K:                                p.K,
 		Logger:                           p.Logger,
 		TopicId:                          p.TopicId,
+		InferenceDimension:               p.InferenceDimension,
 		Inferers:                         append([]Worker(nil), p.Inferers...),
 		InferenceByWorker:                inferenceByWorker,
 		InfererRegrets:                   infererRegrets,
@@ -123,5 +124,7 @@ func (p SynthPalette) Clone() SynthPalette {
 		Epsilon:                          p.Epsilon,
 		PNorm:                            p.PNorm,
 		CNorm:                            p.CNorm,
+		AggregationStrategy:              p.AggregationStrategy,
+		AggregationTrimFraction:          p.AggregationTrimFraction,
 	}
 }
@@ -0,0 +1,34 @@
+package inference_synthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Projects the palette of a vector topic onto one element of its inferences, turning it into
+// the palette of a scalar topic. Weights only depend on regrets and forecasted losses, never on
+// inference values, so synthesizing each projection yields the element-wise network inferences.
+func (p SynthPalette) ElementPalette(element int) (SynthPalette, error) {
+	palette := p.Clone()
+	palette.InferenceDimension = 0
+	for worker, inference := range palette.InferenceByWorker {
+		if element >= len(inference.Values) {
+			return SynthPalette{}, errorsmod.Wrapf(
+				emissions.ErrInferenceDimensionMismatch,
+				"inference of %s has %d values, cannot project onto element %d", worker, len(inference.Values), element,
+			)
+		}
+		inference.Value = inference.Values[element]
+		inference.Values = nil
+	}
+
+	// Forecast-implied inferences depend on the inference values, so recalculate them for this element
+	paletteCopy := palette.Clone()
+	err := paletteCopy.UpdateForecastImpliedInferences()
+	if err != nil {
+		return SynthPalette{}, errorsmod.Wrapf(err, "Error calculating forecast-implied inferences of element %d", element)
+	}
+	palette.ForecastImpliedInferenceByWorker = paletteCopy.ForecastImpliedInferenceByWorker
+
+	return palette, nil
+}
@@ -17,6 +17,7 @@ func (f *SynthPaletteFactory) BuildPaletteFromRequest(req SynthRequest) (SynthPa
 		K:                                req.K,
 		Logger:                           Logger(req.Ctx),
 		TopicId:                          req.TopicId,
+		InferenceDimension:               req.InferenceDimension,
 		Inferers:                         sortedInferers,
 		InferenceByWorker:                inferenceByWorker,
 		InfererRegrets:                   make(map[string]*StatefulRegret), // Populated below
@@ -30,6 +31,8 @@ func (f *SynthPaletteFactory) BuildPaletteFromRequest(req SynthRequest) (SynthPa
 		Epsilon:                          req.Epsilon,
 		PNorm:                            req.PNorm,
 		CNorm:                            req.CNorm,
+		AggregationStrategy:              req.AggregationStrategy,
+		AggregationTrimFraction:          req.AggregationTrimFraction,
 	}
 
 	// Populates: infererRegrets, forecasterRegrets, allInferersAreNew
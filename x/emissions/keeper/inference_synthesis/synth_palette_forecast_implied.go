@@ -22,114 +22,131 @@ func (p *SynthPalette) CalcForecastImpliedInferences() (map[Worker]*emissionstyp
 	// For each forecast, and for each forecast element, calculate forecast-implied inferences I_ik
 	I_i := make(map[Worker]*emissionstypes.Inference, len(p.Forecasters))
 	for _, forecaster := range p.Forecasters {
-		if p.ForecastByWorker[forecaster] != nil && len(p.ForecastByWorker[forecaster].ForecastElements) > 0 {
-			// Filter away all forecast elements that do not have an associated inference (match by worker)
-			// Will effectively set weight in formulas for forcast-implied inference I_ik and network inference I_i to 0 for forecasts without inferences
-			// Map inferer -> forecast element => only one (latest in array) forecast element per inferer
-			forecastElementsByInferer := make(map[Worker]*emissionstypes.ForecastElement, 0)
-			sortedInferersInForecast := make([]Worker, 0)
-			for _, el := range p.ForecastByWorker[forecaster].ForecastElements {
-				if _, ok := p.InferenceByWorker[el.Inferer]; ok {
-					// Check that there is an inference for the worker forecasted before including the forecast element
-					// otherwise the max value below will be incorrect.
-					forecastElementsByInferer[el.Inferer] = el
-					sortedInferersInForecast = append(sortedInferersInForecast, el.Inferer)
-				}
-			}
+		forecastImpliedInference, err := p.calcForecastImpliedInference(forecaster)
+		if err != nil {
+			return nil, err
+		}
+		if forecastImpliedInference != nil {
+			I_i[forecaster] = forecastImpliedInference
+		}
+	}
+
+	return I_i, nil
+}
 
-			weightSum := alloraMath.ZeroDec()                 // denominator in calculation of forecast-implied inferences
-			weightInferenceDotProduct := alloraMath.ZeroDec() // numerator in calculation of forecast-implied inferences
-			err := error(nil)
-
-			// Calculate the forecast-implied inferences I_ik
-			if p.InferersNewStatus == InferersAllNew {
-				// If all inferers are new, take regular average of inferences
-				// This means that forecasters won't be able to influence the network inference when all inferers are new
-				// However this seeds losses for forecasters for future rounds
-
-				for _, inferer := range sortedInferersInForecast {
-					if p.InferenceByWorker[inferer] != nil {
-						weightInferenceDotProduct, err = weightInferenceDotProduct.Add(p.InferenceByWorker[inferer].Value)
-						if err != nil {
-							return nil, errorsmod.Wrapf(err, "error adding dot product")
-						}
-						weightSum, err = weightSum.Add(alloraMath.OneDec())
-						if err != nil {
-							return nil, errorsmod.Wrapf(err, "error adding weight")
-						}
-					}
+// Calculate the forecast-implied inference I_ik of a single forecaster, see CalcForecastImpliedInferences.
+// Returns nil if none of the forecast elements has an associated inference.
+// Only depends on the inferences of the forecasted inferers and on whether all inferers are new.
+func (p *SynthPalette) calcForecastImpliedInference(forecaster Worker) (*emissionstypes.Inference, error) {
+	if p.ForecastByWorker[forecaster] == nil || len(p.ForecastByWorker[forecaster].ForecastElements) == 0 {
+		return nil, nil
+	}
+
+	// Filter away all forecast elements that do not have an associated inference (match by worker)
+	// Will effectively set weight in formulas for forcast-implied inference I_ik and network inference I_i to 0 for forecasts without inferences
+	// Map inferer -> forecast element => only one (latest in array) forecast element per inferer
+	forecastElementsByInferer := make(map[Worker]*emissionstypes.ForecastElement, 0)
+	sortedInferersInForecast := make([]Worker, 0)
+	for _, el := range p.ForecastByWorker[forecaster].ForecastElements {
+		if _, ok := p.InferenceByWorker[el.Inferer]; ok {
+			// Check that there is an inference for the worker forecasted before including the forecast element
+			// otherwise the max value below will be incorrect.
+			forecastElementsByInferer[el.Inferer] = el
+			sortedInferersInForecast = append(sortedInferersInForecast, el.Inferer)
+		}
+	}
+
+	weightSum := alloraMath.ZeroDec()                 // denominator in calculation of forecast-implied inferences
+	weightInferenceDotProduct := alloraMath.ZeroDec() // numerator in calculation of forecast-implied inferences
+	err := error(nil)
+
+	// Calculate the forecast-implied inferences I_ik
+	if p.InferersNewStatus == InferersAllNew {
+		// If all inferers are new, take regular average of inferences
+		// This means that forecasters won't be able to influence the network inference when all inferers are new
+		// However this seeds losses for forecasters for future rounds
+
+		for _, inferer := range sortedInferersInForecast {
+			if p.InferenceByWorker[inferer] != nil {
+				weightInferenceDotProduct, err = weightInferenceDotProduct.Add(p.InferenceByWorker[inferer].Value)
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "error adding dot product")
 				}
-			} else {
-				// If not all inferers are new, calculate forecast-implied inferences using the previous inferer regrets and previous network loss
-
-				// Approximate forecast regrets of the network inference
-				// Map inferer -> regret
-				R_ik := make(map[Worker]*StatefulRegret, len(forecastElementsByInferer))
-				// Forecast-regret-informed weights dot product with inferences to yield forecast-implied inferences
-				// Map inferer -> weight
-				w_ik := make(map[Worker]Weight, len(forecastElementsByInferer))
-
-				// Define variable to store maximum regret for forecast k
-				// `j` is the inferer id. The nomenclature of `j` comes from the corresponding regret formulas in the litepaper
-				for _, j := range sortedInferersInForecast {
-					// Calculate the approximate forecast regret of the network inference
-					R_ijk, err := p.NetworkCombinedLoss.Sub(forecastElementsByInferer[j].Value)
-					if err != nil {
-						return nil, errorsmod.Wrapf(err, "error calculating network loss per value")
-					}
-					R_ik[j] = &StatefulRegret{regret: R_ijk, noPriorRegret: false}
+				weightSum, err = weightSum.Add(alloraMath.OneDec())
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "error adding weight")
 				}
+			}
+		}
+	} else {
+		// If not all inferers are new, calculate forecast-implied inferences using the previous inferer regrets and previous network loss
+
+		// Approximate forecast regrets of the network inference
+		// Map inferer -> regret
+		R_ik := make(map[Worker]*StatefulRegret, len(forecastElementsByInferer))
+		// Forecast-regret-informed weights dot product with inferences to yield forecast-implied inferences
+		// Map inferer -> weight
+		w_ik := make(map[Worker]Weight, len(forecastElementsByInferer))
+
+		// Define variable to store maximum regret for forecast k
+		// `j` is the inferer id. The nomenclature of `j` comes from the corresponding regret formulas in the litepaper
+		for _, j := range sortedInferersInForecast {
+			// Calculate the approximate forecast regret of the network inference
+			R_ijk, err := p.NetworkCombinedLoss.Sub(forecastElementsByInferer[j].Value)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "error calculating network loss per value")
+			}
+			R_ik[j] = &StatefulRegret{regret: R_ijk, noPriorRegret: false}
+		}
 
-				if len(sortedInferersInForecast) > 1 {
-					p.InfererRegrets = R_ik
-					p.ForecasterRegrets = make(map[string]*StatefulRegret, 0)
-
-					weights, err := p.CalcWeightsGivenWorkers()
-					if err != nil {
-						return nil, errorsmod.Wrapf(err, "error calculating normalized forecasted regrets")
-					}
-					w_ik = weights.inferers
-				} else if len(sortedInferersInForecast) == 1 {
-					weights := make(map[Worker]Weight, 1)
-					weights[sortedInferersInForecast[0]] = alloraMath.OneDec()
-					w_ik = weights
-				}
+		if len(sortedInferersInForecast) > 1 {
+			// Weigh the forecasted regrets on a shallow copy, leaving the regrets of the palette untouched
+			forecastPalette := *p
+			forecastPalette.InfererRegrets = R_ik
+			forecastPalette.ForecasterRegrets = make(map[string]*StatefulRegret, 0)
 
-				// Calculate the forecast-implied inferences I_ik
-				for _, j := range sortedInferersInForecast {
-					w_ijk := w_ik[j]
-					if p.InferenceByWorker[j] != nil && !(w_ijk.Equal(alloraMath.ZeroDec())) {
-						thisDotProduct, err := w_ijk.Mul(p.InferenceByWorker[j].Value)
-						if err != nil {
-							return nil, errorsmod.Wrapf(err, "error calculating dot product")
-						}
-						weightInferenceDotProduct, err = weightInferenceDotProduct.Add(thisDotProduct)
-						if err != nil {
-							return nil, errorsmod.Wrapf(err, "error adding dot product")
-						}
-						weightSum, err = weightSum.Add(w_ijk)
-						if err != nil {
-							return nil, errorsmod.Wrapf(err, "error adding weight")
-						}
-					}
-				}
+			weights, err := forecastPalette.CalcWeightsGivenWorkers()
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "error calculating normalized forecasted regrets")
 			}
+			w_ik = weights.inferers
+		} else if len(sortedInferersInForecast) == 1 {
+			weights := make(map[Worker]Weight, 1)
+			weights[sortedInferersInForecast[0]] = alloraMath.OneDec()
+			w_ik = weights
+		}
 
-			if !weightSum.Equal(alloraMath.ZeroDec()) {
-				forecastValue, err := weightInferenceDotProduct.Quo(weightSum)
+		// Calculate the forecast-implied inferences I_ik
+		for _, j := range sortedInferersInForecast {
+			w_ijk := w_ik[j]
+			if p.InferenceByWorker[j] != nil && !(w_ijk.Equal(alloraMath.ZeroDec())) {
+				thisDotProduct, err := w_ijk.Mul(p.InferenceByWorker[j].Value)
 				if err != nil {
-					return nil, errorsmod.Wrapf(err, "error calculating forecast value")
+					return nil, errorsmod.Wrapf(err, "error calculating dot product")
 				}
-				forecastImpliedInference := emissionstypes.Inference{
-					Inferer: forecaster,
-					Value:   forecastValue,
+				weightInferenceDotProduct, err = weightInferenceDotProduct.Add(thisDotProduct)
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "error adding dot product")
+				}
+				weightSum, err = weightSum.Add(w_ijk)
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "error adding weight")
 				}
-				I_i[forecaster] = &forecastImpliedInference
 			}
 		}
 	}
 
-	return I_i, nil
+	if weightSum.Equal(alloraMath.ZeroDec()) {
+		return nil, nil
+	}
+	forecastValue, err := weightInferenceDotProduct.Quo(weightSum)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "error calculating forecast value")
+	}
+	return &emissionstypes.Inference{
+		Inferer: forecaster,
+		Value:   forecastValue,
+	}, nil
 }
 
 // Calculate the forecast-implied inferences I_ik given inferences, forecasts and network losses.
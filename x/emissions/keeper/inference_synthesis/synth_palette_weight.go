@@ -12,23 +12,24 @@ import (
 // Given the current set of inferers and forecasters in the palette, calculate their
 // weights using the current regrets
 func (p *SynthPalette) CalcWeightsGivenWorkers() (RegretInformedWeights, error) {
-	var regrets []alloraMath.Dec
-	infererRegrets := p.GetInfererRegretsSlice()
-	forecasterRegrets := p.GetForecasterRegretsSlice()
-
-	if len(infererRegrets) > 0 {
-		regrets = append(regrets, infererRegrets...)
-	}
-	if len(forecasterRegrets) > 0 {
-		regrets = append(regrets, forecasterRegrets...)
+	regretSum, err := alloraMath.Sum(p.GetRegretsSlice())
+	if err != nil {
+		return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error summing regrets")
 	}
+	return p.CalcWeightsGivenRegretSum(regretSum)
+}
+
+// Calculates the weights of the current inferers and forecasters in the palette given the sum
+// of their regrets, which callers withholding one worker at a time take out of the sum of all regrets
+func (p *SynthPalette) CalcWeightsGivenRegretSum(regretSum alloraMath.Dec) (RegretInformedWeights, error) {
+	regrets := p.GetRegretsSlice()
 	if len(regrets) == 0 {
 		return RegretInformedWeights{}, errorsmod.Wrapf(emissionstypes.ErrEmptyArray, "No regrets to calculate weights")
 	}
 
 	// Calc std dev of regrets + epsilon
 	// σ(R_ijk) + ε
-	stdDevRegrets, err := alloraMath.StdDev(regrets)
+	stdDevRegrets, err := alloraMath.StdDevGivenSum(regrets, regretSum)
 	if err != nil {
 		return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error calculating standard deviation of regrets")
 	}
@@ -86,6 +87,11 @@ func (p *SynthPalette) CalcWeightsGivenWorkers() (RegretInformedWeights, error)
 	infererWeights := make(map[Worker]Weight)
 	forecasterWeights := make(map[Worker]Weight)
 	if p.InferersNewStatus != InferersAllNewExceptOne {
+		bounds, err := newRegretNormalizationBounds(p.PNorm, p.CNorm)
+		if err != nil {
+			return RegretInformedWeights{}, err
+		}
+
 		// Calculate the weights from the normalized regrets
 		for _, worker := range p.Inferers {
 			regretInfo, ok := p.InfererRegrets[worker]
@@ -96,7 +102,7 @@ func (p *SynthPalette) CalcWeightsGivenWorkers() (RegretInformedWeights, error)
 			// If there is more than one not-new inferer, calculate the weight for the ones that are not new
 			var infererWeight = alloraMath.ZeroDec()
 			if !regretInfo.noPriorRegret {
-				infererWeight, err = CalcWeightFromNormalizedRegret(normalizedInfererRegrets[worker], maxRegret, p.PNorm, p.CNorm)
+				infererWeight, err = calcWeightFromNormalizedRegret(normalizedInfererRegrets[worker], maxRegret, p.PNorm, p.CNorm, bounds)
 				if err != nil {
 					return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error calculating inferer weight")
 				}
@@ -113,7 +119,7 @@ func (p *SynthPalette) CalcWeightsGivenWorkers() (RegretInformedWeights, error)
 				}
 				var forecasterWeight = alloraMath.ZeroDec()
 				if !regretInfo.noPriorRegret {
-					forecasterWeight, err = CalcWeightFromNormalizedRegret(normalizedForecasterRegrets[worker], maxRegret, p.PNorm, p.CNorm)
+					forecasterWeight, err = calcWeightFromNormalizedRegret(normalizedForecasterRegrets[worker], maxRegret, p.PNorm, p.CNorm, bounds)
 					if err != nil {
 						return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error calculating forecaster weight")
 					}
@@ -135,6 +141,10 @@ func (p *SynthPalette) CalcWeightsGivenWorkers() (RegretInformedWeights, error)
 // \hatR_i-1,l = R_i-1,l / |max_{l'}(R_i-1,l')|
 // given inferences, forecast-implied inferences, and network regrets
 func (p *SynthPalette) CalcWeightedInference(weights RegretInformedWeights) (InferenceValue, error) {
+	if p.AggregationStrategy != emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN {
+		return p.calcRobustWeightedInference(weights)
+	}
+
 	runningUnnormalizedI_i := alloraMath.ZeroDec()
 	sumWeights := alloraMath.ZeroDec()
 	err := error(nil)
@@ -232,6 +242,76 @@ func (p *SynthPalette) CalcWeightedInference(weights RegretInformedWeights) (Inf
 	return ret, nil
 }
 
+// Combines the same weighted inferences as the regret-weighted mean of CalcWeightedInference with
+// an aggregator less sensitive to outliers, as selected by the topic
+func (p *SynthPalette) calcRobustWeightedInference(weights RegretInformedWeights) (InferenceValue, error) {
+	values, valueWeights := p.collectWeightedValues(weights)
+	var ret InferenceValue
+	var err error
+	switch p.AggregationStrategy {
+	case emissionstypes.AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEDIAN:
+		ret, err = alloraMath.WeightedMedian(values, valueWeights)
+	case emissionstypes.AggregationStrategy_AGGREGATION_TRIMMED_MEAN:
+		ret, err = alloraMath.WeightedTrimmedMean(values, valueWeights, p.AggregationTrimFraction)
+	case emissionstypes.AggregationStrategy_AGGREGATION_WINSORIZED_MEAN:
+		ret, err = alloraMath.WeightedWinsorizedMean(values, valueWeights, p.AggregationTrimFraction)
+	default:
+		return InferenceValue{}, errorsmod.Wrapf(emissionstypes.ErrInvalidAggregationStrategy, "%v", p.AggregationStrategy)
+	}
+	if err != nil {
+		return InferenceValue{}, errorsmod.Wrapf(err, "Error aggregating network inference with %v", p.AggregationStrategy)
+	}
+	return ret, nil
+}
+
+// Collects the inferences and forecast-implied inferences that enter the network inference along
+// with their weights, following the same rules as the regret-weighted mean
+func (p *SynthPalette) collectWeightedValues(weights RegretInformedWeights) ([]alloraMath.Dec, []alloraMath.Dec) {
+	values := make([]alloraMath.Dec, 0, len(p.Inferers)+len(p.Forecasters))
+	valueWeights := make([]alloraMath.Dec, 0, len(p.Inferers)+len(p.Forecasters))
+
+	// If all inferers are new, then the weight is 1 for all inferers
+	if p.InferersNewStatus == InferersAllNew {
+		for _, inferer := range p.Inferers {
+			values = append(values, p.InferenceByWorker[inferer].Value)
+			valueWeights = append(valueWeights, alloraMath.OneDec())
+		}
+		return values, valueWeights
+	}
+	// If there is only one not-new inferer, then just consider that inferer
+	if p.InferersNewStatus == InferersAllNewExceptOne {
+		values = append(values, p.InferenceByWorker[p.SingleNotNewInferer].Value)
+		valueWeights = append(valueWeights, alloraMath.OneDec())
+		return values, valueWeights
+	}
+
+	// Workers without prior regret, without weight or without inference are left out
+	include := func(inference *emissionstypes.Inference, weight alloraMath.Dec, hasWeight bool, regret *StatefulRegret) {
+		if inference == nil || !hasWeight || regret == nil || regret.noPriorRegret {
+			return
+		}
+		if weight.IsNaN() || weight.Equal(alloraMath.ZeroDec()) {
+			return
+		}
+		values = append(values, inference.Value)
+		valueWeights = append(valueWeights, weight)
+	}
+	for _, inferer := range p.Inferers {
+		weight, ok := weights.inferers[inferer]
+		include(p.InferenceByWorker[inferer], weight, ok, p.InfererRegrets[inferer])
+	}
+	for _, forecaster := range p.Forecasters {
+		weight, ok := weights.forecasters[forecaster]
+		include(p.ForecastImpliedInferenceByWorker[forecaster], weight, ok, p.ForecasterRegrets[forecaster])
+	}
+	return values, valueWeights
+}
+
+// Regrets of the current inferers followed by those of the current forecasters
+func (p *SynthPalette) GetRegretsSlice() []alloraMath.Dec {
+	return append(p.GetInfererRegretsSlice(), p.GetForecasterRegretsSlice()...)
+}
+
 func (p *SynthPalette) GetInfererRegretsSlice() []alloraMath.Dec {
 	var regrets []alloraMath.Dec
 	if len(p.InfererRegrets) == 0 {
@@ -381,55 +461,86 @@ func AccumulateWeights(
 	return runningUnnormalizedI_i, sumWeights, nil
 }
 
-func CalcWeightFromNormalizedRegret(
-	normalizedRegret alloraMath.Dec,
-	maxNormalizedRegret alloraMath.Dec,
-	pNorm alloraMath.Dec,
-	cNorm alloraMath.Dec,
-) (alloraMath.Dec, error) {
+// Bounds of the normalized regrets, which only depend on p and c and so are shared by all weights
+type regretNormalizationBounds struct {
+	cPlus6Point75OverP   alloraMath.Dec
+	cMinus8Point25OverP  alloraMath.Dec
+	cMinus17Point25OverP alloraMath.Dec
+}
+
+func newRegretNormalizationBounds(pNorm alloraMath.Dec, cNorm alloraMath.Dec) (regretNormalizationBounds, error) {
 	// upper bound: c + 6.75 / p
 	v6Point75OverP, err := alloraMath.MustNewDecFromString("6.75").Quo(pNorm)
 	if err != nil {
-		return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating upper bound for regret normalization")
+		return regretNormalizationBounds{}, errorsmod.Wrapf(err, "Error calculating upper bound for regret normalization")
 	}
 	cPlus6Point75OverP, err := cNorm.Add(v6Point75OverP)
 	if err != nil {
-		return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating upper bound for regret normalization")
+		return regretNormalizationBounds{}, errorsmod.Wrapf(err, "Error calculating upper bound for regret normalization")
 	}
 
 	// lower bound: c - 8.25 / p
 	v8Point25OverP, err := alloraMath.MustNewDecFromString("8.25").Quo(pNorm)
 	if err != nil {
-		return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating lower bound for regret normalization")
+		return regretNormalizationBounds{}, errorsmod.Wrapf(err, "Error calculating lower bound for regret normalization")
 	}
 	cMinus8Point25OverP, err := cNorm.Sub(v8Point25OverP)
 	if err != nil {
-		return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating lower bound for regret normalization")
+		return regretNormalizationBounds{}, errorsmod.Wrapf(err, "Error calculating lower bound for regret normalization")
 	}
 
 	// threshold for zero weight: c - 17.25 / p
 	v17Point25OverP, err := alloraMath.MustNewDecFromString("17.25").Quo(pNorm)
 	if err != nil {
-		return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating lower bound for regret normalization")
+		return regretNormalizationBounds{}, errorsmod.Wrapf(err, "Error calculating lower bound for regret normalization")
 	}
 	cMinus17Point25OverP, err := cNorm.Sub(v17Point25OverP)
 	if err != nil {
-		return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error calculating lower threshold for zero weight")
+		return regretNormalizationBounds{}, errorsmod.Wrapf(err, "Error calculating lower threshold for zero weight")
+	}
+
+	return regretNormalizationBounds{
+		cPlus6Point75OverP:   cPlus6Point75OverP,
+		cMinus8Point25OverP:  cMinus8Point25OverP,
+		cMinus17Point25OverP: cMinus17Point25OverP,
+	}, nil
+}
+
+func CalcWeightFromNormalizedRegret(
+	normalizedRegret alloraMath.Dec,
+	maxNormalizedRegret alloraMath.Dec,
+	pNorm alloraMath.Dec,
+	cNorm alloraMath.Dec,
+) (alloraMath.Dec, error) {
+	bounds, err := newRegretNormalizationBounds(pNorm, cNorm)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
 	}
+	return calcWeightFromNormalizedRegret(normalizedRegret, maxNormalizedRegret, pNorm, cNorm, bounds)
+}
+
+func calcWeightFromNormalizedRegret(
+	normalizedRegret alloraMath.Dec,
+	maxNormalizedRegret alloraMath.Dec,
+	pNorm alloraMath.Dec,
+	cNorm alloraMath.Dec,
+	bounds regretNormalizationBounds,
+) (alloraMath.Dec, error) {
+	err := error(nil)
 
 	// Cap the normalized regrets at an upper value
 	// regretFrac = min(regretFrac, c + 6.75 / p)
-	if normalizedRegret.Gt(cPlus6Point75OverP) {
-		normalizedRegret = cPlus6Point75OverP
+	if normalizedRegret.Gt(bounds.cPlus6Point75OverP) {
+		normalizedRegret = bounds.cPlus6Point75OverP
 	}
 
 	// if max(regretFrac) < c - 8.25 / p, then regretFrac = regretFrac - max(regretFrac) + (c - 8.25 / p)
-	if maxNormalizedRegret.Lt(cMinus8Point25OverP) {
+	if maxNormalizedRegret.Lt(bounds.cMinus8Point25OverP) {
 		normalizedRegret, err = normalizedRegret.Sub(maxNormalizedRegret)
 		if err != nil {
 			return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error anchoring normalized regrets at zero")
 		}
-		normalizedRegret, err = normalizedRegret.Add(cMinus8Point25OverP)
+		normalizedRegret, err = normalizedRegret.Add(bounds.cMinus8Point25OverP)
 		if err != nil {
 			return alloraMath.ZeroDec(), errorsmod.Wrapf(err, "Error adjusting anchored normalized regrets")
 		}
@@ -437,7 +548,7 @@ func CalcWeightFromNormalizedRegret(
 
 	// Set weight to zero for low regrets
 	// if regretFrac < c - 17.25 / p, then weight = 0
-	if normalizedRegret.Lt(cMinus17Point25OverP) {
+	if normalizedRegret.Lt(bounds.cMinus17Point25OverP) {
 		return alloraMath.ZeroDec(), nil
 	}
 
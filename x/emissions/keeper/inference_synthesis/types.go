@@ -40,12 +40,16 @@ type SynthRequest struct {
 	Ctx                 sdk.Context
 	K                   keeper.Keeper
 	TopicId             TopicId
+	InferenceDimension  uint64
 	Inferences          *emissions.Inferences
 	Forecasts           *emissions.Forecasts
 	NetworkCombinedLoss Loss
 	Epsilon             alloraMath.Dec
 	PNorm               alloraMath.Dec
 	CNorm               alloraMath.Dec
+	// Defaults to the regret-weighted mean
+	AggregationStrategy     emissions.AggregationStrategy
+	AggregationTrimFraction alloraMath.Dec
 }
 
 type InferersNewStatus int
@@ -63,6 +67,8 @@ type SynthPalette struct {
 	K       keeper.Keeper
 	Logger  log.Logger
 	TopicId TopicId
+	// Number of elements of each inference, 0 for scalar topics
+	InferenceDimension uint64
 	// Should use this as a source of truth regarding for which inferers to have data calculated
 	// i.e. if an inferer is not present here, calculate a network inference without their data
 	// Must be unique values
@@ -84,4 +90,7 @@ type SynthPalette struct {
 	Epsilon             alloraMath.Dec
 	PNorm               alloraMath.Dec
 	CNorm               alloraMath.Dec
+	// How the weighted inferences are combined into a network inference
+	AggregationStrategy     emissions.AggregationStrategy
+	AggregationTrimFraction alloraMath.Dec
 }
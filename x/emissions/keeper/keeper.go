@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"math"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -17,6 +18,7 @@ import (
 
 	coreStore "cosmossdk.io/core/store"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,6 +30,10 @@ type BlockHeight = int64
 type Reputer = string
 type Delegator = string
 
+// Keys of the regret histories: topic, worker, then the inferer of one-in forecaster regrets,
+// empty for the other roles, and the sequence number of the regret within its history
+type RegretHistoryKey = collections.Triple[TopicId, ActorId, collections.Pair[ActorId, uint64]]
+
 type Keeper struct {
 	cdc              codec.BinaryCodec
 	addressCodec     address.Codec
@@ -40,6 +46,13 @@ type Keeper struct {
 	authKeeper AccountKeeper
 	bankKeeper BankKeeper
 
+	// the next id to be used for a scheduled params change
+	nextScheduledParamsChangeId collections.Sequence
+	// map of id -> params change waiting for its block
+	scheduledParamsChanges collections.Map[uint64, types.ScheduledParamsChange]
+	// set of (apply at block, id) of the scheduled params changes
+	scheduledParamsChangesByBlock collections.KeySet[collections.Pair[BlockHeight, uint64]]
+
 	/// TOPIC
 
 	// the next topic id to be used, equal to the number of topics that have been created
@@ -47,6 +60,8 @@ type Keeper struct {
 	// every topic that has been created indexed by their topicId starting from 1 (0 is reserved for the root network)
 	topics       collections.Map[TopicId, types.Topic]
 	activeTopics collections.KeySet[TopicId]
+	// map of topic id -> overrides of the global params for that topic, absent when nothing is overridden
+	topicParamsOverrides collections.Map[TopicId, types.TopicParamsOverrides]
 	// every topic that is ready to request inferences and possible also losses
 	churnableTopics collections.KeySet[TopicId]
 	// every topic that has been churned and ready to be rewarded i.e. reputer losses have been committed
@@ -58,6 +73,41 @@ type Keeper struct {
 	// map of (topic) -> nonce/block height
 	topicRewardNonce collections.Map[TopicId, BlockHeight]
 
+	/// INFERENCE REQUESTS
+
+	// the next id to be used for an inference request
+	nextInferenceRequestId collections.Sequence
+	// map of id -> inference request that has not been settled or refunded yet
+	inferenceRequests collections.Map[uint64, types.InferenceRequest]
+	// map of (topic, worker nonce block height) -> id of the open inference request
+	inferenceRequestsByNonce collections.Map[collections.Pair[TopicId, BlockHeight], uint64]
+	// set of (ground truth block, id) of the open inference requests
+	inferenceRequestsByGroundTruthBlock collections.KeySet[collections.Pair[BlockHeight, uint64]]
+	// set of (deadline, id) of the open inference requests
+	inferenceRequestsByDeadline collections.KeySet[collections.Pair[BlockHeight, uint64]]
+
+	/// SUBSCRIPTIONS
+
+	// the next id to be used for a subscription
+	nextSubscriptionId collections.Sequence
+	// map of id -> subscription that has escrow left to pay
+	subscriptions collections.Map[uint64, types.Subscription]
+	// set of (topic, id) of the active subscriptions
+	subscriptionsByTopic collections.KeySet[collections.Pair[TopicId, uint64]]
+	// set of (consumer, id) of the active subscriptions
+	subscriptionsByConsumer collections.KeySet[collections.Pair[ActorId, uint64]]
+	// set of (next payment block, id) of the active subscriptions
+	subscriptionsByPaymentBlock collections.KeySet[collections.Pair[BlockHeight, uint64]]
+
+	/// END BLOCK WORK QUEUES
+
+	// the next id to be used for a pending topic reward, ids give the payout order
+	nextPendingTopicRewardId collections.Sequence
+	// map of id -> topic reward waiting to be paid out
+	pendingTopicRewards collections.Map[uint64, types.PendingTopicReward]
+	// map of topic -> block up to which the rewarded records of the topic are waiting to be pruned
+	pendingRecordPrunes collections.Map[TopicId, BlockHeight]
+
 	/// SCORES
 
 	// map of (topic, block_height, worker) -> score
@@ -124,9 +174,22 @@ type Keeper struct {
 	// map of reputer id to node data about that reputer
 	reputers collections.Map[LibP2pKey, types.OffchainNode]
 
+	// set of (address, libp2p key, topic) the address registered its worker node under in the topic
+	workerNodeRegistrations collections.KeySet[collections.Triple[ActorId, LibP2pKey, TopicId]]
+	// set of (address, libp2p key, topic) the address registered its reputer node under in the topic
+	reputerNodeRegistrations collections.KeySet[collections.Triple[ActorId, LibP2pKey, TopicId]]
+
+	// map of (topic, worker) -> address the worker rewards of the worker in the topic are sent to
+	topicPayoutAddresses collections.Map[collections.Pair[TopicId, ActorId], string]
+	// map of worker -> address its worker rewards are sent to in topics without a topic payout address
+	globalPayoutAddresses collections.Map[ActorId, string]
+
 	// fee revenue collected by a topic over the course of the last reward cadence
 	topicFeeRevenue collections.Map[TopicId, cosmosMath.Int]
 
+	// cumulative amount of topic revenue burned on its way into the ecosystem account
+	topicRevenueBurned collections.Item[cosmosMath.Int]
+
 	// store previous weights for exponential moving average in rewards calc
 	previousTopicWeight collections.Map[TopicId, alloraMath.Dec]
 
@@ -153,6 +216,20 @@ type Keeper struct {
 	// map of (topic) -> unfulfilled nonces
 	unfulfilledReputerNonces collections.Map[TopicId, types.ReputerRequestNonces]
 
+	// map of (topic, nonce block height, worker) -> bundle submitted directly by the worker, waiting for the nonce to close
+	workerPayloadSubmissions collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], types.WorkerDataBundle]
+	// map of (topic, reputer nonce block height, reputer) -> bundle submitted directly by the reputer, waiting for the nonce to close
+	reputerPayloadSubmissions collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], types.ReputerValueBundle]
+	// set of (topic, nonce block height, actor) whose queued bundle the actor submitted itself rather than through a leader
+	workerPayloadSelfSubmissions  collections.KeySet[collections.Triple[TopicId, BlockHeight, ActorId]]
+	reputerPayloadSelfSubmissions collections.KeySet[collections.Triple[TopicId, BlockHeight, ActorId]]
+	// map of (topic, nonce block height) -> block at whose end the submissions of the nonce are ranked
+	workerNonceCloses  collections.Map[collections.Pair[TopicId, BlockHeight], BlockHeight]
+	reputerNonceCloses collections.Map[collections.Pair[TopicId, BlockHeight], BlockHeight]
+	// set of (close block, topic, nonce block height) indexing the nonce closes by the block they close at
+	workerNonceClosesByBlock  collections.KeySet[collections.Triple[BlockHeight, TopicId, BlockHeight]]
+	reputerNonceClosesByBlock collections.KeySet[collections.Triple[BlockHeight, TopicId, BlockHeight]]
+
 	/// REGRETS
 
 	// map of (topic, worker) -> regret of worker from comparing loss of worker relative to loss of other inferers
@@ -164,10 +241,19 @@ type Keeper struct {
 	latestOneInForecasterNetworkRegrets collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.TimestampedValue]
 	// the forecaster (2nd index) regrets made under the regime of the same forecaster as a one-in forecaster
 	latestOneInForecasterSelfNetworkRegrets collections.Map[collections.Pair[TopicId, ActorId], types.TimestampedValue]
+	// bounded histories of the regrets above, one entry per regret in order of sequence number.
+	// Each write drops the regrets that fell out of the last MaxRegretHistoryLength.
+	infererRegretHistories             collections.Map[RegretHistoryKey, types.TimestampedValue]
+	forecasterRegretHistories          collections.Map[RegretHistoryKey, types.TimestampedValue]
+	oneInForecasterRegretHistories     collections.Map[RegretHistoryKey, types.TimestampedValue]
+	oneInForecasterSelfRegretHistories collections.Map[RegretHistoryKey, types.TimestampedValue]
 
 	/// WHITELISTS
 
+	// superseded by roleMembers, only read by the migration to roles
 	whitelistAdmins collections.KeySet[ActorId]
+	// set of (role, address) pairs, see types.AllRoles
+	roleMembers collections.KeySet[collections.Pair[string, ActorId]]
 
 	/// RECORD COMMITS
 
@@ -192,11 +278,28 @@ func NewKeeper(
 		feeCollectorName:                         feeCollectorName,
 		params:                                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		authKeeper:                               ak,
+		nextScheduledParamsChangeId:              collections.NewSequence(sb, types.NextScheduledParamsChangeIdKey, "next_scheduled_params_change_id"),
+		scheduledParamsChanges:                   collections.NewMap(sb, types.ScheduledParamsChangesKey, "scheduled_params_changes", collections.Uint64Key, codec.CollValue[types.ScheduledParamsChange](cdc)),
+		scheduledParamsChangesByBlock:            collections.NewKeySet(sb, types.ScheduledParamsChangesByBlockKey, "scheduled_params_changes_by_block", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
 		bankKeeper:                               bk,
 		totalStake:                               collections.NewItem(sb, types.TotalStakeKey, "total_stake", sdk.IntValue),
 		topicStake:                               collections.NewMap(sb, types.TopicStakeKey, "topic_stake", collections.Uint64Key, sdk.IntValue),
 		nextTopicId:                              collections.NewSequence(sb, types.NextTopicIdKey, "next_TopicId"),
 		topics:                                   collections.NewMap(sb, types.TopicsKey, "topics", collections.Uint64Key, codec.CollValue[types.Topic](cdc)),
+		topicParamsOverrides:                     collections.NewMap(sb, types.TopicParamsOverridesKey, "topic_params_overrides", collections.Uint64Key, codec.CollValue[types.TopicParamsOverrides](cdc)),
+		nextInferenceRequestId:                   collections.NewSequence(sb, types.NextInferenceRequestIdKey, "next_inference_request_id"),
+		inferenceRequests:                        collections.NewMap(sb, types.InferenceRequestsKey, "inference_requests", collections.Uint64Key, codec.CollValue[types.InferenceRequest](cdc)),
+		inferenceRequestsByNonce:                 collections.NewMap(sb, types.InferenceRequestsByNonceKey, "inference_requests_by_nonce", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), collections.Uint64Value),
+		inferenceRequestsByGroundTruthBlock:      collections.NewKeySet(sb, types.InferenceRequestsByGroundTruthBlockKey, "inference_requests_by_ground_truth_block", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		inferenceRequestsByDeadline:              collections.NewKeySet(sb, types.InferenceRequestsByDeadlineKey, "inference_requests_by_deadline", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		nextSubscriptionId:                       collections.NewSequence(sb, types.NextSubscriptionIdKey, "next_subscription_id"),
+		subscriptions:                            collections.NewMap(sb, types.SubscriptionsKey, "subscriptions", collections.Uint64Key, codec.CollValue[types.Subscription](cdc)),
+		subscriptionsByTopic:                     collections.NewKeySet(sb, types.SubscriptionsByTopicKey, "subscriptions_by_topic", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		subscriptionsByConsumer:                  collections.NewKeySet(sb, types.SubscriptionsByConsumerKey, "subscriptions_by_consumer", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		subscriptionsByPaymentBlock:              collections.NewKeySet(sb, types.SubscriptionsByPaymentBlockKey, "subscriptions_by_payment_block", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		nextPendingTopicRewardId:                 collections.NewSequence(sb, types.NextPendingTopicRewardIdKey, "next_pending_topic_reward_id"),
+		pendingTopicRewards:                      collections.NewMap(sb, types.PendingTopicRewardsKey, "pending_topic_rewards", collections.Uint64Key, codec.CollValue[types.PendingTopicReward](cdc)),
+		pendingRecordPrunes:                      collections.NewMap(sb, types.PendingRecordPrunesKey, "pending_record_prunes", collections.Uint64Key, collections.Int64Value),
 		activeTopics:                             collections.NewKeySet(sb, types.ActiveTopicsKey, "active_topics", collections.Uint64Key),
 		churnableTopics:                          collections.NewKeySet(sb, types.ChurnableTopicsKey, "churnable_topics", collections.Uint64Key),
 		rewardableTopics:                         collections.NewKeySet(sb, types.RewardableTopicsKey, "rewardable_topics", collections.Uint64Key),
@@ -212,11 +315,16 @@ func NewKeeper(
 		stakeFromDelegatorsUponReputer:           collections.NewMap(sb, types.TargetStakeKey, "stake_upon_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegateRewardPerShare:                   collections.NewMap(sb, types.DelegateRewardPerShare, "delegate_reward_per_share", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
 		topicFeeRevenue:                          collections.NewMap(sb, types.TopicFeeRevenueKey, "topic_fee_revenue", collections.Uint64Key, sdk.IntValue),
+		topicRevenueBurned:                       collections.NewItem(sb, types.TopicRevenueBurnedKey, "topic_revenue_burned", sdk.IntValue),
 		previousTopicWeight:                      collections.NewMap(sb, types.PreviousTopicWeightKey, "previous_topic_weight", collections.Uint64Key, alloraMath.DecValue),
 		inferences:                               collections.NewMap(sb, types.InferencesKey, "inferences", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Inference](cdc)),
 		forecasts:                                collections.NewMap(sb, types.ForecastsKey, "forecasts", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Forecast](cdc)),
 		workers:                                  collections.NewMap(sb, types.WorkerNodesKey, "worker_nodes", collections.StringKey, codec.CollValue[types.OffchainNode](cdc)),
 		reputers:                                 collections.NewMap(sb, types.ReputerNodesKey, "reputer_nodes", collections.StringKey, codec.CollValue[types.OffchainNode](cdc)),
+		workerNodeRegistrations:                  collections.NewKeySet(sb, types.WorkerNodeRegistrationsKey, "worker_node_registrations", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)),
+		reputerNodeRegistrations:                 collections.NewKeySet(sb, types.ReputerNodeRegistrationsKey, "reputer_node_registrations", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)),
+		topicPayoutAddresses:                     collections.NewMap(sb, types.TopicPayoutAddressesKey, "topic_payout_addresses", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
+		globalPayoutAddresses:                    collections.NewMap(sb, types.GlobalPayoutAddressesKey, "global_payout_addresses", collections.StringKey, collections.StringValue),
 		allInferences:                            collections.NewMap(sb, types.AllInferencesKey, "inferences_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Inferences](cdc)),
 		allForecasts:                             collections.NewMap(sb, types.AllForecastsKey, "forecasts_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Forecasts](cdc)),
 		allLossBundles:                           collections.NewMap(sb, types.AllLossBundlesKey, "value_bundles_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
@@ -226,7 +334,12 @@ func NewKeeper(
 		latestForecasterNetworkRegrets:           collections.NewMap(sb, types.ForecasterNetworkRegretsKey, "forecaster_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestOneInForecasterNetworkRegrets:      collections.NewMap(sb, types.OneInForecasterNetworkRegretsKey, "one_in_forecaster_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestOneInForecasterSelfNetworkRegrets:  collections.NewMap(sb, types.OneInForecasterSelfNetworkRegretsKey, "one_in_forecaster_self_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
+		infererRegretHistories:                   collections.NewMap(sb, types.InfererRegretHistoriesKey, "inferer_regret_histories", regretHistoryKeyCodec, codec.CollValue[types.TimestampedValue](cdc)),
+		forecasterRegretHistories:                collections.NewMap(sb, types.ForecasterRegretHistoriesKey, "forecaster_regret_histories", regretHistoryKeyCodec, codec.CollValue[types.TimestampedValue](cdc)),
+		oneInForecasterRegretHistories:           collections.NewMap(sb, types.OneInForecasterRegretHistoriesKey, "one_in_forecaster_regret_histories", regretHistoryKeyCodec, codec.CollValue[types.TimestampedValue](cdc)),
+		oneInForecasterSelfRegretHistories:       collections.NewMap(sb, types.OneInForecasterSelfRegretHistoriesKey, "one_in_forecaster_self_regret_histories", regretHistoryKeyCodec, codec.CollValue[types.TimestampedValue](cdc)),
 		whitelistAdmins:                          collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey),
+		roleMembers:                              collections.NewKeySet(sb, types.RoleMembersKey, "role_members", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
 		infererScoresByBlock:                     collections.NewMap(sb, types.InferenceScoresKey, "inferer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		forecasterScoresByBlock:                  collections.NewMap(sb, types.ForecastScoresKey, "forecaster_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		latestInfererScoresByWorker:              collections.NewMap(sb, types.LatestInfererScoresByWorkerKey, "latest_inferer_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
@@ -239,6 +352,14 @@ func NewKeeper(
 		reputerListeningCoefficient:              collections.NewMap(sb, types.ReputerListeningCoefficientKey, "reputer_listening_coefficient", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ListeningCoefficient](cdc)),
 		unfulfilledWorkerNonces:                  collections.NewMap(sb, types.UnfulfilledWorkerNoncesKey, "unfulfilled_worker_nonces", collections.Uint64Key, codec.CollValue[types.Nonces](cdc)),
 		unfulfilledReputerNonces:                 collections.NewMap(sb, types.UnfulfilledReputerNoncesKey, "unfulfilled_reputer_nonces", collections.Uint64Key, codec.CollValue[types.ReputerRequestNonces](cdc)),
+		workerPayloadSubmissions:                 collections.NewMap(sb, types.WorkerPayloadSubmissionsKey, "worker_payload_submissions", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), codec.CollValue[types.WorkerDataBundle](cdc)),
+		reputerPayloadSubmissions:                collections.NewMap(sb, types.ReputerPayloadSubmissionsKey, "reputer_payload_submissions", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), codec.CollValue[types.ReputerValueBundle](cdc)),
+		workerPayloadSelfSubmissions:             collections.NewKeySet(sb, types.WorkerPayloadSelfSubmissionsKey, "worker_payload_self_submissions", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey)),
+		reputerPayloadSelfSubmissions:            collections.NewKeySet(sb, types.ReputerPayloadSelfSubmissionsKey, "reputer_payload_self_submissions", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey)),
+		workerNonceCloses:                        collections.NewMap(sb, types.WorkerNonceClosesKey, "worker_nonce_closes", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), collections.Int64Value),
+		reputerNonceCloses:                       collections.NewMap(sb, types.ReputerNonceClosesKey, "reputer_nonce_closes", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), collections.Int64Value),
+		workerNonceClosesByBlock:                 collections.NewKeySet(sb, types.WorkerNonceClosesByBlockKey, "worker_nonce_closes_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.Int64Key)),
+		reputerNonceClosesByBlock:                collections.NewKeySet(sb, types.ReputerNonceClosesByBlockKey, "reputer_nonce_closes_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.Int64Key)),
 		topicRewardNonce:                         collections.NewMap(sb, types.TopicRewardNonceKey, "topic_reward_nonce", collections.Uint64Key, collections.Int64Value),
 		topicLastWorkerCommit:                    collections.NewMap(sb, types.TopicLastWorkerCommitKey, "topic_last_worker_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
 		topicLastReputerCommit:                   collections.NewMap(sb, types.TopicLastReputerCommitKey, "topic_last_reputer_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
@@ -460,28 +581,365 @@ func (k *Keeper) DeleteUnfulfilledReputerNonces(ctx context.Context, topicId Top
 	return k.unfulfilledReputerNonces.Set(ctx, topicId, types.ReputerRequestNonces{})
 }
 
+/// PAYLOAD SUBMISSIONS
+
+// Queues the bundle of a worker for a nonce, whether the worker submitted it itself or a leader did in a bulk payload.
+// A bundle the worker submitted itself replaces one a leader queued for it, otherwise a worker keeps its first queued bundle.
+// The first bundle queued for a nonce schedules the nonce to close at closeBlock.
+// Returns the block at which the nonce closes and whether the bundle was queued.
+func (k *Keeper) QueueWorkerPayload(
+	ctx context.Context,
+	topicId TopicId,
+	nonce BlockHeight,
+	closeBlock BlockHeight,
+	bundle types.WorkerDataBundle,
+	submittedByWorker bool,
+) (BlockHeight, bool, error) {
+	return queuePayload(ctx, k.workerPayloadSubmissions, k.workerPayloadSelfSubmissions, k.workerNonceCloses, k.workerNonceClosesByBlock, topicId, nonce, closeBlock, bundle.Worker, bundle, submittedByWorker)
+}
+
+// Queues the bundle of a reputer for a reputer nonce, whether the reputer submitted it itself or a leader did in a bulk payload.
+// A bundle the reputer submitted itself replaces one a leader queued for it, otherwise a reputer keeps its first queued bundle.
+// The first bundle queued for a nonce schedules the nonce to close at closeBlock.
+// Returns the block at which the nonce closes and whether the bundle was queued.
+func (k *Keeper) QueueReputerPayload(
+	ctx context.Context,
+	topicId TopicId,
+	nonce BlockHeight,
+	closeBlock BlockHeight,
+	bundle types.ReputerValueBundle,
+	submittedByReputer bool,
+) (BlockHeight, bool, error) {
+	return queuePayload(ctx, k.reputerPayloadSubmissions, k.reputerPayloadSelfSubmissions, k.reputerNonceCloses, k.reputerNonceClosesByBlock, topicId, nonce, closeBlock, bundle.ValueBundle.Reputer, bundle, submittedByReputer)
+}
+
+// Removes and returns the bundles queued for a worker nonce, ordered by worker
+func (k *Keeper) PopWorkerPayloads(ctx context.Context, topicId TopicId, nonce BlockHeight) ([]*types.WorkerDataBundle, error) {
+	return popPayloads(ctx, k.workerPayloadSubmissions, k.workerPayloadSelfSubmissions, topicId, nonce)
+}
+
+// Removes and returns the bundles queued for a reputer nonce, ordered by reputer
+func (k *Keeper) PopReputerPayloads(ctx context.Context, topicId TopicId, nonce BlockHeight) ([]*types.ReputerValueBundle, error) {
+	return popPayloads(ctx, k.reputerPayloadSubmissions, k.reputerPayloadSelfSubmissions, topicId, nonce)
+}
+
+// Removes and returns the (topic, nonce) of the worker nonces closing at or before the block
+func (k *Keeper) PopWorkerNonceCloses(ctx context.Context, blockHeight BlockHeight) ([]collections.Pair[TopicId, BlockHeight], error) {
+	return popNonceCloses(ctx, k.workerNonceCloses, k.workerNonceClosesByBlock, blockHeight)
+}
+
+// Removes and returns the (topic, nonce) of the reputer nonces closing at or before the block
+func (k *Keeper) PopReputerNonceCloses(ctx context.Context, blockHeight BlockHeight) ([]collections.Pair[TopicId, BlockHeight], error) {
+	return popNonceCloses(ctx, k.reputerNonceCloses, k.reputerNonceClosesByBlock, blockHeight)
+}
+
+func queuePayload[V any](
+	ctx context.Context,
+	submissions collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], V],
+	selfSubmissions collections.KeySet[collections.Triple[TopicId, BlockHeight, ActorId]],
+	closes collections.Map[collections.Pair[TopicId, BlockHeight], BlockHeight],
+	closesByBlock collections.KeySet[collections.Triple[BlockHeight, TopicId, BlockHeight]],
+	topicId TopicId,
+	nonce BlockHeight,
+	closeBlock BlockHeight,
+	actor ActorId,
+	bundle V,
+	submittedByActor bool,
+) (BlockHeight, bool, error) {
+	// The close of a nonce is scheduled along with its first submission
+	scheduledCloseBlock, err := closes.Get(ctx, collections.Join(topicId, nonce))
+	if err == nil {
+		closeBlock = scheduledCloseBlock
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return 0, false, err
+	} else if err := closes.Set(ctx, collections.Join(topicId, nonce), closeBlock); err != nil {
+		return 0, false, err
+	} else if err := closesByBlock.Set(ctx, collections.Join3(closeBlock, topicId, nonce)); err != nil {
+		return 0, false, err
+	}
+
+	key := collections.Join3(topicId, nonce, actor)
+	has, err := submissions.Has(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	if has {
+		// Only the actor itself replaces a bundle a leader queued for it, so a leader cannot take its place
+		if !submittedByActor {
+			return closeBlock, false, nil
+		}
+		selfSubmitted, err := selfSubmissions.Has(ctx, key)
+		if err != nil || selfSubmitted {
+			return closeBlock, false, err
+		}
+	}
+	if err := submissions.Set(ctx, key, bundle); err != nil {
+		return 0, false, err
+	}
+	if submittedByActor {
+		if err := selfSubmissions.Set(ctx, key); err != nil {
+			return 0, false, err
+		}
+	}
+	return closeBlock, true, nil
+}
+
+func popPayloads[V any](
+	ctx context.Context,
+	submissions collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], V],
+	selfSubmissions collections.KeySet[collections.Triple[TopicId, BlockHeight, ActorId]],
+	topicId TopicId,
+	nonce BlockHeight,
+) ([]*V, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonce)
+	iter, err := submissions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*V, 0, len(kvs))
+	for _, kv := range kvs {
+		bundle := kv.Value
+		ret = append(ret, &bundle)
+		if err := submissions.Remove(ctx, kv.Key); err != nil {
+			return nil, err
+		}
+	}
+	if err := selfSubmissions.Clear(ctx, rng); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func popNonceCloses(
+	ctx context.Context,
+	closes collections.Map[collections.Pair[TopicId, BlockHeight], BlockHeight],
+	closesByBlock collections.KeySet[collections.Triple[BlockHeight, TopicId, BlockHeight]],
+	blockHeight BlockHeight,
+) ([]collections.Pair[TopicId, BlockHeight], error) {
+	rng := collections.NewPrefixUntilTripleRange[BlockHeight, TopicId, BlockHeight](blockHeight)
+	iter, err := closesByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]collections.Pair[TopicId, BlockHeight], 0, len(keys))
+	for _, key := range keys {
+		nonceKey := collections.Join(key.K2(), key.K3())
+		ret = append(ret, nonceKey)
+		if err := closes.Remove(ctx, nonceKey); err != nil {
+			return nil, err
+		}
+		if err := closesByBlock.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
 /// REGRETS
 
-func (k *Keeper) SetInfererNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId, regret types.TimestampedValue) error {
+func (k *Keeper) SetInfererNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId, regret types.TimestampedValue, maxRegretHistoryLength uint64) error {
 	key := collections.Join(topicId, worker)
+	err := appendRegretHistory(ctx, k.infererRegretHistories, topicId, worker, "", regret, maxRegretHistoryLength)
+	if err != nil {
+		return err
+	}
 	return k.latestInfererNetworkRegrets.Set(ctx, key, regret)
 }
 
-func (k *Keeper) SetForecasterNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId, regret types.TimestampedValue) error {
+func (k *Keeper) SetForecasterNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId, regret types.TimestampedValue, maxRegretHistoryLength uint64) error {
 	key := collections.Join(topicId, worker)
+	err := appendRegretHistory(ctx, k.forecasterRegretHistories, topicId, worker, "", regret, maxRegretHistoryLength)
+	if err != nil {
+		return err
+	}
 	return k.latestForecasterNetworkRegrets.Set(ctx, key, regret)
 }
 
-func (k *Keeper) SetOneInForecasterNetworkRegret(ctx context.Context, topicId TopicId, forecaster ActorId, inferer ActorId, regret types.TimestampedValue) error {
+func (k *Keeper) SetOneInForecasterNetworkRegret(ctx context.Context, topicId TopicId, forecaster ActorId, inferer ActorId, regret types.TimestampedValue, maxRegretHistoryLength uint64) error {
 	key := collections.Join3(topicId, forecaster, inferer)
+	err := appendRegretHistory(ctx, k.oneInForecasterRegretHistories, topicId, forecaster, inferer, regret, maxRegretHistoryLength)
+	if err != nil {
+		return err
+	}
 	return k.latestOneInForecasterNetworkRegrets.Set(ctx, key, regret)
 }
 
-func (k *Keeper) SetOneInForecasterSelfNetworkRegret(ctx context.Context, topicId TopicId, forecaster ActorId, regret types.TimestampedValue) error {
+func (k *Keeper) SetOneInForecasterSelfNetworkRegret(ctx context.Context, topicId TopicId, forecaster ActorId, regret types.TimestampedValue, maxRegretHistoryLength uint64) error {
 	key := collections.Join(topicId, forecaster)
+	err := appendRegretHistory(ctx, k.oneInForecasterSelfRegretHistories, topicId, forecaster, "", regret, maxRegretHistoryLength)
+	if err != nil {
+		return err
+	}
 	return k.latestOneInForecasterSelfNetworkRegrets.Set(ctx, key, regret)
 }
 
+var regretHistoryKeyCodec = collections.TripleKeyCodec(
+	collections.Uint64Key,
+	collections.StringKey,
+	collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+)
+
+func regretHistoryKey(topicId TopicId, worker ActorId, inferer ActorId, seq uint64) RegretHistoryKey {
+	return collections.Join3(topicId, worker, collections.Join(inferer, seq))
+}
+
+// Range over the regrets of a history from the given sequence number on
+func regretHistoryRange(topicId TopicId, worker ActorId, inferer ActorId, fromSeq uint64) *collections.Range[RegretHistoryKey] {
+	return new(collections.Range[RegretHistoryKey]).
+		StartInclusive(regretHistoryKey(topicId, worker, inferer, fromSeq)).
+		EndInclusive(regretHistoryKey(topicId, worker, inferer, math.MaxUint64))
+}
+
+// Appends a regret to its history under the next sequence number and drops the regrets that fell
+// out of the last maxLength, oldest first. Each write touches a constant number of entries,
+// except after maxLength shrinks, when the first write drops all the surplus regrets.
+// While the history is disabled nothing is appended and existing histories are left as they are,
+// until their worker is removed from the topic.
+func appendRegretHistory(
+	ctx context.Context,
+	histories collections.Map[RegretHistoryKey, types.TimestampedValue],
+	topicId TopicId,
+	worker ActorId,
+	inferer ActorId,
+	regret types.TimestampedValue,
+	maxLength uint64,
+) error {
+	if maxLength == 0 {
+		return nil
+	}
+
+	// The newest regret has the highest sequence number
+	seq := uint64(0)
+	iter, err := histories.Iterate(ctx, regretHistoryRange(topicId, worker, inferer, 0).Descending())
+	if err != nil {
+		return err
+	}
+	if iter.Valid() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		seq = key.K3().K2() + 1
+	}
+	iter.Close()
+
+	err = histories.Set(ctx, regretHistoryKey(topicId, worker, inferer, seq), regret)
+	if err != nil {
+		return err
+	}
+	if seq < maxLength {
+		return nil
+	}
+	oldestKept := seq + 1 - maxLength
+	return histories.Clear(ctx, new(collections.Range[RegretHistoryKey]).
+		StartInclusive(regretHistoryKey(topicId, worker, inferer, 0)).
+		EndExclusive(regretHistoryKey(topicId, worker, inferer, oldestKept)))
+}
+
+// Returns a page of the regret history of a worker in a role, oldest first.
+// The cursor is the sequence number of the regret to start from. The inferer is only used by the
+// one-in forecaster role, in which the worker is the forecaster.
+func (k *Keeper) GetRegretHistory(
+	ctx context.Context,
+	topicId TopicId,
+	role types.RegretRole,
+	worker ActorId,
+	inferer ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.TimestampedValue, *types.SimpleCursorPaginationResponse, error) {
+	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var histories collections.Map[RegretHistoryKey, types.TimestampedValue]
+	switch role {
+	case types.RegretRole_REGRET_ROLE_INFERER:
+		histories = k.infererRegretHistories
+	case types.RegretRole_REGRET_ROLE_FORECASTER:
+		histories = k.forecasterRegretHistories
+	case types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER:
+		histories = k.oneInForecasterRegretHistories
+	case types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER_SELF:
+		histories = k.oneInForecasterSelfRegretHistories
+	default:
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidRegretRole, "%v", role)
+	}
+	if role != types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER {
+		inferer = ""
+	}
+
+	iter, err := histories.Iterate(ctx, regretHistoryRange(topicId, worker, inferer, start))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	regrets := make([]*types.TimestampedValue, 0)
+	nextKey := make([]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		// If there are more regrets, the next page starts from the first of them
+		if uint64(len(regrets)) >= limit {
+			nextKey = make([]byte, 8)
+			binary.BigEndian.PutUint64(nextKey, kv.Key.K3().K2())
+			break
+		}
+		regret := kv.Value
+		regrets = append(regrets, &regret)
+	}
+
+	return regrets, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+// Removes the regret histories of a worker in a topic, that is those of the worker as an inferer,
+// as a forecaster and as a one-in forecaster. One-in forecaster regrets of other forecasters
+// about the worker as an inferer are kept until the topic itself is removed.
+func (k *Keeper) RemoveWorkerRegretHistories(ctx context.Context, topicId TopicId, worker ActorId) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, collections.Pair[ActorId, uint64]](topicId, worker)
+	for _, histories := range []collections.Map[RegretHistoryKey, types.TimestampedValue]{
+		k.infererRegretHistories,
+		k.forecasterRegretHistories,
+		k.oneInForecasterRegretHistories,
+		k.oneInForecasterSelfRegretHistories,
+	} {
+		if err := histories.Clear(ctx, rng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes the regret histories of every worker of a topic, to be called along with the removal of the topic
+func (k *Keeper) RemoveTopicRegretHistories(ctx context.Context, topicId TopicId) error {
+	rng := collections.NewPrefixedTripleRange[TopicId, ActorId, collections.Pair[ActorId, uint64]](topicId)
+	for _, histories := range []collections.Map[RegretHistoryKey, types.TimestampedValue]{
+		k.infererRegretHistories,
+		k.forecasterRegretHistories,
+		k.oneInForecasterRegretHistories,
+		k.oneInForecasterSelfRegretHistories,
+	} {
+		if err := histories.Clear(ctx, rng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Returns the regret of a worker from comparing loss of worker relative to loss of other inferers
 // Returns (0, true) if no regret is found
 func (k *Keeper) GetInfererNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId) (types.TimestampedValue, bool, error) {
@@ -565,6 +1023,121 @@ func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	return ret, nil
 }
 
+// Applies the set options of the update to the current params, if the result is valid
+func (k *Keeper) UpdateParams(ctx context.Context, update *types.OptionalParams) error {
+	if update == nil {
+		return errorsmod.Wrap(types.ErrInvalidValue, "params update cannot be nil")
+	}
+	if err := update.Validate(); err != nil {
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params = params.MergeOptionalParams(update)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.SetParams(ctx, params)
+}
+
+// Stores a params change to be applied at the beginning of block applyAtBlock and returns its id.
+// The change is validated against the current params so obvious mistakes fail early,
+// it is validated again once applied.
+func (k *Keeper) ScheduleParamsChange(
+	ctx context.Context,
+	proposer ActorId,
+	update *types.OptionalParams,
+	scheduledAtBlock BlockHeight,
+	applyAtBlock BlockHeight,
+) (uint64, error) {
+	if update == nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidValue, "params update cannot be nil")
+	}
+	if err := update.Validate(); err != nil {
+		return 0, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := params.MergeOptionalParams(update).Validate(); err != nil {
+		return 0, err
+	}
+	id, err := k.nextScheduledParamsChangeId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	change := types.ScheduledParamsChange{
+		Id:               id,
+		Proposer:         proposer,
+		Params:           update,
+		ScheduledAtBlock: scheduledAtBlock,
+		ApplyAtBlock:     applyAtBlock,
+	}
+	if err := k.scheduledParamsChanges.Set(ctx, id, change); err != nil {
+		return 0, err
+	}
+	if err := k.scheduledParamsChangesByBlock.Set(ctx, collections.Join(applyAtBlock, id)); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (k Keeper) GetScheduledParamsChange(ctx context.Context, id uint64) (types.ScheduledParamsChange, error) {
+	change, err := k.scheduledParamsChanges.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ScheduledParamsChange{}, errorsmod.Wrapf(types.ErrScheduledParamsChangeNotFound, "id %d", id)
+	}
+	return change, err
+}
+
+// Drops a scheduled params change, whether it was applied or cancelled
+func (k *Keeper) RemoveScheduledParamsChange(ctx context.Context, id uint64) error {
+	change, err := k.GetScheduledParamsChange(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.scheduledParamsChangesByBlock.Remove(ctx, collections.Join(change.ApplyAtBlock, id)); err != nil {
+		return err
+	}
+	return k.scheduledParamsChanges.Remove(ctx, id)
+}
+
+// Returns the scheduled params changes of a block, in the order they are applied
+func (k Keeper) GetScheduledParamsChangesForBlock(ctx context.Context, blockHeight BlockHeight) ([]types.ScheduledParamsChange, error) {
+	return k.getScheduledParamsChanges(ctx, collections.NewPrefixedPairRange[BlockHeight, uint64](blockHeight))
+}
+
+// Returns every pending params change, in the order they are applied
+func (k Keeper) GetScheduledParamsChanges(ctx context.Context) ([]types.ScheduledParamsChange, error) {
+	return k.getScheduledParamsChanges(ctx, nil)
+}
+
+func (k Keeper) getScheduledParamsChanges(
+	ctx context.Context,
+	rng collections.Ranger[collections.Pair[BlockHeight, uint64]],
+) ([]types.ScheduledParamsChange, error) {
+	ret := make([]types.ScheduledParamsChange, 0)
+	iter, err := k.scheduledParamsChangesByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return ret, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return ret, err
+	}
+	for _, key := range keys {
+		change, err := k.scheduledParamsChanges.Get(ctx, key.K2())
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, change)
+	}
+	return ret, nil
+}
+
 /// INFERENCES, FORECASTS
 
 func (k *Keeper) GetInferencesAtBlock(ctx context.Context, topicId TopicId, block BlockHeight) (*types.Inferences, error) {
@@ -1294,6 +1867,45 @@ func (k *Keeper) GetStakeRemovalsForBlock(
 	return ret, nil
 }
 
+// Returns at most limit stake removals due at or before the block, by (block, topic, reputer) ascending.
+// Removals that could not be processed in their block are still due in the following ones.
+func (k *Keeper) GetStakeRemovalsUpUntilBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) ([]types.StakeRemovalInfo, error) {
+	ret := make([]types.StakeRemovalInfo, 0)
+	rng := collections.NewPrefixUntilTripleRange[BlockHeight, TopicId, ActorId](blockHeight)
+	iter, err := k.stakeRemovalsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return ret, err
+	}
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(ret)) < limit; iter.Next() {
+		val, err := iter.Value()
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, val)
+	}
+	return ret, nil
+}
+
+// Counts the stake removals due at or before the block
+func (k *Keeper) CountStakeRemovalsUpUntilBlock(ctx context.Context, blockHeight BlockHeight) (uint64, error) {
+	rng := collections.NewPrefixUntilTripleRange[BlockHeight, TopicId, ActorId](blockHeight)
+	iter, err := k.stakeRemovalsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
 // get the first found stake removal for a reputer and topicId or err not found if not found
 func (k *Keeper) GetStakeRemovalForReputerAndTopicId(
 	ctx sdk.Context,
@@ -1398,6 +2010,47 @@ func (k *Keeper) GetDelegateStakeRemovalsForBlock(
 	return ret, nil
 }
 
+// Returns at most limit delegate stake removals due at or before the block,
+// by (block, topic, delegator, reputer) ascending
+func (k *Keeper) GetDelegateStakeRemovalsUpUntilBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) ([]types.DelegateStakeRemovalInfo, error) {
+	ret := make([]types.DelegateStakeRemovalInfo, 0)
+	// the range ends before the prefix, so it is the next block that bounds the removals due
+	rng := NewSinglePrefixUntilQuadrupleRange[BlockHeight, TopicId, ActorId, ActorId](blockHeight + 1)
+	iter, err := k.delegateStakeRemovalsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return ret, err
+	}
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(ret)) < limit; iter.Next() {
+		val, err := iter.Value()
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, val)
+	}
+	return ret, nil
+}
+
+// Counts the delegate stake removals due at or before the block
+func (k *Keeper) CountDelegateStakeRemovalsUpUntilBlock(ctx context.Context, blockHeight BlockHeight) (uint64, error) {
+	// the range ends before the prefix, so it is the next block that bounds the removals due
+	rng := NewSinglePrefixUntilQuadrupleRange[BlockHeight, TopicId, ActorId, ActorId](blockHeight + 1)
+	iter, err := k.delegateStakeRemovalsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
 // return the first found stake removal object for a delegator, reputer, and topicId
 func (k *Keeper) GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
 	ctx sdk.Context,
@@ -1447,7 +2100,7 @@ func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer Act
 	if err != nil {
 		return err
 	}
-	return nil
+	return k.reputerNodeRegistrations.Set(ctx, collections.Join3(reputer, reputerInfo.LibP2PKey, topicId))
 }
 
 // Remove a reputer to the reputer tracking data structures and topicReputers
@@ -1457,13 +2110,25 @@ func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer Act
 	if err != nil {
 		return err
 	}
-	return nil
+	return removeNodeRegistrations(ctx, k.reputerNodeRegistrations, topicId, reputer)
 }
 
 func (k *Keeper) GetReputerByLibp2pKey(ctx sdk.Context, reputerKey string) (types.OffchainNode, error) {
 	return k.reputers.Get(ctx, reputerKey)
 }
 
+// Moves the reputer node the address registered under a libp2p key to a new libp2p key and multiaddress,
+// returning the node as it was before
+func (k *Keeper) UpdateReputerNodeInfo(
+	ctx context.Context,
+	reputer ActorId,
+	previousLibP2PKey string,
+	libP2PKey string,
+	multiAddress string,
+) (types.OffchainNode, error) {
+	return updateNodeInfo(ctx, k.reputers, k.reputerNodeRegistrations, reputer, previousLibP2PKey, libP2PKey, multiAddress)
+}
+
 /// WORKERS
 
 // Adds a new worker to the worker tracking data structures, workers and topicWorkers
@@ -1477,23 +2142,127 @@ func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker Actor
 	if err != nil {
 		return err
 	}
-	return nil
+	return k.workerNodeRegistrations.Set(ctx, collections.Join3(worker, workerInfo.LibP2PKey, topicId))
 }
 
-// Remove a worker to the worker tracking data structures and topicWorkers
+// Remove a worker to the worker tracking data structures and topicWorkers, along with its regret histories in the topic
 func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
 	topicKey := collections.Join(topicId, worker)
 	err := k.topicWorkers.Remove(ctx, topicKey)
 	if err != nil {
 		return err
 	}
-	return nil
+	if err := removeNodeRegistrations(ctx, k.workerNodeRegistrations, topicId, worker); err != nil {
+		return err
+	}
+	return k.RemoveWorkerRegretHistories(ctx, topicId, worker)
 }
 
 func (k *Keeper) GetWorkerByLibp2pKey(ctx sdk.Context, workerKey string) (types.OffchainNode, error) {
 	return k.workers.Get(ctx, workerKey)
 }
 
+// Moves the worker node the address registered under a libp2p key to a new libp2p key and multiaddress,
+// returning the node as it was before
+func (k *Keeper) UpdateWorkerNodeInfo(
+	ctx context.Context,
+	worker ActorId,
+	previousLibP2PKey string,
+	libP2PKey string,
+	multiAddress string,
+) (types.OffchainNode, error) {
+	return updateNodeInfo(ctx, k.workers, k.workerNodeRegistrations, worker, previousLibP2PKey, libP2PKey, multiAddress)
+}
+
+// Only the node under the previous key moves, with its owner, along with the topic registrations made with it.
+// Topic membership is keyed by address and is left untouched.
+func updateNodeInfo(
+	ctx context.Context,
+	nodes collections.Map[LibP2pKey, types.OffchainNode],
+	registrations collections.KeySet[collections.Triple[ActorId, LibP2pKey, TopicId]],
+	address ActorId,
+	previousLibP2PKey string,
+	libP2PKey string,
+	multiAddress string,
+) (types.OffchainNode, error) {
+	// A later registration of another address under the same key replaces the node
+	previous, err := nodes.Get(ctx, previousLibP2PKey)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && previous.NodeAddress != address) {
+		return types.OffchainNode{}, errorsmod.Wrapf(types.ErrAddressNotRegistered, "%s has no node under %s", address, previousLibP2PKey)
+	} else if err != nil {
+		return types.OffchainNode{}, err
+	}
+	rng := collections.NewSuperPrefixedTripleRange[ActorId, LibP2pKey, TopicId](address, previousLibP2PKey)
+	iter, err := registrations.Iterate(ctx, rng)
+	if err != nil {
+		return types.OffchainNode{}, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return types.OffchainNode{}, err
+	}
+	if len(keys) == 0 {
+		return types.OffchainNode{}, errorsmod.Wrapf(types.ErrAddressNotRegistered, "%s has no node under %s", address, previousLibP2PKey)
+	}
+
+	if libP2PKey != previousLibP2PKey {
+		has, err := nodes.Has(ctx, libP2PKey)
+		if err != nil {
+			return types.OffchainNode{}, err
+		}
+		if has {
+			return types.OffchainNode{}, errorsmod.Wrapf(types.ErrLibP2PKeyTaken, "%s", libP2PKey)
+		}
+	}
+
+	if err := nodes.Remove(ctx, previousLibP2PKey); err != nil {
+		return types.OffchainNode{}, err
+	}
+	updated := previous
+	updated.LibP2PKey = libP2PKey
+	updated.MultiAddress = multiAddress
+	updated.NodeId = updated.Owner + "|" + libP2PKey
+	if err := nodes.Set(ctx, libP2PKey, updated); err != nil {
+		return types.OffchainNode{}, err
+	}
+	for _, key := range keys {
+		if err := registrations.Remove(ctx, key); err != nil {
+			return types.OffchainNode{}, err
+		}
+		if err := registrations.Set(ctx, collections.Join3(address, libP2PKey, key.K3())); err != nil {
+			return types.OffchainNode{}, err
+		}
+	}
+	return previous, nil
+}
+
+// Removes the registrations of the nodes of the address in the topic. Their nodes are kept.
+func removeNodeRegistrations(
+	ctx context.Context,
+	registrations collections.KeySet[collections.Triple[ActorId, LibP2pKey, TopicId]],
+	topicId TopicId,
+	address ActorId,
+) error {
+	rng := collections.NewPrefixedTripleRange[ActorId, LibP2pKey, TopicId](address)
+	iter, err := registrations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.K3() != topicId {
+			continue
+		}
+		if err := registrations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) GetWorkerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
 	worker, err := k.workers.Get(ctx, p2pKey)
 	if err != nil {
@@ -1522,6 +2291,58 @@ func (k *Keeper) GetReputerAddressByP2PKey(ctx context.Context, p2pKey string) (
 	return address, nil
 }
 
+/// PAYOUT ADDRESSES
+
+// Sets the address the worker rewards of an actor in a topic are sent to, an empty payout address removes it
+func (k *Keeper) SetTopicPayoutAddress(ctx context.Context, topicId TopicId, actor ActorId, payoutAddress string) error {
+	key := collections.Join(topicId, actor)
+	if payoutAddress == "" {
+		return k.topicPayoutAddresses.Remove(ctx, key)
+	}
+	return k.topicPayoutAddresses.Set(ctx, key, payoutAddress)
+}
+
+// Sets the address the worker rewards of an actor are sent to in topics without a topic payout address,
+// an empty payout address removes it
+func (k *Keeper) SetGlobalPayoutAddress(ctx context.Context, actor ActorId, payoutAddress string) error {
+	if payoutAddress == "" {
+		return k.globalPayoutAddresses.Remove(ctx, actor)
+	}
+	return k.globalPayoutAddresses.Set(ctx, actor, payoutAddress)
+}
+
+// Returns the payout address of an actor in a topic, or "" if none is set
+func (k *Keeper) GetTopicPayoutAddress(ctx context.Context, topicId TopicId, actor ActorId) (string, error) {
+	payoutAddress, err := k.topicPayoutAddresses.Get(ctx, collections.Join(topicId, actor))
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+	return payoutAddress, err
+}
+
+// Returns the global payout address of an actor, or "" if none is set
+func (k *Keeper) GetGlobalPayoutAddress(ctx context.Context, actor ActorId) (string, error) {
+	payoutAddress, err := k.globalPayoutAddresses.Get(ctx, actor)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+	return payoutAddress, err
+}
+
+// Returns the address the worker rewards of an actor in a topic are sent to:
+// its topic payout address, else its global payout address, else the actor itself
+func (k *Keeper) GetPayoutAddress(ctx context.Context, topicId TopicId, actor ActorId) (string, error) {
+	payoutAddress, err := k.GetTopicPayoutAddress(ctx, topicId, actor)
+	if err != nil || payoutAddress != "" {
+		return payoutAddress, err
+	}
+	payoutAddress, err = k.GetGlobalPayoutAddress(ctx, actor)
+	if err != nil || payoutAddress != "" {
+		return payoutAddress, err
+	}
+	return actor, nil
+}
+
 /// TOPICS
 
 // Get the previous weight during rewards calculation for a topic
@@ -1596,6 +2417,45 @@ func (k *Keeper) SetTopic(ctx context.Context, topicId TopicId, topic types.Topi
 	return k.topics.Set(ctx, topicId, topic)
 }
 
+// Gets the params overrides of a topic, empty when nothing is overridden
+func (k Keeper) GetTopicParamsOverrides(ctx context.Context, topicId TopicId) (types.TopicParamsOverrides, error) {
+	overrides, err := k.topicParamsOverrides.Get(ctx, topicId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TopicParamsOverrides{}, nil
+	}
+	return overrides, err
+}
+
+// Replaces the params overrides of a topic, clearing every override removes the entry
+func (k *Keeper) SetTopicParamsOverrides(ctx context.Context, topicId TopicId, overrides types.TopicParamsOverrides) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+	if overrides.Size() == 0 {
+		return k.RemoveTopicParamsOverrides(ctx, topicId)
+	}
+	return k.topicParamsOverrides.Set(ctx, topicId, overrides)
+}
+
+// Removes the params overrides of a topic, to be called along with the removal of the topic
+func (k *Keeper) RemoveTopicParamsOverrides(ctx context.Context, topicId TopicId) error {
+	return k.topicParamsOverrides.Remove(ctx, topicId)
+}
+
+// Resolves the overridable params of a topic. The rewards and the inference synthesis
+// of a topic read these params from here rather than from the global params.
+func (k Keeper) GetEffectiveTopicParams(ctx context.Context, topicId TopicId) (types.EffectiveTopicParams, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.EffectiveTopicParams{}, err
+	}
+	overrides, err := k.GetTopicParamsOverrides(ctx, topicId)
+	if err != nil {
+		return types.EffectiveTopicParams{}, err
+	}
+	return types.ResolveTopicParams(params, overrides), nil
+}
+
 // Checks if a topic exists
 func (k *Keeper) TopicExists(ctx context.Context, topicId TopicId) (bool, error) {
 	return k.topics.Has(ctx, topicId)
@@ -1610,6 +2470,19 @@ func (k *Keeper) IsTopicActive(ctx context.Context, topicId TopicId) (bool, erro
 	return k.activeTopics.Has(ctx, topicId)
 }
 
+func (k Keeper) CountActiveTopics(ctx context.Context) (uint64, error) {
+	iter, err := k.activeTopics.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
 func (k Keeper) GetIdsOfActiveTopics(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
 	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
 	if err != nil {
@@ -1736,6 +2609,368 @@ func (k *Keeper) DripTopicFeeRevenue(ctx context.Context, topicId TopicId, block
 	return k.topicFeeRevenue.Set(ctx, topicId, newTopicFeeRevenue)
 }
 
+// Get the cumulative amount of topic revenue that has been burned
+func (k Keeper) GetTopicRevenueBurned(ctx context.Context) (cosmosMath.Int, error) {
+	burned, err := k.topicRevenueBurned.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return cosmosMath.ZeroInt(), nil
+		}
+		return cosmosMath.Int{}, err
+	}
+	return burned, nil
+}
+
+// Moves topic revenue (creation fees, registration fees, topic funding) from the sender
+// into the ecosystem account, then burns the governance set fraction of it.
+// The rest is recycled as emissions by the mint module.
+func (k *Keeper) SendTopicRevenueToEcosystem(ctx context.Context, sender ActorId, amount cosmosMath.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	if err := k.SendCoinsFromAccountToModule(ctx, sender, mintTypes.EcosystemModuleName, coins); err != nil {
+		return err
+	}
+	return k.burnTopicRevenueFraction(ctx, amount)
+}
+
+// Same as SendTopicRevenueToEcosystem, for topic revenue held in escrow by a module account
+func (k *Keeper) SendTopicRevenueFromModuleToEcosystem(ctx context.Context, senderModule string, amount cosmosMath.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	if err := k.SendCoinsFromModuleToModule(ctx, senderModule, mintTypes.EcosystemModuleName, coins); err != nil {
+		return err
+	}
+	return k.burnTopicRevenueFraction(ctx, amount)
+}
+
+func (k *Keeper) burnTopicRevenueFraction(ctx context.Context, amount cosmosMath.Int) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	toBurn := moduleParams.TopicRevenueBurnFraction.SdkLegacyDec().MulInt(amount).TruncateInt()
+	if !toBurn.IsPositive() {
+		return nil
+	}
+	err = k.bankKeeper.BurnCoins(ctx, mintTypes.EcosystemModuleName, sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, toBurn)))
+	if err != nil {
+		return err
+	}
+	burned, err := k.GetTopicRevenueBurned(ctx)
+	if err != nil {
+		return err
+	}
+	return k.topicRevenueBurned.Set(ctx, burned.Add(toBurn))
+}
+
+/// INFERENCE REQUESTS
+
+// Stores a new open inference request, indexed by its nonce, ground truth block and deadline, and returns its id
+func (k *Keeper) AddInferenceRequest(ctx context.Context, request types.InferenceRequest) (uint64, error) {
+	id, err := k.nextInferenceRequestId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	request.Id = id
+	if err := k.inferenceRequests.Set(ctx, id, request); err != nil {
+		return 0, err
+	}
+	if err := k.inferenceRequestsByNonce.Set(ctx, collections.Join(request.TopicId, request.BlockHeight), id); err != nil {
+		return 0, err
+	}
+	if err := k.inferenceRequestsByGroundTruthBlock.Set(ctx, collections.Join(request.GroundTruthBlock, id)); err != nil {
+		return 0, err
+	}
+	if err := k.inferenceRequestsByDeadline.Set(ctx, collections.Join(request.Deadline, id)); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (k Keeper) GetInferenceRequest(ctx context.Context, id uint64) (types.InferenceRequest, error) {
+	request, err := k.inferenceRequests.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.InferenceRequest{}, errorsmod.Wrapf(types.ErrInferenceRequestNotFound, "id %d", id)
+	}
+	return request, err
+}
+
+// Returns the open inference request answered at the nonce, if the nonce belongs to one
+func (k Keeper) GetInferenceRequestAtNonce(ctx context.Context, topicId TopicId, blockHeight BlockHeight) (types.InferenceRequest, bool, error) {
+	id, err := k.inferenceRequestsByNonce.Get(ctx, collections.Join(topicId, blockHeight))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.InferenceRequest{}, false, nil
+	} else if err != nil {
+		return types.InferenceRequest{}, false, err
+	}
+	request, err := k.GetInferenceRequest(ctx, id)
+	if err != nil {
+		return types.InferenceRequest{}, false, err
+	}
+	return request, true, nil
+}
+
+// Returns the open inference requests of a topic, by nonce ascending
+func (k Keeper) GetOpenInferenceRequests(ctx context.Context, topicId TopicId) ([]types.InferenceRequest, error) {
+	ret := make([]types.InferenceRequest, 0)
+	iter, err := k.inferenceRequestsByNonce.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId))
+	if err != nil {
+		return ret, err
+	}
+	ids, err := iter.Values()
+	if err != nil {
+		return ret, err
+	}
+	for _, id := range ids {
+		request, err := k.GetInferenceRequest(ctx, id)
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, request)
+	}
+	return ret, nil
+}
+
+// Returns the open inference requests whose ground truth becomes available at the block
+func (k Keeper) GetInferenceRequestsAtGroundTruthBlock(ctx context.Context, blockHeight BlockHeight) ([]types.InferenceRequest, error) {
+	return k.getInferenceRequestsAtBlock(ctx, k.inferenceRequestsByGroundTruthBlock, blockHeight)
+}
+
+// Returns the open inference requests whose deadline is the block
+func (k Keeper) GetInferenceRequestsAtDeadline(ctx context.Context, blockHeight BlockHeight) ([]types.InferenceRequest, error) {
+	return k.getInferenceRequestsAtBlock(ctx, k.inferenceRequestsByDeadline, blockHeight)
+}
+
+func (k Keeper) getInferenceRequestsAtBlock(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[BlockHeight, uint64]],
+	blockHeight BlockHeight,
+) ([]types.InferenceRequest, error) {
+	ret := make([]types.InferenceRequest, 0)
+	iter, err := index.Iterate(ctx, collections.NewPrefixedPairRange[BlockHeight, uint64](blockHeight))
+	if err != nil {
+		return ret, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return ret, err
+	}
+	for _, key := range keys {
+		request, err := k.GetInferenceRequest(ctx, key.K2())
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, request)
+	}
+	return ret, nil
+}
+
+// Drops an inference request once its bounty has been settled or refunded
+func (k *Keeper) RemoveInferenceRequest(ctx context.Context, id uint64) error {
+	request, err := k.GetInferenceRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.inferenceRequestsByNonce.Remove(ctx, collections.Join(request.TopicId, request.BlockHeight)); err != nil {
+		return err
+	}
+	if err := k.inferenceRequestsByGroundTruthBlock.Remove(ctx, collections.Join(request.GroundTruthBlock, id)); err != nil {
+		return err
+	}
+	if err := k.inferenceRequestsByDeadline.Remove(ctx, collections.Join(request.Deadline, id)); err != nil {
+		return err
+	}
+	return k.inferenceRequests.Remove(ctx, id)
+}
+
+/// SUBSCRIPTIONS
+
+// Stores a new subscription and returns its id
+func (k *Keeper) AddSubscription(ctx context.Context, subscription types.Subscription) (uint64, error) {
+	id, err := k.nextSubscriptionId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	subscription.Id = id
+	if err := k.subscriptionsByTopic.Set(ctx, collections.Join(subscription.TopicId, id)); err != nil {
+		return 0, err
+	}
+	if err := k.subscriptionsByConsumer.Set(ctx, collections.Join(subscription.Consumer, id)); err != nil {
+		return 0, err
+	}
+	if err := k.subscriptionsByPaymentBlock.Set(ctx, collections.Join(subscription.NextPaymentBlock, id)); err != nil {
+		return 0, err
+	}
+	return id, k.subscriptions.Set(ctx, id, subscription)
+}
+
+// Updates the escrow and next payment block of an existing subscription
+func (k *Keeper) SetSubscription(ctx context.Context, subscription types.Subscription) error {
+	existing, err := k.GetSubscription(ctx, subscription.Id)
+	if err != nil {
+		return err
+	}
+	if err := k.subscriptionsByPaymentBlock.Remove(ctx, collections.Join(existing.NextPaymentBlock, existing.Id)); err != nil {
+		return err
+	}
+	if err := k.subscriptionsByPaymentBlock.Set(ctx, collections.Join(subscription.NextPaymentBlock, subscription.Id)); err != nil {
+		return err
+	}
+	return k.subscriptions.Set(ctx, subscription.Id, subscription)
+}
+
+func (k Keeper) GetSubscription(ctx context.Context, id uint64) (types.Subscription, error) {
+	subscription, err := k.subscriptions.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Subscription{}, errorsmod.Wrapf(types.ErrSubscriptionNotFound, "id %d", id)
+	}
+	return subscription, err
+}
+
+// Drops a subscription once it is cancelled or its escrow is paid out
+func (k *Keeper) RemoveSubscription(ctx context.Context, id uint64) error {
+	subscription, err := k.GetSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.subscriptionsByTopic.Remove(ctx, collections.Join(subscription.TopicId, id)); err != nil {
+		return err
+	}
+	if err := k.subscriptionsByConsumer.Remove(ctx, collections.Join(subscription.Consumer, id)); err != nil {
+		return err
+	}
+	if err := k.subscriptionsByPaymentBlock.Remove(ctx, collections.Join(subscription.NextPaymentBlock, id)); err != nil {
+		return err
+	}
+	return k.subscriptions.Remove(ctx, id)
+}
+
+// Returns the active subscriptions of a topic, by id ascending
+func (k Keeper) GetTopicSubscriptions(ctx context.Context, topicId TopicId) ([]types.Subscription, error) {
+	return getSubscriptions(ctx, k, k.subscriptionsByTopic, collections.NewPrefixedPairRange[TopicId, uint64](topicId))
+}
+
+// Returns the active subscriptions of a consumer, by id ascending
+func (k Keeper) GetConsumerSubscriptions(ctx context.Context, consumer ActorId) ([]types.Subscription, error) {
+	return getSubscriptions(ctx, k, k.subscriptionsByConsumer, collections.NewPrefixedPairRange[ActorId, uint64](consumer))
+}
+
+// Returns the subscriptions due for a payment at the block
+func (k Keeper) GetSubscriptionsForPaymentBlock(ctx context.Context, blockHeight BlockHeight) ([]types.Subscription, error) {
+	return getSubscriptions(ctx, k, k.subscriptionsByPaymentBlock, collections.NewPrefixedPairRange[BlockHeight, uint64](blockHeight))
+}
+
+func getSubscriptions[K1 any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[K1, uint64]],
+	rng collections.Ranger[collections.Pair[K1, uint64]],
+) ([]types.Subscription, error) {
+	ret := make([]types.Subscription, 0)
+	iter, err := index.Iterate(ctx, rng)
+	if err != nil {
+		return ret, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return ret, err
+	}
+	for _, key := range keys {
+		subscription, err := k.GetSubscription(ctx, key.K2())
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, subscription)
+	}
+	return ret, nil
+}
+
+/// END BLOCK WORK QUEUES
+
+// Queues a topic reward to be paid out after the ones already queued
+func (k *Keeper) AddPendingTopicReward(ctx context.Context, reward types.PendingTopicReward) error {
+	id, err := k.nextPendingTopicRewardId.Next(ctx)
+	if err != nil {
+		return err
+	}
+	reward.Id = id
+	return k.pendingTopicRewards.Set(ctx, id, reward)
+}
+
+// Returns at most limit topic rewards waiting to be paid out, in the order they were queued
+func (k Keeper) GetPendingTopicRewards(ctx context.Context, limit uint64) ([]types.PendingTopicReward, error) {
+	ret := make([]types.PendingTopicReward, 0)
+	iter, err := k.pendingTopicRewards.Iterate(ctx, nil)
+	if err != nil {
+		return ret, err
+	}
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(ret)) < limit; iter.Next() {
+		val, err := iter.Value()
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, val)
+	}
+	return ret, nil
+}
+
+func (k *Keeper) RemovePendingTopicReward(ctx context.Context, id uint64) error {
+	return k.pendingTopicRewards.Remove(ctx, id)
+}
+
+func (k Keeper) CountPendingTopicRewards(ctx context.Context) (uint64, error) {
+	return countKeys(ctx, k.pendingTopicRewards)
+}
+
+// Queues the records of a topic up to the block for pruning.
+// A topic is queued once, up to the latest block it was queued with.
+func (k *Keeper) AddPendingRecordPrune(ctx context.Context, topicId TopicId, blockHeight BlockHeight) error {
+	queued, err := k.pendingRecordPrunes.Get(ctx, topicId)
+	if err == nil && queued >= blockHeight {
+		return nil
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.pendingRecordPrunes.Set(ctx, topicId, blockHeight)
+}
+
+// Returns the topic and block of the first record prune queued, by topic id ascending
+func (k Keeper) GetNextPendingRecordPrune(ctx context.Context) (topicId TopicId, blockHeight BlockHeight, found bool, err error) {
+	iter, err := k.pendingRecordPrunes.Iterate(ctx, nil)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, 0, false, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return kv.Key, kv.Value, true, nil
+}
+
+func (k *Keeper) RemovePendingRecordPrune(ctx context.Context, topicId TopicId) error {
+	return k.pendingRecordPrunes.Remove(ctx, topicId)
+}
+
+func (k Keeper) CountPendingRecordPrunes(ctx context.Context) (uint64, error) {
+	return countKeys(ctx, k.pendingRecordPrunes)
+}
+
+func countKeys[K, V any](ctx context.Context, m collections.Map[K, V]) (uint64, error) {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
 /// CHURNABLE TOPICS
 
 // Get the churnable topics
@@ -2140,20 +3375,98 @@ func (k Keeper) GetPreviousPercentageRewardToStakedReputers(ctx context.Context)
 
 /// WHITELISTS
 
+// A whitelist admin is an address holding every role
 func (k Keeper) IsWhitelistAdmin(ctx context.Context, admin ActorId) (bool, error) {
-	return k.whitelistAdmins.Has(ctx, admin)
+	for _, role := range types.AllRoles {
+		hasRole, err := k.HasRole(ctx, role, admin)
+		if err != nil || !hasRole {
+			return false, err
+		}
+	}
+	return true, nil
 }
 
+// Grants every role to the admin
 func (k *Keeper) AddWhitelistAdmin(ctx context.Context, admin ActorId) error {
-	return k.whitelistAdmins.Set(ctx, admin)
+	for _, role := range types.AllRoles {
+		if err := k.GrantRole(ctx, role, admin); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// Revokes every role from the admin
 func (k *Keeper) RemoveWhitelistAdmin(ctx context.Context, admin ActorId) error {
-	return k.whitelistAdmins.Remove(ctx, admin)
+	for _, role := range types.AllRoles {
+		if err := k.RevokeRole(ctx, role, admin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) HasRole(ctx context.Context, role string, address ActorId) (bool, error) {
+	return k.roleMembers.Has(ctx, collections.Join(role, address))
+}
+
+func (k *Keeper) GrantRole(ctx context.Context, role string, address ActorId) error {
+	if err := types.ValidateRole(role); err != nil {
+		return err
+	}
+	return k.roleMembers.Set(ctx, collections.Join(role, address))
+}
+
+func (k *Keeper) RevokeRole(ctx context.Context, role string, address ActorId) error {
+	if err := types.ValidateRole(role); err != nil {
+		return err
+	}
+	return k.roleMembers.Remove(ctx, collections.Join(role, address))
+}
+
+// Returns the members of a role, sorted by address
+func (k Keeper) GetRoleMembers(ctx context.Context, role string) ([]ActorId, error) {
+	if err := types.ValidateRole(role); err != nil {
+		return nil, err
+	}
+	rng := collections.NewPrefixedPairRange[string, ActorId](role)
+	iter, err := k.roleMembers.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	members := make([]ActorId, 0, len(keys))
+	for _, key := range keys {
+		members = append(members, key.K2())
+	}
+	return members, nil
+}
+
+// Returns the roles held by an address, in the order of types.AllRoles
+func (k Keeper) GetAddressRoles(ctx context.Context, address ActorId) ([]string, error) {
+	roles := make([]string, 0)
+	for _, role := range types.AllRoles {
+		hasRole, err := k.HasRole(ctx, role, address)
+		if err != nil {
+			return nil, err
+		}
+		if hasRole {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
 }
 
 /// BANK KEEPER WRAPPERS
 
+// wrapper around bank keeper BlockedAddr
+func (k *Keeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return k.bankKeeper.BlockedAddr(addr)
+}
+
 // wrapper around bank keeper SendCoinsFromModuleToAccount
 func (k *Keeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipient ActorId, amt sdk.Coins) error {
 	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
@@ -2254,6 +3567,62 @@ func (k *Keeper) PruneRecordsAfterRewards(ctx context.Context, topicId TopicId,
 	return nil
 }
 
+// Same as PruneRecordsAfterRewards, deleting at most limit records.
+// Returns whether all the records up to the block have been deleted.
+func (k *Keeper) PruneRecordsAfterRewardsUpToLimit(ctx context.Context, topicId TopicId, blockHeight int64, limit uint64) (bool, error) {
+	blockRange := collections.
+		NewPrefixedPairRange[TopicId, BlockHeight](topicId).
+		EndInclusive(blockHeight)
+
+	remaining, err := pruneUpToLimit(ctx, k.allInferences, blockRange, limit)
+	if err != nil || remaining == 0 {
+		return false, err
+	}
+	remaining, err = pruneUpToLimit(ctx, k.allForecasts, blockRange, remaining)
+	if err != nil || remaining == 0 {
+		return false, err
+	}
+	remaining, err = pruneUpToLimit(ctx, k.allLossBundles, blockRange, remaining)
+	if err != nil || remaining == 0 {
+		return false, err
+	}
+	remaining, err = pruneUpToLimit(ctx, k.networkLossBundles, blockRange, remaining)
+	if err != nil {
+		return false, err
+	}
+	// whatever is left of the limit proves there is nothing more to delete
+	return remaining > 0, nil
+}
+
+// Deletes at most limit records in the range and returns what is left of the limit
+func pruneUpToLimit[V any](
+	ctx context.Context,
+	m collections.Map[collections.Pair[TopicId, BlockHeight], V],
+	blockRange *collections.PairRange[TopicId, BlockHeight],
+	limit uint64,
+) (uint64, error) {
+	iter, err := m.Iterate(ctx, blockRange)
+	if err != nil {
+		return limit, err
+	}
+	keys := make([]collections.Pair[TopicId, BlockHeight], 0)
+	for ; iter.Valid() && uint64(len(keys)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return limit, err
+		}
+		keys = append(keys, key)
+	}
+	iter.Close()
+	for _, key := range keys {
+		if err := m.Remove(ctx, key); err != nil {
+			return limit, err
+		}
+	}
+	return limit - uint64(len(keys)), nil
+}
+
 func (k *Keeper) pruneInferences(ctx context.Context, blockRange *collections.PairRange[uint64, int64]) error {
 	return k.allInferences.Clear(ctx, blockRange)
 }
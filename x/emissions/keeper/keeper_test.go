@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"sort"
+
 	"encoding/binary"
 	"strconv"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	cosmosMath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -396,6 +399,114 @@ func (s *KeeperTestSuite) TestReputerNonceLimitEnforcement() {
 	}
 }
 
+/// PAYLOAD SUBMISSION TESTS
+
+func (s *KeeperTestSuite) TestQueueWorkerPayloadSchedulesCloseOnFirstSubmission() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	nonce := int64(100)
+
+	closeBlock, queued, err := keeper.QueueWorkerPayload(ctx, topicId, nonce, 105, types.WorkerDataBundle{Worker: "worker-b"}, true)
+	s.Require().NoError(err)
+	s.Require().True(queued)
+	s.Require().Equal(int64(105), closeBlock)
+
+	// Later submissions close with the first one
+	closeBlock, queued, err = keeper.QueueWorkerPayload(ctx, topicId, nonce, 107, types.WorkerDataBundle{Worker: "worker-a"}, true)
+	s.Require().NoError(err)
+	s.Require().True(queued)
+	s.Require().Equal(int64(105), closeBlock)
+
+	_, queued, err = keeper.QueueWorkerPayload(ctx, topicId, nonce, 107, types.WorkerDataBundle{Worker: "worker-a"}, true)
+	s.Require().NoError(err)
+	s.Require().False(queued, "a worker can only queue one bundle per nonce")
+
+	closes, err := keeper.PopWorkerNonceCloses(ctx, 104)
+	s.Require().NoError(err)
+	s.Require().Empty(closes)
+	closes, err = keeper.PopWorkerNonceCloses(ctx, 105)
+	s.Require().NoError(err)
+	s.Require().Equal([]collections.Pair[uint64, int64]{collections.Join(topicId, nonce)}, closes)
+	closes, err = keeper.PopWorkerNonceCloses(ctx, 106)
+	s.Require().NoError(err)
+	s.Require().Empty(closes)
+
+	bundles, err := keeper.PopWorkerPayloads(ctx, topicId, nonce)
+	s.Require().NoError(err)
+	s.Require().Len(bundles, 2)
+	s.Require().Equal("worker-a", bundles[0].Worker)
+	s.Require().Equal("worker-b", bundles[1].Worker)
+	bundles, err = keeper.PopWorkerPayloads(ctx, topicId, nonce)
+	s.Require().NoError(err)
+	s.Require().Empty(bundles)
+}
+
+func (s *KeeperTestSuite) TestQueueWorkerPayloadOnlyLetsTheWorkerReplaceALeaderQueuedBundle() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	nonce := int64(100)
+	leaderQueued := types.WorkerDataBundle{Worker: "worker", Pubkey: "queued by leader"}
+	ownBundle := types.WorkerDataBundle{Worker: "worker", Pubkey: "submitted by worker"}
+
+	_, queued, err := keeper.QueueWorkerPayload(ctx, topicId, nonce, 105, leaderQueued, false)
+	s.Require().NoError(err)
+	s.Require().True(queued)
+	_, queued, err = keeper.QueueWorkerPayload(ctx, topicId, nonce, 105, types.WorkerDataBundle{Worker: "worker"}, false)
+	s.Require().NoError(err)
+	s.Require().False(queued, "another leader cannot replace a queued bundle")
+
+	_, queued, err = keeper.QueueWorkerPayload(ctx, topicId, nonce, 105, ownBundle, true)
+	s.Require().NoError(err)
+	s.Require().True(queued, "the worker replaces the bundle a leader queued for it")
+	_, queued, err = keeper.QueueWorkerPayload(ctx, topicId, nonce, 105, leaderQueued, true)
+	s.Require().NoError(err)
+	s.Require().False(queued, "a worker submits its own bundle once")
+
+	bundles, err := keeper.PopWorkerPayloads(ctx, topicId, nonce)
+	s.Require().NoError(err)
+	s.Require().Len(bundles, 1)
+	s.Require().Equal(ownBundle.Pubkey, bundles[0].Pubkey)
+
+	// The nonce is popped with its self submissions
+	_, queued, err = keeper.QueueWorkerPayload(ctx, topicId, nonce, 105, ownBundle, true)
+	s.Require().NoError(err)
+	s.Require().True(queued)
+}
+
+func (s *KeeperTestSuite) TestPopNonceClosesOnlyReturnsDueCloses() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+
+	_, _, err := keeper.QueueWorkerPayload(ctx, 2, 100, 110, types.WorkerDataBundle{Worker: "worker"}, true)
+	s.Require().NoError(err)
+	_, _, err = keeper.QueueWorkerPayload(ctx, 2, 90, 105, types.WorkerDataBundle{Worker: "worker"}, true)
+	s.Require().NoError(err)
+	_, _, err = keeper.QueueWorkerPayload(ctx, 1, 100, 103, types.WorkerDataBundle{Worker: "worker"}, true)
+	s.Require().NoError(err)
+	_, _, err = keeper.QueueReputerPayload(ctx, 1, 100, 104, types.ReputerValueBundle{ValueBundle: &types.ValueBundle{Reputer: "reputer"}}, true)
+	s.Require().NoError(err)
+
+	// Closes that were not popped in their block are due in the following ones, by close block
+	closes, err := keeper.PopWorkerNonceCloses(ctx, 105)
+	s.Require().NoError(err)
+	s.Require().Equal([]collections.Pair[uint64, int64]{collections.Join(uint64(1), int64(100)), collections.Join(uint64(2), int64(90))}, closes)
+	closes, err = keeper.PopWorkerNonceCloses(ctx, 109)
+	s.Require().NoError(err)
+	s.Require().Empty(closes)
+	closes, err = keeper.PopWorkerNonceCloses(ctx, 110)
+	s.Require().NoError(err)
+	s.Require().Equal([]collections.Pair[uint64, int64]{collections.Join(uint64(2), int64(100))}, closes)
+
+	closes, err = keeper.PopReputerNonceCloses(ctx, 110)
+	s.Require().NoError(err)
+	s.Require().Equal([]collections.Pair[uint64, int64]{collections.Join(uint64(1), int64(100))}, closes)
+	closes, err = keeper.PopReputerNonceCloses(ctx, 110)
+	s.Require().NoError(err)
+	s.Require().Empty(closes)
+}
+
 /// REGRET TESTS
 
 func (s *KeeperTestSuite) TestSetAndGetInfererNetworkRegret() {
@@ -406,7 +517,7 @@ func (s *KeeperTestSuite) TestSetAndGetInfererNetworkRegret() {
 	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
 
 	// Set Inferer Network Regret
-	err := keeper.SetInfererNetworkRegret(ctx, topicId, worker, regret)
+	err := keeper.SetInfererNetworkRegret(ctx, topicId, worker, regret, 0)
 	s.Require().NoError(err)
 
 	// Get Inferer Network Regret
@@ -424,7 +535,7 @@ func (s *KeeperTestSuite) TestSetAndGetForecasterNetworkRegret() {
 	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(20)}
 
 	// Set Forecaster Network Regret
-	err := keeper.SetForecasterNetworkRegret(ctx, topicId, worker, regret)
+	err := keeper.SetForecasterNetworkRegret(ctx, topicId, worker, regret, 0)
 	s.Require().NoError(err)
 
 	// Get Forecaster Network Regret
@@ -445,7 +556,7 @@ func (s *KeeperTestSuite) TestSetAndGetOneInForecasterNetworkRegret() {
 	regret := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(30)}
 
 	// Set One-In Forecaster Network Regret
-	err := keeper.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster, inferer, regret)
+	err := keeper.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster, inferer, regret, 0)
 	s.Require().NoError(err)
 
 	// Get One-In Forecaster Network Regret
@@ -524,9 +635,9 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentInfererRegrets() {
 	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20)}
 
 	// Set regrets for the same worker under different topic IDs
-	err = keeper.SetInfererNetworkRegret(ctx, topicId1, worker, regret1)
+	err = keeper.SetInfererNetworkRegret(ctx, topicId1, worker, regret1, 0)
 	s.Require().NoError(err)
-	err = keeper.SetInfererNetworkRegret(ctx, topicId2, worker, regret2)
+	err = keeper.SetInfererNetworkRegret(ctx, topicId2, worker, regret2, 0)
 	s.Require().NoError(err)
 
 	// Get and compare regrets after setting them
@@ -565,9 +676,9 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentForecasterRegrets()
 	s.Require().Equal(noPrior1, true)
 
 	// Set regrets for the same worker under different topic IDs
-	err = keeper.SetForecasterNetworkRegret(ctx, topicId1, worker, regret1)
+	err = keeper.SetForecasterNetworkRegret(ctx, topicId1, worker, regret1, 0)
 	s.Require().NoError(err)
-	err = keeper.SetForecasterNetworkRegret(ctx, topicId2, worker, regret2)
+	err = keeper.SetForecasterNetworkRegret(ctx, topicId2, worker, regret2, 0)
 	s.Require().NoError(err)
 
 	// Get and compare regrets
@@ -615,9 +726,9 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentOneInForecasterNetw
 	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20)}
 
 	// Set regrets for the same forecaster-inferer pair under different topic IDs
-	err = keeper.SetOneInForecasterNetworkRegret(ctx, topicId1, forecaster, inferer, regret1)
+	err = keeper.SetOneInForecasterNetworkRegret(ctx, topicId1, forecaster, inferer, regret1, 0)
 	s.Require().NoError(err)
-	err = keeper.SetOneInForecasterNetworkRegret(ctx, topicId2, forecaster, inferer, regret2)
+	err = keeper.SetOneInForecasterNetworkRegret(ctx, topicId2, forecaster, inferer, regret2, 0)
 	s.Require().NoError(err)
 
 	// Get and compare regrets after setting them
@@ -636,6 +747,139 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentOneInForecasterNetw
 	s.Require().NotEqual(gotRegret1, gotRegret2, "Regrets from different topics should not be equal")
 }
 
+func (s *KeeperTestSuite) TestRegretHistoryIsBoundedAndPaginated() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	worker := "worker-address"
+	inferer := "inferer-address"
+
+	regretAt := func(blockHeight int64) types.TimestampedValue {
+		return types.TimestampedValue{BlockHeight: blockHeight, Value: alloraMath.NewDecFromInt64(blockHeight)}
+	}
+
+	// A max length of zero disables the history
+	err := keeper.SetInfererNetworkRegret(ctx, topicId, worker, regretAt(1), 0)
+	s.Require().NoError(err)
+	regrets, _, err := keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_INFERER, worker, "", nil)
+	s.Require().NoError(err)
+	s.Require().Empty(regrets)
+
+	for blockHeight := int64(1); blockHeight <= 5; blockHeight++ {
+		err = keeper.SetInfererNetworkRegret(ctx, topicId, worker, regretAt(blockHeight), 3)
+		s.Require().NoError(err)
+	}
+	err = keeper.SetOneInForecasterNetworkRegret(ctx, topicId, worker, inferer, regretAt(7), 3)
+	s.Require().NoError(err)
+
+	// Only the latest regrets are kept, oldest first
+	regrets, pageRes, err := keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_INFERER, worker, "", nil)
+	s.Require().NoError(err)
+	s.Require().Len(regrets, 3)
+	for i, regret := range regrets {
+		s.Require().Equal(regretAt(int64(i+3)), *regret)
+	}
+	s.Require().Empty(pageRes.NextKey)
+
+	// Roles are kept apart
+	regrets, _, err = keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_FORECASTER, worker, "", nil)
+	s.Require().NoError(err)
+	s.Require().Empty(regrets)
+	regrets, _, err = keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER, worker, inferer, nil)
+	s.Require().NoError(err)
+	s.Require().Len(regrets, 1)
+	s.Require().Equal(regretAt(7), *regrets[0])
+
+	// Pages continue from the cursor of the previous page
+	regrets, pageRes, err = keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_INFERER, worker, "", &types.SimpleCursorPaginationRequest{Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(regrets, 2)
+	s.Require().Equal(int64(3), regrets[0].BlockHeight)
+	s.Require().NotEmpty(pageRes.NextKey)
+	regrets, pageRes, err = keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_INFERER, worker, "", &types.SimpleCursorPaginationRequest{Key: pageRes.NextKey, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(regrets, 1)
+	s.Require().Equal(int64(5), regrets[0].BlockHeight)
+	s.Require().Empty(pageRes.NextKey)
+
+	_, _, err = keeper.GetRegretHistory(ctx, topicId, types.RegretRole(42), worker, "", nil)
+	s.Require().ErrorIs(err, types.ErrInvalidRegretRole)
+}
+
+func (s *KeeperTestSuite) TestRegretHistoryShrinksWithItsMaxLength() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	worker := "worker-address"
+
+	for blockHeight := int64(1); blockHeight <= 6; blockHeight++ {
+		err := keeper.SetForecasterNetworkRegret(ctx, topicId, worker, types.TimestampedValue{BlockHeight: blockHeight, Value: alloraMath.NewDecFromInt64(blockHeight)}, 4)
+		s.Require().NoError(err)
+	}
+
+	// Disabling the history keeps the regrets recorded so far
+	err := keeper.SetForecasterNetworkRegret(ctx, topicId, worker, types.TimestampedValue{BlockHeight: 7, Value: alloraMath.NewDecFromInt64(7)}, 0)
+	s.Require().NoError(err)
+	regrets, _, err := keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_FORECASTER, worker, "", nil)
+	s.Require().NoError(err)
+	s.Require().Len(regrets, 4)
+	s.Require().Equal(int64(6), regrets[3].BlockHeight)
+
+	// The next regret drops every regret beyond the new max length
+	err = keeper.SetForecasterNetworkRegret(ctx, topicId, worker, types.TimestampedValue{BlockHeight: 8, Value: alloraMath.NewDecFromInt64(8)}, 2)
+	s.Require().NoError(err)
+
+	regrets, _, err = keeper.GetRegretHistory(ctx, topicId, types.RegretRole_REGRET_ROLE_FORECASTER, worker, "", nil)
+	s.Require().NoError(err)
+	s.Require().Len(regrets, 2)
+	s.Require().Equal(int64(6), regrets[0].BlockHeight)
+	s.Require().Equal(int64(8), regrets[1].BlockHeight)
+}
+
+func (s *KeeperTestSuite) TestRegretHistoriesArePrunedWithTheirWorkerAndTopic() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	worker := "worker-address"
+	otherWorker := "other-worker-address"
+
+	regret := types.TimestampedValue{BlockHeight: 1, Value: alloraMath.OneDec()}
+	for _, topicId := range []uint64{1, 2} {
+		for _, actor := range []string{worker, otherWorker} {
+			s.Require().NoError(keeper.SetInfererNetworkRegret(ctx, topicId, actor, regret, 3))
+			s.Require().NoError(keeper.SetForecasterNetworkRegret(ctx, topicId, actor, regret, 3))
+			s.Require().NoError(keeper.SetOneInForecasterNetworkRegret(ctx, topicId, actor, "inferer", regret, 3))
+			s.Require().NoError(keeper.SetOneInForecasterSelfNetworkRegret(ctx, topicId, actor, regret, 3))
+		}
+	}
+	historyLength := func(topicId uint64, role types.RegretRole, actor string) int {
+		regrets, _, err := keeper.GetRegretHistory(ctx, topicId, role, actor, "inferer", nil)
+		s.Require().NoError(err)
+		return len(regrets)
+	}
+	roles := []types.RegretRole{
+		types.RegretRole_REGRET_ROLE_INFERER,
+		types.RegretRole_REGRET_ROLE_FORECASTER,
+		types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER,
+		types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER_SELF,
+	}
+
+	// Unregistering a worker drops its histories in that topic only
+	s.Require().NoError(keeper.RemoveWorker(ctx, 1, worker))
+	for _, role := range roles {
+		s.Require().Zero(historyLength(1, role, worker), role)
+		s.Require().Equal(1, historyLength(1, role, otherWorker), role)
+		s.Require().Equal(1, historyLength(2, role, worker), role)
+	}
+
+	// Removing a topic drops the histories of all of its workers
+	s.Require().NoError(keeper.RemoveTopicRegretHistories(ctx, 2))
+	for _, role := range roles {
+		s.Require().Zero(historyLength(2, role, worker), role)
+		s.Require().Zero(historyLength(2, role, otherWorker), role)
+		s.Require().Equal(1, historyLength(1, role, otherWorker), role)
+	}
+}
+
 // / PARAMS TESTS
 func (s *KeeperTestSuite) TestSetGetMaxTopicsPerBlock() {
 	ctx := s.ctx
@@ -1937,6 +2181,25 @@ func (s *KeeperTestSuite) TestGetActiveTopics() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCountActiveTopics() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+
+	count, err := keeper.CountActiveTopics(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), count)
+
+	for topicId := uint64(1); topicId <= 3; topicId++ {
+		s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId}))
+		s.Require().NoError(keeper.ActivateTopic(ctx, topicId))
+	}
+	s.Require().NoError(keeper.InactivateTopic(ctx, 2))
+
+	count, err = keeper.CountActiveTopics(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), count)
+}
+
 func (s *KeeperTestSuite) TestGetActiveTopicsWithSmallLimitAndOffset() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2669,6 +2932,111 @@ func (s *KeeperTestSuite) TestWhitelistAdminOperations() {
 	s.Require().False(isAdmin, "Address should not be an admin after being removed")
 }
 
+func (s *KeeperTestSuite) TestRoleOperations() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	first := "allo1firstRoleMemberExample"
+	second := "allo1secondRoleMemberExample"
+
+	s.Require().NoError(keeper.GrantRole(ctx, types.RoleTopicModerator, second))
+	s.Require().NoError(keeper.GrantRole(ctx, types.RoleTopicModerator, first))
+	s.Require().NoError(keeper.GrantRole(ctx, types.RoleMintAdmin, first))
+	s.Require().ErrorIs(keeper.GrantRole(ctx, "superuser", first), types.ErrUnknownRole)
+
+	members, err := keeper.GetRoleMembers(ctx, types.RoleTopicModerator)
+	s.Require().NoError(err)
+	s.Require().Contains(members, first)
+	s.Require().Contains(members, second)
+	s.Require().True(sort.StringsAreSorted(members), "members are sorted by address")
+
+	roles, err := keeper.GetAddressRoles(ctx, first)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{types.RoleMintAdmin, types.RoleTopicModerator}, roles)
+
+	s.Require().NoError(keeper.RevokeRole(ctx, types.RoleTopicModerator, first))
+	hasRole, err := keeper.HasRole(ctx, types.RoleTopicModerator, first)
+	s.Require().NoError(err)
+	s.Require().False(hasRole)
+	hasRole, err = keeper.HasRole(ctx, types.RoleMintAdmin, first)
+	s.Require().NoError(err)
+	s.Require().True(hasRole, "revoking one role keeps the others")
+}
+
+/// TOPIC PARAMS OVERRIDES
+
+func (s *KeeperTestSuite) TestResolveTopicParams() {
+	params := types.DefaultParams()
+	s.Require().Equal(
+		types.ResolveTopicParams(params, types.TopicParamsOverrides{}).PRewardInference,
+		params.PRewardInference,
+		"no overrides resolves to the global params",
+	)
+
+	overrides := types.TopicParamsOverrides{
+		PRewardInference:       []alloraMath.Dec{alloraMath.NewDecFromInt64(7)},
+		MaxTopInferersToReward: []uint64{2},
+	}
+	resolved := types.ResolveTopicParams(params, overrides)
+	s.Require().Equal(alloraMath.NewDecFromInt64(7), resolved.PRewardInference)
+	s.Require().Equal(uint64(2), resolved.MaxTopInferersToReward)
+	s.Require().Equal(params.PRewardForecast, resolved.PRewardForecast)
+	s.Require().Equal(params.LearningRate, resolved.LearningRate)
+}
+
+func (s *KeeperTestSuite) TestSetTopicParamsOverridesValidates() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	overrides, err := keeper.GetTopicParamsOverrides(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.TopicParamsOverrides{}, overrides, "no overrides by default")
+
+	err = keeper.SetTopicParamsOverrides(ctx, topicId, types.TopicParamsOverrides{
+		MaxTopReputersToReward: []uint64{1, 2},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidValue, "more than one value")
+	err = keeper.SetTopicParamsOverrides(ctx, topicId, types.TopicParamsOverrides{
+		LearningRate: []alloraMath.Dec{alloraMath.NewDecFromInt64(-1)},
+	})
+	s.Require().Error(err, "same validation as the global param")
+
+	err = keeper.SetTopicParamsOverrides(ctx, topicId, types.TopicParamsOverrides{
+		LearningRate: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.01")},
+	})
+	s.Require().NoError(err)
+	topicParams, err := keeper.GetEffectiveTopicParams(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(alloraMath.MustNewDecFromString("0.01"), topicParams.LearningRate)
+}
+
+func (s *KeeperTestSuite) TestRemoveTopicParamsOverrides() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+
+	err = keeper.SetTopicParamsOverrides(ctx, topicId, types.TopicParamsOverrides{
+		LearningRate: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.01")},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.RemoveTopicParamsOverrides(ctx, topicId))
+	topicParams, err := keeper.GetEffectiveTopicParams(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(params.LearningRate, topicParams.LearningRate)
+
+	// clearing every override removes the entry rather than storing an empty one
+	err = keeper.SetTopicParamsOverrides(ctx, topicId, types.TopicParamsOverrides{
+		LearningRate: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.01")},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetTopicParamsOverrides(ctx, topicId, types.TopicParamsOverrides{}))
+	overrides, err := keeper.GetTopicParamsOverrides(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.TopicParamsOverrides{}, overrides)
+}
+
 /// TOPIC REWARD NONCE
 
 func (s *KeeperTestSuite) TestGetSetDeleteTopicRewardNonce() {
@@ -3211,3 +3579,124 @@ func (s *KeeperTestSuite) TestGetFirstDelegateStakeRemovalForDelegatorReputerAnd
 	s.Require().NoError(err)
 	s.Require().False(found)
 }
+
+func (s *KeeperTestSuite) TestGetStakeRemovalsUpUntilBlock() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	for _, removal := range []types.StakeRemovalInfo{
+		{Reputer: "reputer2", TopicId: 1, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 30},
+		{Reputer: "reputer1", TopicId: 2, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 20},
+		{Reputer: "reputer1", TopicId: 1, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 30},
+		{Reputer: "reputer3", TopicId: 1, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 31},
+	} {
+		s.Require().NoError(k.SetStakeRemoval(ctx, removal))
+	}
+
+	removals, err := k.GetStakeRemovalsUpUntilBlock(ctx, 30, 10)
+	s.Require().NoError(err)
+	s.Require().Len(removals, 3, "removals due later are left out")
+	s.Require().Equal(int64(20), removals[0].BlockRemovalCompleted, "overdue removals come first")
+	s.Require().Equal("reputer1", removals[1].Reputer)
+	s.Require().Equal("reputer2", removals[2].Reputer)
+
+	removals, err = k.GetStakeRemovalsUpUntilBlock(ctx, 30, 2)
+	s.Require().NoError(err)
+	s.Require().Len(removals, 2)
+	count, err := k.CountStakeRemovalsUpUntilBlock(ctx, 30)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), count)
+}
+
+func (s *KeeperTestSuite) TestGetDelegateStakeRemovalsUpUntilBlock() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	for _, removal := range []types.DelegateStakeRemovalInfo{
+		{Reputer: "reputer", Delegator: "delegator2", TopicId: 1, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 30},
+		{Reputer: "reputer", Delegator: "delegator1", TopicId: 1, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 20},
+		{Reputer: "reputer", Delegator: "delegator3", TopicId: 1, Amount: cosmosMath.NewInt(100), BlockRemovalCompleted: 31},
+	} {
+		s.Require().NoError(k.SetDelegateStakeRemoval(ctx, removal))
+	}
+
+	removals, err := k.GetDelegateStakeRemovalsUpUntilBlock(ctx, 30, 10)
+	s.Require().NoError(err)
+	s.Require().Len(removals, 2)
+	s.Require().Equal("delegator1", removals[0].Delegator)
+	s.Require().Equal("delegator2", removals[1].Delegator)
+	count, err := k.CountDelegateStakeRemovalsUpUntilBlock(ctx, 31)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), count)
+}
+
+func (s *KeeperTestSuite) TestPendingTopicRewardsAreFirstInFirstOut() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	for _, topicId := range []uint64{3, 1, 2} {
+		err := k.AddPendingTopicReward(ctx, types.PendingTopicReward{
+			TopicId:     topicId,
+			Reward:      alloraMath.NewDecFromInt64(int64(topicId)),
+			RewardNonce: 10,
+		})
+		s.Require().NoError(err)
+	}
+
+	rewards, err := k.GetPendingTopicRewards(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 2)
+	s.Require().Equal(uint64(3), rewards[0].TopicId)
+	s.Require().Equal(uint64(1), rewards[1].TopicId)
+
+	s.Require().NoError(k.RemovePendingTopicReward(ctx, rewards[0].Id))
+	rewards, err = k.GetPendingTopicRewards(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 2)
+	s.Require().Equal(uint64(1), rewards[0].TopicId)
+	count, err := k.CountPendingTopicRewards(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), count)
+}
+
+func (s *KeeperTestSuite) TestPruneRecordsAfterRewardsUpToLimit() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+	for block := int64(1); block <= 3; block++ {
+		err := k.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, types.Inferences{
+			Inferences: []*types.Inference{{TopicId: topicId, BlockHeight: block, Inferer: "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"}},
+		})
+		s.Require().NoError(err)
+		err = k.InsertNetworkLossBundleAtBlock(ctx, topicId, block, types.ValueBundle{})
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(k.AddPendingRecordPrune(ctx, topicId, 2))
+	s.Require().NoError(k.AddPendingRecordPrune(ctx, topicId, 1), "a topic is queued up to its latest block")
+	queuedTopic, queuedBlock, found, err := k.GetNextPendingRecordPrune(ctx)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(topicId, queuedTopic)
+	s.Require().Equal(int64(2), queuedBlock)
+
+	done, err := k.PruneRecordsAfterRewardsUpToLimit(ctx, topicId, queuedBlock, 3)
+	s.Require().NoError(err)
+	s.Require().False(done, "the two network losses are not all pruned yet")
+	_, err = k.GetInferencesAtBlock(ctx, topicId, 2)
+	s.Require().Error(err)
+	_, err = k.GetNetworkLossBundleAtBlock(ctx, topicId, 1)
+	s.Require().Error(err)
+	_, err = k.GetNetworkLossBundleAtBlock(ctx, topicId, 2)
+	s.Require().NoError(err)
+
+	done, err = k.PruneRecordsAfterRewardsUpToLimit(ctx, topicId, queuedBlock, 3)
+	s.Require().NoError(err)
+	s.Require().True(done)
+	_, err = k.GetNetworkLossBundleAtBlock(ctx, topicId, 2)
+	s.Require().Error(err)
+	_, err = k.GetInferencesAtBlock(ctx, topicId, 3)
+	s.Require().NoError(err, "records after the block are kept")
+
+	s.Require().NoError(k.RemovePendingRecordPrune(ctx, topicId))
+	_, _, found, err = k.GetNextPendingRecordPrune(ctx)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	v2 "github.com/allora-network/allora-chain/x/emissions/migrations/v2"
+	v3 "github.com/allora-network/allora-chain/x/emissions/migrations/v3"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,9 +19,20 @@ func NewMigrator(k Keeper) Migrator {
 }
 
 // Migrate1to2 migrates the emissions module state from the consensus version 1 to
-// version 2. For now this is a no-op since we don't actually have any state to
-// upgrade, but in the future this function or a 2to3 would be used to handle
-// state migrations between versions of the emissions module.
+// version 2, granting every role to the existing whitelist admins.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
-	return v2.MigrateStore(ctx)
+	return v2.MigrateStore(ctx, m.keeper.whitelistAdmins, m.keeper.roleMembers)
+}
+
+// Migrate2to3 migrates the emissions module state from the consensus version 2 to
+// version 3, accepting legacy bundle signatures for a window after the upgrade and
+// indexing the registered nodes by address.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := v3.MigrateParams(ctx, m.keeper.params); err != nil {
+		return err
+	}
+	if err := v3.MigrateNodeRegistrations(ctx, m.keeper.workers, m.keeper.topicWorkers, m.keeper.workerNodeRegistrations); err != nil {
+		return err
+	}
+	return v3.MigrateNodeRegistrations(ctx, m.keeper.reputers, m.keeper.topicReputers, m.keeper.reputerNodeRegistrations)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -37,3 +38,14 @@ func checkInputLength(ctx context.Context, ms msgServer, msg proto.Message) erro
 
 	return nil
 }
+
+// Options under which worker and reputer bundle signatures are verified at the current block
+func bundleSignatureOptions(ctx context.Context, params types.Params) types.BundleSignatureOptions {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endBlock := params.LegacyBundleSignaturesEndBlock
+	return types.BundleSignatureOptions{
+		ChainId: sdkCtx.ChainID(),
+		// A zero end block never accepts them, not even at genesis
+		AcceptLegacy: endBlock > 0 && sdkCtx.BlockHeight() <= endBlock,
+	}
+}
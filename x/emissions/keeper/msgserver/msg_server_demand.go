@@ -2,10 +2,12 @@ package msgserver
 
 import (
 	"context"
+	"errors"
 
-	appParams "github.com/allora-network/allora-chain/app/params"
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
-	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,8 +25,7 @@ func (ms msgServer) FundTopic(ctx context.Context, msg *types.MsgFundTopic) (*ty
 	// Check sender has funds to pay for the inference request
 	// bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
 	// Send funds
-	coins := sdk.NewCoins(sdk.NewCoin(appParams.DefaultBondDenom, msg.Amount))
-	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, minttypes.EcosystemModuleName, coins)
+	err = ms.k.SendTopicRevenueToEcosystem(ctx, msg.Sender, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +37,98 @@ func (ms msgServer) FundTopic(ctx context.Context, msg *types.MsgFundTopic) (*ty
 	}
 
 	// Activate topic if it exhibits minimum weight
-	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.TopicId, msg.Amount)
+	err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, msg.TopicId, msg.Amount)
 	return &types.MsgFundTopicResponse{}, err
 }
+
+// Requests an ad-hoc inference answered at a one-off nonce at the current block.
+// The bounty is held in escrow until the request's deadline.
+func (ms msgServer) RequestInference(ctx context.Context, msg *types.MsgRequestInference) (*types.MsgRequestInferenceResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if moduleParams.MaxOpenInferenceRequestsPerTopic == 0 {
+		return nil, types.ErrInferenceRequestsDisabled
+	}
+	openRequests, err := ms.k.GetOpenInferenceRequests(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(openRequests)) >= moduleParams.MaxOpenInferenceRequestsPerTopic {
+		return nil, errorsmod.Wrapf(types.ErrTooManyOpenInferenceRequests, "max %d", moduleParams.MaxOpenInferenceRequestsPerTopic)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	nonce := types.Nonce{BlockHeight: sdkCtx.BlockHeight()}
+	groundTruthBlock := nonce.BlockHeight + topic.GroundTruthLag
+	if msg.Deadline <= groundTruthBlock {
+		return nil, errorsmod.Wrapf(types.ErrInvalidInferenceRequestDeadline, "deadline %d, ground truth block %d", msg.Deadline, groundTruthBlock)
+	}
+	if err := ensureNonceIsFree(ctx, ms, msg.TopicId, nonce); err != nil {
+		return nil, err
+	}
+
+	// Check sender has funds to pay for the bounty
+	// bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraRequestsAccountName, sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, msg.Bounty)))
+	if err != nil {
+		return nil, err
+	}
+
+	request := types.InferenceRequest{
+		Sender:           msg.Sender,
+		TopicId:          msg.TopicId,
+		Argument:         msg.Argument,
+		Bounty:           msg.Bounty,
+		BlockHeight:      nonce.BlockHeight,
+		GroundTruthBlock: groundTruthBlock,
+		Deadline:         msg.Deadline,
+	}
+	request.Id, err = ms.k.AddInferenceRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.AddWorkerNonce(ctx, msg.TopicId, &nonce)
+	if err != nil {
+		return nil, err
+	}
+	types.EmitNewInferenceRequestedEvent(sdkCtx, request)
+
+	return &types.MsgRequestInferenceResponse{RequestId: request.Id, Nonce: &nonce}, nil
+}
+
+// A request's nonce must not be shared with an epoch of the topic,
+// or with another request, as the work is stored per topic and block
+func ensureNonceIsFree(ctx context.Context, ms msgServer, topicId uint64, nonce types.Nonce) error {
+	_, isInferenceRequest, err := ms.k.GetInferenceRequestAtNonce(ctx, topicId, nonce.BlockHeight)
+	if err != nil {
+		return err
+	}
+	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, &nonce)
+	if err != nil {
+		return err
+	}
+	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, topicId, &nonce)
+	if err != nil {
+		return err
+	}
+	if isInferenceRequest || workerNonceUnfulfilled || reputerNonceUnfulfilled {
+		return errorsmod.Wrapf(types.ErrInferenceRequestNonceTaken, "topic %d, block %d", topicId, nonce.BlockHeight)
+	}
+	if _, err := ms.k.GetInferencesAtBlock(ctx, topicId, nonce.BlockHeight); err == nil {
+		return errorsmod.Wrapf(types.ErrInferenceRequestNonceTaken, "topic %d, block %d", topicId, nonce.BlockHeight)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return nil
+}
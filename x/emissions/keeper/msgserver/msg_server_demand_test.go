@@ -3,7 +3,9 @@ package msgserver_test
 import (
 	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -59,6 +61,47 @@ func (s *MsgServerTestSuite) TestFundTopicSimple() {
 	s.Require().True(topicWeightAfter.Gt(topicWeightBefore), "Topic weight should be greater after funding the topic")
 }
 
+func (s *MsgServerTestSuite) TestFundTopicBurnsRevenueFraction() {
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	moduleParams.TopicRevenueBurnFraction = alloraMath.MustNewDecFromString("0.25")
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	amount := cosmosMath.NewInt(1000)
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	s.bankKeeper.MintCoins(s.ctx, types.AlloraStakingAccountName, coins)
+	s.bankKeeper.SendCoinsFromModuleToAccount(s.ctx, types.AlloraStakingAccountName, senderAddr, coins)
+
+	ecosystemAddr := s.accountKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	ecosystemBefore := s.bankKeeper.GetBalance(s.ctx, ecosystemAddr, params.DefaultBondDenom).Amount
+	supplyBefore := s.bankKeeper.GetSupply(s.ctx, params.DefaultBondDenom).Amount
+	burnedBefore, err := s.emissionsKeeper.GetTopicRevenueBurned(s.ctx)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.FundTopic(s.ctx, &types.MsgFundTopic{
+		Sender:  senderAddr.String(),
+		TopicId: topicId,
+		Amount:  amount,
+	})
+	s.Require().NoError(err)
+
+	ecosystemAfter := s.bankKeeper.GetBalance(s.ctx, ecosystemAddr, params.DefaultBondDenom).Amount
+	s.Require().Equal(cosmosMath.NewInt(750), ecosystemAfter.Sub(ecosystemBefore))
+	supplyAfter := s.bankKeeper.GetSupply(s.ctx, params.DefaultBondDenom).Amount
+	s.Require().Equal(cosmosMath.NewInt(250), supplyBefore.Sub(supplyAfter))
+	burnedAfter, err := s.emissionsKeeper.GetTopicRevenueBurned(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(250), burnedAfter.Sub(burnedBefore))
+
+	// the whole payment still counts as demand for the topic
+	feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(s.ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(amount, feeRevenue)
+}
+
 func (s *MsgServerTestSuite) TestHighWeightForHighFundedTopic() {
 	senderAddr := sdk.AccAddress(PKS[0].Address())
 	sender := senderAddr.String()
@@ -429,3 +472,116 @@ func (s *KeeperTestSuite) TestRequestInferenceInvalidBidAmountLessThanGlobalMini
 	s.Require().ErrorIs(err, types.ErrFundAmountTooLow, "RequestInference should return an error when the bid amount is below global minimum threshold")
 }
 */
+
+func (s *MsgServerTestSuite) requestInference(sender sdk.AccAddress, topicId uint64, deadline int64, bounty cosmosMath.Int) (*types.MsgRequestInferenceResponse, error) {
+	return s.msgServer.RequestInference(s.ctx, &types.MsgRequestInference{
+		Sender:   sender.String(),
+		TopicId:  topicId,
+		Argument: "BTC",
+		Deadline: deadline,
+		Bounty:   bounty,
+	})
+}
+
+func (s *MsgServerTestSuite) TestRequestInferenceEscrowsBountyAndAddsNonce() {
+	require := s.Require()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	bounty := cosmosMath.NewInt(1000)
+	s.MintTokensToAddress(senderAddr, bounty)
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, senderAddr, params.DefaultBondDenom).Amount
+
+	resp, err := s.requestInference(senderAddr, topicId, 200, bounty)
+	require.NoError(err)
+	require.Equal(int64(100), resp.Nonce.BlockHeight)
+	require.True(hasEvent(s.ctx, "emissions.v1.EventInferenceRequested"))
+
+	require.True(balanceBefore.Sub(bounty).Equal(s.bankKeeper.GetBalance(s.ctx, senderAddr, params.DefaultBondDenom).Amount))
+	requestsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRequestsAccountName)
+	require.Equal(bounty, s.bankKeeper.GetBalance(s.ctx, requestsAddr, params.DefaultBondDenom).Amount)
+
+	unfulfilled, err := s.emissionsKeeper.IsWorkerNonceUnfulfilled(s.ctx, topicId, resp.Nonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	requests, err := s.emissionsKeeper.GetOpenInferenceRequests(s.ctx, topicId)
+	require.NoError(err)
+	require.Len(requests, 1)
+	require.Equal(resp.RequestId, requests[0].Id)
+	require.Equal("BTC", requests[0].Argument)
+	require.Equal(bounty, requests[0].Bounty)
+	require.Equal(int64(100), requests[0].GroundTruthBlock, "the topic has no ground truth lag")
+}
+
+func (s *MsgServerTestSuite) TestRequestInferenceInvalid() {
+	require := s.Require()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(senderAddr, cosmosMath.NewInt(1000))
+
+	_, err := s.requestInference(senderAddr, topicId, 200, cosmosMath.ZeroInt())
+	require.ErrorIs(err, types.ErrInvalidValue)
+	_, err = s.requestInference(senderAddr, topicId+1, 200, cosmosMath.NewInt(10))
+	require.ErrorIs(err, types.ErrInvalidTopicId)
+	_, err = s.requestInference(senderAddr, topicId, 100, cosmosMath.NewInt(10))
+	require.ErrorIs(err, types.ErrInvalidInferenceRequestDeadline)
+
+	_, err = s.requestInference(senderAddr, topicId, 200, cosmosMath.NewInt(10))
+	require.NoError(err)
+	_, err = s.requestInference(senderAddr, topicId, 200, cosmosMath.NewInt(10))
+	require.ErrorIs(err, types.ErrInferenceRequestNonceTaken, "one request per topic and block")
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.MaxOpenInferenceRequestsPerTopic = 1
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+	s.ctx = s.ctx.WithBlockHeight(101)
+	_, err = s.requestInference(senderAddr, topicId, 200, cosmosMath.NewInt(10))
+	require.ErrorIs(err, types.ErrTooManyOpenInferenceRequests)
+
+	moduleParams.MaxOpenInferenceRequestsPerTopic = 0
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+	_, err = s.requestInference(senderAddr, topicId, 200, cosmosMath.NewInt(10))
+	require.ErrorIs(err, types.ErrInferenceRequestsDisabled)
+}
+
+func (s *MsgServerTestSuite) TestRequestInferenceCannotShareEpochNonce() {
+	s.ctx = s.ctx.WithBlockHeight(100)
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(senderAddr, cosmosMath.NewInt(1000))
+	s.Require().NoError(s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: 100}))
+
+	_, err := s.requestInference(senderAddr, topicId, 200, cosmosMath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrInferenceRequestNonceTaken)
+}
+
+func (s *MsgServerTestSuite) TestInferenceRequestRefundedOnExpiry() {
+	require := s.Require()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	bounty := cosmosMath.NewInt(1000)
+	s.MintTokensToAddress(senderAddr, bounty)
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, senderAddr, params.DefaultBondDenom).Amount
+
+	resp, err := s.requestInference(senderAddr, topicId, 120, bounty)
+	require.NoError(err)
+
+	require.NoError(s.appModule.EndBlock(s.ctx.WithBlockHeight(119)))
+	_, err = s.emissionsKeeper.GetInferenceRequest(s.ctx, resp.RequestId)
+	require.NoError(err, "still open before the deadline")
+
+	expiryCtx := s.ctx.WithBlockHeight(120).WithEventManager(sdk.NewEventManager())
+	require.NoError(s.appModule.EndBlock(expiryCtx))
+	require.True(hasEvent(expiryCtx, "emissions.v1.EventInferenceRequestExpired"))
+	require.True(balanceBefore.Equal(s.bankKeeper.GetBalance(s.ctx, senderAddr, params.DefaultBondDenom).Amount))
+
+	_, err = s.emissionsKeeper.GetInferenceRequest(s.ctx, resp.RequestId)
+	require.ErrorIs(err, types.ErrInferenceRequestNotFound)
+	unfulfilled, err := s.emissionsKeeper.IsWorkerNonceUnfulfilled(s.ctx, topicId, resp.Nonce)
+	require.NoError(err)
+	require.False(unfulfilled, "nobody can answer the expired request anymore")
+}
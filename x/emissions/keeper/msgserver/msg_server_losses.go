@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,70 +32,248 @@ func (ms msgServer) InsertBulkReputerPayload(
 		return nil, err
 	}
 
-	// Check if the topic exists
-	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	/// Do filters upon the leader (the sender) first, then do checks on each reputer in the payload
+	/// All filters should be done in order of increasing computational complexity
+	if err := ms.checkReputerNonceOpen(ctx, msg.TopicId, msg.ReputerRequestNonce); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return nil, sdkerrors.ErrNotFound
+		return nil, err
+	}
+	// With a submission window, the bundles accumulate with those submitted directly until the nonce closes
+	if params.PayloadSubmissionWindow > 0 {
+		return ms.queueBulkReputerPayload(ctx, msg, params)
 	}
 
-	/// Do filters upon the leader (the sender) first, then do checks on each reputer in the payload
-	/// All filters should be done in order of increasing computational complexity
+	// Without one, the payload closes the nonce, along with what reputers submitted directly so far.
+	// The bundles of the payload come first so that the outcomes index them.
+	reputerNonce := msg.ReputerRequestNonce.ReputerNonce.BlockHeight
+	queuedBundles, err := ms.k.PopReputerPayloads(ctx, msg.TopicId, reputerNonce)
+	if err != nil {
+		return nil, err
+	}
+	bundles := append(append([]*types.ReputerValueBundle{}, msg.ReputerValueBundles...), queuedBundles...)
+	outcomes, err := ms.closeReputerNonce(ctx, msg.TopicId, *msg.ReputerRequestNonce, bundles, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgInsertBulkReputerPayloadResponse{Outcomes: outcomes.List()}, nil
+}
 
-	// Check if the worker nonce is unfulfilled
-	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.ReputerRequestNonce.WorkerNonce)
+// Queues the bundles of a bulk payload until the nonce closes, once they pass the checks that do not
+// depend on the other bundles of the nonce, so a leader cannot queue a bundle a reputer did not sign for the nonce.
+// Returns the outcomes of the bundles left out of the queue. The queued bundles get theirs
+// in the submission outcomes event emitted when the nonce closes.
+func (ms msgServer) queueBulkReputerPayload(
+	ctx context.Context,
+	msg *types.MsgInsertBulkReputerPayload,
+	params types.Params,
+) (*types.MsgInsertBulkReputerPayloadResponse, error) {
+	reputerNonce := msg.ReputerRequestNonce.ReputerNonce.BlockHeight
+	closeBlock, err := ms.payloadCloseBlock(ctx, msg.TopicId, reputerNonce, params.PayloadSubmissionWindow)
+	if err != nil {
+		return nil, err
+	}
+	signatureOpts := bundleSignatureOptions(ctx, params)
+	outcomes := types.NewSubmissionOutcomes(msg.TopicId, types.ActorType_REPUTER)
+	for i, bundle := range msg.ReputerValueBundles {
+		if err := bundle.Validate(signatureOpts); err != nil {
+			reputer := ""
+			if bundle != nil && bundle.ValueBundle != nil {
+				reputer = bundle.ValueBundle.Reputer
+			}
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_INVALID)
+			continue
+		}
+		reputer := bundle.ValueBundle.Reputer
+		if bundle.ValueBundle.TopicId != msg.TopicId ||
+			bundle.ValueBundle.ReputerRequestNonce.WorkerNonce.BlockHeight != msg.ReputerRequestNonce.WorkerNonce.BlockHeight ||
+			bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != reputerNonce {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
+			continue
+		}
+		isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, reputer)
+		if err != nil {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
+			continue
+		}
+		if !isReputerRegistered {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED)
+			continue
+		}
+		var queued bool
+		closeBlock, queued, err = ms.k.QueueReputerPayload(ctx, msg.TopicId, reputerNonce, closeBlock, *bundle, false)
+		if err != nil {
+			return nil, err
+		}
+		if !queued {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE)
+		}
+	}
+	return &types.MsgInsertBulkReputerPayloadResponse{Outcomes: outcomes.List(), CloseBlock: closeBlock}, nil
+}
+
+// Called by a reputer to submit its own bundle, without going through a leader.
+// The bundle is ranked with the other bundles of its nonce when the nonce closes.
+func (ms msgServer) InsertReputerPayload(
+	ctx context.Context,
+	msg *types.MsgInsertReputerPayload,
+) (*types.MsgInsertReputerPayloadResponse, error) {
+	err := checkInputLength(ctx, ms, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
+	bundle := msg.ReputerValueBundle
+	if err := bundle.Validate(bundleSignatureOptions(ctx, params)); err != nil {
+		return nil, err
+	}
+
+	topicId := bundle.ValueBundle.TopicId
+	if err := ms.checkReputerNonceOpen(ctx, topicId, bundle.ValueBundle.ReputerRequestNonce); err != nil {
+		return nil, err
+	}
+
+	reputerNonce := bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight
+	closeBlock, err := ms.payloadCloseBlock(ctx, topicId, reputerNonce, params.PayloadSubmissionWindow)
+	if err != nil {
+		return nil, err
+	}
+	closeBlock, queued, err := ms.k.QueueReputerPayload(ctx, topicId, reputerNonce, closeBlock, *bundle, true)
+	if err != nil {
+		return nil, err
+	}
+	if !queued {
+		return nil, types.ErrPayloadAlreadySubmitted
+	}
+	return &types.MsgInsertReputerPayloadResponse{CloseBlock: closeBlock}, nil
+}
+
+// Closes a reputer nonce whose submission window ended, ranking the bundles submitted for it.
+// Called by the EndBlocker, so the commit of the nonce is attributed to the module.
+func CloseReputerNonce(ctx context.Context, k keeper.Keeper, topicId uint64, reputerNonce int64, bundles []*types.ReputerValueBundle) error {
+	ms := msgServer{k: k}
+	unfulfilledNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, nonce := range unfulfilledNonces.Nonces {
+		if nonce.ReputerNonce.BlockHeight != reputerNonce {
+			continue
+		}
+		if err := ms.checkReputerNonceOpen(ctx, topicId, nonce); err != nil {
+			return err
+		}
+		_, err := ms.closeReputerNonce(ctx, topicId, *nonce, bundles, types.ModuleName)
+		return err
+	}
+	return errorsmod.Wrapf(types.ErrNonceAlreadyFulfilled, "Reputer nonce already fulfilled: %v", reputerNonce)
+}
+
+// Errors unless the topic exists, the worker nonce is fulfilled and the reputer nonce is not
+func (ms msgServer) checkReputerNonceOpen(ctx context.Context, topicId uint64, nonce *types.ReputerRequestNonce) error {
+	// Check if the topic exists
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil || !topicExists {
+		return sdkerrors.ErrNotFound
+	}
+
+	// Check if the worker nonce is unfulfilled
+	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce.WorkerNonce)
+	if err != nil {
+		return err
+	}
 	// Throw if worker nonce is unfulfilled -- can't report losses on something not yet committed
 	if workerNonceUnfulfilled {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			types.ErrNonceStillUnfulfilled,
 			"Reputer's worker nonce not yet fulfilled: %v  for reputer block: %v",
-			msg.ReputerRequestNonce.WorkerNonce.BlockHeight,
-			msg.ReputerRequestNonce.ReputerNonce.BlockHeight,
+			nonce.WorkerNonce.BlockHeight,
+			nonce.ReputerNonce.BlockHeight,
 		)
 	}
 
 	// Check if the reputer nonce is unfulfilled
-	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce)
+	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	// Throw if already fulfilled -- can't return a response twice
 	if !reputerNonceUnfulfilled {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			types.ErrNonceAlreadyFulfilled,
 			"Reputer nonce already fulfilled: %v",
-			msg.ReputerRequestNonce.ReputerNonce.BlockHeight,
+			nonce.ReputerNonce.BlockHeight,
 		)
 	}
+	return nil
+}
+
+// Ranks the bundles submitted for an unfulfilled reputer nonce, stores the losses of the top reputers
+// and the network losses and regrets they imply, fulfills the nonce and makes the topic rewardable.
+func (ms msgServer) closeReputerNonce(
+	ctx context.Context,
+	topicId uint64,
+	reputerRequestNonce types.ReputerRequestNonce,
+	reputerValueBundles []*types.ReputerValueBundle,
+	committer string,
+) (*types.SubmissionOutcomes, error) {
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound
+	}
 
 	params, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
+	topicParams, err := ms.k.GetEffectiveTopicParams(ctx, topicId)
+	if err != nil {
+		return nil, err
+	}
 
 	/// Do checks on each reputer in the payload
 	// Iterate through the array to ensure each reputer is in the whitelist
 	// and get get score for each reputer => later we can skim only the top few by score descending
 	lossBundlesByReputer := make(map[string]*types.ReputerValueBundle)
 	latestReputerScores := make(map[string]types.Score)
-	for _, bundle := range msg.ReputerValueBundles {
-		if err := bundle.Validate(); err != nil {
+	bundleIndexByReputer := make(map[string]int)
+	signatureOpts := bundleSignatureOptions(ctx, params)
+	outcomes := types.NewSubmissionOutcomes(topicId, types.ActorType_REPUTER)
+	for i, bundle := range reputerValueBundles {
+		if err := bundle.Validate(signatureOpts); err != nil {
+			reputer := ""
+			if bundle != nil && bundle.ValueBundle != nil {
+				reputer = bundle.ValueBundle.Reputer
+			}
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_INVALID)
 			continue
 		}
 
 		reputer := bundle.ValueBundle.Reputer
 
 		// Check that the reputer's value bundle is for a topic matching the leader's given topic
-		if bundle.ValueBundle.TopicId != msg.TopicId {
+		if bundle.ValueBundle.TopicId != topicId {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
 		// Check that the reputer's value bundle is for a nonce matching the leader's given nonce
-		if bundle.ValueBundle.ReputerRequestNonce.WorkerNonce.BlockHeight != msg.ReputerRequestNonce.WorkerNonce.BlockHeight {
+		if bundle.ValueBundle.ReputerRequestNonce.WorkerNonce.BlockHeight != reputerRequestNonce.WorkerNonce.BlockHeight {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
-		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != msg.ReputerRequestNonce.ReputerNonce.BlockHeight {
+		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != reputerRequestNonce.ReputerNonce.BlockHeight {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
 
@@ -102,19 +282,23 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// Check that the reputer is registered in the topic
 			isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, bundle.ValueBundle.TopicId, reputer)
 			if err != nil {
+				outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			// We'll keep what we can get from the payload, but we'll ignore the rest
 			if !isReputerRegistered {
+				outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED)
 				continue
 			}
 
 			// Check that the reputer enough stake in the topic
-			stake, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, reputer)
+			stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, reputer)
 			if err != nil {
+				outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			if stake.LT(params.RequiredMinimumStake) {
+				outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_INSUFFICIENT_STAKE)
 				continue
 			}
 
@@ -122,8 +306,9 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// A check of their registration and other filters have already been applied when their inferences were inserted.
 			// We keep what we can, ignoring the reputer and their contribution (losses) entirely
 			// if they're left with no valid losses.
-			filteredBundle, err := filterUnacceptedWorkersFromReputerValueBundle(ctx, ms, msg.TopicId, *msg.ReputerRequestNonce, bundle)
+			filteredBundle, err := filterUnacceptedWorkersFromReputerValueBundle(ctx, ms, topicId, reputerRequestNonce, bundle)
 			if err != nil {
+				outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_NO_ACCEPTED_WORKERS)
 				continue
 			}
 
@@ -134,27 +319,38 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// Get the latest score for each reputer
 			latestScore, err := ms.k.GetLatestReputerScore(ctx, bundle.ValueBundle.TopicId, reputer)
 			if err != nil {
+				outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			latestReputerScores[bundle.ValueBundle.Reputer] = latestScore
 			lossBundlesByReputer[bundle.ValueBundle.Reputer] = filteredBundle
+			bundleIndexByReputer[bundle.ValueBundle.Reputer] = i
+		} else {
+			outcomes.Reject(i, reputer, types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE)
 		}
 	}
 
 	// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
-	topReputers := FindTopNByScoreDesc(params.MaxTopReputersToReward, latestReputerScores, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	topReputers := FindTopNByScoreDesc(topicParams.MaxTopReputersToReward, latestReputerScores, reputerRequestNonce.ReputerNonce.BlockHeight)
 
 	// Check that the reputer in the payload is a top reputer among those who have submitted losses
 	stakesByReputer := make(map[string]cosmosMath.Int)
 	lossBundlesFromTopReputers := make([]*types.ReputerValueBundle, 0)
 	for _, reputer := range topReputers {
-		stake, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, reputer)
+		stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, reputer)
 		if err != nil {
+			outcomes.Reject(bundleIndexByReputer[reputer], reputer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 			continue
 		}
 
 		lossBundlesFromTopReputers = append(lossBundlesFromTopReputers, lossBundlesByReputer[reputer])
 		stakesByReputer[reputer] = stake
+		outcomes.Accept(bundleIndexByReputer[reputer], reputer)
+	}
+	for reputer, bundleIndex := range bundleIndexByReputer {
+		if !slices.Contains(topReputers, reputer) {
+			outcomes.Reject(bundleIndex, reputer, types.SubmissionOutcomeCode_SUBMISSION_NOT_TOP_RANKED)
+		}
 	}
 	// sort by reputer score descending
 	sort.Slice(lossBundlesFromTopReputers, func(i, j int) bool {
@@ -162,16 +358,18 @@ func (ms msgServer) InsertBulkReputerPayload(
 	})
 
 	if len(lossBundlesFromTopReputers) == 0 {
-		return nil, types.ErrNoValidBundles
+		return nil, errorsmod.Wrap(types.ErrNoValidBundles, outcomes.String())
 	}
 
 	bundles := types.ReputerValueBundles{
 		ReputerValueBundles: lossBundlesFromTopReputers,
 	}
-	err = ms.k.InsertReputerLossBundlesAtBlock(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, bundles)
+	err = ms.k.InsertReputerLossBundlesAtBlock(ctx, topicId, reputerRequestNonce.ReputerNonce.BlockHeight, bundles)
 	if err != nil {
 		return nil, err
 	}
+	types.IncrSubmissionsAccepted(sdk.UnwrapSDKContext(ctx), topicId, types.ActorType_REPUTER, len(lossBundlesFromTopReputers))
+	types.SetParticipantsGauge(sdk.UnwrapSDKContext(ctx), topicId, types.ActorType_REPUTER, len(lossBundlesFromTopReputers))
 
 	networkLossBundle, err := synth.CalcNetworkLosses(stakesByReputer, bundles, topic.Epsilon)
 	if err != nil {
@@ -179,46 +377,56 @@ func (ms msgServer) InsertBulkReputerPayload(
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle))
+	sdkCtx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", reputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle))
 
-	networkLossBundle.ReputerRequestNonce = msg.ReputerRequestNonce
+	networkLossBundle.ReputerRequestNonce = &reputerRequestNonce
 
-	err = ms.k.InsertNetworkLossBundleAtBlock(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
+	err = ms.k.InsertNetworkLossBundleAtBlock(ctx, topicId, reputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
 	if err != nil {
 		return nil, err
 	}
 
-	types.EmitNewNetworkLossSetEvent(sdkCtx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
+	types.EmitNewNetworkLossSetEvent(sdkCtx, topicId, reputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
 
-	err = synth.GetCalcSetNetworkRegrets(sdkCtx, ms.k, msg.TopicId, networkLossBundle, *msg.ReputerRequestNonce.ReputerNonce, topic.AlphaRegret)
+	err = synth.GetCalcSetNetworkRegrets(sdkCtx, ms.k, topicId, networkLossBundle, *reputerRequestNonce.ReputerNonce, topic.AlphaRegret)
 	if err != nil {
 		return nil, err
 	}
 
 	// Update the unfulfilled nonces
-	_, err = ms.k.FulfillReputerNonce(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce)
+	_, err = ms.k.FulfillReputerNonce(ctx, topicId, reputerRequestNonce.ReputerNonce)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update topic reward nonce
-	err = ms.k.SetTopicRewardNonce(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	// Losses reported for an inference request are paid by its bounty at the request's deadline,
+	// only the epochs of the topic are rewarded from emissions
+	_, isInferenceRequest, err := ms.k.GetInferenceRequestAtNonce(ctx, topicId, reputerRequestNonce.ReputerNonce.BlockHeight)
 	if err != nil {
 		return nil, err
 	}
+	if !isInferenceRequest {
+		// Update topic reward nonce
+		err = ms.k.SetTopicRewardNonce(ctx, topicId, reputerRequestNonce.ReputerNonce.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
 
-	err = ms.k.AddRewardableTopic(ctx, msg.TopicId)
-	if err != nil {
-		return nil, err
+		err = ms.k.AddRewardableTopic(ctx, topicId)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	blockHeight := sdkCtx.BlockHeight()
-	err = ms.k.SetTopicLastCommit(ctx, topic.Id, blockHeight, msg.ReputerRequestNonce.ReputerNonce, msg.Sender, types.ActorType_REPUTER)
+	err = ms.k.SetTopicLastCommit(ctx, topic.Id, blockHeight, reputerRequestNonce.ReputerNonce, committer, types.ActorType_REPUTER)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgInsertBulkReputerPayloadResponse{}, nil
+	types.EmitNewSubmissionOutcomesEvent(sdkCtx, topicId, reputerRequestNonce.ReputerNonce.BlockHeight, outcomes)
+	types.IncrSubmissionsRejected(sdkCtx, outcomes)
+	return outcomes, nil
 }
 
 // Filter out values of unaccepted workers.
@@ -334,7 +542,9 @@ func filterUnacceptedWorkersFromReputerValueBundle(
 			NaiveValue:             reputerValueBundle.ValueBundle.NaiveValue,
 			CombinedValue:          reputerValueBundle.ValueBundle.CombinedValue,
 		},
-		Signature: reputerValueBundle.Signature,
+		Signature:  reputerValueBundle.Signature,
+		Pubkey:     reputerValueBundle.Pubkey,
+		PubkeyType: reputerValueBundle.PubkeyType,
 	}
 
 	return acceptedReputerValueBundle, nil
@@ -115,9 +115,8 @@ func (s *MsgServerTestSuite) getBasicReputerPayload(
 
 func (s *MsgServerTestSuite) signValueBundle(reputerValueBundle *types.ValueBundle, privateKey secp256k1.PrivKey) []byte {
 	require := s.Require()
-	src := make([]byte, 0)
-	src, err := reputerValueBundle.XXX_Marshal(src, true)
-	require.NoError(err, "Marshall reputer value bundle should not return an error")
+	src, err := reputerValueBundle.SignBytes(s.ctx.ChainID())
+	require.NoError(err, "SignBytes should not return an error")
 
 	valueBundleSignature, err := privateKey.Sign(src)
 	require.NoError(err, "Sign should not return an error")
@@ -184,6 +183,51 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayload() {
 	require.NoError(err)
 }
 
+func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadReportsBundleOutcomes() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerPublicKeyBytes := reputerPrivateKey.PubKey().Bytes()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, workerNonce := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+
+	err := keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
+	require.NoError(err)
+
+	signedBundle := &types.ReputerValueBundle{
+		ValueBundle: &reputerValueBundle,
+		Signature:   s.signValueBundle(&reputerValueBundle, reputerPrivateKey),
+		Pubkey:      hex.EncodeToString(reputerPublicKeyBytes),
+	}
+	lossesMsg := &types.MsgInsertBulkReputerPayload{
+		Sender:  reputerAddr.String(),
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &reputerNonce,
+			WorkerNonce:  &workerNonce,
+		},
+		ReputerValueBundles: []*types.ReputerValueBundle{signedBundle, signedBundle},
+	}
+
+	response, err := msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
+	require.NoError(err)
+	require.Equal([]*types.SubmissionOutcome{
+		{BundleIndex: 0, Actor: reputerAddr.String(), Code: types.SubmissionOutcomeCode_SUBMISSION_ACCEPTED},
+		{BundleIndex: 1, Actor: reputerAddr.String(), Code: types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE},
+	}, response.Outcomes)
+	require.True(hasEvent(ctx, "emissions.v1.EventSubmissionOutcomes"))
+}
+
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedTopicIdsIsIgnored() {
 	ctx := s.ctx
 	require := s.Require()
@@ -345,6 +389,7 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnregisteredReputerI
 	)
 
 	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.ErrorContains(err, types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED.String())
 }
 
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnderstakeReputerIsIgnored() {
@@ -588,9 +633,8 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadInvalid() {
 		},
 	}
 
-	src := make([]byte, 0)
-	src, err = reputerValueBundle.XXX_Marshal(src, true)
-	require.NoError(err, "Marshall reputer value bundle should not return an error")
+	src, err := reputerValueBundle.SignBytes(s.ctx.ChainID())
+	require.NoError(err, "SignBytes should not return an error")
 
 	valueBundleSignature, err := reputerPrivateKey.Sign(src)
 	require.NoError(err, "Sign should not return an error")
@@ -741,9 +785,8 @@ func (s *MsgServerTestSuite) TestMsgInsertHugeBulkReputerPayloadFails() {
 		},
 	}
 
-	src := make([]byte, 0)
-	src, err = reputerValueBundle.XXX_Marshal(src, true)
-	require.NoError(err, "Marshall reputer value bundle should not return an error")
+	src, err := reputerValueBundle.SignBytes(s.ctx.ChainID())
+	require.NoError(err, "SignBytes should not return an error")
 
 	valueBundleSignature, err := reputerPrivateKey.Sign(src)
 	require.NoError(err, "Sign should not return an error")
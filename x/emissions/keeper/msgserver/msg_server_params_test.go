@@ -1,10 +1,13 @@
 package msgserver_test
 
 import (
+	"cosmossdk.io/collections"
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -55,43 +58,53 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 	keeper.AddWhitelistAdmin(ctx, adminAddr.String())
 
 	newParams := &types.OptionalParams{
-		Version:                         []string{"1234"},
-		MinTopicWeight:                  []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
-		MaxTopicsPerBlock:               []uint64{1234},
-		RequiredMinimumStake:            []cosmosMath.Int{cosmosMath.NewInt(1234)},
-		RemoveStakeDelayWindow:          []int64{1234},
-		MinEpochLength:                  []int64{1234},
-		BetaEntropy:                     []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		LearningRate:                    []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		GradientDescentMaxIters:         []uint64{1234},
-		MaxGradientThreshold:            []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		MinStakeFraction:                []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		EpsilonReputer:                  []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		MaxUnfulfilledWorkerRequests:    []uint64{1234},
-		MaxUnfulfilledReputerRequests:   []uint64{1234},
-		TopicRewardStakeImportance:      []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		TopicRewardFeeRevenueImportance: []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		TopicRewardAlpha:                []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		TaskRewardAlpha:                 []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		ValidatorsVsAlloraPercentReward: []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
-		MaxSamplesToScaleScores:         []uint64{1234},
-		MaxTopInferersToReward:          []uint64{1234},
-		MaxTopForecastersToReward:       []uint64{1234},
-		MaxTopReputersToReward:          []uint64{1234},
-		CreateTopicFee:                  []cosmosMath.Int{cosmosMath.NewInt(1234)},
-		MaxRetriesToFulfilNoncesWorker:  []int64{1234},
-		MaxRetriesToFulfilNoncesReputer: []int64{1234},
-		RegistrationFee:                 []cosmosMath.Int{cosmosMath.NewInt(1234)},
-		DefaultPageLimit:                []uint64{1234},
-		MaxPageLimit:                    []uint64{1234},
-		MinEpochLengthRecordLimit:       []int64{1234},
-		MaxSerializedMsgLength:          []int64{1234},
-		PRewardInference:                []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
-		PRewardForecast:                 []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
-		PRewardReputer:                  []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
-		CRewardInference:                []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
-		CRewardForecast:                 []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
-		CNorm:                           []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		Version:                          []string{"1234"},
+		MinTopicWeight:                   []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		MaxTopicsPerBlock:                []uint64{1234},
+		RequiredMinimumStake:             []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		RemoveStakeDelayWindow:           []int64{1234},
+		MinEpochLength:                   []int64{1234},
+		BetaEntropy:                      []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		LearningRate:                     []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		GradientDescentMaxIters:          []uint64{1234},
+		MaxGradientThreshold:             []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		MinStakeFraction:                 []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		EpsilonReputer:                   []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		MaxUnfulfilledWorkerRequests:     []uint64{1234},
+		MaxUnfulfilledReputerRequests:    []uint64{1234},
+		TopicRewardStakeImportance:       []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		TopicRewardFeeRevenueImportance:  []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		TopicRewardAlpha:                 []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		TaskRewardAlpha:                  []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		MaxSamplesToScaleScores:          []uint64{1234},
+		MaxTopInferersToReward:           []uint64{1234},
+		MaxTopForecastersToReward:        []uint64{1234},
+		MaxTopReputersToReward:           []uint64{1234},
+		CreateTopicFee:                   []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		MaxRetriesToFulfilNoncesWorker:   []int64{1234},
+		MaxRetriesToFulfilNoncesReputer:  []int64{1234},
+		RegistrationFee:                  []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		DefaultPageLimit:                 []uint64{1234},
+		MaxPageLimit:                     []uint64{1234},
+		MinEpochLengthRecordLimit:        []int64{1234},
+		MaxSerializedMsgLength:           []int64{1234},
+		PRewardInference:                 []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		PRewardForecast:                  []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		PRewardReputer:                   []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		CRewardInference:                 []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		CRewardForecast:                  []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		CNorm:                            []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		TopicRevenueBurnFraction:         []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		MinParamChangeDelay:              []int64{1234},
+		MaxOpenInferenceRequestsPerTopic: []uint64{1234},
+		MaxStakeRemovalGasPerBlock:       []uint64{1234},
+		MaxRewardGasPerBlock:             []uint64{1234},
+		MaxPruningGasPerBlock:            []uint64{1234},
+		MaxInferenceDimension:            []uint64{16},
+		ProbabilitySumTolerance:          []alloraMath.Dec{alloraMath.MustNewDecFromString("0.01")},
+		MaxRegretHistoryLength:           []uint64{8},
+		LegacyBundleSignaturesEndBlock:   []int64{1234},
+		PayloadSubmissionWindow:          []int64{5},
 	}
 
 	updateMsg := &types.MsgUpdateParams{
@@ -123,7 +136,6 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 	require.Equal(newParams.TopicRewardFeeRevenueImportance[0], updatedParams.TopicRewardFeeRevenueImportance)
 	require.Equal(newParams.TopicRewardAlpha[0], updatedParams.TopicRewardAlpha)
 	require.Equal(newParams.TaskRewardAlpha[0], updatedParams.TaskRewardAlpha)
-	require.Equal(newParams.ValidatorsVsAlloraPercentReward[0], updatedParams.ValidatorsVsAlloraPercentReward)
 	require.Equal(newParams.MaxSamplesToScaleScores[0], updatedParams.MaxSamplesToScaleScores)
 	require.Equal(newParams.MaxTopInferersToReward[0], updatedParams.MaxTopInferersToReward)
 	require.Equal(newParams.MaxTopForecastersToReward[0], updatedParams.MaxTopForecastersToReward)
@@ -143,6 +155,17 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 	require.Equal(newParams.CRewardInference[0], updatedParams.CRewardInference)
 	require.Equal(newParams.CRewardForecast[0], updatedParams.CRewardForecast)
 	require.Equal(newParams.CNorm[0], updatedParams.CNorm)
+	require.Equal(newParams.TopicRevenueBurnFraction[0], updatedParams.TopicRevenueBurnFraction)
+	require.Equal(newParams.MinParamChangeDelay[0], updatedParams.MinParamChangeDelay)
+	require.Equal(newParams.MaxOpenInferenceRequestsPerTopic[0], updatedParams.MaxOpenInferenceRequestsPerTopic)
+	require.Equal(newParams.MaxStakeRemovalGasPerBlock[0], updatedParams.MaxStakeRemovalGasPerBlock)
+	require.Equal(newParams.MaxRewardGasPerBlock[0], updatedParams.MaxRewardGasPerBlock)
+	require.Equal(newParams.MaxPruningGasPerBlock[0], updatedParams.MaxPruningGasPerBlock)
+	require.Equal(newParams.MaxInferenceDimension[0], updatedParams.MaxInferenceDimension)
+	require.Equal(newParams.ProbabilitySumTolerance[0], updatedParams.ProbabilitySumTolerance)
+	require.Equal(newParams.MaxRegretHistoryLength[0], updatedParams.MaxRegretHistoryLength)
+	require.Equal(newParams.LegacyBundleSignaturesEndBlock[0], updatedParams.LegacyBundleSignaturesEndBlock)
+	require.Equal(newParams.PayloadSubmissionWindow[0], updatedParams.PayloadSubmissionWindow)
 }
 
 func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
@@ -169,5 +192,259 @@ func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
 
 	// Expect an error since the sender is not whitelisted
 	require.Nil(response, "Response should be nil when access is denied")
-	require.Error(err, types.ErrNotWhitelistAdmin, "Expected an error for non-whitelisted sender")
+	require.ErrorIs(err, types.ErrMissingRole, "Expected an error for non-whitelisted sender")
+}
+
+func (s *MsgServerTestSuite) TestUpdateParamsRequiresParamsAdminRole() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	mintAdminAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	err := s.emissionsKeeper.GrantRole(ctx, types.RoleMintAdmin, mintAdminAddr)
+	require.NoError(err)
+
+	updateMsg := &types.MsgUpdateParams{
+		Sender: mintAdminAddr,
+		Params: &types.OptionalParams{Version: []string{"2.0"}},
+	}
+	_, err = msgServer.UpdateParams(ctx, updateMsg)
+	require.ErrorIs(err, types.ErrMissingRole, "the mint admin role does not cover emissions params")
+
+	err = s.emissionsKeeper.GrantRole(ctx, types.RoleParamsAdmin, mintAdminAddr)
+	require.NoError(err)
+	_, err = msgServer.UpdateParams(ctx, updateMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) setMinParamChangeDelay(delay int64) {
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MinParamChangeDelay = delay
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, params))
+}
+
+func (s *MsgServerTestSuite) TestUpdateParamsRefusedWhileDelayIsSet() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	s.setMinParamChangeDelay(10)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{MaxTopicsPerBlock: []uint64{1}},
+	})
+	s.Require().ErrorIs(err, types.ErrParamsChangeMustBeScheduled)
+}
+
+func (s *MsgServerTestSuite) TestUpdateParamsRefusedWithDefaultDelay() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	s.setMinParamChangeDelay(types.DefaultParams().MinParamChangeDelay)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{MaxTopicsPerBlock: []uint64{1}},
+	})
+	s.Require().ErrorIs(err, types.ErrParamsChangeMustBeScheduled)
+}
+
+func (s *MsgServerTestSuite) TestScheduledParamsChangeAppliesAtTargetBlock() {
+	require := s.Require()
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	s.setMinParamChangeDelay(10)
+	ctx := s.ctx.WithBlockHeight(100)
+	original, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+
+	change := &types.OptionalParams{MaxTopicsPerBlock: []uint64{original.MaxTopicsPerBlock + 1}}
+	_, err = s.msgServer.ScheduleParamsChange(ctx, &types.MsgScheduleParamsChange{
+		Sender:       adminAddr,
+		Params:       change,
+		ApplyAtBlock: 109,
+	})
+	require.ErrorIs(err, types.ErrParamsChangeTooEarly)
+
+	resp, err := s.msgServer.ScheduleParamsChange(ctx, &types.MsgScheduleParamsChange{
+		Sender:       adminAddr,
+		Params:       change,
+		ApplyAtBlock: 110,
+	})
+	require.NoError(err)
+	require.True(hasEvent(ctx, "emissions.v1.EventParamsChangeScheduled"))
+
+	pending, err := s.emissionsKeeper.GetScheduledParamsChanges(ctx)
+	require.NoError(err)
+	require.Len(pending, 1)
+	require.Equal(resp.Id, pending[0].Id)
+	require.Equal(int64(100), pending[0].ScheduledAtBlock)
+
+	require.NoError(s.appModule.BeginBlock(ctx.WithBlockHeight(109)))
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(original.MaxTopicsPerBlock, params.MaxTopicsPerBlock, "not applied before the target block")
+
+	applyCtx := ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	require.NoError(s.appModule.BeginBlock(applyCtx))
+	params, err = s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(original.MaxTopicsPerBlock+1, params.MaxTopicsPerBlock)
+	require.True(hasEvent(applyCtx, "emissions.v1.EventParamsChangeApplied"))
+
+	pending, err = s.emissionsKeeper.GetScheduledParamsChanges(ctx)
+	require.NoError(err)
+	require.Empty(pending)
+}
+
+func (s *MsgServerTestSuite) TestScheduleInvalidParamsChangeFailsEarly() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	_, err := s.msgServer.ScheduleParamsChange(s.ctx, &types.MsgScheduleParamsChange{
+		Sender:       adminAddr,
+		Params:       &types.OptionalParams{TopicRevenueBurnFraction: []alloraMath.Dec{alloraMath.NewDecFromInt64(2)}},
+		ApplyAtBlock: s.ctx.BlockHeight() + 1,
+	})
+	s.Require().ErrorIs(err, types.ErrValidationMustBeBetweenZeroAndOne)
+}
+
+func (s *MsgServerTestSuite) TestMaxRegretHistoryLengthIsCapped() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{MaxRegretHistoryLength: []uint64{types.MaxRegretHistoryLengthLimit + 1}},
+	})
+	s.Require().ErrorIs(err, types.ErrValidationTooLarge)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{MaxRegretHistoryLength: []uint64{types.MaxRegretHistoryLengthLimit}},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestPayloadSubmissionWindowIsShorterThanMinEpochLength() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{PayloadSubmissionWindow: []int64{params.MinEpochLength}},
+	})
+	s.Require().ErrorIs(err, types.ErrValidationTooLarge)
+
+	// Lowering the min epoch length below the window is refused too
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{PayloadSubmissionWindow: []int64{params.MinEpochLength - 1}},
+	})
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{MinEpochLength: []int64{params.MinEpochLength - 1}},
+	})
+	s.Require().ErrorIs(err, types.ErrValidationTooLarge)
+}
+
+func (s *MsgServerTestSuite) TestDeprecatedParamsCannotBeChanged() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	update := &types.OptionalParams{
+		ValidatorsVsAlloraPercentReward: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")},
+	}
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Sender: adminAddr, Params: update})
+	s.Require().ErrorIs(err, types.ErrDeprecatedParam)
+
+	_, err = s.msgServer.ScheduleParamsChange(s.ctx, &types.MsgScheduleParamsChange{
+		Sender:       adminAddr,
+		Params:       update,
+		ApplyAtBlock: s.ctx.BlockHeight() + 1,
+	})
+	s.Require().ErrorIs(err, types.ErrDeprecatedParam)
+
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams().ValidatorsVsAlloraPercentReward, params.ValidatorsVsAlloraPercentReward)
+}
+
+func (s *MsgServerTestSuite) TestCancelScheduledParamsChange() {
+	require := s.Require()
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	nonAdminAddr := nonAdminAccounts[0].String()
+	ctx := s.ctx.WithBlockHeight(100)
+	original, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+
+	resp, err := s.msgServer.ScheduleParamsChange(ctx, &types.MsgScheduleParamsChange{
+		Sender:       adminAddr,
+		Params:       &types.OptionalParams{MaxTopicsPerBlock: []uint64{original.MaxTopicsPerBlock + 1}},
+		ApplyAtBlock: 101,
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.CancelParamsChange(ctx, &types.MsgCancelParamsChange{Sender: nonAdminAddr, Id: resp.Id})
+	require.ErrorIs(err, types.ErrMissingRole)
+
+	_, err = s.msgServer.CancelParamsChange(ctx, &types.MsgCancelParamsChange{Sender: adminAddr, Id: resp.Id})
+	require.NoError(err)
+	require.True(hasEvent(ctx, "emissions.v1.EventParamsChangeCancelled"))
+
+	_, err = s.msgServer.CancelParamsChange(ctx, &types.MsgCancelParamsChange{Sender: adminAddr, Id: resp.Id})
+	require.ErrorIs(err, types.ErrScheduledParamsChangeNotFound)
+
+	require.NoError(s.appModule.BeginBlock(ctx.WithBlockHeight(101)))
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(original.MaxTopicsPerBlock, params.MaxTopicsPerBlock, "a cancelled change is never applied")
+}
+
+func (s *MsgServerTestSuite) TestFailedScheduledParamsChangeEmitsEvent() {
+	require := s.Require()
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	ctx := s.ctx.WithBlockHeight(100)
+
+	resp, err := s.msgServer.ScheduleParamsChange(ctx, &types.MsgScheduleParamsChange{
+		Sender:       adminAddr,
+		Params:       &types.OptionalParams{MaxTopicsPerBlock: []uint64{5}},
+		ApplyAtBlock: 101,
+	})
+	require.NoError(err)
+
+	// a change scheduled before the param it sets was deprecated no longer applies
+	changes := collections.NewMap(
+		collections.NewSchemaBuilder(s.storeService),
+		types.ScheduledParamsChangesKey,
+		"scheduled_params_changes",
+		collections.Uint64Key,
+		codec.CollValue[types.ScheduledParamsChange](s.codec),
+	)
+	change, err := s.emissionsKeeper.GetScheduledParamsChange(ctx, resp.Id)
+	require.NoError(err)
+	change.Params.ValidatorsVsAlloraPercentReward = []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")}
+	require.NoError(changes.Set(ctx, resp.Id, change))
+
+	applyCtx := ctx.WithBlockHeight(101).WithEventManager(sdk.NewEventManager())
+	require.NoError(s.appModule.BeginBlock(applyCtx))
+	require.False(hasEvent(applyCtx, "emissions.v1.EventParamsChangeApplied"))
+
+	var failed *types.EventParamsChangeFailed
+	for _, event := range applyCtx.EventManager().Events() {
+		if event.Type != "emissions.v1.EventParamsChangeFailed" {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(err)
+		failed = typed.(*types.EventParamsChangeFailed)
+	}
+	require.NotNil(failed)
+	require.Equal(resp.Id, failed.Id)
+	require.Equal(int64(101), failed.BlockHeight)
+	require.Contains(failed.Error, types.ErrDeprecatedParam.Error())
+
+	pending, err := s.emissionsKeeper.GetScheduledParamsChanges(ctx)
+	require.NoError(err)
+	require.Empty(pending)
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
 }
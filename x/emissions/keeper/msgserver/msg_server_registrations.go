@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
-	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -33,7 +33,7 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 	}
 
 	// Before creating topic, transfer fee amount from creator to ecosystem bucket
-	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
+	err = ms.k.SendTopicRevenueToEcosystem(ctx, msg.Sender, fee.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +116,62 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 	}, nil
 }
 
+// Rotates the libp2p key and multiaddress of one node of the sender, keeping its topic registrations
+func (ms msgServer) UpdateNodeInfo(ctx context.Context, msg *types.MsgUpdateNodeInfo) (*types.MsgUpdateNodeInfoResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var previousNode types.OffchainNode
+	var err error
+	if msg.IsReputer {
+		previousNode, err = ms.k.UpdateReputerNodeInfo(ctx, msg.Sender, msg.PreviousLibP2PKey, msg.LibP2PKey, msg.MultiAddress)
+	} else {
+		previousNode, err = ms.k.UpdateWorkerNodeInfo(ctx, msg.Sender, msg.PreviousLibP2PKey, msg.LibP2PKey, msg.MultiAddress)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	types.EmitNewNodeInfoUpdatedEvent(sdk.UnwrapSDKContext(ctx), msg, previousNode.LibP2PKey)
+
+	return &types.MsgUpdateNodeInfoResponse{}, nil
+}
+
+// Sets where the worker rewards of the sender are sent, in one topic or in all topics
+func (ms msgServer) SetPayoutAddress(ctx context.Context, msg *types.MsgSetPayoutAddress) (*types.MsgSetPayoutAddressResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	// Module accounts and other blocked addresses would refuse every reward sent to them
+	if msg.PayoutAddress != "" && ms.k.BlockedAddr(sdk.MustAccAddressFromBech32(msg.PayoutAddress)) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedPayoutAddress, "%s", msg.PayoutAddress)
+	}
+
+	if msg.Global {
+		err := ms.k.SetGlobalPayoutAddress(ctx, msg.Sender, msg.PayoutAddress)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		if !topicExists {
+			return nil, types.ErrTopicDoesNotExist
+		}
+
+		err = ms.k.SetTopicPayoutAddress(ctx, msg.TopicId, msg.Sender, msg.PayoutAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	types.EmitNewPayoutAddressSetEvent(sdk.UnwrapSDKContext(ctx), msg)
+
+	return &types.MsgSetPayoutAddressResponse{}, nil
+}
+
 func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, address string) (bool, sdk.Coin, error) {
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -385,3 +386,229 @@ func (s *MsgServerTestSuite) TestMsgRegisterReputerInvalidTopicNotExist() {
 	_, err := msgServer.Register(ctx, registerMsg)
 	require.ErrorIs(err, types.ErrTopicDoesNotExist, "Register should return an error")
 }
+
+func (s *MsgServerTestSuite) TestMsgSetPayoutAddress() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	topicTreasury := sdk.AccAddress(PKS[1].Address()).String()
+	globalTreasury := sdk.AccAddress(PKS[2].Address()).String()
+	topicId := uint64(1)
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId})
+
+	_, err := msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{
+		Sender:        workerAddr,
+		Global:        true,
+		PayoutAddress: globalTreasury,
+	})
+	require.NoError(err)
+	payoutAddress, err := s.emissionsKeeper.GetPayoutAddress(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.Equal(globalTreasury, payoutAddress)
+
+	// A topic payout address takes precedence over the global one
+	_, err = msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{
+		Sender:        workerAddr,
+		TopicId:       topicId,
+		PayoutAddress: topicTreasury,
+	})
+	require.NoError(err)
+	payoutAddress, err = s.emissionsKeeper.GetPayoutAddress(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.Equal(topicTreasury, payoutAddress)
+	payoutAddress, err = s.emissionsKeeper.GetPayoutAddress(ctx, topicId+1, workerAddr)
+	require.NoError(err)
+	require.Equal(globalTreasury, payoutAddress)
+
+	// Clearing both sends the rewards back to the worker
+	_, err = msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{Sender: workerAddr, TopicId: topicId})
+	require.NoError(err)
+	_, err = msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{Sender: workerAddr, Global: true})
+	require.NoError(err)
+	payoutAddress, err = s.emissionsKeeper.GetPayoutAddress(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.Equal(workerAddr, payoutAddress)
+}
+
+func (s *MsgServerTestSuite) TestMsgSetPayoutAddressInvalid() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	topicId := uint64(1)
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId})
+
+	_, err := msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{
+		Sender:        workerAddr,
+		TopicId:       topicId,
+		PayoutAddress: "not-a-bech32-address",
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	// The fee collector is blocked by the bank keeper and would refuse every reward
+	_, err = msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{
+		Sender:        workerAddr,
+		Global:        true,
+		PayoutAddress: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+	})
+	require.ErrorIs(err, types.ErrBlockedPayoutAddress)
+
+	_, err = msgServer.SetPayoutAddress(ctx, &types.MsgSetPayoutAddress{
+		Sender:        workerAddr,
+		TopicId:       topicId + 1,
+		PayoutAddress: workerAddr,
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfo() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	otherWorkerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	ownerAddr := sdk.AccAddress(PKS[2].Address()).String()
+	workerNode := types.OffchainNode{
+		LibP2PKey:    "old-key",
+		MultiAddress: "old-address",
+		Owner:        ownerAddr,
+		NodeAddress:  workerAddr,
+		NodeId:       ownerAddr + "|old-key",
+	}
+	for _, topicId := range []uint64{1, 2} {
+		s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId})
+		require.NoError(s.emissionsKeeper.InsertWorker(ctx, topicId, workerAddr, workerNode))
+	}
+	require.NoError(s.emissionsKeeper.InsertWorker(ctx, 1, otherWorkerAddr, types.OffchainNode{
+		LibP2PKey:   "other-key",
+		NodeAddress: otherWorkerAddr,
+	}))
+
+	_, err := msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            workerAddr,
+		PreviousLibP2PKey: "old-key",
+		LibP2PKey:         "other-key",
+		MultiAddress:      "new-address",
+	})
+	require.ErrorIs(err, types.ErrLibP2PKeyTaken)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            otherWorkerAddr,
+		PreviousLibP2PKey: "old-key",
+		LibP2PKey:         "stolen-key",
+		MultiAddress:      "new-address",
+	})
+	require.ErrorIs(err, types.ErrAddressNotRegistered, "only the address that registered a node can rotate it")
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            workerAddr,
+		PreviousLibP2PKey: "old-key",
+		LibP2PKey:         "new-key",
+		MultiAddress:      "new-address",
+	})
+	require.NoError(err)
+
+	_, err = s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "old-key")
+	require.Error(err, "the node must no longer be found under its previous key")
+	node, err := s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "new-key")
+	require.NoError(err)
+	require.Equal(types.OffchainNode{
+		LibP2PKey:    "new-key",
+		MultiAddress: "new-address",
+		Owner:        ownerAddr,
+		NodeAddress:  workerAddr,
+		NodeId:       ownerAddr + "|new-key",
+	}, node)
+	for _, topicId := range []uint64{1, 2} {
+		isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr)
+		require.NoError(err)
+		require.True(isRegistered, "the worker keeps its topic registrations")
+	}
+
+	var updatedEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "emissions.v1.EventNodeInfoUpdated" {
+			updatedEvents++
+		}
+	}
+	require.Equal(1, updatedEvents)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoFailsWhenNotRegistered() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	_, err := msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            reputerAddr,
+		PreviousLibP2PKey: "old-key",
+		LibP2PKey:         "new-key",
+		MultiAddress:      "new-address",
+		IsReputer:         true,
+	})
+	require.ErrorIs(err, types.ErrAddressNotRegistered)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoOnlyRotatesTheListedNode() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	firstOwner := sdk.AccAddress(PKS[1].Address()).String()
+	secondOwner := sdk.AccAddress(PKS[2].Address()).String()
+	firstNode := types.OffchainNode{LibP2PKey: "first-key", Owner: firstOwner, NodeAddress: reputerAddr}
+	secondNode := types.OffchainNode{LibP2PKey: "second-key", Owner: secondOwner, NodeAddress: reputerAddr}
+	for _, topicId := range []uint64{1, 2, 3} {
+		s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId})
+	}
+	require.NoError(s.emissionsKeeper.InsertReputer(ctx, 1, reputerAddr, firstNode))
+	require.NoError(s.emissionsKeeper.InsertReputer(ctx, 2, reputerAddr, firstNode))
+	require.NoError(s.emissionsKeeper.InsertReputer(ctx, 3, reputerAddr, secondNode))
+
+	// The node is no longer registered under the key once it left the topics it was registered in with it
+	require.NoError(s.emissionsKeeper.RemoveReputer(ctx, 3, reputerAddr))
+	_, err := msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            reputerAddr,
+		PreviousLibP2PKey: "second-key",
+		LibP2PKey:         "new-second-key",
+		MultiAddress:      "new-address",
+		IsReputer:         true,
+	})
+	require.ErrorIs(err, types.ErrAddressNotRegistered)
+	require.NoError(s.emissionsKeeper.InsertReputer(ctx, 3, reputerAddr, secondNode))
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            reputerAddr,
+		PreviousLibP2PKey: "first-key",
+		LibP2PKey:         "new-first-key",
+		MultiAddress:      "new-address",
+		IsReputer:         true,
+	})
+	require.NoError(err)
+
+	node, err := s.emissionsKeeper.GetReputerByLibp2pKey(ctx, "new-first-key")
+	require.NoError(err)
+	require.Equal(firstOwner, node.Owner)
+	node, err = s.emissionsKeeper.GetReputerByLibp2pKey(ctx, "second-key")
+	require.NoError(err)
+	require.Equal(secondNode, node, "the other node of the reputer is left untouched")
+
+	// The topics registered with the first key follow it to the new one
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            reputerAddr,
+		PreviousLibP2PKey: "first-key",
+		LibP2PKey:         "newer-first-key",
+		MultiAddress:      "new-address",
+		IsReputer:         true,
+	})
+	require.ErrorIs(err, types.ErrAddressNotRegistered)
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:            reputerAddr,
+		PreviousLibP2PKey: "new-first-key",
+		LibP2PKey:         "new-first-key",
+		MultiAddress:      "newer-address",
+		IsReputer:         true,
+	})
+	require.NoError(err)
+}
@@ -1801,3 +1801,76 @@ func (s *MsgServerTestSuite) TestCancelRemoveDelegateStakeNotExist() {
 	require.Error(err)
 	require.True(errors.Is(err, types.ErrStakeRemovalNotFound), "Expected delegate stake removal not found error")
 }
+
+func (s *MsgServerTestSuite) TestStakeRemovalsCarryOverWhenOverGasBudget() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	topicId := uint64(123)
+	stakeAmount := cosmosMath.NewInt(50)
+	blockEnd := ctx.BlockHeight() + 10
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxStakeRemovalGasPerBlock = 1
+	require.NoError(keeper.SetParams(ctx, moduleParams))
+
+	s.MintTokensToModule(types.AlloraStakingAccountName, cosmosMath.NewInt(1000))
+	reputers := []sdk.AccAddress{PKS[0].Address().Bytes(), PKS[1].Address().Bytes(), PKS[2].Address().Bytes()}
+	for _, reputer := range reputers {
+		require.NoError(keeper.AddReputerStake(ctx, topicId, reputer.String(), stakeAmount))
+		require.NoError(keeper.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+			TopicId:               topicId,
+			Reputer:               reputer.String(),
+			Amount:                stakeAmount,
+			BlockRemovalStarted:   ctx.BlockHeight(),
+			BlockRemovalCompleted: blockEnd,
+		}))
+	}
+
+	// one removal fits in the budget each block, the others stay due
+	for i := range reputers {
+		ctx = ctx.WithBlockHeight(blockEnd + int64(i))
+		require.NoError(s.appModule.EndBlock(ctx))
+		due, err := keeper.CountStakeRemovalsUpUntilBlock(ctx, ctx.BlockHeight())
+		require.NoError(err)
+		require.Equal(uint64(len(reputers)-i-1), due)
+	}
+	for _, reputer := range reputers {
+		stake, err := keeper.GetStakeReputerAuthority(ctx, topicId, reputer.String())
+		require.NoError(err)
+		require.True(stake.IsZero())
+		require.True(stakeAmount.Equal(s.bankKeeper.GetBalance(ctx, reputer, params.DefaultBondDenom).Amount))
+	}
+}
+
+func (s *MsgServerTestSuite) TestFailedStakeRemovalIsDroppedWithoutPayingOut() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	topicId := uint64(123)
+	reputer := sdk.AccAddress(PKS[0].Address())
+	blockEnd := ctx.BlockHeight() + 10
+
+	s.MintTokensToModule(types.AlloraStakingAccountName, cosmosMath.NewInt(1000))
+	require.NoError(keeper.AddReputerStake(ctx, topicId, reputer.String(), cosmosMath.NewInt(50)))
+	// more than what is staked, the removal cannot go through
+	require.NoError(keeper.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+		TopicId:               topicId,
+		Reputer:               reputer.String(),
+		Amount:                cosmosMath.NewInt(100),
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		BlockRemovalCompleted: blockEnd,
+	}))
+
+	ctx = ctx.WithBlockHeight(blockEnd)
+	require.NoError(s.appModule.EndBlock(ctx))
+
+	require.True(s.bankKeeper.GetBalance(ctx, reputer, params.DefaultBondDenom).Amount.IsZero(), "nothing is paid out")
+	stake, err := keeper.GetStakeReputerAuthority(ctx, topicId, reputer.String())
+	require.NoError(err)
+	require.True(cosmosMath.NewInt(50).Equal(stake))
+	_, found, err := keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer.String(), topicId)
+	require.NoError(err)
+	require.False(found, "the removal is not retried every block")
+}
@@ -0,0 +1,72 @@
+package msgserver
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Escrows funds that are paid into the topic's fee revenue every epoch.
+// The first payment is made in the EndBlock of the current block.
+func (ms msgServer) CreateSubscription(ctx context.Context, msg *types.MsgCreateSubscription) (*types.MsgCreateSubscriptionResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	// Check sender has funds to pay for the escrow
+	// bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraRequestsAccountName, sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, msg.Escrow)))
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	subscription := types.Subscription{
+		Consumer:         msg.Sender,
+		TopicId:          msg.TopicId,
+		Escrow:           msg.Escrow,
+		AmountPerEpoch:   msg.AmountPerEpoch,
+		NextPaymentBlock: sdkCtx.BlockHeight(),
+	}
+	subscription.Id, err = ms.k.AddSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+	types.EmitNewSubscriptionCreatedEvent(sdkCtx, subscription)
+
+	return &types.MsgCreateSubscriptionResponse{SubscriptionId: subscription.Id}, nil
+}
+
+// Stops a subscription and refunds what is left of its escrow to the consumer
+func (ms msgServer) CancelSubscription(ctx context.Context, msg *types.MsgCancelSubscription) (*types.MsgCancelSubscriptionResponse, error) {
+	subscription, err := ms.k.GetSubscription(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Consumer != msg.Sender {
+		return nil, errorsmod.Wrapf(types.ErrNotSubscriptionConsumer, "subscription %d", msg.SubscriptionId)
+	}
+
+	if subscription.Escrow.IsPositive() {
+		err = ms.k.SendCoinsFromModuleToAccount(ctx, types.AlloraRequestsAccountName, subscription.Consumer, sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, subscription.Escrow)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.k.RemoveSubscription(ctx, subscription.Id); err != nil {
+		return nil, err
+	}
+	types.EmitNewSubscriptionCancelledEvent(sdk.UnwrapSDKContext(ctx), subscription)
+
+	return &types.MsgCancelSubscriptionResponse{}, nil
+}
@@ -0,0 +1,152 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) createSubscription(consumer sdk.AccAddress, topicId uint64, escrow, amountPerEpoch int64) (*types.MsgCreateSubscriptionResponse, error) {
+	return s.msgServer.CreateSubscription(s.ctx, &types.MsgCreateSubscription{
+		Sender:         consumer.String(),
+		TopicId:        topicId,
+		Escrow:         cosmosMath.NewInt(escrow),
+		AmountPerEpoch: cosmosMath.NewInt(amountPerEpoch),
+	})
+}
+
+func (s *MsgServerTestSuite) TestCreateSubscriptionEscrowsFunds() {
+	require := s.Require()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	consumerAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(consumerAddr, cosmosMath.NewInt(1000))
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, consumerAddr, params.DefaultBondDenom).Amount
+
+	resp, err := s.createSubscription(consumerAddr, topicId, 250, 100)
+	require.NoError(err)
+	require.True(hasEvent(s.ctx, "emissions.v1.EventSubscriptionCreated"))
+
+	require.True(balanceBefore.SubRaw(250).Equal(s.bankKeeper.GetBalance(s.ctx, consumerAddr, params.DefaultBondDenom).Amount))
+	requestsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRequestsAccountName)
+	require.True(cosmosMath.NewInt(250).Equal(s.bankKeeper.GetBalance(s.ctx, requestsAddr, params.DefaultBondDenom).Amount))
+
+	subscription, err := s.emissionsKeeper.GetSubscription(s.ctx, resp.SubscriptionId)
+	require.NoError(err)
+	require.Equal(consumerAddr.String(), subscription.Consumer)
+	require.Equal(int64(100), subscription.NextPaymentBlock, "first payment in this block")
+
+	bySender, err := s.emissionsKeeper.GetConsumerSubscriptions(s.ctx, consumerAddr.String())
+	require.NoError(err)
+	require.Len(bySender, 1)
+	byTopic, err := s.emissionsKeeper.GetTopicSubscriptions(s.ctx, topicId)
+	require.NoError(err)
+	require.Len(byTopic, 1)
+}
+
+func (s *MsgServerTestSuite) TestCreateSubscriptionInvalid() {
+	require := s.Require()
+	consumerAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(consumerAddr, cosmosMath.NewInt(1000))
+
+	_, err := s.createSubscription(consumerAddr, topicId, 100, 0)
+	require.ErrorIs(err, types.ErrInvalidValue)
+	_, err = s.createSubscription(consumerAddr, topicId, 50, 100)
+	require.ErrorIs(err, types.ErrInvalidValue, "escrow must cover one epoch")
+	_, err = s.createSubscription(consumerAddr, topicId+1, 100, 100)
+	require.ErrorIs(err, types.ErrInvalidTopicId)
+}
+
+func (s *MsgServerTestSuite) TestSubscriptionPaysEachEpochUntilItLapses() {
+	require := s.Require()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	consumerAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.CreateOneTopic()
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	s.MintTokensToAddress(consumerAddr, cosmosMath.NewInt(1000))
+
+	resp, err := s.createSubscription(consumerAddr, topicId, 250, 100)
+	require.NoError(err)
+
+	for _, expected := range []struct {
+		block       int64
+		feeRevenue  int64
+		escrowLeft  int64
+		nextPayment int64
+	}{
+		{100, 100, 150, 100 + topic.EpochLength},
+		{100 + topic.EpochLength, 200, 50, 100 + 2*topic.EpochLength},
+	} {
+		s.ctx = s.ctx.WithBlockHeight(expected.block)
+		require.NoError(s.appModule.EndBlock(s.ctx))
+		feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(s.ctx, topicId)
+		require.NoError(err)
+		require.True(cosmosMath.NewInt(expected.feeRevenue).Equal(feeRevenue), "fee revenue %s at block %d", feeRevenue, expected.block)
+		subscription, err := s.emissionsKeeper.GetSubscription(s.ctx, resp.SubscriptionId)
+		require.NoError(err)
+		require.True(cosmosMath.NewInt(expected.escrowLeft).Equal(subscription.Escrow))
+		require.Equal(expected.nextPayment, subscription.NextPaymentBlock)
+	}
+
+	// nothing is due in between epochs
+	s.ctx = s.ctx.WithBlockHeight(101 + topic.EpochLength)
+	require.NoError(s.appModule.EndBlock(s.ctx))
+	subscription, err := s.emissionsKeeper.GetSubscription(s.ctx, resp.SubscriptionId)
+	require.NoError(err)
+	require.True(cosmosMath.NewInt(50).Equal(subscription.Escrow))
+
+	// the last payment is what is left of the escrow
+	s.ctx = s.ctx.WithBlockHeight(100 + 2*topic.EpochLength).WithEventManager(sdk.NewEventManager())
+	require.NoError(s.appModule.EndBlock(s.ctx))
+	feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(s.ctx, topicId)
+	require.NoError(err)
+	require.True(cosmosMath.NewInt(250).Equal(feeRevenue))
+	require.True(hasEvent(s.ctx, "emissions.v1.EventSubscriptionLapsed"))
+	_, err = s.emissionsKeeper.GetSubscription(s.ctx, resp.SubscriptionId)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+	byTopic, err := s.emissionsKeeper.GetTopicSubscriptions(s.ctx, topicId)
+	require.NoError(err)
+	require.Empty(byTopic)
+
+	requestsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRequestsAccountName)
+	require.True(s.bankKeeper.GetBalance(s.ctx, requestsAddr, params.DefaultBondDenom).Amount.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestCancelSubscriptionRefundsEscrow() {
+	require := s.Require()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	consumerAddr := sdk.AccAddress(PKS[0].Address())
+	otherAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(consumerAddr, cosmosMath.NewInt(1000))
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, consumerAddr, params.DefaultBondDenom).Amount
+
+	resp, err := s.createSubscription(consumerAddr, topicId, 250, 100)
+	require.NoError(err)
+	require.NoError(s.appModule.EndBlock(s.ctx))
+
+	_, err = s.msgServer.CancelSubscription(s.ctx, &types.MsgCancelSubscription{
+		Sender:         otherAddr.String(),
+		SubscriptionId: resp.SubscriptionId,
+	})
+	require.ErrorIs(err, types.ErrNotSubscriptionConsumer)
+
+	_, err = s.msgServer.CancelSubscription(s.ctx, &types.MsgCancelSubscription{
+		Sender:         consumerAddr.String(),
+		SubscriptionId: resp.SubscriptionId,
+	})
+	require.NoError(err)
+	require.True(hasEvent(s.ctx, "emissions.v1.EventSubscriptionCancelled"))
+	require.True(balanceBefore.SubRaw(100).Equal(s.bankKeeper.GetBalance(s.ctx, consumerAddr, params.DefaultBondDenom).Amount), "only the first payment is kept")
+	_, err = s.emissionsKeeper.GetSubscription(s.ctx, resp.SubscriptionId)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+
+	_, err = s.msgServer.CancelSubscription(s.ctx, &types.MsgCancelSubscription{
+		Sender:         consumerAddr.String(),
+		SubscriptionId: resp.SubscriptionId,
+	})
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+}
@@ -86,12 +86,13 @@ func (s *MsgServerTestSuite) SetupTest() {
 	s.addressCodec = addressCodec
 
 	maccPerms := map[string][]string{
-		"fee_collector":                {"minter"},
-		"mint":                         {"minter"},
-		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
-		types.AlloraRewardsAccountName: {"minter"},
+		"fee_collector":                                  {"minter"},
+		"mint":                                           {"minter"},
+		types.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
+		types.AlloraRewardsAccountName:                   {"minter"},
+		types.AlloraRequestsAccountName:                  nil,
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
-		minttypes.EcosystemModuleName:                    nil,
+		minttypes.EcosystemModuleName:                    {"burner"},
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
 		multiPerm:                                        {"burner", "minter", "staking"},
@@ -122,7 +123,7 @@ func (s *MsgServerTestSuite) SetupTest() {
 		encCfg.Codec,
 		storeService,
 		accountKeeper,
-		map[string]bool{},
+		map[string]bool{authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(): true},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		log.NewNopLogger(),
 	)
@@ -143,6 +144,14 @@ func (s *MsgServerTestSuite) SetupTest() {
 	appModule.InitGenesis(ctx, encCfg.Codec, defaultGenesis)
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
 
+	// Params change immediately and bulk payloads are ranked on arrival,
+	// tests of the params timelock and of the submission window set their own
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+	moduleParams.MinParamChangeDelay = 0
+	moduleParams.PayloadSubmissionWindow = 0
+	s.Require().NoError(s.emissionsKeeper.SetParams(ctx, moduleParams))
+
 	s.appModule = appModule
 
 	// Add all tests addresses in whitelists
@@ -6,7 +6,6 @@ import (
 	"cosmossdk.io/errors"
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
-	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -36,29 +35,44 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	if msg.EpochLength < params.MinEpochLength {
 		return nil, types.ErrTopicCadenceBelowMinimum
 	}
+	inferenceDimension := msg.InferenceDimension
+	if len(msg.QuantileLevels) > 0 {
+		inferenceDimension = uint64(len(msg.QuantileLevels))
+	}
+	if len(msg.CategoryLabels) > 0 {
+		inferenceDimension = uint64(len(msg.CategoryLabels))
+	}
+	if inferenceDimension > params.MaxInferenceDimension {
+		return nil, types.ErrInferenceDimensionAboveMaximum
+	}
 
 	// Before creating topic, transfer fee amount from creator to ecosystem bucket
-	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Creator, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
+	err = ms.k.SendTopicRevenueToEcosystem(ctx, msg.Creator, fee.Amount)
 	if err != nil {
 		return nil, err
 	}
 
 	topic := types.Topic{
-		Id:              id,
-		Creator:         msg.Creator,
-		Metadata:        msg.Metadata,
-		LossLogic:       msg.LossLogic,
-		LossMethod:      msg.LossMethod,
-		InferenceLogic:  msg.InferenceLogic,
-		InferenceMethod: msg.InferenceMethod,
-		EpochLastEnded:  0,
-		EpochLength:     msg.EpochLength,
-		GroundTruthLag:  msg.GroundTruthLag,
-		DefaultArg:      msg.DefaultArg,
-		PNorm:           msg.PNorm,
-		AlphaRegret:     msg.AlphaRegret,
-		AllowNegative:   msg.AllowNegative,
-		Epsilon:         msg.Epsilon,
+		Id:                      id,
+		Creator:                 msg.Creator,
+		Metadata:                msg.Metadata,
+		LossLogic:               msg.LossLogic,
+		LossMethod:              msg.LossMethod,
+		InferenceLogic:          msg.InferenceLogic,
+		InferenceMethod:         msg.InferenceMethod,
+		EpochLastEnded:          0,
+		EpochLength:             msg.EpochLength,
+		GroundTruthLag:          msg.GroundTruthLag,
+		DefaultArg:              msg.DefaultArg,
+		PNorm:                   msg.PNorm,
+		AlphaRegret:             msg.AlphaRegret,
+		AllowNegative:           msg.AllowNegative,
+		Epsilon:                 msg.Epsilon,
+		InferenceDimension:      inferenceDimension,
+		QuantileLevels:          msg.QuantileLevels,
+		CategoryLabels:          msg.CategoryLabels,
+		AggregationStrategy:     msg.AggregationStrategy,
+		AggregationTrimFraction: msg.AggregationTrimFraction,
 	}
 	_, err = ms.k.IncrementTopicId(ctx)
 	if err != nil {
@@ -67,6 +81,11 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	if err := ms.k.SetTopic(ctx, id, topic); err != nil {
 		return nil, err
 	}
+	if msg.ParamsOverrides != nil {
+		if err := ms.k.SetTopicParamsOverrides(ctx, id, *msg.ParamsOverrides); err != nil {
+			return nil, err
+		}
+	}
 	// Rather than set latest weight-adjustment timestamp of a topic to 0
 	// we do nothing, since no value in the map means zero
 
@@ -86,3 +105,27 @@ func checkAddressHasBalanceForTopicCreationFee(ctx context.Context, ms msgServer
 	balance := ms.k.GetBankBalance(ctx, accAddress, fee.Denom)
 	return balance.IsGTE(fee), fee, nil
 }
+
+func (ms msgServer) UpdateTopicParamsOverrides(ctx context.Context, msg *types.MsgUpdateTopicParamsOverrides) (*types.MsgUpdateTopicParamsOverridesResponse, error) {
+	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := ms.requireRole(ctx, types.RoleTopicModerator, msg.Sender); err != nil {
+		return nil, err
+	}
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	overrides := types.TopicParamsOverrides{}
+	if msg.Overrides != nil {
+		overrides = *msg.Overrides
+	}
+	if err := ms.k.SetTopicParamsOverrides(ctx, msg.TopicId, overrides); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateTopicParamsOverridesResponse{}, nil
+}
@@ -84,6 +84,274 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithEpsilonZeroFails() {
 	s.Require().Nil(result)
 }
 
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithInferenceDimension() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	sender := senderAddr.String()
+
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:            sender,
+		Metadata:           "Some metadata for the new topic",
+		LossLogic:          "logic",
+		LossMethod:         "method",
+		EpochLength:        10800,
+		InferenceLogic:     "Ilogic",
+		InferenceMethod:    "Imethod",
+		DefaultArg:         "ETH",
+		AlphaRegret:        alloraMath.NewDecFromInt64(1),
+		PNorm:              alloraMath.NewDecFromInt64(3),
+		Epsilon:            alloraMath.MustNewDecFromString("0.01"),
+		InferenceDimension: types.DefaultParams().MaxInferenceDimension + 1,
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInferenceDimensionAboveMaximum)
+
+	newTopicMsg.InferenceDimension = 3
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(uint64(3), topic.InferenceDimension)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewQuantileTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	sender := senderAddr.String()
+
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         sender,
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "crps",
+		EpochLength:     10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		QuantileLevels: []alloraMath.Dec{
+			alloraMath.MustNewDecFromString("0.5"),
+			alloraMath.MustNewDecFromString("0.05"),
+		},
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantileLevels)
+
+	newTopicMsg.QuantileLevels = []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.05"),
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("0.95"),
+	}
+	newTopicMsg.InferenceDimension = 2
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInferenceDimensionMismatch)
+
+	newTopicMsg.InferenceDimension = 0
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsQuantileTopic())
+	require.Equal(uint64(3), topic.InferenceDimension)
+	require.Equal(newTopicMsg.QuantileLevels, topic.QuantileLevels)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewCategoricalTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	sender := senderAddr.String()
+
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         sender,
+		Metadata:        "Winner of the match",
+		LossLogic:       "logic",
+		LossMethod:      "log-loss",
+		EpochLength:     10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "match",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		CategoryLabels:  []string{"home", "draw", "home"},
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidCategoryLabels)
+
+	newTopicMsg.CategoryLabels = []string{"home", "draw", "away"}
+	newTopicMsg.QuantileLevels = []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")}
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrConflictingTopicKinds)
+
+	newTopicMsg.QuantileLevels = nil
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsCategoricalTopic())
+	require.Equal(uint64(3), topic.InferenceDimension)
+	require.Equal(newTopicMsg.CategoryLabels, topic.CategoryLabels)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithAggregationStrategy() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	sender := senderAddr.String()
+
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:             sender,
+		Metadata:            "Some metadata for the new topic",
+		LossLogic:           "logic",
+		LossMethod:          "method",
+		EpochLength:         10800,
+		InferenceLogic:      "Ilogic",
+		InferenceMethod:     "Imethod",
+		DefaultArg:          "ETH",
+		AlphaRegret:         alloraMath.NewDecFromInt64(1),
+		PNorm:               alloraMath.NewDecFromInt64(3),
+		Epsilon:             alloraMath.MustNewDecFromString("0.01"),
+		AggregationStrategy: types.AggregationStrategy_AGGREGATION_TRIMMED_MEAN,
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	// A trimmed mean needs a trim fraction
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidAggregationStrategy)
+
+	newTopicMsg.AggregationTrimFraction = alloraMath.MustNewDecFromString("0.5")
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidAggregationStrategy)
+
+	newTopicMsg.AggregationTrimFraction = alloraMath.MustNewDecFromString("0.1")
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(types.AggregationStrategy_AGGREGATION_TRIMMED_MEAN, topic.AggregationStrategy)
+	require.True(alloraMath.MustNewDecFromString("0.1").Equal(topic.AggregationTrimFraction))
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithParamsOverrides() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         senderAddr.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		ParamsOverrides: &types.TopicParamsOverrides{
+			LearningRate: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.2")},
+		},
+	}
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	globalParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	topicParams, err := s.emissionsKeeper.GetEffectiveTopicParams(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(alloraMath.MustNewDecFromString("0.2"), topicParams.LearningRate)
+	require.Equal(globalParams.BetaEntropy, topicParams.BetaEntropy, "params without override follow the global params")
+
+	newTopicMsg.ParamsOverrides = &types.TopicParamsOverrides{
+		LearningRate: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.2"), alloraMath.MustNewDecFromString("0.3")},
+	}
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorContains(err, "invalid params overrides")
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicParamsOverrides() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	moderator := sdk.AccAddress(PKS[0].Address()).String()
+	nonModerator := nonAdminAccounts[0].String()
+
+	overrides := &types.TopicParamsOverrides{
+		MaxTopReputersToReward: []uint64{3},
+		TaskRewardAlpha:        []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")},
+	}
+	_, err := msgServer.UpdateTopicParamsOverrides(ctx, &types.MsgUpdateTopicParamsOverrides{
+		Sender:    nonModerator,
+		TopicId:   topicId,
+		Overrides: overrides,
+	})
+	require.ErrorIs(err, types.ErrMissingRole)
+
+	_, err = msgServer.UpdateTopicParamsOverrides(ctx, &types.MsgUpdateTopicParamsOverrides{
+		Sender:    moderator,
+		TopicId:   topicId + 100,
+		Overrides: overrides,
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+
+	_, err = msgServer.UpdateTopicParamsOverrides(ctx, &types.MsgUpdateTopicParamsOverrides{
+		Sender:    moderator,
+		TopicId:   topicId,
+		Overrides: &types.TopicParamsOverrides{TaskRewardAlpha: []alloraMath.Dec{alloraMath.NewDecFromInt64(2)}},
+	})
+	require.ErrorIs(err, types.ErrValidationMustBeBetweenZeroAndOne)
+
+	_, err = msgServer.UpdateTopicParamsOverrides(ctx, &types.MsgUpdateTopicParamsOverrides{
+		Sender:    moderator,
+		TopicId:   topicId,
+		Overrides: overrides,
+	})
+	require.NoError(err)
+	topicParams, err := s.emissionsKeeper.GetEffectiveTopicParams(ctx, topicId)
+	require.NoError(err)
+	require.Equal(uint64(3), topicParams.MaxTopReputersToReward)
+	require.Equal(alloraMath.MustNewDecFromString("0.5"), topicParams.TaskRewardAlpha)
+
+	// no overrides clears them
+	_, err = msgServer.UpdateTopicParamsOverrides(ctx, &types.MsgUpdateTopicParamsOverrides{
+		Sender:  moderator,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+	globalParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	topicParams, err = s.emissionsKeeper.GetEffectiveTopicParams(ctx, topicId)
+	require.NoError(err)
+	require.Equal(globalParams.MaxTopReputersToReward, topicParams.MaxTopReputersToReward)
+	require.Equal(globalParams.TaskRewardAlpha, topicParams.TaskRewardAlpha)
+}
+
 func (s *MsgServerTestSuite) TestUpdateTopicLossUpdateLastRan() {
 	ctx := s.ctx
 	require := s.Require()
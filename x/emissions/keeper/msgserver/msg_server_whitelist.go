@@ -3,47 +3,108 @@ package msgserver
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 )
 
+// Grants every role to the address
 func (ms msgServer) AddToWhitelistAdmin(ctx context.Context, msg *types.MsgAddToWhitelistAdmin) (*types.MsgAddToWhitelistAdminResponse, error) {
-	// Check that sender is also a whitelist admin
-	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
-	if err != nil {
+	if err := ms.requireRole(ctx, types.RoleAdminManager, msg.Sender); err != nil {
 		return nil, err
 	}
-	if !isAdmin {
-		return nil, types.ErrNotWhitelistAdmin
-	}
 	// Validate the address
 	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
 		return nil, err
 	}
-	// Add the address to the whitelist
-	err = ms.k.AddWhitelistAdmin(ctx, msg.Address)
+	err := ms.k.AddWhitelistAdmin(ctx, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 	return &types.MsgAddToWhitelistAdminResponse{}, nil
 }
 
+// Revokes every role from the address
 func (ms msgServer) RemoveFromWhitelistAdmin(ctx context.Context, msg *types.MsgRemoveFromWhitelistAdmin) (*types.MsgRemoveFromWhitelistAdminResponse, error) {
-	// Check that sender is also a whitelist admin
-	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
-	if err != nil {
+	if err := ms.requireRole(ctx, types.RoleAdminManager, msg.Sender); err != nil {
 		return nil, err
 	}
-	if !isAdmin {
-		return nil, types.ErrNotWhitelistAdmin
-	}
 	// Validate the address
 	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
 		return nil, err
 	}
-	// Remove the address from the whitelist
-	err = ms.k.RemoveWhitelistAdmin(ctx, msg.Address)
+	if err := ms.ensureNotLastAdminManager(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+	err := ms.k.RemoveWhitelistAdmin(ctx, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 	return &types.MsgRemoveFromWhitelistAdminResponse{}, nil
 }
+
+func (ms msgServer) GrantRole(ctx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if err := ms.requireRole(ctx, types.RoleAdminManager, msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateRole(msg.Role); err != nil {
+		return nil, err
+	}
+	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
+		return nil, err
+	}
+	err := ms.k.GrantRole(ctx, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (ms msgServer) RevokeRole(ctx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if err := ms.requireRole(ctx, types.RoleAdminManager, msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateRole(msg.Role); err != nil {
+		return nil, err
+	}
+	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
+		return nil, err
+	}
+	if msg.Role == types.RoleAdminManager {
+		if err := ms.ensureNotLastAdminManager(ctx, msg.Address); err != nil {
+			return nil, err
+		}
+	}
+	err := ms.k.RevokeRole(ctx, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+// Returns an error if the sender does not hold the role
+func (ms msgServer) requireRole(ctx context.Context, role string, sender string) error {
+	hasRole, err := ms.k.HasRole(ctx, role, sender)
+	if err != nil {
+		return err
+	}
+	if !hasRole {
+		return errorsmod.Wrapf(types.ErrMissingRole, "%s does not hold the %s role", sender, role)
+	}
+	return nil
+}
+
+// Nobody could manage the roles anymore once the last admin manager is gone
+func (ms msgServer) ensureNotLastAdminManager(ctx context.Context, address string) error {
+	isAdminManager, err := ms.k.HasRole(ctx, types.RoleAdminManager, address)
+	if err != nil || !isAdminManager {
+		return err
+	}
+	adminManagers, err := ms.k.GetRoleMembers(ctx, types.RoleAdminManager)
+	if err != nil {
+		return err
+	}
+	if len(adminManagers) <= 1 {
+		return types.ErrLastAdminManager
+	}
+	return nil
+}
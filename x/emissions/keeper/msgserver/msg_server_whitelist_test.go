@@ -47,7 +47,7 @@ func (s *MsgServerTestSuite) TestAddWhitelistAdminInvalidUnauthorized() {
 	}
 
 	_, err := s.msgServer.AddToWhitelistAdmin(ctx, msg)
-	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+	require.ErrorIs(err, types.ErrMissingRole, "Should fail due to unauthorized access")
 }
 
 func (s *MsgServerTestSuite) TestRemoveWhitelistAdmin() {
@@ -86,5 +86,111 @@ func (s *MsgServerTestSuite) TestRemoveWhitelistAdminInvalidUnauthorized() {
 	}
 
 	_, err := s.msgServer.RemoveFromWhitelistAdmin(ctx, msg)
-	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+	require.ErrorIs(err, types.ErrMissingRole, "Should fail due to unauthorized access")
+}
+
+func (s *MsgServerTestSuite) TestGrantAndRevokeRole() {
+	ctx := s.ctx
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	newAddr := sdk.AccAddress(nonAdminAccounts[0]).String()
+
+	_, err := s.msgServer.GrantRole(ctx, &types.MsgGrantRole{
+		Sender:  adminAddr,
+		Role:    types.RoleParamsAdmin,
+		Address: newAddr,
+	})
+	require.NoError(err)
+
+	roles, err := s.emissionsKeeper.GetAddressRoles(ctx, newAddr)
+	require.NoError(err)
+	require.Equal([]string{types.RoleParamsAdmin}, roles)
+	isWhitelistAdmin, err := s.emissionsKeeper.IsWhitelistAdmin(ctx, newAddr)
+	require.NoError(err)
+	require.False(isWhitelistAdmin, "a single role does not make a whitelist admin")
+
+	_, err = s.msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+		Sender:  adminAddr,
+		Role:    types.RoleParamsAdmin,
+		Address: newAddr,
+	})
+	require.NoError(err)
+
+	hasRole, err := s.emissionsKeeper.HasRole(ctx, types.RoleParamsAdmin, newAddr)
+	require.NoError(err)
+	require.False(hasRole)
+}
+
+func (s *MsgServerTestSuite) TestGrantRoleRequiresAdminManager() {
+	ctx := s.ctx
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	paramsAdminAddr := sdk.AccAddress(nonAdminAccounts[0]).String()
+	targetAddr := sdk.AccAddress(nonAdminAccounts[1]).String()
+
+	err := s.emissionsKeeper.GrantRole(ctx, types.RoleParamsAdmin, paramsAdminAddr)
+	require.NoError(err)
+
+	_, err = s.msgServer.GrantRole(ctx, &types.MsgGrantRole{
+		Sender:  paramsAdminAddr,
+		Role:    types.RoleParamsAdmin,
+		Address: targetAddr,
+	})
+	require.ErrorIs(err, types.ErrMissingRole)
+
+	_, err = s.msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+		Sender:  paramsAdminAddr,
+		Role:    types.RoleAdminManager,
+		Address: adminAddr,
+	})
+	require.ErrorIs(err, types.ErrMissingRole)
+}
+
+func (s *MsgServerTestSuite) TestGrantUnknownRole() {
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	_, err := s.msgServer.GrantRole(s.ctx, &types.MsgGrantRole{
+		Sender:  adminAddr,
+		Role:    "superuser",
+		Address: sdk.AccAddress(nonAdminAccounts[0]).String(),
+	})
+	s.Require().ErrorIs(err, types.ErrUnknownRole)
+}
+
+func (s *MsgServerTestSuite) TestCannotRevokeLastAdminManager() {
+	ctx := s.ctx
+	require := s.Require()
+
+	lastAdminManager := sdk.AccAddress(PKS[0].Address()).String()
+	adminManagers, err := s.emissionsKeeper.GetRoleMembers(ctx, types.RoleAdminManager)
+	require.NoError(err)
+	for _, adminManager := range adminManagers {
+		if adminManager == lastAdminManager {
+			continue
+		}
+		_, err := s.msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+			Sender:  lastAdminManager,
+			Role:    types.RoleAdminManager,
+			Address: adminManager,
+		})
+		require.NoError(err)
+	}
+
+	_, err = s.msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+		Sender:  lastAdminManager,
+		Role:    types.RoleAdminManager,
+		Address: lastAdminManager,
+	})
+	require.ErrorIs(err, types.ErrLastAdminManager)
+
+	_, err = s.msgServer.RemoveFromWhitelistAdmin(ctx, &types.MsgRemoveFromWhitelistAdmin{
+		Sender:  lastAdminManager,
+		Address: lastAdminManager,
+	})
+	require.ErrorIs(err, types.ErrLastAdminManager)
+
+	hasRole, err := s.emissionsKeeper.HasRole(ctx, types.RoleAdminManager, lastAdminManager)
+	require.NoError(err)
+	require.True(hasRole)
 }
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -22,32 +25,57 @@ func verifyAndInsertInferencesFromTopInferers(
 	// inferences []*types.Inference,
 	workerDataBundles []*types.WorkerDataBundle,
 	maxTopWorkersToReward uint64,
+	topic *types.Topic,
+	probabilitySumTolerance alloraMath.Dec,
+	signatureOpts types.BundleSignatureOptions,
+	// Records what became of the inference of each bundle
+	outcomes *types.SubmissionOutcomes,
 ) (map[string]bool, error) {
 	inferencesByInferer := make(map[string]*types.Inference)
 	latestInfererScores := make(map[string]types.Score)
-	errors := make(map[string]string)
+	bundleIndexByInferer := make(map[string]int)
 	if len(workerDataBundles) == 0 {
 		return nil, types.ErrNoValidBundles
 	}
-	for _, workerDataBundle := range workerDataBundles {
+	for i, workerDataBundle := range workerDataBundles {
 		/// Do filters first, then consider the inferenes for inclusion
 		/// Do filters on the per payload first, then on each inferer
 		/// All filters should be done in order of increasing computational complexity
 
-		if err := workerDataBundle.Validate(); err != nil {
-			errors[workerDataBundle.Worker] = "Validate: Invalid worker data bundle"
+		if err := workerDataBundle.Validate(signatureOpts); err != nil {
+			outcomes.Reject(i, workerDataBundle.Worker, types.SubmissionOutcomeCode_SUBMISSION_INVALID)
 			continue // Ignore only invalid worker data bundles
 		}
 		/// If we do PoX-like anti-sybil procedure, would go here
 
 		inference := workerDataBundle.InferenceForecastsBundle.Inference
+		// A bundle without an inference is a valid forecast-only submission
+		if inference == nil {
+			continue
+		}
 
 		// Check if the topic and nonce are correct
 		if inference.TopicId != topicId ||
 			inference.BlockHeight != nonce.BlockHeight {
-			errors[workerDataBundle.Worker] = "Worker data bundle does not match topic or nonce"
+			outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
+			continue
+		}
+		if err := inference.ValidateDimension(topic.InferenceDimension); err != nil {
+			outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_WRONG_DIMENSION)
 			continue
 		}
+		if topic.IsQuantileTopic() {
+			if err := inference.ValidateQuantiles(); err != nil {
+				outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_NON_MONOTONIC_QUANTILES)
+				continue
+			}
+		}
+		if topic.IsCategoricalTopic() {
+			if err := inference.ValidateProbabilities(probabilitySumTolerance); err != nil {
+				outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_INVALID_PROBABILITIES)
+				continue
+			}
+		}
 
 		/// Now do filters on each inferer
 		// Ensure that we only have one inference per inferer. If not, we just take the first one
@@ -55,23 +83,26 @@ func verifyAndInsertInferencesFromTopInferers(
 			// Check if the inferer is registered
 			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, inference.Inferer)
 			if err != nil {
-				errors[workerDataBundle.Worker] = "Err to check if worker is registered in topic"
+				outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			if !isInfererRegistered {
-				errors[workerDataBundle.Worker] = "Inferer is not registered"
+				outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED)
 				continue
 			}
 
 			// Get the latest score for each inferer => only take top few by score descending
 			latestScore, err := ms.k.GetLatestInfererScore(ctx, topicId, inference.Inferer)
 			if err != nil {
-				errors[workerDataBundle.Worker] = "Latest score not found"
+				outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			/// Filtering done now, now write what we must for inclusion
 			latestInfererScores[inference.Inferer] = latestScore
 			inferencesByInferer[inference.Inferer] = inference
+			bundleIndexByInferer[inference.Inferer] = i
+		} else {
+			outcomes.Reject(i, inference.Inferer, types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE)
 		}
 	}
 
@@ -86,9 +117,16 @@ func verifyAndInsertInferencesFromTopInferers(
 		acceptedInferers[worker] = true
 		inferencesFromTopInferers = append(inferencesFromTopInferers, inferencesByInferer[worker])
 	}
+	for inferer, bundleIndex := range bundleIndexByInferer {
+		if acceptedInferers[inferer] {
+			outcomes.Accept(bundleIndex, inferer)
+		} else {
+			outcomes.Reject(bundleIndex, inferer, types.SubmissionOutcomeCode_SUBMISSION_NOT_TOP_RANKED)
+		}
+	}
 
 	if len(inferencesFromTopInferers) == 0 {
-		return nil, types.ErrNoValidBundles
+		return nil, errorsmod.Wrap(types.ErrNoValidBundles, outcomes.String())
 	}
 
 	// Ensure deterministic ordering of inferences
@@ -104,6 +142,8 @@ func verifyAndInsertInferencesFromTopInferers(
 	if err != nil {
 		return nil, err
 	}
+	types.IncrSubmissionsAccepted(sdk.UnwrapSDKContext(ctx), topicId, types.ActorType_INFERER, len(inferencesFromTopInferers))
+	types.SetParticipantsGauge(sdk.UnwrapSDKContext(ctx), topicId, types.ActorType_INFERER, len(inferencesFromTopInferers))
 
 	return acceptedInferers, nil
 }
@@ -122,25 +162,36 @@ func verifyAndInsertForecastsFromTopForecasters(
 	// Inferers in the current batch, assumed to have passed VerifyAndInsertInferencesFromTopInferers() filters
 	acceptedInferersOfBatch map[string]bool,
 	maxTopWorkersToReward uint64,
+	signatureOpts types.BundleSignatureOptions,
+	// Records what became of the forecast of each bundle that has one
+	outcomes *types.SubmissionOutcomes,
 ) error {
 	forecastsByForecaster := make(map[string]*types.Forecast)
 	latestForecasterScores := make(map[string]types.Score)
-	for _, workerDataBundle := range workerDataBundle {
+	bundleIndexByForecaster := make(map[string]int)
+	for i, workerDataBundle := range workerDataBundle {
 		/// Do filters first, then consider the inferenes for inclusion
 		/// Do filters on the per payload first, then on each forecaster
 		/// All filters should be done in order of increasing computational complexity
 
-		if err := workerDataBundle.Validate(); err != nil {
+		if err := workerDataBundle.Validate(signatureOpts); err != nil {
+			if workerDataBundle.InferenceForecastsBundle != nil && workerDataBundle.InferenceForecastsBundle.Forecast != nil {
+				outcomes.Reject(i, workerDataBundle.Worker, types.SubmissionOutcomeCode_SUBMISSION_INVALID)
+			}
 			continue // Ignore only invalid worker data bundles
 		}
 
 		/// If we do PoX-like anti-sybil procedure, would go here
 
 		forecast := workerDataBundle.InferenceForecastsBundle.Forecast
-		// Check that the forecast exist, is for the correct topic, and is for the correct nonce
-		if forecast == nil ||
-			forecast.TopicId != topicId ||
+		// A bundle without a forecast is a valid inference-only submission
+		if forecast == nil {
+			continue
+		}
+		// Check that the forecast is for the correct topic, and is for the correct nonce
+		if forecast.TopicId != topicId ||
 			forecast.BlockHeight != nonce.BlockHeight {
+			outcomes.Reject(i, forecast.Forecaster, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
 
@@ -150,9 +201,11 @@ func verifyAndInsertForecastsFromTopForecasters(
 			// Check if the forecaster is registered
 			isForecasterRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, forecast.Forecaster)
 			if err != nil {
+				outcomes.Reject(i, forecast.Forecaster, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			if !isForecasterRegistered {
+				outcomes.Reject(i, forecast.Forecaster, types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED)
 				continue
 			}
 
@@ -169,6 +222,7 @@ func verifyAndInsertForecastsFromTopForecasters(
 
 			// Discard if empty
 			if len(acceptedForecastElements) == 0 {
+				outcomes.Reject(i, forecast.Forecaster, types.SubmissionOutcomeCode_SUBMISSION_NO_ACCEPTED_WORKERS)
 				continue
 			}
 
@@ -177,10 +231,14 @@ func verifyAndInsertForecastsFromTopForecasters(
 			// Get the latest score for each forecaster => only take top few by score descending
 			latestScore, err := ms.k.GetLatestForecasterScore(ctx, topicId, forecast.Forecaster)
 			if err != nil {
+				outcomes.Reject(i, forecast.Forecaster, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
 				continue
 			}
 			latestForecasterScores[forecast.Forecaster] = latestScore
 			forecastsByForecaster[forecast.Forecaster] = forecast
+			bundleIndexByForecaster[forecast.Forecaster] = i
+		} else {
+			outcomes.Reject(i, forecast.Forecaster, types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE)
 		}
 	}
 
@@ -190,9 +248,18 @@ func verifyAndInsertForecastsFromTopForecasters(
 	// Build list of forecasts that pass all filters
 	// AND are from top performing forecasters among those who have submitted forecasts in this batch
 	forecastsFromTopForecasters := make([]*types.Forecast, 0)
+	acceptedForecasters := make(map[string]bool, 0)
 	for _, worker := range topForecasters {
+		acceptedForecasters[worker] = true
 		forecastsFromTopForecasters = append(forecastsFromTopForecasters, forecastsByForecaster[worker])
 	}
+	for forecaster, bundleIndex := range bundleIndexByForecaster {
+		if acceptedForecasters[forecaster] {
+			outcomes.Accept(bundleIndex, forecaster)
+		} else {
+			outcomes.Reject(bundleIndex, forecaster, types.SubmissionOutcomeCode_SUBMISSION_NOT_TOP_RANKED)
+		}
+	}
 
 	// Though less than ideal because it produces less-acurate network inferences,
 	// it is fine if no forecasts are accepted
@@ -210,6 +277,8 @@ func verifyAndInsertForecastsFromTopForecasters(
 	if err != nil {
 		return err
 	}
+	types.IncrSubmissionsAccepted(sdk.UnwrapSDKContext(ctx), topicId, types.ActorType_FORECASTER, len(forecastsFromTopForecasters))
+	types.SetParticipantsGauge(sdk.UnwrapSDKContext(ctx), topicId, types.ActorType_FORECASTER, len(forecastsFromTopForecasters))
 
 	return nil
 }
@@ -226,81 +295,291 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		return nil, err
 	}
 
-	// Check if the topic exists
-	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err := ms.checkWorkerNonceOpen(ctx, msg.TopicId, msg.Nonce); err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// With a submission window, the bundles accumulate with those submitted directly until the nonce closes
+	if moduleParams.PayloadSubmissionWindow > 0 {
+		return ms.queueBulkWorkerPayload(ctx, msg, moduleParams)
+	}
+
+	// Without one, the payload closes the nonce, along with what workers submitted directly so far.
+	// The bundles of the payload come first so that the outcomes index them.
+	queuedBundles, err := ms.k.PopWorkerPayloads(ctx, msg.TopicId, msg.Nonce.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	bundles := append(append([]*types.WorkerDataBundle{}, msg.WorkerDataBundles...), queuedBundles...)
+	inferenceOutcomes, forecastOutcomes, err := ms.closeWorkerNonce(ctx, msg.TopicId, *msg.Nonce, bundles, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgInsertBulkWorkerPayloadResponse{
+		InferenceOutcomes: inferenceOutcomes.List(),
+		ForecastOutcomes:  forecastOutcomes.List(),
+	}, nil
+}
+
+// Queues the bundles of a bulk payload until the nonce closes, once they pass the checks that do not
+// depend on the other bundles of the nonce, so a leader cannot queue a bundle a worker did not sign for the nonce.
+// Returns the outcomes of the bundles left out of the queue. The queued bundles get theirs
+// in the submission outcomes event emitted when the nonce closes.
+func (ms msgServer) queueBulkWorkerPayload(
+	ctx context.Context,
+	msg *types.MsgInsertBulkWorkerPayload,
+	moduleParams types.Params,
+) (*types.MsgInsertBulkWorkerPayloadResponse, error) {
+	closeBlock, err := ms.payloadCloseBlock(ctx, msg.TopicId, msg.Nonce.BlockHeight, moduleParams.PayloadSubmissionWindow)
+	if err != nil {
+		return nil, err
+	}
+	signatureOpts := bundleSignatureOptions(ctx, moduleParams)
+	inferenceOutcomes := types.NewSubmissionOutcomes(msg.TopicId, types.ActorType_INFERER)
+	forecastOutcomes := types.NewSubmissionOutcomes(msg.TopicId, types.ActorType_FORECASTER)
+	for i, bundle := range msg.WorkerDataBundles {
+		if err := bundle.Validate(signatureOpts); err != nil {
+			rejectWorkerBundle(inferenceOutcomes, forecastOutcomes, i, bundle, types.SubmissionOutcomeCode_SUBMISSION_INVALID)
+			continue
+		}
+		topicId, nonce := bundle.TopicIdAndNonce()
+		if topicId != msg.TopicId || nonce.BlockHeight != msg.Nonce.BlockHeight {
+			rejectWorkerBundle(inferenceOutcomes, forecastOutcomes, i, bundle, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH)
+			continue
+		}
+		isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, bundle.Worker)
+		if err != nil {
+			rejectWorkerBundle(inferenceOutcomes, forecastOutcomes, i, bundle, types.SubmissionOutcomeCode_SUBMISSION_STORE_ERROR)
+			continue
+		}
+		if !isWorkerRegistered {
+			rejectWorkerBundle(inferenceOutcomes, forecastOutcomes, i, bundle, types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED)
+			continue
+		}
+		var queued bool
+		closeBlock, queued, err = ms.k.QueueWorkerPayload(ctx, msg.TopicId, msg.Nonce.BlockHeight, closeBlock, *bundle, false)
+		if err != nil {
+			return nil, err
+		}
+		if !queued {
+			rejectWorkerBundle(inferenceOutcomes, forecastOutcomes, i, bundle, types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE)
+		}
+	}
+	return &types.MsgInsertBulkWorkerPayloadResponse{
+		InferenceOutcomes: inferenceOutcomes.List(),
+		ForecastOutcomes:  forecastOutcomes.List(),
+		CloseBlock:        closeBlock,
+	}, nil
+}
+
+// Records the rejection of a worker bundle among the outcomes of the inference and of the forecast it carries
+func rejectWorkerBundle(
+	inferenceOutcomes *types.SubmissionOutcomes,
+	forecastOutcomes *types.SubmissionOutcomes,
+	bundleIndex int,
+	bundle *types.WorkerDataBundle,
+	code types.SubmissionOutcomeCode,
+) {
+	if bundle == nil || bundle.InferenceForecastsBundle == nil {
+		worker := ""
+		if bundle != nil {
+			worker = bundle.Worker
+		}
+		inferenceOutcomes.Reject(bundleIndex, worker, code)
+		return
+	}
+	if bundle.InferenceForecastsBundle.Inference != nil {
+		inferenceOutcomes.Reject(bundleIndex, bundle.Worker, code)
+	}
+	if bundle.InferenceForecastsBundle.Forecast != nil {
+		forecastOutcomes.Reject(bundleIndex, bundle.Worker, code)
+	}
+}
+
+// Called by a worker to submit its own bundle, without going through a leader.
+// The bundle is ranked with the other bundles of its nonce when the nonce closes.
+func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.MsgInsertWorkerPayload) (*types.MsgInsertWorkerPayloadResponse, error) {
+	err := checkInputLength(ctx, ms, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bundle := msg.WorkerDataBundle
+	if err := bundle.Validate(bundleSignatureOptions(ctx, moduleParams)); err != nil {
+		return nil, err
+	}
+
+	topicId, nonce := bundle.TopicIdAndNonce()
+	if err := ms.checkWorkerNonceOpen(ctx, topicId, &nonce); err != nil {
+		return nil, err
+	}
+
+	closeBlock, err := ms.payloadCloseBlock(ctx, topicId, nonce.BlockHeight, moduleParams.PayloadSubmissionWindow)
 	if err != nil {
 		return nil, err
 	}
+	closeBlock, queued, err := ms.k.QueueWorkerPayload(ctx, topicId, nonce.BlockHeight, closeBlock, *bundle, true)
+	if err != nil {
+		return nil, err
+	}
+	if !queued {
+		return nil, types.ErrPayloadAlreadySubmitted
+	}
+	return &types.MsgInsertWorkerPayloadResponse{CloseBlock: closeBlock}, nil
+}
+
+// Closes a worker nonce whose submission window ended, ranking the bundles submitted for it.
+// Called by the EndBlocker, so the commit of the nonce is attributed to the module.
+func CloseWorkerNonce(ctx context.Context, k keeper.Keeper, topicId uint64, nonce types.Nonce, bundles []*types.WorkerDataBundle) error {
+	ms := msgServer{k: k}
+	if err := ms.checkWorkerNonceOpen(ctx, topicId, &nonce); err != nil {
+		return err
+	}
+	_, _, err := ms.closeWorkerNonce(ctx, topicId, nonce, bundles, types.ModuleName)
+	return err
+}
+
+// Errors unless the topic exists and the worker nonce is unfulfilled
+func (ms msgServer) checkWorkerNonceOpen(ctx context.Context, topicId uint64, nonce *types.Nonce) error {
+	// Check if the topic exists
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return err
+	}
 	if !topicExists {
-		return nil, types.ErrInvalidTopicId
+		return types.ErrInvalidTopicId
 	}
 
 	// Check if the nonce is unfulfilled
-	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.Nonce)
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	// If the nonce is already fulfilled, return an error
 	if !nonceUnfulfilled {
-		return nil, types.ErrNonceAlreadyFulfilled
+		return types.ErrNonceAlreadyFulfilled
 	}
+	return nil
+}
+
+// Returns the block at whose end a nonce whose first payload arrives now closes: once the submission
+// window ends, but before the next nonce of the topic opens, and never before the current block.
+func (ms msgServer) payloadCloseBlock(ctx context.Context, topicId uint64, nonce int64, window int64) (int64, error) {
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return 0, err
+	}
+	currentBlock := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return max(currentBlock, min(currentBlock+window, nonce+topic.EpochLength-1)), nil
+}
 
+// Ranks the bundles submitted for an unfulfilled worker nonce, stores the work of the top inferers
+// and forecasters, fulfills the nonce and asks reputers to assess it.
+func (ms msgServer) closeWorkerNonce(
+	ctx context.Context,
+	topicId uint64,
+	nonce types.Nonce,
+	bundles []*types.WorkerDataBundle,
+	committer string,
+) (*types.SubmissionOutcomes, *types.SubmissionOutcomes, error) {
+	topicParams, err := ms.k.GetEffectiveTopicParams(ctx, topicId)
+	if err != nil {
+		return nil, nil, err
+	}
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, nil, types.ErrInvalidTopicId
+	}
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	signatureOpts := bundleSignatureOptions(ctx, moduleParams)
 
+	inferenceOutcomes := types.NewSubmissionOutcomes(topicId, types.ActorType_INFERER)
 	acceptedInferers, err := verifyAndInsertInferencesFromTopInferers(
 		ctx,
 		ms,
-		msg.TopicId,
-		*msg.Nonce,
-		msg.WorkerDataBundles,
-		moduleParams.MaxTopInferersToReward,
+		topicId,
+		nonce,
+		bundles,
+		topicParams.MaxTopInferersToReward,
+		&topic,
+		moduleParams.ProbabilitySumTolerance,
+		signatureOpts,
+		inferenceOutcomes,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	forecastOutcomes := types.NewSubmissionOutcomes(topicId, types.ActorType_FORECASTER)
 	err = verifyAndInsertForecastsFromTopForecasters(
 		ctx,
 		ms,
-		msg.TopicId,
-		*msg.Nonce,
-		msg.WorkerDataBundles,
+		topicId,
+		nonce,
+		bundles,
 		acceptedInferers,
-		moduleParams.MaxTopForecastersToReward,
+		topicParams.MaxTopForecastersToReward,
+		signatureOpts,
+		forecastOutcomes,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// Update the unfulfilled worker nonce
-	_, err = ms.k.FulfillWorkerNonce(ctx, msg.TopicId, msg.Nonce)
+	_, err = ms.k.FulfillWorkerNonce(ctx, topicId, &nonce)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockHeight := sdkCtx.BlockHeight()
+	request, isInferenceRequest, err := ms.k.GetInferenceRequestAtNonce(ctx, topicId, nonce.BlockHeight)
 	if err != nil {
-		return nil, types.ErrInvalidTopicId
-	}
-
-	workerNonce := &types.Nonce{
-		BlockHeight: msg.Nonce.BlockHeight - topic.EpochLength,
+		return nil, nil, err
 	}
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.Logger().Debug(fmt.Sprintf("InsertBulkWorkerPayload workerNonce %d", workerNonce.BlockHeight))
+	if isInferenceRequest {
+		// Reputers assess the request's own nonce once its ground truth is available.
+		// If it is not yet, the EndBlocker of the ground truth block asks them.
+		if blockHeight >= request.GroundTruthBlock {
+			err = ms.k.AddReputerNonce(ctx, topic.Id, &nonce, &nonce)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		workerNonce := &types.Nonce{
+			BlockHeight: nonce.BlockHeight - topic.EpochLength,
+		}
+		sdkCtx.Logger().Debug(fmt.Sprintf("InsertBulkWorkerPayload workerNonce %d", workerNonce.BlockHeight))
 
-	err = ms.k.AddReputerNonce(ctx, topic.Id, msg.Nonce, workerNonce)
-	if err != nil {
-		return nil, err
+		err = ms.k.AddReputerNonce(ctx, topic.Id, &nonce, workerNonce)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	blockHeight := sdkCtx.BlockHeight()
-	err = ms.k.SetTopicLastCommit(ctx, topic.Id, blockHeight, msg.Nonce, msg.Sender, types.ActorType_INFERER)
+	err = ms.k.SetTopicLastCommit(ctx, topic.Id, blockHeight, &nonce, committer, types.ActorType_INFERER)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	// Return an empty response as the operation was successful
-	return &types.MsgInsertBulkWorkerPayloadResponse{}, nil
+	types.EmitNewSubmissionOutcomesEvent(sdkCtx, topicId, nonce.BlockHeight, inferenceOutcomes)
+	types.EmitNewSubmissionOutcomesEvent(sdkCtx, topicId, nonce.BlockHeight, forecastOutcomes)
+	types.IncrSubmissionsRejected(sdkCtx, inferenceOutcomes)
+	types.IncrSubmissionsRejected(sdkCtx, forecastOutcomes)
+	return inferenceOutcomes, forecastOutcomes, nil
 }
@@ -47,7 +47,7 @@ func (s *MsgServerTestSuite) setUpMsgInsertBulkWorkerPayload(
 	keeper.AddWorkerNonce(ctx, 0, &nonce)
 	keeper.InsertWorker(ctx, topicId, InfererAddr, workerInfo)
 	keeper.InsertWorker(ctx, topicId, ForecasterAddr, workerInfo)
-	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId})
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, EpochLength: types.DefaultParams().MinEpochLength})
 
 	// Create a MsgInsertBulkWorkerPayload message
 	workerMsg := types.MsgInsertBulkWorkerPayload{
@@ -92,9 +92,8 @@ func (s *MsgServerTestSuite) signMsgInsertBulkWorkerPayload(workerMsg types.MsgI
 
 	workerPublicKeyBytes := workerPrivateKey.PubKey().Bytes()
 
-	src := make([]byte, 0)
-	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.XXX_Marshal(src, true)
-	require.NoError(err, "Marshall reputer value bundle should not return an error")
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.SignBytes(s.ctx.ChainID())
+	require.NoError(err, "SignBytes should not return an error")
 
 	sig, err := workerPrivateKey.Sign(src)
 	require.NoError(err, "Sign should not return an error")
@@ -127,6 +126,36 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayload() {
 	require.Equal(forecastsCount1, 1)
 }
 
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadAcceptsLegacySignatureDuringWindow() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// Sign the bare bundle, as workers did before sign docs
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Marshal()
+	require.NoError(err)
+	sig, err := workerPrivateKey.Sign(src)
+	require.NoError(err)
+	workerMsg.WorkerDataBundles[0].InferencesForecastsBundleSignature = sig
+	workerMsg.WorkerDataBundles[0].Pubkey = hex.EncodeToString(workerPrivateKey.PubKey().Bytes())
+	blockHeight := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Forecast.BlockHeight
+
+	_, _ = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.Equal(0, s.getCountForecastsAtBlock(topicId, blockHeight), "legacy signature accepted outside its window")
+
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	params.LegacyBundleSignaturesEndBlock = ctx.BlockHeight()
+	require.NoError(s.emissionsKeeper.SetParams(ctx, params))
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err, "InsertBulkWorkerPayload should not return an error")
+	require.Equal(1, s.getCountForecastsAtBlock(topicId, blockHeight))
+}
+
 func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithoutWorkerDataBundle() {
 	ctx, msgServer := s.ctx, s.msgServer
 	require := s.Require()
@@ -336,9 +365,8 @@ func (s *MsgServerTestSuite) TestInsertingHugeBulkWorkerPayloadFails() {
 		},
 	}
 
-	src := make([]byte, 0)
-	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.XXX_Marshal(src, true)
-	require.NoError(err, "Marshall reputer value bundle should not return an error")
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.SignBytes(s.ctx.ChainID())
+	require.NoError(err, "SignBytes should not return an error")
 
 	sig, err := workerPrivateKey.Sign(src)
 	require.NoError(err, "Sign should not return an error")
@@ -507,9 +535,8 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerAlreadyFullfilledNonce() {
 		},
 	}
 
-	src := make([]byte, 0)
-	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.XXX_Marshal(src, true)
-	require.NoError(err, "Marshall reputer value bundle should not return an error")
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.SignBytes(s.ctx.ChainID())
+	require.NoError(err, "SignBytes should not return an error")
 
 	sig, err := workerPrivateKey.Sign(src)
 	require.NoError(err, "Sign should not return an error")
@@ -541,3 +568,265 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadUpdateTopicCommit() {
 	require.Equal(workerMsg.Sender, lastCommit.Actor, "Actor should be same")
 	require.Equal(workerMsg.Nonce, lastCommit.Nonce, "Nonce should be same")
 }
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadReportsBundleOutcomes() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, _ := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	signedBundle := workerMsg.WorkerDataBundles[0]
+	inferer := signedBundle.InferenceForecastsBundle.Inference.Inferer
+	forecaster := signedBundle.InferenceForecastsBundle.Forecast.Forecaster
+	unsignedWorker := getNewAddress()
+	workerMsg.WorkerDataBundles = append(
+		workerMsg.WorkerDataBundles,
+		signedBundle,
+		&types.WorkerDataBundle{
+			Worker:                   unsignedWorker,
+			InferenceForecastsBundle: signedBundle.InferenceForecastsBundle,
+		},
+	)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	require.Equal([]*types.SubmissionOutcome{
+		{BundleIndex: 0, Actor: inferer, Code: types.SubmissionOutcomeCode_SUBMISSION_ACCEPTED},
+		{BundleIndex: 1, Actor: inferer, Code: types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE},
+		{BundleIndex: 2, Actor: unsignedWorker, Code: types.SubmissionOutcomeCode_SUBMISSION_INVALID},
+	}, response.InferenceOutcomes)
+	require.Equal([]*types.SubmissionOutcome{
+		{BundleIndex: 0, Actor: forecaster, Code: types.SubmissionOutcomeCode_SUBMISSION_ACCEPTED},
+		{BundleIndex: 1, Actor: forecaster, Code: types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE},
+		{BundleIndex: 2, Actor: unsignedWorker, Code: types.SubmissionOutcomeCode_SUBMISSION_INVALID},
+	}, response.ForecastOutcomes)
+	require.True(hasEvent(ctx, "emissions.v1.EventSubmissionOutcomes"))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadExplainsRejectedPayload() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, _ := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference.TopicId = 1
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.ErrorContains(err, "bundle 0")
+	require.ErrorContains(err, types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH.String())
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadRejectsInferenceOfWrongDimension() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	// The topic is scalar, so an inference carrying a vector is of the wrong dimension
+	workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference.Values = []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(1),
+		alloraMath.NewDecFromInt64(2),
+	}
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.ErrorContains(err, types.SubmissionOutcomeCode_SUBMISSION_WRONG_DIMENSION.String())
+
+	_, err = s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.Error(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadQuantileTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	err := s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{
+		Id:                 topicId,
+		InferenceDimension: 3,
+		QuantileLevels: []alloraMath.Dec{
+			alloraMath.MustNewDecFromString("0.05"),
+			alloraMath.MustNewDecFromString("0.5"),
+			alloraMath.MustNewDecFromString("0.95"),
+		},
+	})
+	require.NoError(err)
+
+	inference := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference
+	inference.Values = []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(90),
+		alloraMath.NewDecFromInt64(110),
+		alloraMath.NewDecFromInt64(100),
+	}
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.ErrorContains(err, types.SubmissionOutcomeCode_SUBMISSION_NON_MONOTONIC_QUANTILES.String())
+
+	inference.Values[2] = alloraMath.NewDecFromInt64(130)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	require.Equal(types.SubmissionOutcomeCode_SUBMISSION_ACCEPTED, response.InferenceOutcomes[0].Code)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Equal(inference.Values, inferences.Inferences[0].Values)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadCategoricalTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	err := s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{
+		Id:                 topicId,
+		InferenceDimension: 3,
+		CategoryLabels:     []string{"home", "draw", "away"},
+	})
+	require.NoError(err)
+
+	inference := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference
+	inference.Values = []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("0.3"),
+		alloraMath.MustNewDecFromString("0.3"),
+	}
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.ErrorContains(err, types.SubmissionOutcomeCode_SUBMISSION_INVALID_PROBABILITIES.String())
+
+	// Within the tolerance of the params around one
+	inference.Values[2] = alloraMath.MustNewDecFromString("0.2001")
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	require.Equal(types.SubmissionOutcomeCode_SUBMISSION_ACCEPTED, response.InferenceOutcomes[0].Code)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadRejectsBundleOfAnotherWorker() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, _ := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err := msgServer.InsertWorkerPayload(ctx, &types.MsgInsertWorkerPayload{
+		Sender:           workerMsg.Sender,
+		WorkerDataBundle: workerMsg.WorkerDataBundles[0],
+	})
+	require.ErrorIs(err, types.ErrNotPayloadOwner)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadQueuesDuringSubmissionWindow() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	params.PayloadSubmissionWindow = 2
+	require.NoError(s.emissionsKeeper.SetParams(ctx, params))
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err, "InsertBulkWorkerPayload should not return an error")
+	require.Equal(ctx.BlockHeight()+2, response.CloseBlock)
+	require.Empty(response.InferenceOutcomes)
+	require.Equal(0, s.getCountForecastsAtBlock(topicId, workerMsg.Nonce.BlockHeight))
+
+	unfulfilled, err := s.emissionsKeeper.IsWorkerNonceUnfulfilled(ctx, topicId, workerMsg.Nonce)
+	require.NoError(err)
+	require.True(unfulfilled, "nonce must stay open until the window closes")
+
+	bundles, err := s.emissionsKeeper.PopWorkerPayloads(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(bundles, 1)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadClosesBeforeNextNonce() {
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	params.PayloadSubmissionWindow = params.MinEpochLength - 1
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, params))
+
+	// The window would run into the next nonce of the topic
+	ctx := s.ctx.WithBlockHeight(workerMsg.Nonce.BlockHeight + 5)
+	response, err := s.msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	require.Equal(workerMsg.Nonce.BlockHeight+topic.EpochLength-1, response.CloseBlock)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadIncludesDirectSubmissions() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	// Another worker submits its own inference before the leader's bulk payload lands
+	directPrivateKey := secp256k1.GenPrivKey()
+	directAddr := sdk.AccAddress(directPrivateKey.PubKey().Address()).String()
+	require.NoError(s.emissionsKeeper.InsertWorker(ctx, topicId, directAddr, types.OffchainNode{Owner: directAddr}))
+	directBundle := &types.WorkerDataBundle{
+		Worker: directAddr,
+		InferenceForecastsBundle: &types.InferenceForecastBundle{
+			Inference: &types.Inference{
+				TopicId:     topicId,
+				BlockHeight: workerMsg.Nonce.BlockHeight,
+				Inferer:     directAddr,
+				Value:       alloraMath.NewDecFromInt64(90),
+			},
+		},
+		Pubkey: hex.EncodeToString(directPrivateKey.PubKey().Bytes()),
+	}
+	src, err := directBundle.InferenceForecastsBundle.SignBytes(ctx.ChainID())
+	require.NoError(err)
+	directBundle.InferencesForecastsBundleSignature, err = directPrivateKey.Sign(src)
+	require.NoError(err)
+
+	directResponse, err := msgServer.InsertWorkerPayload(ctx, &types.MsgInsertWorkerPayload{
+		Sender:           directAddr,
+		WorkerDataBundle: directBundle,
+	})
+	require.NoError(err, "InsertWorkerPayload should not return an error")
+	require.Equal(ctx.BlockHeight(), directResponse.CloseBlock)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err, "InsertBulkWorkerPayload should not return an error")
+	require.Equal(ctx.BlockHeight(), response.CloseBlock)
+	require.Len(response.InferenceOutcomes, 2)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 2)
+
+	bundles, err := s.emissionsKeeper.PopWorkerPayloads(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Empty(bundles)
+}
@@ -18,3 +18,17 @@ func (qs queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// GetScheduledParamsChanges returns the pending params changes, in the order they will be applied.
+func (qs queryServer) GetScheduledParamsChanges(ctx context.Context, req *types.QueryScheduledParamsChangesRequest) (*types.QueryScheduledParamsChangesResponse, error) {
+	changes, err := qs.k.GetScheduledParamsChanges(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	changePointers := make([]*types.ScheduledParamsChange, 0, len(changes))
+	for i := range changes {
+		changePointers = append(changePointers, &changes[i])
+	}
+	return &types.QueryScheduledParamsChangesResponse{Changes: changePointers}, nil
+}
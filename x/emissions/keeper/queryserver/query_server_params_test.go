@@ -20,3 +20,21 @@ func (s *KeeperTestSuite) TestParams() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().Equal(expectedParams.Version, response.Params.Version)
 }
+
+func (s *KeeperTestSuite) TestGetScheduledParamsChanges() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	proposer := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+
+	later, err := keeper.ScheduleParamsChange(ctx, proposer, &types.OptionalParams{MaxTopicsPerBlock: []uint64{3}}, 1, 20)
+	s.Require().NoError(err)
+	sooner, err := keeper.ScheduleParamsChange(ctx, proposer, &types.OptionalParams{MaxTopicsPerBlock: []uint64{2}}, 1, 10)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetScheduledParamsChanges(ctx, &types.QueryScheduledParamsChangesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Changes, 2)
+	s.Require().Equal(sooner, response.Changes[0].Id, "changes are listed in the order they are applied")
+	s.Require().Equal(later, response.Changes[1].Id)
+	s.Require().Equal(proposer, response.Changes[0].Proposer)
+}
@@ -77,3 +77,28 @@ func (qs queryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *typ
 
 	return &types.QueryIsReputerRegisteredInTopicIdResponse{IsRegistered: isRegistered}, nil
 }
+
+func (qs queryServer) GetPayoutAddress(ctx context.Context, req *types.QueryPayoutAddressRequest) (*types.QueryPayoutAddressResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	topicPayoutAddress, err := qs.k.GetTopicPayoutAddress(ctx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	globalPayoutAddress, err := qs.k.GetGlobalPayoutAddress(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	payoutAddress, err := qs.k.GetPayoutAddress(ctx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPayoutAddressResponse{
+		PayoutAddress:       payoutAddress,
+		TopicPayoutAddress:  topicPayoutAddress,
+		GlobalPayoutAddress: globalPayoutAddress,
+	}, nil
+}
@@ -275,3 +275,31 @@ func (s *KeeperTestSuite) TestRegisteredReputerIsRegisteredInTopicId() {
 	require.NoError(err, "QueryIsReputerRegisteredInTopicId should not return an error")
 	require.True(queryResp.IsRegistered, "Query response should confirm reputer is registered")
 }
+
+func (s *KeeperTestSuite) TestGetPayoutAddress() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	worker := s.addrs[0].String()
+	treasury := s.addrs[1].String()
+	topicId := uint64(1)
+
+	response, err := queryServer.GetPayoutAddress(ctx, &types.QueryPayoutAddressRequest{TopicId: topicId, Address: worker})
+	s.Require().NoError(err)
+	s.Require().Equal(worker, response.PayoutAddress, "Rewards are paid to the worker by default")
+	s.Require().Empty(response.TopicPayoutAddress)
+	s.Require().Empty(response.GlobalPayoutAddress)
+
+	err = keeper.SetGlobalPayoutAddress(ctx, worker, treasury)
+	s.Require().NoError(err)
+
+	response, err = queryServer.GetPayoutAddress(ctx, &types.QueryPayoutAddressRequest{TopicId: topicId, Address: worker})
+	s.Require().NoError(err)
+	s.Require().Equal(treasury, response.PayoutAddress)
+	s.Require().Empty(response.TopicPayoutAddress)
+	s.Require().Equal(treasury, response.GlobalPayoutAddress)
+
+	_, err = queryServer.GetPayoutAddress(ctx, &types.QueryPayoutAddressRequest{TopicId: topicId, Address: "invalid"})
+	s.Require().Error(err)
+}
@@ -0,0 +1,23 @@
+package queryserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRegretHistory returns a page of the past regrets of a worker in a role, oldest first.
+func (qs queryServer) GetRegretHistory(ctx context.Context, req *types.QueryRegretHistoryRequest) (*types.QueryRegretHistoryResponse, error) {
+	regrets, pageRes, err := qs.k.GetRegretHistory(ctx, req.TopicId, req.Role, req.Worker, req.Inferer, req.Pagination)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidRegretRole) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRegretHistoryResponse{Regrets: regrets, Pagination: pageRes}, nil
+}
@@ -0,0 +1,52 @@
+package queryserver_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *KeeperTestSuite) TestGetRegretHistory() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	forecaster := "forecaster-address"
+
+	for blockHeight := int64(1); blockHeight <= 3; blockHeight++ {
+		err := keeper.SetOneInForecasterSelfNetworkRegret(ctx, topicId, forecaster, types.TimestampedValue{
+			BlockHeight: blockHeight,
+			Value:       alloraMath.NewDecFromInt64(-blockHeight),
+		}, 10)
+		s.Require().NoError(err)
+	}
+
+	response, err := s.queryServer.GetRegretHistory(ctx, &types.QueryRegretHistoryRequest{
+		TopicId:    topicId,
+		Role:       types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER_SELF,
+		Worker:     forecaster,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Regrets, 2)
+	s.Require().Equal(int64(1), response.Regrets[0].BlockHeight)
+	s.Require().Equal(int64(2), response.Regrets[1].BlockHeight)
+
+	response, err = s.queryServer.GetRegretHistory(ctx, &types.QueryRegretHistoryRequest{
+		TopicId:    topicId,
+		Role:       types.RegretRole_REGRET_ROLE_ONE_IN_FORECASTER_SELF,
+		Worker:     forecaster,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Regrets, 1)
+	s.Require().True(alloraMath.NewDecFromInt64(-3).Equal(response.Regrets[0].Value))
+	s.Require().Empty(response.Pagination.NextKey)
+
+	_, err = s.queryServer.GetRegretHistory(ctx, &types.QueryRegretHistoryRequest{
+		TopicId: topicId,
+		Role:    types.RegretRole(42),
+		Worker:  forecaster,
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
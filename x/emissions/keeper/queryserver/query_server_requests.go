@@ -0,0 +1,36 @@
+package queryserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetInferenceRequest returns an inference request that has not been settled or refunded yet.
+func (qs queryServer) GetInferenceRequest(ctx context.Context, req *types.QueryInferenceRequestRequest) (*types.QueryInferenceRequestResponse, error) {
+	request, err := qs.k.GetInferenceRequest(ctx, req.RequestId)
+	if err != nil {
+		if errors.Is(err, types.ErrInferenceRequestNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryInferenceRequestResponse{Request: &request}, nil
+}
+
+// GetOpenInferenceRequests returns the open inference requests of a topic, by nonce ascending.
+func (qs queryServer) GetOpenInferenceRequests(ctx context.Context, req *types.QueryOpenInferenceRequestsRequest) (*types.QueryOpenInferenceRequestsResponse, error) {
+	requests, err := qs.k.GetOpenInferenceRequests(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	requestPointers := make([]*types.InferenceRequest, 0, len(requests))
+	for i := range requests {
+		requestPointers = append(requestPointers, &requests[i])
+	}
+	return &types.QueryOpenInferenceRequestsResponse{Requests: requestPointers}, nil
+}
@@ -0,0 +1,47 @@
+package queryserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *KeeperTestSuite) TestGetInferenceRequests() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	sender := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	request := types.InferenceRequest{
+		Sender:           sender,
+		TopicId:          1,
+		Argument:         "BTC",
+		Bounty:           cosmosMath.NewInt(100),
+		GroundTruthBlock: 20,
+		Deadline:         30,
+	}
+
+	request.BlockHeight = 15
+	later, err := keeper.AddInferenceRequest(ctx, request)
+	s.Require().NoError(err)
+	request.BlockHeight = 10
+	sooner, err := keeper.AddInferenceRequest(ctx, request)
+	s.Require().NoError(err)
+	request.TopicId = 2
+	_, err = keeper.AddInferenceRequest(ctx, request)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetOpenInferenceRequests(ctx, &types.QueryOpenInferenceRequestsRequest{TopicId: 1})
+	s.Require().NoError(err)
+	s.Require().Len(response.Requests, 2)
+	s.Require().Equal(sooner, response.Requests[0].Id, "requests are listed by nonce")
+	s.Require().Equal(later, response.Requests[1].Id)
+
+	requestResponse, err := s.queryServer.GetInferenceRequest(ctx, &types.QueryInferenceRequestRequest{RequestId: later})
+	s.Require().NoError(err)
+	s.Require().Equal(sender, requestResponse.Request.Sender)
+	s.Require().Equal(int64(15), requestResponse.Request.BlockHeight)
+
+	s.Require().NoError(keeper.RemoveInferenceRequest(ctx, later))
+	_, err = s.queryServer.GetInferenceRequest(ctx, &types.QueryInferenceRequestRequest{RequestId: later})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,35 @@
+package queryserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTopicSubscriptions returns the subscriptions that still pay into a topic, by id ascending.
+func (qs queryServer) GetTopicSubscriptions(ctx context.Context, req *types.QueryTopicSubscriptionsRequest) (*types.QueryTopicSubscriptionsResponse, error) {
+	subscriptions, err := qs.k.GetTopicSubscriptions(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTopicSubscriptionsResponse{Subscriptions: subscriptionPointers(subscriptions)}, nil
+}
+
+// GetConsumerSubscriptions returns the active subscriptions of a consumer, by id ascending.
+func (qs queryServer) GetConsumerSubscriptions(ctx context.Context, req *types.QueryConsumerSubscriptionsRequest) (*types.QueryConsumerSubscriptionsResponse, error) {
+	subscriptions, err := qs.k.GetConsumerSubscriptions(ctx, req.Consumer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryConsumerSubscriptionsResponse{Subscriptions: subscriptionPointers(subscriptions)}, nil
+}
+
+func subscriptionPointers(subscriptions []types.Subscription) []*types.Subscription {
+	ret := make([]*types.Subscription, 0, len(subscriptions))
+	for i := range subscriptions {
+		ret = append(ret, &subscriptions[i])
+	}
+	return ret
+}
@@ -0,0 +1,48 @@
+package queryserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestGetSubscriptions() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	consumer := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	otherConsumer := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+	subscription := types.Subscription{
+		Consumer:         consumer,
+		TopicId:          1,
+		Escrow:           cosmosMath.NewInt(300),
+		AmountPerEpoch:   cosmosMath.NewInt(100),
+		NextPaymentBlock: 10,
+	}
+
+	first, err := keeper.AddSubscription(ctx, subscription)
+	s.Require().NoError(err)
+	subscription.TopicId = 2
+	second, err := keeper.AddSubscription(ctx, subscription)
+	s.Require().NoError(err)
+	subscription.Consumer = otherConsumer
+	_, err = keeper.AddSubscription(ctx, subscription)
+	s.Require().NoError(err)
+
+	topicResponse, err := s.queryServer.GetTopicSubscriptions(ctx, &types.QueryTopicSubscriptionsRequest{TopicId: 2})
+	s.Require().NoError(err)
+	s.Require().Len(topicResponse.Subscriptions, 2)
+	s.Require().Equal(second, topicResponse.Subscriptions[0].Id)
+
+	consumerResponse, err := s.queryServer.GetConsumerSubscriptions(ctx, &types.QueryConsumerSubscriptionsRequest{Consumer: consumer})
+	s.Require().NoError(err)
+	s.Require().Len(consumerResponse.Subscriptions, 2)
+	s.Require().Equal(first, consumerResponse.Subscriptions[0].Id)
+	s.Require().Equal(second, consumerResponse.Subscriptions[1].Id)
+
+	s.Require().NoError(keeper.RemoveSubscription(ctx, first))
+	consumerResponse, err = s.queryServer.GetConsumerSubscriptions(ctx, &types.QueryConsumerSubscriptionsRequest{Consumer: consumer})
+	s.Require().NoError(err)
+	s.Require().Len(consumerResponse.Subscriptions, 1)
+	topicResponse, err = s.queryServer.GetTopicSubscriptions(ctx, &types.QueryTopicSubscriptionsRequest{TopicId: 1})
+	s.Require().NoError(err)
+	s.Require().Empty(topicResponse.Subscriptions)
+}
@@ -89,3 +89,34 @@ func (qs queryServer) GetTopicLastReputerCommitInfo(ctx context.Context, req *ty
 
 	return &types.QueryTopicLastCommitResponse{LastCommit: &lastCommit}, nil
 }
+
+// Retrieves the cumulative amount of topic revenue that has been burned
+func (qs queryServer) GetTopicRevenueBurned(ctx context.Context, req *types.QueryTopicRevenueBurnedRequest) (*types.QueryTopicRevenueBurnedResponse, error) {
+	burned, err := qs.k.GetTopicRevenueBurned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTopicRevenueBurnedResponse{Amount: burned}, nil
+}
+
+// GetTopicParams returns the params that apply to a topic along with the overrides they resolve from.
+func (qs queryServer) GetTopicParams(ctx context.Context, req *types.QueryTopicParamsRequest) (*types.QueryTopicParamsResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	overrides, err := qs.k.GetTopicParamsOverrides(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	effectiveParams, err := qs.k.GetEffectiveTopicParams(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicParamsResponse{EffectiveParams: effectiveParams, Overrides: overrides}, nil
+}
@@ -1,6 +1,7 @@
 package queryserver_test
 
 import (
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 )
 
@@ -149,3 +150,23 @@ func (s *KeeperTestSuite) TestGetLatestCommit() {
 	s.Require().Equal(&nonce, response2.LastCommit.Nonce, "The metadata of the retrieved nonce should match")
 	s.Require().Equal(actor, response2.LastCommit.Actor, "The metadata of the retrieved nonce should match")
 }
+
+func (s *KeeperTestSuite) TestGetTopicParams() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId}))
+	overrides := types.TopicParamsOverrides{CNorm: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.9")}}
+	s.Require().NoError(keeper.SetTopicParamsOverrides(ctx, topicId, overrides))
+
+	response, err := s.queryServer.GetTopicParams(ctx, &types.QueryTopicParamsRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal(overrides, response.Overrides)
+	s.Require().Equal(alloraMath.MustNewDecFromString("0.9"), response.EffectiveParams.CNorm)
+	globalParams, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(globalParams.LearningRate, response.EffectiveParams.LearningRate)
+
+	_, err = s.queryServer.GetTopicParams(ctx, &types.QueryTopicParamsRequest{TopicId: topicId + 1})
+	s.Require().Error(err, "unknown topic")
+}
@@ -23,3 +23,29 @@ func (qs queryServer) IsWhitelistAdmin(ctx context.Context, req *types.QueryIsWh
 
 	return &types.QueryIsWhitelistAdminResponse{IsAdmin: isAdmin}, nil
 }
+
+func (qs queryServer) GetRoleMembers(ctx context.Context, req *types.QueryRoleMembersRequest) (*types.QueryRoleMembersResponse, error) {
+	if err := types.ValidateRole(req.Role); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	members, err := qs.k.GetRoleMembers(ctx, req.Role)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoleMembersResponse{Members: members}, nil
+}
+
+func (qs queryServer) GetAddressRoles(ctx context.Context, req *types.QueryAddressRolesRequest) (*types.QueryAddressRolesResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	roles, err := qs.k.GetAddressRoles(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressRolesResponse{Roles: roles}, nil
+}
@@ -33,3 +33,24 @@ func (s *KeeperTestSuite) TestIsWhitelistAdmin() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().False(response.IsAdmin, "The IsAdmin field should be false for the anti test address")
 }
+
+func (s *KeeperTestSuite) TestGetRoleMembersAndAddressRoles() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	moderator := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	err := keeper.GrantRole(ctx, types.RoleTopicModerator, moderator)
+	s.Require().NoError(err)
+
+	membersResponse, err := queryServer.GetRoleMembers(ctx, &types.QueryRoleMembersRequest{Role: types.RoleTopicModerator})
+	s.Require().NoError(err)
+	s.Require().Contains(membersResponse.Members, moderator)
+
+	rolesResponse, err := queryServer.GetAddressRoles(ctx, &types.QueryAddressRolesRequest{Address: moderator})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{types.RoleTopicModerator}, rolesResponse.Roles)
+
+	_, err = queryServer.GetRoleMembers(ctx, &types.QueryRoleMembersRequest{Role: "superuser"})
+	s.Require().Error(err)
+}
@@ -97,3 +97,43 @@ func (k *Keeper) GetCurrentTopicWeight(
 
 	return alloraMath.ZeroDec(), topicFeeRevenue, nil
 }
+
+// Activates the topic if its weight, with the added amount of stake or fee revenue, reaches the global minimum
+func (k *Keeper) ActivateTopicIfWeightAtLeastGlobalMin(ctx context.Context, topicId TopicId, amount cosmosMath.Int) error {
+	isActivated, err := k.IsTopicActive(ctx, topicId)
+	if err != nil {
+		return errors.Wrapf(err, "error getting topic activation status")
+	}
+	if !isActivated {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error getting params")
+		}
+		topic, err := k.GetTopic(ctx, topicId)
+		if err != nil {
+			return errors.Wrapf(err, "error getting topic")
+		}
+
+		newTopicWeight, _, err := k.GetCurrentTopicWeight(
+			ctx,
+			topicId,
+			topic.EpochLength,
+			params.TopicRewardAlpha,
+			params.TopicRewardStakeImportance,
+			params.TopicRewardFeeRevenueImportance,
+			amount,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "error getting current topic weight")
+		}
+
+		if newTopicWeight.Gte(params.MinTopicWeight) {
+			err = k.ActivateTopic(ctx, topicId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,18 +1,37 @@
 package v2
 
 import (
-	"fmt"
-
+	"cosmossdk.io/collections"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// An example MigrateStore function that might be run in the migration
-// handler.
-func MigrateStore(ctx sdk.Context) error {
-	ctx.Logger().Error(fmt.Sprintf(
-		"###################################################" +
-			"### MIGRATING STORE FROM VERSION 1 TO VERSION 2 ###" +
-			"###################################################",
-	))
+// MigrateStore replaces the single set of whitelist admins with named roles.
+// Every existing admin is granted every role, so nothing they could do before
+// is lost, and the old set is cleared.
+func MigrateStore(
+	ctx sdk.Context,
+	whitelistAdmins collections.KeySet[string],
+	roleMembers collections.KeySet[collections.Pair[string, string]],
+) error {
+	iter, err := whitelistAdmins.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	admins, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, admin := range admins {
+		for _, role := range types.AllRoles {
+			if err := roleMembers.Set(ctx, collections.Join(role, admin)); err != nil {
+				return err
+			}
+		}
+		if err := whitelistAdmins.Remove(ctx, admin); err != nil {
+			return err
+		}
+	}
+	ctx.Logger().Info("migrated whitelist admins to roles", "admins", len(admins))
 	return nil
 }
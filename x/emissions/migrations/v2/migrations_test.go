@@ -0,0 +1,41 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	v2 "github.com/allora-network/allora-chain/x/emissions/migrations/v2"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStoreGrantsAllRolesToWhitelistAdmins(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	whitelistAdmins := collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey)
+	roleMembers := collections.NewKeySet(sb, types.RoleMembersKey, "role_members", collections.PairKeyCodec(collections.StringKey, collections.StringKey))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	admins := []string{"allo1firstadmin", "allo1secondadmin"}
+	for _, admin := range admins {
+		require.NoError(t, whitelistAdmins.Set(ctx, admin))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, whitelistAdmins, roleMembers))
+
+	for _, admin := range admins {
+		for _, role := range types.AllRoles {
+			hasRole, err := roleMembers.Has(ctx, collections.Join(role, admin))
+			require.NoError(t, err)
+			require.True(t, hasRole, "%s should hold %s", admin, role)
+		}
+		stillWhitelisted, err := whitelistAdmins.Has(ctx, admin)
+		require.NoError(t, err)
+		require.False(t, stillWhitelisted)
+	}
+}
@@ -0,0 +1,82 @@
+package v3
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Number of blocks after the upgrade during which bundles signed over their bare
+// proto encoding are still accepted, about two weeks of five second blocks.
+const LegacyBundleSignatureWindow int64 = 241_920
+
+// MigrateParams gives the params added since version 2 their default value, they
+// would otherwise read as zero on upgraded chains. It also keeps accepting legacy
+// bundle signatures for a window after the upgrade, so that workers and reputers
+// have time to sign over a BundleSignDoc.
+func MigrateParams(ctx sdk.Context, params collections.Item[types.Params]) error {
+	moduleParams, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defaults := types.DefaultParams()
+	moduleParams.TopicRevenueBurnFraction = defaults.TopicRevenueBurnFraction
+	moduleParams.MinParamChangeDelay = defaults.MinParamChangeDelay
+	moduleParams.MaxOpenInferenceRequestsPerTopic = defaults.MaxOpenInferenceRequestsPerTopic
+	moduleParams.MaxStakeRemovalGasPerBlock = defaults.MaxStakeRemovalGasPerBlock
+	moduleParams.MaxRewardGasPerBlock = defaults.MaxRewardGasPerBlock
+	moduleParams.MaxPruningGasPerBlock = defaults.MaxPruningGasPerBlock
+	moduleParams.MaxInferenceDimension = defaults.MaxInferenceDimension
+	moduleParams.ProbabilitySumTolerance = defaults.ProbabilitySumTolerance
+	// the window must stay shorter than the shortest epoch
+	moduleParams.PayloadSubmissionWindow = min(defaults.PayloadSubmissionWindow, moduleParams.MinEpochLength-1)
+	moduleParams.LegacyBundleSignaturesEndBlock = ctx.BlockHeight() + LegacyBundleSignatureWindow
+	if err := params.Set(ctx, moduleParams); err != nil {
+		return err
+	}
+	ctx.Logger().Info("accepting legacy bundle signatures", "until", moduleParams.LegacyBundleSignaturesEndBlock)
+	return nil
+}
+
+// MigrateNodeRegistrations indexes the nodes registered before version 3 by the address that
+// registered them. Registrations did not record which node was registered in which topic, so
+// every node of an address is indexed under every topic the address is registered in.
+func MigrateNodeRegistrations(
+	ctx sdk.Context,
+	nodes collections.Map[string, types.OffchainNode],
+	topicMembers collections.KeySet[collections.Pair[uint64, string]],
+	registrations collections.KeySet[collections.Triple[string, string, uint64]],
+) error {
+	iter, err := topicMembers.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	members, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	topicsByAddress := make(map[string][]uint64)
+	for _, member := range members {
+		topicsByAddress[member.K2()] = append(topicsByAddress[member.K2()], member.K1())
+	}
+
+	nodesIter, err := nodes.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	kvs, err := nodesIter.KeyValues()
+	if err != nil {
+		return err
+	}
+	count := 0
+	for _, kv := range kvs {
+		for _, topicId := range topicsByAddress[kv.Value.NodeAddress] {
+			if err := registrations.Set(ctx, collections.Join3(kv.Value.NodeAddress, kv.Key, topicId)); err != nil {
+				return err
+			}
+			count++
+		}
+	}
+	ctx.Logger().Info("indexed node registrations", "nodes", len(kvs), "registrations", count)
+	return nil
+}
@@ -0,0 +1,116 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	v3 "github.com/allora-network/allora-chain/x/emissions/migrations/v3"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func setupParams(t *testing.T) (sdk.Context, collections.Item[types.Params]) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithBlockHeight(1000)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+	return ctx, params
+}
+
+func TestMigrateParamsOpensLegacySignatureWindow(t *testing.T) {
+	ctx, params := setupParams(t)
+
+	before := types.DefaultParams()
+	require.NoError(t, params.Set(ctx, before))
+
+	require.NoError(t, v3.MigrateParams(ctx, params))
+
+	after, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1000+v3.LegacyBundleSignatureWindow, after.LegacyBundleSignaturesEndBlock)
+	after.LegacyBundleSignaturesEndBlock = before.LegacyBundleSignaturesEndBlock
+	require.Equal(t, before, after)
+}
+
+func TestMigrateParamsBackfillsParamsAddedSinceV2(t *testing.T) {
+	ctx, params := setupParams(t)
+
+	// params added since version 2 are missing from the stored params of upgraded chains
+	before := types.DefaultParams()
+	before.TopicRevenueBurnFraction = alloraMath.Dec{}
+	before.MinParamChangeDelay = 0
+	before.MaxOpenInferenceRequestsPerTopic = 0
+	before.MaxStakeRemovalGasPerBlock = 0
+	before.MaxRewardGasPerBlock = 0
+	before.MaxPruningGasPerBlock = 0
+	before.MaxInferenceDimension = 0
+	before.ProbabilitySumTolerance = alloraMath.Dec{}
+	before.PayloadSubmissionWindow = 0
+	require.NoError(t, params.Set(ctx, before))
+
+	require.NoError(t, v3.MigrateParams(ctx, params))
+
+	after, err := params.Get(ctx)
+	require.NoError(t, err)
+	after.LegacyBundleSignaturesEndBlock = types.DefaultParams().LegacyBundleSignaturesEndBlock
+	require.Equal(t, types.DefaultParams(), after)
+	require.Positive(t, after.MinParamChangeDelay, "params changes of upgraded chains go through the timelock")
+}
+
+func TestMigrateParamsKeepsPayloadSubmissionWindowShorterThanMinEpochLength(t *testing.T) {
+	ctx, params := setupParams(t)
+
+	before := types.DefaultParams()
+	before.MinEpochLength = 2
+	before.PayloadSubmissionWindow = 0
+	require.NoError(t, params.Set(ctx, before))
+
+	require.NoError(t, v3.MigrateParams(ctx, params))
+
+	after, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), after.PayloadSubmissionWindow)
+	require.NoError(t, after.Validate())
+}
+
+func TestMigrateNodeRegistrationsIndexesNodesByAddress(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	nodes := collections.NewMap(sb, types.WorkerNodesKey, "worker_nodes", collections.StringKey, codec.CollValue[types.OffchainNode](cdc))
+	topicWorkers := collections.NewKeySet(sb, types.TopicWorkersKey, "topic_workers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey))
+	registrations := collections.NewKeySet(sb, types.WorkerNodeRegistrationsKey, "worker_node_registrations", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, nodes.Set(ctx, "key-a", types.OffchainNode{LibP2PKey: "key-a", NodeAddress: "worker-a"}))
+	require.NoError(t, nodes.Set(ctx, "key-b", types.OffchainNode{LibP2PKey: "key-b", NodeAddress: "worker-b"}))
+	require.NoError(t, nodes.Set(ctx, "key-c", types.OffchainNode{LibP2PKey: "key-c", NodeAddress: "worker-c"}))
+	require.NoError(t, topicWorkers.Set(ctx, collections.Join(uint64(1), "worker-a")))
+	require.NoError(t, topicWorkers.Set(ctx, collections.Join(uint64(2), "worker-a")))
+	require.NoError(t, topicWorkers.Set(ctx, collections.Join(uint64(2), "worker-b")))
+
+	require.NoError(t, v3.MigrateNodeRegistrations(ctx, nodes, topicWorkers, registrations))
+
+	iter, err := registrations.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[string, string, uint64]{
+		collections.Join3("worker-a", "key-a", uint64(1)),
+		collections.Join3("worker-a", "key-a", uint64(2)),
+		collections.Join3("worker-b", "key-b", uint64(2)),
+	}, keys, "nodes of addresses in no topic are not indexed")
+}
@@ -29,6 +29,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetTopicParams",
+					Use:       "topic-params [topic_id]",
+					Short:     "Get the params that apply to a topic, i.e. its overrides on top of the global params",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "GetActiveTopics",
 					Use:       "active-topics [pagination]",
@@ -156,6 +164,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "total-stake",
 					Short:     "Get the total amount of staked tokens by all participants in the network",
 				},
+				{
+					RpcMethod: "GetTopicRevenueBurned",
+					Use:       "topic-revenue-burned",
+					Short:     "Get the cumulative amount of topic revenue that has been burned",
+				},
 				{
 					RpcMethod: "GetForecastsAtBlock",
 					Use:       "forecasts-at-block [topic_id] [block]",
@@ -195,7 +208,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "IsWhitelistAdmin",
 					Use:       "is-whitelist-admin [address]",
-					Short:     "Check if an address is a whitelist admin. True if so, else false",
+					Short:     "Check if an address is a whitelist admin, i.e. holds every role. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetScheduledParamsChanges",
+					Use:       "scheduled-params-changes",
+					Short:     "Get the pending params changes, in the order they will be applied",
+				},
+				{
+					RpcMethod: "GetInferenceRequest",
+					Use:       "inference-request [request_id]",
+					Short:     "Get an ad-hoc inference request that has not been settled or refunded yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "request_id"},
+					},
+				},
+				{
+					RpcMethod: "GetOpenInferenceRequests",
+					Use:       "open-inference-requests [topic_id]",
+					Short:     "Get the open ad-hoc inference requests of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSubscriptions",
+					Use:       "topic-subscriptions [topic_id]",
+					Short:     "Get the subscriptions that still pay into a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetConsumerSubscriptions",
+					Use:       "consumer-subscriptions [consumer]",
+					Short:     "Get the active subscriptions of a consumer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "consumer"},
+					},
+				},
+				{
+					RpcMethod: "GetRoleMembers",
+					Use:       "role-members [role]",
+					Short:     "Get the addresses holding a role: params-admin, mint-admin, topic-moderator or admin-manager",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "GetAddressRoles",
+					Use:       "address-roles [address]",
+					Short:     "Get the roles held by an address",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "address"},
 					},
@@ -251,6 +317,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetRegretHistory",
+					Use:       "regret-history [topic_id] [role] [worker]",
+					Short:     "Get the past regrets of a worker in a role, oldest first. Pass --inferer for the one-in forecaster role",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "role"},
+						{ProtoField: "worker"},
+					},
+				},
+				{
+					RpcMethod: "GetPayoutAddress",
+					Use:       "payout-address [topic_id] [address]",
+					Short:     "Get the address the worker rewards of an address in a topic are sent to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -265,6 +350,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "params"},
 					},
 				},
+				{
+					RpcMethod: "UpdateTopicParamsOverrides",
+					Use:       "update-topic-params-overrides [sender] [topic_id] [overrides]",
+					Short:     "Replace the overrides of the global params for a topic, empty overrides clear them",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "overrides"},
+					},
+				},
+				{
+					RpcMethod: "ScheduleParamsChange",
+					Use:       "schedule-params-change [sender] [params] [apply_at_block]",
+					Short:     "Schedule a params change of the network, applied at the beginning of the given block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "params"},
+						{ProtoField: "apply_at_block"},
+					},
+				},
+				{
+					RpcMethod: "CancelParamsChange",
+					Use:       "cancel-params-change [sender] [id]",
+					Short:     "Cancel a scheduled params change before it is applied",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "id"},
+					},
+				},
 				{
 					RpcMethod: "CreateNewTopic",
 					Use:       "create-topic [creator] [metadata] [loss_logic] [loss_method] [inference_logic] [inference_method] [epoch_length] [ground_truth_lag] [default_arg] [p_norm] [alpha_regret] [allow_negative] [epsilon]",
@@ -308,6 +422,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "is_reputer"},
 					},
 				},
+				{
+					RpcMethod: "UpdateNodeInfo",
+					Use:       "update-node-info [sender] [previous_lib_p2p_key] [lib_p2p_key] [multi_address] [is_reputer]",
+					Short:     "Rotate the libp2p key and multiaddress of the worker or reputer node sender registered under previous_lib_p2p_key, in all its topics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "previous_lib_p2p_key"},
+						{ProtoField: "lib_p2p_key"},
+						{ProtoField: "multi_address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "SetPayoutAddress",
+					Use:       "set-payout-address [sender] [topic_id] [payout_address]",
+					Short:     "Send the worker rewards of sender in a topic, or in all topics with --global, to [payout_address]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "payout_address"},
+					},
+				},
 				{
 					RpcMethod: "AddStake",
 					Use:       "add-stake [sender] [topic_id] [amount]",
@@ -389,10 +525,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "RequestInference",
+					Use:       "request-inference [sender] [topic_id] [argument] [deadline] [bounty]",
+					Short:     "request an ad-hoc inference, escrowing a bounty paid to participants by score at the deadline",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "argument"},
+						{ProtoField: "deadline"},
+						{ProtoField: "bounty"},
+					},
+				},
+				{
+					RpcMethod: "CreateSubscription",
+					Use:       "create-subscription [sender] [topic_id] [escrow] [amount_per_epoch]",
+					Short:     "escrow funds paid into the revenue of a topic, amount_per_epoch every epoch of the topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "escrow"},
+						{ProtoField: "amount_per_epoch"},
+					},
+				},
+				{
+					RpcMethod: "CancelSubscription",
+					Use:       "cancel-subscription [sender] [subscription_id]",
+					Short:     "cancel a subscription, refunding what is left of its escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "subscription_id"},
+					},
+				},
 				{
 					RpcMethod: "AddToWhitelistAdmin",
 					Use:       "add-to-whitelist-admin [sender] [address]",
-					Short:     "add an admin address to the whitelist used for admin functions on-chain",
+					Short:     "grant every role used for admin functions on-chain to an address",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "sender"},
 						{ProtoField: "address"},
@@ -401,12 +569,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "RemoveFromWhitelistAdmin",
 					Use:       "remove-from-whitelist-admin [sender] [address]",
-					Short:     "remove a admin address from the whitelist used for admin functions on-chain",
+					Short:     "revoke every role used for admin functions on-chain from an address",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "sender"},
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GrantRole",
+					Use:       "grant-role [sender] [role] [address]",
+					Short:     "grant a role (params-admin, mint-admin, topic-moderator or admin-manager) to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "role"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "RevokeRole",
+					Use:       "revoke-role [sender] [role] [address]",
+					Short:     "revoke a role from an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "role"},
+						{ProtoField: "address"},
+					},
+				},
 				{
 					RpcMethod: "InsertBulkWorkerPayload",
 					Use:       "insert-bulk-worker-payload [worker_data_bundles]",
@@ -425,6 +613,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer_value_bundles"},
 					},
 				},
+				{
+					RpcMethod: "InsertWorkerPayload",
+					Use:       "insert-worker-payload [sender] [worker_data_bundle]",
+					Short:     "Insert the payload of a single worker, signed by the worker itself",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "worker_data_bundle"},
+					},
+				},
+				{
+					RpcMethod: "InsertReputerPayload",
+					Use:       "insert-reputer-payload [sender] [reputer_value_bundle]",
+					Short:     "Insert the payload of a single reputer, signed by the reputer itself",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "reputer_value_bundle"},
+					},
+				},
 			},
 		},
 	}
@@ -0,0 +1,108 @@
+package module
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ask reputers for losses on the inference requests whose ground truth is available this block,
+// then pay out or refund the bounties of the inference requests whose deadline is this block
+func ProcessInferenceRequests(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	requests, err := k.GetInferenceRequestsAtGroundTruthBlock(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get inference requests with ground truth at block %d: %v", currentBlock, err))
+	}
+	for _, request := range requests {
+		// without any inference there is nothing to assess, the bounty is refunded at the deadline
+		_, err := k.GetInferencesAtBlock(sdkCtx, request.TopicId, request.BlockHeight)
+		if err != nil {
+			continue
+		}
+		nonce := emissionstypes.Nonce{BlockHeight: request.BlockHeight}
+		err = k.AddReputerNonce(sdkCtx, request.TopicId, &nonce, &nonce)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error adding reputer nonce of inference request %d: %v", request.Id, err))
+		}
+	}
+
+	requests, err = k.GetInferenceRequestsAtDeadline(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get inference requests with deadline at block %d: %v", currentBlock, err))
+		return
+	}
+	for _, request := range requests {
+		err = closeInferenceRequest(sdkCtx, k, request)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error closing inference request %d: %v", request.Id, err))
+		}
+	}
+}
+
+// Pays the bounty out by score if reputers reported losses for the request, and refunds what is left to the sender
+func closeInferenceRequest(sdkCtx sdk.Context, k emissionskeeper.Keeper, request emissionstypes.InferenceRequest) error {
+	paid := cosmosMath.ZeroInt()
+	_, err := k.GetNetworkLossBundleAtBlock(sdkCtx, request.TopicId, request.BlockHeight)
+	if err == nil {
+		paid, err = payInferenceRequestBounty(sdkCtx, k, request)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Failed to pay out bounty of inference request %d, refunding it: %v", request.Id, err))
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	refund := request.Bounty.Sub(paid)
+	if refund.IsPositive() {
+		err = k.SendCoinsFromModuleToAccount(
+			sdkCtx,
+			emissionstypes.AlloraRequestsAccountName,
+			request.Sender,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, refund)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// nobody can be paid for this nonce anymore
+	nonce := emissionstypes.Nonce{BlockHeight: request.BlockHeight}
+	if _, err := k.FulfillWorkerNonce(sdkCtx, request.TopicId, &nonce); err != nil {
+		return err
+	}
+	if _, err := k.FulfillReputerNonce(sdkCtx, request.TopicId, &nonce); err != nil {
+		return err
+	}
+	if err := k.RemoveInferenceRequest(sdkCtx, request.Id); err != nil {
+		return err
+	}
+
+	if paid.IsPositive() {
+		emissionstypes.EmitNewInferenceRequestSettledEvent(sdkCtx, request, paid, refund)
+	} else {
+		emissionstypes.EmitNewInferenceRequestExpiredEvent(sdkCtx, request)
+	}
+	return nil
+}
+
+// Scoring and paying out is all or nothing, so a failure leaves the whole bounty to be refunded
+func payInferenceRequestBounty(sdkCtx sdk.Context, k emissionskeeper.Keeper, request emissionstypes.InferenceRequest) (cosmosMath.Int, error) {
+	cacheCtx, write := sdkCtx.CacheContext()
+	paid, err := rewards.PayInferenceRequestBounty(cacheCtx, k, request)
+	if err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	write()
+	return paid, nil
+}
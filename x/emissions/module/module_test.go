@@ -0,0 +1,109 @@
+package module_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	auth "github.com/cosmos/cosmos-sdk/x/auth"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModuleTestSuite struct {
+	suite.Suite
+
+	ctx                sdk.Context
+	cms                storetypes.CommitMultiStore
+	bankKeeper         bankkeeper.BaseKeeper
+	emissionsKeeper    keeper.Keeper
+	emissionsAppModule module.AppModule
+	msgServer          types.MsgServer
+	addrs              []sdk.AccAddress
+}
+
+func TestModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(ModuleTestSuite))
+}
+
+func (s *ModuleTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey("emissions")
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
+
+	maccPerms := map[string][]string{
+		"fee_collector":                                  {"minter"},
+		"ecosystem":                                      {"minter"},
+		types.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
+		types.AlloraRewardsAccountName:                   {"minter"},
+		types.AlloraRequestsAccountName:                  nil,
+		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+	}
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		storeService,
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		authcodec.NewBech32Codec(params.Bech32PrefixAccAddr),
+		params.Bech32PrefixAccAddr,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	)
+	emissionsKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName)
+
+	s.ctx = ctx
+	s.cms = testCtx.CMS
+	s.bankKeeper = bankKeeper
+	s.emissionsKeeper = emissionsKeeper
+	s.emissionsAppModule = module.NewAppModule(encCfg.Codec, emissionsKeeper)
+	s.emissionsAppModule.InitGenesis(ctx, encCfg.Codec, s.emissionsAppModule.DefaultGenesis(encCfg.Codec))
+	s.msgServer = msgserver.NewMsgServerImpl(emissionsKeeper)
+
+	s.addrs = make([]sdk.AccAddress, 0)
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		s.addrs = append(s.addrs, addr)
+		s.MintTokensToAddress(addr, cosmosMath.NewInt(10000000000))
+		s.emissionsKeeper.AddWhitelistAdmin(ctx, addr.String())
+	}
+}
+
+func (s *ModuleTestSuite) MintTokensToAddress(address sdk.AccAddress, amount cosmosMath.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, types.AlloraStakingAccountName, coins))
+	s.Require().NoError(s.bankKeeper.SendCoinsFromModuleToAccount(s.ctx, types.AlloraStakingAccountName, address, coins))
+}
@@ -0,0 +1,68 @@
+package module
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The work done on one topic, held in its own branch of the store until it is merged
+type topicBranch struct {
+	topic emissionstypes.Topic
+	ctx   sdk.Context
+	write func()
+	err   error
+}
+
+// Runs fn on every topic in parallel, each on its own branch of the store with its own gas meter
+// and event manager, so the goroutines share no mutable state. The store is only read while they run.
+// The branches are then merged one at a time by topic id ascending, so the resulting state and events
+// do not depend on the order the goroutines finish in. A topic whose fn fails leaves no trace.
+// fn must only write the state of its own topic, so that the branches do not overwrite each other.
+func RunTopicsInParallel(
+	sdkCtx sdk.Context,
+	topics []emissionstypes.Topic,
+	fn func(ctx sdk.Context, topic emissionstypes.Topic) error,
+) {
+	branches := make([]*topicBranch, 0, len(topics))
+	for _, topic := range topics {
+		ctx, write := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+		branches = append(branches, &topicBranch{topic: topic, ctx: ctx, write: write})
+	}
+	slices.SortFunc(branches, func(a, b *topicBranch) int {
+		if a.topic.Id < b.topic.Id {
+			return -1
+		} else if a.topic.Id > b.topic.Id {
+			return 1
+		}
+		return 0
+	})
+
+	var wg sync.WaitGroup
+	for _, branch := range branches {
+		wg.Add(1)
+		go func(branch *topicBranch) {
+			defer wg.Done()
+			// a panic must not take the node down from a goroutine, it fails the topic instead
+			defer func() {
+				if r := recover(); r != nil {
+					branch.err = fmt.Errorf("panic: %v", r)
+				}
+			}()
+			branch.err = fn(branch.ctx, branch.topic)
+		}(branch)
+	}
+	wg.Wait()
+
+	for _, branch := range branches {
+		if branch.err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error running topic %d, discarding its changes: %v", branch.topic.Id, branch.err))
+			continue
+		}
+		branch.write()
+	}
+}
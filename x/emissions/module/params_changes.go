@@ -0,0 +1,51 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Apply the params changes scheduled for this block, in the order they were scheduled
+func ApplyScheduledParamsChanges(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	changes, err := k.GetScheduledParamsChangesForBlock(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf(
+			"Unable to get scheduled params changes for block %d, skipping params changes: %v",
+			currentBlock,
+			err,
+		))
+		return
+	}
+	for _, change := range changes {
+		// the change leaves the schedule whether or not it applies, a failed change
+		// has to be scheduled again
+		err = k.RemoveScheduledParamsChange(sdkCtx, change.Id)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing scheduled params change: %v | %v",
+				change,
+				err,
+			))
+			continue
+		}
+		// params are validated once more as other changes may have landed since scheduling
+		err = k.UpdateParams(sdkCtx, change.Params)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error applying scheduled params change: %v | %v",
+				change,
+				err,
+			))
+			emissionstypes.EmitNewParamsChangeFailedEvent(sdkCtx, change.Id, currentBlock, err)
+			continue
+		}
+		emissionstypes.EmitNewParamsChangeAppliedEvent(sdkCtx, change.Id, currentBlock)
+	}
+}
@@ -0,0 +1,66 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ranks the payloads workers and reputers submitted directly to the nonces whose submission window
+// ends this block. Worker nonces close first, so that reputers can assess them in a later block.
+func ClosePayloadSubmissions(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	workerNonces, err := k.PopWorkerNonceCloses(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get worker nonces closing at block %d: %v", currentBlock, err))
+	}
+	for _, key := range workerNonces {
+		topicId, nonce := key.K1(), key.K2()
+		bundles, err := k.PopWorkerPayloads(sdkCtx, topicId, nonce)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Unable to get worker payloads of topic %d at nonce %d: %v", topicId, nonce, err))
+			continue
+		}
+		// a leader's payload may have closed the nonce already
+		if len(bundles) == 0 {
+			continue
+		}
+		// a nonce that fails to close must not keep part of the work of its bundles
+		cacheCtx, write := sdkCtx.CacheContext()
+		err = msgserver.CloseWorkerNonce(cacheCtx, k, topicId, emissionstypes.Nonce{BlockHeight: nonce}, bundles)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error closing worker nonce %d of topic %d: %v", nonce, topicId, err))
+			continue
+		}
+		write()
+	}
+
+	reputerNonces, err := k.PopReputerNonceCloses(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get reputer nonces closing at block %d: %v", currentBlock, err))
+	}
+	for _, key := range reputerNonces {
+		topicId, nonce := key.K1(), key.K2()
+		bundles, err := k.PopReputerPayloads(sdkCtx, topicId, nonce)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Unable to get reputer payloads of topic %d at nonce %d: %v", topicId, nonce, err))
+			continue
+		}
+		if len(bundles) == 0 {
+			continue
+		}
+		cacheCtx, write := sdkCtx.CacheContext()
+		err = msgserver.CloseReputerNonce(cacheCtx, k, topicId, nonce, bundles)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error closing reputer nonce %d of topic %d: %v", nonce, topicId, err))
+			continue
+		}
+		write()
+	}
+}
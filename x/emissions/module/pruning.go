@@ -0,0 +1,46 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prune the records of rewarded topics, one topic at a time by topic id, within the gas budget
+// of the work context. The records left over are the first to be pruned next block.
+func PruneRewardedRecords(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	gasBudget uint64,
+) {
+	processed := 0
+	for !isWorkBudgetSpent(ctx, gasBudget, processed) {
+		topicId, blockHeight, found, err := k.GetNextPendingRecordPrune(ctx)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Unable to get records to prune: %v", err))
+			return
+		}
+		if !found {
+			break
+		}
+		processed++
+		done, err := k.PruneRecordsAfterRewardsUpToLimit(ctx, topicId, blockHeight, workQueueBatchSize)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Error pruning records of topic %d up to block %d, skipping: %v", topicId, blockHeight, err))
+			done = true
+		}
+		if done {
+			err = k.RemovePendingRecordPrune(ctx, topicId)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("Error removing records to prune of topic %d: %v", topicId, err))
+				return
+			}
+		}
+	}
+
+	backlog, err := k.CountPendingRecordPrunes(ctx)
+	if err == nil {
+		setBacklogGauge(backlog, "record_prunes")
+	}
+}
@@ -0,0 +1,45 @@
+package rewards
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pays the bounty of an inference request out of escrow to the inferers, forecasters and reputers
+// of its nonce, split by score in the same way as the topic reward of an epoch.
+// Returns how much of the bounty was paid out, the remainder is left in escrow.
+func PayInferenceRequestBounty(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	request types.InferenceRequest,
+) (cosmosMath.Int, error) {
+	bounty, err := alloraMath.NewDecFromSdkInt(request.Bounty)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrapf(err, "failed to convert bounty of inference request %d", request.Id)
+	}
+	distribution, _, err := GenerateRewardsDistributionByTopicParticipant(ctx, k, request.TopicId, &bounty, request.BlockHeight)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrapf(err, "failed to generate rewards of inference request %d", request.Id)
+	}
+
+	// never pay out more than what this request escrowed, the account holds the bounties of other requests too
+	total := cosmosMath.ZeroInt()
+	for _, reward := range distribution {
+		total = total.Add(reward.Reward.Abs().SdkIntTrim())
+	}
+	if total.GT(request.Bounty) {
+		return cosmosMath.Int{}, errors.Wrapf(types.ErrInvalidReward, "rewards %s exceed the bounty %s of inference request %d", total, request.Bounty, request.Id)
+	}
+
+	paid, payoutErrors := payoutRewards(ctx, k, types.AlloraRequestsAccountName, distribution)
+	for _, err := range payoutErrors {
+		Logger(ctx).Warn(fmt.Sprintf("Failed to pay out bounty of inference request %d: %s", request.Id, err.Error()))
+	}
+	return paid, nil
+}
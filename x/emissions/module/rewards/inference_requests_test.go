@@ -0,0 +1,165 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *RewardsTestSuite) TestInferenceRequestBountyPaidByScore() {
+	require := s.Require()
+	blockHeight := int64(600)
+	groundTruthLag := int64(10)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight)
+
+	reputer := s.addrs[0]
+	requester := s.addrs[1]
+	worker := s.addrs[5]
+
+	res, err := s.msgServer.CreateNewTopic(s.ctx, &types.MsgCreateNewTopic{
+		Creator:         reputer.String(),
+		Metadata:        "test",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  groundTruthLag,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.NoError(err)
+	topicId := res.TopicId
+
+	for _, registration := range []struct {
+		addr      sdk.AccAddress
+		isReputer bool
+	}{{worker, false}, {reputer, true}} {
+		_, err = s.msgServer.Register(s.ctx, &types.MsgRegister{
+			Sender:       registration.addr.String(),
+			LibP2PKey:    "test",
+			MultiAddress: "test",
+			TopicId:      topicId,
+			IsReputer:    registration.isReputer,
+			Owner:        registration.addr.String(),
+		})
+		require.NoError(err)
+	}
+
+	stake := cosmosMath.NewInt(1176644).Mul(inference_synthesis.CosmosIntOneE18())
+	s.MintTokensToAddress(reputer, stake)
+	_, err = s.msgServer.AddStake(s.ctx, &types.MsgAddStake{
+		Sender:  reputer.String(),
+		Amount:  stake,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+
+	bounty := cosmosMath.NewInt(1_000_000)
+	s.MintTokensToAddress(requester, bounty)
+	requesterBalanceBefore := s.bankKeeper.GetBalance(s.ctx, requester, params.DefaultBondDenom).Amount
+	requestResp, err := s.msgServer.RequestInference(s.ctx, &types.MsgRequestInference{
+		Sender:   requester.String(),
+		TopicId:  topicId,
+		Argument: "BTC",
+		Deadline: blockHeight + groundTruthLag + 10,
+		Bounty:   bounty,
+	})
+	require.NoError(err)
+	nonce := requestResp.Nonce
+	require.Equal(blockHeight, nonce.BlockHeight)
+
+	// the worker answers the one-off nonce through the usual bulk payload
+	inferenceBundle := &types.InferenceForecastBundle{
+		Inference: &types.Inference{
+			TopicId:     topicId,
+			BlockHeight: nonce.BlockHeight,
+			Inferer:     worker.String(),
+			Value:       alloraMath.MustNewDecFromString("0.01127"),
+		},
+	}
+	workerSig, err := GenerateWorkerSignature(s, inferenceBundle, worker)
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:  worker.String(),
+		Nonce:   nonce,
+		TopicId: topicId,
+		WorkerDataBundles: []*types.WorkerDataBundle{{
+			Worker:                             worker.String(),
+			InferenceForecastsBundle:           inferenceBundle,
+			InferencesForecastsBundleSignature: workerSig,
+			Pubkey:                             GetAccPubKey(s, worker),
+		}},
+	})
+	require.NoError(err)
+
+	// reputers are only asked once the ground truth is available
+	unfulfilled, err := s.emissionsKeeper.IsReputerNonceUnfulfilled(s.ctx, topicId, nonce)
+	require.NoError(err)
+	require.False(unfulfilled)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + groundTruthLag)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+	unfulfilled, err = s.emissionsKeeper.IsReputerNonceUnfulfilled(s.ctx, topicId, nonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	reputerRequestNonce := &types.ReputerRequestNonce{ReputerNonce: nonce, WorkerNonce: nonce}
+	valueBundle := &types.ValueBundle{
+		TopicId:                topicId,
+		ReputerRequestNonce:    reputerRequestNonce,
+		Reputer:                reputer.String(),
+		CombinedValue:          alloraMath.MustNewDecFromString("0.01127"),
+		NaiveValue:             alloraMath.MustNewDecFromString("0.0116"),
+		InfererValues:          []*types.WorkerAttributedValue{{Worker: worker.String(), Value: alloraMath.MustNewDecFromString("0.0112")}},
+		ForecasterValues:       []*types.WorkerAttributedValue{},
+		OneOutInfererValues:    []*types.WithheldWorkerAttributedValue{},
+		OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{},
+		OneInForecasterValues:  []*types.WorkerAttributedValue{},
+	}
+	reputerSig, err := GenerateReputerSignature(s, valueBundle, reputer)
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkReputerPayload(s.ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:              reputer.String(),
+		TopicId:             topicId,
+		ReputerRequestNonce: reputerRequestNonce,
+		ReputerValueBundles: []*types.ReputerValueBundle{{
+			Pubkey:      GetAccPubKey(s, reputer),
+			Signature:   reputerSig,
+			ValueBundle: valueBundle,
+		}},
+	})
+	require.NoError(err)
+
+	// the request is paid by its bounty, not by emissions
+	rewardNonce, err := s.emissionsKeeper.GetTopicRewardNonce(s.ctx, topicId)
+	require.NoError(err)
+	require.Zero(rewardNonce)
+
+	workerBalanceBefore := s.bankKeeper.GetBalance(s.ctx, worker, params.DefaultBondDenom).Amount
+	reputerStakeBefore, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, reputer.String())
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + groundTruthLag + 10)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+
+	workerBalanceAfter := s.bankKeeper.GetBalance(s.ctx, worker, params.DefaultBondDenom).Amount
+	require.True(workerBalanceAfter.GT(workerBalanceBefore), "the inferer is paid from the bounty")
+	reputerStakeAfter, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, reputer.String())
+	require.NoError(err)
+	require.True(reputerStakeAfter.GT(reputerStakeBefore), "the reputer is paid from the bounty")
+
+	paid := workerBalanceAfter.Sub(workerBalanceBefore).Add(reputerStakeAfter.Sub(reputerStakeBefore))
+	requesterBalanceAfter := s.bankKeeper.GetBalance(s.ctx, requester, params.DefaultBondDenom).Amount
+	refunded := requesterBalanceAfter.Sub(requesterBalanceBefore.Sub(bounty))
+	require.Equal(bounty, paid.Add(refunded), "the bounty is paid out in full, the remainder refunded")
+	requestsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRequestsAccountName)
+	require.True(s.bankKeeper.GetBalance(s.ctx, requestsAddr, params.DefaultBondDenom).Amount.IsZero())
+
+	_, err = s.emissionsKeeper.GetInferenceRequest(s.ctx, requestResp.RequestId)
+	require.ErrorIs(err, types.ErrInferenceRequestNotFound)
+}
@@ -0,0 +1,265 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *RewardsTestSuite) TestDirectPayloadsAreRankedWhenTheirNonceCloses() {
+	require := s.Require()
+	blockHeight := int64(600)
+	window := int64(3)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.PayloadSubmissionWindow = window
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	reputer := s.addrs[0]
+	workers := []sdk.AccAddress{s.addrs[5], s.addrs[6]}
+
+	res, err := s.msgServer.CreateNewTopic(s.ctx, &types.MsgCreateNewTopic{
+		Creator:         reputer.String(),
+		Metadata:        "test",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.NoError(err)
+	topicId := res.TopicId
+	epochLength := int64(10800)
+
+	for _, addr := range append([]sdk.AccAddress{reputer}, workers...) {
+		_, err = s.msgServer.Register(s.ctx, &types.MsgRegister{
+			Sender:       addr.String(),
+			LibP2PKey:    "test",
+			MultiAddress: "test",
+			TopicId:      topicId,
+			IsReputer:    addr.Equals(reputer),
+			Owner:        addr.String(),
+		})
+		require.NoError(err)
+	}
+	stake := cosmosMath.NewInt(1176644).Mul(inference_synthesis.CosmosIntOneE18())
+	s.MintTokensToAddress(reputer, stake)
+	_, err = s.msgServer.AddStake(s.ctx, &types.MsgAddStake{
+		Sender:  reputer.String(),
+		Amount:  stake,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+
+	// Each worker submits its own inferences, for two consecutive worker nonces
+	submitInferences := func(nonce int64) {
+		for i, worker := range workers {
+			bundle := &types.InferenceForecastBundle{
+				Inference: &types.Inference{
+					TopicId:     topicId,
+					BlockHeight: nonce,
+					Inferer:     worker.String(),
+					Value:       alloraMath.NewDecFromInt64(int64(100 + i)),
+				},
+			}
+			sig, err := GenerateWorkerSignature(s, bundle, worker)
+			require.NoError(err)
+			msg := &types.MsgInsertWorkerPayload{
+				Sender: worker.String(),
+				WorkerDataBundle: &types.WorkerDataBundle{
+					Worker:                             worker.String(),
+					InferenceForecastsBundle:           bundle,
+					InferencesForecastsBundleSignature: sig,
+					Pubkey:                             GetAccPubKey(s, worker),
+				},
+			}
+			resp, err := s.msgServer.InsertWorkerPayload(s.ctx, msg)
+			require.NoError(err)
+			require.Equal(s.ctx.BlockHeight()+window, resp.CloseBlock)
+
+			_, err = s.msgServer.InsertWorkerPayload(s.ctx, msg)
+			require.ErrorIs(err, types.ErrPayloadAlreadySubmitted)
+		}
+	}
+	firstNonce := &types.Nonce{BlockHeight: blockHeight}
+	require.NoError(s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, firstNonce))
+	submitInferences(firstNonce.BlockHeight)
+
+	// Nothing is ranked before the window ends
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + window - 1)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+	unfulfilled, err := s.emissionsKeeper.IsWorkerNonceUnfulfilled(s.ctx, topicId, firstNonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + window)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+	unfulfilled, err = s.emissionsKeeper.IsWorkerNonceUnfulfilled(s.ctx, topicId, firstNonce)
+	require.NoError(err)
+	require.False(unfulfilled)
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(s.ctx, topicId, firstNonce.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 2)
+
+	// Closing the next worker nonce asks reputers to assess the first one
+	secondNonce := &types.Nonce{BlockHeight: blockHeight + epochLength}
+	require.NoError(s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, secondNonce))
+	submitInferences(secondNonce.BlockHeight)
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + window)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+	reputerRequestNonce := &types.ReputerRequestNonce{ReputerNonce: secondNonce, WorkerNonce: firstNonce}
+	unfulfilled, err = s.emissionsKeeper.IsReputerNonceUnfulfilled(s.ctx, topicId, secondNonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	valueBundle := &types.ValueBundle{
+		TopicId:             topicId,
+		ReputerRequestNonce: reputerRequestNonce,
+		Reputer:             reputer.String(),
+		CombinedValue:       alloraMath.MustNewDecFromString("0.01"),
+		NaiveValue:          alloraMath.MustNewDecFromString("0.02"),
+		InfererValues: []*types.WorkerAttributedValue{
+			{Worker: workers[0].String(), Value: alloraMath.MustNewDecFromString("0.01")},
+			{Worker: workers[1].String(), Value: alloraMath.MustNewDecFromString("0.03")},
+		},
+		OneOutInfererValues: []*types.WithheldWorkerAttributedValue{
+			{Worker: workers[0].String(), Value: alloraMath.MustNewDecFromString("0.03")},
+			{Worker: workers[1].String(), Value: alloraMath.MustNewDecFromString("0.01")},
+		},
+	}
+	reputerSig, err := GenerateReputerSignature(s, valueBundle, reputer)
+	require.NoError(err)
+	resp, err := s.msgServer.InsertReputerPayload(s.ctx, &types.MsgInsertReputerPayload{
+		Sender: reputer.String(),
+		ReputerValueBundle: &types.ReputerValueBundle{
+			ValueBundle: valueBundle,
+			Signature:   reputerSig,
+			Pubkey:      GetAccPubKey(s, reputer),
+		},
+	})
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(resp.CloseBlock)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+	unfulfilled, err = s.emissionsKeeper.IsReputerNonceUnfulfilled(s.ctx, topicId, secondNonce)
+	require.NoError(err)
+	require.False(unfulfilled)
+	lossBundles, err := s.emissionsKeeper.GetReputerLossBundlesAtBlock(s.ctx, topicId, secondNonce.BlockHeight)
+	require.NoError(err)
+	require.Len(lossBundles.ReputerValueBundles, 1)
+	lastCommit, err := s.emissionsKeeper.GetTopicLastCommit(s.ctx, topicId, types.ActorType_REPUTER)
+	require.NoError(err)
+	require.Equal(types.ModuleName, lastCommit.Actor)
+}
+
+func (s *RewardsTestSuite) TestLeaderCannotQueueABundleInPlaceOfItsWorker() {
+	require := s.Require()
+	blockHeight := int64(600)
+	window := int64(3)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.PayloadSubmissionWindow = window
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	leader := s.addrs[0]
+	workers := []sdk.AccAddress{s.addrs[5], s.addrs[6]}
+	unregisteredWorker := s.addrs[7]
+
+	res, err := s.msgServer.CreateNewTopic(s.ctx, &types.MsgCreateNewTopic{
+		Creator:         leader.String(),
+		Metadata:        "test",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.NoError(err)
+	topicId := res.TopicId
+	for _, worker := range workers {
+		_, err = s.msgServer.Register(s.ctx, &types.MsgRegister{
+			Sender:       worker.String(),
+			LibP2PKey:    "test",
+			MultiAddress: "test",
+			TopicId:      topicId,
+			Owner:        worker.String(),
+		})
+		require.NoError(err)
+	}
+	nonce := &types.Nonce{BlockHeight: blockHeight}
+	require.NoError(s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, nonce))
+
+	// Bundle of the worker for the nonce, signed by the signer
+	signedBundle := func(worker sdk.AccAddress, signer sdk.AccAddress, blockHeight int64, value int64) *types.WorkerDataBundle {
+		bundle := &types.InferenceForecastBundle{
+			Inference: &types.Inference{
+				TopicId:     topicId,
+				BlockHeight: blockHeight,
+				Inferer:     worker.String(),
+				Value:       alloraMath.NewDecFromInt64(value),
+			},
+		}
+		sig, err := GenerateWorkerSignature(s, bundle, signer)
+		require.NoError(err)
+		return &types.WorkerDataBundle{
+			Worker:                             worker.String(),
+			InferenceForecastsBundle:           bundle,
+			InferencesForecastsBundleSignature: sig,
+			Pubkey:                             GetAccPubKey(s, worker),
+		}
+	}
+
+	bulkResp, err := s.msgServer.InsertBulkWorkerPayload(s.ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:  leader.String(),
+		Nonce:   nonce,
+		TopicId: topicId,
+		WorkerDataBundles: []*types.WorkerDataBundle{
+			signedBundle(workers[0], leader, nonce.BlockHeight, 100),
+			signedBundle(workers[0], workers[0], nonce.BlockHeight-1, 100),
+			signedBundle(unregisteredWorker, unregisteredWorker, nonce.BlockHeight, 100),
+			signedBundle(workers[1], workers[1], nonce.BlockHeight, 100),
+			signedBundle(workers[1], workers[1], nonce.BlockHeight, 101),
+		},
+	})
+	require.NoError(err)
+	require.Equal(blockHeight+window, bulkResp.CloseBlock)
+	require.Equal([]*types.SubmissionOutcome{
+		{BundleIndex: 0, Actor: workers[0].String(), Code: types.SubmissionOutcomeCode_SUBMISSION_INVALID},
+		{BundleIndex: 1, Actor: workers[0].String(), Code: types.SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH},
+		{BundleIndex: 2, Actor: unregisteredWorker.String(), Code: types.SubmissionOutcomeCode_SUBMISSION_UNREGISTERED},
+		{BundleIndex: 4, Actor: workers[1].String(), Code: types.SubmissionOutcomeCode_SUBMISSION_DUPLICATE},
+	}, bulkResp.InferenceOutcomes)
+	require.Empty(bulkResp.ForecastOutcomes)
+
+	// Both workers submit their own bundles, the second one in place of the bundle the leader queued for it
+	for _, worker := range workers {
+		_, err = s.msgServer.InsertWorkerPayload(s.ctx, &types.MsgInsertWorkerPayload{
+			Sender:           worker.String(),
+			WorkerDataBundle: signedBundle(worker, worker, nonce.BlockHeight, 200),
+		})
+		require.NoError(err)
+	}
+
+	s.ctx = s.ctx.WithBlockHeight(bulkResp.CloseBlock)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(s.ctx, topicId, nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 2)
+	for _, inference := range inferences.Inferences {
+		require.Equal(alloraMath.NewDecFromInt64(200), inference.Value, inference.Inferer)
+	}
+}
@@ -0,0 +1,199 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Runs an ad-hoc inference request answered by a single inferer up to the block before its rewards
+// are paid, which is ten blocks later. Returns the topic, the nonce of the request and the inferer.
+func (s *RewardsTestSuite) setUpSingleInfererRewards() (uint64, int64, sdk.AccAddress) {
+	require := s.Require()
+	blockHeight := int64(600)
+	groundTruthLag := int64(10)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight)
+
+	reputer := s.addrs[0]
+	requester := s.addrs[1]
+	worker := s.addrs[5]
+
+	res, err := s.msgServer.CreateNewTopic(s.ctx, &types.MsgCreateNewTopic{
+		Creator:         reputer.String(),
+		Metadata:        "test",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  groundTruthLag,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.NoError(err)
+	topicId := res.TopicId
+
+	_, err = s.msgServer.Register(s.ctx, &types.MsgRegister{
+		Sender:       worker.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		Owner:        worker.String(),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.Register(s.ctx, &types.MsgRegister{
+		Sender:       reputer.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		IsReputer:    true,
+		Owner:        reputer.String(),
+	})
+	require.NoError(err)
+	stake := cosmosMath.NewInt(1176644).Mul(inference_synthesis.CosmosIntOneE18())
+	s.MintTokensToAddress(reputer, stake)
+	_, err = s.msgServer.AddStake(s.ctx, &types.MsgAddStake{
+		Sender:  reputer.String(),
+		Amount:  stake,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+
+	bounty := cosmosMath.NewInt(1_000_000)
+	s.MintTokensToAddress(requester, bounty)
+	requestResp, err := s.msgServer.RequestInference(s.ctx, &types.MsgRequestInference{
+		Sender:   requester.String(),
+		TopicId:  topicId,
+		Argument: "BTC",
+		Deadline: blockHeight + groundTruthLag + 10,
+		Bounty:   bounty,
+	})
+	require.NoError(err)
+	nonce := requestResp.Nonce
+
+	inferenceBundle := &types.InferenceForecastBundle{
+		Inference: &types.Inference{
+			TopicId:     topicId,
+			BlockHeight: nonce.BlockHeight,
+			Inferer:     worker.String(),
+			Value:       alloraMath.MustNewDecFromString("0.01127"),
+		},
+	}
+	workerSig, err := GenerateWorkerSignature(s, inferenceBundle, worker)
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:  worker.String(),
+		Nonce:   nonce,
+		TopicId: topicId,
+		WorkerDataBundles: []*types.WorkerDataBundle{{
+			Worker:                             worker.String(),
+			InferenceForecastsBundle:           inferenceBundle,
+			InferencesForecastsBundleSignature: workerSig,
+			Pubkey:                             GetAccPubKey(s, worker),
+		}},
+	})
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + groundTruthLag)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+
+	reputerRequestNonce := &types.ReputerRequestNonce{ReputerNonce: nonce, WorkerNonce: nonce}
+	valueBundle := &types.ValueBundle{
+		TopicId:             topicId,
+		ReputerRequestNonce: reputerRequestNonce,
+		Reputer:             reputer.String(),
+		CombinedValue:       alloraMath.MustNewDecFromString("0.01127"),
+		NaiveValue:          alloraMath.MustNewDecFromString("0.0116"),
+		InfererValues:       []*types.WorkerAttributedValue{{Worker: worker.String(), Value: alloraMath.MustNewDecFromString("0.0112")}},
+	}
+	reputerSig, err := GenerateReputerSignature(s, valueBundle, reputer)
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkReputerPayload(s.ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:              reputer.String(),
+		TopicId:             topicId,
+		ReputerRequestNonce: reputerRequestNonce,
+		ReputerValueBundles: []*types.ReputerValueBundle{{
+			Pubkey:      GetAccPubKey(s, reputer),
+			Signature:   reputerSig,
+			ValueBundle: valueBundle,
+		}},
+	})
+	require.NoError(err)
+
+	return topicId, nonce.BlockHeight, worker
+}
+
+func (s *RewardsTestSuite) TestWorkerRewardsArePaidToPayoutAddress() {
+	require := s.Require()
+	topicId, _, worker := s.setUpSingleInfererRewards()
+	treasury := s.addrs[2]
+
+	_, err := s.msgServer.SetPayoutAddress(s.ctx, &types.MsgSetPayoutAddress{
+		Sender:        worker.String(),
+		TopicId:       topicId,
+		PayoutAddress: treasury.String(),
+	})
+	require.NoError(err)
+
+	workerBalanceBefore := s.bankKeeper.GetBalance(s.ctx, worker, params.DefaultBondDenom).Amount
+	treasuryBalanceBefore := s.bankKeeper.GetBalance(s.ctx, treasury, params.DefaultBondDenom).Amount
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+
+	workerBalanceAfter := s.bankKeeper.GetBalance(s.ctx, worker, params.DefaultBondDenom).Amount
+	treasuryBalanceAfter := s.bankKeeper.GetBalance(s.ctx, treasury, params.DefaultBondDenom).Amount
+	require.Equal(workerBalanceBefore, workerBalanceAfter, "the inferer is not paid directly")
+	require.True(treasuryBalanceAfter.GT(treasuryBalanceBefore), "the inferer reward goes to its payout address")
+}
+
+func (s *RewardsTestSuite) TestWorkerRewardsFallBackToWorkerWhenPayoutAddressRefusesThem() {
+	require := s.Require()
+	topicId, _, worker := s.setUpSingleInfererRewards()
+
+	// Stored directly, as if the fee collector had been blocked after the payout address was set
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	err := s.emissionsKeeper.SetTopicPayoutAddress(s.ctx, topicId, worker.String(), feeCollector.String())
+	require.NoError(err)
+
+	workerBalanceBefore := s.bankKeeper.GetBalance(s.ctx, worker, params.DefaultBondDenom).Amount
+	feeCollectorBalanceBefore := s.bankKeeper.GetBalance(s.ctx, feeCollector, params.DefaultBondDenom).Amount
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10)
+	require.NoError(s.emissionsAppModule.EndBlock(s.ctx))
+
+	workerBalanceAfter := s.bankKeeper.GetBalance(s.ctx, worker, params.DefaultBondDenom).Amount
+	feeCollectorBalanceAfter := s.bankKeeper.GetBalance(s.ctx, feeCollector, params.DefaultBondDenom).Amount
+	require.Equal(feeCollectorBalanceBefore, feeCollectorBalanceAfter, "the blocked payout address is not paid")
+	require.True(workerBalanceAfter.GT(workerBalanceBefore), "the inferer reward goes to the inferer instead")
+}
+
+func (s *RewardsTestSuite) TestRecordsArePrunedAfterRewardsThatCouldNotBePaid() {
+	require := s.Require()
+	topicId, rewardNonce, _ := s.setUpSingleInfererRewards()
+
+	// Nothing has been minted to the rewards account, so none of the participants can be paid
+	err := s.emissionsKeeper.AddPendingTopicReward(s.ctx, types.PendingTopicReward{
+		TopicId:     topicId,
+		Reward:      alloraMath.NewDecFromInt64(1_000_000),
+		RewardNonce: rewardNonce,
+	})
+	require.NoError(err)
+	err = rewards.EmitRewards(s.ctx, s.emissionsKeeper, s.ctx.BlockHeight(), map[uint64]*alloraMath.Dec{}, alloraMath.ZeroDec(), cosmosMath.ZeroInt())
+	require.NoError(err)
+
+	count, err := s.emissionsKeeper.CountPendingTopicRewards(s.ctx)
+	require.NoError(err)
+	require.Zero(count)
+	prunedTopicId, _, found, err := s.emissionsKeeper.GetNextPendingRecordPrune(s.ctx)
+	require.NoError(err)
+	require.True(found, "the records are queued for pruning all the same")
+	require.Equal(topicId, prunedTopicId)
+}
@@ -10,9 +10,13 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Queues the rewards of this block's churnable topics, then pays out queued topic rewards
+// for as long as the gas meter of the context is within the reward gas budget.
+// Topic rewards left over are the first to be paid out next block.
 func EmitRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
@@ -26,16 +30,55 @@ func EmitRewards(
 	if err != nil {
 		return errors.Wrapf(err, "failed to get total reward to distribute")
 	}
-	if totalReward.IsZero() {
-		Logger(ctx).Warn("The total scheduled rewards to distribute this epoch are zero!")
-		return nil
-	}
 
 	moduleParams, err := k.GetParams(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get module params")
 	}
 
+	err = queueTopicRewards(ctx, k, weights, totalReward, moduleParams)
+	if err != nil {
+		return err
+	}
+
+	totalRewardToStakedReputers, paidTopics, err := payPendingTopicRewards(ctx, k, moduleParams)
+	if err != nil {
+		return err
+	}
+	Logger(ctx).Debug(
+		fmt.Sprintf("Paid out %s to staked reputers over %d topics",
+			totalRewardToStakedReputers.String(),
+			paidTopics))
+	if !totalReward.IsZero() && uint64(blockHeight)%moduleParams.BlocksPerMonth == 0 {
+		// set the previous percentage reward to staked reputers
+		// for the mint module to be able to control the inflation rate to that actor
+		percentageToStakedReputers, err := totalRewardToStakedReputers.Quo(totalReward)
+		if err != nil {
+			return errors.Wrapf(err, "failed to calculate percentage to staked reputers")
+		}
+		err = k.SetPreviousPercentageRewardToStakedReputers(ctx, percentageToStakedReputers)
+		if err != nil {
+			return errors.Wrapf(err, "failed to set previous percentage reward to staked reputers")
+		}
+	}
+
+	return nil
+}
+
+// Splits the total reward between the top `N=MaxTopicsPerBlock` rewardable topics by weight,
+// and queues the rewards of those that have a reward nonce in the same order
+func queueTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	weights map[uint64]*alloraMath.Dec,
+	totalReward alloraMath.Dec,
+	moduleParams types.Params,
+) error {
+	if totalReward.IsZero() {
+		Logger(ctx).Warn("The total scheduled rewards to distribute this epoch are zero!")
+		return nil
+	}
+
 	rewardableTopics, err := k.GetRewardableTopics(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get rewardable topics")
@@ -69,8 +112,6 @@ func EmitRewards(
 		return errors.Wrapf(err, "failed to calculate topic rewards")
 	}
 
-	// Calculate then pay out topic rewards to topic participants
-	totalRewardToStakedReputers := alloraMath.ZeroDec() // This is used to communicate with the mint module
 	for _, topicId := range sortedChurnableTopics {
 		topicReward := topicRewards[topicId]
 		if topicReward == nil {
@@ -84,90 +125,123 @@ func EmitRewards(
 			continue
 		}
 
-		// Distribute rewards between topic participants
-		totalRewardsDistribution, rewardInTopicToReputers, err := GenerateRewardsDistributionByTopicParticipant(ctx, k, topicId, topicReward, topicRewardNonce, moduleParams)
+		err = k.AddPendingTopicReward(ctx, types.PendingTopicReward{
+			TopicId:     topicId,
+			Reward:      *topicReward,
+			RewardNonce: topicRewardNonce,
+		})
 		if err != nil {
-			topicRewardString := "nil"
-			Logger(ctx).Warn(
-				fmt.Sprintf(
-					"Failed to Generate Rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-					topicId,
-					topicRewardString,
-					err.Error(),
-				),
-			)
-			continue
+			return errors.Wrapf(err, "failed to queue reward of topic %d", topicId)
 		}
-		totalRewardToStakedReputers, err = totalRewardToStakedReputers.Add(rewardInTopicToReputers)
+		// The queued reward holds on to the nonce, the topic is free to get a new one
+		err = k.DeleteTopicRewardNonce(ctx, topicId)
 		if err != nil {
-			return errors.Wrapf(
-				err,
-				"Error finding sum of rewards to Reputers:\n%s\n%s",
-				totalRewardToStakedReputers.String(),
-				rewardInTopicToReputers.String(),
-			)
+			return errors.Wrapf(err, "failed to delete topic reward nonce")
 		}
-
-		// Pay out rewards to topic participants
-		payoutErrors := payoutRewards(ctx, k, totalRewardsDistribution)
-		if len(payoutErrors) > 0 {
-			for _, err := range payoutErrors {
-				Logger(ctx).Warn(
-					fmt.Sprintf(
-						"Failed to pay out rewards to participant in Topic:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-						topicId,
-						topicReward.String(),
-						err.Error(),
-					),
-				)
-			}
-			continue
+		err = k.RemoveRewardableTopic(ctx, topicId)
+		if err != nil {
+			return errors.Wrapf(err, "failed to remove rewardable topic %d", topicId)
 		}
+	}
+	return nil
+}
 
-		// Prune records after rewards have been paid out
-		err = pruneRecordsAfterRewards(ctx, k, moduleParams.MinEpochLengthRecordLimit, topicId, topicRewardNonce)
+// Calculate then pay out queued topic rewards to topic participants, in the order they were queued
+func payPendingTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	moduleParams types.Params,
+) (alloraMath.Dec, int, error) {
+	totalRewardToStakedReputers := alloraMath.ZeroDec() // This is used to communicate with the mint module
+	processed := 0
+	// At least one topic reward is paid out every block, so the queue always drains eventually
+	for processed == 0 || ctx.GasMeter().GasConsumed() < moduleParams.MaxRewardGasPerBlock {
+		pendingRewards, err := k.GetPendingTopicRewards(ctx, 1)
 		if err != nil {
-			Logger(ctx).Warn(
-				fmt.Sprintf(
-					"Failed to prune records after rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-					topicId,
-					topicReward.String(),
-					err.Error(),
-				),
-			)
-			continue
+			return totalRewardToStakedReputers, processed, errors.Wrapf(err, "failed to get pending topic rewards")
+		}
+		if len(pendingRewards) == 0 {
+			break
+		}
+		pendingReward := pendingRewards[0]
+		processed++
+		err = k.RemovePendingTopicReward(ctx, pendingReward.Id)
+		if err != nil {
+			return totalRewardToStakedReputers, processed, errors.Wrapf(err, "failed to remove pending topic reward")
 		}
 
-		err = k.RemoveRewardableTopic(ctx, topicId)
+		rewardInTopicToReputers, err := payTopicReward(ctx, k, pendingReward, moduleParams)
 		if err != nil {
 			Logger(ctx).Warn(
 				fmt.Sprintf(
-					"Failed to remove rewardable topic:\nTopic Id %d\nError:\n%s\n\n",
-					topicId,
+					"Failed to pay out rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
+					pendingReward.TopicId,
+					pendingReward.Reward.String(),
 					err.Error(),
 				),
 			)
 			continue
 		}
-	}
-	Logger(ctx).Debug(
-		fmt.Sprintf("Paid out %s to staked reputers over %d topics",
-			totalRewardToStakedReputers.String(),
-			len(topicRewards)))
-	if !totalReward.IsZero() && uint64(blockHeight)%moduleParams.BlocksPerMonth == 0 {
-		// set the previous percentage reward to staked reputers
-		// for the mint module to be able to control the inflation rate to that actor
-		percentageToStakedReputers, err := totalRewardToStakedReputers.Quo(totalReward)
-		if err != nil {
-			return errors.Wrapf(err, "failed to calculate percentage to staked reputers")
-		}
-		err = k.SetPreviousPercentageRewardToStakedReputers(ctx, percentageToStakedReputers)
+		totalRewardToStakedReputers, err = totalRewardToStakedReputers.Add(rewardInTopicToReputers)
 		if err != nil {
-			return errors.Wrapf(err, "failed to set previous percentage reward to staked reputers")
+			return totalRewardToStakedReputers, processed, errors.Wrapf(
+				err,
+				"Error finding sum of rewards to Reputers:\n%s\n%s",
+				totalRewardToStakedReputers.String(),
+				rewardInTopicToReputers.String(),
+			)
 		}
 	}
 
-	return nil
+	backlog, err := k.CountPendingTopicRewards(ctx)
+	if err == nil {
+		telemetry.ModuleSetGauge(types.ModuleName, float32(backlog), "topic_rewards", "backlog")
+	}
+	return totalRewardToStakedReputers, processed, nil
+}
+
+// Pays out the reward of a topic, then queues the records it was computed from for pruning.
+// A participant that cannot be paid is logged and skipped, as the other participants have been
+// paid already and the records are no longer needed either way.
+func payTopicReward(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	pendingReward types.PendingTopicReward,
+	moduleParams types.Params,
+) (alloraMath.Dec, error) {
+	// Distribute rewards between topic participants
+	totalRewardsDistribution, rewardInTopicToReputers, err := GenerateRewardsDistributionByTopicParticipant(
+		ctx, k, pendingReward.TopicId, &pendingReward.Reward, pendingReward.RewardNonce)
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "failed to generate rewards")
+	}
+
+	// Pay out rewards to topic participants
+	_, payoutErrors := payoutRewards(ctx, k, types.AlloraRewardsAccountName, totalRewardsDistribution)
+	for _, err := range payoutErrors {
+		Logger(ctx).Warn(
+			fmt.Sprintf(
+				"Failed to pay out rewards to participant in Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
+				pendingReward.TopicId,
+				pendingReward.Reward.String(),
+				err.Error(),
+			),
+		)
+	}
+
+	// Prune records after rewards have been paid out
+	err = queueRecordsPruneAfterRewards(ctx, k, moduleParams.MinEpochLengthRecordLimit, pendingReward.TopicId, pendingReward.RewardNonce)
+	if err != nil {
+		Logger(ctx).Warn(
+			fmt.Sprintf(
+				"Failed to prune records after rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
+				pendingReward.TopicId,
+				pendingReward.Reward.String(),
+				err.Error(),
+			),
+		)
+	}
+	return rewardInTopicToReputers, nil
 }
 
 func CalcTopicRewards(
@@ -202,7 +276,6 @@ func GenerateRewardsDistributionByTopicParticipant(
 	topicId uint64,
 	topicReward *alloraMath.Dec,
 	blockHeight int64,
-	moduleParams types.Params,
 ) (
 	totalRewardsDistribution []types.TaskReward,
 	taskReputerReward alloraMath.Dec,
@@ -211,6 +284,10 @@ func GenerateRewardsDistributionByTopicParticipant(
 	if topicReward == nil {
 		return nil, alloraMath.Dec{}, types.ErrInvalidReward
 	}
+	topicParams, err := k.GetEffectiveTopicParams(ctx, topicId)
+	if err != nil {
+		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get effective params for topic %d", topicId)
+	}
 	bundles, err := k.GetReputerLossBundlesAtBlock(ctx, topicId, blockHeight)
 	if err != nil {
 		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
@@ -240,7 +317,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 
 	// Get reputer participants' addresses and reward fractions to be used in the reward round for topic
-	reputers, reputersRewardFractions, err := GetReputersRewardFractions(ctx, k, topicId, moduleParams.PRewardReputer, reputerScores)
+	reputers, reputersRewardFractions, err := GetReputersRewardFractions(ctx, k, topicId, topicParams.PRewardReputer, reputerScores)
 	if err != nil {
 		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer reward round data")
 	}
@@ -250,8 +327,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 		ctx,
 		k,
 		topicId,
-		moduleParams.TaskRewardAlpha,
-		moduleParams.BetaEntropy,
+		topicParams.TaskRewardAlpha,
+		topicParams.BetaEntropy,
 		reputers,
 		reputersRewardFractions,
 	)
@@ -265,8 +342,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 		k,
 		topicId,
 		blockHeight,
-		moduleParams.PRewardInference,
-		moduleParams.CRewardInference,
+		topicParams.PRewardInference,
+		topicParams.CRewardInference,
 		infererScores,
 	)
 	if err != nil {
@@ -278,8 +355,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 		ctx,
 		k,
 		topicId,
-		moduleParams.TaskRewardAlpha,
-		moduleParams.BetaEntropy,
+		topicParams.TaskRewardAlpha,
+		topicParams.BetaEntropy,
 		inferers,
 		inferersRewardFractions,
 	)
@@ -293,8 +370,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 		k,
 		topicId,
 		blockHeight,
-		moduleParams.PRewardForecast,
-		moduleParams.CRewardForecast,
+		topicParams.PRewardForecast,
+		topicParams.CRewardForecast,
 		forecasterScores,
 	)
 	if err != nil {
@@ -308,8 +385,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 			ctx,
 			k,
 			topicId,
-			moduleParams.TaskRewardAlpha,
-			moduleParams.BetaEntropy,
+			topicParams.TaskRewardAlpha,
+			topicParams.BetaEntropy,
 			forecasters,
 			forecastersRewardFractions,
 		)
@@ -406,14 +483,16 @@ func GenerateRewardsDistributionByTopicParticipant(
 }
 
 // pay out the rewards to the participants
-// this function moves tokens from the rewards module to the participants
+// this function moves tokens from the source module to the participants and returns the total paid out
 // if it fails to pay a particular participant, it will continue to the next participant
 func payoutRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
+	sourceModule string,
 	rewards []types.TaskReward,
-) []error {
+) (cosmosMath.Int, []error) {
 	ret := make([]error, 0) // errors to return from paying individual actors
+	paid := cosmosMath.ZeroInt()
 	reputerAndDelegatorRewards := make([]types.TaskReward, 0)
 	infererRewards := make([]types.TaskReward, 0)
 	forecasterRewards := make([]types.TaskReward, 0)
@@ -427,11 +506,12 @@ func payoutRewards(
 		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, rewardInt))
 
 		if reward.Type == types.ReputerAndDelegatorRewardType {
-			err := k.SendCoinsFromModuleToModule(ctx, types.AlloraRewardsAccountName, types.AlloraStakingAccountName, coins)
+			err := k.SendCoinsFromModuleToModule(ctx, sourceModule, types.AlloraStakingAccountName, coins)
 			if err != nil {
 				ret = append(ret, errors.Wrapf(
 					err,
-					"failed to send coins from rewards module to staking module: %s",
+					"failed to send coins from %s module to staking module: %s",
+					sourceModule,
 					coins.String(),
 				))
 				continue
@@ -442,28 +522,50 @@ func payoutRewards(
 				continue
 			}
 
+			paid = paid.Add(rewardInt)
+			types.IncrRewardsPaid(types.RewardTypeMetricLabel(reward.Type), rewardInt)
 			reputerAndDelegatorRewards = append(reputerAndDelegatorRewards, reward)
 		} else {
-			_, err := sdk.AccAddressFromBech32(reward.Address)
+			// Worker rewards go to the payout address the worker chose, if any
+			payoutAddress, err := k.GetPayoutAddress(ctx, reward.TopicId, reward.Address)
 			if err != nil {
-				ret = append(ret, errors.Wrapf(err, "failed to decode payout address: %s", reward.Address))
+				ret = append(ret, errors.Wrapf(err, "failed to get payout address of %s", reward.Address))
+				continue
+			}
+			_, err = sdk.AccAddressFromBech32(payoutAddress)
+			if err != nil {
+				ret = append(ret, errors.Wrapf(err, "failed to decode payout address: %s", payoutAddress))
 				continue
 			}
 			err = k.SendCoinsFromModuleToAccount(
 				ctx,
-				types.AlloraRewardsAccountName,
-				reward.Address,
+				sourceModule,
+				payoutAddress,
 				coins,
 			)
+			// A payout address that refuses the reward, e.g. one blocked after it was set, leaves it with the worker
+			if err != nil && payoutAddress != reward.Address {
+				ctx.Logger().Warn(fmt.Sprintf("failed to pay %s to payout address %s of %s, paying the worker instead: %s",
+					coins.String(), payoutAddress, reward.Address, err.Error()))
+				payoutAddress = reward.Address
+				err = k.SendCoinsFromModuleToAccount(
+					ctx,
+					sourceModule,
+					payoutAddress,
+					coins,
+				)
+			}
 			if err != nil {
 				ret = append(ret, errors.Wrapf(
 					err,
 					"failed to send coins from rewards module to payout address %s, %s",
-					types.AlloraRewardsAccountName,
-					reward.Address,
+					sourceModule,
+					payoutAddress,
 				))
 				continue
 			}
+			paid = paid.Add(rewardInt)
+			types.IncrRewardsPaid(types.RewardTypeMetricLabel(reward.Type), rewardInt)
 
 			if reward.Type == types.WorkerInferenceRewardType {
 				infererRewards = append(infererRewards, reward)
@@ -476,22 +578,17 @@ func payoutRewards(
 	types.EmitNewInfererRewardsSettledEvent(ctx, blockHeight, infererRewards)
 	types.EmitNewForecasterRewardsSettledEvent(ctx, blockHeight, forecasterRewards)
 	types.EmitNewReputerAndDelegatorRewardsSettledEvent(ctx, blockHeight, reputerAndDelegatorRewards)
-	return ret
+	return paid, ret
 }
 
-func pruneRecordsAfterRewards(
+// Queues the records of the topic behind its oldest unfulfilled nonce for pruning
+func queueRecordsPruneAfterRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	minEpochLengthRecordLimit int64,
 	topicId uint64,
 	topicRewardNonce int64,
 ) error {
-	// Delete topic reward nonce
-	err := k.DeleteTopicRewardNonce(ctx, topicId)
-	if err != nil {
-		return errors.Wrapf(err, "failed to delete topic reward nonce")
-	}
-
 	// Get oldest unfulfilled nonce - delete everything behind it
 	unfulfilledNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
 	if err != nil {
@@ -520,10 +617,10 @@ func pruneRecordsAfterRewards(
 	// unfulfilled nonces to be fulfilled
 	oldestNonce -= minEpochLengthRecordLimit * topic.EpochLength
 
-	// Prune old records after rewards have been paid out
-	err = k.PruneRecordsAfterRewards(ctx, topicId, oldestNonce)
+	// Old records are pruned once rewards have been paid out, within the pruning gas budget
+	err = k.AddPendingRecordPrune(ctx, topicId, oldestNonce)
 	if err != nil {
-		return errors.Wrapf(err, "failed to prune records after rewards")
+		return errors.Wrapf(err, "failed to queue records for pruning")
 	}
 
 	return nil
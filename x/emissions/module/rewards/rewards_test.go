@@ -31,6 +31,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/suite"
@@ -45,6 +47,7 @@ type RewardsTestSuite struct {
 	suite.Suite
 
 	ctx                sdk.Context
+	cms                storetypes.CommitMultiStore
 	accountKeeper      authkeeper.AccountKeeper
 	bankKeeper         bankkeeper.BaseKeeper
 	emissionsKeeper    keeper.Keeper
@@ -65,16 +68,18 @@ func (s *RewardsTestSuite) SetupTest() {
 	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
 
 	maccPerms := map[string][]string{
-		"fee_collector":                {"minter"},
-		"mint":                         {"minter"},
-		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
-		types.AlloraRewardsAccountName: {"minter"},
+		"fee_collector":                                  {"minter"},
+		"mint":                                           {"minter"},
+		types.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
+		types.AlloraRewardsAccountName:                   {"minter"},
+		types.AlloraRequestsAccountName:                  nil,
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
-		"ecosystem":              {"minter"},
-		"bonded_tokens_pool":     {"burner", "staking"},
-		"not_bonded_tokens_pool": {"burner", "staking"},
-		multiPerm:                {"burner", "minter", "staking"},
-		randomPerm:               {"random"},
+		"ecosystem":                                      {"minter"},
+		distrtypes.ModuleName:                            nil,
+		"bonded_tokens_pool":                             {"burner", "staking"},
+		"not_bonded_tokens_pool":                         {"burner", "staking"},
+		multiPerm:                                        {"burner", "minter", "staking"},
+		randomPerm:                                       {"random"},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -90,7 +95,7 @@ func (s *RewardsTestSuite) SetupTest() {
 		encCfg.Codec,
 		storeService,
 		accountKeeper,
-		map[string]bool{},
+		map[string]bool{authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(): true},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		log.NewNopLogger(),
 	)
@@ -110,6 +115,17 @@ func (s *RewardsTestSuite) SetupTest() {
 		codecAddress.NewBech32Codec(sdk.Bech32PrefixValAddr),
 		codecAddress.NewBech32Codec(sdk.Bech32PrefixConsAddr),
 	)
+	distrKeeper := distrkeeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	err := distrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool())
+	s.Require().NoError(err)
 	mintKeeper := mintkeeper.NewKeeper(
 		encCfg.Codec,
 		storeService,
@@ -117,10 +133,12 @@ func (s *RewardsTestSuite) SetupTest() {
 		accountKeeper,
 		bankKeeper,
 		emissionsKeeper,
+		distrKeeper,
 		authtypes.FeeCollectorName,
 	)
 
 	s.ctx = ctx
+	s.cms = testCtx.CMS
 	s.accountKeeper = accountKeeper
 	s.bankKeeper = bankKeeper
 	s.emissionsKeeper = emissionsKeeper
@@ -129,6 +147,13 @@ func (s *RewardsTestSuite) SetupTest() {
 	defaultEmissionsGenesis := emissionsAppModule.DefaultGenesis(encCfg.Codec)
 	emissionsAppModule.InitGenesis(ctx, encCfg.Codec, defaultEmissionsGenesis)
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
+	// Params change immediately and bulk payloads are ranked on arrival,
+	// tests of the params timelock and of the submission window set their own
+	emissionsParams, err := s.emissionsKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+	emissionsParams.MinParamChangeDelay = 0
+	emissionsParams.PayloadSubmissionWindow = 0
+	s.Require().NoError(s.emissionsKeeper.SetParams(ctx, emissionsParams))
 	s.emissionsAppModule = emissionsAppModule
 	mintAppModule := mint.NewAppModule(encCfg.Codec, mintKeeper, accountKeeper)
 	defaultMintGenesis := mintAppModule.DefaultGenesis(encCfg.Codec)
@@ -703,10 +728,7 @@ func (s *RewardsTestSuite) getRewardsDistribution(
 ) []types.TaskReward {
 	require := s.Require()
 
-	params, err := s.emissionsKeeper.GetParams(s.ctx)
-	require.NoError(err)
-
-	err = s.emissionsKeeper.AddWorkerNonce(
+	err := s.emissionsKeeper.AddWorkerNonce(
 		s.ctx,
 		topicId,
 		&types.Nonce{BlockHeight: blockHeight},
@@ -773,7 +795,6 @@ func (s *RewardsTestSuite) getRewardsDistribution(
 		topicId,
 		&topicTotalRewards,
 		blockHeight,
-		params,
 	)
 	require.NoError(err)
 
@@ -1361,10 +1382,8 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	s.Require().NoError(err)
 
 	topicTotalRewards := alloraMath.NewDecFromInt64(1000000)
-	params, err := s.emissionsKeeper.GetParams(s.ctx)
-	s.Require().NoError(err)
 
-	firstRewardsDistribution, firstTotalReputerReward, err := rewards.GenerateRewardsDistributionByTopicParticipant(s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block, params)
+	firstRewardsDistribution, firstTotalReputerReward, err := rewards.GenerateRewardsDistributionByTopicParticipant(s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block)
 	s.Require().NoError(err)
 
 	calcFirstTotalReputerReward := alloraMath.ZeroDec()
@@ -1508,7 +1527,7 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	})
 	s.Require().NoError(err)
 
-	secondRewardsDistribution, secondTotalReputerReward, err := rewards.GenerateRewardsDistributionByTopicParticipant(s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block, params)
+	secondRewardsDistribution, secondTotalReputerReward, err := rewards.GenerateRewardsDistributionByTopicParticipant(s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block)
 	s.Require().NoError(err)
 
 	calcSecondTotalReputerReward := alloraMath.ZeroDec()
@@ -2131,6 +2150,27 @@ func (s *RewardsTestSuite) SetParamsForTest(numInferers uint64) {
 }
 
 func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
+	infererScores, forecasterScores := s.generateScoresOfManyActors(nil)
+
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(len(infererScores), int(params.GetMaxTopInferersToReward()), "Only few Top inferers can get reward")
+	s.Require().Equal(len(forecasterScores), int(params.GetMaxTopForecastersToReward()), "Only few Top forecasters can get reward")
+}
+
+func (s *RewardsTestSuite) TestTopicOverrideOfTopActorsToReward() {
+	// the global params reward the top 24 of the 25 inferers and the top 6 forecasters
+	infererScores, forecasterScores := s.generateScoresOfManyActors(&types.TopicParamsOverrides{
+		MaxTopInferersToReward:    []uint64{30},
+		MaxTopForecastersToReward: []uint64{3},
+	})
+
+	s.Require().Len(infererScores, 25, "the topic override applies instead of the global param")
+	s.Require().Len(forecasterScores, 3, "the topic override applies instead of the global param")
+}
+
+// Runs an epoch of 25 workers and 25 reputers on a new topic and returns the resulting scores
+func (s *RewardsTestSuite) generateScoresOfManyActors(paramsOverrides *types.TopicParamsOverrides) (infererScores, forecasterScores []types.Score) {
 	block := int64(600)
 	s.ctx = s.ctx.WithBlockHeight(block)
 	epochLength := int64(10800)
@@ -2162,6 +2202,7 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 		AlphaRegret:     alloraMath.NewDecFromInt64(1),
 		PNorm:           alloraMath.NewDecFromInt64(3),
 		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		ParamsOverrides: paramsOverrides,
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -2257,16 +2298,13 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 	})
 	s.Require().NoError(err)
 
-	params, err := s.emissionsKeeper.GetParams(s.ctx)
-	s.Require().NoError(err)
-
 	//scoresAtBlock, err := s.emissionsKeeper.GetReputersScoresAtBlock(s.ctx, topicId, block)
 	//s.Require().Equal(len(scoresAtBlock.Scores), int(params.GetMaxTopReputersToReward()), "Only few Top reputers can get reward")
 
 	networkLossBundles, err := s.emissionsKeeper.GetNetworkLossBundleAtBlock(s.ctx, topicId, block)
 	s.Require().NoError(err)
 
-	infererScores, err := rewards.GenerateInferenceScores(
+	infererScores, err = rewards.GenerateInferenceScores(
 		s.ctx,
 		s.emissionsKeeper,
 		topicId,
@@ -2274,7 +2312,7 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 		*networkLossBundles)
 	s.Require().NoError(err)
 
-	forecasterScores, err := rewards.GenerateForecastScores(
+	forecasterScores, err = rewards.GenerateForecastScores(
 		s.ctx,
 		s.emissionsKeeper,
 		topicId,
@@ -2282,8 +2320,7 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 		*networkLossBundles)
 	s.Require().NoError(err)
 
-	s.Require().Equal(len(infererScores), int(params.GetMaxTopInferersToReward()), "Only few Top inferers can get reward")
-	s.Require().Equal(len(forecasterScores), int(params.GetMaxTopForecastersToReward()), "Only few Top forecasters can get reward")
+	return infererScores, forecasterScores
 }
 
 func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers() {
@@ -2419,11 +2456,9 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	s.Require().NoError(err)
 
 	topicTotalRewards := alloraMath.NewDecFromInt64(1000000)
-	params, err := s.emissionsKeeper.GetParams(s.ctx)
-	s.Require().NoError(err)
 
 	firstRewardsDistribution, _, err := rewards.GenerateRewardsDistributionByTopicParticipant(
-		s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block, params)
+		s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block)
 	s.Require().NoError(err)
 
 	totalInferersReward := alloraMath.ZeroDec()
@@ -2569,7 +2604,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 
 	topicTotalRewards = alloraMath.NewDecFromInt64(1000000)
 	secondRewardsDistribution, _, err := rewards.GenerateRewardsDistributionByTopicParticipant(
-		s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block, params)
+		s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block)
 	s.Require().NoError(err)
 
 	totalInferersReward = alloraMath.ZeroDec()
@@ -2707,7 +2742,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 
 	topicTotalRewards = alloraMath.NewDecFromInt64(1000000)
 	thirdRewardsDistribution, _, err := rewards.GenerateRewardsDistributionByTopicParticipant(
-		s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block, params)
+		s.ctx, s.emissionsKeeper, topicId, &topicTotalRewards, block)
 	s.Require().NoError(err)
 
 	totalForecastersReward = alloraMath.ZeroDec()
@@ -3271,3 +3306,34 @@ func (s *RewardsTestSuite) TestRewardForRemainingParticipantsGoUpWhenParticipant
 	require.True(reputer2_Reward0.GT(cosmosMath.ZeroInt()))
 	require.True(reputer2_Reward1.Equal(cosmosMath.ZeroInt()))
 }
+
+func (s *RewardsTestSuite) TestTopicRewardsCarryOverWhenOverGasBudget() {
+	require := s.Require()
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.MaxRewardGasPerBlock = 1
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	for _, topicId := range []uint64{7, 8, 9} {
+		err = s.emissionsKeeper.AddPendingTopicReward(s.ctx, types.PendingTopicReward{
+			TopicId:     topicId,
+			Reward:      alloraMath.NewDecFromInt64(100),
+			RewardNonce: 10,
+		})
+		require.NoError(err)
+	}
+
+	// one queued topic reward is paid out per block, in the order they were queued
+	for _, expected := range [][]uint64{{8, 9}, {9}, {}} {
+		ctx := s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		err = rewards.EmitRewards(ctx, s.emissionsKeeper, s.ctx.BlockHeight(), map[uint64]*alloraMath.Dec{}, alloraMath.ZeroDec(), cosmosMath.ZeroInt())
+		require.NoError(err)
+		pending, err := s.emissionsKeeper.GetPendingTopicRewards(s.ctx, 10)
+		require.NoError(err)
+		topicIds := make([]uint64, 0)
+		for _, reward := range pending {
+			topicIds = append(topicIds, reward.TopicId)
+		}
+		require.Equal(expected, topicIds)
+	}
+}
@@ -69,15 +69,20 @@ func GenerateReputerScores(
 		return []types.Score{}, errors.Wrapf(err, "Error getting topic %v", topicId)
 	}
 
+	topicParams, err := keeper.GetEffectiveTopicParams(ctx, topicId)
+	if err != nil {
+		return []types.Score{}, errors.Wrapf(err, "Error getting params of topic %v", topicId)
+	}
+
 	// Get reputer output
 	scores, newCoefficients, err := GetAllReputersOutput(
 		losses,
 		reputerStakes,
 		reputerListeningCoefficients,
 		int64(len(reputerStakes)),
-		params.LearningRate,
+		topicParams.LearningRate,
 		params.GradientDescentMaxIters,
-		params.EpsilonReputer,
+		topicParams.EpsilonReputer,
 		topic.Epsilon,
 		params.MinStakeFraction,
 		params.MaxGradientThreshold,
@@ -695,8 +695,7 @@ func GenerateHugeWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topic
 	return inferences
 }
 func GenerateReputerSignature(s *RewardsTestSuite, valueBundle *types.ValueBundle, account sdk.AccAddress) ([]byte, error) {
-	protoBytesIn := make([]byte, 0)
-	protoBytesIn, err := valueBundle.XXX_Marshal(protoBytesIn, true)
+	protoBytesIn, err := valueBundle.SignBytes(s.ctx.ChainID())
 	if err != nil {
 		return nil, err
 	}
@@ -711,8 +710,7 @@ func GenerateReputerSignature(s *RewardsTestSuite, valueBundle *types.ValueBundl
 }
 
 func GenerateWorkerSignature(s *RewardsTestSuite, valueBundle *types.InferenceForecastBundle, account sdk.AccAddress) ([]byte, error) {
-	protoBytesIn := make([]byte, 0)
-	protoBytesIn, err := valueBundle.XXX_Marshal(protoBytesIn, true)
+	protoBytesIn, err := valueBundle.SignBytes(s.ctx.ChainID())
 	if err != nil {
 		return nil, err
 	}
@@ -179,6 +179,7 @@ func GetAndUpdateActiveTopicWeights(
 		if err != nil {
 			return errors.Wrapf(err, "failed to set previous topic weight")
 		}
+		types.SetTopicWeightGauge(topic.Id, weight)
 
 		// This revenue will be paid to top active topics of this block (the churnable topics).
 		// This happens regardless of this topic's fate (inactivation or not)
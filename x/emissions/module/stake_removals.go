@@ -9,110 +9,164 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Remove all stakes this block that have been marked for removal
+// Remove the stakes marked for removal this block or before, within the gas budget
+// of the work context. Removals left over are the first to be processed next block.
 func RemoveStakes(
-	sdkCtx sdk.Context,
+	ctx sdk.Context,
 	currentBlock int64,
 	k emissionskeeper.Keeper,
+	gasBudget uint64,
 ) {
-	removals, err := k.GetStakeRemovalsForBlock(sdkCtx, currentBlock)
-	if err != nil {
-		sdkCtx.Logger().Error(fmt.Sprintf(
-			"Unable to get stake removals for block %d, skipping removing stakes: %v",
-			currentBlock,
-			err,
-		))
-		return
-	}
-	for _, stakeRemoval := range removals {
-		// do no checking that the stake removal struct is valid. In order to have a stake removal
-		// it would have had to be created in msgServer.RemoveStake which would have done
-		// validation of validity up front before scheduling the delay
-
-		// Check the module has enough funds to send back to the sender
-		// Bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
-		// Send the funds
-		coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, stakeRemoval.Amount))
-		err = k.SendCoinsFromModuleToAccount(sdkCtx, emissionstypes.AlloraStakingAccountName, stakeRemoval.Reputer, coins)
+	processed := 0
+	for !isWorkBudgetSpent(ctx, gasBudget, processed) {
+		removals, err := k.GetStakeRemovalsUpUntilBlock(ctx, currentBlock, workQueueBatchSize)
 		if err != nil {
-			sdkCtx.Logger().Error(fmt.Sprintf(
-				"Error removing stake: %v | %v",
-				stakeRemoval,
+			ctx.Logger().Error(fmt.Sprintf(
+				"Unable to get stake removals up until block %d, skipping removing stakes: %v",
+				currentBlock,
 				err,
 			))
-			continue
+			return
 		}
-
-		// Update the stake data structures
-		err = k.RemoveReputerStake(
-			sdkCtx,
-			currentBlock,
-			stakeRemoval.TopicId,
-			stakeRemoval.Reputer,
-			stakeRemoval.Amount,
-		)
-		if err != nil {
-			sdkCtx.Logger().Error(fmt.Sprintf(
+		if len(removals) == 0 {
+			break
+		}
+		for _, stakeRemoval := range removals {
+			if isWorkBudgetSpent(ctx, gasBudget, processed) {
+				break
+			}
+			processed++
+			// the removal is all or nothing, so a failed removal cannot pay out twice
+			cacheCtx, write := ctx.CacheContext()
+			err = removeStake(cacheCtx, k, stakeRemoval)
+			if err == nil {
+				write()
+				continue
+			}
+			ctx.Logger().Error(fmt.Sprintf(
 				"Error removing stake: %v | %v",
 				stakeRemoval,
 				err,
 			))
-			continue
+			// drop the removal so it is not retried every block, the reputer can ask for it again
+			err = k.DeleteStakeRemoval(ctx, stakeRemoval.BlockRemovalCompleted, stakeRemoval.TopicId, stakeRemoval.Reputer)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("Error dropping stake removal: %v | %v", stakeRemoval, err))
+				return
+			}
 		}
 	}
+
+	backlog, err := k.CountStakeRemovalsUpUntilBlock(ctx, currentBlock)
+	if err == nil {
+		setBacklogGauge(backlog, "stake_removals")
+	}
 }
 
-// remove all delegated stakes that have been marked for removal this block
+func removeStake(ctx sdk.Context, k emissionskeeper.Keeper, stakeRemoval emissionstypes.StakeRemovalInfo) error {
+	// do no checking that the stake removal struct is valid. In order to have a stake removal
+	// it would have had to be created in msgServer.RemoveStake which would have done
+	// validation of validity up front before scheduling the delay
+
+	// Check the module has enough funds to send back to the sender
+	// Bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
+	// Send the funds
+	coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, stakeRemoval.Amount))
+	err := k.SendCoinsFromModuleToAccount(ctx, emissionstypes.AlloraStakingAccountName, stakeRemoval.Reputer, coins)
+	if err != nil {
+		return err
+	}
+
+	// Update the stake data structures
+	return k.RemoveReputerStake(
+		ctx,
+		stakeRemoval.BlockRemovalCompleted,
+		stakeRemoval.TopicId,
+		stakeRemoval.Reputer,
+		stakeRemoval.Amount,
+	)
+}
+
+// remove the delegated stakes marked for removal this block or before, within what is
+// left of the gas budget of the work context
 func RemoveDelegateStakes(
-	sdkCtx sdk.Context,
+	ctx sdk.Context,
 	currentBlock int64,
 	k emissionskeeper.Keeper,
+	gasBudget uint64,
 ) {
-	removals, err := k.GetDelegateStakeRemovalsForBlock(sdkCtx, currentBlock)
-	if err != nil {
-		sdkCtx.Logger().Error(
-			fmt.Sprintf(
-				"Unable to get stake removals for block %d, skipping removing stakes: %v",
-				currentBlock,
-				err,
-			))
-		return
-	}
-	for _, stakeRemoval := range removals {
-		// do no checking that the stake removal struct is valid. In order to have a stake removal
-		// it would have had to be created in msgServer.RemoveDelegateStake which would have done
-		// validation of validity up front before scheduling the delay
-
-		// Check the module has enough funds to send back to the sender
-		// Bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
-		// Send the funds
-		coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, stakeRemoval.Amount))
-		err = k.SendCoinsFromModuleToAccount(sdkCtx, emissionstypes.AlloraStakingAccountName, stakeRemoval.Delegator, coins)
+	processed := 0
+	for !isWorkBudgetSpent(ctx, gasBudget, processed) {
+		removals, err := k.GetDelegateStakeRemovalsUpUntilBlock(ctx, currentBlock, workQueueBatchSize)
 		if err != nil {
-			sdkCtx.Logger().Error(fmt.Sprintf(
-				"Error removing stake: %v | %v",
-				stakeRemoval,
-				err,
-			))
-			continue
+			ctx.Logger().Error(
+				fmt.Sprintf(
+					"Unable to get stake removals up until block %d, skipping removing stakes: %v",
+					currentBlock,
+					err,
+				))
+			return
 		}
-
-		// Update the stake data structures
-		err = k.RemoveDelegateStake(
-			sdkCtx,
-			currentBlock,
-			stakeRemoval.TopicId,
-			stakeRemoval.Delegator,
-			stakeRemoval.Reputer,
-			stakeRemoval.Amount,
-		)
-		if err != nil {
-			sdkCtx.Logger().Error(fmt.Sprintf(
+		if len(removals) == 0 {
+			break
+		}
+		for _, stakeRemoval := range removals {
+			if isWorkBudgetSpent(ctx, gasBudget, processed) {
+				break
+			}
+			processed++
+			cacheCtx, write := ctx.CacheContext()
+			err = removeDelegateStake(cacheCtx, k, stakeRemoval)
+			if err == nil {
+				write()
+				continue
+			}
+			ctx.Logger().Error(fmt.Sprintf(
 				"Error removing stake: %v | %v",
 				stakeRemoval,
 				err,
 			))
-			continue
+			err = k.DeleteDelegateStakeRemoval(
+				ctx,
+				stakeRemoval.BlockRemovalCompleted,
+				stakeRemoval.TopicId,
+				stakeRemoval.Reputer,
+				stakeRemoval.Delegator,
+			)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("Error dropping stake removal: %v | %v", stakeRemoval, err))
+				return
+			}
 		}
 	}
+
+	backlog, err := k.CountDelegateStakeRemovalsUpUntilBlock(ctx, currentBlock)
+	if err == nil {
+		setBacklogGauge(backlog, "delegate_stake_removals")
+	}
+}
+
+func removeDelegateStake(ctx sdk.Context, k emissionskeeper.Keeper, stakeRemoval emissionstypes.DelegateStakeRemovalInfo) error {
+	// do no checking that the stake removal struct is valid. In order to have a stake removal
+	// it would have had to be created in msgServer.RemoveDelegateStake which would have done
+	// validation of validity up front before scheduling the delay
+
+	// Check the module has enough funds to send back to the sender
+	// Bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
+	// Send the funds
+	coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, stakeRemoval.Amount))
+	err := k.SendCoinsFromModuleToAccount(ctx, emissionstypes.AlloraStakingAccountName, stakeRemoval.Delegator, coins)
+	if err != nil {
+		return err
+	}
+
+	// Update the stake data structures
+	return k.RemoveDelegateStake(
+		ctx,
+		stakeRemoval.BlockRemovalCompleted,
+		stakeRemoval.TopicId,
+		stakeRemoval.Delegator,
+		stakeRemoval.Reputer,
+		stakeRemoval.Amount,
+	)
 }
@@ -0,0 +1,103 @@
+package module
+
+import (
+	"fmt"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pay the subscriptions due this block into the fee revenue of their topics,
+// before the topic weights are computed from that revenue
+func ChargeSubscriptions(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	subscriptions, err := k.GetSubscriptionsForPaymentBlock(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get subscriptions due at block %d: %v", currentBlock, err))
+		return
+	}
+	for _, subscription := range subscriptions {
+		// a failed payment must not leave the escrow and the topic revenue out of sync
+		cacheCtx, write := sdkCtx.CacheContext()
+		err = chargeSubscription(cacheCtx, currentBlock, k, subscription)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error charging subscription %d, lapsing it: %v", subscription.Id, err))
+			// Left as is, the subscription would never be due again, so it lapses and its escrow is refunded
+			cacheCtx, write = sdkCtx.CacheContext()
+			err = lapseSubscription(cacheCtx, currentBlock, k, subscription)
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error lapsing subscription %d: %v", subscription.Id, err))
+				continue
+			}
+		}
+		write()
+	}
+}
+
+// Drops a subscription that cannot be charged and refunds what is left of its escrow to the consumer
+func lapseSubscription(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	subscription emissionstypes.Subscription,
+) error {
+	if subscription.Escrow.IsPositive() {
+		err := k.SendCoinsFromModuleToAccount(
+			sdkCtx,
+			emissionstypes.AlloraRequestsAccountName,
+			subscription.Consumer,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, subscription.Escrow)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if err := k.RemoveSubscription(sdkCtx, subscription.Id); err != nil {
+		return err
+	}
+	emissionstypes.EmitNewSubscriptionLapsedEvent(sdkCtx, subscription, currentBlock)
+	return nil
+}
+
+func chargeSubscription(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	subscription emissionstypes.Subscription,
+) error {
+	topic, err := k.GetTopic(sdkCtx, subscription.TopicId)
+	if err != nil {
+		return err
+	}
+
+	payment := cosmosMath.MinInt(subscription.AmountPerEpoch, subscription.Escrow)
+	err = k.SendTopicRevenueFromModuleToEcosystem(sdkCtx, emissionstypes.AlloraRequestsAccountName, payment)
+	if err != nil {
+		return err
+	}
+	err = k.AddTopicFeeRevenue(sdkCtx, subscription.TopicId, payment)
+	if err != nil {
+		return err
+	}
+	err = k.ActivateTopicIfWeightAtLeastGlobalMin(sdkCtx, subscription.TopicId, payment)
+	if err != nil {
+		return err
+	}
+
+	subscription.Escrow = subscription.Escrow.Sub(payment)
+	if !subscription.Escrow.IsPositive() {
+		if err := k.RemoveSubscription(sdkCtx, subscription.Id); err != nil {
+			return err
+		}
+		emissionstypes.EmitNewSubscriptionLapsedEvent(sdkCtx, subscription, currentBlock)
+		return nil
+	}
+	subscription.NextPaymentBlock = currentBlock + topic.EpochLength
+	return k.SetSubscription(sdkCtx, subscription)
+}
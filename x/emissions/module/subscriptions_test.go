@@ -0,0 +1,40 @@
+package module_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *ModuleTestSuite) TestSubscriptionThatCannotBeChargedLapsesAndIsRefunded() {
+	require := s.Require()
+	blockHeight := int64(100)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight)
+	consumer := s.addrs[0]
+
+	// The topic of the subscription does not exist, so it cannot be charged
+	escrow := cosmosMath.NewInt(1000)
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, escrow))
+	require.NoError(s.bankKeeper.SendCoinsFromAccountToModule(s.ctx, consumer, types.AlloraRequestsAccountName, coins))
+	subscriptionId, err := s.emissionsKeeper.AddSubscription(s.ctx, types.Subscription{
+		Consumer:         consumer.String(),
+		TopicId:          99,
+		Escrow:           escrow,
+		AmountPerEpoch:   cosmosMath.NewInt(100),
+		NextPaymentBlock: blockHeight,
+	})
+	require.NoError(err)
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, consumer, params.DefaultBondDenom).Amount
+
+	module.ChargeSubscriptions(s.ctx, blockHeight, s.emissionsKeeper)
+
+	_, err = s.emissionsKeeper.GetSubscription(s.ctx, subscriptionId)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+	due, err := s.emissionsKeeper.GetSubscriptionsForPaymentBlock(s.ctx, blockHeight)
+	require.NoError(err)
+	require.Empty(due)
+	balanceAfter := s.bankKeeper.GetBalance(s.ctx, consumer, params.DefaultBondDenom).Amount
+	require.Equal(balanceBefore.Add(escrow), balanceAfter, "the whole escrow is refunded")
+}
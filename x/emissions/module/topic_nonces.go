@@ -0,0 +1,82 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Opens the next epoch of a churnable topic if its cadence is met: adds the next worker nonce,
+// marks the topic churnable and prunes the nonces too old to be fulfilled.
+// Only writes the state of the topic, so it can run in parallel with the other topics.
+func ManageTopicNonces(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	blockHeight int64,
+	topic emissionstypes.Topic,
+	moduleParams emissionstypes.Params,
+) error {
+	// Check the cadence of inferences, and just in case also check multiples of epoch lengths
+	// to avoid potential situations where the block is missed
+	if !k.CheckCadence(blockHeight, topic) {
+		return nil
+	}
+	ctx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker: Inference cadence met for topic: %v metadata: %s default arg: %s. \n",
+		topic.Id,
+		topic.Metadata,
+		topic.DefaultArg))
+
+	// Update the last inference ran
+	err := k.UpdateTopicEpochLastEnded(ctx, topic.Id, blockHeight)
+	if err != nil {
+		return fmt.Errorf("error updating last inference ran: %w", err)
+	}
+	// Add Worker Nonces
+	nextNonce := emissionstypes.Nonce{BlockHeight: blockHeight + topic.EpochLength}
+	err = k.AddWorkerNonce(ctx, topic.Id, &nextNonce)
+	if err != nil {
+		return fmt.Errorf("error adding worker nonce: %w", err)
+	}
+	ctx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
+	// To notify topic handler that the topic is ready for churn i.e. requests to be sent to workers and reputers
+	err = k.AddChurnableTopic(ctx, topic.Id)
+	if err != nil {
+		return fmt.Errorf("error setting churn ready topic: %w", err)
+	}
+
+	reputerPruningBlock := blockHeight - (int64(moduleParams.MaxUnfulfilledReputerRequests)*topic.EpochLength + topic.GroundTruthLag)
+	if reputerPruningBlock > 0 {
+		ctx.Logger().Warn(fmt.Sprintf("Pruning reputer nonces before block: %v for topic: %d on block: %v", reputerPruningBlock, topic.Id, blockHeight))
+		// a topic without reputer nonces has nothing to prune
+		if err := k.PruneReputerNonces(ctx, topic.Id, reputerPruningBlock); err != nil {
+			ctx.Logger().Debug(fmt.Sprintf("Error pruning reputer nonces of topic %d: %v", topic.Id, err))
+		}
+
+		workerPruningBlock := reputerPruningBlock - topic.EpochLength
+		if workerPruningBlock > 0 {
+			ctx.Logger().Debug("Pruning worker nonces before block: ", workerPruningBlock, " for topic: ", topic.Id)
+			// Prune old worker nonces previous to current blockHeight to avoid inserting inferences after its time has passed
+			// Reputer nonces need to check worker nonces one epoch before the reputer nonces
+			if err := k.PruneWorkerNonces(ctx, topic.Id, workerPruningBlock); err != nil {
+				ctx.Logger().Debug(fmt.Sprintf("Error pruning worker nonces of topic %d: %v", topic.Id, err))
+			}
+		}
+	}
+
+	setNonceBacklogGauges(ctx, k, topic.Id)
+	return nil
+}
+
+// Nonces opened but not yet fulfilled by workers or reputers, watched to alert on stalled topics
+func setNonceBacklogGauges(ctx sdk.Context, k emissionskeeper.Keeper, topicId emissionstypes.TopicId) {
+	workerNonces, err := k.GetUnfulfilledWorkerNonces(ctx, topicId)
+	if err == nil {
+		emissionstypes.SetUnfulfilledNoncesGauge(topicId, emissionstypes.MetricNonceTypeWorker, len(workerNonces.Nonces))
+	}
+	reputerNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
+	if err == nil {
+		emissionstypes.SetUnfulfilledNoncesGauge(topicId, emissionstypes.MetricNonceTypeReputer, len(reputerNonces.Nonces))
+	}
+}
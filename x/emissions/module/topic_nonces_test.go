@@ -0,0 +1,82 @@
+package module_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *ModuleTestSuite) TestParallelNonceManagementIsDeterministic() {
+	require := s.Require()
+	block := int64(600)
+	s.ctx = s.ctx.WithBlockHeight(block)
+	reputer := s.addrs[0]
+
+	topicIds := make([]uint64, 0)
+	for i := 0; i < 40; i++ {
+		res, err := s.msgServer.CreateNewTopic(s.ctx, &types.MsgCreateNewTopic{
+			Creator:         reputer.String(),
+			Metadata:        "test",
+			LossLogic:       "logic",
+			LossMethod:      "method",
+			EpochLength:     int64(12 + i%4),
+			InferenceLogic:  "Ilogic",
+			InferenceMethod: "Imethod",
+			DefaultArg:      "ETH",
+			AlphaRegret:     alloraMath.NewDecFromInt64(1),
+			PNorm:           alloraMath.NewDecFromInt64(3),
+			Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		})
+		require.NoError(err)
+		topicIds = append(topicIds, res.TopicId)
+
+		_, err = s.msgServer.Register(s.ctx, &types.MsgRegister{
+			Sender:       reputer.String(),
+			LibP2PKey:    "test",
+			MultiAddress: "test",
+			TopicId:      res.TopicId,
+			IsReputer:    true,
+			Owner:        reputer.String(),
+		})
+		require.NoError(err)
+		stake := cosmosMath.NewInt(int64(1000 + i)).Mul(inference_synthesis.CosmosIntOneE18())
+		s.MintTokensToAddress(reputer, stake)
+		_, err = s.msgServer.AddStake(s.ctx, &types.MsgAddStake{
+			Sender:  reputer.String(),
+			Amount:  stake,
+			TopicId: res.TopicId,
+		})
+		require.NoError(err)
+		revenue := cosmosMath.NewInt(int64(10 + i)).Mul(inference_synthesis.CosmosIntOneE18())
+		s.MintTokensToAddress(reputer, revenue)
+		_, err = s.msgServer.FundTopic(s.ctx, &types.MsgFundTopic{
+			Sender:  reputer.String(),
+			TopicId: res.TopicId,
+			Amount:  revenue,
+		})
+		require.NoError(err)
+	}
+	s.cms.Commit()
+
+	// replay the same blocks from the same committed state, the topics' goroutines
+	// finishing in a different order every time
+	var appHashes [][]byte
+	for run := 0; run < 3; run++ {
+		for height := block + 1; height <= block+15; height++ {
+			require.NoError(s.emissionsAppModule.EndBlock(s.ctx.WithBlockHeight(height)))
+		}
+		appHashes = append(appHashes, s.cms.WorkingHash())
+
+		for _, topicId := range topicIds {
+			nonces, err := s.emissionsKeeper.GetUnfulfilledWorkerNonces(s.ctx, topicId)
+			require.NoError(err)
+			require.NotEmpty(nonces.Nonces, "topic %d ran its epochs", topicId)
+		}
+		// discard the blocks
+		require.NoError(s.cms.LoadLatestVersion())
+	}
+	for run, appHash := range appHashes {
+		require.Equal(appHashes[0], appHash, "run %d", run)
+	}
+}
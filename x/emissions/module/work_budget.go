@@ -0,0 +1,26 @@
+package module
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// How many queued items are read from the store at once
+const workQueueBatchSize = 100
+
+// Meters the gas spent on a queue of EndBlock work on its own, so it can be bounded.
+// Whatever is left in the queue once the budget is spent carries over to the next block.
+func newWorkContext(sdkCtx sdk.Context) sdk.Context {
+	return sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+// At least one item is processed every block, so a queue always drains eventually
+func isWorkBudgetSpent(ctx sdk.Context, budget uint64, processed int) bool {
+	return processed > 0 && ctx.GasMeter().GasConsumed() >= budget
+}
+
+func setBacklogGauge(backlog uint64, queue string) {
+	telemetry.ModuleSetGauge(emissionstypes.ModuleName, float32(backlog), queue, "backlog")
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Trimmed and winsorized means need a trim fraction in (0, 0.5), the other strategies take none
+func ValidateAggregationStrategy(strategy AggregationStrategy, trimFraction alloraMath.Dec) error {
+	if _, ok := AggregationStrategy_name[int32(strategy)]; !ok {
+		return errors.Wrapf(ErrInvalidAggregationStrategy, "unknown aggregation strategy %d", strategy)
+	}
+	if trimFraction.IsNaN() {
+		return errors.Wrap(ErrInvalidAggregationStrategy, "trim fraction cannot be NaN")
+	}
+	switch strategy {
+	case AggregationStrategy_AGGREGATION_TRIMMED_MEAN, AggregationStrategy_AGGREGATION_WINSORIZED_MEAN:
+		if trimFraction.Lte(alloraMath.ZeroDec()) || trimFraction.Gte(alloraMath.MustNewDecFromString("0.5")) {
+			return errors.Wrapf(ErrInvalidAggregationStrategy, "trim fraction %s must be in (0, 0.5) for %s", trimFraction, strategy)
+		}
+	default:
+		if !trimFraction.IsZero() {
+			return errors.Wrapf(ErrInvalidAggregationStrategy, "%s takes no trim fraction", strategy)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Domain of every BundleSignDoc, versioned so that the sign bytes can evolve
+const BundleSignDocDomain = "allora/emissions/bundle/v1"
+
+// Compressed size of a secp256r1 public key
+const secp256r1PubKeySize = 33
+
+// Options under which the signature of a worker or reputer bundle is verified
+type BundleSignatureOptions struct {
+	ChainId string
+	// Whether bundles signed by secp256k1 keys over their bare proto encoding are accepted
+	AcceptLegacy bool
+}
+
+// Returns the canonical bytes signed for a payload of the given kind, topic and nonce
+func BundleSignBytes(chainId string, kind PayloadKind, topicId uint64, nonce int64, payload []byte) ([]byte, error) {
+	signDoc := BundleSignDoc{
+		Domain:      BundleSignDocDomain,
+		ChainId:     chainId,
+		PayloadKind: kind,
+		TopicId:     topicId,
+		Nonce:       nonce,
+		Payload:     payload,
+	}
+	return signDoc.XXX_Marshal(nil, true)
+}
+
+// Decodes a hex-encoded public key of the given type
+func decodeBundlePubKey(pubkey string, pubkeyType PubKeyType) (cryptotypes.PubKey, error) {
+	pk, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	switch pubkeyType {
+	case PubKeyType_PUB_KEY_TYPE_SECP256K1:
+		if len(pk) != secp256k1.PubKeySize {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "secp256k1 public key must be %d bytes", secp256k1.PubKeySize)
+		}
+		return &secp256k1.PubKey{Key: pk}, nil
+	case PubKeyType_PUB_KEY_TYPE_ED25519:
+		if len(pk) != ed25519.PubKeySize {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "ed25519 public key must be %d bytes", ed25519.PubKeySize)
+		}
+		return &ed25519.PubKey{Key: pk}, nil
+	case PubKeyType_PUB_KEY_TYPE_SECP256R1:
+		if len(pk) != secp256r1PubKeySize {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "secp256r1 public key must be %d bytes", secp256r1PubKeySize)
+		}
+		// The point of a secp256r1 key can only be set through its proto encoding, where it is field 1
+		pubKey := &secp256r1.PubKey{}
+		bz := protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), pk)
+		if err := pubKey.Unmarshal(bz); err != nil {
+			return nil, err
+		}
+		return pubKey, nil
+	default:
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "unknown public key type %d", pubkeyType)
+	}
+}
+
+// Verifies the signature of a bundle over its sign doc or, when accepted, over its bare payload
+func verifyBundleSignature(
+	pubkey string,
+	pubkeyType PubKeyType,
+	signature []byte,
+	payload []byte,
+	kind PayloadKind,
+	topicId uint64,
+	nonce int64,
+	opts BundleSignatureOptions,
+) error {
+	pk, err := decodeBundlePubKey(pubkey, pubkeyType)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed: %s", err)
+	}
+	signBytes, err := BundleSignBytes(opts.ChainId, kind, topicId, nonce, payload)
+	if err != nil {
+		return err
+	}
+	if pk.VerifySignature(signBytes, signature) {
+		return nil
+	}
+	if opts.AcceptLegacy && pubkeyType == PubKeyType_PUB_KEY_TYPE_SECP256K1 && pk.VerifySignature(payload, signature) {
+		return nil
+	}
+	return errors.Wrap(sdkerrors.ErrInvalidRequest, "signature verification failed")
+}
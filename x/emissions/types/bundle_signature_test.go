@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainId = "allora-testnet-1"
+
+func newSignedWorkerDataBundle(
+	t *testing.T,
+	privKey cryptotypes.PrivKey,
+	pubkeyType types.PubKeyType,
+	signBytes func(*types.InferenceForecastBundle) []byte,
+) *types.WorkerDataBundle {
+	worker := sdk.AccAddress(privKey.PubKey().Address()).String()
+	bundle := &types.InferenceForecastBundle{
+		Inference: &types.Inference{
+			TopicId:     7,
+			BlockHeight: 100,
+			Inferer:     worker,
+			Value:       alloraMath.MustNewDecFromString("1.5"),
+		},
+	}
+	sig, err := privKey.Sign(signBytes(bundle))
+	require.NoError(t, err)
+	return &types.WorkerDataBundle{
+		Worker:                             worker,
+		InferenceForecastsBundle:           bundle,
+		InferencesForecastsBundleSignature: sig,
+		Pubkey:                             hex.EncodeToString(privKey.PubKey().Bytes()),
+		PubkeyType:                         pubkeyType,
+	}
+}
+
+func signDocBytes(t *testing.T, chainId string) func(*types.InferenceForecastBundle) []byte {
+	return func(bundle *types.InferenceForecastBundle) []byte {
+		bz, err := bundle.SignBytes(chainId)
+		require.NoError(t, err)
+		return bz
+	}
+}
+
+func legacyBytes(t *testing.T) func(*types.InferenceForecastBundle) []byte {
+	return func(bundle *types.InferenceForecastBundle) []byte {
+		bz, err := bundle.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+}
+
+func TestWorkerDataBundleAcceptsEverySupportedKeyType(t *testing.T) {
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	testCases := []struct {
+		name       string
+		privKey    cryptotypes.PrivKey
+		pubkeyType types.PubKeyType
+	}{
+		{"secp256k1", secp256k1.GenPrivKey(), types.PubKeyType_PUB_KEY_TYPE_SECP256K1},
+		{"ed25519", ed25519.GenPrivKey(), types.PubKeyType_PUB_KEY_TYPE_ED25519},
+		{"secp256r1", secp256r1Key, types.PubKeyType_PUB_KEY_TYPE_SECP256R1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle := newSignedWorkerDataBundle(t, tc.privKey, tc.pubkeyType, signDocBytes(t, testChainId))
+			require.NoError(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId}))
+
+			// The key must be read as the type it was declared with
+			bundle.PubkeyType = (tc.pubkeyType + 1) % 3
+			require.Error(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId}))
+		})
+	}
+}
+
+func TestWorkerDataBundleRejectsSignatureForAnotherChain(t *testing.T) {
+	bundle := newSignedWorkerDataBundle(t, ed25519.GenPrivKey(), types.PubKeyType_PUB_KEY_TYPE_ED25519, signDocBytes(t, "other-chain"))
+	err := bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId, AcceptLegacy: true})
+	require.Error(t, err)
+}
+
+func TestWorkerDataBundleAcceptsLegacySignatureOnlyDuringWindow(t *testing.T) {
+	bundle := newSignedWorkerDataBundle(t, secp256k1.GenPrivKey(), types.PubKeyType_PUB_KEY_TYPE_SECP256K1, legacyBytes(t))
+	require.NoError(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId, AcceptLegacy: true}))
+	require.Error(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId}))
+
+	// Legacy signatures only ever existed for secp256k1 keys
+	bundle = newSignedWorkerDataBundle(t, ed25519.GenPrivKey(), types.PubKeyType_PUB_KEY_TYPE_ED25519, legacyBytes(t))
+	require.Error(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId, AcceptLegacy: true}))
+}
+
+func TestReputerValueBundleSignatureIsBoundToChainAndNonce(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	valueBundle := &types.ValueBundle{
+		TopicId: 7,
+		Reputer: sdk.AccAddress(privKey.PubKey().Address()).String(),
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &types.Nonce{BlockHeight: 100},
+		},
+		CombinedValue: alloraMath.MustNewDecFromString("0.5"),
+		NaiveValue:    alloraMath.MustNewDecFromString("0.6"),
+	}
+	signBytes, err := valueBundle.SignBytes(testChainId)
+	require.NoError(t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	bundle := &types.ReputerValueBundle{
+		ValueBundle: valueBundle,
+		Signature:   sig,
+		Pubkey:      hex.EncodeToString(privKey.PubKey().Bytes()),
+		PubkeyType:  types.PubKeyType_PUB_KEY_TYPE_ED25519,
+	}
+	require.NoError(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId}))
+	require.Error(t, bundle.Validate(types.BundleSignatureOptions{ChainId: "other-chain"}))
+
+	valueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight = 101
+	require.Error(t, bundle.Validate(types.BundleSignatureOptions{ChainId: testChainId}))
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// A categorical topic has at least two outcomes, each with a distinct, non-empty label
+func ValidateCategoryLabels(labels []string) error {
+	if len(labels) < 2 {
+		return errors.Wrapf(ErrInvalidCategoryLabels, "got %d labels", len(labels))
+	}
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		if label == "" {
+			return errors.Wrap(ErrInvalidCategoryLabels, "label cannot be empty")
+		}
+		if seen[label] {
+			return errors.Wrapf(ErrInvalidCategoryLabels, "duplicate label %s", label)
+		}
+		seen[label] = true
+	}
+	return nil
+}
+
+// Categorical topics take inferences made of one probability per category label of the topic.
+// Reputers score the probabilities off chain against the observed category, e.g. with the
+// log-loss or the Brier score, and report that score as the loss of each inferer in their
+// value bundle, from which regrets follow as for any other topic.
+func (topic *Topic) IsCategoricalTopic() bool {
+	return len(topic.CategoryLabels) > 0
+}
+
+// The values of an inference of a categorical topic form a probability distribution over the
+// categories: they are non-negative and sum to one, give or take the tolerance
+func (inference *Inference) ValidateProbabilities(tolerance alloraMath.Dec) error {
+	sum := alloraMath.ZeroDec()
+	for i, probability := range inference.Values {
+		if probability.IsNegative() {
+			return errors.Wrapf(ErrInvalidProbabilities, "probability %d (%s) is negative", i, probability)
+		}
+		var err error
+		sum, err = sum.Add(probability)
+		if err != nil {
+			return err
+		}
+	}
+	deviation, err := sum.Sub(alloraMath.OneDec())
+	if err != nil {
+		return err
+	}
+	if deviation.Abs().Gt(tolerance) {
+		return errors.Wrapf(ErrInvalidProbabilities, "probabilities sum to %s", sum)
+	}
+	return nil
+}
@@ -11,6 +11,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgInsertBulkReputerPayload{},
 		&MsgInsertBulkWorkerPayload{},
+		&MsgInsertReputerPayload{},
+		&MsgInsertWorkerPayload{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -67,4 +67,32 @@ var (
 	ErrStakeRemovalNotFound                     = errors.Register(ModuleName, 64, "No stake removal found")
 	ErrInvariantFailure                         = errors.Register(ModuleName, 65, "invariant failure")
 	ErrIntegerUnderflowReputerStakeAuthority    = errors.Register(ModuleName, 66, "integer underflow for reputer stake authority")
+	ErrUnknownRole                              = errors.Register(ModuleName, 67, "unknown role")
+	ErrMissingRole                              = errors.Register(ModuleName, 68, "sender does not hold the required role")
+	ErrLastAdminManager                         = errors.Register(ModuleName, 69, "cannot revoke the role of the last admin manager")
+	ErrParamsChangeMustBeScheduled              = errors.Register(ModuleName, 70, "params changes must be scheduled while a min param change delay is set")
+	ErrParamsChangeTooEarly                     = errors.Register(ModuleName, 71, "params change scheduled before the min param change delay has passed")
+	ErrScheduledParamsChangeNotFound            = errors.Register(ModuleName, 72, "scheduled params change not found")
+	ErrInferenceRequestNotFound                 = errors.Register(ModuleName, 73, "inference request not found")
+	ErrInvalidInferenceRequestDeadline          = errors.Register(ModuleName, 74, "inference request deadline must be after the ground truth is available")
+	ErrTooManyOpenInferenceRequests             = errors.Register(ModuleName, 75, "too many open inference requests in topic")
+	ErrInferenceRequestNonceTaken               = errors.Register(ModuleName, 76, "topic already has a worker nonce at this block")
+	ErrSubscriptionNotFound                     = errors.Register(ModuleName, 77, "subscription not found")
+	ErrNotSubscriptionConsumer                  = errors.Register(ModuleName, 78, "only the consumer can cancel a subscription")
+	ErrInferenceDimensionAboveMaximum           = errors.Register(ModuleName, 79, "inference dimension above maximum")
+	ErrInferenceDimensionMismatch               = errors.Register(ModuleName, 80, "inference does not match the inference dimension of the topic")
+	ErrInvalidQuantileLevels                    = errors.Register(ModuleName, 81, "quantile levels must be strictly increasing and within (0, 1)")
+	ErrQuantilesNotMonotonic                    = errors.Register(ModuleName, 82, "quantiles must not decrease as the quantile level increases")
+	ErrInvalidCategoryLabels                    = errors.Register(ModuleName, 83, "categorical topics need at least two distinct, non-empty labels")
+	ErrInvalidProbabilities                     = errors.Register(ModuleName, 84, "probabilities must be non-negative and sum to one")
+	ErrConflictingTopicKinds                    = errors.Register(ModuleName, 85, "a topic cannot be both a quantile and a categorical topic")
+	ErrInvalidAggregationStrategy               = errors.Register(ModuleName, 86, "invalid aggregation strategy")
+	ErrInvalidRegretRole                        = errors.Register(ModuleName, 87, "invalid regret role")
+	ErrPayloadAlreadySubmitted                  = errors.Register(ModuleName, 88, "a payload was already submitted for this nonce")
+	ErrNotPayloadOwner                          = errors.Register(ModuleName, 89, "only the worker or reputer of a payload can submit it directly")
+	ErrLibP2PKeyTaken                           = errors.Register(ModuleName, 90, "libp2p key is used by another node")
+	ErrDeprecatedParam                          = errors.Register(ModuleName, 91, "param is deprecated and can no longer be updated")
+	ErrInferenceRequestsDisabled                = errors.Register(ModuleName, 92, "ad-hoc inference requests are disabled")
+	ErrValidationTooLarge                       = errors.Register(ModuleName, 93, "value is too large")
+	ErrBlockedPayoutAddress                     = errors.Register(ModuleName, 94, "payout address is not allowed to receive funds")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -54,6 +55,117 @@ func EmitNewReputerAndDelegatorRewardsSettledEvent(ctx sdk.Context, blockHeight
 	ctx.EventManager().EmitTypedEvent(NewRewardsSetEventBase(ActorType_REPUTER, blockHeight, rewards))
 }
 
+func EmitNewParamsChangeScheduledEvent(ctx sdk.Context, id uint64, proposer string, params *OptionalParams, applyAtBlock BlockHeight) {
+	ctx.EventManager().EmitTypedEvent(&EventParamsChangeScheduled{
+		Id:           id,
+		Proposer:     proposer,
+		Params:       params,
+		ApplyAtBlock: applyAtBlock,
+	})
+}
+
+func EmitNewParamsChangeCancelledEvent(ctx sdk.Context, id uint64, sender string) {
+	ctx.EventManager().EmitTypedEvent(&EventParamsChangeCancelled{
+		Id:     id,
+		Sender: sender,
+	})
+}
+
+func EmitNewParamsChangeAppliedEvent(ctx sdk.Context, id uint64, blockHeight BlockHeight) {
+	ctx.EventManager().EmitTypedEvent(&EventParamsChangeApplied{
+		Id:          id,
+		BlockHeight: blockHeight,
+	})
+}
+
+func EmitNewParamsChangeFailedEvent(ctx sdk.Context, id uint64, blockHeight BlockHeight, err error) {
+	ctx.EventManager().EmitTypedEvent(&EventParamsChangeFailed{
+		Id:          id,
+		BlockHeight: blockHeight,
+		Error:       err.Error(),
+	})
+}
+
+func EmitNewInferenceRequestedEvent(ctx sdk.Context, request InferenceRequest) {
+	ctx.EventManager().EmitTypedEvent(&EventInferenceRequested{
+		Request: &request,
+	})
+}
+
+func EmitNewInferenceRequestSettledEvent(ctx sdk.Context, request InferenceRequest, paid, refunded cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventInferenceRequestSettled{
+		RequestId: request.Id,
+		TopicId:   request.TopicId,
+		Paid:      paid,
+		Refunded:  refunded,
+	})
+}
+
+func EmitNewInferenceRequestExpiredEvent(ctx sdk.Context, request InferenceRequest) {
+	ctx.EventManager().EmitTypedEvent(&EventInferenceRequestExpired{
+		RequestId: request.Id,
+		TopicId:   request.TopicId,
+		Refunded:  request.Bounty,
+	})
+}
+
+func EmitNewSubscriptionCreatedEvent(ctx sdk.Context, subscription Subscription) {
+	ctx.EventManager().EmitTypedEvent(&EventSubscriptionCreated{
+		Subscription: &subscription,
+	})
+}
+
+func EmitNewSubscriptionCancelledEvent(ctx sdk.Context, subscription Subscription) {
+	ctx.EventManager().EmitTypedEvent(&EventSubscriptionCancelled{
+		SubscriptionId: subscription.Id,
+		Consumer:       subscription.Consumer,
+		TopicId:        subscription.TopicId,
+		Refunded:       subscription.Escrow,
+	})
+}
+
+func EmitNewSubscriptionLapsedEvent(ctx sdk.Context, subscription Subscription, blockHeight BlockHeight) {
+	ctx.EventManager().EmitTypedEvent(&EventSubscriptionLapsed{
+		SubscriptionId: subscription.Id,
+		Consumer:       subscription.Consumer,
+		TopicId:        subscription.TopicId,
+		BlockHeight:    blockHeight,
+		Refunded:       subscription.Escrow,
+	})
+}
+
+func EmitNewSubmissionOutcomesEvent(ctx sdk.Context, topicId TopicId, nonceBlockHeight BlockHeight, outcomes *SubmissionOutcomes) {
+	list := outcomes.List()
+	if len(list) < 1 {
+		return
+	}
+	ctx.EventManager().EmitTypedEvent(&EventSubmissionOutcomes{
+		ActorType:        outcomes.actorType,
+		TopicId:          topicId,
+		NonceBlockHeight: nonceBlockHeight,
+		Outcomes:         list,
+	})
+}
+
+func EmitNewNodeInfoUpdatedEvent(ctx sdk.Context, msg *MsgUpdateNodeInfo, previousLibP2PKey string) {
+	ctx.EventManager().EmitTypedEvent(&EventNodeInfoUpdated{
+		Address:           msg.Sender,
+		IsReputer:         msg.IsReputer,
+		PreviousLibP2PKey: previousLibP2PKey,
+		LibP2PKey:         msg.LibP2PKey,
+		MultiAddress:      msg.MultiAddress,
+	})
+}
+
+func EmitNewPayoutAddressSetEvent(ctx sdk.Context, msg *MsgSetPayoutAddress) {
+	ctx.EventManager().EmitTypedEvent(&EventPayoutAddressSet{
+		Address:       msg.Sender,
+		TopicId:       msg.TopicId,
+		Global:        msg.Global,
+		PayoutAddress: msg.PayoutAddress,
+	})
+}
+
 /// Utils
 
 // Assumes length of `scores` is at least 1
@@ -373,14 +373,16 @@ func TestEmitNewNetworkLossSetEvent(t *testing.T) {
 	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
 	topicId := uint64(1)
 	blockHeight := int64(10)
+	// Events are emitted as JSON with defaults, so scalar values come back with an empty list of values
+	noValues := []alloraMath.Dec{}
 	loss := types.ValueBundle{
 		CombinedValue:          alloraMath.MustNewDecFromString("10"),
 		NaiveValue:             alloraMath.MustNewDecFromString("20"),
-		InfererValues:          []*types.WorkerAttributedValue{{Worker: "TestInferer", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestInferer1", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		ForecasterValues:       []*types.WorkerAttributedValue{{Worker: "TestForecaster", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster1", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneOutInfererValues:    []*types.WithheldWorkerAttributedValue{{Worker: "TestInferer2", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestInferer3", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{{Worker: "TestForecaster3", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster4", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneInForecasterValues:  []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112")}},
+		InfererValues:          []*types.WorkerAttributedValue{{Worker: "TestInferer", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestInferer1", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		ForecasterValues:       []*types.WorkerAttributedValue{{Worker: "TestForecaster", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestForecaster1", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		OneOutInfererValues:    []*types.WithheldWorkerAttributedValue{{Worker: "TestInferer2", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestInferer3", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{{Worker: "TestForecaster3", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestForecaster4", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		OneInForecasterValues:  []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
 	}
 
 	types.EmitNewNetworkLossSetEvent(ctx, topicId, blockHeight, loss)
@@ -20,6 +20,25 @@ func (inference *Inference) Validate() error {
 	if inference.BlockHeight < 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference block height cannot be negative")
 	}
+	for _, value := range inference.Values {
+		if value.IsNaN() {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference values cannot be NaN")
+		}
+	}
 
 	return nil
 }
+
+// Inferences of vector topics carry exactly inferenceDimension values,
+// those of scalar topics (a dimension of 0) carry none
+func (inference *Inference) ValidateDimension(inferenceDimension uint64) error {
+	if uint64(len(inference.Values)) != inferenceDimension {
+		return errors.Wrapf(
+			ErrInferenceDimensionMismatch,
+			"inference has %d values, topic expects %d",
+			len(inference.Values),
+			inferenceDimension,
+		)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ const (
 	AlloraStakingAccountName                   = "allorastaking"
 	AlloraRewardsAccountName                   = "allorarewards"
 	AlloraPendingRewardForDelegatorAccountName = "allorapendingrewards"
+	AlloraRequestsAccountName                  = "allorarequests"
 )
 
 var (
@@ -70,4 +71,39 @@ var (
 	DelegateStakeRemovalsByActorKey             = collections.NewPrefix(55)
 	TopicLastWorkerCommitKey                    = collections.NewPrefix(56)
 	TopicLastReputerCommitKey                   = collections.NewPrefix(57)
+	TopicRevenueBurnedKey                       = collections.NewPrefix(58)
+	RoleMembersKey                              = collections.NewPrefix(59)
+	NextScheduledParamsChangeIdKey              = collections.NewPrefix(60)
+	ScheduledParamsChangesKey                   = collections.NewPrefix(61)
+	ScheduledParamsChangesByBlockKey            = collections.NewPrefix(62)
+	TopicParamsOverridesKey                     = collections.NewPrefix(63)
+	NextInferenceRequestIdKey                   = collections.NewPrefix(64)
+	InferenceRequestsKey                        = collections.NewPrefix(65)
+	InferenceRequestsByNonceKey                 = collections.NewPrefix(66)
+	InferenceRequestsByGroundTruthBlockKey      = collections.NewPrefix(67)
+	InferenceRequestsByDeadlineKey              = collections.NewPrefix(68)
+	NextSubscriptionIdKey                       = collections.NewPrefix(69)
+	SubscriptionsKey                            = collections.NewPrefix(70)
+	SubscriptionsByTopicKey                     = collections.NewPrefix(71)
+	SubscriptionsByConsumerKey                  = collections.NewPrefix(72)
+	SubscriptionsByPaymentBlockKey              = collections.NewPrefix(73)
+	NextPendingTopicRewardIdKey                 = collections.NewPrefix(74)
+	PendingTopicRewardsKey                      = collections.NewPrefix(75)
+	PendingRecordPrunesKey                      = collections.NewPrefix(76)
+	InfererRegretHistoriesKey                   = collections.NewPrefix(77)
+	ForecasterRegretHistoriesKey                = collections.NewPrefix(78)
+	OneInForecasterRegretHistoriesKey           = collections.NewPrefix(79)
+	OneInForecasterSelfRegretHistoriesKey       = collections.NewPrefix(80)
+	WorkerPayloadSubmissionsKey                 = collections.NewPrefix(81)
+	ReputerPayloadSubmissionsKey                = collections.NewPrefix(82)
+	WorkerNonceClosesKey                        = collections.NewPrefix(83)
+	ReputerNonceClosesKey                       = collections.NewPrefix(84)
+	TopicPayoutAddressesKey                     = collections.NewPrefix(85)
+	GlobalPayoutAddressesKey                    = collections.NewPrefix(86)
+	WorkerNonceClosesByBlockKey                 = collections.NewPrefix(87)
+	ReputerNonceClosesByBlockKey                = collections.NewPrefix(88)
+	WorkerNodeRegistrationsKey                  = collections.NewPrefix(89)
+	ReputerNodeRegistrationsKey                 = collections.NewPrefix(90)
+	WorkerPayloadSelfSubmissionsKey             = collections.NewPrefix(91)
+	ReputerPayloadSelfSubmissionsKey            = collections.NewPrefix(92)
 )
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+)
+
+// Telemetry metric keys of the module. All metrics are exposed through the Cosmos SDK telemetry
+// module, so with telemetry enabled in app.toml they are scraped from the node's Prometheus endpoint.
+const (
+	MetricKeyTopics               = "topics"
+	MetricKeyTopicWeight          = "topic_weight"
+	MetricKeyParticipants         = "participants"
+	MetricKeySubmissionsAccepted  = "submissions_accepted"
+	MetricKeySubmissionsRejected  = "submissions_rejected"
+	MetricKeyUnfulfilledNonces    = "unfulfilled_nonces"
+	MetricKeyRewards              = "rewards"
+	MetricKeyTotalStake           = "total_stake"
+	MetricKeyEndBlockerPhase      = "end_blocker_phase"
+	MetricLabelTopicId            = "topic_id"
+	MetricLabelActorType          = "actor_type"
+	MetricLabelReason             = "reason"
+	MetricLabelTopicSet           = "set"
+	MetricLabelNonceType          = "nonce_type"
+	MetricTopicSetActive          = "active"
+	MetricTopicSetChurnable       = "churnable"
+	MetricTopicSetRewardable      = "rewardable"
+	MetricNonceTypeWorker         = "worker"
+	MetricNonceTypeReputer        = "reputer"
+	MetricActorReputerAndDelegate = "reputer_and_delegator"
+)
+
+// Transactions run in CheckTx and simulations are discarded, their outcome is only
+// counted once the transaction is delivered
+func isDeliveredTx(ctx sdk.Context) bool {
+	return !ctx.IsCheckTx() && !ctx.IsReCheckTx() && ctx.ExecMode() != sdk.ExecModeSimulate
+}
+
+func topicLabel(topicId TopicId) metrics.Label {
+	return telemetry.NewLabel(MetricLabelTopicId, strconv.FormatUint(topicId, 10))
+}
+
+func actorTypeLabel(actorType ActorType) metrics.Label {
+	return telemetry.NewLabel(MetricLabelActorType, strings.ToLower(actorType.String()))
+}
+
+// Lossy conversion, only good enough for telemetry
+func decToFloat32(d alloraMath.Dec) float32 {
+	f, err := strconv.ParseFloat(d.String(), 32)
+	if err != nil {
+		return 0
+	}
+	return float32(f)
+}
+
+func RewardTypeMetricLabel(rewardType TaskRewardType) string {
+	switch rewardType {
+	case WorkerInferenceRewardType:
+		return strings.ToLower(ActorType_INFERER.String())
+	case WorkerForecastRewardType:
+		return strings.ToLower(ActorType_FORECASTER.String())
+	default:
+		return MetricActorReputerAndDelegate
+	}
+}
+
+func SetTopicCountGauge(set string, count int) {
+	telemetry.SetGaugeWithLabels(
+		[]string{ModuleName, MetricKeyTopics},
+		float32(count),
+		[]metrics.Label{telemetry.NewLabel(MetricLabelTopicSet, set)},
+	)
+}
+
+func SetTopicWeightGauge(topicId TopicId, weight alloraMath.Dec) {
+	telemetry.SetGaugeWithLabels(
+		[]string{ModuleName, MetricKeyTopicWeight},
+		decToFloat32(weight),
+		[]metrics.Label{topicLabel(topicId)},
+	)
+}
+
+// Number of actors whose submissions were accepted for the latest nonce of a topic
+func SetParticipantsGauge(ctx sdk.Context, topicId TopicId, actorType ActorType, count int) {
+	if !isDeliveredTx(ctx) {
+		return
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{ModuleName, MetricKeyParticipants},
+		float32(count),
+		[]metrics.Label{topicLabel(topicId), actorTypeLabel(actorType)},
+	)
+}
+
+func IncrSubmissionsAccepted(ctx sdk.Context, topicId TopicId, actorType ActorType, count int) {
+	if count == 0 || !isDeliveredTx(ctx) {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, MetricKeySubmissionsAccepted},
+		float32(count),
+		[]metrics.Label{topicLabel(topicId), actorTypeLabel(actorType)},
+	)
+}
+
+// Counts the rejected submissions of a payload, to be called once the payload is stored
+func IncrSubmissionsRejected(ctx sdk.Context, outcomes *SubmissionOutcomes) {
+	if !isDeliveredTx(ctx) {
+		return
+	}
+	for _, outcome := range outcomes.List() {
+		if outcome.Code == SubmissionOutcomeCode_SUBMISSION_ACCEPTED {
+			continue
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{ModuleName, MetricKeySubmissionsRejected},
+			1,
+			[]metrics.Label{
+				topicLabel(outcomes.topicId),
+				actorTypeLabel(outcomes.actorType),
+				telemetry.NewLabel(MetricLabelReason, outcome.Code.MetricLabel()),
+			},
+		)
+	}
+}
+
+func SetUnfulfilledNoncesGauge(topicId TopicId, nonceType string, count int) {
+	telemetry.SetGaugeWithLabels(
+		[]string{ModuleName, MetricKeyUnfulfilledNonces},
+		float32(count),
+		[]metrics.Label{topicLabel(topicId), telemetry.NewLabel(MetricLabelNonceType, nonceType)},
+	)
+}
+
+func IncrRewardsPaid(actorType string, amount cosmosMath.Int) {
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, MetricKeyRewards},
+		alloraMath.SdkIntToFloat32(amount),
+		[]metrics.Label{telemetry.NewLabel(MetricLabelActorType, actorType)},
+	)
+}
+
+func SetTotalStakeGauge(totalStake cosmosMath.Int) {
+	telemetry.ModuleSetGauge(ModuleName, alloraMath.SdkIntToFloat32(totalStake), MetricKeyTotalStake)
+}
+
+// Times a phase of the EndBlocker, to be deferred or called when the phase is over
+func MeasureEndBlockerPhase(phase string, start time.Time) {
+	telemetry.ModuleMeasureSince(ModuleName, start, MetricKeyEndBlockerPhase, phase)
+}
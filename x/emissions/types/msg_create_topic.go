@@ -43,6 +43,33 @@ func (msg *MsgCreateNewTopic) Validate() error {
 	if msg.Epsilon.Lte(alloraMath.ZeroDec()) {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epsilon must be greater than 0")
 	}
+	if len(msg.QuantileLevels) > 0 && len(msg.CategoryLabels) > 0 {
+		return ErrConflictingTopicKinds
+	}
+	if len(msg.QuantileLevels) > 0 {
+		if err := ValidateQuantileLevels(msg.QuantileLevels); err != nil {
+			return err
+		}
+		if msg.InferenceDimension != 0 && msg.InferenceDimension != uint64(len(msg.QuantileLevels)) {
+			return errors.Wrap(ErrInferenceDimensionMismatch, "inference dimension of a quantile topic must be its number of quantile levels")
+		}
+	}
+	if len(msg.CategoryLabels) > 0 {
+		if err := ValidateCategoryLabels(msg.CategoryLabels); err != nil {
+			return err
+		}
+		if msg.InferenceDimension != 0 && msg.InferenceDimension != uint64(len(msg.CategoryLabels)) {
+			return errors.Wrap(ErrInferenceDimensionMismatch, "inference dimension of a categorical topic must be its number of labels")
+		}
+	}
+	if err := ValidateAggregationStrategy(msg.AggregationStrategy, msg.AggregationTrimFraction); err != nil {
+		return err
+	}
+	if msg.ParamsOverrides != nil {
+		if err := msg.ParamsOverrides.Validate(); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params overrides: %s", err)
+		}
+	}
 
 	return nil
 }
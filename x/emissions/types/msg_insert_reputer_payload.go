@@ -0,0 +1,31 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validates the message without the bundle's signature, which is verified against the params
+func (msg *MsgInsertReputerPayload) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	bundle := msg.ReputerValueBundle
+	if bundle == nil || bundle.ValueBundle == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer value bundle cannot be nil")
+	}
+	nonce := bundle.ValueBundle.ReputerRequestNonce
+	if nonce == nil || nonce.WorkerNonce == nil || nonce.ReputerNonce == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer request nonce cannot be nil")
+	}
+
+	// A reputer only submits its own losses
+	if bundle.ValueBundle.Reputer != msg.Sender {
+		return ErrNotPayloadOwner
+	}
+
+	return nil
+}
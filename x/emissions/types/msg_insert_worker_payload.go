@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validates the message without the bundle's signature, which is verified against the params
+func (msg *MsgInsertWorkerPayload) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	bundle := msg.WorkerDataBundle
+	if bundle == nil || bundle.InferenceForecastsBundle == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker data bundle cannot be nil")
+	}
+
+	// A worker only submits its own work
+	if bundle.Worker != msg.Sender {
+		return ErrNotPayloadOwner
+	}
+	if inference := bundle.InferenceForecastsBundle.Inference; inference != nil && inference.Inferer != msg.Sender {
+		return ErrNotPayloadOwner
+	}
+	if forecast := bundle.InferenceForecastsBundle.Forecast; forecast != nil && forecast.Forecaster != msg.Sender {
+		return ErrNotPayloadOwner
+	}
+
+	return nil
+}
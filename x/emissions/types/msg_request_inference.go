@@ -0,0 +1,21 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgRequestInference) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.Argument) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "argument cannot be empty")
+	}
+	if msg.Bounty.IsNil() || !msg.Bounty.IsPositive() {
+		return errors.Wrap(ErrInvalidValue, "bounty must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgSetPayoutAddress) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	// An empty payout address sends the rewards back to the sender
+	if msg.PayoutAddress != "" {
+		_, err = sdk.AccAddressFromBech32(msg.PayoutAddress)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payout address (%s)", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgCreateSubscription) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.AmountPerEpoch.IsNil() || !msg.AmountPerEpoch.IsPositive() {
+		return errors.Wrap(ErrInvalidValue, "amount per epoch must be positive")
+	}
+	if msg.Escrow.IsNil() || msg.Escrow.LT(msg.AmountPerEpoch) {
+		return errors.Wrap(ErrInvalidValue, "escrow must cover at least one epoch")
+	}
+	return nil
+}
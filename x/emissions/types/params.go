@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 )
@@ -10,46 +11,57 @@ import (
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
 	return Params{
-		Version:                         "0.0.3",                                  // version of the protocol should be in lockstep with github release tag version
-		MinTopicWeight:                  alloraMath.MustNewDecFromString("100"),   // total weight for a topic < this => don't run inference solicatation or loss update
-		MaxTopicsPerBlock:               uint64(128),                              // max number of topics to run cadence for per block
-		RequiredMinimumStake:            cosmosMath.NewInt(100),                   // minimum stake required to be a worker or reputer
-		RemoveStakeDelayWindow:          int64((60 * 60 * 24 * 7 * 3) / 5),        // ~approx 3 weeks assuming 5 second block time, number of blocks to wait before finalizing a stake withdrawal
-		MinEpochLength:                  12,                                       // shortest number of blocks per epoch topics are allowed to set as their cadence
-		BetaEntropy:                     alloraMath.MustNewDecFromString("0.25"),  // controls resilience of reward payouts against copycat workers
-		LearningRate:                    alloraMath.MustNewDecFromString("0.05"),  // speed of gradient descent
-		GradientDescentMaxIters:         uint64(10),                               // max iterations on gradient descent
-		MaxGradientThreshold:            alloraMath.MustNewDecFromString("0.001"), // gradient descent stops when gradient falls below this
-		MinStakeFraction:                alloraMath.MustNewDecFromString("0.5"),   // minimum fraction of stake that should be listened to when setting consensus listening coefficients
-		EpsilonReputer:                  alloraMath.MustNewDecFromString("0.01"),  // a small tolerance quantity used to cap reputer scores at infinitesimally close proximities
-		MaxUnfulfilledWorkerRequests:    uint64(100),                              // maximum number of outstanding nonces for worker requests per topic from the chain; needs to be bigger to account for varying topic ground truth lag
-		MaxUnfulfilledReputerRequests:   uint64(100),                              // maximum number of outstanding nonces for reputer requests per topic from the chain; needs to be bigger to account for varying topic ground truth lag
-		TopicRewardStakeImportance:      alloraMath.MustNewDecFromString("0.5"),   // importance of stake in determining rewards for a topic
-		TopicRewardFeeRevenueImportance: alloraMath.MustNewDecFromString("0.5"),   // importance of fee revenue in determining rewards for a topic
-		TopicRewardAlpha:                alloraMath.MustNewDecFromString("0.5"),   // alpha for topic reward calculation; coupled with blocktime, or how often rewards are calculated
-		TaskRewardAlpha:                 alloraMath.MustNewDecFromString("0.1"),   // alpha for task reward calculation used to calculate  ~U_ij, ~V_ik, ~W_im
-		ValidatorsVsAlloraPercentReward: alloraMath.MustNewDecFromString("0.25"),  // 25% rewards go to cosmos network validators
-		MaxSamplesToScaleScores:         uint64(10),                               // maximum number of previous scores to store and use for standard deviation calculation
-		MaxTopInferersToReward:          uint64(48),                               // max this many top inferers by score are rewarded for a topic
-		MaxTopForecastersToReward:       uint64(6),                                // max this many top forecasters by score are rewarded for a topic
-		MaxTopReputersToReward:          uint64(12),                               // max this many top reputers by score are rewarded for a topic
-		CreateTopicFee:                  cosmosMath.NewInt(10),                    // topic registration fee
-		MaxRetriesToFulfilNoncesWorker:  int64(1),                                 // max throttle of simultaneous unfulfilled worker requests
-		MaxRetriesToFulfilNoncesReputer: int64(3),                                 // max throttle of simultaneous unfulfilled reputer requests
-		RegistrationFee:                 cosmosMath.NewInt(10),                    // how much workers and reputers must pay to register per topic
-		DefaultPageLimit:                uint64(100),                              // how many topics to return per page during churn of requests
-		MaxPageLimit:                    uint64(1000),                             // max limit for pagination
-		MinEpochLengthRecordLimit:       int64(3),                                 // minimum number of epochs to keep records for a topic
-		MaxSerializedMsgLength:          int64(1000 * 1000),                       // maximum size of data to msg and query server in bytes
-		BlocksPerMonth:                  uint64(525960),                           // ~5 seconds block time, 6311520 per year, 525960 per month
-		PRewardInference:                alloraMath.NewDecFromInt64(1),            // fiducial value for rewards calculation
-		PRewardForecast:                 alloraMath.NewDecFromInt64(3),            // fiducial value for rewards calculation
-		PRewardReputer:                  alloraMath.NewDecFromInt64(3),            // fiducial value for rewards calculation
-		CRewardInference:                alloraMath.MustNewDecFromString("0.75"),  // fiducial value for rewards calculation
-		CRewardForecast:                 alloraMath.MustNewDecFromString("0.75"),  // fiducial value for rewards calculation
-		CNorm:                           alloraMath.MustNewDecFromString("0.75"),  // fiducial value for inference synthesis
-		TopicFeeRevenueDecayRate:        alloraMath.MustNewDecFromString("0.025"), // rate at which topic fee revenue decays over time
-		MinEffectiveTopicRevenue:        alloraMath.MustNewDecFromString("0.01"),  // we no stop dripping from the topic's effective revenue when the topic's effective revenue is below this
+		Version:                          "0.0.3",                                  // version of the protocol should be in lockstep with github release tag version
+		MinTopicWeight:                   alloraMath.MustNewDecFromString("100"),   // total weight for a topic < this => don't run inference solicatation or loss update
+		MaxTopicsPerBlock:                uint64(128),                              // max number of topics to run cadence for per block
+		RequiredMinimumStake:             cosmosMath.NewInt(100),                   // minimum stake required to be a worker or reputer
+		RemoveStakeDelayWindow:           int64((60 * 60 * 24 * 7 * 3) / 5),        // ~approx 3 weeks assuming 5 second block time, number of blocks to wait before finalizing a stake withdrawal
+		MinEpochLength:                   12,                                       // shortest number of blocks per epoch topics are allowed to set as their cadence
+		BetaEntropy:                      alloraMath.MustNewDecFromString("0.25"),  // controls resilience of reward payouts against copycat workers
+		LearningRate:                     alloraMath.MustNewDecFromString("0.05"),  // speed of gradient descent
+		GradientDescentMaxIters:          uint64(10),                               // max iterations on gradient descent
+		MaxGradientThreshold:             alloraMath.MustNewDecFromString("0.001"), // gradient descent stops when gradient falls below this
+		MinStakeFraction:                 alloraMath.MustNewDecFromString("0.5"),   // minimum fraction of stake that should be listened to when setting consensus listening coefficients
+		EpsilonReputer:                   alloraMath.MustNewDecFromString("0.01"),  // a small tolerance quantity used to cap reputer scores at infinitesimally close proximities
+		MaxUnfulfilledWorkerRequests:     uint64(100),                              // maximum number of outstanding nonces for worker requests per topic from the chain; needs to be bigger to account for varying topic ground truth lag
+		MaxUnfulfilledReputerRequests:    uint64(100),                              // maximum number of outstanding nonces for reputer requests per topic from the chain; needs to be bigger to account for varying topic ground truth lag
+		TopicRewardStakeImportance:       alloraMath.MustNewDecFromString("0.5"),   // importance of stake in determining rewards for a topic
+		TopicRewardFeeRevenueImportance:  alloraMath.MustNewDecFromString("0.5"),   // importance of fee revenue in determining rewards for a topic
+		TopicRewardAlpha:                 alloraMath.MustNewDecFromString("0.5"),   // alpha for topic reward calculation; coupled with blocktime, or how often rewards are calculated
+		TaskRewardAlpha:                  alloraMath.MustNewDecFromString("0.1"),   // alpha for task reward calculation used to calculate  ~U_ij, ~V_ik, ~W_im
+		ValidatorsVsAlloraPercentReward:  alloraMath.MustNewDecFromString("0.25"),  // 25% rewards go to cosmos network validators
+		MaxSamplesToScaleScores:          uint64(10),                               // maximum number of previous scores to store and use for standard deviation calculation
+		MaxTopInferersToReward:           uint64(48),                               // max this many top inferers by score are rewarded for a topic
+		MaxTopForecastersToReward:        uint64(6),                                // max this many top forecasters by score are rewarded for a topic
+		MaxTopReputersToReward:           uint64(12),                               // max this many top reputers by score are rewarded for a topic
+		CreateTopicFee:                   cosmosMath.NewInt(10),                    // topic registration fee
+		MaxRetriesToFulfilNoncesWorker:   int64(1),                                 // max throttle of simultaneous unfulfilled worker requests
+		MaxRetriesToFulfilNoncesReputer:  int64(3),                                 // max throttle of simultaneous unfulfilled reputer requests
+		RegistrationFee:                  cosmosMath.NewInt(10),                    // how much workers and reputers must pay to register per topic
+		DefaultPageLimit:                 uint64(100),                              // how many topics to return per page during churn of requests
+		MaxPageLimit:                     uint64(1000),                             // max limit for pagination
+		MinEpochLengthRecordLimit:        int64(3),                                 // minimum number of epochs to keep records for a topic
+		MaxSerializedMsgLength:           int64(1000 * 1000),                       // maximum size of data to msg and query server in bytes
+		BlocksPerMonth:                   uint64(525960),                           // ~5 seconds block time, 6311520 per year, 525960 per month
+		PRewardInference:                 alloraMath.NewDecFromInt64(1),            // fiducial value for rewards calculation
+		PRewardForecast:                  alloraMath.NewDecFromInt64(3),            // fiducial value for rewards calculation
+		PRewardReputer:                   alloraMath.NewDecFromInt64(3),            // fiducial value for rewards calculation
+		CRewardInference:                 alloraMath.MustNewDecFromString("0.75"),  // fiducial value for rewards calculation
+		CRewardForecast:                  alloraMath.MustNewDecFromString("0.75"),  // fiducial value for rewards calculation
+		CNorm:                            alloraMath.MustNewDecFromString("0.75"),  // fiducial value for inference synthesis
+		TopicFeeRevenueDecayRate:         alloraMath.MustNewDecFromString("0.025"), // rate at which topic fee revenue decays over time
+		MinEffectiveTopicRevenue:         alloraMath.MustNewDecFromString("0.01"),  // we no stop dripping from the topic's effective revenue when the topic's effective revenue is below this
+		TopicRevenueBurnFraction:         alloraMath.ZeroDec(),                     // fraction of incoming topic revenue that is burned instead of recycled as emissions
+		MinParamChangeDelay:              int64(17_280),                            // params changes wait at least about a day of five second blocks, zero applies them immediately
+		MaxOpenInferenceRequestsPerTopic: uint64(10),                               // max number of ad-hoc inference requests open at once in a topic, zero disables them
+		MaxStakeRemovalGasPerBlock:       uint64(10_000_000),                       // gas spent on due stake removals per block, the rest carries over
+		MaxRewardGasPerBlock:             uint64(100_000_000),                      // gas spent on paying out topic rewards per block, the rest carries over
+		MaxPruningGasPerBlock:            uint64(10_000_000),                       // gas spent on pruning rewarded records per block, the rest carries over
+		MaxInferenceDimension:            uint64(64),                               // max length of the inferences of vector topics
+		ProbabilitySumTolerance:          alloraMath.MustNewDecFromString("0.001"), // how far the probabilities of an inference of a categorical topic may sum away from one
+		MaxRegretHistoryLength:           uint64(0),                                // number of past regrets kept per worker and role, zero stops recording but keeps the histories so far
+		LegacyBundleSignaturesEndBlock:   int64(0),                                 // last block at which bundles signed without a sign doc are accepted
+		PayloadSubmissionWindow:          int64(3),                                 // blocks after the first payload of a nonce until it closes, zero ranks bulk payloads on arrival
 	}
 }
 
@@ -175,6 +187,39 @@ func (p Params) Validate() error {
 	if err := validateMinEffectiveTopicRevenue(p.MinEffectiveTopicRevenue); err != nil {
 		return err
 	}
+	if err := validateTopicRevenueBurnFraction(p.TopicRevenueBurnFraction); err != nil {
+		return err
+	}
+	if err := validateMinParamChangeDelay(p.MinParamChangeDelay); err != nil {
+		return err
+	}
+	if err := validateMaxOpenInferenceRequestsPerTopic(p.MaxOpenInferenceRequestsPerTopic); err != nil {
+		return err
+	}
+	if err := validateMaxWorkGasPerBlock(p.MaxStakeRemovalGasPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxWorkGasPerBlock(p.MaxRewardGasPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxWorkGasPerBlock(p.MaxPruningGasPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxInferenceDimension(p.MaxInferenceDimension); err != nil {
+		return err
+	}
+	if err := validateProbabilitySumTolerance(p.ProbabilitySumTolerance); err != nil {
+		return err
+	}
+	if err := validateMaxRegretHistoryLength(p.MaxRegretHistoryLength); err != nil {
+		return err
+	}
+	if err := validateLegacyBundleSignaturesEndBlock(p.LegacyBundleSignaturesEndBlock); err != nil {
+		return err
+	}
+	if err := validatePayloadSubmissionWindow(p.PayloadSubmissionWindow, p.MinEpochLength); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -519,6 +564,92 @@ func validateMinEffectiveTopicRevenue(i alloraMath.Dec) error {
 	return nil
 }
 
+// Fraction of incoming topic revenue that is burned rather than recycled as emissions.
+// Should be a value between 0 and 1.
+func validateTopicRevenueBurnFraction(i alloraMath.Dec) error {
+	if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Number of blocks a scheduled params change has to wait at least.
+// Should be >= 0.
+func validateMinParamChangeDelay(i BlockHeight) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max number of ad-hoc inference requests open at once in a topic.
+// Should be >= 0, zero disables the requests.
+func validateMaxOpenInferenceRequestsPerTopic(_ uint64) error {
+	return nil
+}
+
+// Gas the EndBlocker may spend on a queue of work per block.
+// Should be > 0. At least one item of a queue is processed every block regardless.
+func validateMaxWorkGasPerBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max length of the inferences of vector topics.
+// Should be > 0, a vector topic has at least one element.
+func validateMaxInferenceDimension(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Tolerance on the sum of the probabilities of categorical inferences.
+// Should be x in [0, 1)
+func validateProbabilitySumTolerance(i alloraMath.Dec) error {
+	if !isAlloraDecBetweenZeroAndOneInclusive(i) || i.Equal(alloraMath.OneDec()) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Upper bound of MaxRegretHistoryLength, as every worker may keep that many regrets in each role
+const MaxRegretHistoryLengthLimit = uint64(1000)
+
+// Number of past regrets kept per worker and role.
+// Should be x in [0, MaxRegretHistoryLengthLimit]. Zero stops recording regrets, the histories
+// recorded so far are kept until their workers are removed.
+func validateMaxRegretHistoryLength(i uint64) error {
+	if i > MaxRegretHistoryLengthLimit {
+		return errors.Wrapf(ErrValidationTooLarge, "max regret history length %d exceeds %d", i, MaxRegretHistoryLengthLimit)
+	}
+	return nil
+}
+
+// Last block at which worker and reputer bundles signed over their bare proto encoding are accepted.
+// Should be >= 0. Zero only accepts bundles signed over a BundleSignDoc.
+func validateLegacyBundleSignaturesEndBlock(i BlockHeight) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Blocks during which worker and reputer payloads of a nonce accumulate before they are ranked.
+// Should be x in [0, minEpochLength), so that a nonce closes before the next nonce of its topic opens.
+// Zero ranks bulk payloads as they arrive and direct payloads at the end of their block.
+func validatePayloadSubmissionWindow(i BlockHeight, minEpochLength BlockHeight) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	if i >= minEpochLength {
+		return errors.Wrapf(ErrValidationTooLarge, "payload submission window %d is not shorter than the min epoch length %d", i, minEpochLength)
+	}
+	return nil
+}
+
 // Whether an alloraDec is between the value of [0, 1] inclusive
 func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 	return a.Gte(alloraMath.ZeroDec()) && a.Lte(alloraMath.OneDec())
@@ -528,3 +659,169 @@ func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 func isAlloraDecBetweenZeroAndOneExclusive(a alloraMath.Dec) bool {
 	return a.Gt(alloraMath.ZeroDec()) && a.Lt(alloraMath.OneDec())
 }
+
+// Validate rejects updates of deprecated params, they are only kept in state
+// for migrations and must not be changed by governance.
+func (update *OptionalParams) Validate() error {
+	if len(update.ValidatorsVsAlloraPercentReward) > 0 {
+		return errors.Wrap(ErrDeprecatedParam, "validators_vs_allora_percent_reward")
+	}
+	return nil
+}
+
+// MergeOptionalParams returns the params with every option set in the update applied.
+// Every option is a repeated field, so we interpret an empty array as "make no change".
+// Deprecated params are never applied, see OptionalParams.Validate.
+func (p Params) MergeOptionalParams(update *OptionalParams) Params {
+	if len(update.Version) == 1 {
+		p.Version = update.Version[0]
+	}
+	if len(update.MinTopicWeight) == 1 {
+		p.MinTopicWeight = update.MinTopicWeight[0]
+	}
+	if len(update.MaxTopicsPerBlock) == 1 {
+		p.MaxTopicsPerBlock = update.MaxTopicsPerBlock[0]
+	}
+	if len(update.RequiredMinimumStake) == 1 {
+		p.RequiredMinimumStake = update.RequiredMinimumStake[0]
+	}
+	if len(update.RemoveStakeDelayWindow) == 1 {
+		p.RemoveStakeDelayWindow = update.RemoveStakeDelayWindow[0]
+	}
+	if len(update.MinEpochLength) == 1 {
+		p.MinEpochLength = update.MinEpochLength[0]
+	}
+	if len(update.BetaEntropy) == 1 {
+		p.BetaEntropy = update.BetaEntropy[0]
+	}
+	if len(update.LearningRate) == 1 {
+		p.LearningRate = update.LearningRate[0]
+	}
+	if len(update.MaxGradientThreshold) == 1 {
+		p.MaxGradientThreshold = update.MaxGradientThreshold[0]
+	}
+	if len(update.GradientDescentMaxIters) == 1 {
+		p.GradientDescentMaxIters = update.GradientDescentMaxIters[0]
+	}
+	if len(update.MinStakeFraction) == 1 {
+		p.MinStakeFraction = update.MinStakeFraction[0]
+	}
+	if len(update.MaxUnfulfilledWorkerRequests) == 1 {
+		p.MaxUnfulfilledWorkerRequests = update.MaxUnfulfilledWorkerRequests[0]
+	}
+	if len(update.MaxUnfulfilledReputerRequests) == 1 {
+		p.MaxUnfulfilledReputerRequests = update.MaxUnfulfilledReputerRequests[0]
+	}
+	if len(update.TopicRewardStakeImportance) == 1 {
+		p.TopicRewardStakeImportance = update.TopicRewardStakeImportance[0]
+	}
+	if len(update.TopicRewardFeeRevenueImportance) == 1 {
+		p.TopicRewardFeeRevenueImportance = update.TopicRewardFeeRevenueImportance[0]
+	}
+	if len(update.TopicRewardAlpha) == 1 {
+		p.TopicRewardAlpha = update.TopicRewardAlpha[0]
+	}
+	if len(update.TaskRewardAlpha) == 1 {
+		p.TaskRewardAlpha = update.TaskRewardAlpha[0]
+	}
+	if len(update.MaxSamplesToScaleScores) == 1 {
+		p.MaxSamplesToScaleScores = update.MaxSamplesToScaleScores[0]
+	}
+	if len(update.MaxTopInferersToReward) == 1 {
+		p.MaxTopInferersToReward = update.MaxTopInferersToReward[0]
+	}
+	if len(update.MaxTopForecastersToReward) == 1 {
+		p.MaxTopForecastersToReward = update.MaxTopForecastersToReward[0]
+	}
+	if len(update.MaxTopReputersToReward) == 1 {
+		p.MaxTopReputersToReward = update.MaxTopReputersToReward[0]
+	}
+	if len(update.CreateTopicFee) == 1 {
+		p.CreateTopicFee = update.CreateTopicFee[0]
+	}
+	if len(update.MaxRetriesToFulfilNoncesWorker) == 1 {
+		p.MaxRetriesToFulfilNoncesWorker = update.MaxRetriesToFulfilNoncesWorker[0]
+	}
+	if len(update.MaxRetriesToFulfilNoncesReputer) == 1 {
+		p.MaxRetriesToFulfilNoncesReputer = update.MaxRetriesToFulfilNoncesReputer[0]
+	}
+	if len(update.RegistrationFee) == 1 {
+		p.RegistrationFee = update.RegistrationFee[0]
+	}
+	if len(update.DefaultPageLimit) == 1 {
+		p.DefaultPageLimit = update.DefaultPageLimit[0]
+	}
+	if len(update.MaxPageLimit) == 1 {
+		p.MaxPageLimit = update.MaxPageLimit[0]
+	}
+	if len(update.MinEpochLengthRecordLimit) == 1 {
+		p.MinEpochLengthRecordLimit = update.MinEpochLengthRecordLimit[0]
+	}
+	if len(update.MaxSerializedMsgLength) == 1 {
+		p.MaxSerializedMsgLength = update.MaxSerializedMsgLength[0]
+	}
+	if len(update.BlocksPerMonth) == 1 {
+		p.BlocksPerMonth = update.BlocksPerMonth[0]
+	}
+	if len(update.PRewardInference) == 1 {
+		p.PRewardInference = update.PRewardInference[0]
+	}
+	if len(update.PRewardForecast) == 1 {
+		p.PRewardForecast = update.PRewardForecast[0]
+	}
+	if len(update.PRewardReputer) == 1 {
+		p.PRewardReputer = update.PRewardReputer[0]
+	}
+	if len(update.CRewardInference) == 1 {
+		p.CRewardInference = update.CRewardInference[0]
+	}
+	if len(update.CRewardForecast) == 1 {
+		p.CRewardForecast = update.CRewardForecast[0]
+	}
+	if len(update.CNorm) == 1 {
+		p.CNorm = update.CNorm[0]
+	}
+	if len(update.TopicFeeRevenueDecayRate) == 1 {
+		p.TopicFeeRevenueDecayRate = update.TopicFeeRevenueDecayRate[0]
+	}
+	if len(update.EpsilonReputer) == 1 {
+		p.EpsilonReputer = update.EpsilonReputer[0]
+	}
+	if len(update.MinEffectiveTopicRevenue) == 1 {
+		p.MinEffectiveTopicRevenue = update.MinEffectiveTopicRevenue[0]
+	}
+	if len(update.TopicRevenueBurnFraction) == 1 {
+		p.TopicRevenueBurnFraction = update.TopicRevenueBurnFraction[0]
+	}
+	if len(update.MinParamChangeDelay) == 1 {
+		p.MinParamChangeDelay = update.MinParamChangeDelay[0]
+	}
+	if len(update.MaxOpenInferenceRequestsPerTopic) == 1 {
+		p.MaxOpenInferenceRequestsPerTopic = update.MaxOpenInferenceRequestsPerTopic[0]
+	}
+	if len(update.MaxStakeRemovalGasPerBlock) == 1 {
+		p.MaxStakeRemovalGasPerBlock = update.MaxStakeRemovalGasPerBlock[0]
+	}
+	if len(update.MaxRewardGasPerBlock) == 1 {
+		p.MaxRewardGasPerBlock = update.MaxRewardGasPerBlock[0]
+	}
+	if len(update.MaxPruningGasPerBlock) == 1 {
+		p.MaxPruningGasPerBlock = update.MaxPruningGasPerBlock[0]
+	}
+	if len(update.MaxInferenceDimension) == 1 {
+		p.MaxInferenceDimension = update.MaxInferenceDimension[0]
+	}
+	if len(update.ProbabilitySumTolerance) == 1 {
+		p.ProbabilitySumTolerance = update.ProbabilitySumTolerance[0]
+	}
+	if len(update.MaxRegretHistoryLength) == 1 {
+		p.MaxRegretHistoryLength = update.MaxRegretHistoryLength[0]
+	}
+	if len(update.LegacyBundleSignaturesEndBlock) == 1 {
+		p.LegacyBundleSignaturesEndBlock = update.LegacyBundleSignaturesEndBlock[0]
+	}
+	if len(update.PayloadSubmissionWindow) == 1 {
+		p.PayloadSubmissionWindow = update.PayloadSubmissionWindow[0]
+	}
+	return p
+}
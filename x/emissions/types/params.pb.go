@@ -51,8 +51,10 @@ type Params struct {
 	// 0.5
 	TopicRewardAlpha github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,17,opt,name=topic_reward_alpha,json=topicRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_reward_alpha"`
 	// updates
-	TaskRewardAlpha                 github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,18,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"task_reward_alpha"`
-	ValidatorsVsAlloraPercentReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,opt,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"validators_vs_allora_percent_reward"`
+	TaskRewardAlpha github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,18,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"task_reward_alpha"`
+	// Deprecated: the block emission is split by the mint emission recipients, this is
+	// only read by the mint migration that seeds them and can no longer be updated
+	ValidatorsVsAlloraPercentReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,opt,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"validators_vs_allora_percent_reward"` // Deprecated: Do not use.
 	MaxSamplesToScaleScores         uint64                                          `protobuf:"varint,20,opt,name=max_samples_to_scale_scores,json=maxSamplesToScaleScores,proto3" json:"max_samples_to_scale_scores,omitempty"`
 	MaxTopInferersToReward          uint64                                          `protobuf:"varint,21,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
 	MaxTopForecastersToReward       uint64                                          `protobuf:"varint,22,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
@@ -77,6 +79,18 @@ type Params struct {
 	TopicFeeRevenueDecayRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,39,opt,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_fee_revenue_decay_rate"`
 	EpsilonReputer           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,opt,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
 	MinEffectiveTopicRevenue github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,41,opt,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"min_effective_topic_revenue"`
+	TopicRevenueBurnFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,42,opt,name=topic_revenue_burn_fraction,json=topicRevenueBurnFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_revenue_burn_fraction"`
+	MinParamChangeDelay      int64                                           `protobuf:"varint,43,opt,name=min_param_change_delay,json=minParamChangeDelay,proto3" json:"min_param_change_delay,omitempty"`
+	// Immediate params updates are only allowed while this is zero
+	MaxOpenInferenceRequestsPerTopic uint64                                          `protobuf:"varint,44,opt,name=max_open_inference_requests_per_topic,json=maxOpenInferenceRequestsPerTopic,proto3" json:"max_open_inference_requests_per_topic,omitempty"`
+	MaxStakeRemovalGasPerBlock       uint64                                          `protobuf:"varint,45,opt,name=max_stake_removal_gas_per_block,json=maxStakeRemovalGasPerBlock,proto3" json:"max_stake_removal_gas_per_block,omitempty"`
+	MaxRewardGasPerBlock             uint64                                          `protobuf:"varint,46,opt,name=max_reward_gas_per_block,json=maxRewardGasPerBlock,proto3" json:"max_reward_gas_per_block,omitempty"`
+	MaxPruningGasPerBlock            uint64                                          `protobuf:"varint,47,opt,name=max_pruning_gas_per_block,json=maxPruningGasPerBlock,proto3" json:"max_pruning_gas_per_block,omitempty"`
+	MaxInferenceDimension            uint64                                          `protobuf:"varint,48,opt,name=max_inference_dimension,json=maxInferenceDimension,proto3" json:"max_inference_dimension,omitempty"`
+	ProbabilitySumTolerance          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,opt,name=probability_sum_tolerance,json=probabilitySumTolerance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"probability_sum_tolerance"`
+	MaxRegretHistoryLength           uint64                                          `protobuf:"varint,50,opt,name=max_regret_history_length,json=maxRegretHistoryLength,proto3" json:"max_regret_history_length,omitempty"`
+	LegacyBundleSignaturesEndBlock   int64                                           `protobuf:"varint,51,opt,name=legacy_bundle_signatures_end_block,json=legacyBundleSignaturesEndBlock,proto3" json:"legacy_bundle_signatures_end_block,omitempty"`
+	PayloadSubmissionWindow          int64                                           `protobuf:"varint,52,opt,name=payload_submission_window,json=payloadSubmissionWindow,proto3" json:"payload_submission_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -238,91 +252,258 @@ func (m *Params) GetBlocksPerMonth() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinParamChangeDelay() int64 {
+	if m != nil {
+		return m.MinParamChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOpenInferenceRequestsPerTopic() uint64 {
+	if m != nil {
+		return m.MaxOpenInferenceRequestsPerTopic
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakeRemovalGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxStakeRemovalGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRewardGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRewardGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPruningGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPruningGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInferenceDimension() uint64 {
+	if m != nil {
+		return m.MaxInferenceDimension
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRegretHistoryLength() uint64 {
+	if m != nil {
+		return m.MaxRegretHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetLegacyBundleSignaturesEndBlock() int64 {
+	if m != nil {
+		return m.LegacyBundleSignaturesEndBlock
+	}
+	return 0
+}
+
+func (m *Params) GetPayloadSubmissionWindow() int64 {
+	if m != nil {
+		return m.PayloadSubmissionWindow
+	}
+	return 0
+}
+
+// A params change waiting for its block
+type ScheduledParamsChange struct {
+	Id               uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer         string          `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Params           *OptionalParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	ScheduledAtBlock int64           `protobuf:"varint,4,opt,name=scheduled_at_block,json=scheduledAtBlock,proto3" json:"scheduled_at_block,omitempty"`
+	ApplyAtBlock     int64           `protobuf:"varint,5,opt,name=apply_at_block,json=applyAtBlock,proto3" json:"apply_at_block,omitempty"`
+}
+
+func (m *ScheduledParamsChange) Reset()         { *m = ScheduledParamsChange{} }
+func (m *ScheduledParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsChange) ProtoMessage()    {}
+func (*ScheduledParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f535e53c00d44458, []int{1}
+}
+func (m *ScheduledParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsChange.Merge(m, src)
+}
+func (m *ScheduledParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsChange proto.InternalMessageInfo
+
+func (m *ScheduledParamsChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledParamsChange) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ScheduledParamsChange) GetParams() *OptionalParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *ScheduledParamsChange) GetScheduledAtBlock() int64 {
+	if m != nil {
+		return m.ScheduledAtBlock
+	}
+	return 0
+}
+
+func (m *ScheduledParamsChange) GetApplyAtBlock() int64 {
+	if m != nil {
+		return m.ApplyAtBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v1.Params")
+	proto.RegisterType((*ScheduledParamsChange)(nil), "emissions.v1.ScheduledParamsChange")
 }
 
 func init() { proto.RegisterFile("emissions/v1/params.proto", fileDescriptor_f535e53c00d44458) }
 
 var fileDescriptor_f535e53c00d44458 = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0x5c, 0x35,
-	0x14, 0xcd, 0xd0, 0x36, 0xa5, 0x6e, 0x9a, 0x0f, 0x93, 0xa6, 0xce, 0xb4, 0x99, 0x84, 0xb6, 0x40,
-	0x40, 0x34, 0x43, 0xc5, 0x82, 0xcf, 0x05, 0xad, 0xd2, 0x54, 0x11, 0x4d, 0x15, 0xbd, 0x04, 0x2a,
-	0x15, 0x24, 0xe3, 0xf8, 0xdd, 0x99, 0xb1, 0xf2, 0x6c, 0x3f, 0x6c, 0xcf, 0x64, 0xd2, 0x3d, 0x7b,
-	0x7e, 0x01, 0x6b, 0x96, 0x2c, 0xf8, 0x11, 0x5d, 0x56, 0xac, 0x10, 0x8b, 0x0a, 0xb5, 0x0b, 0xfe,
-	0x06, 0xf2, 0xc7, 0x9b, 0xcc, 0xb4, 0x80, 0x50, 0xdf, 0x26, 0xca, 0xf3, 0x3d, 0x3e, 0xe7, 0xfa,
-	0xd8, 0xbe, 0xd7, 0x83, 0x96, 0x41, 0x0a, 0x6b, 0x85, 0x56, 0xb6, 0x3d, 0xb8, 0xd9, 0x2e, 0x99,
-	0x61, 0xd2, 0x6e, 0x94, 0x46, 0x3b, 0x8d, 0x67, 0x46, 0xa1, 0x8d, 0xc1, 0xcd, 0xe6, 0x32, 0xd7,
-	0x56, 0x6a, 0x4b, 0x43, 0xac, 0x1d, 0x3f, 0x22, 0xb0, 0xb9, 0xc0, 0xa4, 0x50, 0xba, 0x1d, 0xfe,
-	0xa6, 0xa1, 0xc5, 0xae, 0xee, 0xea, 0x08, 0xf5, 0xff, 0xc5, 0xd1, 0xab, 0x3f, 0x35, 0xd1, 0xf4,
-	0x6e, 0x90, 0xc0, 0x04, 0x9d, 0x1d, 0x80, 0xf1, 0xec, 0xa4, 0xb1, 0xd6, 0x58, 0x3f, 0x97, 0x55,
-	0x9f, 0xf8, 0x13, 0xb4, 0x2c, 0xd9, 0x90, 0x5a, 0x30, 0x82, 0x15, 0xe2, 0x11, 0xe4, 0x54, 0xda,
-	0x2e, 0x2d, 0x40, 0x75, 0x5d, 0x8f, 0xbc, 0xb6, 0xd6, 0x58, 0x3f, 0x95, 0x2d, 0x49, 0x36, 0xdc,
-	0x1b, 0xc5, 0x77, 0x6c, 0xf7, 0x5e, 0x88, 0x62, 0x86, 0xe6, 0xa5, 0x50, 0xd4, 0xe9, 0x52, 0x70,
-	0x7a, 0x04, 0xa2, 0xdb, 0x73, 0xe4, 0x94, 0x67, 0xbf, 0xfd, 0xd1, 0xe3, 0xa7, 0xab, 0x53, 0x7f,
-	0x3c, 0x5d, 0x6d, 0x77, 0x85, 0xeb, 0xf5, 0x0f, 0x36, 0xb8, 0x96, 0x6d, 0x56, 0x14, 0xda, 0xb0,
-	0x1b, 0x0a, 0xdc, 0x91, 0x36, 0x87, 0xd5, 0x27, 0xef, 0x31, 0xa1, 0xda, 0x92, 0xb9, 0xde, 0xc6,
-	0x26, 0xf0, 0x6c, 0x56, 0x0a, 0xb5, 0xef, 0xf9, 0x1e, 0x04, 0x3a, 0xdc, 0x46, 0x8b, 0x3e, 0xbb,
-	0x20, 0x61, 0x69, 0x09, 0x86, 0x1e, 0x14, 0x9a, 0x1f, 0x92, 0xd3, 0x6b, 0x8d, 0xf5, 0xd3, 0xd9,
-	0x82, 0x64, 0xc3, 0x80, 0xb6, 0xbb, 0x60, 0x6e, 0xfb, 0x00, 0xee, 0xa0, 0x25, 0x03, 0xdf, 0xf7,
-	0x85, 0xf1, 0x0b, 0x11, 0x4a, 0xc8, 0xbe, 0xa4, 0xd6, 0xb1, 0x43, 0x20, 0x67, 0x42, 0x66, 0x1f,
-	0xa4, 0xcc, 0x2e, 0x46, 0x4b, 0x6d, 0x7e, 0xb8, 0x21, 0x74, 0xd4, 0xdf, 0x56, 0xee, 0xb7, 0x5f,
-	0x6f, 0xa0, 0xe4, 0xf5, 0xb6, 0x72, 0x3f, 0xff, 0xf5, 0xcb, 0x7b, 0x8d, 0x6c, 0xb1, 0xe2, 0xdb,
-	0x89, 0x74, 0x7b, 0x9e, 0xcd, 0xdb, 0x66, 0x40, 0xea, 0x01, 0x44, 0x76, 0x9a, 0x43, 0xc1, 0x8e,
-	0xe9, 0x91, 0x50, 0xb9, 0x3e, 0x22, 0xd3, 0xd1, 0xb6, 0x08, 0x08, 0xf8, 0x4d, 0x1f, 0x7e, 0x10,
-	0xa2, 0x78, 0x3d, 0xda, 0x06, 0xa5, 0xe6, 0xbd, 0xca, 0xe8, 0xb3, 0x61, 0x86, 0x5f, 0xfd, 0x1d,
-	0x3f, 0x9c, 0x0c, 0x7e, 0x88, 0x66, 0x0e, 0xc0, 0x31, 0x0a, 0xca, 0x19, 0x5d, 0x1e, 0x93, 0xd7,
-	0xeb, 0x99, 0x7b, 0xde, 0x93, 0xdd, 0x89, 0x5c, 0xf8, 0x5b, 0x74, 0xa1, 0x00, 0x66, 0x94, 0x50,
-	0x5d, 0x6a, 0x98, 0x03, 0x72, 0xae, 0x1e, 0xf9, 0x4c, 0xc5, 0x96, 0x31, 0x07, 0x58, 0x22, 0x7f,
-	0x68, 0x68, 0xd7, 0xb0, 0x5c, 0x80, 0x72, 0xd4, 0xf5, 0x0c, 0xd8, 0x9e, 0x2e, 0x72, 0x82, 0xea,
-	0xc9, 0xf8, 0xe3, 0x70, 0x37, 0xb1, 0xee, 0x57, 0xa4, 0x18, 0x10, 0xf6, 0x96, 0xc6, 0xad, 0xe8,
-	0x18, 0xc6, 0x9d, 0x3f, 0xe9, 0xe7, 0xeb, 0x49, 0xf9, 0x5d, 0x0a, 0x9b, 0xb7, 0x95, 0x08, 0xf1,
-	0x1d, 0xb4, 0xea, 0x57, 0xd5, 0x57, 0x9d, 0x7e, 0xd1, 0x11, 0x45, 0x01, 0x39, 0xf5, 0xf3, 0xc1,
-	0x50, 0x7f, 0x46, 0xc0, 0x3a, 0x4b, 0x2e, 0x84, 0x83, 0x79, 0x45, 0xb2, 0xe1, 0x57, 0x27, 0xa8,
-	0x07, 0x01, 0x94, 0x25, 0x0c, 0xbe, 0x8b, 0xd6, 0x5e, 0xa4, 0x31, 0x50, 0xf6, 0xdd, 0x38, 0xcf,
-	0x6c, 0xe0, 0x59, 0x99, 0xe4, 0xc9, 0x22, 0x6a, 0x44, 0xf4, 0x08, 0xad, 0xc4, 0xcb, 0x67, 0xe0,
-	0x88, 0x99, 0x3c, 0xad, 0x5f, 0xc8, 0x52, 0x1b, 0xc7, 0x14, 0x07, 0x32, 0x57, 0xcf, 0x81, 0x66,
-	0x60, 0xcf, 0x02, 0x79, 0x70, 0x62, 0x7b, 0x44, 0x8d, 0x7f, 0x68, 0xa0, 0x6b, 0x13, 0xe2, 0x1d,
-	0x00, 0x6a, 0x60, 0x00, 0xaa, 0x3f, 0x91, 0xc2, 0x7c, 0xbd, 0x14, 0x56, 0xc7, 0x52, 0xd8, 0x02,
-	0xc8, 0xa2, 0xc0, 0x58, 0x1e, 0x80, 0xf0, 0x44, 0x1a, 0xac, 0x28, 0x7b, 0x8c, 0x2c, 0xd4, 0xdc,
-	0xfa, 0x31, 0xd5, 0x5b, 0x9e, 0x10, 0x73, 0xb4, 0xe0, 0x98, 0x3d, 0x9c, 0x54, 0xc1, 0xf5, 0x54,
-	0xe6, 0x3c, 0xe3, 0xb8, 0x88, 0xf7, 0x74, 0xc0, 0x0a, 0x91, 0x33, 0xa7, 0x8d, 0xa5, 0x03, 0x4b,
-	0xe3, 0x44, 0x5f, 0xf8, 0xb8, 0xbf, 0x46, 0x51, 0x9d, 0xbc, 0x51, 0xd3, 0xd3, 0x13, 0x8d, 0xaf,
-	0xed, 0xad, 0x00, 0xd9, 0x8d, 0x02, 0x31, 0x19, 0xfc, 0x39, 0xba, 0x1c, 0x7a, 0x02, 0x93, 0x65,
-	0x01, 0x96, 0x3a, 0x4d, 0x2d, 0x67, 0x05, 0x50, 0xcb, 0xb5, 0x01, 0x4b, 0x16, 0xc3, 0xd9, 0xbc,
-	0xe4, 0xbb, 0x42, 0x44, 0xec, 0xeb, 0x3d, 0x1f, 0xdf, 0x0b, 0x61, 0xfc, 0x29, 0x6a, 0xa6, 0x9a,
-	0x4d, 0x85, 0xea, 0x80, 0x01, 0x13, 0x28, 0x52, 0xee, 0x17, 0xc3, 0xe4, 0xa5, 0x58, 0xb9, 0xb7,
-	0x53, 0x7c, 0x5f, 0x27, 0xe5, 0x2f, 0xd0, 0x4a, 0x35, 0xb7, 0xa3, 0x0d, 0x70, 0x66, 0xdd, 0xe4,
-	0xf4, 0xa5, 0x30, 0x7d, 0x39, 0x4e, 0xdf, 0x3a, 0x81, 0x8c, 0x18, 0xc6, 0xd4, 0xd3, 0xa5, 0x1a,
-	0x9f, 0x7e, 0x69, 0x5c, 0x3d, 0x5d, 0xa7, 0x93, 0xb9, 0x0f, 0xd1, 0x3c, 0x37, 0xc0, 0x1c, 0xa4,
-	0x9e, 0xd6, 0x01, 0x20, 0xe4, 0x15, 0xdb, 0xc6, 0x6c, 0x64, 0x0a, 0xed, 0x69, 0x0b, 0x00, 0x7f,
-	0x86, 0x9a, 0xa3, 0x6a, 0x98, 0x83, 0x0d, 0xdb, 0xe9, 0x13, 0x15, 0x3e, 0x03, 0xb2, 0x1c, 0x2d,
-	0xad, 0x10, 0x9b, 0x11, 0xb0, 0xc3, 0x86, 0xdb, 0x3e, 0x8c, 0xbf, 0x44, 0xd7, 0x3c, 0xd6, 0x80,
-	0x33, 0x22, 0x6e, 0x48, 0xac, 0x09, 0x54, 0x69, 0xc5, 0xc1, 0xa6, 0x2a, 0x44, 0x9a, 0xa1, 0x8b,
-	0xb4, 0x24, 0x1b, 0x66, 0x11, 0xb9, 0xaf, 0xb7, 0x02, 0xee, 0x7e, 0x80, 0xc5, 0x32, 0x84, 0x77,
-	0xd0, 0xf5, 0xff, 0x24, 0x4b, 0xb6, 0x91, 0xcb, 0x81, 0x6d, 0xf5, 0xdf, 0xd8, 0x92, 0x7b, 0xf8,
-	0x1b, 0x34, 0x6f, 0xa0, 0x2b, 0xac, 0x33, 0xcc, 0x17, 0xc9, 0x60, 0xda, 0x95, 0x57, 0x34, 0x6d,
-	0x6e, 0x9c, 0xc9, 0xbb, 0xf6, 0x3e, 0xc2, 0x39, 0x74, 0x58, 0xbf, 0x70, 0xb4, 0x64, 0x5d, 0xa0,
-	0x85, 0x90, 0xc2, 0x91, 0x95, 0xe0, 0xd6, 0x7c, 0x8a, 0xec, 0xb2, 0x2e, 0xdc, 0xf3, 0xe3, 0xf8,
-	0x3a, 0x9a, 0xf5, 0x2b, 0x1b, 0x43, 0xb6, 0x02, 0x72, 0x46, 0xb2, 0xe1, 0x09, 0xca, 0x9f, 0xb1,
-	0x17, 0xfa, 0x2f, 0x35, 0xc0, 0xb5, 0xc9, 0xd3, 0xa4, 0xd5, 0xb0, 0xf0, 0xe5, 0xc9, 0x66, 0x9c,
-	0x05, 0x44, 0x64, 0x58, 0x47, 0xf3, 0xe1, 0x19, 0x12, 0x5f, 0x24, 0x52, 0x2b, 0xd7, 0x23, 0x6b,
-	0x41, 0x69, 0x36, 0x8e, 0xef, 0x82, 0xd9, 0xf1, 0xa3, 0xbe, 0x3a, 0x95, 0x55, 0xcd, 0x88, 0x97,
-	0xc1, 0xd7, 0xc4, 0x37, 0x6b, 0x56, 0xa7, 0x32, 0x9e, 0xd7, 0xed, 0x8a, 0xd0, 0x57, 0xa7, 0x91,
-	0x4c, 0x75, 0x6f, 0xc8, 0xd5, 0x9a, 0xd5, 0x29, 0xa9, 0x54, 0x97, 0xcc, 0x3f, 0xf7, 0x46, 0x22,
-	0xd5, 0x19, 0xb9, 0x56, 0xf3, 0xb9, 0x97, 0x34, 0xaa, 0xb3, 0x04, 0x08, 0xf3, 0x97, 0xed, 0xba,
-	0x5e, 0xd3, 0x2e, 0xfe, 0x0f, 0x76, 0xf1, 0x97, 0xec, 0x7a, 0xab, 0xa6, 0x5d, 0xfc, 0x05, 0xbb,
-	0xee, 0xa3, 0x69, 0x4e, 0x95, 0x36, 0x92, 0xbc, 0x5d, 0x8f, 0xf9, 0x0c, 0xbf, 0xaf, 0x8d, 0xc4,
-	0x47, 0xe8, 0xca, 0xa8, 0x2a, 0x8d, 0x1a, 0x6d, 0x0e, 0x9c, 0x1d, 0xc7, 0xf7, 0xdb, 0x3b, 0xf5,
-	0x54, 0x88, 0x4b, 0x95, 0x2a, 0xb5, 0xd8, 0x4d, 0xcf, 0x1c, 0xde, 0x72, 0xdf, 0xa1, 0x39, 0x28,
-	0xad, 0x28, 0xb4, 0x1a, 0x6d, 0xfb, 0x7a, 0xcd, 0x6d, 0x4f, 0x7c, 0xd5, 0xb6, 0x0f, 0xd0, 0xe5,
-	0x70, 0x23, 0x3b, 0x1d, 0xe0, 0x4e, 0x0c, 0xaa, 0xf2, 0x9b, 0x16, 0x49, 0xde, 0xad, 0xb9, 0x32,
-	0x7f, 0x91, 0x2b, 0xea, 0xfd, 0xd8, 0xd8, 0x03, 0xf1, 0xed, 0xec, 0xf1, 0xb3, 0x56, 0xe3, 0xc9,
-	0xb3, 0x56, 0xe3, 0xcf, 0x67, 0xad, 0xc6, 0x8f, 0xcf, 0x5b, 0x53, 0x4f, 0x9e, 0xb7, 0xa6, 0x7e,
-	0x7f, 0xde, 0x9a, 0x7a, 0xf8, 0xf1, 0xff, 0x14, 0x19, 0xb6, 0x4f, 0x7e, 0xd0, 0xb9, 0xe3, 0x12,
-	0xec, 0xc1, 0x74, 0xf8, 0xed, 0xf5, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x37, 0xa3, 0x34,
-	0xa5, 0xea, 0x0d, 0x00, 0x00,
+	// 1646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1c, 0xb7,
+	0x15, 0xf7, 0xfa, 0x8f, 0x12, 0xd3, 0x8a, 0x2c, 0x33, 0x92, 0x3c, 0x92, 0x6d, 0x49, 0xb5, 0x9d,
+	0x56, 0x4d, 0x63, 0x6d, 0x1c, 0x07, 0x6d, 0x92, 0xf6, 0x50, 0x2b, 0xb2, 0x5c, 0xb5, 0x91, 0x2d,
+	0xcc, 0xaa, 0x35, 0xe0, 0x16, 0x60, 0xb9, 0x33, 0x6f, 0x67, 0x09, 0xcd, 0x90, 0x53, 0x92, 0xb3,
+	0xda, 0xcd, 0xbd, 0xe8, 0xb5, 0x1f, 0xa3, 0xc7, 0x1e, 0xfa, 0x15, 0x0a, 0xe4, 0x52, 0x20, 0xe8,
+	0xa9, 0xe8, 0x21, 0x28, 0xec, 0x43, 0xbf, 0x46, 0xc1, 0x47, 0xce, 0xec, 0xae, 0xdd, 0x16, 0x85,
+	0xa7, 0x17, 0x41, 0x3b, 0xef, 0xf7, 0x7e, 0x3f, 0xf2, 0x91, 0x7c, 0x7c, 0x8f, 0x64, 0x1d, 0x0a,
+	0x61, 0x8c, 0x50, 0xd2, 0x74, 0x47, 0xf7, 0xbb, 0x25, 0xd7, 0xbc, 0x30, 0xbb, 0xa5, 0x56, 0x56,
+	0xd1, 0xc5, 0xc6, 0xb4, 0x3b, 0xba, 0xbf, 0xb1, 0x9e, 0x28, 0x53, 0x28, 0xc3, 0xd0, 0xd6, 0xf5,
+	0x3f, 0x3c, 0x70, 0xe3, 0x1a, 0x2f, 0x84, 0x54, 0x5d, 0xfc, 0x1b, 0x3e, 0xad, 0x64, 0x2a, 0x53,
+	0x1e, 0xea, 0xfe, 0x0b, 0x5f, 0x57, 0xe7, 0xc4, 0xec, 0xd8, 0x7f, 0xbe, 0xfd, 0xe7, 0x6d, 0xb2,
+	0x70, 0x8c, 0xca, 0x34, 0x22, 0x6f, 0x8d, 0x40, 0x3b, 0x48, 0xd4, 0xd9, 0xee, 0xec, 0x5c, 0x8e,
+	0xeb, 0x9f, 0xf4, 0x53, 0xb2, 0x5e, 0xf0, 0x31, 0x33, 0xa0, 0x05, 0xcf, 0xc5, 0x97, 0x90, 0xb2,
+	0xc2, 0x64, 0x2c, 0x07, 0x99, 0xd9, 0x61, 0x74, 0x7e, 0xbb, 0xb3, 0x73, 0x21, 0x5e, 0x2b, 0xf8,
+	0xb8, 0xd7, 0xd8, 0x8f, 0x4c, 0xf6, 0x05, 0x5a, 0x29, 0x27, 0xcb, 0x85, 0x90, 0xcc, 0xaa, 0x52,
+	0x24, 0xec, 0x0c, 0x44, 0x36, 0xb4, 0xd1, 0x05, 0xc7, 0xbe, 0xf7, 0x83, 0xaf, 0xbe, 0xd9, 0x3a,
+	0xf7, 0xf7, 0x6f, 0xb6, 0xba, 0x99, 0xb0, 0xc3, 0xaa, 0xbf, 0x9b, 0xa8, 0xa2, 0xcb, 0xf3, 0x5c,
+	0x69, 0x7e, 0x4f, 0x82, 0x3d, 0x53, 0xfa, 0xb4, 0xfe, 0x99, 0x0c, 0xb9, 0x90, 0xdd, 0x82, 0xdb,
+	0xe1, 0xee, 0x3e, 0x24, 0xf1, 0x52, 0x21, 0xe4, 0x89, 0xe3, 0x7b, 0x86, 0x74, 0xb4, 0x4b, 0x56,
+	0xdc, 0xe8, 0x50, 0xc2, 0xb0, 0x12, 0x34, 0xeb, 0xe7, 0x2a, 0x39, 0x8d, 0x2e, 0x6e, 0x77, 0x76,
+	0x2e, 0xc6, 0xd7, 0x0a, 0x3e, 0x46, 0xb4, 0x39, 0x06, 0xbd, 0xe7, 0x0c, 0x74, 0x40, 0xd6, 0x34,
+	0xfc, 0xa6, 0x12, 0xda, 0x4d, 0x44, 0x48, 0x51, 0x54, 0x05, 0x33, 0x96, 0x9f, 0x42, 0x74, 0x09,
+	0x47, 0xf6, 0x61, 0x18, 0xd9, 0xaa, 0x8f, 0xb4, 0x49, 0x4f, 0x77, 0x85, 0xf2, 0xfa, 0x87, 0xd2,
+	0xfe, 0xf5, 0x4f, 0xf7, 0x48, 0x58, 0x82, 0x43, 0x69, 0xff, 0xf0, 0xcf, 0x3f, 0xbe, 0xdf, 0x89,
+	0x57, 0x6a, 0xbe, 0x23, 0x4f, 0xd7, 0x73, 0x6c, 0x2e, 0x6c, 0x1a, 0x0a, 0x35, 0x02, 0xcf, 0xce,
+	0x52, 0xc8, 0xf9, 0x84, 0x9d, 0x09, 0x99, 0xaa, 0xb3, 0x68, 0xc1, 0x87, 0xcd, 0x03, 0x10, 0xbf,
+	0xef, 0xcc, 0xcf, 0xd0, 0x4a, 0x77, 0x7c, 0xd8, 0xa0, 0x54, 0xc9, 0xb0, 0x0e, 0xf4, 0x5b, 0xe8,
+	0xe1, 0x66, 0xff, 0xc8, 0x7d, 0x0e, 0x01, 0x7e, 0x4e, 0x16, 0xfb, 0x60, 0x39, 0x03, 0x69, 0xb5,
+	0x2a, 0x27, 0xd1, 0xdb, 0xed, 0x82, 0x7b, 0xc5, 0x91, 0x3d, 0xf2, 0x5c, 0xf4, 0x57, 0xe4, 0x9d,
+	0x1c, 0xb8, 0x96, 0x42, 0x66, 0x4c, 0x73, 0x0b, 0xd1, 0xe5, 0x76, 0xe4, 0x8b, 0x35, 0x5b, 0xcc,
+	0x2d, 0xd0, 0x82, 0xb8, 0x4d, 0xc3, 0x32, 0xcd, 0x53, 0x01, 0xd2, 0x32, 0x3b, 0xd4, 0x60, 0x86,
+	0x2a, 0x4f, 0x23, 0xd2, 0x4e, 0xc6, 0x6d, 0x87, 0xc7, 0x81, 0xf5, 0xa4, 0x26, 0xa5, 0x40, 0xa8,
+	0x0b, 0xa9, 0x5f, 0x8a, 0x81, 0xe6, 0x89, 0x75, 0x3b, 0xfd, 0x4a, 0x3b, 0x29, 0xb7, 0x4a, 0xb8,
+	0x78, 0x07, 0x81, 0x90, 0x3e, 0x22, 0x5b, 0x6e, 0x56, 0x95, 0x1c, 0x54, 0xf9, 0x40, 0xe4, 0x39,
+	0xa4, 0xcc, 0xf9, 0x83, 0x66, 0x6e, 0x8f, 0x80, 0xb1, 0x26, 0x7a, 0x07, 0x37, 0xe6, 0xcd, 0x82,
+	0x8f, 0x7f, 0x3e, 0x45, 0x3d, 0x43, 0x50, 0x1c, 0x30, 0xf4, 0x31, 0xd9, 0x7e, 0x95, 0x46, 0x43,
+	0x59, 0xd9, 0x59, 0x9e, 0x25, 0xe4, 0xb9, 0x35, 0xcf, 0x13, 0x7b, 0x54, 0x43, 0xf4, 0x25, 0xb9,
+	0xe5, 0x0f, 0x9f, 0x86, 0x33, 0xae, 0xd3, 0x30, 0x7f, 0x51, 0x94, 0x4a, 0x5b, 0x2e, 0x13, 0x88,
+	0xae, 0xb6, 0x8b, 0xc0, 0x06, 0xb2, 0xc7, 0x48, 0x8e, 0x91, 0x38, 0x6c, 0xa8, 0xe9, 0x6f, 0x3b,
+	0xe4, 0xce, 0x9c, 0xf8, 0x00, 0x80, 0x69, 0x18, 0x81, 0xac, 0xe6, 0x86, 0xb0, 0xdc, 0x6e, 0x08,
+	0x5b, 0x33, 0x43, 0x38, 0x00, 0x88, 0xbd, 0xc0, 0xcc, 0x38, 0x80, 0xd0, 0xb9, 0x61, 0xf0, 0xbc,
+	0x1c, 0xf2, 0xe8, 0x5a, 0xcb, 0xa5, 0x9f, 0x51, 0x7d, 0xe8, 0x08, 0x69, 0x42, 0xae, 0x59, 0x6e,
+	0x4e, 0xe7, 0x55, 0x68, 0x3b, 0x95, 0xab, 0x8e, 0x71, 0x56, 0xe4, 0x77, 0x1d, 0x72, 0x67, 0xc4,
+	0x73, 0x91, 0x72, 0xab, 0xb4, 0x61, 0x23, 0xc3, 0xbc, 0xa3, 0x4b, 0x7c, 0x89, 0x3b, 0x46, 0x5e,
+	0x3d, 0x7a, 0x17, 0x75, 0x3f, 0x7d, 0x43, 0xdd, 0xa8, 0x13, 0x6f, 0x4d, 0x55, 0x7e, 0x61, 0x1e,
+	0x22, 0xe8, 0xd8, 0x4b, 0xf8, 0xe1, 0xd0, 0x1f, 0x91, 0x1b, 0x78, 0x2b, 0xf0, 0xa2, 0xcc, 0xc1,
+	0x30, 0xab, 0x98, 0x49, 0x78, 0x0e, 0xcc, 0x24, 0x4a, 0x83, 0x89, 0x56, 0x70, 0x77, 0x5e, 0x77,
+	0xf7, 0x82, 0x47, 0x9c, 0xa8, 0x9e, 0xb3, 0xf7, 0xd0, 0x4c, 0x3f, 0x23, 0x1b, 0x21, 0x6b, 0x33,
+	0x21, 0x07, 0xa0, 0x41, 0x23, 0x45, 0x18, 0xfd, 0x2a, 0x3a, 0xaf, 0xf9, 0xdc, 0x7d, 0x18, 0xec,
+	0x27, 0x2a, 0x28, 0xff, 0x98, 0xdc, 0xaa, 0x7d, 0x07, 0x4a, 0x43, 0xc2, 0x8d, 0x9d, 0x77, 0x5f,
+	0x43, 0xf7, 0x75, 0xef, 0x7e, 0x30, 0x85, 0x34, 0x0c, 0x33, 0xea, 0xe1, 0x58, 0xcd, 0xba, 0x5f,
+	0x9f, 0x55, 0x0f, 0x07, 0x6a, 0xea, 0xfb, 0x9c, 0x2c, 0x27, 0x1a, 0xb8, 0x85, 0x70, 0xab, 0x0d,
+	0x00, 0xa2, 0xe8, 0x0d, 0x2f, 0x8e, 0x25, 0xcf, 0x84, 0x17, 0xd4, 0x01, 0x00, 0xfd, 0x21, 0xd9,
+	0x68, 0xf2, 0x61, 0x0a, 0x06, 0x17, 0xd4, 0x0d, 0x54, 0xb8, 0x11, 0x44, 0xeb, 0x3e, 0xa4, 0x35,
+	0x62, 0xdf, 0x03, 0x8e, 0xf8, 0xf8, 0xd0, 0x99, 0xe9, 0xcf, 0xc8, 0x1d, 0x87, 0xd5, 0x60, 0xb5,
+	0xf0, 0x0b, 0xe2, 0xb3, 0x02, 0x93, 0x4a, 0x26, 0x60, 0x42, 0x1e, 0x8a, 0x36, 0xf0, 0x1e, 0xd9,
+	0x2c, 0xf8, 0x38, 0xf6, 0xc8, 0x13, 0x75, 0x80, 0xb8, 0x27, 0x08, 0xf3, 0x89, 0x88, 0x1e, 0x91,
+	0xbb, 0xff, 0x95, 0x2c, 0x84, 0x2d, 0xba, 0x81, 0x6c, 0x5b, 0xff, 0x89, 0x2d, 0x44, 0x8f, 0xfe,
+	0x92, 0x2c, 0x6b, 0xc8, 0x84, 0xb1, 0x9a, 0xbb, 0x34, 0x89, 0x41, 0xbb, 0xf9, 0x86, 0x41, 0xbb,
+	0x3a, 0xcb, 0xe4, 0xa2, 0xf6, 0x01, 0xa1, 0x29, 0x0c, 0x78, 0x95, 0x5b, 0x56, 0xf2, 0x0c, 0x58,
+	0x2e, 0x0a, 0x61, 0xa3, 0x5b, 0x18, 0xad, 0xe5, 0x60, 0x39, 0xe6, 0x19, 0x7c, 0xe1, 0xbe, 0xd3,
+	0xbb, 0x64, 0xc9, 0xcd, 0x6c, 0x06, 0xb9, 0x89, 0xc8, 0xc5, 0x82, 0x8f, 0xa7, 0x28, 0xb7, 0xc7,
+	0x5e, 0xb9, 0x81, 0x99, 0x86, 0x44, 0xe9, 0x34, 0x38, 0x6d, 0xe1, 0xc4, 0xd7, 0xe7, 0xaf, 0xe3,
+	0x18, 0x11, 0x9e, 0x61, 0x87, 0x2c, 0x63, 0x21, 0xe2, 0x6b, 0x92, 0x42, 0x49, 0x3b, 0x8c, 0xb6,
+	0x51, 0x69, 0xc9, 0x7f, 0x3f, 0x06, 0x7d, 0xe4, 0xbe, 0xba, 0xfc, 0x54, 0xd6, 0x59, 0xc3, 0x1f,
+	0x06, 0x97, 0x15, 0xbf, 0xd5, 0x32, 0x3f, 0x95, 0x7e, 0xbf, 0x1e, 0xd6, 0x84, 0x2e, 0x3f, 0x35,
+	0x32, 0xf5, 0xb9, 0x89, 0x6e, 0xb7, 0xcc, 0x4f, 0x41, 0xa5, 0x3e, 0x64, 0xae, 0xe0, 0x6b, 0x44,
+	0xea, 0x3d, 0x72, 0xa7, 0x65, 0xc1, 0x17, 0x34, 0xea, 0xbd, 0x04, 0x84, 0x26, 0xaf, 0x87, 0xeb,
+	0x6e, 0xcb, 0x70, 0x25, 0xff, 0x26, 0x5c, 0xc9, 0x6b, 0xe1, 0x7a, 0xaf, 0x65, 0xb8, 0x92, 0x57,
+	0xc2, 0xf5, 0x84, 0x2c, 0x24, 0x4c, 0x2a, 0x5d, 0x44, 0xdf, 0x6e, 0xc7, 0x7c, 0x29, 0x79, 0xa2,
+	0x74, 0x41, 0xcf, 0xc8, 0xcd, 0x26, 0x2b, 0x35, 0x57, 0x6d, 0x0a, 0x09, 0x9f, 0xf8, 0x0a, 0xee,
+	0x3b, 0xed, 0x54, 0x22, 0x1b, 0x32, 0x55, 0xb8, 0x64, 0xf7, 0x1d, 0x33, 0x56, 0x73, 0xbf, 0x26,
+	0x57, 0xa1, 0x34, 0x22, 0x57, 0xb2, 0x59, 0xf6, 0x9d, 0x96, 0xcb, 0x1e, 0xf8, 0xea, 0x65, 0x1f,
+	0x91, 0x1b, 0x78, 0x22, 0x07, 0x03, 0x48, 0xac, 0x18, 0xd5, 0xe9, 0x37, 0x4c, 0x32, 0xfa, 0x6e,
+	0xcb, 0x99, 0xb9, 0x83, 0x5c, 0x53, 0x9f, 0xf8, 0xab, 0x1d, 0x89, 0x9d, 0xee, 0x9c, 0x12, 0xeb,
+	0x57, 0x5a, 0x4e, 0x2b, 0xc8, 0xf7, 0xff, 0x1f, 0x11, 0x0d, 0x5a, 0x7b, 0x95, 0x96, 0x4d, 0x25,
+	0xf9, 0x80, 0xac, 0xb9, 0xf9, 0x62, 0x5f, 0xc8, 0x92, 0x21, 0x97, 0x59, 0x68, 0x21, 0xa2, 0xef,
+	0x61, 0xea, 0x79, 0xb7, 0x10, 0x12, 0x5b, 0xb7, 0xcf, 0xd1, 0x86, 0xed, 0x03, 0x7d, 0x4a, 0xde,
+	0x73, 0xc9, 0x4d, 0x95, 0x20, 0xa7, 0x67, 0xa3, 0x29, 0x19, 0x31, 0x13, 0xa1, 0x60, 0xf4, 0x01,
+	0x66, 0x22, 0x57, 0x64, 0x3e, 0x2d, 0x41, 0x36, 0xbb, 0xbe, 0xae, 0x1b, 0x8f, 0x41, 0x63, 0x10,
+	0xe8, 0xe7, 0xbe, 0x9e, 0xf5, 0x65, 0x23, 0x76, 0x2b, 0x3c, 0x67, 0x19, 0x9f, 0x6d, 0xb4, 0xee,
+	0x21, 0x95, 0xbb, 0x50, 0xb1, 0x00, 0x8c, 0x3d, 0xe8, 0x31, 0x9f, 0x76, 0x5c, 0xdf, 0x27, 0x91,
+	0xbf, 0x4c, 0xf0, 0x30, 0xcd, 0x7b, 0xef, 0xa2, 0xf7, 0x0a, 0x5e, 0x20, 0xce, 0x3c, 0xeb, 0xf7,
+	0x89, 0x6f, 0x3c, 0x4b, 0x5d, 0x61, 0x0f, 0x32, 0xef, 0xd8, 0x45, 0xc7, 0x55, 0x97, 0xb5, 0xbd,
+	0x7d, 0x5e, 0xd1, 0x55, 0x1e, 0x33, 0x21, 0x48, 0x45, 0x01, 0x12, 0x9b, 0xdb, 0x0f, 0x1b, 0xbf,
+	0x66, 0xd6, 0xfb, 0xb5, 0x91, 0x1a, 0xb2, 0x5e, 0x6a, 0xd5, 0xe7, 0x7d, 0x91, 0x0b, 0x3b, 0x61,
+	0xa6, 0x2a, 0x98, 0x55, 0x39, 0x68, 0xac, 0x53, 0xef, 0xb7, 0x5b, 0xea, 0xeb, 0x33, 0xcc, 0xbd,
+	0xaa, 0x38, 0xa9, 0x79, 0xeb, 0xfe, 0x5a, 0x43, 0xa6, 0xc1, 0xb2, 0xa1, 0x30, 0x56, 0xe9, 0x49,
+	0xdd, 0xf6, 0x7d, 0xd4, 0x14, 0x23, 0x31, 0xda, 0x7f, 0xe2, 0xcd, 0xa1, 0xfd, 0xfb, 0x29, 0xb9,
+	0x9d, 0x43, 0xc6, 0x93, 0x09, 0xeb, 0x57, 0x32, 0x75, 0xd5, 0x97, 0xc8, 0x24, 0xb7, 0x95, 0x06,
+	0xc3, 0x40, 0xa6, 0x21, 0x54, 0x0f, 0xfc, 0x95, 0xef, 0x91, 0x7b, 0x08, 0xec, 0x35, 0xb8, 0x47,
+	0x32, 0xf5, 0x31, 0xfb, 0x8c, 0xac, 0x97, 0x7c, 0x92, 0x2b, 0x9e, 0x32, 0x53, 0xf5, 0xc3, 0x73,
+	0x41, 0xdd, 0xaf, 0x7e, 0x8c, 0x14, 0xd7, 0x03, 0xa0, 0xd7, 0xd8, 0x7d, 0xc3, 0x7a, 0xfb, 0x2f,
+	0x1d, 0xb2, 0xda, 0x4b, 0x86, 0x90, 0x56, 0x39, 0xa4, 0xfe, 0x41, 0xc1, 0x6f, 0x4b, 0xba, 0x44,
+	0xce, 0x8b, 0x14, 0x5f, 0x14, 0x2e, 0xc6, 0xe7, 0x45, 0x4a, 0x37, 0xc8, 0xdb, 0xa5, 0x56, 0xa5,
+	0x32, 0xa0, 0xf1, 0xed, 0xe0, 0x72, 0xdc, 0xfc, 0xa6, 0x1f, 0x93, 0x05, 0xff, 0x0c, 0x82, 0x6f,
+	0x04, 0x57, 0x3e, 0xba, 0xb9, 0x3b, 0xfb, 0x0e, 0xb2, 0xfb, 0xb4, 0x74, 0x07, 0x83, 0xe7, 0x9e,
+	0x3f, 0x0e, 0x58, 0x77, 0xfd, 0x9b, 0x5a, 0x9a, 0x71, 0x3b, 0xd3, 0xfe, 0x5f, 0x88, 0x97, 0x1b,
+	0xcb, 0x43, 0xeb, 0x67, 0x79, 0x97, 0x2c, 0xf1, 0xb2, 0xcc, 0x27, 0x53, 0xe4, 0x25, 0x44, 0x2e,
+	0xe2, 0xd7, 0x80, 0xda, 0x8b, 0xbf, 0x7a, 0xb1, 0xd9, 0xf9, 0xfa, 0xc5, 0x66, 0xe7, 0x1f, 0x2f,
+	0x36, 0x3b, 0xbf, 0x7f, 0xb9, 0x79, 0xee, 0xeb, 0x97, 0x9b, 0xe7, 0xfe, 0xf6, 0x72, 0xf3, 0xdc,
+	0xf3, 0x4f, 0xfe, 0xc7, 0x65, 0x1f, 0x77, 0x9b, 0xb1, 0x77, 0xed, 0xa4, 0x04, 0xd3, 0x5f, 0xc0,
+	0x27, 0x97, 0x07, 0xff, 0x1a, 0x00, 0xf6, 0xd6, 0x40, 0xf1, 0xf8, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -345,6 +526,93 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PayloadSubmissionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PayloadSubmissionWindow))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LegacyBundleSignaturesEndBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LegacyBundleSignaturesEndBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxRegretHistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRegretHistoryLength))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.ProbabilitySumTolerance.Size()
+		i -= size
+		if _, err := m.ProbabilitySumTolerance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x8a
+	if m.MaxInferenceDimension != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxInferenceDimension))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxPruningGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPruningGasPerBlock))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxRewardGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRewardGasPerBlock))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxStakeRemovalGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakeRemovalGasPerBlock))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.MaxOpenInferenceRequestsPerTopic != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenInferenceRequestsPerTopic))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MinParamChangeDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinParamChangeDelay))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.TopicRevenueBurnFraction.Size()
+		i -= size
+		if _, err := m.TopicRevenueBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
 	{
 		size := m.MinEffectiveTopicRevenue.Size()
 		i -= size
@@ -712,6 +980,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplyAtBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ApplyAtBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScheduledAtBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ScheduledAtBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -828,6 +1153,63 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovParams(uint64(l))
 	l = m.MinEffectiveTopicRevenue.Size()
 	n += 2 + l + sovParams(uint64(l))
+	l = m.TopicRevenueBurnFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MinParamChangeDelay != 0 {
+		n += 2 + sovParams(uint64(m.MinParamChangeDelay))
+	}
+	if m.MaxOpenInferenceRequestsPerTopic != 0 {
+		n += 2 + sovParams(uint64(m.MaxOpenInferenceRequestsPerTopic))
+	}
+	if m.MaxStakeRemovalGasPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxStakeRemovalGasPerBlock))
+	}
+	if m.MaxRewardGasPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxRewardGasPerBlock))
+	}
+	if m.MaxPruningGasPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxPruningGasPerBlock))
+	}
+	if m.MaxInferenceDimension != 0 {
+		n += 2 + sovParams(uint64(m.MaxInferenceDimension))
+	}
+	l = m.ProbabilitySumTolerance.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxRegretHistoryLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxRegretHistoryLength))
+	}
+	if m.LegacyBundleSignaturesEndBlock != 0 {
+		n += 2 + sovParams(uint64(m.LegacyBundleSignaturesEndBlock))
+	}
+	if m.PayloadSubmissionWindow != 0 {
+		n += 2 + sovParams(uint64(m.PayloadSubmissionWindow))
+	}
+	return n
+}
+
+func (m *ScheduledParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovParams(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ScheduledAtBlock != 0 {
+		n += 1 + sovParams(uint64(m.ScheduledAtBlock))
+	}
+	if m.ApplyAtBlock != 0 {
+		n += 1 + sovParams(uint64(m.ApplyAtBlock))
+	}
 	return n
 }
 
@@ -1969,6 +2351,420 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRevenueBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopicRevenueBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParamChangeDelay", wireType)
+			}
+			m.MinParamChangeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinParamChangeDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInferenceRequestsPerTopic", wireType)
+			}
+			m.MaxOpenInferenceRequestsPerTopic = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenInferenceRequestsPerTopic |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakeRemovalGasPerBlock", wireType)
+			}
+			m.MaxStakeRemovalGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakeRemovalGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardGasPerBlock", wireType)
+			}
+			m.MaxRewardGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPruningGasPerBlock", wireType)
+			}
+			m.MaxPruningGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPruningGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInferenceDimension", wireType)
+			}
+			m.MaxInferenceDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInferenceDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilitySumTolerance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProbabilitySumTolerance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegretHistoryLength", wireType)
+			}
+			m.MaxRegretHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegretHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyBundleSignaturesEndBlock", wireType)
+			}
+			m.LegacyBundleSignaturesEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacyBundleSignaturesEndBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSubmissionWindow", wireType)
+			}
+			m.PayloadSubmissionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSubmissionWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAtBlock", wireType)
+			}
+			m.ScheduledAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyAtBlock", wireType)
+			}
+			m.ApplyAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyAtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
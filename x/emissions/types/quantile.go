@@ -0,0 +1,42 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Quantile levels are strictly increasing and each lies within (0, 1)
+func ValidateQuantileLevels(levels []alloraMath.Dec) error {
+	for i, level := range levels {
+		if level.IsNaN() || level.Lte(alloraMath.ZeroDec()) || level.Gte(alloraMath.OneDec()) {
+			return errors.Wrapf(ErrInvalidQuantileLevels, "quantile level %s out of range", level)
+		}
+		if i > 0 && level.Lte(levels[i-1]) {
+			return errors.Wrapf(ErrInvalidQuantileLevels, "quantile level %s does not exceed %s", level, levels[i-1])
+		}
+	}
+	return nil
+}
+
+// Quantile topics take inferences made of one quantile per quantile level of the topic.
+// The ground truth never reaches the chain, so reputers score the quantiles off chain, e.g. with
+// the pinball loss or its average over the levels approximating the CRPS, and report that score
+// as the loss of each inferer in their value bundle like for any other topic.
+func (topic *Topic) IsQuantileTopic() bool {
+	return len(topic.QuantileLevels) > 0
+}
+
+// The quantiles of an inference must not decrease as the quantile level increases,
+// otherwise they do not describe a distribution
+func (inference *Inference) ValidateQuantiles() error {
+	for i := 1; i < len(inference.Values); i++ {
+		if inference.Values[i].Lt(inference.Values[i-1]) {
+			return errors.Wrapf(
+				ErrQuantilesNotMonotonic,
+				"quantile %d (%s) is below quantile %d (%s)",
+				i, inference.Values[i], i-1, inference.Values[i-1],
+			)
+		}
+	}
+	return nil
+}
@@ -194,6 +194,81 @@ func (m *QueryTotalStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTotalStakeResponse proto.InternalMessageInfo
 
+// Topic Revenue Burned returns the cumulative amount of topic revenue that has been burned
+type QueryTopicRevenueBurnedRequest struct {
+}
+
+func (m *QueryTopicRevenueBurnedRequest) Reset()         { *m = QueryTopicRevenueBurnedRequest{} }
+func (m *QueryTopicRevenueBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicRevenueBurnedRequest) ProtoMessage()    {}
+func (*QueryTopicRevenueBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{4}
+}
+func (m *QueryTopicRevenueBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicRevenueBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicRevenueBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopicRevenueBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicRevenueBurnedRequest.Merge(m, src)
+}
+func (m *QueryTopicRevenueBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicRevenueBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicRevenueBurnedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopicRevenueBurnedRequest proto.InternalMessageInfo
+
+// Topic Revenue Burned returns the cumulative amount of topic revenue that has been burned
+type QueryTopicRevenueBurnedResponse struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *QueryTopicRevenueBurnedResponse) Reset()         { *m = QueryTopicRevenueBurnedResponse{} }
+func (m *QueryTopicRevenueBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicRevenueBurnedResponse) ProtoMessage()    {}
+func (*QueryTopicRevenueBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{5}
+}
+func (m *QueryTopicRevenueBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicRevenueBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicRevenueBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopicRevenueBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicRevenueBurnedResponse.Merge(m, src)
+}
+func (m *QueryTopicRevenueBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicRevenueBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicRevenueBurnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopicRevenueBurnedResponse proto.InternalMessageInfo
+
 type QueryReputerStakeInTopicRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
@@ -203,7 +278,7 @@ func (m *QueryReputerStakeInTopicRequest) Reset()         { *m = QueryReputerSta
 func (m *QueryReputerStakeInTopicRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReputerStakeInTopicRequest) ProtoMessage()    {}
 func (*QueryReputerStakeInTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{4}
+	return fileDescriptor_c426af2f1e908986, []int{6}
 }
 func (m *QueryReputerStakeInTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +329,7 @@ func (m *QueryReputerStakeInTopicResponse) Reset()         { *m = QueryReputerSt
 func (m *QueryReputerStakeInTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReputerStakeInTopicResponse) ProtoMessage()    {}
 func (*QueryReputerStakeInTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{5}
+	return fileDescriptor_c426af2f1e908986, []int{7}
 }
 func (m *QueryReputerStakeInTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +367,7 @@ func (m *QueryMultiReputerStakeInTopicRequest) Reset()         { *m = QueryMulti
 func (m *QueryMultiReputerStakeInTopicRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMultiReputerStakeInTopicRequest) ProtoMessage()    {}
 func (*QueryMultiReputerStakeInTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{6}
+	return fileDescriptor_c426af2f1e908986, []int{8}
 }
 func (m *QueryMultiReputerStakeInTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +418,7 @@ func (m *QueryMultiReputerStakeInTopicResponse) Reset()         { *m = QueryMult
 func (m *QueryMultiReputerStakeInTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMultiReputerStakeInTopicResponse) ProtoMessage()    {}
 func (*QueryMultiReputerStakeInTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{7}
+	return fileDescriptor_c426af2f1e908986, []int{9}
 }
 func (m *QueryMultiReputerStakeInTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +467,7 @@ func (m *QueryStakeFromReputerInTopicInSelfRequest) String() string {
 }
 func (*QueryStakeFromReputerInTopicInSelfRequest) ProtoMessage() {}
 func (*QueryStakeFromReputerInTopicInSelfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{8}
+	return fileDescriptor_c426af2f1e908986, []int{10}
 }
 func (m *QueryStakeFromReputerInTopicInSelfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +522,7 @@ func (m *QueryStakeFromReputerInTopicInSelfResponse) String() string {
 }
 func (*QueryStakeFromReputerInTopicInSelfResponse) ProtoMessage() {}
 func (*QueryStakeFromReputerInTopicInSelfResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{9}
+	return fileDescriptor_c426af2f1e908986, []int{11}
 }
 func (m *QueryStakeFromReputerInTopicInSelfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +564,7 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) String() string {
 }
 func (*QueryDelegateStakeInTopicInReputerRequest) ProtoMessage() {}
 func (*QueryDelegateStakeInTopicInReputerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{10}
+	return fileDescriptor_c426af2f1e908986, []int{12}
 }
 func (m *QueryDelegateStakeInTopicInReputerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +619,7 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) String() string {
 }
 func (*QueryDelegateStakeInTopicInReputerResponse) ProtoMessage() {}
 func (*QueryDelegateStakeInTopicInReputerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{11}
+	return fileDescriptor_c426af2f1e908986, []int{13}
 }
 func (m *QueryDelegateStakeInTopicInReputerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +662,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) String() string {
 }
 func (*QueryStakeFromDelegatorInTopicInReputerRequest) ProtoMessage() {}
 func (*QueryStakeFromDelegatorInTopicInReputerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{12}
+	return fileDescriptor_c426af2f1e908986, []int{14}
 }
 func (m *QueryStakeFromDelegatorInTopicInReputerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +724,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) String() string {
 }
 func (*QueryStakeFromDelegatorInTopicInReputerResponse) ProtoMessage() {}
 func (*QueryStakeFromDelegatorInTopicInReputerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{13}
+	return fileDescriptor_c426af2f1e908986, []int{15}
 }
 func (m *QueryStakeFromDelegatorInTopicInReputerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +762,7 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Reset()         { *m = QueryStak
 func (m *QueryStakeFromDelegatorInTopicRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStakeFromDelegatorInTopicRequest) ProtoMessage()    {}
 func (*QueryStakeFromDelegatorInTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{14}
+	return fileDescriptor_c426af2f1e908986, []int{16}
 }
 func (m *QueryStakeFromDelegatorInTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +815,7 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Reset() {
 func (m *QueryStakeFromDelegatorInTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStakeFromDelegatorInTopicResponse) ProtoMessage()    {}
 func (*QueryStakeFromDelegatorInTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{15}
+	return fileDescriptor_c426af2f1e908986, []int{17}
 }
 func (m *QueryStakeFromDelegatorInTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +852,7 @@ func (m *QueryTopicStakeRequest) Reset()         { *m = QueryTopicStakeRequest{}
 func (m *QueryTopicStakeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTopicStakeRequest) ProtoMessage()    {}
 func (*QueryTopicStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{16}
+	return fileDescriptor_c426af2f1e908986, []int{18}
 }
 func (m *QueryTopicStakeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +896,7 @@ func (m *QueryTopicStakeResponse) Reset()         { *m = QueryTopicStakeResponse
 func (m *QueryTopicStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTopicStakeResponse) ProtoMessage()    {}
 func (*QueryTopicStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{17}
+	return fileDescriptor_c426af2f1e908986, []int{19}
 }
 func (m *QueryTopicStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +934,7 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Reset()         { *m = QueryNetwo
 func (m *QueryNetworkLossBundleAtBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNetworkLossBundleAtBlockRequest) ProtoMessage()    {}
 func (*QueryNetworkLossBundleAtBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{18}
+	return fileDescriptor_c426af2f1e908986, []int{20}
 }
 func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +985,7 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Reset()         { *m = QueryNetw
 func (m *QueryNetworkLossBundleAtBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNetworkLossBundleAtBlockResponse) ProtoMessage()    {}
 func (*QueryNetworkLossBundleAtBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{19}
+	return fileDescriptor_c426af2f1e908986, []int{21}
 }
 func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,7 +1028,7 @@ func (m *QueryNextTopicIdRequest) Reset()         { *m = QueryNextTopicIdRequest
 func (m *QueryNextTopicIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextTopicIdRequest) ProtoMessage()    {}
 func (*QueryNextTopicIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{20}
+	return fileDescriptor_c426af2f1e908986, []int{22}
 }
 func (m *QueryNextTopicIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,7 +1065,7 @@ func (m *QueryNextTopicIdResponse) Reset()         { *m = QueryNextTopicIdRespon
 func (m *QueryNextTopicIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextTopicIdResponse) ProtoMessage()    {}
 func (*QueryNextTopicIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{21}
+	return fileDescriptor_c426af2f1e908986, []int{23}
 }
 func (m *QueryNextTopicIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1109,7 @@ func (m *QueryTopicRequest) Reset()         { *m = QueryTopicRequest{} }
 func (m *QueryTopicRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTopicRequest) ProtoMessage()    {}
 func (*QueryTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{22}
+	return fileDescriptor_c426af2f1e908986, []int{24}
 }
 func (m *QueryTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1155,7 @@ func (m *QueryTopicResponse) Reset()         { *m = QueryTopicResponse{} }
 func (m *QueryTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTopicResponse) ProtoMessage()    {}
 func (*QueryTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{23}
+	return fileDescriptor_c426af2f1e908986, []int{25}
 }
 func (m *QueryTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1213,7 @@ func (m *QueryActiveTopicsRequest) Reset()         { *m = QueryActiveTopicsReque
 func (m *QueryActiveTopicsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveTopicsRequest) ProtoMessage()    {}
 func (*QueryActiveTopicsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{24}
+	return fileDescriptor_c426af2f1e908986, []int{26}
 }
 func (m *QueryActiveTopicsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1258,7 @@ func (m *QueryActiveTopicsResponse) Reset()         { *m = QueryActiveTopicsResp
 func (m *QueryActiveTopicsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveTopicsResponse) ProtoMessage()    {}
 func (*QueryActiveTopicsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{25}
+	return fileDescriptor_c426af2f1e908986, []int{27}
 }
 func (m *QueryActiveTopicsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1236,7 +1311,7 @@ func (m *QueryInferencesAtBlockRequest) Reset()         { *m = QueryInferencesAt
 func (m *QueryInferencesAtBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInferencesAtBlockRequest) ProtoMessage()    {}
 func (*QueryInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{26}
+	return fileDescriptor_c426af2f1e908986, []int{28}
 }
 func (m *QueryInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1366,7 @@ func (m *QueryInferencesAtBlockResponse) Reset()         { *m = QueryInferencesA
 func (m *QueryInferencesAtBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInferencesAtBlockResponse) ProtoMessage()    {}
 func (*QueryInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{27}
+	return fileDescriptor_c426af2f1e908986, []int{29}
 }
 func (m *QueryInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1412,7 @@ func (m *QueryForecastsAtBlockRequest) Reset()         { *m = QueryForecastsAtBl
 func (m *QueryForecastsAtBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryForecastsAtBlockRequest) ProtoMessage()    {}
 func (*QueryForecastsAtBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{28}
+	return fileDescriptor_c426af2f1e908986, []int{30}
 }
 func (m *QueryForecastsAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1392,7 +1467,7 @@ func (m *QueryForecastsAtBlockResponse) Reset()         { *m = QueryForecastsAtB
 func (m *QueryForecastsAtBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryForecastsAtBlockResponse) ProtoMessage()    {}
 func (*QueryForecastsAtBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{29}
+	return fileDescriptor_c426af2f1e908986, []int{31}
 }
 func (m *QueryForecastsAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1437,7 +1512,7 @@ func (m *QueryWorkerLatestInferenceRequest) Reset()         { *m = QueryWorkerLa
 func (m *QueryWorkerLatestInferenceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkerLatestInferenceRequest) ProtoMessage()    {}
 func (*QueryWorkerLatestInferenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{30}
+	return fileDescriptor_c426af2f1e908986, []int{32}
 }
 func (m *QueryWorkerLatestInferenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1488,7 +1563,7 @@ func (m *QueryWorkerLatestInferenceResponse) Reset()         { *m = QueryWorkerL
 func (m *QueryWorkerLatestInferenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkerLatestInferenceResponse) ProtoMessage()    {}
 func (*QueryWorkerLatestInferenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{31}
+	return fileDescriptor_c426af2f1e908986, []int{33}
 }
 func (m *QueryWorkerLatestInferenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1607,7 @@ func (m *QueryWorkerNodeInfoRequest) Reset()         { *m = QueryWorkerNodeInfoR
 func (m *QueryWorkerNodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkerNodeInfoRequest) ProtoMessage()    {}
 func (*QueryWorkerNodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{32}
+	return fileDescriptor_c426af2f1e908986, []int{34}
 }
 func (m *QueryWorkerNodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1576,7 +1651,7 @@ func (m *QueryWorkerNodeInfoResponse) Reset()         { *m = QueryWorkerNodeInfo
 func (m *QueryWorkerNodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkerNodeInfoResponse) ProtoMessage()    {}
 func (*QueryWorkerNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{33}
+	return fileDescriptor_c426af2f1e908986, []int{35}
 }
 func (m *QueryWorkerNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1620,7 +1695,7 @@ func (m *QueryReputerNodeInfoRequest) Reset()         { *m = QueryReputerNodeInf
 func (m *QueryReputerNodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReputerNodeInfoRequest) ProtoMessage()    {}
 func (*QueryReputerNodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{34}
+	return fileDescriptor_c426af2f1e908986, []int{36}
 }
 func (m *QueryReputerNodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1664,7 +1739,7 @@ func (m *QueryReputerNodeInfoResponse) Reset()         { *m = QueryReputerNodeIn
 func (m *QueryReputerNodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReputerNodeInfoResponse) ProtoMessage()    {}
 func (*QueryReputerNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{35}
+	return fileDescriptor_c426af2f1e908986, []int{37}
 }
 func (m *QueryReputerNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1783,7 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Reset()         { *m = QueryWorkerAd
 func (m *QueryWorkerAddressByP2PKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkerAddressByP2PKeyRequest) ProtoMessage()    {}
 func (*QueryWorkerAddressByP2PKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{36}
+	return fileDescriptor_c426af2f1e908986, []int{38}
 }
 func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1827,7 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Reset()         { *m = QueryWorkerA
 func (m *QueryWorkerAddressByP2PKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkerAddressByP2PKeyResponse) ProtoMessage()    {}
 func (*QueryWorkerAddressByP2PKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{37}
+	return fileDescriptor_c426af2f1e908986, []int{39}
 }
 func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1796,7 +1871,7 @@ func (m *QueryReputerAddressByP2PKeyRequest) Reset()         { *m = QueryReputer
 func (m *QueryReputerAddressByP2PKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReputerAddressByP2PKeyRequest) ProtoMessage()    {}
 func (*QueryReputerAddressByP2PKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{38}
+	return fileDescriptor_c426af2f1e908986, []int{40}
 }
 func (m *QueryReputerAddressByP2PKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1840,7 +1915,7 @@ func (m *QueryReputerAddressByP2PKeyResponse) Reset()         { *m = QueryRepute
 func (m *QueryReputerAddressByP2PKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReputerAddressByP2PKeyResponse) ProtoMessage()    {}
 func (*QueryReputerAddressByP2PKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{39}
+	return fileDescriptor_c426af2f1e908986, []int{41}
 }
 func (m *QueryReputerAddressByP2PKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1886,7 +1961,7 @@ func (m *QueryNetworkInferencesAtBlockRequest) Reset()         { *m = QueryNetwo
 func (m *QueryNetworkInferencesAtBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNetworkInferencesAtBlockRequest) ProtoMessage()    {}
 func (*QueryNetworkInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{40}
+	return fileDescriptor_c426af2f1e908986, []int{42}
 }
 func (m *QueryNetworkInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2023,7 @@ func (m *QueryLatestNetworkInferencesAtBlockRequest) String() string {
 }
 func (*QueryLatestNetworkInferencesAtBlockRequest) ProtoMessage() {}
 func (*QueryLatestNetworkInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{41}
+	return fileDescriptor_c426af2f1e908986, []int{43}
 }
 func (m *QueryLatestNetworkInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2067,7 @@ func (m *QueryNetworkInferencesAtBlockResponse) Reset()         { *m = QueryNetw
 func (m *QueryNetworkInferencesAtBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNetworkInferencesAtBlockResponse) ProtoMessage()    {}
 func (*QueryNetworkInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{42}
+	return fileDescriptor_c426af2f1e908986, []int{44}
 }
 func (m *QueryNetworkInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2043,7 +2118,7 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) String() string {
 }
 func (*QueryLatestNetworkInferencesAtBlockResponse) ProtoMessage() {}
 func (*QueryLatestNetworkInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{43}
+	return fileDescriptor_c426af2f1e908986, []int{45}
 }
 func (m *QueryLatestNetworkInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2186,7 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Reset() {
 func (m *QueryIsWorkerRegisteredInTopicIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsWorkerRegisteredInTopicIdRequest) ProtoMessage()    {}
 func (*QueryIsWorkerRegisteredInTopicIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{44}
+	return fileDescriptor_c426af2f1e908986, []int{46}
 }
 func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2164,7 +2239,7 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Reset() {
 func (m *QueryIsWorkerRegisteredInTopicIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsWorkerRegisteredInTopicIdResponse) ProtoMessage()    {}
 func (*QueryIsWorkerRegisteredInTopicIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{45}
+	return fileDescriptor_c426af2f1e908986, []int{47}
 }
 func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2211,7 +2286,7 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Reset() {
 func (m *QueryIsReputerRegisteredInTopicIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsReputerRegisteredInTopicIdRequest) ProtoMessage()    {}
 func (*QueryIsReputerRegisteredInTopicIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{46}
+	return fileDescriptor_c426af2f1e908986, []int{48}
 }
 func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2341,7 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) String() string {
 }
 func (*QueryIsReputerRegisteredInTopicIdResponse) ProtoMessage() {}
 func (*QueryIsReputerRegisteredInTopicIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{47}
+	return fileDescriptor_c426af2f1e908986, []int{49}
 }
 func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2310,7 +2385,7 @@ func (m *QueryIsWhitelistAdminRequest) Reset()         { *m = QueryIsWhitelistAd
 func (m *QueryIsWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsWhitelistAdminRequest) ProtoMessage()    {}
 func (*QueryIsWhitelistAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{48}
+	return fileDescriptor_c426af2f1e908986, []int{50}
 }
 func (m *QueryIsWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2354,7 +2429,7 @@ func (m *QueryIsWhitelistAdminResponse) Reset()         { *m = QueryIsWhitelistA
 func (m *QueryIsWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsWhitelistAdminResponse) ProtoMessage()    {}
 func (*QueryIsWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{49}
+	return fileDescriptor_c426af2f1e908986, []int{51}
 }
 func (m *QueryIsWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2390,22 +2465,22 @@ func (m *QueryIsWhitelistAdminResponse) GetIsAdmin() bool {
 	return false
 }
 
-type QueryStakeRemovalsForBlockRequest struct {
-	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+type QueryTopicParamsRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *QueryStakeRemovalsForBlockRequest) Reset()         { *m = QueryStakeRemovalsForBlockRequest{} }
-func (m *QueryStakeRemovalsForBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStakeRemovalsForBlockRequest) ProtoMessage()    {}
-func (*QueryStakeRemovalsForBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{50}
+func (m *QueryTopicParamsRequest) Reset()         { *m = QueryTopicParamsRequest{} }
+func (m *QueryTopicParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicParamsRequest) ProtoMessage()    {}
+func (*QueryTopicParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{52}
 }
-func (m *QueryStakeRemovalsForBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakeRemovalsForBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakeRemovalsForBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2415,41 +2490,42 @@ func (m *QueryStakeRemovalsForBlockRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryStakeRemovalsForBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakeRemovalsForBlockRequest.Merge(m, src)
+func (m *QueryTopicParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicParamsRequest.Merge(m, src)
 }
-func (m *QueryStakeRemovalsForBlockRequest) XXX_Size() int {
+func (m *QueryTopicParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakeRemovalsForBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakeRemovalsForBlockRequest.DiscardUnknown(m)
+func (m *QueryTopicParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakeRemovalsForBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicParamsRequest proto.InternalMessageInfo
 
-func (m *QueryStakeRemovalsForBlockRequest) GetBlockHeight() int64 {
+func (m *QueryTopicParamsRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TopicId
 	}
 	return 0
 }
 
-type QueryStakeRemovalsForBlockResponse struct {
-	Removals []*StakeRemovalInfo `protobuf:"bytes,1,rep,name=removals,proto3" json:"removals,omitempty"`
+type QueryTopicParamsResponse struct {
+	EffectiveParams EffectiveTopicParams `protobuf:"bytes,1,opt,name=effective_params,json=effectiveParams,proto3" json:"effective_params"`
+	Overrides       TopicParamsOverrides `protobuf:"bytes,2,opt,name=overrides,proto3" json:"overrides"`
 }
 
-func (m *QueryStakeRemovalsForBlockResponse) Reset()         { *m = QueryStakeRemovalsForBlockResponse{} }
-func (m *QueryStakeRemovalsForBlockResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStakeRemovalsForBlockResponse) ProtoMessage()    {}
-func (*QueryStakeRemovalsForBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{51}
+func (m *QueryTopicParamsResponse) Reset()         { *m = QueryTopicParamsResponse{} }
+func (m *QueryTopicParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicParamsResponse) ProtoMessage()    {}
+func (*QueryTopicParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{53}
 }
-func (m *QueryStakeRemovalsForBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakeRemovalsForBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakeRemovalsForBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2459,45 +2535,47 @@ func (m *QueryStakeRemovalsForBlockResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryStakeRemovalsForBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakeRemovalsForBlockResponse.Merge(m, src)
+func (m *QueryTopicParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicParamsResponse.Merge(m, src)
 }
-func (m *QueryStakeRemovalsForBlockResponse) XXX_Size() int {
+func (m *QueryTopicParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakeRemovalsForBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakeRemovalsForBlockResponse.DiscardUnknown(m)
+func (m *QueryTopicParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakeRemovalsForBlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicParamsResponse proto.InternalMessageInfo
 
-func (m *QueryStakeRemovalsForBlockResponse) GetRemovals() []*StakeRemovalInfo {
+func (m *QueryTopicParamsResponse) GetEffectiveParams() EffectiveTopicParams {
 	if m != nil {
-		return m.Removals
+		return m.EffectiveParams
 	}
-	return nil
+	return EffectiveTopicParams{}
 }
 
-type QueryDelegateStakeRemovalsForBlockRequest struct {
-	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+func (m *QueryTopicParamsResponse) GetOverrides() TopicParamsOverrides {
+	if m != nil {
+		return m.Overrides
+	}
+	return TopicParamsOverrides{}
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockRequest) Reset() {
-	*m = QueryDelegateStakeRemovalsForBlockRequest{}
+type QueryScheduledParamsChangesRequest struct {
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDelegateStakeRemovalsForBlockRequest) ProtoMessage() {}
-func (*QueryDelegateStakeRemovalsForBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{52}
+
+func (m *QueryScheduledParamsChangesRequest) Reset()         { *m = QueryScheduledParamsChangesRequest{} }
+func (m *QueryScheduledParamsChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsChangesRequest) ProtoMessage()    {}
+func (*QueryScheduledParamsChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{54}
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryScheduledParamsChangesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryScheduledParamsChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryScheduledParamsChangesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2507,45 +2585,35 @@ func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest.Merge(m, src)
+func (m *QueryScheduledParamsChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsChangesRequest.Merge(m, src)
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Size() int {
+func (m *QueryScheduledParamsChangesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest.DiscardUnknown(m)
+func (m *QueryScheduledParamsChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsChangesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest proto.InternalMessageInfo
-
-func (m *QueryDelegateStakeRemovalsForBlockRequest) GetBlockHeight() int64 {
-	if m != nil {
-		return m.BlockHeight
-	}
-	return 0
-}
+var xxx_messageInfo_QueryScheduledParamsChangesRequest proto.InternalMessageInfo
 
-type QueryDelegateStakeRemovalsForBlockResponse struct {
-	Removals []*DelegateStakeRemovalInfo `protobuf:"bytes,1,rep,name=removals,proto3" json:"removals,omitempty"`
+// Pending params changes, in the order they will be applied
+type QueryScheduledParamsChangesResponse struct {
+	Changes []*ScheduledParamsChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockResponse) Reset() {
-	*m = QueryDelegateStakeRemovalsForBlockResponse{}
-}
-func (m *QueryDelegateStakeRemovalsForBlockResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDelegateStakeRemovalsForBlockResponse) ProtoMessage() {}
-func (*QueryDelegateStakeRemovalsForBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{53}
+func (m *QueryScheduledParamsChangesResponse) Reset()         { *m = QueryScheduledParamsChangesResponse{} }
+func (m *QueryScheduledParamsChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsChangesResponse) ProtoMessage()    {}
+func (*QueryScheduledParamsChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{55}
 }
-func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryScheduledParamsChangesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryScheduledParamsChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryScheduledParamsChangesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2555,42 +2623,41 @@ func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse.Merge(m, src)
+func (m *QueryScheduledParamsChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsChangesResponse.Merge(m, src)
 }
-func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Size() int {
+func (m *QueryScheduledParamsChangesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse.DiscardUnknown(m)
+func (m *QueryScheduledParamsChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsChangesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryScheduledParamsChangesResponse proto.InternalMessageInfo
 
-func (m *QueryDelegateStakeRemovalsForBlockResponse) GetRemovals() []*DelegateStakeRemovalInfo {
+func (m *QueryScheduledParamsChangesResponse) GetChanges() []*ScheduledParamsChange {
 	if m != nil {
-		return m.Removals
+		return m.Changes
 	}
 	return nil
 }
 
-type QueryStakeRemovalInfoRequest struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+type QueryInferenceRequestRequest struct {
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (m *QueryStakeRemovalInfoRequest) Reset()         { *m = QueryStakeRemovalInfoRequest{} }
-func (m *QueryStakeRemovalInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStakeRemovalInfoRequest) ProtoMessage()    {}
-func (*QueryStakeRemovalInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{54}
+func (m *QueryInferenceRequestRequest) Reset()         { *m = QueryInferenceRequestRequest{} }
+func (m *QueryInferenceRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInferenceRequestRequest) ProtoMessage()    {}
+func (*QueryInferenceRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{56}
 }
-func (m *QueryStakeRemovalInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryInferenceRequestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakeRemovalInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryInferenceRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakeRemovalInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryInferenceRequestRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2600,48 +2667,41 @@ func (m *QueryStakeRemovalInfoRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryStakeRemovalInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakeRemovalInfoRequest.Merge(m, src)
+func (m *QueryInferenceRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInferenceRequestRequest.Merge(m, src)
 }
-func (m *QueryStakeRemovalInfoRequest) XXX_Size() int {
+func (m *QueryInferenceRequestRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakeRemovalInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakeRemovalInfoRequest.DiscardUnknown(m)
+func (m *QueryInferenceRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInferenceRequestRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakeRemovalInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryInferenceRequestRequest proto.InternalMessageInfo
 
-func (m *QueryStakeRemovalInfoRequest) GetTopicId() uint64 {
+func (m *QueryInferenceRequestRequest) GetRequestId() uint64 {
 	if m != nil {
-		return m.TopicId
+		return m.RequestId
 	}
 	return 0
 }
 
-func (m *QueryStakeRemovalInfoRequest) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
+type QueryInferenceRequestResponse struct {
+	Request *InferenceRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-type QueryStakeRemovalInfoResponse struct {
-	Removal *StakeRemovalInfo `protobuf:"bytes,1,opt,name=removal,proto3" json:"removal,omitempty"`
-}
-
-func (m *QueryStakeRemovalInfoResponse) Reset()         { *m = QueryStakeRemovalInfoResponse{} }
-func (m *QueryStakeRemovalInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStakeRemovalInfoResponse) ProtoMessage()    {}
-func (*QueryStakeRemovalInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{55}
+func (m *QueryInferenceRequestResponse) Reset()         { *m = QueryInferenceRequestResponse{} }
+func (m *QueryInferenceRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInferenceRequestResponse) ProtoMessage()    {}
+func (*QueryInferenceRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{57}
 }
-func (m *QueryStakeRemovalInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryInferenceRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakeRemovalInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryInferenceRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakeRemovalInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryInferenceRequestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2651,43 +2711,41 @@ func (m *QueryStakeRemovalInfoResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryStakeRemovalInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakeRemovalInfoResponse.Merge(m, src)
+func (m *QueryInferenceRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInferenceRequestResponse.Merge(m, src)
 }
-func (m *QueryStakeRemovalInfoResponse) XXX_Size() int {
+func (m *QueryInferenceRequestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakeRemovalInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakeRemovalInfoResponse.DiscardUnknown(m)
+func (m *QueryInferenceRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInferenceRequestResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakeRemovalInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryInferenceRequestResponse proto.InternalMessageInfo
 
-func (m *QueryStakeRemovalInfoResponse) GetRemoval() *StakeRemovalInfo {
+func (m *QueryInferenceRequestResponse) GetRequest() *InferenceRequest {
 	if m != nil {
-		return m.Removal
+		return m.Request
 	}
 	return nil
 }
 
-type QueryDelegateStakeRemovalInfoRequest struct {
-	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Reputer   string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+type QueryOpenInferenceRequestsRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *QueryDelegateStakeRemovalInfoRequest) Reset()         { *m = QueryDelegateStakeRemovalInfoRequest{} }
-func (m *QueryDelegateStakeRemovalInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegateStakeRemovalInfoRequest) ProtoMessage()    {}
-func (*QueryDelegateStakeRemovalInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{56}
+func (m *QueryOpenInferenceRequestsRequest) Reset()         { *m = QueryOpenInferenceRequestsRequest{} }
+func (m *QueryOpenInferenceRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInferenceRequestsRequest) ProtoMessage()    {}
+func (*QueryOpenInferenceRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{58}
 }
-func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOpenInferenceRequestsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOpenInferenceRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOpenInferenceRequestsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2697,55 +2755,42 @@ func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest.Merge(m, src)
+func (m *QueryOpenInferenceRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInferenceRequestsRequest.Merge(m, src)
 }
-func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Size() int {
+func (m *QueryOpenInferenceRequestsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegateStakeRemovalInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest.DiscardUnknown(m)
+func (m *QueryOpenInferenceRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInferenceRequestsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOpenInferenceRequestsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegateStakeRemovalInfoRequest) GetTopicId() uint64 {
+func (m *QueryOpenInferenceRequestsRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *QueryDelegateStakeRemovalInfoRequest) GetDelegator() string {
-	if m != nil {
-		return m.Delegator
-	}
-	return ""
-}
-
-func (m *QueryDelegateStakeRemovalInfoRequest) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
-}
-
-type QueryDelegateStakeRemovalInfoResponse struct {
-	Removal *DelegateStakeRemovalInfo `protobuf:"bytes,1,opt,name=removal,proto3" json:"removal,omitempty"`
+// Open inference requests of the topic, by nonce ascending
+type QueryOpenInferenceRequestsResponse struct {
+	Requests []*InferenceRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (m *QueryDelegateStakeRemovalInfoResponse) Reset()         { *m = QueryDelegateStakeRemovalInfoResponse{} }
-func (m *QueryDelegateStakeRemovalInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegateStakeRemovalInfoResponse) ProtoMessage()    {}
-func (*QueryDelegateStakeRemovalInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{57}
+func (m *QueryOpenInferenceRequestsResponse) Reset()         { *m = QueryOpenInferenceRequestsResponse{} }
+func (m *QueryOpenInferenceRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInferenceRequestsResponse) ProtoMessage()    {}
+func (*QueryOpenInferenceRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{59}
 }
-func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOpenInferenceRequestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOpenInferenceRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOpenInferenceRequestsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2755,41 +2800,41 @@ func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse.Merge(m, src)
+func (m *QueryOpenInferenceRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInferenceRequestsResponse.Merge(m, src)
 }
-func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Size() int {
+func (m *QueryOpenInferenceRequestsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegateStakeRemovalInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse.DiscardUnknown(m)
+func (m *QueryOpenInferenceRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInferenceRequestsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOpenInferenceRequestsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegateStakeRemovalInfoResponse) GetRemoval() *DelegateStakeRemovalInfo {
+func (m *QueryOpenInferenceRequestsResponse) GetRequests() []*InferenceRequest {
 	if m != nil {
-		return m.Removal
+		return m.Requests
 	}
 	return nil
 }
 
-type QueryTopicLastCommitRequest struct {
+type QueryTopicSubscriptionsRequest struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *QueryTopicLastCommitRequest) Reset()         { *m = QueryTopicLastCommitRequest{} }
-func (m *QueryTopicLastCommitRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTopicLastCommitRequest) ProtoMessage()    {}
-func (*QueryTopicLastCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{58}
+func (m *QueryTopicSubscriptionsRequest) Reset()         { *m = QueryTopicSubscriptionsRequest{} }
+func (m *QueryTopicSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicSubscriptionsRequest) ProtoMessage()    {}
+func (*QueryTopicSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{60}
 }
-func (m *QueryTopicLastCommitRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTopicLastCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTopicLastCommitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicSubscriptionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2799,41 +2844,42 @@ func (m *QueryTopicLastCommitRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTopicLastCommitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTopicLastCommitRequest.Merge(m, src)
+func (m *QueryTopicSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicSubscriptionsRequest.Merge(m, src)
 }
-func (m *QueryTopicLastCommitRequest) XXX_Size() int {
+func (m *QueryTopicSubscriptionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTopicLastCommitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTopicLastCommitRequest.DiscardUnknown(m)
+func (m *QueryTopicSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicSubscriptionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTopicLastCommitRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicSubscriptionsRequest proto.InternalMessageInfo
 
-func (m *QueryTopicLastCommitRequest) GetTopicId() uint64 {
+func (m *QueryTopicSubscriptionsRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type QueryTopicLastCommitResponse struct {
-	LastCommit *TimestampedActorNonce `protobuf:"bytes,1,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"`
+// Active subscriptions of the topic, by id ascending
+type QueryTopicSubscriptionsResponse struct {
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 }
 
-func (m *QueryTopicLastCommitResponse) Reset()         { *m = QueryTopicLastCommitResponse{} }
-func (m *QueryTopicLastCommitResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTopicLastCommitResponse) ProtoMessage()    {}
-func (*QueryTopicLastCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c426af2f1e908986, []int{59}
+func (m *QueryTopicSubscriptionsResponse) Reset()         { *m = QueryTopicSubscriptionsResponse{} }
+func (m *QueryTopicSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicSubscriptionsResponse) ProtoMessage()    {}
+func (*QueryTopicSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{61}
 }
-func (m *QueryTopicLastCommitResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTopicLastCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTopicLastCommitResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicSubscriptionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2843,2706 +2889,3046 @@ func (m *QueryTopicLastCommitResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTopicLastCommitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTopicLastCommitResponse.Merge(m, src)
+func (m *QueryTopicSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicSubscriptionsResponse.Merge(m, src)
 }
-func (m *QueryTopicLastCommitResponse) XXX_Size() int {
+func (m *QueryTopicSubscriptionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTopicLastCommitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTopicLastCommitResponse.DiscardUnknown(m)
+func (m *QueryTopicSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicSubscriptionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTopicLastCommitResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicSubscriptionsResponse proto.InternalMessageInfo
 
-func (m *QueryTopicLastCommitResponse) GetLastCommit() *TimestampedActorNonce {
+func (m *QueryTopicSubscriptionsResponse) GetSubscriptions() []*Subscription {
 	if m != nil {
-		return m.LastCommit
+		return m.Subscriptions
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "emissions.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "emissions.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryTotalStakeRequest)(nil), "emissions.v1.QueryTotalStakeRequest")
-	proto.RegisterType((*QueryTotalStakeResponse)(nil), "emissions.v1.QueryTotalStakeResponse")
-	proto.RegisterType((*QueryReputerStakeInTopicRequest)(nil), "emissions.v1.QueryReputerStakeInTopicRequest")
-	proto.RegisterType((*QueryReputerStakeInTopicResponse)(nil), "emissions.v1.QueryReputerStakeInTopicResponse")
-	proto.RegisterType((*QueryMultiReputerStakeInTopicRequest)(nil), "emissions.v1.QueryMultiReputerStakeInTopicRequest")
-	proto.RegisterType((*QueryMultiReputerStakeInTopicResponse)(nil), "emissions.v1.QueryMultiReputerStakeInTopicResponse")
-	proto.RegisterType((*QueryStakeFromReputerInTopicInSelfRequest)(nil), "emissions.v1.QueryStakeFromReputerInTopicInSelfRequest")
-	proto.RegisterType((*QueryStakeFromReputerInTopicInSelfResponse)(nil), "emissions.v1.QueryStakeFromReputerInTopicInSelfResponse")
-	proto.RegisterType((*QueryDelegateStakeInTopicInReputerRequest)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerRequest")
-	proto.RegisterType((*QueryDelegateStakeInTopicInReputerResponse)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerResponse")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerRequest")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerResponse")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicRequest")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicResponse")
-	proto.RegisterType((*QueryTopicStakeRequest)(nil), "emissions.v1.QueryTopicStakeRequest")
-	proto.RegisterType((*QueryTopicStakeResponse)(nil), "emissions.v1.QueryTopicStakeResponse")
-	proto.RegisterType((*QueryNetworkLossBundleAtBlockRequest)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockRequest")
-	proto.RegisterType((*QueryNetworkLossBundleAtBlockResponse)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockResponse")
-	proto.RegisterType((*QueryNextTopicIdRequest)(nil), "emissions.v1.QueryNextTopicIdRequest")
-	proto.RegisterType((*QueryNextTopicIdResponse)(nil), "emissions.v1.QueryNextTopicIdResponse")
-	proto.RegisterType((*QueryTopicRequest)(nil), "emissions.v1.QueryTopicRequest")
-	proto.RegisterType((*QueryTopicResponse)(nil), "emissions.v1.QueryTopicResponse")
-	proto.RegisterType((*QueryActiveTopicsRequest)(nil), "emissions.v1.QueryActiveTopicsRequest")
-	proto.RegisterType((*QueryActiveTopicsResponse)(nil), "emissions.v1.QueryActiveTopicsResponse")
-	proto.RegisterType((*QueryInferencesAtBlockRequest)(nil), "emissions.v1.QueryInferencesAtBlockRequest")
-	proto.RegisterType((*QueryInferencesAtBlockResponse)(nil), "emissions.v1.QueryInferencesAtBlockResponse")
-	proto.RegisterType((*QueryForecastsAtBlockRequest)(nil), "emissions.v1.QueryForecastsAtBlockRequest")
-	proto.RegisterType((*QueryForecastsAtBlockResponse)(nil), "emissions.v1.QueryForecastsAtBlockResponse")
-	proto.RegisterType((*QueryWorkerLatestInferenceRequest)(nil), "emissions.v1.QueryWorkerLatestInferenceRequest")
-	proto.RegisterType((*QueryWorkerLatestInferenceResponse)(nil), "emissions.v1.QueryWorkerLatestInferenceResponse")
-	proto.RegisterType((*QueryWorkerNodeInfoRequest)(nil), "emissions.v1.QueryWorkerNodeInfoRequest")
-	proto.RegisterType((*QueryWorkerNodeInfoResponse)(nil), "emissions.v1.QueryWorkerNodeInfoResponse")
-	proto.RegisterType((*QueryReputerNodeInfoRequest)(nil), "emissions.v1.QueryReputerNodeInfoRequest")
-	proto.RegisterType((*QueryReputerNodeInfoResponse)(nil), "emissions.v1.QueryReputerNodeInfoResponse")
-	proto.RegisterType((*QueryWorkerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyRequest")
-	proto.RegisterType((*QueryWorkerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyResponse")
-	proto.RegisterType((*QueryReputerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryReputerAddressByP2PKeyRequest")
-	proto.RegisterType((*QueryReputerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryReputerAddressByP2PKeyResponse")
-	proto.RegisterType((*QueryNetworkInferencesAtBlockRequest)(nil), "emissions.v1.QueryNetworkInferencesAtBlockRequest")
-	proto.RegisterType((*QueryLatestNetworkInferencesAtBlockRequest)(nil), "emissions.v1.QueryLatestNetworkInferencesAtBlockRequest")
-	proto.RegisterType((*QueryNetworkInferencesAtBlockResponse)(nil), "emissions.v1.QueryNetworkInferencesAtBlockResponse")
-	proto.RegisterType((*QueryLatestNetworkInferencesAtBlockResponse)(nil), "emissions.v1.QueryLatestNetworkInferencesAtBlockResponse")
-	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdRequest")
-	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdResponse")
-	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdRequest")
-	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdResponse")
-	proto.RegisterType((*QueryIsWhitelistAdminRequest)(nil), "emissions.v1.QueryIsWhitelistAdminRequest")
-	proto.RegisterType((*QueryIsWhitelistAdminResponse)(nil), "emissions.v1.QueryIsWhitelistAdminResponse")
-	proto.RegisterType((*QueryStakeRemovalsForBlockRequest)(nil), "emissions.v1.QueryStakeRemovalsForBlockRequest")
-	proto.RegisterType((*QueryStakeRemovalsForBlockResponse)(nil), "emissions.v1.QueryStakeRemovalsForBlockResponse")
-	proto.RegisterType((*QueryDelegateStakeRemovalsForBlockRequest)(nil), "emissions.v1.QueryDelegateStakeRemovalsForBlockRequest")
-	proto.RegisterType((*QueryDelegateStakeRemovalsForBlockResponse)(nil), "emissions.v1.QueryDelegateStakeRemovalsForBlockResponse")
-	proto.RegisterType((*QueryStakeRemovalInfoRequest)(nil), "emissions.v1.QueryStakeRemovalInfoRequest")
-	proto.RegisterType((*QueryStakeRemovalInfoResponse)(nil), "emissions.v1.QueryStakeRemovalInfoResponse")
-	proto.RegisterType((*QueryDelegateStakeRemovalInfoRequest)(nil), "emissions.v1.QueryDelegateStakeRemovalInfoRequest")
-	proto.RegisterType((*QueryDelegateStakeRemovalInfoResponse)(nil), "emissions.v1.QueryDelegateStakeRemovalInfoResponse")
-	proto.RegisterType((*QueryTopicLastCommitRequest)(nil), "emissions.v1.QueryTopicLastCommitRequest")
-	proto.RegisterType((*QueryTopicLastCommitResponse)(nil), "emissions.v1.QueryTopicLastCommitResponse")
+type QueryConsumerSubscriptionsRequest struct {
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v1/query.proto", fileDescriptor_c426af2f1e908986) }
-
-var fileDescriptor_c426af2f1e908986 = []byte{
-	// 2707 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x14, 0xd9,
-	0xd5, 0xa7, 0xec, 0x19, 0x63, 0x5f, 0xf3, 0xf2, 0x85, 0x01, 0xbb, 0x6c, 0xfc, 0x28, 0x30, 0xf8,
-	0x01, 0x5d, 0xd8, 0x06, 0xec, 0x8f, 0x19, 0xe0, 0x73, 0x33, 0xc1, 0x38, 0x30, 0x86, 0x29, 0x10,
-	0x28, 0x28, 0x49, 0xa7, 0xdc, 0x7d, 0xdd, 0x2e, 0xb9, 0xba, 0xaa, 0xa9, 0xaa, 0x36, 0x58, 0x8e,
-	0x95, 0x4c, 0x34, 0x8b, 0x8c, 0x94, 0x48, 0x91, 0x22, 0x8d, 0xb2, 0xc8, 0x22, 0xd9, 0x65, 0x93,
-	0x09, 0x8a, 0xf2, 0xd0, 0x2c, 0xb2, 0x9f, 0x64, 0x93, 0x51, 0x66, 0x33, 0x9a, 0x05, 0x4a, 0x20,
-	0xd1, 0xe4, 0x6f, 0xc8, 0x2a, 0xea, 0x7b, 0x4f, 0x3d, 0x6e, 0xf5, 0xad, 0x47, 0x33, 0x58, 0xca,
-	0x06, 0xd1, 0x75, 0xcf, 0xe3, 0xf7, 0xbb, 0xaf, 0x73, 0xce, 0x3d, 0x46, 0xfd, 0xa4, 0x66, 0xb8,
-	0xae, 0x61, 0x5b, 0xae, 0xba, 0x39, 0xa3, 0x3e, 0x6a, 0x10, 0x67, 0xab, 0x50, 0x77, 0x6c, 0xcf,
-	0xc6, 0xfb, 0x82, 0x91, 0xc2, 0xe6, 0x8c, 0x3c, 0x55, 0xb6, 0xdd, 0x9a, 0xed, 0xaa, 0xab, 0xba,
-	0x4b, 0x98, 0x98, 0xba, 0x39, 0xb3, 0x4a, 0x3c, 0x7d, 0x46, 0xad, 0xeb, 0x55, 0xc3, 0xd2, 0x3d,
-	0xc3, 0xb6, 0x98, 0xa6, 0xcc, 0xdb, 0xf4, 0xb6, 0xea, 0xc4, 0x85, 0x91, 0xa1, 0xaa, 0x6d, 0x57,
-	0x4d, 0xa2, 0xea, 0x75, 0x43, 0xd5, 0x2d, 0xcb, 0xf6, 0xa8, 0x9a, 0x3f, 0x3a, 0x08, 0x3e, 0x7c,
-	0xf3, 0x51, 0x38, 0x72, 0x9f, 0x5e, 0x33, 0x2c, 0x5b, 0xa5, 0xff, 0xc2, 0xa7, 0x23, 0x55, 0xbb,
-	0x6a, 0xd3, 0xff, 0xaa, 0xcd, 0xff, 0xc1, 0xd7, 0x01, 0x66, 0xa5, 0xc4, 0x06, 0xd8, 0x0f, 0x7f,
-	0x88, 0x03, 0x56, 0xd7, 0x1d, 0xbd, 0xe6, 0x0f, 0x1d, 0xe3, 0x86, 0x2c, 0xbb, 0x42, 0x84, 0x3a,
-	0x8f, 0x6d, 0x67, 0x83, 0x38, 0x30, 0x24, 0x73, 0x43, 0x0e, 0xa9, 0x37, 0xbc, 0x60, 0x2c, 0x36,
-	0x07, 0x76, 0xdd, 0x28, 0x0b, 0x47, 0x5c, 0x4f, 0xdf, 0xf0, 0x5d, 0x0d, 0x71, 0x23, 0x86, 0xb5,
-	0x46, 0x1c, 0x62, 0x95, 0x61, 0x54, 0x39, 0x82, 0xf0, 0xbb, 0xcd, 0xf9, 0xb8, 0x43, 0x61, 0x6b,
-	0xe4, 0x51, 0x83, 0xb8, 0x9e, 0xb2, 0x82, 0x0e, 0x73, 0x5f, 0xdd, 0xba, 0x6d, 0xb9, 0x04, 0xcf,
-	0xa3, 0x2e, 0x46, 0xaf, 0x5f, 0x1a, 0x95, 0x26, 0x7a, 0x67, 0x8f, 0x14, 0xa2, 0xab, 0x59, 0x60,
-	0xd2, 0xc5, 0x9e, 0x4f, 0x9e, 0x8d, 0xec, 0xf9, 0xd5, 0x97, 0x4f, 0xa7, 0x24, 0x0d, 0xc4, 0x95,
-	0x7e, 0x74, 0x94, 0xda, 0xbb, 0x67, 0x7b, 0xba, 0x79, 0xb7, 0x09, 0xce, 0xf7, 0x64, 0xa0, 0x63,
-	0x2d, 0x23, 0xe0, 0xed, 0x06, 0xea, 0xd2, 0x6b, 0x76, 0xc3, 0xf2, 0xa8, 0xb7, 0x9e, 0xe2, 0xb9,
-	0xa6, 0xdd, 0x2f, 0x9e, 0x8d, 0xbc, 0xc1, 0x66, 0xdf, 0xad, 0x6c, 0x14, 0x0c, 0x5b, 0xad, 0xe9,
-	0xde, 0x7a, 0x61, 0xd9, 0xf2, 0xfe, 0xf6, 0xbb, 0xb3, 0x08, 0x96, 0x65, 0xd9, 0xf2, 0xc0, 0x3d,
-	0xd3, 0xbf, 0xf4, 0xda, 0xbf, 0x7f, 0x31, 0x22, 0x29, 0xf7, 0xd1, 0x08, 0x75, 0xa5, 0xb1, 0x29,
-	0xa5, 0xce, 0x96, 0xad, 0x7b, 0xcd, 0x49, 0x04, 0x34, 0xb8, 0x1f, 0xed, 0xd5, 0x2b, 0x15, 0x87,
-	0xb8, 0x8c, 0x61, 0x8f, 0xe6, 0xff, 0xc4, 0x03, 0xa8, 0x9b, 0x4e, 0x77, 0xc9, 0xa8, 0xf4, 0x77,
-	0x8c, 0x4a, 0x13, 0xaf, 0x69, 0x7b, 0xe9, 0xef, 0xe5, 0x8a, 0xe2, 0xa0, 0xd1, 0x64, 0xbb, 0xbb,
-	0xc4, 0xa5, 0x84, 0x4e, 0x52, 0x9f, 0xef, 0x34, 0x4c, 0xcf, 0x48, 0x21, 0x34, 0x84, 0x7a, 0x80,
-	0x01, 0x69, 0x52, 0xea, 0x9c, 0xe8, 0xd1, 0xc2, 0x0f, 0x69, 0xa4, 0x1e, 0xa2, 0xf1, 0x0c, 0x07,
-	0xc0, 0x6c, 0x06, 0xed, 0x65, 0xc8, 0x98, 0xfd, 0xde, 0xd9, 0x63, 0xfc, 0xa6, 0x00, 0xa5, 0x35,
-	0x5b, 0xf3, 0xe5, 0x14, 0x1b, 0x4d, 0x52, 0xdb, 0x74, 0xe8, 0xba, 0x63, 0xd7, 0xc0, 0x3e, 0x98,
-	0x5e, 0xb6, 0xee, 0x12, 0x73, 0xcd, 0x67, 0x70, 0x1a, 0x1d, 0x84, 0x33, 0x50, 0xe2, 0x97, 0xe6,
-	0x00, 0x7c, 0x5e, 0xcc, 0x5e, 0xa1, 0xef, 0xa2, 0xa9, 0x3c, 0x0e, 0x77, 0x69, 0xad, 0x7c, 0xba,
-	0x6f, 0x13, 0x93, 0x54, 0x75, 0x8f, 0x44, 0xa7, 0x71, 0xd9, 0x02, 0x28, 0xbb, 0x41, 0x37, 0xc3,
-	0xe1, 0x2e, 0xd1, 0xfd, 0xa5, 0x84, 0x0a, 0xfc, 0x6c, 0x03, 0x0e, 0xdb, 0x49, 0x22, 0x3d, 0x8d,
-	0xfa, 0x2a, 0xbe, 0x4c, 0x8c, 0xf6, 0xa1, 0x60, 0xc0, 0x27, 0x2e, 0x98, 0xa1, 0x8e, 0xcc, 0x19,
-	0xea, 0xe4, 0x67, 0xe8, 0x3d, 0x09, 0xa9, 0xb9, 0x31, 0xee, 0xda, 0xb6, 0x18, 0x4f, 0x87, 0xf0,
-	0x52, 0xb3, 0x93, 0xb2, 0x2d, 0x9e, 0xa0, 0x53, 0x59, 0x0e, 0x77, 0x89, 0xea, 0x5c, 0x70, 0xfd,
-	0xd7, 0x8d, 0x72, 0xf4, 0xfa, 0xe7, 0xe0, 0x4a, 0x3c, 0xdc, 0x30, 0x32, 0x84, 0x4a, 0xbb, 0x84,
-	0xaf, 0x02, 0xb7, 0xe9, 0x0a, 0xf1, 0x9a, 0xa1, 0xf8, 0x96, 0xed, 0xba, 0xc5, 0x86, 0x55, 0x31,
-	0xc9, 0xa2, 0x57, 0x34, 0xed, 0xf2, 0x46, 0x36, 0x5a, 0x3c, 0x86, 0xf6, 0xad, 0x36, 0x45, 0x4b,
-	0xeb, 0xc4, 0xa8, 0xae, 0x7b, 0x74, 0xee, 0x3b, 0xb5, 0x5e, 0xfa, 0xed, 0x06, 0xfd, 0xa4, 0x94,
-	0x61, 0xc1, 0x93, 0xbd, 0x00, 0xbd, 0x4b, 0xa8, 0xd7, 0xb4, 0x5d, 0xb7, 0xb4, 0x4a, 0x47, 0x21,
-	0xd6, 0x0e, 0xf0, 0xd7, 0xea, 0x7d, 0xdd, 0x6c, 0x10, 0xa6, 0xae, 0x21, 0x33, 0x30, 0xa5, 0x0c,
-	0xc0, 0xac, 0xad, 0x90, 0x27, 0x1e, 0xdb, 0xc8, 0x15, 0x3f, 0xd4, 0x5e, 0x41, 0xfd, 0xad, 0x43,
-	0xe0, 0x52, 0x41, 0xfb, 0x2d, 0xf2, 0xc4, 0x2b, 0xc5, 0xe8, 0xf5, 0x5a, 0xa1, 0xac, 0x52, 0x40,
-	0x7d, 0xe1, 0x82, 0xe4, 0x58, 0xc0, 0xf7, 0x25, 0xc8, 0x2d, 0xf8, 0xcd, 0x35, 0x89, 0x5e, 0xa7,
-	0x12, 0xc0, 0xeb, 0x30, 0xcf, 0x8b, 0xc9, 0x32, 0x09, 0x7c, 0x14, 0x75, 0x3d, 0x0e, 0xa7, 0xb3,
-	0x47, 0x83, 0x5f, 0xcd, 0x13, 0x41, 0xd6, 0xd6, 0x48, 0xd9, 0x33, 0x36, 0x49, 0xc9, 0x21, 0x9b,
-	0xc4, 0x6a, 0x10, 0x7a, 0xc4, 0x7b, 0xb4, 0x43, 0xc1, 0x80, 0xc6, 0xbe, 0x2b, 0x55, 0xa0, 0xbd,
-	0x48, 0xbf, 0x52, 0xfb, 0x7e, 0x9e, 0x83, 0x6f, 0x22, 0x14, 0xe6, 0x99, 0x00, 0x68, 0x3a, 0x16,
-	0xbf, 0x8c, 0x5a, 0xdd, 0x24, 0xd7, 0x1a, 0x8e, 0x6b, 0x3b, 0x77, 0x02, 0x59, 0x30, 0xa0, 0x45,
-	0xd4, 0x95, 0x0f, 0x25, 0x34, 0x20, 0xf0, 0x04, 0xb4, 0xa7, 0x51, 0x17, 0x25, 0xe5, 0x87, 0x49,
-	0x21, 0x6f, 0x10, 0xc1, 0xb7, 0x38, 0x5c, 0x1d, 0x14, 0xd7, 0x99, 0x7c, 0xb8, 0x98, 0x3b, 0x0e,
-	0xd8, 0xb7, 0xd0, 0x71, 0x8a, 0x6b, 0xd9, 0xcf, 0xfd, 0xdc, 0x57, 0xba, 0xaf, 0x1f, 0xa2, 0xe1,
-	0x24, 0xf3, 0xc0, 0x7d, 0x01, 0xa1, 0x20, 0xef, 0xf4, 0x73, 0xc7, 0x7e, 0x9e, 0x4e, 0xa8, 0xac,
-	0x45, 0x64, 0x95, 0x6f, 0xa2, 0x21, 0x6a, 0xfb, 0xba, 0xed, 0x90, 0xb2, 0xee, 0x7a, 0xaf, 0x16,
-	0xf9, 0x7d, 0x98, 0x98, 0x56, 0xeb, 0x00, 0xfc, 0x02, 0xea, 0x59, 0xf3, 0xc7, 0x00, 0x77, 0x2c,
-	0xbd, 0x09, 0x54, 0xb5, 0x50, 0x52, 0x21, 0x68, 0x8c, 0xda, 0x7d, 0x40, 0xf3, 0xfa, 0x5b, 0xba,
-	0x47, 0x5c, 0x2f, 0x20, 0x98, 0x03, 0xfa, 0x38, 0x3a, 0xc0, 0x4a, 0x82, 0x58, 0x84, 0xdb, 0xcf,
-	0xbe, 0xc2, 0x5d, 0xaf, 0xac, 0x23, 0x25, 0xcd, 0x0d, 0x70, 0x28, 0xa2, 0x43, 0x26, 0x1d, 0x2a,
-	0x05, 0xf3, 0x2a, 0xa6, 0x12, 0xaa, 0x1e, 0x34, 0x79, 0x5b, 0xca, 0x9b, 0x48, 0x8e, 0x78, 0x5a,
-	0xb1, 0x2b, 0x2c, 0xa3, 0x03, 0x26, 0xc7, 0x11, 0x32, 0x8d, 0xd5, 0xfa, 0x6c, 0xbd, 0xb4, 0x41,
-	0xb6, 0x20, 0x32, 0xf5, 0xb0, 0x2f, 0x37, 0xc9, 0x96, 0x72, 0x1f, 0x0d, 0x0a, 0x95, 0x83, 0xa2,
-	0xa2, 0xa7, 0x59, 0x18, 0x35, 0xd1, 0xd9, 0x00, 0x4c, 0xe6, 0x81, 0xdd, 0x5e, 0x5b, 0x2b, 0xaf,
-	0xeb, 0x86, 0xd5, 0x54, 0xd5, 0xba, 0x2d, 0x30, 0xa0, 0xbc, 0x05, 0x76, 0x21, 0x50, 0xb7, 0x89,
-	0xea, 0x01, 0xec, 0xac, 0x16, 0xed, 0xaf, 0x0a, 0xab, 0xc8, 0x2d, 0x3e, 0xac, 0x55, 0x71, 0xeb,
-	0xce, 0xec, 0x9d, 0x9b, 0x64, 0x2b, 0x27, 0xb8, 0x2b, 0xdc, 0xca, 0xb6, 0xd8, 0x00, 0x88, 0x89,
-	0xd5, 0x8a, 0x72, 0x0d, 0xf4, 0x35, 0x2e, 0x23, 0x6a, 0x13, 0xc4, 0x55, 0x74, 0x22, 0xd5, 0x48,
-	0x26, 0x8a, 0x8f, 0x25, 0x3e, 0xae, 0xbe, 0xcc, 0xfd, 0x73, 0x19, 0x0d, 0x46, 0x4f, 0x71, 0xc9,
-	0xd4, 0xb9, 0x8d, 0xcc, 0x0e, 0x75, 0x7f, 0xe4, 0x50, 0xdf, 0xd2, 0x23, 0x1b, 0x17, 0xcf, 0xa3,
-	0xfe, 0x56, 0x75, 0x87, 0x3c, 0xd6, 0x1d, 0x96, 0x13, 0x76, 0x6a, 0x6f, 0xc4, 0x74, 0x35, 0x3a,
-	0xa8, 0x2c, 0x41, 0x0e, 0xcd, 0x4e, 0xd5, 0xcb, 0x13, 0x50, 0x1e, 0xf1, 0x51, 0x3f, 0xf9, 0x92,
-	0xbc, 0x81, 0xb0, 0xc5, 0x64, 0x4a, 0x2d, 0x97, 0x65, 0x4a, 0xf0, 0xef, 0xb3, 0xe2, 0x86, 0x95,
-	0xff, 0x74, 0xa0, 0xe9, 0x5c, 0xe0, 0x5f, 0xb5, 0x67, 0x7c, 0x13, 0x1d, 0x64, 0x16, 0x9c, 0x12,
-	0x0b, 0xd5, 0xcd, 0x9b, 0xab, 0x19, 0xed, 0x14, 0xde, 0x8c, 0x46, 0xaa, 0x0e, 0x69, 0x2e, 0x93,
-	0xed, 0xd4, 0x48, 0xe5, 0x01, 0x15, 0xd5, 0x0e, 0x80, 0x2a, 0xfb, 0xe9, 0xe2, 0x77, 0x11, 0xf6,
-	0xaf, 0xd4, 0x88, 0xbd, 0xce, 0xdc, 0xf6, 0xfa, 0x42, 0x6d, 0xdf, 0xa4, 0x8e, 0x06, 0xfc, 0x8f,
-	0xcb, 0xb5, 0xba, 0x69, 0x90, 0x4a, 0x08, 0xbe, 0xff, 0x35, 0x6a, 0xf9, 0x04, 0x6f, 0x19, 0x4e,
-	0xa0, 0xe7, 0x39, 0xc6, 0x6a, 0xc3, 0x23, 0x15, 0x3a, 0x01, 0x5a, 0xb2, 0x15, 0xe5, 0xdb, 0xe8,
-	0x34, 0x8b, 0x86, 0x2e, 0x53, 0xd5, 0x48, 0xd5, 0x68, 0x42, 0x68, 0x0a, 0xf1, 0x09, 0x59, 0xda,
-	0xb6, 0x8f, 0x1c, 0xaa, 0x0e, 0xfe, 0x50, 0xdd, 0x46, 0x13, 0xd9, 0xf6, 0x61, 0x61, 0x4f, 0xa0,
-	0xfd, 0x86, 0x5b, 0x72, 0x02, 0x09, 0xea, 0xa5, 0x5b, 0xdb, 0x67, 0xb8, 0xa1, 0x96, 0x52, 0x0a,
-	0x0c, 0x06, 0x15, 0xcf, 0xab, 0x45, 0x7c, 0x07, 0xea, 0xdf, 0x74, 0x07, 0xed, 0x40, 0x5e, 0x80,
-	0xbb, 0x7b, 0xd9, 0x7d, 0xb0, 0x6e, 0x78, 0xc4, 0x34, 0x5c, 0x6f, 0xb1, 0x52, 0x33, 0xac, 0xcc,
-	0x67, 0x1c, 0xe5, 0x92, 0x9f, 0x0a, 0xb5, 0x68, 0x82, 0xff, 0x01, 0xd4, 0x6d, 0xb8, 0x25, 0xbd,
-	0xf9, 0x0d, 0x5c, 0xef, 0x35, 0x5c, 0x2a, 0xa2, 0x5c, 0x87, 0x8b, 0x1d, 0x6a, 0x91, 0x9a, 0xbd,
-	0xa9, 0x9b, 0xee, 0x75, 0xdb, 0xe1, 0x6e, 0x82, 0x78, 0xd6, 0x21, 0xb5, 0x66, 0x1d, 0xdf, 0x81,
-	0xcb, 0x39, 0xc1, 0x4e, 0x50, 0x04, 0x74, 0x3b, 0x30, 0x06, 0x19, 0xe3, 0xb0, 0xe0, 0x61, 0x05,
-	0xd4, 0x69, 0xe4, 0x0a, 0xe4, 0x95, 0x15, 0xd1, 0x8b, 0xc3, 0x57, 0x40, 0x5c, 0x17, 0x3d, 0x28,
-	0x24, 0x22, 0x2f, 0xb6, 0x20, 0x3f, 0xc5, 0x23, 0x17, 0x99, 0x89, 0x31, 0xb8, 0x0b, 0x2b, 0xdc,
-	0x22, 0x92, 0x6b, 0x23, 0xc2, 0x43, 0x80, 0xbf, 0x11, 0xe1, 0xa7, 0xf2, 0x0d, 0x58, 0xfc, 0x56,
-	0xa3, 0x41, 0x9e, 0xba, 0x17, 0x10, 0xc0, 0xed, 0x97, 0x35, 0xe5, 0xbe, 0xb8, 0xb2, 0x05, 0x91,
-	0x2e, 0x91, 0x5a, 0x36, 0xee, 0x21, 0xd4, 0x13, 0x54, 0xf3, 0x80, 0x3c, 0xfc, 0x10, 0x65, 0xd5,
-	0xc9, 0xb3, 0x32, 0x20, 0xc0, 0x24, 0xbb, 0x06, 0x76, 0xff, 0x1f, 0x67, 0x97, 0x77, 0x59, 0x02,
-	0x96, 0x0b, 0x90, 0x71, 0xd1, 0x43, 0xdb, 0x0c, 0x96, 0xd7, 0xec, 0x5a, 0xcd, 0xf0, 0x72, 0x44,
-	0xc1, 0x0a, 0xac, 0x67, 0x8b, 0x26, 0x60, 0x7b, 0x1b, 0xf5, 0xd2, 0xd0, 0x5c, 0xa6, 0x9f, 0x01,
-	0x5f, 0xec, 0x2a, 0xbe, 0x67, 0xd4, 0x88, 0xeb, 0xe9, 0xb5, 0x3a, 0xa9, 0x2c, 0x96, 0x3d, 0xdb,
-	0x59, 0xb1, 0x9b, 0xb9, 0x2a, 0x32, 0x03, 0x6b, 0xb3, 0x4f, 0xcf, 0xa2, 0xd7, 0xa9, 0x1b, 0xbc,
-	0x81, 0xba, 0xd8, 0x6b, 0x34, 0x1e, 0xe5, 0x8d, 0xb4, 0x3e, 0x76, 0xcb, 0x63, 0x29, 0x12, 0x0c,
-	0x9e, 0x32, 0xf4, 0x83, 0xcf, 0xfe, 0xf9, 0xd3, 0x8e, 0xa3, 0xf8, 0x88, 0x2a, 0x78, 0xeb, 0xc7,
-	0x1f, 0x48, 0xe8, 0xc0, 0x12, 0xf1, 0x22, 0x75, 0x35, 0x1e, 0x17, 0xd8, 0x6c, 0x2d, 0xc9, 0xe5,
-	0x53, 0x59, 0x62, 0xe0, 0x7f, 0xe2, 0x87, 0x5f, 0x3e, 0x9d, 0x92, 0x28, 0x88, 0xe3, 0x78, 0x90,
-	0x07, 0xc1, 0xd5, 0xed, 0x78, 0x1b, 0x75, 0x2f, 0x11, 0xa6, 0x8f, 0x47, 0x04, 0xd6, 0xa3, 0xc5,
-	0xbb, 0x3c, 0x9a, 0x2c, 0x00, 0x8e, 0xcf, 0x84, 0x8e, 0xc7, 0xf0, 0x88, 0xda, 0xda, 0x7e, 0x70,
-	0xd5, 0x6d, 0xdf, 0xf7, 0x0e, 0xfe, 0x91, 0x84, 0x0e, 0x2e, 0x11, 0x2f, 0x5a, 0xff, 0x62, 0x11,
-	0x45, 0x41, 0x29, 0x2e, 0x9f, 0xce, 0x94, 0xcb, 0x31, 0x17, 0x3a, 0x7b, 0x12, 0x80, 0x2a, 0xfa,
-	0x99, 0x84, 0xc6, 0x96, 0x88, 0x27, 0x2c, 0x8f, 0x8a, 0x5b, 0xfe, 0x52, 0xa9, 0x02, 0xc7, 0x69,
-	0x85, 0x9b, 0x7c, 0x2e, 0xbf, 0x02, 0x40, 0xbe, 0x1f, 0x42, 0xbe, 0x89, 0x97, 0x33, 0x66, 0x11,
-	0xba, 0x41, 0xae, 0xba, 0xcd, 0xd7, 0x80, 0x3b, 0x6a, 0xbc, 0x8c, 0xc3, 0xbf, 0x95, 0xd0, 0x91,
-	0x25, 0xe2, 0xb5, 0x64, 0x76, 0x78, 0x5a, 0x00, 0x31, 0x29, 0x79, 0x95, 0xcf, 0xe4, 0x13, 0x06,
-	0x2e, 0x57, 0x43, 0x2e, 0xe7, 0xf1, 0xac, 0x2a, 0x6e, 0x2e, 0x71, 0x7c, 0xb6, 0xa3, 0xe1, 0x65,
-	0x07, 0x7f, 0x24, 0xa1, 0xc3, 0x4b, 0xc4, 0x8b, 0xd7, 0xdc, 0x78, 0x4a, 0x00, 0x23, 0xa1, 0xec,
-	0x97, 0xa7, 0x73, 0xc9, 0x02, 0xe2, 0x2b, 0x21, 0xe2, 0x39, 0x3c, 0xc3, 0x23, 0x0e, 0x6a, 0xf6,
-	0x14, 0xc0, 0x7f, 0x96, 0xd0, 0x20, 0x3d, 0xde, 0xe2, 0x67, 0x3b, 0x3c, 0x2b, 0x3c, 0xc4, 0xa9,
-	0x2f, 0x89, 0xf2, 0x5c, 0x5b, 0x3a, 0x40, 0x64, 0x31, 0x24, 0x72, 0x11, 0x9f, 0x8f, 0xdf, 0x02,
-	0x2c, 0x81, 0x37, 0x6d, 0x37, 0x8d, 0xcb, 0xfb, 0x12, 0xda, 0x4f, 0xef, 0x07, 0xbf, 0xdb, 0x86,
-	0x4f, 0x0a, 0xef, 0x80, 0x58, 0x9b, 0x4e, 0x1e, 0xcf, 0x90, 0x02, 0x84, 0xa7, 0x42, 0x84, 0x83,
-	0x78, 0x20, 0xbe, 0xd1, 0x3d, 0xdd, 0x2c, 0xd1, 0xce, 0x24, 0xfe, 0x83, 0x84, 0x8e, 0x2e, 0x11,
-	0x4f, 0xd0, 0x57, 0xc2, 0x67, 0x05, 0x9e, 0x92, 0x1b, 0x5c, 0x72, 0x21, 0xaf, 0x38, 0x20, 0xbc,
-	0x1c, 0x22, 0x9c, 0xc5, 0xe7, 0x54, 0x51, 0xaf, 0x95, 0x61, 0x54, 0xb7, 0x83, 0x73, 0x17, 0xb9,
-	0xe1, 0xfe, 0xc4, 0xf6, 0x42, 0x52, 0x57, 0x4c, 0xb8, 0x17, 0x32, 0x7a, 0x74, 0xc2, 0xbd, 0x90,
-	0xd5, 0x76, 0x53, 0x2e, 0x84, 0x3c, 0xa6, 0xf0, 0x84, 0x90, 0x87, 0xcb, 0x88, 0x70, 0x37, 0xf4,
-	0x3f, 0x24, 0x34, 0xba, 0x44, 0xbc, 0xd4, 0x46, 0x18, 0x9e, 0x17, 0x00, 0xca, 0xd3, 0xab, 0x93,
-	0x17, 0xda, 0x57, 0x04, 0x3a, 0x5f, 0x0f, 0xe9, 0x5c, 0xc5, 0x97, 0x53, 0x96, 0xa5, 0xe4, 0x12,
-	0x73, 0x4d, 0xdd, 0x8e, 0x75, 0x80, 0xb8, 0x35, 0xfa, 0x17, 0xe3, 0x98, 0xda, 0xfd, 0x12, 0x72,
-	0xcc, 0xd3, 0xa0, 0x13, 0x72, 0xcc, 0xd5, 0x68, 0x53, 0x56, 0x42, 0x8e, 0xd7, 0xf0, 0xa2, 0x98,
-	0x23, 0x24, 0x7c, 0xc4, 0xdf, 0x83, 0x69, 0x3c, 0xbf, 0xdf, 0x81, 0xc6, 0xa3, 0x6b, 0x99, 0xd8,
-	0xc2, 0xc2, 0x6f, 0xa5, 0xad, 0x4b, 0x56, 0x77, 0x4e, 0xbe, 0xfc, 0x92, 0xda, 0x40, 0xfb, 0x61,
-	0x48, 0xfb, 0x36, 0x7e, 0x87, 0xa7, 0x1d, 0xa7, 0xdb, 0xd2, 0xe7, 0xda, 0x49, 0x9f, 0x82, 0xcf,
-	0x24, 0x34, 0x94, 0x36, 0x05, 0x78, 0xae, 0x1d, 0xec, 0x3e, 0xe1, 0xf3, 0xed, 0x29, 0x01, 0xcf,
-	0x1b, 0x21, 0xcf, 0xcb, 0xf8, 0xcd, 0xf6, 0x79, 0x86, 0xac, 0x3e, 0xf0, 0x2f, 0x69, 0xbf, 0xf1,
-	0x95, 0x70, 0x49, 0xc7, 0x9a, 0x69, 0x09, 0x97, 0x74, 0xbc, 0x7b, 0xa6, 0x4c, 0x87, 0x40, 0x47,
-	0xf1, 0xb0, 0xda, 0xfa, 0x87, 0x23, 0x51, 0x2c, 0x7f, 0x94, 0x50, 0xbf, 0x3f, 0xc3, 0xf1, 0x8a,
-	0x4f, 0x98, 0x3a, 0xa5, 0xd5, 0x9a, 0xc2, 0xd4, 0x29, 0xb5, 0x98, 0x54, 0x16, 0x42, 0xb0, 0x67,
-	0xf1, 0xb4, 0x00, 0x6c, 0xc9, 0xaf, 0x19, 0xe3, 0xa1, 0xee, 0x73, 0xc1, 0x35, 0xd0, 0xc2, 0x20,
-	0xf3, 0x1a, 0x48, 0x62, 0xb2, 0xd0, 0xbe, 0x62, 0x8e, 0x28, 0xce, 0xef, 0x93, 0x44, 0x6a, 0xbf,
-	0x66, 0x29, 0x54, 0xbc, 0x50, 0x13, 0xa6, 0x50, 0x09, 0x95, 0xa8, 0x30, 0x85, 0x4a, 0x2a, 0x1d,
-	0x53, 0xa3, 0x26, 0x07, 0x95, 0x4b, 0x3d, 0xe0, 0xe4, 0xee, 0xe0, 0x2f, 0x58, 0xd4, 0x4c, 0x2a,
-	0x30, 0x85, 0x51, 0x33, 0xa3, 0x92, 0x16, 0x46, 0xcd, 0xac, 0x12, 0x58, 0xd1, 0x42, 0x1e, 0x4b,
-	0xf8, 0x6b, 0x79, 0xe6, 0x9e, 0x23, 0x14, 0x1c, 0xdb, 0x28, 0xb9, 0x9f, 0x49, 0xa8, 0x2f, 0xa8,
-	0x32, 0xfc, 0x36, 0x02, 0x9e, 0x48, 0x2c, 0x12, 0x62, 0x7d, 0x0a, 0x79, 0x32, 0x87, 0x24, 0xc0,
-	0x2f, 0x84, 0xf0, 0x4f, 0xe0, 0x31, 0x55, 0xf0, 0x37, 0x64, 0xea, 0x76, 0xf8, 0xa2, 0xbf, 0x83,
-	0x7f, 0x2e, 0x21, 0x1c, 0xa6, 0x59, 0x01, 0xb6, 0xc9, 0xe4, 0x9c, 0x29, 0x0e, 0x6e, 0x2a, 0x8f,
-	0x28, 0xa0, 0x53, 0x43, 0x74, 0x27, 0xb1, 0x22, 0x8c, 0x6f, 0x3c, 0xbc, 0xdf, 0xb3, 0xbb, 0x45,
-	0xd8, 0xe4, 0x48, 0x29, 0xcb, 0xc4, 0xdd, 0x8c, 0x94, 0xb2, 0x2c, 0xa1, 0x73, 0xa1, 0x5c, 0x0c,
-	0x01, 0x4f, 0xe3, 0x49, 0xd1, 0x74, 0xfa, 0xd7, 0x33, 0x8f, 0xfb, 0x63, 0x09, 0x0d, 0x84, 0xd3,
-	0x1a, 0x07, 0x7e, 0x2e, 0x79, 0xca, 0x12, 0x90, 0xcf, 0xb4, 0xa1, 0x01, 0xd0, 0xe7, 0x43, 0xe8,
-	0x67, 0xf0, 0x94, 0x38, 0x97, 0x10, 0x62, 0xff, 0xab, 0x84, 0x06, 0x53, 0x9e, 0x8e, 0xf1, 0x05,
-	0x51, 0x31, 0x98, 0xf9, 0x94, 0x2d, 0x5f, 0x6c, 0x57, 0x2d, 0xc7, 0x65, 0x08, 0x4b, 0x10, 0xbe,
-	0x05, 0x73, 0x67, 0xd1, 0x0f, 0x9c, 0x34, 0x07, 0x48, 0x7b, 0x5a, 0xc6, 0x62, 0x6c, 0x99, 0x8f,
-	0xdd, 0xf2, 0x7c, 0xdb, 0x7a, 0x40, 0xaa, 0x18, 0x92, 0x9a, 0xc7, 0x17, 0xc4, 0x8b, 0x93, 0xc5,
-	0xea, 0xc3, 0x8e, 0x68, 0xd1, 0xd9, 0x5a, 0xe1, 0xa7, 0x14, 0x9d, 0x89, 0x85, 0xfe, 0x5c, 0x5b,
-	0x3a, 0x40, 0xe6, 0xc7, 0x52, 0xc8, 0xe6, 0x3d, 0x09, 0x7f, 0x4f, 0x5c, 0x76, 0x8a, 0x2b, 0x7f,
-	0xbe, 0x59, 0xc7, 0x47, 0xb1, 0x58, 0x27, 0x0f, 0x84, 0x59, 0x6b, 0x4e, 0x24, 0xc9, 0x46, 0x76,
-	0xf0, 0x5f, 0xd8, 0xe1, 0x13, 0xb7, 0xb6, 0xb0, 0x28, 0x2c, 0xe7, 0x6a, 0xe1, 0xc9, 0xff, 0xf7,
-	0x12, 0x9a, 0x39, 0x72, 0x94, 0xd4, 0x09, 0x6a, 0x5e, 0xd0, 0x87, 0xe2, 0xad, 0x08, 0x61, 0x14,
-	0x4f, 0xe8, 0x74, 0x08, 0xa3, 0x78, 0x52, 0x6f, 0x43, 0x51, 0x29, 0xc4, 0x49, 0x7c, 0x3a, 0x76,
-	0xce, 0x7c, 0x69, 0xd6, 0xf6, 0x88, 0x6c, 0xc2, 0x8f, 0x58, 0x7a, 0x1d, 0x3c, 0xda, 0xb2, 0xf3,
-	0xcc, 0x1e, 0x5b, 0x13, 0x23, 0x89, 0xf8, 0x71, 0x58, 0x18, 0x49, 0x12, 0x5e, 0x83, 0x53, 0x6f,
-	0x37, 0x36, 0x79, 0x91, 0xc7, 0xe2, 0xe8, 0x7c, 0xfe, 0x46, 0x42, 0xc7, 0xa3, 0x80, 0xe1, 0xb0,
-	0xfe, 0xef, 0x22, 0x2e, 0x6a, 0x9f, 0x3c, 0x1f, 0x96, 0x3e, 0x7d, 0x3e, 0x2c, 0xfd, 0xfd, 0xf9,
-	0xb0, 0xf4, 0x93, 0x17, 0xc3, 0x7b, 0x3e, 0x7d, 0x31, 0xbc, 0xe7, 0xf3, 0x17, 0xc3, 0x7b, 0x1e,
-	0x2e, 0x54, 0x0d, 0x6f, 0xbd, 0xb1, 0x5a, 0x28, 0xdb, 0x35, 0x55, 0x37, 0x4d, 0xdb, 0xd1, 0xcf,
-	0xc2, 0x66, 0xf2, 0x7f, 0xd2, 0xbf, 0x3e, 0x50, 0x9f, 0x44, 0xbc, 0xd1, 0xbf, 0x8a, 0x5f, 0xed,
-	0xa2, 0x7f, 0xda, 0x3d, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0xde, 0xd8, 0x0a, 0x86,
-	0x2f, 0x00, 0x00,
+func (m *QueryConsumerSubscriptionsRequest) Reset()         { *m = QueryConsumerSubscriptionsRequest{} }
+func (m *QueryConsumerSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerSubscriptionsRequest) ProtoMessage()    {}
+func (*QueryConsumerSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{62}
 }
-
-func (this *QueryTotalStakeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*QueryTotalStakeResponse)
-	if !ok {
-		that2, ok := that.(QueryTotalStakeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryConsumerSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
-func (this *QueryReputerStakeInTopicResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *QueryConsumerSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerSubscriptionsRequest.Merge(m, src)
+}
+func (m *QueryConsumerSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerSubscriptionsRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
 	}
+	return ""
+}
 
-	that1, ok := that.(*QueryReputerStakeInTopicResponse)
-	if !ok {
-		that2, ok := that.(QueryReputerStakeInTopicResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Active subscriptions of the consumer, by id ascending
+type QueryConsumerSubscriptionsResponse struct {
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (m *QueryConsumerSubscriptionsResponse) Reset()         { *m = QueryConsumerSubscriptionsResponse{} }
+func (m *QueryConsumerSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerSubscriptionsResponse) ProtoMessage()    {}
+func (*QueryConsumerSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{63}
+}
+func (m *QueryConsumerSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
-func (this *QueryStakeFromReputerInTopicInSelfResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *QueryConsumerSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerSubscriptionsResponse.Merge(m, src)
+}
+func (m *QueryConsumerSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if m != nil {
+		return m.Subscriptions
 	}
+	return nil
+}
 
-	that1, ok := that.(*QueryStakeFromReputerInTopicInSelfResponse)
-	if !ok {
-		that2, ok := that.(QueryStakeFromReputerInTopicInSelfResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
+type QueryRoleMembersRequest struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 }
-func (this *QueryDelegateStakeInTopicInReputerResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryDelegateStakeInTopicInReputerResponse)
-	if !ok {
-		that2, ok := that.(QueryDelegateStakeInTopicInReputerResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryRoleMembersRequest) Reset()         { *m = QueryRoleMembersRequest{} }
+func (m *QueryRoleMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleMembersRequest) ProtoMessage()    {}
+func (*QueryRoleMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{64}
+}
+func (m *QueryRoleMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
-func (this *QueryStakeFromDelegatorInTopicInReputerResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryRoleMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleMembersRequest.Merge(m, src)
+}
+func (m *QueryRoleMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleMembersRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryStakeFromDelegatorInTopicInReputerResponse)
-	if !ok {
-		that2, ok := that.(QueryStakeFromDelegatorInTopicInReputerResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+var xxx_messageInfo_QueryRoleMembersRequest proto.InternalMessageInfo
+
+func (m *QueryRoleMembersRequest) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return true
+	return ""
 }
-func (this *QueryStakeFromDelegatorInTopicResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryStakeFromDelegatorInTopicResponse)
-	if !ok {
-		that2, ok := that.(QueryStakeFromDelegatorInTopicResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
+type QueryRoleMembersResponse struct {
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 }
-func (this *QueryTopicStakeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryTopicStakeResponse)
-	if !ok {
-		that2, ok := that.(QueryTopicStakeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryRoleMembersResponse) Reset()         { *m = QueryRoleMembersResponse{} }
+func (m *QueryRoleMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleMembersResponse) ProtoMessage()    {}
+func (*QueryRoleMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{65}
+}
+func (m *QueryRoleMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
+}
+func (m *QueryRoleMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleMembersResponse.Merge(m, src)
+}
+func (m *QueryRoleMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleMembersResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRoleMembersResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error)
-	GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error)
-	GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error)
-	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error)
-	GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error)
-	GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error)
-	GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error)
-	GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error)
-	GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error)
-	GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error)
-	GetStakeFromReputerInTopicInSelf(ctx context.Context, in *QueryStakeFromReputerInTopicInSelfRequest, opts ...grpc.CallOption) (*QueryStakeFromReputerInTopicInSelfResponse, error)
-	GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error)
-	GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error)
-	GetStakeRemovalsForBlock(ctx context.Context, in *QueryStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryStakeRemovalsForBlockResponse, error)
-	GetDelegateStakeRemovalsForBlock(ctx context.Context, in *QueryDelegateStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalsForBlockResponse, error)
-	GetStakeRemovalInfo(ctx context.Context, in *QueryStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryStakeRemovalInfoResponse, error)
-	GetDelegateStakeRemovalInfo(ctx context.Context, in *QueryDelegateStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalInfoResponse, error)
-	GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error)
-	GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error)
-	GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error)
-	GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error)
-	IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
-	IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error)
-	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
-	GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesAtBlockResponse, error)
-	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
-	GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
-	GetTopicLastReputerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
+func (m *QueryRoleMembersResponse) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryAddressRolesRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAddressRolesRequest) Reset()         { *m = QueryAddressRolesRequest{} }
+func (m *QueryAddressRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressRolesRequest) ProtoMessage()    {}
+func (*QueryAddressRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{66}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAddressRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAddressRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressRolesRequest.Merge(m, src)
+}
+func (m *QueryAddressRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressRolesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error) {
-	out := new(QueryNextTopicIdResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNextTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressRolesRequest proto.InternalMessageInfo
+
+func (m *QueryAddressRolesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error) {
-	out := new(QueryTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAddressRolesResponse struct {
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
-func (c *queryClient) GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error) {
-	out := new(QueryActiveTopicsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetActiveTopics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAddressRolesResponse) Reset()         { *m = QueryAddressRolesResponse{} }
+func (m *QueryAddressRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressRolesResponse) ProtoMessage()    {}
+func (*QueryAddressRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{67}
+}
+func (m *QueryAddressRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAddressRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressRolesResponse.Merge(m, src)
+}
+func (m *QueryAddressRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressRolesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error) {
-	out := new(QueryWorkerLatestInferenceResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressRolesResponse proto.InternalMessageInfo
+
+func (m *QueryAddressRolesResponse) GetRoles() []string {
+	if m != nil {
+		return m.Roles
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error) {
-	out := new(QueryInferencesAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetInferencesAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStakeRemovalsForBlockRequest struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (c *queryClient) GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error) {
-	out := new(QueryForecastsAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetForecastsAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockRequest) Reset()         { *m = QueryStakeRemovalsForBlockRequest{} }
+func (m *QueryStakeRemovalsForBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeRemovalsForBlockRequest) ProtoMessage()    {}
+func (*QueryStakeRemovalsForBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{68}
 }
-
-func (c *queryClient) GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error) {
-	out := new(QueryNetworkLossBundleAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkLossBundleAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error) {
-	out := new(QueryTotalStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTotalStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStakeRemovalsForBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeRemovalsForBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error) {
-	out := new(QueryReputerStakeInTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerStakeInTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeRemovalsForBlockRequest.Merge(m, src)
 }
-
-func (c *queryClient) GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error) {
-	out := new(QueryMultiReputerStakeInTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetMultiReputerStakeInTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetStakeFromReputerInTopicInSelf(ctx context.Context, in *QueryStakeFromReputerInTopicInSelfRequest, opts ...grpc.CallOption) (*QueryStakeFromReputerInTopicInSelfResponse, error) {
-	out := new(QueryStakeFromReputerInTopicInSelfResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromReputerInTopicInSelf", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeRemovalsForBlockRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error) {
-	out := new(QueryDelegateStakeInTopicInReputerResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeInTopicInReputer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryStakeRemovalsForBlockRequest proto.InternalMessageInfo
 
-func (c *queryClient) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
-	out := new(QueryStakeFromDelegatorInTopicInReputerResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStakeRemovalsForBlockRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error) {
-	out := new(QueryStakeFromDelegatorInTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStakeRemovalsForBlockResponse struct {
+	Removals []*StakeRemovalInfo `protobuf:"bytes,1,rep,name=removals,proto3" json:"removals,omitempty"`
 }
 
-func (c *queryClient) GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error) {
-	out := new(QueryTopicStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockResponse) Reset()         { *m = QueryStakeRemovalsForBlockResponse{} }
+func (m *QueryStakeRemovalsForBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeRemovalsForBlockResponse) ProtoMessage()    {}
+func (*QueryStakeRemovalsForBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{69}
 }
-
-func (c *queryClient) GetStakeRemovalsForBlock(ctx context.Context, in *QueryStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryStakeRemovalsForBlockResponse, error) {
-	out := new(QueryStakeRemovalsForBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeRemovalsForBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetDelegateStakeRemovalsForBlock(ctx context.Context, in *QueryDelegateStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalsForBlockResponse, error) {
-	out := new(QueryDelegateStakeRemovalsForBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeRemovalsForBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStakeRemovalsForBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeRemovalsForBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetStakeRemovalInfo(ctx context.Context, in *QueryStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryStakeRemovalInfoResponse, error) {
-	out := new(QueryStakeRemovalInfoResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeRemovalInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeRemovalsForBlockResponse.Merge(m, src)
 }
-
-func (c *queryClient) GetDelegateStakeRemovalInfo(ctx context.Context, in *QueryDelegateStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalInfoResponse, error) {
-	out := new(QueryDelegateStakeRemovalInfoResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeRemovalInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error) {
-	out := new(QueryWorkerNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryStakeRemovalsForBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeRemovalsForBlockResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error) {
-	out := new(QueryReputerNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryStakeRemovalsForBlockResponse proto.InternalMessageInfo
 
-func (c *queryClient) GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error) {
-	out := new(QueryWorkerAddressByP2PKeyResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerAddressByP2PKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStakeRemovalsForBlockResponse) GetRemovals() []*StakeRemovalInfo {
+	if m != nil {
+		return m.Removals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error) {
-	out := new(QueryReputerAddressByP2PKeyResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerAddressByP2PKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDelegateStakeRemovalsForBlockRequest struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (c *queryClient) IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
-	out := new(QueryIsWorkerRegisteredInTopicIdResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWorkerRegisteredInTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegateStakeRemovalsForBlockRequest) Reset() {
+	*m = QueryDelegateStakeRemovalsForBlockRequest{}
+}
+func (m *QueryDelegateStakeRemovalsForBlockRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegateStakeRemovalsForBlockRequest) ProtoMessage() {}
+func (*QueryDelegateStakeRemovalsForBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{70}
+}
+func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest.Merge(m, src)
+}
+func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegateStakeRemovalsForBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
-	out := new(QueryIsReputerRegisteredInTopicIdResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsReputerRegisteredInTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegateStakeRemovalsForBlockRequest proto.InternalMessageInfo
+
+func (m *QueryDelegateStakeRemovalsForBlockRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error) {
-	out := new(QueryNetworkInferencesAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkInferencesAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDelegateStakeRemovalsForBlockResponse struct {
+	Removals []*DelegateStakeRemovalInfo `protobuf:"bytes,1,rep,name=removals,proto3" json:"removals,omitempty"`
 }
 
-func (c *queryClient) GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesAtBlockResponse, error) {
-	out := new(QueryLatestNetworkInferencesAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetLatestNetworkInference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegateStakeRemovalsForBlockResponse) Reset() {
+	*m = QueryDelegateStakeRemovalsForBlockResponse{}
 }
-
-func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error) {
-	out := new(QueryIsWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegateStakeRemovalsForBlockResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error) {
-	out := new(QueryTopicLastCommitResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicLastWorkerCommitInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*QueryDelegateStakeRemovalsForBlockResponse) ProtoMessage() {}
+func (*QueryDelegateStakeRemovalsForBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{71}
+}
+func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse.Merge(m, src)
+}
+func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegateStakeRemovalsForBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTopicLastReputerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error) {
-	out := new(QueryTopicLastCommitResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicLastReputerCommitInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegateStakeRemovalsForBlockResponse proto.InternalMessageInfo
+
+func (m *QueryDelegateStakeRemovalsForBlockResponse) GetRemovals() []*DelegateStakeRemovalInfo {
+	if m != nil {
+		return m.Removals
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetNextTopicId(context.Context, *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error)
-	GetTopic(context.Context, *QueryTopicRequest) (*QueryTopicResponse, error)
-	GetActiveTopics(context.Context, *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error)
-	GetWorkerLatestInferenceByTopicId(context.Context, *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error)
-	GetInferencesAtBlock(context.Context, *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error)
-	GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error)
-	GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error)
-	GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error)
-	GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error)
-	GetMultiReputerStakeInTopic(context.Context, *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error)
-	GetStakeFromReputerInTopicInSelf(context.Context, *QueryStakeFromReputerInTopicInSelfRequest) (*QueryStakeFromReputerInTopicInSelfResponse, error)
-	GetDelegateStakeInTopicInReputer(context.Context, *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopicInReputer(context.Context, *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopic(context.Context, *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error)
-	GetTopicStake(context.Context, *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error)
-	GetStakeRemovalsForBlock(context.Context, *QueryStakeRemovalsForBlockRequest) (*QueryStakeRemovalsForBlockResponse, error)
-	GetDelegateStakeRemovalsForBlock(context.Context, *QueryDelegateStakeRemovalsForBlockRequest) (*QueryDelegateStakeRemovalsForBlockResponse, error)
-	GetStakeRemovalInfo(context.Context, *QueryStakeRemovalInfoRequest) (*QueryStakeRemovalInfoResponse, error)
-	GetDelegateStakeRemovalInfo(context.Context, *QueryDelegateStakeRemovalInfoRequest) (*QueryDelegateStakeRemovalInfoResponse, error)
-	GetWorkerNodeInfo(context.Context, *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error)
-	GetReputerNodeInfo(context.Context, *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error)
-	GetWorkerAddressByP2PKey(context.Context, *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error)
-	GetReputerAddressByP2PKey(context.Context, *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error)
-	IsWorkerRegisteredInTopicId(context.Context, *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
-	IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error)
-	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
-	GetLatestNetworkInference(context.Context, *QueryLatestNetworkInferencesAtBlockRequest) (*QueryLatestNetworkInferencesAtBlockResponse, error)
-	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
-	GetTopicLastWorkerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
-	GetTopicLastReputerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
+type QueryStakeRemovalInfoRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryStakeRemovalInfoRequest) Reset()         { *m = QueryStakeRemovalInfoRequest{} }
+func (m *QueryStakeRemovalInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeRemovalInfoRequest) ProtoMessage()    {}
+func (*QueryStakeRemovalInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{72}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryStakeRemovalInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetNextTopicId(ctx context.Context, req *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNextTopicId not implemented")
+func (m *QueryStakeRemovalInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeRemovalInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetTopic(ctx context.Context, req *QueryTopicRequest) (*QueryTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+func (m *QueryStakeRemovalInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeRemovalInfoRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetActiveTopics(ctx context.Context, req *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveTopics not implemented")
+func (m *QueryStakeRemovalInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetWorkerLatestInferenceByTopicId(ctx context.Context, req *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLatestInferenceByTopicId not implemented")
+func (m *QueryStakeRemovalInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeRemovalInfoRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetInferencesAtBlock(ctx context.Context, req *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInferencesAtBlock not implemented")
+
+var xxx_messageInfo_QueryStakeRemovalInfoRequest proto.InternalMessageInfo
+
+func (m *QueryStakeRemovalInfoRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetForecastsAtBlock(ctx context.Context, req *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForecastsAtBlock not implemented")
+
+func (m *QueryStakeRemovalInfoRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetNetworkLossBundleAtBlock(ctx context.Context, req *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkLossBundleAtBlock not implemented")
+
+type QueryStakeRemovalInfoResponse struct {
+	Removal *StakeRemovalInfo `protobuf:"bytes,1,opt,name=removal,proto3" json:"removal,omitempty"`
 }
-func (*UnimplementedQueryServer) GetTotalStake(ctx context.Context, req *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalStake not implemented")
+
+func (m *QueryStakeRemovalInfoResponse) Reset()         { *m = QueryStakeRemovalInfoResponse{} }
+func (m *QueryStakeRemovalInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeRemovalInfoResponse) ProtoMessage()    {}
+func (*QueryStakeRemovalInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{73}
 }
-func (*UnimplementedQueryServer) GetReputerStakeInTopic(ctx context.Context, req *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakeInTopic not implemented")
+func (m *QueryStakeRemovalInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetMultiReputerStakeInTopic(ctx context.Context, req *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMultiReputerStakeInTopic not implemented")
+func (m *QueryStakeRemovalInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeRemovalInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetStakeFromReputerInTopicInSelf(ctx context.Context, req *QueryStakeFromReputerInTopicInSelfRequest) (*QueryStakeFromReputerInTopicInSelfResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromReputerInTopicInSelf not implemented")
+func (m *QueryStakeRemovalInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeRemovalInfoResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetDelegateStakeInTopicInReputer(ctx context.Context, req *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeInTopicInReputer not implemented")
+func (m *QueryStakeRemovalInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, req *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopicInReputer not implemented")
+func (m *QueryStakeRemovalInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeRemovalInfoResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopic(ctx context.Context, req *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopic not implemented")
+
+var xxx_messageInfo_QueryStakeRemovalInfoResponse proto.InternalMessageInfo
+
+func (m *QueryStakeRemovalInfoResponse) GetRemoval() *StakeRemovalInfo {
+	if m != nil {
+		return m.Removal
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetTopicStake(ctx context.Context, req *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopicStake not implemented")
+
+type QueryDelegateStakeRemovalInfoRequest struct {
+	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer   string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
-func (*UnimplementedQueryServer) GetStakeRemovalsForBlock(ctx context.Context, req *QueryStakeRemovalsForBlockRequest) (*QueryStakeRemovalsForBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeRemovalsForBlock not implemented")
+
+func (m *QueryDelegateStakeRemovalInfoRequest) Reset()         { *m = QueryDelegateStakeRemovalInfoRequest{} }
+func (m *QueryDelegateStakeRemovalInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegateStakeRemovalInfoRequest) ProtoMessage()    {}
+func (*QueryDelegateStakeRemovalInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{74}
 }
-func (*UnimplementedQueryServer) GetDelegateStakeRemovalsForBlock(ctx context.Context, req *QueryDelegateStakeRemovalsForBlockRequest) (*QueryDelegateStakeRemovalsForBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeRemovalsForBlock not implemented")
+func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetStakeRemovalInfo(ctx context.Context, req *QueryStakeRemovalInfoRequest) (*QueryStakeRemovalInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeRemovalInfo not implemented")
+func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetDelegateStakeRemovalInfo(ctx context.Context, req *QueryDelegateStakeRemovalInfoRequest) (*QueryDelegateStakeRemovalInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeRemovalInfo not implemented")
+func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetWorkerNodeInfo(ctx context.Context, req *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerNodeInfo not implemented")
+func (m *QueryDelegateStakeRemovalInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetReputerNodeInfo(ctx context.Context, req *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReputerNodeInfo not implemented")
+func (m *QueryDelegateStakeRemovalInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetWorkerAddressByP2PKey(ctx context.Context, req *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerAddressByP2PKey not implemented")
+
+var xxx_messageInfo_QueryDelegateStakeRemovalInfoRequest proto.InternalMessageInfo
+
+func (m *QueryDelegateStakeRemovalInfoRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetReputerAddressByP2PKey(ctx context.Context, req *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReputerAddressByP2PKey not implemented")
+
+func (m *QueryDelegateStakeRemovalInfoRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) IsWorkerRegisteredInTopicId(ctx context.Context, req *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsWorkerRegisteredInTopicId not implemented")
+
+func (m *QueryDelegateStakeRemovalInfoRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsReputerRegisteredInTopicId not implemented")
+
+type QueryDelegateStakeRemovalInfoResponse struct {
+	Removal *DelegateStakeRemovalInfo `protobuf:"bytes,1,opt,name=removal,proto3" json:"removal,omitempty"`
 }
-func (*UnimplementedQueryServer) GetNetworkInferencesAtBlock(ctx context.Context, req *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferencesAtBlock not implemented")
+
+func (m *QueryDelegateStakeRemovalInfoResponse) Reset()         { *m = QueryDelegateStakeRemovalInfoResponse{} }
+func (m *QueryDelegateStakeRemovalInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegateStakeRemovalInfoResponse) ProtoMessage()    {}
+func (*QueryDelegateStakeRemovalInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{75}
 }
-func (*UnimplementedQueryServer) GetLatestNetworkInference(ctx context.Context, req *QueryLatestNetworkInferencesAtBlockRequest) (*QueryLatestNetworkInferencesAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestNetworkInference not implemented")
+func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IsWhitelistAdmin(ctx context.Context, req *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
+func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetTopicLastWorkerCommitInfo(ctx context.Context, req *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastWorkerCommitInfo not implemented")
+func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetTopicLastReputerCommitInfo(ctx context.Context, req *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastReputerCommitInfo not implemented")
+func (m *QueryDelegateStakeRemovalInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDelegateStakeRemovalInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryDelegateStakeRemovalInfoResponse proto.InternalMessageInfo
+
+func (m *QueryDelegateStakeRemovalInfoResponse) GetRemoval() *DelegateStakeRemovalInfo {
+	if m != nil {
+		return m.Removal
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetNextTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextTopicIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNextTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetNextTopicId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNextTopicId(ctx, req.(*QueryNextTopicIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryTopicLastCommitRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func _Query_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopic(ctx, req.(*QueryTopicRequest))
+func (m *QueryTopicLastCommitRequest) Reset()         { *m = QueryTopicLastCommitRequest{} }
+func (m *QueryTopicLastCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicLastCommitRequest) ProtoMessage()    {}
+func (*QueryTopicLastCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{76}
+}
+func (m *QueryTopicLastCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicLastCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicLastCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTopicLastCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicLastCommitRequest.Merge(m, src)
+}
+func (m *QueryTopicLastCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicLastCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicLastCommitRequest.DiscardUnknown(m)
 }
 
-func _Query_GetActiveTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveTopicsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetActiveTopics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetActiveTopics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetActiveTopics(ctx, req.(*QueryActiveTopicsRequest))
+var xxx_messageInfo_QueryTopicLastCommitRequest proto.InternalMessageInfo
+
+func (m *QueryTopicLastCommitRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWorkerLatestInferenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId",
+type QueryTopicLastCommitResponse struct {
+	LastCommit *TimestampedActorNonce `protobuf:"bytes,1,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"`
+}
+
+func (m *QueryTopicLastCommitResponse) Reset()         { *m = QueryTopicLastCommitResponse{} }
+func (m *QueryTopicLastCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicLastCommitResponse) ProtoMessage()    {}
+func (*QueryTopicLastCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{77}
+}
+func (m *QueryTopicLastCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicLastCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicLastCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, req.(*QueryWorkerLatestInferenceRequest))
+}
+func (m *QueryTopicLastCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicLastCommitResponse.Merge(m, src)
+}
+func (m *QueryTopicLastCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicLastCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicLastCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopicLastCommitResponse proto.InternalMessageInfo
+
+func (m *QueryTopicLastCommitResponse) GetLastCommit() *TimestampedActorNonce {
+	if m != nil {
+		return m.LastCommit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInferencesAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryRegretHistoryRequest struct {
+	TopicId uint64     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Role    RegretRole `protobuf:"varint,2,opt,name=role,proto3,enum=emissions.v1.RegretRole" json:"role,omitempty"`
+	Worker  string     `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	// Inferer the regrets are of when the role is one-in forecaster, the worker being the forecaster
+	Inferer    string                         `protobuf:"bytes,4,opt,name=inferer,proto3" json:"inferer,omitempty"`
+	Pagination *SimpleCursorPaginationRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegretHistoryRequest) Reset()         { *m = QueryRegretHistoryRequest{} }
+func (m *QueryRegretHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegretHistoryRequest) ProtoMessage()    {}
+func (*QueryRegretHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{78}
+}
+func (m *QueryRegretHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegretHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegretHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetInferencesAtBlock(ctx, in)
+}
+func (m *QueryRegretHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegretHistoryRequest.Merge(m, src)
+}
+func (m *QueryRegretHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegretHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegretHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegretHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRegretHistoryRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetInferencesAtBlock",
+	return 0
+}
+
+func (m *QueryRegretHistoryRequest) GetRole() RegretRole {
+	if m != nil {
+		return m.Role
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetInferencesAtBlock(ctx, req.(*QueryInferencesAtBlockRequest))
+	return RegretRole_REGRET_ROLE_INFERER
+}
+
+func (m *QueryRegretHistoryRequest) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryForecastsAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetForecastsAtBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetForecastsAtBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetForecastsAtBlock(ctx, req.(*QueryForecastsAtBlockRequest))
+func (m *QueryRegretHistoryRequest) GetInferer() string {
+	if m != nil {
+		return m.Inferer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetworkLossBundleAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetNetworkLossBundleAtBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, req.(*QueryNetworkLossBundleAtBlockRequest))
+func (m *QueryRegretHistoryRequest) GetPagination() *SimpleCursorPaginationRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTotalStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalStake(ctx, req.(*QueryTotalStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Past regrets, oldest first
+type QueryRegretHistoryResponse struct {
+	Regrets    []*TimestampedValue             `protobuf:"bytes,1,rep,name=regrets,proto3" json:"regrets,omitempty"`
+	Pagination *SimpleCursorPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReputerStakeInTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReputerStakeInTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetReputerStakeInTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReputerStakeInTopic(ctx, req.(*QueryReputerStakeInTopicRequest))
+func (m *QueryRegretHistoryResponse) Reset()         { *m = QueryRegretHistoryResponse{} }
+func (m *QueryRegretHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegretHistoryResponse) ProtoMessage()    {}
+func (*QueryRegretHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{79}
+}
+func (m *QueryRegretHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegretHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegretHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRegretHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegretHistoryResponse.Merge(m, src)
+}
+func (m *QueryRegretHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegretHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegretHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_GetMultiReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMultiReputerStakeInTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetMultiReputerStakeInTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, req.(*QueryMultiReputerStakeInTopicRequest))
+var xxx_messageInfo_QueryRegretHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRegretHistoryResponse) GetRegrets() []*TimestampedValue {
+	if m != nil {
+		return m.Regrets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetStakeFromReputerInTopicInSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeFromReputerInTopicInSelfRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeFromReputerInTopicInSelf(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeFromReputerInTopicInSelf",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeFromReputerInTopicInSelf(ctx, req.(*QueryStakeFromReputerInTopicInSelfRequest))
+func (m *QueryRegretHistoryResponse) GetPagination() *SimpleCursorPaginationResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetDelegateStakeInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateStakeInTopicInReputerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetDelegateStakeInTopicInReputer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, req.(*QueryDelegateStakeInTopicInReputerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPayoutAddressRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_GetStakeFromDelegatorInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeFromDelegatorInTopicInReputerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, req.(*QueryStakeFromDelegatorInTopicInReputerRequest))
+func (m *QueryPayoutAddressRequest) Reset()         { *m = QueryPayoutAddressRequest{} }
+func (m *QueryPayoutAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutAddressRequest) ProtoMessage()    {}
+func (*QueryPayoutAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{80}
+}
+func (m *QueryPayoutAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPayoutAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutAddressRequest.Merge(m, src)
+}
+func (m *QueryPayoutAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeFromDelegatorInTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, req.(*QueryStakeFromDelegatorInTopicRequest))
+var xxx_messageInfo_QueryPayoutAddressRequest proto.InternalMessageInfo
+
+func (m *QueryPayoutAddressRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopicStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopicStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTopicStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopicStake(ctx, req.(*QueryTopicStakeRequest))
+func (m *QueryPayoutAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetStakeRemovalsForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeRemovalsForBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeRemovalsForBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeRemovalsForBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeRemovalsForBlock(ctx, req.(*QueryStakeRemovalsForBlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Where the worker rewards of the address in the topic are sent, along with
+// the topic and global payout addresses it was resolved from, if set
+type QueryPayoutAddressResponse struct {
+	PayoutAddress       string `protobuf:"bytes,1,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	TopicPayoutAddress  string `protobuf:"bytes,2,opt,name=topic_payout_address,json=topicPayoutAddress,proto3" json:"topic_payout_address,omitempty"`
+	GlobalPayoutAddress string `protobuf:"bytes,3,opt,name=global_payout_address,json=globalPayoutAddress,proto3" json:"global_payout_address,omitempty"`
 }
 
-func _Query_GetDelegateStakeRemovalsForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateStakeRemovalsForBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateStakeRemovalsForBlock(ctx, in)
+func (m *QueryPayoutAddressResponse) Reset()         { *m = QueryPayoutAddressResponse{} }
+func (m *QueryPayoutAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutAddressResponse) ProtoMessage()    {}
+func (*QueryPayoutAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{81}
+}
+func (m *QueryPayoutAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetDelegateStakeRemovalsForBlock",
+}
+func (m *QueryPayoutAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutAddressResponse.Merge(m, src)
+}
+func (m *QueryPayoutAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutAddressResponse proto.InternalMessageInfo
+
+func (m *QueryPayoutAddressResponse) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateStakeRemovalsForBlock(ctx, req.(*QueryDelegateStakeRemovalsForBlockRequest))
+	return ""
+}
+
+func (m *QueryPayoutAddressResponse) GetTopicPayoutAddress() string {
+	if m != nil {
+		return m.TopicPayoutAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeRemovalInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeRemovalInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeRemovalInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeRemovalInfo(ctx, req.(*QueryStakeRemovalInfoRequest))
+func (m *QueryPayoutAddressResponse) GetGlobalPayoutAddress() string {
+	if m != nil {
+		return m.GlobalPayoutAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetDelegateStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateStakeRemovalInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateStakeRemovalInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetDelegateStakeRemovalInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateStakeRemovalInfo(ctx, req.(*QueryDelegateStakeRemovalInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "emissions.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "emissions.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTotalStakeRequest)(nil), "emissions.v1.QueryTotalStakeRequest")
+	proto.RegisterType((*QueryTotalStakeResponse)(nil), "emissions.v1.QueryTotalStakeResponse")
+	proto.RegisterType((*QueryTopicRevenueBurnedRequest)(nil), "emissions.v1.QueryTopicRevenueBurnedRequest")
+	proto.RegisterType((*QueryTopicRevenueBurnedResponse)(nil), "emissions.v1.QueryTopicRevenueBurnedResponse")
+	proto.RegisterType((*QueryReputerStakeInTopicRequest)(nil), "emissions.v1.QueryReputerStakeInTopicRequest")
+	proto.RegisterType((*QueryReputerStakeInTopicResponse)(nil), "emissions.v1.QueryReputerStakeInTopicResponse")
+	proto.RegisterType((*QueryMultiReputerStakeInTopicRequest)(nil), "emissions.v1.QueryMultiReputerStakeInTopicRequest")
+	proto.RegisterType((*QueryMultiReputerStakeInTopicResponse)(nil), "emissions.v1.QueryMultiReputerStakeInTopicResponse")
+	proto.RegisterType((*QueryStakeFromReputerInTopicInSelfRequest)(nil), "emissions.v1.QueryStakeFromReputerInTopicInSelfRequest")
+	proto.RegisterType((*QueryStakeFromReputerInTopicInSelfResponse)(nil), "emissions.v1.QueryStakeFromReputerInTopicInSelfResponse")
+	proto.RegisterType((*QueryDelegateStakeInTopicInReputerRequest)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerRequest")
+	proto.RegisterType((*QueryDelegateStakeInTopicInReputerResponse)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerResponse")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerRequest")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerResponse")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicRequest")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicResponse")
+	proto.RegisterType((*QueryTopicStakeRequest)(nil), "emissions.v1.QueryTopicStakeRequest")
+	proto.RegisterType((*QueryTopicStakeResponse)(nil), "emissions.v1.QueryTopicStakeResponse")
+	proto.RegisterType((*QueryNetworkLossBundleAtBlockRequest)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockRequest")
+	proto.RegisterType((*QueryNetworkLossBundleAtBlockResponse)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockResponse")
+	proto.RegisterType((*QueryNextTopicIdRequest)(nil), "emissions.v1.QueryNextTopicIdRequest")
+	proto.RegisterType((*QueryNextTopicIdResponse)(nil), "emissions.v1.QueryNextTopicIdResponse")
+	proto.RegisterType((*QueryTopicRequest)(nil), "emissions.v1.QueryTopicRequest")
+	proto.RegisterType((*QueryTopicResponse)(nil), "emissions.v1.QueryTopicResponse")
+	proto.RegisterType((*QueryActiveTopicsRequest)(nil), "emissions.v1.QueryActiveTopicsRequest")
+	proto.RegisterType((*QueryActiveTopicsResponse)(nil), "emissions.v1.QueryActiveTopicsResponse")
+	proto.RegisterType((*QueryInferencesAtBlockRequest)(nil), "emissions.v1.QueryInferencesAtBlockRequest")
+	proto.RegisterType((*QueryInferencesAtBlockResponse)(nil), "emissions.v1.QueryInferencesAtBlockResponse")
+	proto.RegisterType((*QueryForecastsAtBlockRequest)(nil), "emissions.v1.QueryForecastsAtBlockRequest")
+	proto.RegisterType((*QueryForecastsAtBlockResponse)(nil), "emissions.v1.QueryForecastsAtBlockResponse")
+	proto.RegisterType((*QueryWorkerLatestInferenceRequest)(nil), "emissions.v1.QueryWorkerLatestInferenceRequest")
+	proto.RegisterType((*QueryWorkerLatestInferenceResponse)(nil), "emissions.v1.QueryWorkerLatestInferenceResponse")
+	proto.RegisterType((*QueryWorkerNodeInfoRequest)(nil), "emissions.v1.QueryWorkerNodeInfoRequest")
+	proto.RegisterType((*QueryWorkerNodeInfoResponse)(nil), "emissions.v1.QueryWorkerNodeInfoResponse")
+	proto.RegisterType((*QueryReputerNodeInfoRequest)(nil), "emissions.v1.QueryReputerNodeInfoRequest")
+	proto.RegisterType((*QueryReputerNodeInfoResponse)(nil), "emissions.v1.QueryReputerNodeInfoResponse")
+	proto.RegisterType((*QueryWorkerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyRequest")
+	proto.RegisterType((*QueryWorkerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyResponse")
+	proto.RegisterType((*QueryReputerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryReputerAddressByP2PKeyRequest")
+	proto.RegisterType((*QueryReputerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryReputerAddressByP2PKeyResponse")
+	proto.RegisterType((*QueryNetworkInferencesAtBlockRequest)(nil), "emissions.v1.QueryNetworkInferencesAtBlockRequest")
+	proto.RegisterType((*QueryLatestNetworkInferencesAtBlockRequest)(nil), "emissions.v1.QueryLatestNetworkInferencesAtBlockRequest")
+	proto.RegisterType((*QueryNetworkInferencesAtBlockResponse)(nil), "emissions.v1.QueryNetworkInferencesAtBlockResponse")
+	proto.RegisterType((*QueryLatestNetworkInferencesAtBlockResponse)(nil), "emissions.v1.QueryLatestNetworkInferencesAtBlockResponse")
+	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdRequest")
+	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdResponse")
+	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdRequest")
+	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdResponse")
+	proto.RegisterType((*QueryIsWhitelistAdminRequest)(nil), "emissions.v1.QueryIsWhitelistAdminRequest")
+	proto.RegisterType((*QueryIsWhitelistAdminResponse)(nil), "emissions.v1.QueryIsWhitelistAdminResponse")
+	proto.RegisterType((*QueryTopicParamsRequest)(nil), "emissions.v1.QueryTopicParamsRequest")
+	proto.RegisterType((*QueryTopicParamsResponse)(nil), "emissions.v1.QueryTopicParamsResponse")
+	proto.RegisterType((*QueryScheduledParamsChangesRequest)(nil), "emissions.v1.QueryScheduledParamsChangesRequest")
+	proto.RegisterType((*QueryScheduledParamsChangesResponse)(nil), "emissions.v1.QueryScheduledParamsChangesResponse")
+	proto.RegisterType((*QueryInferenceRequestRequest)(nil), "emissions.v1.QueryInferenceRequestRequest")
+	proto.RegisterType((*QueryInferenceRequestResponse)(nil), "emissions.v1.QueryInferenceRequestResponse")
+	proto.RegisterType((*QueryOpenInferenceRequestsRequest)(nil), "emissions.v1.QueryOpenInferenceRequestsRequest")
+	proto.RegisterType((*QueryOpenInferenceRequestsResponse)(nil), "emissions.v1.QueryOpenInferenceRequestsResponse")
+	proto.RegisterType((*QueryTopicSubscriptionsRequest)(nil), "emissions.v1.QueryTopicSubscriptionsRequest")
+	proto.RegisterType((*QueryTopicSubscriptionsResponse)(nil), "emissions.v1.QueryTopicSubscriptionsResponse")
+	proto.RegisterType((*QueryConsumerSubscriptionsRequest)(nil), "emissions.v1.QueryConsumerSubscriptionsRequest")
+	proto.RegisterType((*QueryConsumerSubscriptionsResponse)(nil), "emissions.v1.QueryConsumerSubscriptionsResponse")
+	proto.RegisterType((*QueryRoleMembersRequest)(nil), "emissions.v1.QueryRoleMembersRequest")
+	proto.RegisterType((*QueryRoleMembersResponse)(nil), "emissions.v1.QueryRoleMembersResponse")
+	proto.RegisterType((*QueryAddressRolesRequest)(nil), "emissions.v1.QueryAddressRolesRequest")
+	proto.RegisterType((*QueryAddressRolesResponse)(nil), "emissions.v1.QueryAddressRolesResponse")
+	proto.RegisterType((*QueryStakeRemovalsForBlockRequest)(nil), "emissions.v1.QueryStakeRemovalsForBlockRequest")
+	proto.RegisterType((*QueryStakeRemovalsForBlockResponse)(nil), "emissions.v1.QueryStakeRemovalsForBlockResponse")
+	proto.RegisterType((*QueryDelegateStakeRemovalsForBlockRequest)(nil), "emissions.v1.QueryDelegateStakeRemovalsForBlockRequest")
+	proto.RegisterType((*QueryDelegateStakeRemovalsForBlockResponse)(nil), "emissions.v1.QueryDelegateStakeRemovalsForBlockResponse")
+	proto.RegisterType((*QueryStakeRemovalInfoRequest)(nil), "emissions.v1.QueryStakeRemovalInfoRequest")
+	proto.RegisterType((*QueryStakeRemovalInfoResponse)(nil), "emissions.v1.QueryStakeRemovalInfoResponse")
+	proto.RegisterType((*QueryDelegateStakeRemovalInfoRequest)(nil), "emissions.v1.QueryDelegateStakeRemovalInfoRequest")
+	proto.RegisterType((*QueryDelegateStakeRemovalInfoResponse)(nil), "emissions.v1.QueryDelegateStakeRemovalInfoResponse")
+	proto.RegisterType((*QueryTopicLastCommitRequest)(nil), "emissions.v1.QueryTopicLastCommitRequest")
+	proto.RegisterType((*QueryTopicLastCommitResponse)(nil), "emissions.v1.QueryTopicLastCommitResponse")
+	proto.RegisterType((*QueryRegretHistoryRequest)(nil), "emissions.v1.QueryRegretHistoryRequest")
+	proto.RegisterType((*QueryRegretHistoryResponse)(nil), "emissions.v1.QueryRegretHistoryResponse")
+	proto.RegisterType((*QueryPayoutAddressRequest)(nil), "emissions.v1.QueryPayoutAddressRequest")
+	proto.RegisterType((*QueryPayoutAddressResponse)(nil), "emissions.v1.QueryPayoutAddressResponse")
 }
 
-func _Query_GetWorkerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWorkerNodeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWorkerNodeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetWorkerNodeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWorkerNodeInfo(ctx, req.(*QueryWorkerNodeInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() { proto.RegisterFile("emissions/v1/query.proto", fileDescriptor_c426af2f1e908986) }
+
+var fileDescriptor_c426af2f1e908986 = []byte{
+	// 3522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdb, 0x6f, 0xdc, 0xc6,
+	0xd5, 0x37, 0xe5, 0xeb, 0x8e, 0xe3, 0xdb, 0xf8, 0x92, 0x15, 0x25, 0x4b, 0x32, 0x6d, 0x59, 0xb2,
+	0x64, 0x8b, 0xba, 0xd8, 0xb1, 0x92, 0xf8, 0xf2, 0x59, 0x4e, 0x2c, 0xeb, 0xb3, 0x63, 0x2b, 0x74,
+	0x60, 0xe3, 0x33, 0xbe, 0xef, 0x63, 0xa9, 0xdd, 0x91, 0x44, 0x88, 0x4b, 0x6e, 0x48, 0xae, 0x62,
+	0x41, 0x15, 0xda, 0x04, 0x79, 0x68, 0x80, 0x16, 0x2d, 0x10, 0x20, 0xe8, 0x43, 0x1f, 0x5a, 0xa0,
+	0x01, 0x1a, 0x14, 0x4d, 0x8b, 0xa2, 0x69, 0x11, 0xb4, 0x7d, 0x4f, 0xfb, 0xd2, 0xa0, 0x79, 0x09,
+	0xf2, 0x10, 0xb4, 0x71, 0x8b, 0xf6, 0x6f, 0xe8, 0x53, 0x41, 0xce, 0x19, 0x92, 0xc3, 0x1d, 0x5e,
+	0xd6, 0x96, 0x80, 0xbe, 0x18, 0x5a, 0xce, 0x39, 0x67, 0x7e, 0xbf, 0xb9, 0x9c, 0x39, 0x73, 0xe6,
+	0x18, 0x55, 0x49, 0xc3, 0xf4, 0x3c, 0xd3, 0xb1, 0x3d, 0x75, 0x75, 0x42, 0x7d, 0xbd, 0x45, 0xdc,
+	0xb5, 0xb1, 0xa6, 0xeb, 0xf8, 0x0e, 0x7e, 0x26, 0x6a, 0x19, 0x5b, 0x9d, 0x90, 0x47, 0x6a, 0x8e,
+	0xd7, 0x70, 0x3c, 0x75, 0xc1, 0xf0, 0x08, 0x15, 0x53, 0x57, 0x27, 0x16, 0x88, 0x6f, 0x4c, 0xa8,
+	0x4d, 0x63, 0xc9, 0xb4, 0x0d, 0xdf, 0x74, 0x6c, 0xaa, 0x29, 0xf3, 0x36, 0xfd, 0xb5, 0x26, 0xf1,
+	0xa0, 0xa5, 0x77, 0xc9, 0x71, 0x96, 0x2c, 0xa2, 0x1a, 0x4d, 0x53, 0x35, 0x6c, 0xdb, 0xf1, 0x43,
+	0x35, 0xd6, 0xda, 0x03, 0x7d, 0x30, 0xf3, 0x49, 0x38, 0xf2, 0x21, 0xa3, 0x61, 0xda, 0x8e, 0x1a,
+	0xfe, 0x0b, 0x9f, 0x8e, 0x2c, 0x39, 0x4b, 0x4e, 0xf8, 0xa7, 0x1a, 0xfc, 0x05, 0x5f, 0xbb, 0xa9,
+	0x15, 0x9d, 0x36, 0xd0, 0x1f, 0xac, 0x89, 0x03, 0xd6, 0x34, 0x5c, 0xa3, 0xc1, 0x9a, 0x9e, 0xe5,
+	0x9a, 0x6c, 0xa7, 0x4e, 0x84, 0x3a, 0x6f, 0x38, 0xee, 0x0a, 0x71, 0xa1, 0x49, 0xe6, 0x9a, 0x5c,
+	0xd2, 0x6c, 0xf9, 0xc4, 0x15, 0x8f, 0x81, 0xd3, 0x34, 0x6b, 0xc2, 0x16, 0xcf, 0x37, 0x56, 0x58,
+	0x57, 0xbd, 0x5c, 0x8b, 0x69, 0x2f, 0x12, 0x97, 0xd8, 0x35, 0x92, 0xd1, 0xdb, 0xeb, 0x2d, 0xe2,
+	0xf9, 0xd0, 0xd6, 0xcf, 0xdb, 0x6c, 0x2d, 0x78, 0x35, 0xd7, 0x6c, 0xc6, 0x53, 0xa2, 0x1c, 0x41,
+	0xf8, 0xd5, 0x60, 0x30, 0xe7, 0x43, 0xce, 0x1a, 0x55, 0x56, 0xee, 0xa0, 0xc3, 0xdc, 0x57, 0xaf,
+	0xe9, 0xd8, 0x1e, 0xc1, 0x17, 0xd1, 0x2e, 0x3a, 0x36, 0x55, 0x69, 0x40, 0x1a, 0xde, 0x3b, 0x79,
+	0x64, 0x2c, 0xb9, 0x14, 0xc6, 0xa8, 0xf4, 0x4c, 0xe5, 0x93, 0x2f, 0xfb, 0xb7, 0xfd, 0xe4, 0x1f,
+	0xbf, 0x18, 0x91, 0x34, 0x10, 0x57, 0xaa, 0xe8, 0x58, 0x68, 0xef, 0x35, 0xc7, 0x37, 0xac, 0x7b,
+	0x01, 0x33, 0xd6, 0x93, 0x89, 0x9e, 0x6d, 0x6b, 0x81, 0xde, 0x6e, 0xa2, 0x5d, 0x46, 0xc3, 0x69,
+	0xd9, 0x7e, 0xd8, 0x5b, 0x65, 0x66, 0x3c, 0xb0, 0xfb, 0xc5, 0x97, 0xfd, 0x47, 0xe9, 0xd4, 0x79,
+	0xf5, 0x95, 0x31, 0xd3, 0x51, 0x1b, 0x86, 0xbf, 0x3c, 0x36, 0x67, 0xfb, 0x7f, 0xfe, 0xd5, 0x39,
+	0x04, 0x73, 0x3a, 0x67, 0xfb, 0xd0, 0x3d, 0xd5, 0x7f, 0x61, 0xc7, 0x3f, 0x7f, 0xd8, 0x2f, 0x29,
+	0x03, 0xa8, 0x0f, 0xba, 0x6a, 0x9a, 0x35, 0x8d, 0xac, 0x12, 0xbb, 0x45, 0x66, 0x5a, 0xae, 0x4d,
+	0xea, 0x0c, 0xcc, 0x0a, 0xea, 0xcf, 0x94, 0xd8, 0x6c, 0x50, 0xca, 0x7d, 0xe8, 0x4c, 0xa3, 0xcb,
+	0x23, 0xe4, 0x3e, 0x67, 0x43, 0xd7, 0x21, 0x1e, 0x5c, 0x45, 0xbb, 0x8d, 0x7a, 0xdd, 0x25, 0x1e,
+	0x1d, 0xf0, 0x8a, 0xc6, 0x7e, 0xe2, 0x6e, 0xb4, 0x27, 0x5c, 0x3a, 0xba, 0x59, 0xaf, 0x76, 0x0d,
+	0x48, 0xc3, 0x3b, 0xb4, 0xdd, 0xe1, 0xef, 0xb9, 0xba, 0xe2, 0xa2, 0x81, 0x6c, 0xbb, 0x5b, 0x34,
+	0xb4, 0x3a, 0x3a, 0x15, 0xf6, 0xf9, 0x4a, 0xcb, 0xf2, 0xcd, 0x1c, 0x42, 0xbd, 0xa8, 0x02, 0x0c,
+	0x48, 0x40, 0x69, 0xfb, 0x70, 0x45, 0x8b, 0x3f, 0xe4, 0x91, 0x7a, 0x88, 0x06, 0x0b, 0x3a, 0x00,
+	0x66, 0x13, 0x68, 0x37, 0x45, 0x46, 0xed, 0xef, 0x9d, 0x7c, 0x96, 0x5f, 0xa3, 0xa0, 0xb4, 0xe8,
+	0x68, 0x4c, 0x4e, 0x71, 0xd0, 0x99, 0xd0, 0x76, 0xd8, 0x74, 0xc3, 0x75, 0x1a, 0x60, 0x1f, 0x4c,
+	0xcf, 0xd9, 0xf7, 0x88, 0xb5, 0xc8, 0x18, 0x0c, 0xa1, 0x03, 0xb0, 0x9f, 0x75, 0x7e, 0x6a, 0xf6,
+	0xc3, 0xe7, 0x6b, 0xc5, 0x33, 0xf4, 0x75, 0x34, 0x52, 0xa6, 0xc3, 0x2d, 0x9a, 0x2b, 0x46, 0xf7,
+	0x25, 0x62, 0x91, 0x25, 0xc3, 0x27, 0xc9, 0x61, 0x9c, 0xb3, 0x01, 0xca, 0x56, 0xd0, 0x2d, 0xe8,
+	0x70, 0x8b, 0xe8, 0xfe, 0x48, 0x42, 0x63, 0xfc, 0x68, 0x03, 0x0e, 0xc7, 0xcd, 0x22, 0x3d, 0x8a,
+	0x0e, 0xd5, 0x99, 0x4c, 0x8a, 0xf6, 0xc1, 0xa8, 0x81, 0x11, 0x17, 0x8c, 0x50, 0x57, 0xe1, 0x08,
+	0x6d, 0xe7, 0x47, 0xe8, 0x4d, 0x09, 0xa9, 0xa5, 0x31, 0x6e, 0xd9, 0xb2, 0x18, 0xcc, 0x87, 0xf0,
+	0x44, 0xa3, 0x93, 0xb3, 0x2c, 0x1e, 0xa1, 0xd3, 0x45, 0x1d, 0x6e, 0x11, 0xd5, 0xa9, 0xe8, 0x34,
+	0x6a, 0x9a, 0xb5, 0xe4, 0x69, 0xc4, 0xc1, 0x95, 0x78, 0xb8, 0xf1, 0x41, 0x15, 0x2b, 0x6d, 0x11,
+	0xbe, 0x3a, 0x78, 0xd3, 0x3b, 0xc4, 0x0f, 0xc2, 0x8a, 0xdb, 0x8e, 0xe7, 0xcd, 0xb4, 0xec, 0xba,
+	0x45, 0xae, 0xf9, 0x33, 0x96, 0x53, 0x5b, 0x29, 0x46, 0x8b, 0x4f, 0xa0, 0x67, 0x16, 0x02, 0x51,
+	0x7d, 0x99, 0x98, 0x4b, 0xcb, 0x7e, 0x38, 0xf6, 0xdb, 0xb5, 0xbd, 0xe1, 0xb7, 0x9b, 0xe1, 0x27,
+	0xa5, 0x86, 0x06, 0x0b, 0x7a, 0x01, 0x7a, 0x2f, 0xa0, 0xbd, 0x96, 0xe3, 0x79, 0xfa, 0x42, 0xd8,
+	0x0a, 0x47, 0x7f, 0x37, 0xef, 0x56, 0xef, 0x1b, 0x56, 0x70, 0x54, 0x06, 0x02, 0x1a, 0xb2, 0x22,
+	0x53, 0x4a, 0x37, 0x8c, 0xda, 0x1d, 0xf2, 0xc8, 0xa7, 0x0b, 0x39, 0x3a, 0x6c, 0xaf, 0xa0, 0x6a,
+	0x7b, 0x13, 0x74, 0xa9, 0xa0, 0x7d, 0x36, 0x79, 0xe4, 0xeb, 0x29, 0x7a, 0x7b, 0xed, 0x58, 0x56,
+	0x19, 0x43, 0x87, 0x92, 0x87, 0x75, 0xe1, 0x04, 0xbe, 0x2d, 0x21, 0x9c, 0x54, 0x80, 0xae, 0xce,
+	0xa0, 0x9d, 0xa1, 0x04, 0xf0, 0x3a, 0xcc, 0xf3, 0xa2, 0xb2, 0x54, 0x02, 0x1f, 0x43, 0xbb, 0xde,
+	0x88, 0x87, 0xb3, 0xa2, 0xc1, 0xaf, 0x60, 0x47, 0x90, 0xc5, 0x45, 0x52, 0xf3, 0xcd, 0x55, 0xa2,
+	0xbb, 0x34, 0x6c, 0x08, 0xb7, 0x78, 0x45, 0x3b, 0x18, 0x35, 0x40, 0x38, 0xa1, 0x2c, 0x01, 0xed,
+	0x6b, 0xe1, 0xd7, 0xd0, 0x3e, 0x0b, 0xbb, 0xf0, 0x2d, 0x84, 0xe2, 0x98, 0x19, 0x00, 0x8d, 0xa6,
+	0xce, 0x2f, 0xb3, 0xd1, 0xb4, 0xc8, 0xf5, 0x96, 0xeb, 0x39, 0xee, 0x7c, 0x24, 0x0b, 0x06, 0xb4,
+	0x84, 0xba, 0xf2, 0x9e, 0x84, 0xba, 0x05, 0x3d, 0x01, 0xed, 0x51, 0xb4, 0x2b, 0x24, 0xc5, 0x8e,
+	0x49, 0x21, 0x6f, 0x10, 0xc1, 0xb7, 0x39, 0x5c, 0x5d, 0x21, 0xae, 0xb3, 0xe5, 0x70, 0xd1, 0xee,
+	0x38, 0x60, 0xff, 0x87, 0x8e, 0x87, 0xb8, 0xe6, 0x58, 0x1c, 0xeb, 0x6d, 0xea, 0xba, 0x7e, 0x88,
+	0xfa, 0xb2, 0xcc, 0x03, 0xf7, 0x69, 0x84, 0xa2, 0x18, 0x9a, 0x85, 0xb2, 0x55, 0x9e, 0x4e, 0xac,
+	0xac, 0x25, 0x64, 0x95, 0xff, 0x45, 0xbd, 0xa1, 0xed, 0x1b, 0x8e, 0x4b, 0x6a, 0x86, 0xe7, 0x6f,
+	0x2e, 0xf2, 0xfb, 0xe8, 0x78, 0x86, 0x75, 0x00, 0x7e, 0x01, 0x55, 0x16, 0x59, 0x1b, 0xe0, 0x4e,
+	0x85, 0x37, 0x91, 0xaa, 0x16, 0x4b, 0x2a, 0x04, 0x9d, 0x08, 0xed, 0x3e, 0x08, 0xef, 0x28, 0xb7,
+	0x0d, 0x9f, 0x78, 0x7e, 0x44, 0xb0, 0x04, 0xf4, 0x41, 0xb4, 0x9f, 0x5e, 0x6f, 0x52, 0x27, 0xdc,
+	0x3e, 0xfa, 0x15, 0x7c, 0xbd, 0xb2, 0x8c, 0x94, 0xbc, 0x6e, 0x80, 0xc3, 0x0c, 0x3a, 0x68, 0x85,
+	0x4d, 0x7a, 0x34, 0xae, 0x62, 0x2a, 0xb1, 0xea, 0x01, 0x8b, 0xb7, 0xa5, 0xbc, 0x88, 0xe4, 0x44,
+	0x4f, 0x77, 0x9c, 0x3a, 0x8d, 0xe8, 0x80, 0xc9, 0x71, 0x84, 0x2c, 0x73, 0xa1, 0x39, 0xd9, 0xd4,
+	0x57, 0xc8, 0x1a, 0x9c, 0x4c, 0x15, 0xfa, 0xe5, 0x16, 0x59, 0x53, 0xee, 0xa3, 0x1e, 0xa1, 0x72,
+	0x74, 0xc7, 0xa9, 0x04, 0x97, 0xbc, 0x00, 0x9d, 0x03, 0xc0, 0x64, 0x1e, 0xd8, 0xdd, 0xc5, 0xc5,
+	0xda, 0xb2, 0x61, 0xda, 0x81, 0xaa, 0xb6, 0xc7, 0x06, 0x03, 0xca, 0x25, 0xb0, 0x0b, 0x07, 0x75,
+	0x87, 0xa8, 0x1e, 0xa0, 0x5e, 0xb1, 0xf6, 0xd3, 0xc2, 0x9a, 0xe1, 0x26, 0x1f, 0xe6, 0x6a, 0x66,
+	0x6d, 0x7e, 0x72, 0xfe, 0x16, 0x59, 0x2b, 0x09, 0xee, 0x0a, 0x52, 0xf2, 0x6c, 0x00, 0xc4, 0xcc,
+	0xdb, 0x8a, 0x72, 0x1d, 0x29, 0x49, 0x72, 0x4f, 0x06, 0xe2, 0x2a, 0x3a, 0x99, 0x6b, 0xa4, 0x10,
+	0xc5, 0xc7, 0x12, 0x7f, 0xae, 0x3e, 0x89, 0xff, 0xb9, 0x8c, 0x7a, 0x92, 0xbb, 0x58, 0xb7, 0x0c,
+	0x6e, 0x21, 0xd3, 0x4d, 0x5d, 0x4d, 0x6c, 0xea, 0xdb, 0x46, 0x62, 0xe1, 0xe2, 0x8b, 0xa8, 0xda,
+	0xae, 0xee, 0x92, 0x37, 0x0c, 0x97, 0xc6, 0x84, 0xdb, 0xb5, 0xa3, 0x29, 0x5d, 0x2d, 0x6c, 0x54,
+	0x66, 0x21, 0x86, 0xa6, 0xbb, 0xea, 0xc9, 0x09, 0x28, 0xaf, 0xf3, 0xa7, 0x7e, 0xb6, 0x93, 0xbc,
+	0x89, 0xb0, 0x4d, 0x65, 0xf4, 0x36, 0x67, 0x99, 0x73, 0xf8, 0x1f, 0xb2, 0xd3, 0x86, 0x95, 0x7f,
+	0x75, 0xa1, 0xd1, 0x52, 0xe0, 0x37, 0xbb, 0x67, 0x7c, 0x0b, 0x1d, 0xa0, 0x16, 0x5c, 0x9d, 0x1e,
+	0xd5, 0x81, 0xe7, 0x0a, 0x4e, 0x3b, 0x85, 0x37, 0xa3, 0x91, 0x25, 0x97, 0x04, 0xd3, 0xe4, 0xb8,
+	0x0d, 0x52, 0x7f, 0x10, 0x8a, 0x6a, 0xfb, 0x41, 0x95, 0xfe, 0xf4, 0xf0, 0xab, 0x08, 0x33, 0x97,
+	0x9a, 0xb0, 0xb7, 0xbd, 0xb4, 0xbd, 0x43, 0xb1, 0x36, 0x33, 0x69, 0xa0, 0x6e, 0xf6, 0x71, 0xae,
+	0xd1, 0xb4, 0x4c, 0x52, 0x8f, 0xc1, 0x57, 0x77, 0x84, 0x96, 0x4f, 0xf2, 0x96, 0x61, 0x07, 0xfa,
+	0xbe, 0x6b, 0x2e, 0xb4, 0x7c, 0x52, 0x0f, 0x07, 0x40, 0xcb, 0xb6, 0xa2, 0xfc, 0x3f, 0x1a, 0xa2,
+	0xa7, 0xa1, 0x47, 0x55, 0x35, 0xb2, 0x64, 0x06, 0x10, 0x02, 0x21, 0x3e, 0x20, 0xcb, 0x5b, 0xf6,
+	0x89, 0x4d, 0xd5, 0xc5, 0x6f, 0xaa, 0xbb, 0x68, 0xb8, 0xd8, 0x3e, 0x4c, 0xec, 0x49, 0xb4, 0xcf,
+	0xf4, 0x74, 0x37, 0x92, 0x08, 0x7b, 0xd9, 0xa3, 0x3d, 0x63, 0x7a, 0xb1, 0x96, 0xa2, 0x47, 0x06,
+	0xa3, 0x1b, 0xcf, 0xe6, 0x22, 0x9e, 0x47, 0x67, 0x4a, 0x74, 0xd0, 0x09, 0xe4, 0x69, 0xf0, 0xdd,
+	0x73, 0xde, 0x83, 0x65, 0xd3, 0x27, 0x96, 0xe9, 0xf9, 0xd7, 0xea, 0x0d, 0xd3, 0x2e, 0x4c, 0xe3,
+	0x28, 0x2f, 0xa0, 0xe3, 0x19, 0x9a, 0xd0, 0x7f, 0x37, 0xda, 0x63, 0x7a, 0xba, 0x11, 0x7c, 0x83,
+	0xae, 0x77, 0x9b, 0x5e, 0x28, 0xa2, 0x9c, 0x4f, 0x5e, 0x48, 0xb8, 0xf4, 0x5d, 0xde, 0xfe, 0xff,
+	0x8d, 0x84, 0xaa, 0xed, 0x6a, 0xd0, 0xdb, 0x3d, 0x14, 0xc7, 0xab, 0x3a, 0x97, 0xe9, 0x4b, 0x2d,
+	0xf0, 0x97, 0x99, 0x54, 0xc2, 0xca, 0xcc, 0x8e, 0xe0, 0xda, 0xa3, 0x1d, 0x88, 0x2c, 0xd0, 0xcf,
+	0xf8, 0x06, 0xaa, 0x38, 0xab, 0xc4, 0x75, 0xcd, 0x3a, 0xf1, 0xaa, 0x5d, 0x22, 0x6b, 0x09, 0x23,
+	0x77, 0x99, 0x24, 0x58, 0x8b, 0x55, 0x95, 0x53, 0x70, 0x88, 0xdc, 0xab, 0x2d, 0x93, 0x7a, 0xcb,
+	0x22, 0x75, 0xaa, 0x71, 0x7d, 0xd9, 0xb0, 0x97, 0x48, 0x94, 0xb9, 0xac, 0xa3, 0x93, 0xb9, 0x52,
+	0xc0, 0xf4, 0x32, 0xda, 0x5d, 0xa3, 0x9f, 0xaa, 0x92, 0x68, 0x9f, 0x09, 0xd5, 0x35, 0xa6, 0xa3,
+	0x5c, 0x66, 0x33, 0x9e, 0x8a, 0xa2, 0x12, 0x47, 0x19, 0xe4, 0x61, 0xe3, 0x29, 0xa8, 0xc0, 0x97,
+	0xb9, 0xba, 0xf2, 0x3f, 0xe9, 0x08, 0x38, 0x52, 0x8f, 0x22, 0xd4, 0xdd, 0x20, 0x0d, 0xe3, 0xdf,
+	0x97, 0x15, 0x1b, 0x81, 0x22, 0x13, 0x57, 0xae, 0xc0, 0x71, 0x7f, 0xb7, 0x49, 0xec, 0xb4, 0x54,
+	0x99, 0xf5, 0xf1, 0x35, 0xa4, 0xe4, 0xe9, 0x47, 0x57, 0xc2, 0x3d, 0xd0, 0x21, 0x1b, 0xbf, 0x22,
+	0x80, 0x91, 0xbc, 0xf2, 0x62, 0x32, 0x0d, 0x7b, 0x2f, 0x91, 0x91, 0x2e, 0x03, 0xaf, 0x86, 0xfa,
+	0x33, 0x95, 0x01, 0xdb, 0x7f, 0xa1, 0x7d, 0xc9, 0x3c, 0x37, 0x03, 0x98, 0x8a, 0x96, 0x92, 0xba,
+	0x1a, 0xaf, 0xa0, 0x5c, 0x85, 0x31, 0xbc, 0xee, 0xd8, 0x5e, 0xab, 0x41, 0x5c, 0x21, 0x48, 0x19,
+	0xed, 0xa9, 0x41, 0x3b, 0xec, 0xea, 0xe8, 0xb7, 0xb2, 0x88, 0x94, 0x3c, 0x03, 0x9b, 0x06, 0xf4,
+	0x1c, 0xb8, 0x00, 0xcd, 0xb1, 0xc8, 0x2b, 0xa4, 0xb1, 0x40, 0xdc, 0x08, 0x1e, 0x46, 0x3b, 0x5c,
+	0x07, 0x6e, 0xeb, 0x15, 0x2d, 0xfc, 0x5b, 0x39, 0x8f, 0xaa, 0xed, 0xe2, 0x71, 0xd8, 0xd4, 0xa0,
+	0x9f, 0x20, 0x2f, 0xcb, 0x7e, 0x46, 0x5a, 0x10, 0x70, 0x05, 0xca, 0x5e, 0xb1, 0x67, 0x9b, 0x60,
+	0x97, 0x4f, 0x4e, 0x0b, 0x3a, 0x3b, 0x82, 0x76, 0x06, 0x80, 0x58, 0x57, 0xf4, 0x87, 0x72, 0x03,
+	0x86, 0x1d, 0x92, 0x2b, 0x0d, 0x67, 0xd5, 0xb0, 0xbc, 0x1b, 0x8e, 0xcb, 0x85, 0x36, 0xe9, 0x6b,
+	0x94, 0xd4, 0x7e, 0x8d, 0x62, 0x4b, 0x38, 0xc3, 0x4e, 0x72, 0x09, 0xd3, 0x36, 0xf1, 0x12, 0x4e,
+	0xaa, 0x87, 0xa1, 0x78, 0x24, 0xaf, 0xdc, 0x11, 0xa5, 0x50, 0x9f, 0x02, 0x71, 0x13, 0x8d, 0x94,
+	0xb1, 0x17, 0xdd, 0xa0, 0xd2, 0xc8, 0x4f, 0xf3, 0xc8, 0x45, 0x66, 0x52, 0x0c, 0xee, 0x81, 0x03,
+	0x6b, 0x13, 0x29, 0x75, 0xb2, 0x42, 0x66, 0x93, 0x9d, 0xac, 0xf0, 0x33, 0x72, 0x6b, 0xed, 0x46,
+	0x93, 0x6e, 0x2d, 0xfc, 0x2c, 0x76, 0x6b, 0x6d, 0x8a, 0x4c, 0x5c, 0x59, 0x83, 0xd0, 0x3d, 0x93,
+	0x5a, 0x31, 0xee, 0x5e, 0x54, 0x89, 0xd2, 0x93, 0x80, 0x3c, 0xfe, 0x90, 0x64, 0xb5, 0x9d, 0x67,
+	0x65, 0xa2, 0xc1, 0x82, 0xae, 0xa3, 0xfd, 0x9c, 0x62, 0x57, 0x76, 0x5a, 0x22, 0x96, 0xd3, 0xa8,
+	0x27, 0xf6, 0x6e, 0x41, 0xf4, 0x7f, 0xdd, 0x69, 0x34, 0x4c, 0xbf, 0x84, 0x5f, 0xac, 0xa3, 0x5e,
+	0xb1, 0x26, 0x60, 0x7b, 0x09, 0xed, 0x0d, 0xef, 0x1a, 0xb5, 0xf0, 0x33, 0xe0, 0x4b, 0x9d, 0x79,
+	0xaf, 0x99, 0x0d, 0xe2, 0xf9, 0x46, 0xa3, 0x49, 0xea, 0xd7, 0x6a, 0xbe, 0xe3, 0xde, 0x71, 0x02,
+	0xff, 0x8d, 0xac, 0xc8, 0x9a, 0xf2, 0x98, 0xa5, 0x94, 0x68, 0x80, 0x7b, 0xd3, 0xf4, 0x7c, 0xc7,
+	0x5d, 0x2b, 0x31, 0xf6, 0x67, 0xc1, 0x1b, 0x05, 0xc3, 0xbe, 0x3f, 0x9d, 0x6b, 0xa1, 0xc6, 0x02,
+	0x0f, 0x41, 0xfd, 0x54, 0x98, 0x67, 0x0b, 0x83, 0x49, 0x98, 0x0a, 0xf8, 0x15, 0xcc, 0x11, 0xc4,
+	0xe4, 0xd5, 0x1d, 0x74, 0x8e, 0xe0, 0x67, 0x2a, 0x71, 0xb6, 0xf3, 0xe9, 0x12, 0x67, 0x3f, 0x96,
+	0x20, 0xbd, 0x90, 0x62, 0x99, 0x5c, 0xc4, 0x41, 0x43, 0x86, 0xdf, 0x48, 0x0c, 0x23, 0x8d, 0xce,
+	0x99, 0xf8, 0x26, 0xa7, 0xd1, 0xe6, 0x61, 0x2e, 0xe6, 0x8d, 0x35, 0xa7, 0xe5, 0x33, 0x3f, 0xfb,
+	0x34, 0x91, 0xf1, 0x07, 0x8c, 0x78, 0xca, 0x24, 0x10, 0x1f, 0x44, 0xfb, 0x9b, 0x61, 0x43, 0x2a,
+	0xeb, 0xbf, 0xaf, 0x99, 0x14, 0xc7, 0xe3, 0xe8, 0x08, 0xed, 0x3a, 0x25, 0x4c, 0x3b, 0xc3, 0x3e,
+	0x0d, 0xf6, 0x92, 0x1a, 0x93, 0xe8, 0xe8, 0x92, 0xe5, 0x2c, 0x18, 0x56, 0x5a, 0x85, 0x4e, 0xff,
+	0x61, 0xda, 0xc8, 0xe9, 0x4c, 0xbe, 0x75, 0x09, 0xed, 0x0c, 0xb1, 0xe2, 0x15, 0xb4, 0x0b, 0x22,
+	0xcd, 0x01, 0x7e, 0x2c, 0xdb, 0xdf, 0xb5, 0xe5, 0x13, 0x39, 0x12, 0x94, 0xa5, 0xd2, 0xfb, 0xd6,
+	0x67, 0x7f, 0x7b, 0xb7, 0xeb, 0x18, 0x3e, 0xa2, 0x0a, 0x6a, 0x02, 0xf0, 0x3b, 0x12, 0xda, 0x3f,
+	0x4b, 0xfc, 0x44, 0xce, 0x1a, 0x0f, 0x0a, 0x6c, 0xb6, 0xa7, 0xbb, 0xe5, 0xd3, 0x45, 0x62, 0xd0,
+	0xff, 0xf0, 0xb7, 0x82, 0x17, 0x81, 0x10, 0xc4, 0x71, 0xdc, 0xc3, 0x83, 0xe0, 0x72, 0xe2, 0x78,
+	0x1d, 0xed, 0x99, 0x25, 0x54, 0x1f, 0xf7, 0x0b, 0xac, 0x27, 0x13, 0xe3, 0xf2, 0x40, 0xb6, 0x00,
+	0x74, 0x7c, 0x36, 0xee, 0xf8, 0x04, 0xee, 0x57, 0xdb, 0xcb, 0x14, 0x3c, 0x75, 0x9d, 0xf5, 0xbd,
+	0x81, 0xbf, 0x2d, 0xa1, 0x03, 0xb3, 0xc4, 0x4f, 0xe6, 0x96, 0xb1, 0x88, 0xa2, 0x20, 0xcd, 0x2d,
+	0x0f, 0x15, 0xca, 0x95, 0x18, 0x0b, 0x83, 0xde, 0x52, 0x20, 0x43, 0xfd, 0xa5, 0x84, 0x4e, 0xcc,
+	0x12, 0x5f, 0x98, 0x7a, 0x9c, 0x59, 0x63, 0x53, 0xa5, 0x0a, 0x3a, 0xce, 0x4b, 0x8a, 0xca, 0xe3,
+	0xe5, 0x15, 0x00, 0xf2, 0xfd, 0x18, 0xf2, 0x2d, 0x3c, 0x57, 0x30, 0x8a, 0x50, 0x35, 0xe2, 0xa9,
+	0xeb, 0x7c, 0x7e, 0x75, 0x43, 0x4d, 0xa7, 0x48, 0xf1, 0x2f, 0x25, 0x74, 0x64, 0x96, 0xf8, 0x6d,
+	0x59, 0x13, 0x3c, 0x2a, 0x80, 0x98, 0x95, 0x18, 0x92, 0xcf, 0x96, 0x13, 0x06, 0x2e, 0x57, 0x63,
+	0x2e, 0xe7, 0xf1, 0xa4, 0x2a, 0x2e, 0x42, 0xe1, 0xf8, 0xac, 0x27, 0x23, 0x9d, 0x0d, 0xfc, 0xa1,
+	0x84, 0x0e, 0xcf, 0x12, 0x3f, 0x9d, 0xcf, 0xc6, 0x23, 0x02, 0x18, 0x19, 0x29, 0x75, 0x79, 0xb4,
+	0x94, 0x2c, 0x20, 0xbe, 0x12, 0x23, 0x9e, 0xc2, 0x13, 0x3c, 0xe2, 0x28, 0x1f, 0x9e, 0x03, 0xf8,
+	0x0f, 0x12, 0xea, 0x09, 0xb7, 0xb7, 0xf8, 0x49, 0x0c, 0x4f, 0x0a, 0x37, 0x71, 0xee, 0x2b, 0x9d,
+	0x3c, 0xd5, 0x91, 0x0e, 0x10, 0xb9, 0x16, 0x13, 0x79, 0x0e, 0x9f, 0x4f, 0x7b, 0x81, 0x50, 0x59,
+	0xb7, 0x1c, 0x2f, 0x8f, 0xcb, 0xdb, 0x12, 0xda, 0x17, 0xfa, 0x07, 0x56, 0x58, 0x83, 0x4f, 0x09,
+	0x7d, 0x40, 0xaa, 0x22, 0x47, 0x1e, 0x2c, 0x90, 0x02, 0x84, 0xa7, 0x63, 0x84, 0x3d, 0xb8, 0x3b,
+	0xbd, 0xd0, 0x7d, 0xc3, 0xd2, 0xc3, 0x0a, 0x26, 0xfc, 0xbe, 0x84, 0x8e, 0x32, 0x37, 0xc5, 0x95,
+	0xd4, 0xe0, 0xb3, 0xd9, 0x2e, 0xa9, 0xbd, 0x36, 0x47, 0x3e, 0x57, 0x52, 0x1a, 0xe0, 0xa9, 0x31,
+	0xbc, 0x53, 0x58, 0x11, 0xec, 0x43, 0xf6, 0x50, 0xa7, 0x2f, 0x50, 0x34, 0xbf, 0x96, 0xd0, 0xb1,
+	0x59, 0xe2, 0x0b, 0x6a, 0x4b, 0xb0, 0xa8, 0xeb, 0xec, 0x22, 0x17, 0x79, 0xac, 0xac, 0x38, 0x40,
+	0xbd, 0x1c, 0x43, 0x9d, 0xc4, 0xe3, 0xaa, 0xa8, 0x76, 0x8c, 0x8e, 0xa5, 0xba, 0x1e, 0xf9, 0x87,
+	0x84, 0x27, 0xfe, 0x3d, 0x5d, 0xb3, 0x59, 0x95, 0x31, 0xc2, 0x35, 0x5b, 0x50, 0xa7, 0x23, 0x4f,
+	0x75, 0xa4, 0x03, 0x3c, 0x2e, 0xc4, 0x3c, 0x46, 0xf0, 0xb0, 0x90, 0x87, 0x47, 0x89, 0x70, 0x27,
+	0xc9, 0x5f, 0x25, 0x34, 0x30, 0x4b, 0xfc, 0xdc, 0x62, 0x18, 0x7c, 0x51, 0x00, 0xa8, 0x4c, 0xbd,
+	0x8e, 0x3c, 0xdd, 0xb9, 0x22, 0xd0, 0xf9, 0xef, 0x98, 0xce, 0x55, 0x7c, 0x39, 0x67, 0x5a, 0x74,
+	0x8f, 0x58, 0x8b, 0xea, 0x7a, 0xaa, 0x0a, 0x84, 0x9b, 0xa3, 0xbf, 0x53, 0x8e, 0xb9, 0x15, 0x30,
+	0x42, 0x8e, 0x65, 0x8a, 0x74, 0xe4, 0xe9, 0xce, 0x15, 0x81, 0xe3, 0x9d, 0x98, 0xe3, 0x75, 0x7c,
+	0x4d, 0xcc, 0x11, 0xee, 0x48, 0x84, 0xad, 0xc1, 0x3c, 0x9e, 0xdf, 0xec, 0x42, 0x83, 0xc9, 0xb9,
+	0xcc, 0x2c, 0x63, 0xc1, 0x97, 0xf2, 0xe6, 0xa5, 0xa8, 0x42, 0x47, 0xbe, 0xfc, 0x84, 0xda, 0x40,
+	0xfb, 0x61, 0x4c, 0xfb, 0x2e, 0x7e, 0x85, 0xa7, 0x9d, 0xa6, 0xdb, 0x56, 0xeb, 0xb2, 0x91, 0x3f,
+	0x04, 0x9f, 0x49, 0xa8, 0x37, 0x6f, 0x08, 0xf0, 0x54, 0x27, 0xd8, 0x19, 0xe1, 0xf3, 0x9d, 0x29,
+	0x01, 0xcf, 0x9b, 0x31, 0xcf, 0xcb, 0xf8, 0xc5, 0xce, 0x79, 0xc6, 0xac, 0xde, 0x61, 0x87, 0x09,
+	0x2b, 0x7e, 0xc9, 0x38, 0x4c, 0x52, 0x05, 0x35, 0xf2, 0x60, 0x81, 0x14, 0x00, 0x1d, 0x8d, 0x81,
+	0x0e, 0xe0, 0x3e, 0xb5, 0xbd, 0x10, 0x36, 0x89, 0x25, 0x48, 0x61, 0xb3, 0x11, 0x4e, 0x27, 0x49,
+	0x84, 0x21, 0x5e, 0x5e, 0x7a, 0x46, 0x1e, 0x2f, 0xaf, 0x00, 0x60, 0xa7, 0x63, 0xb0, 0xe7, 0xf0,
+	0xa8, 0x00, 0xac, 0xce, 0xd2, 0x2c, 0xe9, 0x23, 0xf9, 0x73, 0x81, 0x1b, 0x68, 0x63, 0x50, 0xe8,
+	0x06, 0xb2, 0x98, 0x4c, 0x77, 0xae, 0x58, 0x22, 0xda, 0xe0, 0xd7, 0x49, 0x26, 0xb5, 0x9f, 0xd1,
+	0x50, 0x2f, 0x9d, 0xdb, 0x10, 0x86, 0x7a, 0x19, 0xc9, 0x1b, 0x79, 0xb4, 0x94, 0x6c, 0x89, 0x53,
+	0x93, 0x83, 0xca, 0x85, 0x48, 0xb0, 0x73, 0x37, 0xf0, 0x17, 0xf4, 0xd4, 0xcc, 0xca, 0xc9, 0x08,
+	0x4f, 0xcd, 0x82, 0xe4, 0x93, 0x3c, 0xd5, 0x91, 0x0e, 0xf0, 0xd0, 0x62, 0x1e, 0xb3, 0xf8, 0xe5,
+	0x32, 0x63, 0xcf, 0x11, 0x8a, 0xb6, 0x6d, 0x92, 0xdc, 0xf7, 0x25, 0x74, 0x28, 0xba, 0x0d, 0xb1,
+	0x52, 0x02, 0x3c, 0x9c, 0x79, 0x99, 0x49, 0xd5, 0x2a, 0xc8, 0x67, 0x4a, 0x48, 0x02, 0xfc, 0xb1,
+	0x18, 0xfe, 0x49, 0x7c, 0x42, 0x15, 0xd4, 0xc4, 0xab, 0xeb, 0xf1, 0xab, 0xfe, 0x06, 0xfe, 0x81,
+	0x84, 0x70, 0x1c, 0x66, 0x45, 0xd8, 0xce, 0x64, 0xc7, 0x4c, 0x69, 0x70, 0x23, 0x65, 0x44, 0x4b,
+	0x44, 0x81, 0x30, 0x5a, 0x3c, 0xbc, 0x8f, 0xa8, 0x6f, 0x11, 0x16, 0x3a, 0xe4, 0x5c, 0x1f, 0xc5,
+	0x15, 0x0d, 0xf2, 0x78, 0x79, 0x05, 0x00, 0xfc, 0x5c, 0x0c, 0x78, 0x14, 0x9f, 0x11, 0x0d, 0x27,
+	0x73, 0xcf, 0x3c, 0xee, 0x8f, 0x25, 0xd4, 0x1d, 0x0f, 0x6b, 0x1a, 0xf8, 0x78, 0xf6, 0x90, 0x65,
+	0x20, 0x9f, 0xe8, 0x40, 0x03, 0xa0, 0x5f, 0x8c, 0xa1, 0x9f, 0xc5, 0x23, 0xe2, 0x58, 0x42, 0x88,
+	0xfd, 0x4f, 0x12, 0xea, 0xc9, 0x79, 0x3e, 0xc6, 0x17, 0x44, 0x97, 0xd6, 0xc2, 0xe7, 0x6c, 0xf9,
+	0xb9, 0x4e, 0xd5, 0x4a, 0x38, 0x43, 0x98, 0x82, 0xf8, 0x3d, 0x98, 0xdb, 0x8b, 0xec, 0xe0, 0x0c,
+	0x63, 0x80, 0xbc, 0xe7, 0x65, 0x2c, 0xc6, 0x56, 0xf8, 0xe0, 0x2d, 0x5f, 0xec, 0x58, 0x0f, 0x48,
+	0xcd, 0xc4, 0xa4, 0x2e, 0xe2, 0x0b, 0xe2, 0xc9, 0x29, 0x62, 0xf5, 0x5e, 0x57, 0xf2, 0x72, 0xdc,
+	0x9e, 0x89, 0xc8, 0xb9, 0x1c, 0x67, 0x26, 0x24, 0xa6, 0x3a, 0xd2, 0x01, 0x32, 0xdf, 0x91, 0x62,
+	0x36, 0x6f, 0x4a, 0xf8, 0x1b, 0xe2, 0xeb, 0xb1, 0x38, 0x43, 0xc1, 0x17, 0xec, 0xa8, 0xeb, 0xed,
+	0xe5, 0x38, 0x51, 0xe3, 0x86, 0x9a, 0x28, 0xcf, 0x11, 0x49, 0xd2, 0x96, 0x0d, 0xfc, 0x47, 0xba,
+	0xf9, 0xc4, 0xe5, 0x2d, 0x58, 0x74, 0x2c, 0x97, 0x2a, 0xe3, 0x91, 0x9f, 0x7f, 0x02, 0xcd, 0x12,
+	0x31, 0x4a, 0xee, 0x00, 0x05, 0x0e, 0xfa, 0x60, 0xba, 0x1c, 0x41, 0x78, 0x8a, 0x67, 0x54, 0x3b,
+	0xc8, 0xa3, 0xa5, 0x64, 0x99, 0x83, 0x0e, 0x21, 0x9e, 0xc1, 0x43, 0xa9, 0x7d, 0xc6, 0xa4, 0x69,
+	0xe9, 0x43, 0x62, 0x11, 0xbe, 0x4b, 0x13, 0xb0, 0x89, 0x92, 0x01, 0x9c, 0x19, 0x63, 0xf2, 0xb9,
+	0xdf, 0xd3, 0x45, 0x62, 0x00, 0x69, 0x32, 0x1e, 0xba, 0x21, 0x3c, 0x28, 0xca, 0x1c, 0xd0, 0x5c,
+	0x70, 0x72, 0xd0, 0x3e, 0xa2, 0x2b, 0x40, 0x5c, 0x74, 0x20, 0x74, 0xbf, 0xb9, 0x55, 0x0c, 0xf2,
+	0x44, 0x07, 0x1a, 0x00, 0x7b, 0x2a, 0x86, 0x3d, 0x8c, 0x4f, 0xf3, 0xb0, 0x3d, 0xa6, 0x0a, 0xd0,
+	0x75, 0xa8, 0x63, 0xc0, 0x1f, 0xd0, 0xa8, 0xad, 0xad, 0x18, 0x74, 0x24, 0x2f, 0x4f, 0xc8, 0xd7,
+	0x3a, 0xc8, 0xa3, 0xa5, 0x64, 0x01, 0xe5, 0xf3, 0x31, 0xca, 0x31, 0x7c, 0x36, 0x23, 0xa5, 0xa8,
+	0x43, 0xad, 0x80, 0xba, 0x0e, 0x7f, 0x84, 0x63, 0xfc, 0x3b, 0x7a, 0x34, 0x0b, 0x0b, 0x13, 0x84,
+	0x47, 0x73, 0x5e, 0x09, 0x84, 0x3c, 0x5e, 0x5e, 0xa1, 0x44, 0xc0, 0xe9, 0x34, 0x89, 0xad, 0xb7,
+	0xe1, 0xe7, 0x96, 0xc8, 0xcf, 0x13, 0x79, 0x30, 0xae, 0x1e, 0x20, 0x3b, 0x0f, 0x26, 0xaa, 0x3b,
+	0x90, 0xcf, 0x95, 0x94, 0x2e, 0x31, 0xe0, 0x14, 0x1c, 0x57, 0x52, 0x90, 0x44, 0xfc, 0x5b, 0x3a,
+	0xe0, 0xc2, 0x22, 0x06, 0xe1, 0x80, 0xe7, 0xd5, 0x4b, 0xc8, 0xe3, 0xe5, 0x15, 0x00, 0xfa, 0xa5,
+	0x18, 0xfa, 0x04, 0x56, 0x79, 0xe8, 0xac, 0xd4, 0x22, 0x8d, 0x9e, 0x7d, 0xdf, 0xc0, 0xdf, 0xa5,
+	0x8e, 0x22, 0x51, 0xeb, 0x20, 0x74, 0x14, 0xed, 0xa5, 0x13, 0xf2, 0xe9, 0x22, 0xb1, 0x12, 0xa1,
+	0xaf, 0xeb, 0x58, 0x44, 0x87, 0x0a, 0x0a, 0x75, 0x3d, 0xf8, 0xb5, 0x81, 0xdf, 0x83, 0x27, 0x93,
+	0x44, 0x45, 0x84, 0xf8, 0xc9, 0xa4, 0xbd, 0xd0, 0x42, 0x1e, 0x2a, 0x94, 0x2b, 0xe1, 0xbd, 0xc0,
+	0x89, 0xea, 0x01, 0x1c, 0x2f, 0xe1, 0x53, 0x3f, 0xa4, 0x29, 0x8b, 0xe8, 0xed, 0x98, 0xc6, 0x48,
+	0xf4, 0xcd, 0x37, 0x33, 0x3a, 0x17, 0xbf, 0x51, 0xcb, 0x23, 0x65, 0x44, 0x4b, 0x44, 0x8c, 0x74,
+	0x19, 0x26, 0xde, 0xac, 0x53, 0x7b, 0xe9, 0x78, 0x12, 0x30, 0x04, 0x40, 0xff, 0xc1, 0x88, 0x7f,
+	0x2a, 0xa1, 0x83, 0x61, 0x7c, 0x9e, 0x78, 0x51, 0xc6, 0x43, 0xc2, 0x20, 0xbb, 0xfd, 0x65, 0x5d,
+	0x1e, 0x2e, 0x16, 0x04, 0x80, 0x2f, 0xc5, 0x00, 0x9f, 0xc7, 0x17, 0xd3, 0x71, 0x5e, 0xa0, 0xa1,
+	0x2f, 0x53, 0x15, 0xfe, 0x5a, 0x1c, 0x2c, 0x50, 0xf6, 0x0a, 0xb5, 0x81, 0xdf, 0xa7, 0x68, 0xf9,
+	0x57, 0xda, 0x21, 0xe1, 0xdb, 0x69, 0xfb, 0xdb, 0xb3, 0x3c, 0x5c, 0x2c, 0x58, 0xe2, 0xb9, 0x86,
+	0x7f, 0x0a, 0x16, 0x46, 0xa4, 0x33, 0xda, 0x27, 0x5f, 0xf5, 0x49, 0x9f, 0x7e, 0xd5, 0x27, 0xfd,
+	0xe5, 0xab, 0x3e, 0xe9, 0x7b, 0x8f, 0xfb, 0xb6, 0x7d, 0xfa, 0xb8, 0x6f, 0xdb, 0xe7, 0x8f, 0xfb,
+	0xb6, 0x3d, 0x9c, 0x5e, 0x32, 0xfd, 0xe5, 0xd6, 0xc2, 0x58, 0xcd, 0x69, 0xa8, 0x86, 0x65, 0x39,
+	0xae, 0x71, 0x0e, 0xc2, 0x1e, 0xf6, 0x33, 0xac, 0x95, 0x57, 0x1f, 0x25, 0x3a, 0x0d, 0xff, 0x3f,
+	0xfa, 0xc2, 0xae, 0xf0, 0xff, 0x45, 0x4f, 0xfd, 0x7b, 0x00, 0x0b, 0x4e, 0x76, 0x20, 0x00, 0x3f,
+	0x00, 0x00,
 }
 
-func _Query_GetReputerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReputerNodeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryTotalStakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReputerNodeInfo(ctx, in)
+
+	that1, ok := that.(*QueryTotalStakeResponse)
+	if !ok {
+		that2, ok := that.(QueryTotalStakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetReputerNodeInfo",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReputerNodeInfo(ctx, req.(*QueryReputerNodeInfoRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_GetWorkerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWorkerAddressByP2PKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryReputerStakeInTopicResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, in)
+
+	that1, ok := that.(*QueryReputerStakeInTopicResponse)
+	if !ok {
+		that2, ok := that.(QueryReputerStakeInTopicResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetWorkerAddressByP2PKey",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, req.(*QueryWorkerAddressByP2PKeyRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_GetReputerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReputerAddressByP2PKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryStakeFromReputerInTopicInSelfResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, in)
+
+	that1, ok := that.(*QueryStakeFromReputerInTopicInSelfResponse)
+	if !ok {
+		that2, ok := that.(QueryStakeFromReputerInTopicInSelfResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetReputerAddressByP2PKey",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, req.(*QueryReputerAddressByP2PKeyRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_IsWorkerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsWorkerRegisteredInTopicIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryDelegateStakeInTopicInReputerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, in)
+
+	that1, ok := that.(*QueryDelegateStakeInTopicInReputerResponse)
+	if !ok {
+		that2, ok := that.(QueryDelegateStakeInTopicInReputerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/IsWorkerRegisteredInTopicId",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, req.(*QueryIsWorkerRegisteredInTopicIdRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *QueryStakeFromDelegatorInTopicInReputerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_IsReputerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsReputerRegisteredInTopicIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryStakeFromDelegatorInTopicInReputerResponse)
+	if !ok {
+		that2, ok := that.(QueryStakeFromDelegatorInTopicInReputerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/IsReputerRegisteredInTopicId",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, req.(*QueryIsReputerRegisteredInTopicIdRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_GetNetworkInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetworkInferencesAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryStakeFromDelegatorInTopicResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, in)
+
+	that1, ok := that.(*QueryStakeFromDelegatorInTopicResponse)
+	if !ok {
+		that2, ok := that.(QueryStakeFromDelegatorInTopicResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetNetworkInferencesAtBlock",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, req.(*QueryNetworkInferencesAtBlockRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_GetLatestNetworkInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestNetworkInferencesAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryTopicStakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLatestNetworkInference(ctx, in)
+
+	that1, ok := that.(*QueryTopicStakeResponse)
+	if !ok {
+		that2, ok := that.(QueryTopicStakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetLatestNetworkInference",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLatestNetworkInference(ctx, req.(*QueryLatestNetworkInferencesAtBlockRequest))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
 
-func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsWhitelistAdminRequest)
-	if err := dec(in); err != nil {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error)
+	GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error)
+	GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error)
+	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error)
+	GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error)
+	GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error)
+	GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error)
+	GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error)
+	GetTopicRevenueBurned(ctx context.Context, in *QueryTopicRevenueBurnedRequest, opts ...grpc.CallOption) (*QueryTopicRevenueBurnedResponse, error)
+	GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error)
+	GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error)
+	GetStakeFromReputerInTopicInSelf(ctx context.Context, in *QueryStakeFromReputerInTopicInSelfRequest, opts ...grpc.CallOption) (*QueryStakeFromReputerInTopicInSelfResponse, error)
+	GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error)
+	GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error)
+	GetStakeRemovalsForBlock(ctx context.Context, in *QueryStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryStakeRemovalsForBlockResponse, error)
+	GetDelegateStakeRemovalsForBlock(ctx context.Context, in *QueryDelegateStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalsForBlockResponse, error)
+	GetStakeRemovalInfo(ctx context.Context, in *QueryStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryStakeRemovalInfoResponse, error)
+	GetDelegateStakeRemovalInfo(ctx context.Context, in *QueryDelegateStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalInfoResponse, error)
+	GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error)
+	GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error)
+	GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error)
+	GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error)
+	IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
+	IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error)
+	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
+	GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesAtBlockResponse, error)
+	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error)
+	GetScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error)
+	GetInferenceRequest(ctx context.Context, in *QueryInferenceRequestRequest, opts ...grpc.CallOption) (*QueryInferenceRequestResponse, error)
+	GetOpenInferenceRequests(ctx context.Context, in *QueryOpenInferenceRequestsRequest, opts ...grpc.CallOption) (*QueryOpenInferenceRequestsResponse, error)
+	GetTopicSubscriptions(ctx context.Context, in *QueryTopicSubscriptionsRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error)
+	GetConsumerSubscriptions(ctx context.Context, in *QueryConsumerSubscriptionsRequest, opts ...grpc.CallOption) (*QueryConsumerSubscriptionsResponse, error)
+	GetRoleMembers(ctx context.Context, in *QueryRoleMembersRequest, opts ...grpc.CallOption) (*QueryRoleMembersResponse, error)
+	GetAddressRoles(ctx context.Context, in *QueryAddressRolesRequest, opts ...grpc.CallOption) (*QueryAddressRolesResponse, error)
+	GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
+	GetTopicLastReputerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
+	GetRegretHistory(ctx context.Context, in *QueryRegretHistoryRequest, opts ...grpc.CallOption) (*QueryRegretHistoryResponse, error)
+	GetPayoutAddress(ctx context.Context, in *QueryPayoutAddressRequest, opts ...grpc.CallOption) (*QueryPayoutAddressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsWhitelistAdmin(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error) {
+	out := new(QueryNextTopicIdResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNextTopicId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/IsWhitelistAdmin",
+	return out, nil
+}
+
+func (c *queryClient) GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error) {
+	out := new(QueryTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsWhitelistAdmin(ctx, req.(*QueryIsWhitelistAdminRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error) {
+	out := new(QueryActiveTopicsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetActiveTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetTopicLastWorkerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopicLastCommitRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error) {
+	out := new(QueryWorkerLatestInferenceResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopicLastWorkerCommitInfo(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error) {
+	out := new(QueryInferencesAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetInferencesAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTopicLastWorkerCommitInfo",
+	return out, nil
+}
+
+func (c *queryClient) GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error) {
+	out := new(QueryForecastsAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetForecastsAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopicLastWorkerCommitInfo(ctx, req.(*QueryTopicLastCommitRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error) {
+	out := new(QueryNetworkLossBundleAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkLossBundleAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetTopicLastReputerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopicLastCommitRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error) {
+	out := new(QueryTotalStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTotalStake", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopicLastReputerCommitInfo(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetTopicRevenueBurned(ctx context.Context, in *QueryTopicRevenueBurnedRequest, opts ...grpc.CallOption) (*QueryTopicRevenueBurnedResponse, error) {
+	out := new(QueryTopicRevenueBurnedResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicRevenueBurned", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTopicLastReputerCommitInfo",
+	return out, nil
+}
+
+func (c *queryClient) GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error) {
+	out := new(QueryReputerStakeInTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerStakeInTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopicLastReputerCommitInfo(ctx, req.(*QueryTopicLastCommitRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error) {
+	out := new(QueryMultiReputerStakeInTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetMultiReputerStakeInTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "emissions.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetNextTopicId",
-			Handler:    _Query_GetNextTopicId_Handler,
-		},
-		{
-			MethodName: "GetTopic",
-			Handler:    _Query_GetTopic_Handler,
-		},
-		{
-			MethodName: "GetActiveTopics",
-			Handler:    _Query_GetActiveTopics_Handler,
-		},
-		{
-			MethodName: "GetWorkerLatestInferenceByTopicId",
-			Handler:    _Query_GetWorkerLatestInferenceByTopicId_Handler,
-		},
-		{
-			MethodName: "GetInferencesAtBlock",
-			Handler:    _Query_GetInferencesAtBlock_Handler,
-		},
-		{
-			MethodName: "GetForecastsAtBlock",
-			Handler:    _Query_GetForecastsAtBlock_Handler,
-		},
-		{
-			MethodName: "GetNetworkLossBundleAtBlock",
-			Handler:    _Query_GetNetworkLossBundleAtBlock_Handler,
-		},
-		{
-			MethodName: "GetTotalStake",
-			Handler:    _Query_GetTotalStake_Handler,
-		},
-		{
-			MethodName: "GetReputerStakeInTopic",
-			Handler:    _Query_GetReputerStakeInTopic_Handler,
-		},
-		{
-			MethodName: "GetMultiReputerStakeInTopic",
-			Handler:    _Query_GetMultiReputerStakeInTopic_Handler,
-		},
-		{
-			MethodName: "GetStakeFromReputerInTopicInSelf",
-			Handler:    _Query_GetStakeFromReputerInTopicInSelf_Handler,
-		},
-		{
-			MethodName: "GetDelegateStakeInTopicInReputer",
-			Handler:    _Query_GetDelegateStakeInTopicInReputer_Handler,
-		},
-		{
-			MethodName: "GetStakeFromDelegatorInTopicInReputer",
-			Handler:    _Query_GetStakeFromDelegatorInTopicInReputer_Handler,
-		},
-		{
-			MethodName: "GetStakeFromDelegatorInTopic",
-			Handler:    _Query_GetStakeFromDelegatorInTopic_Handler,
-		},
-		{
-			MethodName: "GetTopicStake",
-			Handler:    _Query_GetTopicStake_Handler,
-		},
-		{
-			MethodName: "GetStakeRemovalsForBlock",
-			Handler:    _Query_GetStakeRemovalsForBlock_Handler,
-		},
-		{
-			MethodName: "GetDelegateStakeRemovalsForBlock",
-			Handler:    _Query_GetDelegateStakeRemovalsForBlock_Handler,
-		},
-		{
-			MethodName: "GetStakeRemovalInfo",
-			Handler:    _Query_GetStakeRemovalInfo_Handler,
-		},
-		{
-			MethodName: "GetDelegateStakeRemovalInfo",
-			Handler:    _Query_GetDelegateStakeRemovalInfo_Handler,
-		},
-		{
-			MethodName: "GetWorkerNodeInfo",
-			Handler:    _Query_GetWorkerNodeInfo_Handler,
-		},
-		{
-			MethodName: "GetReputerNodeInfo",
-			Handler:    _Query_GetReputerNodeInfo_Handler,
-		},
-		{
-			MethodName: "GetWorkerAddressByP2PKey",
-			Handler:    _Query_GetWorkerAddressByP2PKey_Handler,
-		},
-		{
-			MethodName: "GetReputerAddressByP2PKey",
-			Handler:    _Query_GetReputerAddressByP2PKey_Handler,
-		},
-		{
-			MethodName: "IsWorkerRegisteredInTopicId",
-			Handler:    _Query_IsWorkerRegisteredInTopicId_Handler,
-		},
-		{
-			MethodName: "IsReputerRegisteredInTopicId",
-			Handler:    _Query_IsReputerRegisteredInTopicId_Handler,
-		},
-		{
-			MethodName: "GetNetworkInferencesAtBlock",
-			Handler:    _Query_GetNetworkInferencesAtBlock_Handler,
-		},
-		{
-			MethodName: "GetLatestNetworkInference",
-			Handler:    _Query_GetLatestNetworkInference_Handler,
-		},
-		{
-			MethodName: "IsWhitelistAdmin",
-			Handler:    _Query_IsWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "GetTopicLastWorkerCommitInfo",
-			Handler:    _Query_GetTopicLastWorkerCommitInfo_Handler,
-		},
-		{
-			MethodName: "GetTopicLastReputerCommitInfo",
-			Handler:    _Query_GetTopicLastReputerCommitInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "emissions/v1/query.proto",
+func (c *queryClient) GetStakeFromReputerInTopicInSelf(ctx context.Context, in *QueryStakeFromReputerInTopicInSelfRequest, opts ...grpc.CallOption) (*QueryStakeFromReputerInTopicInSelfResponse, error) {
+	out := new(QueryStakeFromReputerInTopicInSelfResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromReputerInTopicInSelf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error) {
+	out := new(QueryDelegateStakeInTopicInReputerResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeInTopicInReputer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
+	out := new(QueryStakeFromDelegatorInTopicInReputerResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error) {
+	out := new(QueryStakeFromDelegatorInTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error) {
+	out := new(QueryTopicStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStakeRemovalsForBlock(ctx context.Context, in *QueryStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryStakeRemovalsForBlockResponse, error) {
+	out := new(QueryStakeRemovalsForBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeRemovalsForBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetDelegateStakeRemovalsForBlock(ctx context.Context, in *QueryDelegateStakeRemovalsForBlockRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalsForBlockResponse, error) {
+	out := new(QueryDelegateStakeRemovalsForBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeRemovalsForBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStakeRemovalInfo(ctx context.Context, in *QueryStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryStakeRemovalInfoResponse, error) {
+	out := new(QueryStakeRemovalInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeRemovalInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegateStakeRemovalInfo(ctx context.Context, in *QueryDelegateStakeRemovalInfoRequest, opts ...grpc.CallOption) (*QueryDelegateStakeRemovalInfoResponse, error) {
+	out := new(QueryDelegateStakeRemovalInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeRemovalInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error) {
+	out := new(QueryWorkerNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error) {
+	out := new(QueryReputerNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerNodeInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error) {
+	out := new(QueryWorkerAddressByP2PKeyResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerAddressByP2PKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error) {
+	out := new(QueryReputerAddressByP2PKeyResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerAddressByP2PKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
+	out := new(QueryIsWorkerRegisteredInTopicIdResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWorkerRegisteredInTopicId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
+	out := new(QueryIsReputerRegisteredInTopicIdResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsReputerRegisteredInTopicId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error) {
+	out := new(QueryNetworkInferencesAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkInferencesAtBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesAtBlockResponse, error) {
+	out := new(QueryLatestNetworkInferencesAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetLatestNetworkInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error) {
+	out := new(QueryIsWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWhitelistAdmin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMultiReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error) {
+	out := new(QueryTopicParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error) {
+	out := new(QueryScheduledParamsChangesResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetScheduledParamsChanges", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetInferenceRequest(ctx context.Context, in *QueryInferenceRequestRequest, opts ...grpc.CallOption) (*QueryInferenceRequestResponse, error) {
+	out := new(QueryInferenceRequestResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetInferenceRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetOpenInferenceRequests(ctx context.Context, in *QueryOpenInferenceRequestsRequest, opts ...grpc.CallOption) (*QueryOpenInferenceRequestsResponse, error) {
+	out := new(QueryOpenInferenceRequestsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetOpenInferenceRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTopicSubscriptions(ctx context.Context, in *QueryTopicSubscriptionsRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error) {
+	out := new(QueryTopicSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicSubscriptions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetConsumerSubscriptions(ctx context.Context, in *QueryConsumerSubscriptionsRequest, opts ...grpc.CallOption) (*QueryConsumerSubscriptionsResponse, error) {
+	out := new(QueryConsumerSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetConsumerSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ReputerAddress) > 0 {
-		i -= len(m.ReputerAddress)
-		copy(dAtA[i:], m.ReputerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetRoleMembers(ctx context.Context, in *QueryRoleMembersRequest, opts ...grpc.CallOption) (*QueryRoleMembersResponse, error) {
+	out := new(QueryRoleMembersResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetRoleMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAddressRoles(ctx context.Context, in *QueryAddressRolesRequest, opts ...grpc.CallOption) (*QueryAddressRolesResponse, error) {
+	out := new(QueryAddressRolesResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetAddressRoles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error) {
+	out := new(QueryTopicLastCommitResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicLastWorkerCommitInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetTopicLastReputerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error) {
+	out := new(QueryTopicLastCommitResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicLastReputerCommitInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRegretHistory(ctx context.Context, in *QueryRegretHistoryRequest, opts ...grpc.CallOption) (*QueryRegretHistoryResponse, error) {
+	out := new(QueryRegretHistoryResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetRegretHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPayoutAddress(ctx context.Context, in *QueryPayoutAddressRequest, opts ...grpc.CallOption) (*QueryPayoutAddressResponse, error) {
+	out := new(QueryPayoutAddressResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetPayoutAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ReputerAddress) > 0 {
-		i -= len(m.ReputerAddress)
-		copy(dAtA[i:], m.ReputerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	GetNextTopicId(context.Context, *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error)
+	GetTopic(context.Context, *QueryTopicRequest) (*QueryTopicResponse, error)
+	GetActiveTopics(context.Context, *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error)
+	GetWorkerLatestInferenceByTopicId(context.Context, *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error)
+	GetInferencesAtBlock(context.Context, *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error)
+	GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error)
+	GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error)
+	GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error)
+	GetTopicRevenueBurned(context.Context, *QueryTopicRevenueBurnedRequest) (*QueryTopicRevenueBurnedResponse, error)
+	GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error)
+	GetMultiReputerStakeInTopic(context.Context, *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error)
+	GetStakeFromReputerInTopicInSelf(context.Context, *QueryStakeFromReputerInTopicInSelfRequest) (*QueryStakeFromReputerInTopicInSelfResponse, error)
+	GetDelegateStakeInTopicInReputer(context.Context, *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopicInReputer(context.Context, *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopic(context.Context, *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error)
+	GetTopicStake(context.Context, *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error)
+	GetStakeRemovalsForBlock(context.Context, *QueryStakeRemovalsForBlockRequest) (*QueryStakeRemovalsForBlockResponse, error)
+	GetDelegateStakeRemovalsForBlock(context.Context, *QueryDelegateStakeRemovalsForBlockRequest) (*QueryDelegateStakeRemovalsForBlockResponse, error)
+	GetStakeRemovalInfo(context.Context, *QueryStakeRemovalInfoRequest) (*QueryStakeRemovalInfoResponse, error)
+	GetDelegateStakeRemovalInfo(context.Context, *QueryDelegateStakeRemovalInfoRequest) (*QueryDelegateStakeRemovalInfoResponse, error)
+	GetWorkerNodeInfo(context.Context, *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error)
+	GetReputerNodeInfo(context.Context, *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error)
+	GetWorkerAddressByP2PKey(context.Context, *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error)
+	GetReputerAddressByP2PKey(context.Context, *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error)
+	IsWorkerRegisteredInTopicId(context.Context, *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
+	IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error)
+	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
+	GetLatestNetworkInference(context.Context, *QueryLatestNetworkInferencesAtBlockRequest) (*QueryLatestNetworkInferencesAtBlockResponse, error)
+	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicParams(context.Context, *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error)
+	GetScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error)
+	GetInferenceRequest(context.Context, *QueryInferenceRequestRequest) (*QueryInferenceRequestResponse, error)
+	GetOpenInferenceRequests(context.Context, *QueryOpenInferenceRequestsRequest) (*QueryOpenInferenceRequestsResponse, error)
+	GetTopicSubscriptions(context.Context, *QueryTopicSubscriptionsRequest) (*QueryTopicSubscriptionsResponse, error)
+	GetConsumerSubscriptions(context.Context, *QueryConsumerSubscriptionsRequest) (*QueryConsumerSubscriptionsResponse, error)
+	GetRoleMembers(context.Context, *QueryRoleMembersRequest) (*QueryRoleMembersResponse, error)
+	GetAddressRoles(context.Context, *QueryAddressRolesRequest) (*QueryAddressRolesResponse, error)
+	GetTopicLastWorkerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
+	GetTopicLastReputerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
+	GetRegretHistory(context.Context, *QueryRegretHistoryRequest) (*QueryRegretHistoryResponse, error)
+	GetPayoutAddress(context.Context, *QueryPayoutAddressRequest) (*QueryPayoutAddressResponse, error)
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetNextTopicId(ctx context.Context, req *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextTopicId not implemented")
+}
+func (*UnimplementedQueryServer) GetTopic(ctx context.Context, req *QueryTopicRequest) (*QueryTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+}
+func (*UnimplementedQueryServer) GetActiveTopics(ctx context.Context, req *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveTopics not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerLatestInferenceByTopicId(ctx context.Context, req *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLatestInferenceByTopicId not implemented")
+}
+func (*UnimplementedQueryServer) GetInferencesAtBlock(ctx context.Context, req *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInferencesAtBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetForecastsAtBlock(ctx context.Context, req *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecastsAtBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworkLossBundleAtBlock(ctx context.Context, req *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkLossBundleAtBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetTotalStake(ctx context.Context, req *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalStake not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicRevenueBurned(ctx context.Context, req *QueryTopicRevenueBurnedRequest) (*QueryTopicRevenueBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRevenueBurned not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerStakeInTopic(ctx context.Context, req *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakeInTopic not implemented")
+}
+func (*UnimplementedQueryServer) GetMultiReputerStakeInTopic(ctx context.Context, req *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMultiReputerStakeInTopic not implemented")
+}
+func (*UnimplementedQueryServer) GetStakeFromReputerInTopicInSelf(ctx context.Context, req *QueryStakeFromReputerInTopicInSelfRequest) (*QueryStakeFromReputerInTopicInSelfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromReputerInTopicInSelf not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegateStakeInTopicInReputer(ctx context.Context, req *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeInTopicInReputer not implemented")
+}
+func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, req *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopicInReputer not implemented")
+}
+func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopic(ctx context.Context, req *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopic not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicStake(ctx context.Context, req *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicStake not implemented")
+}
+func (*UnimplementedQueryServer) GetStakeRemovalsForBlock(ctx context.Context, req *QueryStakeRemovalsForBlockRequest) (*QueryStakeRemovalsForBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeRemovalsForBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegateStakeRemovalsForBlock(ctx context.Context, req *QueryDelegateStakeRemovalsForBlockRequest) (*QueryDelegateStakeRemovalsForBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeRemovalsForBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetStakeRemovalInfo(ctx context.Context, req *QueryStakeRemovalInfoRequest) (*QueryStakeRemovalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeRemovalInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegateStakeRemovalInfo(ctx context.Context, req *QueryDelegateStakeRemovalInfoRequest) (*QueryDelegateStakeRemovalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeRemovalInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerNodeInfo(ctx context.Context, req *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerNodeInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerNodeInfo(ctx context.Context, req *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerNodeInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerAddressByP2PKey(ctx context.Context, req *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerAddressByP2PKey not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerAddressByP2PKey(ctx context.Context, req *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerAddressByP2PKey not implemented")
+}
+func (*UnimplementedQueryServer) IsWorkerRegisteredInTopicId(ctx context.Context, req *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWorkerRegisteredInTopicId not implemented")
+}
+func (*UnimplementedQueryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReputerRegisteredInTopicId not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworkInferencesAtBlock(ctx context.Context, req *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferencesAtBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetLatestNetworkInference(ctx context.Context, req *QueryLatestNetworkInferencesAtBlockRequest) (*QueryLatestNetworkInferencesAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestNetworkInference not implemented")
+}
+func (*UnimplementedQueryServer) IsWhitelistAdmin(ctx context.Context, req *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicParams(ctx context.Context, req *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicParams not implemented")
+}
+func (*UnimplementedQueryServer) GetScheduledParamsChanges(ctx context.Context, req *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledParamsChanges not implemented")
+}
+func (*UnimplementedQueryServer) GetInferenceRequest(ctx context.Context, req *QueryInferenceRequestRequest) (*QueryInferenceRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInferenceRequest not implemented")
+}
+func (*UnimplementedQueryServer) GetOpenInferenceRequests(ctx context.Context, req *QueryOpenInferenceRequestsRequest) (*QueryOpenInferenceRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInferenceRequests not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicSubscriptions(ctx context.Context, req *QueryTopicSubscriptionsRequest) (*QueryTopicSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSubscriptions not implemented")
+}
+func (*UnimplementedQueryServer) GetConsumerSubscriptions(ctx context.Context, req *QueryConsumerSubscriptionsRequest) (*QueryConsumerSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerSubscriptions not implemented")
+}
+func (*UnimplementedQueryServer) GetRoleMembers(ctx context.Context, req *QueryRoleMembersRequest) (*QueryRoleMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleMembers not implemented")
+}
+func (*UnimplementedQueryServer) GetAddressRoles(ctx context.Context, req *QueryAddressRolesRequest) (*QueryAddressRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressRoles not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicLastWorkerCommitInfo(ctx context.Context, req *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastWorkerCommitInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicLastReputerCommitInfo(ctx context.Context, req *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastReputerCommitInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetRegretHistory(ctx context.Context, req *QueryRegretHistoryRequest) (*QueryRegretHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegretHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetPayoutAddress(ctx context.Context, req *QueryPayoutAddressRequest) (*QueryPayoutAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutAddress not implemented")
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
+func _Query_GetNextTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ReputerAddress) > 0 {
-		i -= len(m.ReputerAddress)
-		copy(dAtA[i:], m.ReputerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetNextTopicId(ctx, in)
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetNextTopicId",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNextTopicId(ctx, req.(*QueryNextTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopic(ctx, req.(*QueryTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetActiveTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetActiveTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetActiveTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActiveTopics(ctx, req.(*QueryActiveTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerLatestInferenceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, req.(*QueryWorkerLatestInferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferencesAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetInferencesAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetInferencesAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetInferencesAtBlock(ctx, req.(*QueryInferencesAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+func _Query_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForecastsAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetForecastsAtBlock(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetForecastsAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetForecastsAtBlock(ctx, req.(*QueryForecastsAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkLossBundleAtBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetNetworkLossBundleAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, req.(*QueryNetworkLossBundleAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTotalStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalStake(ctx, req.(*QueryTotalStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetTopicRevenueBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicRevenueBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicRevenueBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicRevenueBurned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicRevenueBurned(ctx, req.(*QueryTopicRevenueBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerStakeInTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerStakeInTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerStakeInTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerStakeInTopic(ctx, req.(*QueryReputerStakeInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetMultiReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiReputerStakeInTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetMultiReputerStakeInTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, req.(*QueryMultiReputerStakeInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetStakeFromReputerInTopicInSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeFromReputerInTopicInSelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeFromReputerInTopicInSelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeFromReputerInTopicInSelf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeFromReputerInTopicInSelf(ctx, req.(*QueryStakeFromReputerInTopicInSelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetDelegateStakeInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateStakeInTopicInReputerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetDelegateStakeInTopicInReputer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, req.(*QueryDelegateStakeInTopicInReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTopicStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetStakeFromDelegatorInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeFromDelegatorInTopicInReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, req.(*QueryStakeFromDelegatorInTopicInReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeFromDelegatorInTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkLossBundleAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNetworkLossBundleAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, in)
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopic",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, req.(*QueryStakeFromDelegatorInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicStakeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkLossBundleAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNetworkLossBundleAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LossBundle != nil {
-		{
-			size, err := m.LossBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicStake(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicStake(ctx, req.(*QueryTopicStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNextTopicIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetStakeRemovalsForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeRemovalsForBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNextTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNextTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeRemovalsForBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeRemovalsForBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeRemovalsForBlock(ctx, req.(*QueryStakeRemovalsForBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNextTopicIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetDelegateStakeRemovalsForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateStakeRemovalsForBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNextTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNextTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextTopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextTopicId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateStakeRemovalsForBlock(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetDelegateStakeRemovalsForBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateStakeRemovalsForBlock(ctx, req.(*QueryDelegateStakeRemovalsForBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeRemovalInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeRemovalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeRemovalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeRemovalInfo(ctx, req.(*QueryStakeRemovalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetDelegateStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateStakeRemovalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateStakeRemovalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetDelegateStakeRemovalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateStakeRemovalInfo(ctx, req.(*QueryDelegateStakeRemovalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetWorkerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerNodeInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerNodeInfo(ctx, req.(*QueryWorkerNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EffectiveRevenue) > 0 {
-		i -= len(m.EffectiveRevenue)
-		copy(dAtA[i:], m.EffectiveRevenue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EffectiveRevenue)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_GetReputerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerNodeInfo(ctx, in)
 	}
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerNodeInfo",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerNodeInfo(ctx, req.(*QueryReputerNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveTopicsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetWorkerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerAddressByP2PKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryActiveTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerAddressByP2PKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, req.(*QueryWorkerAddressByP2PKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveTopicsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetReputerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerAddressByP2PKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerAddressByP2PKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, req.(*QueryReputerAddressByP2PKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_IsWorkerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsWorkerRegisteredInTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/IsWorkerRegisteredInTopicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, req.(*QueryIsWorkerRegisteredInTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IsReputerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsReputerRegisteredInTopicIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/IsReputerRegisteredInTopicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, req.(*QueryIsReputerRegisteredInTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetNetworkInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkInferencesAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetNetworkInferencesAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, req.(*QueryNetworkInferencesAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+func _Query_GetLatestNetworkInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestNetworkInferencesAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetLatestNetworkInference(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetLatestNetworkInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLatestNetworkInference(ctx, req.(*QueryLatestNetworkInferencesAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsWhitelistAdminRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IsWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/IsWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsWhitelistAdmin(ctx, req.(*QueryIsWhitelistAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetTopicParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicParams(ctx, req.(*QueryTopicParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Inferences != nil {
-		{
-			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_GetScheduledParamsChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetScheduledParamsChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetScheduledParamsChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetScheduledParamsChanges(ctx, req.(*QueryScheduledParamsChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryForecastsAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetInferenceRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferenceRequestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryForecastsAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryForecastsAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).GetInferenceRequest(ctx, in)
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetInferenceRequest",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetInferenceRequest(ctx, req.(*QueryInferenceRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryForecastsAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetOpenInferenceRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenInferenceRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryForecastsAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryForecastsAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Forecasts != nil {
-		{
-			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetOpenInferenceRequests(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetOpenInferenceRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOpenInferenceRequests(ctx, req.(*QueryOpenInferenceRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTopicSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicSubscriptionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicSubscriptions(ctx, req.(*QueryTopicSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetConsumerSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetConsumerSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetConsumerSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetConsumerSubscriptions(ctx, req.(*QueryConsumerSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerAddress) > 0 {
-		i -= len(m.WorkerAddress)
-		copy(dAtA[i:], m.WorkerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkerAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_GetRoleMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetRoleMembers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetRoleMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRoleMembers(ctx, req.(*QueryRoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAddressRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressRolesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAddressRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetAddressRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAddressRoles(ctx, req.(*QueryAddressRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetTopicLastWorkerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicLastCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicLastWorkerCommitInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicLastWorkerCommitInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicLastWorkerCommitInfo(ctx, req.(*QueryTopicLastCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LatestInference != nil {
-		{
-			size, err := m.LatestInference.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_GetTopicLastReputerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicLastCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicLastReputerCommitInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicLastReputerCommitInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicLastReputerCommitInfo(ctx, req.(*QueryTopicLastCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetRegretHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegretHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRegretHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetRegretHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRegretHistory(ctx, req.(*QueryRegretHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetPayoutAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPayoutAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetPayoutAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPayoutAddress(ctx, req.(*QueryPayoutAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "emissions.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetNextTopicId",
+			Handler:    _Query_GetNextTopicId_Handler,
+		},
+		{
+			MethodName: "GetTopic",
+			Handler:    _Query_GetTopic_Handler,
+		},
+		{
+			MethodName: "GetActiveTopics",
+			Handler:    _Query_GetActiveTopics_Handler,
+		},
+		{
+			MethodName: "GetWorkerLatestInferenceByTopicId",
+			Handler:    _Query_GetWorkerLatestInferenceByTopicId_Handler,
+		},
+		{
+			MethodName: "GetInferencesAtBlock",
+			Handler:    _Query_GetInferencesAtBlock_Handler,
+		},
+		{
+			MethodName: "GetForecastsAtBlock",
+			Handler:    _Query_GetForecastsAtBlock_Handler,
+		},
+		{
+			MethodName: "GetNetworkLossBundleAtBlock",
+			Handler:    _Query_GetNetworkLossBundleAtBlock_Handler,
+		},
+		{
+			MethodName: "GetTotalStake",
+			Handler:    _Query_GetTotalStake_Handler,
+		},
+		{
+			MethodName: "GetTopicRevenueBurned",
+			Handler:    _Query_GetTopicRevenueBurned_Handler,
+		},
+		{
+			MethodName: "GetReputerStakeInTopic",
+			Handler:    _Query_GetReputerStakeInTopic_Handler,
+		},
+		{
+			MethodName: "GetMultiReputerStakeInTopic",
+			Handler:    _Query_GetMultiReputerStakeInTopic_Handler,
+		},
+		{
+			MethodName: "GetStakeFromReputerInTopicInSelf",
+			Handler:    _Query_GetStakeFromReputerInTopicInSelf_Handler,
+		},
+		{
+			MethodName: "GetDelegateStakeInTopicInReputer",
+			Handler:    _Query_GetDelegateStakeInTopicInReputer_Handler,
+		},
+		{
+			MethodName: "GetStakeFromDelegatorInTopicInReputer",
+			Handler:    _Query_GetStakeFromDelegatorInTopicInReputer_Handler,
+		},
+		{
+			MethodName: "GetStakeFromDelegatorInTopic",
+			Handler:    _Query_GetStakeFromDelegatorInTopic_Handler,
+		},
+		{
+			MethodName: "GetTopicStake",
+			Handler:    _Query_GetTopicStake_Handler,
+		},
+		{
+			MethodName: "GetStakeRemovalsForBlock",
+			Handler:    _Query_GetStakeRemovalsForBlock_Handler,
+		},
+		{
+			MethodName: "GetDelegateStakeRemovalsForBlock",
+			Handler:    _Query_GetDelegateStakeRemovalsForBlock_Handler,
+		},
+		{
+			MethodName: "GetStakeRemovalInfo",
+			Handler:    _Query_GetStakeRemovalInfo_Handler,
+		},
+		{
+			MethodName: "GetDelegateStakeRemovalInfo",
+			Handler:    _Query_GetDelegateStakeRemovalInfo_Handler,
+		},
+		{
+			MethodName: "GetWorkerNodeInfo",
+			Handler:    _Query_GetWorkerNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetReputerNodeInfo",
+			Handler:    _Query_GetReputerNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetWorkerAddressByP2PKey",
+			Handler:    _Query_GetWorkerAddressByP2PKey_Handler,
+		},
+		{
+			MethodName: "GetReputerAddressByP2PKey",
+			Handler:    _Query_GetReputerAddressByP2PKey_Handler,
+		},
+		{
+			MethodName: "IsWorkerRegisteredInTopicId",
+			Handler:    _Query_IsWorkerRegisteredInTopicId_Handler,
+		},
+		{
+			MethodName: "IsReputerRegisteredInTopicId",
+			Handler:    _Query_IsReputerRegisteredInTopicId_Handler,
+		},
+		{
+			MethodName: "GetNetworkInferencesAtBlock",
+			Handler:    _Query_GetNetworkInferencesAtBlock_Handler,
+		},
+		{
+			MethodName: "GetLatestNetworkInference",
+			Handler:    _Query_GetLatestNetworkInference_Handler,
+		},
+		{
+			MethodName: "IsWhitelistAdmin",
+			Handler:    _Query_IsWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "GetTopicParams",
+			Handler:    _Query_GetTopicParams_Handler,
+		},
+		{
+			MethodName: "GetScheduledParamsChanges",
+			Handler:    _Query_GetScheduledParamsChanges_Handler,
+		},
+		{
+			MethodName: "GetInferenceRequest",
+			Handler:    _Query_GetInferenceRequest_Handler,
+		},
+		{
+			MethodName: "GetOpenInferenceRequests",
+			Handler:    _Query_GetOpenInferenceRequests_Handler,
+		},
+		{
+			MethodName: "GetTopicSubscriptions",
+			Handler:    _Query_GetTopicSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetConsumerSubscriptions",
+			Handler:    _Query_GetConsumerSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetRoleMembers",
+			Handler:    _Query_GetRoleMembers_Handler,
+		},
+		{
+			MethodName: "GetAddressRoles",
+			Handler:    _Query_GetAddressRoles_Handler,
+		},
+		{
+			MethodName: "GetTopicLastWorkerCommitInfo",
+			Handler:    _Query_GetTopicLastWorkerCommitInfo_Handler,
+		},
+		{
+			MethodName: "GetTopicLastReputerCommitInfo",
+			Handler:    _Query_GetTopicLastReputerCommitInfo_Handler,
+		},
+		{
+			MethodName: "GetRegretHistory",
+			Handler:    _Query_GetRegretHistory_Handler,
+		},
+		{
+			MethodName: "GetPayoutAddress",
+			Handler:    _Query_GetPayoutAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emissions/v1/query.proto",
 }
 
-func (m *QueryWorkerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5552,32 +5938,20 @@ func (m *QueryWorkerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeInfo != nil {
-		{
-			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReputerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5587,27 +5961,30 @@ func (m *QueryReputerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReputerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReputerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReputerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalStakeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5617,32 +5994,20 @@ func (m *QueryReputerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReputerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalStakeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReputerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeInfo != nil {
-		{
-			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalStakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5652,27 +6017,30 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalStakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTopicRevenueBurnedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5682,27 +6050,20 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTopicRevenueBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTopicRevenueBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTopicRevenueBurnedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5712,27 +6073,30 @@ func (m *QueryReputerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTopicRevenueBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTopicRevenueBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
-	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReputerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5742,16 +6106,21 @@ func (m *QueryReputerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryReputerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReputerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -5762,7 +6131,7 @@ func (m *QueryReputerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5772,35 +6141,30 @@ func (m *QueryNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeightLastReward != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastReward))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BlockHeightLastInference != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastInference))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMultiReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5810,12 +6174,12 @@ func (m *QueryLatestNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMultiReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMultiReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5823,47 +6187,21 @@ func (m *QueryLatestNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA [
 	if m.TopicId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x10
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NetworkInferences != nil {
-		{
-			size, err := m.NetworkInferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMultiReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5873,48 +6211,20 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMultiReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMultiReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ForecastImpliedInferences) > 0 {
-		for iNdEx := len(m.ForecastImpliedInferences) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForecastImpliedInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ForecasterWeights) > 0 {
-		for iNdEx := len(m.ForecasterWeights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForecasterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.InfererWeights) > 0 {
-		for iNdEx := len(m.InfererWeights) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5922,25 +6232,13 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.NetworkInferences != nil {
-		{
-			size, err := m.NetworkInferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakeFromReputerInTopicInSelfRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5950,32 +6248,32 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakeFromReputerInTopicInSelfRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakeFromReputerInTopicInSelfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.TopicId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakeFromReputerInTopicInSelfResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5985,30 +6283,30 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakeFromReputerInTopicInSelfResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakeFromReputerInTopicInSelfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsRegistered {
-		i--
-		if m.IsRegistered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegateStakeInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6018,32 +6316,32 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.TopicId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegateStakeInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6053,30 +6351,30 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsRegistered {
-		i--
-		if m.IsRegistered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6086,27 +6384,39 @@ func (m *QueryIsWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *QueryIsWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6116,30 +6426,30 @@ func (m *QueryIsWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsAdmin {
-		i--
-		if m.IsAdmin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalsForBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakeFromDelegatorInTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6149,25 +6459,32 @@ func (m *QueryStakeRemovalsForBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStakeRemovalsForBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakeRemovalsForBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalsForBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakeFromDelegatorInTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6177,34 +6494,30 @@ func (m *QueryStakeRemovalsForBlockResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryStakeRemovalsForBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakeRemovalsForBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakeFromDelegatorInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Removals) > 0 {
-		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTopicStakeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6214,25 +6527,25 @@ func (m *QueryDelegateStakeRemovalsForBlockRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTopicStakeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTopicStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTopicStakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6242,34 +6555,30 @@ func (m *QueryDelegateStakeRemovalsForBlockResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTopicStakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTopicStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Removals) > 0 {
-		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNetworkLossBundleAtBlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6279,22 +6588,20 @@ func (m *QueryStakeRemovalInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStakeRemovalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNetworkLossBundleAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakeRemovalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNetworkLossBundleAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reputer)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
@@ -6304,7 +6611,7 @@ func (m *QueryStakeRemovalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNetworkLossBundleAtBlockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6314,19 +6621,19 @@ func (m *QueryStakeRemovalInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStakeRemovalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNetworkLossBundleAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakeRemovalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNetworkLossBundleAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Removal != nil {
+	if m.LossBundle != nil {
 		{
-			size, err := m.Removal.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LossBundle.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6339,7 +6646,7 @@ func (m *QueryStakeRemovalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNextTopicIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6349,39 +6656,20 @@ func (m *QueryDelegateStakeRemovalInfoRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateStakeRemovalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNextTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateStakeRemovalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNextTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNextTopicIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6391,32 +6679,25 @@ func (m *QueryDelegateStakeRemovalInfoResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateStakeRemovalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNextTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateStakeRemovalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNextTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Removal != nil {
-		{
-			size, err := m.Removal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.NextTopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextTopicId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTopicLastCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6426,12 +6707,12 @@ func (m *QueryTopicLastCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTopicLastCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTopicLastCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6444,7 +6725,7 @@ func (m *QueryTopicLastCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTopicLastCommitResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6454,19 +6735,33 @@ func (m *QueryTopicLastCommitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTopicLastCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTopicLastCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastCommit != nil {
+	if len(m.EffectiveRevenue) > 0 {
+		i -= len(m.EffectiveRevenue)
+		copy(dAtA[i:], m.EffectiveRevenue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EffectiveRevenue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Topic != nil {
 		{
-			size, err := m.LastCommit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6479,858 +6774,4828 @@ func (m *QueryTopicLastCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryActiveTopicsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryActiveTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryTotalStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActiveTopicsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReputerStakeInTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReputerStakeInTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Inferences != nil {
+		{
+			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ReputerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+func (m *QueryForecastsAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStakeFromReputerInTopicInSelfResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryForecastsAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryForecastsAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ReputerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryForecastsAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryForecastsAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForecastsAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ReputerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if m.Forecasts != nil {
+		{
+			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWorkerLatestInferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStakeFromDelegatorInTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWorkerLatestInferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerLatestInferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.WorkerAddress) > 0 {
+		i -= len(m.WorkerAddress)
+		copy(dAtA[i:], m.WorkerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWorkerLatestInferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTopicStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWorkerLatestInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerLatestInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if m.LatestInference != nil {
+		{
+			size, err := m.LatestInference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTopicStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWorkerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	return n
+func (m *QueryWorkerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWorkerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LossBundle != nil {
-		l = m.LossBundle.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextTopicIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWorkerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNextTopicIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NextTopicId != 0 {
-		n += 1 + sovQuery(uint64(m.NextTopicId))
-	}
-	return n
+func (m *QueryWorkerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWorkerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if m.NodeInfo != nil {
+		{
+			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReputerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Topic != nil {
-		l = m.Topic.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EffectiveRevenue)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveTopicsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReputerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NodeInfo != nil {
+		{
+			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveTopicsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWorkerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInferencesAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	return n
+func (m *QueryWorkerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInferencesAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWorkerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Inferences != nil {
-		l = m.Inferences.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryForecastsAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+func (m *QueryWorkerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryForecastsAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Forecasts != nil {
-		l = m.Forecasts.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryWorkerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkerLatestInferenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWorkerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	l = len(m.WorkerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkerLatestInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LatestInference != nil {
-		l = m.LatestInference.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReputerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWorkerNodeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryReputerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkerNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReputerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeInfo != nil {
-		l = m.NodeInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReputerNodeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReputerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReputerNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NodeInfo != nil {
-		l = m.NodeInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryReputerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReputerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryNetworkInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReputerAddressByP2PKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryNetworkInferencesAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if m.BlockHeightLastReward != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastReward))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.BlockHeightLastInference != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeightLastInference))
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastInference))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.BlockHeightLastReward != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeightLastReward))
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestNetworkInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNetworkInferencesAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.NetworkInferences != nil {
-		l = m.NetworkInferences.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.NetworkInferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestNetworkInferencesAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestNetworkInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NetworkInferences != nil {
-		l = m.NetworkInferences.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.InfererWeights) > 0 {
-		for _, e := range m.InfererWeights {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ForecastImpliedInferences) > 0 {
+		for iNdEx := len(m.ForecastImpliedInferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecastImpliedInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
 	if len(m.ForecasterWeights) > 0 {
-		for _, e := range m.ForecasterWeights {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.ForecasterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecasterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.ForecastImpliedInferences) > 0 {
-		for _, e := range m.ForecastImpliedInferences {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.InfererWeights) > 0 {
+		for iNdEx := len(m.InfererWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfererWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.NetworkInferences != nil {
+		{
+			size, err := m.NetworkInferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsRegistered {
-		n += 2
-	}
-	return n
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.IsRegistered {
-		n += 2
+		i--
+		if m.IsRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsWhitelistAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsAdmin {
-		n += 2
-	}
-	return n
+func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakeRemovalsForBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalsForBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Removals) > 0 {
-		for _, e := range m.Removals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IsRegistered {
+		i--
+		if m.IsRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalsForBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Removals) > 0 {
-		for _, e := range m.Removals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IsAdmin {
+		i--
+		if m.IsAdmin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTopicParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeRemovalInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Removal != nil {
-		l = m.Removal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopicParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateStakeRemovalInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTopicParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EffectiveParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateStakeRemovalInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Removal != nil {
-		l = m.Removal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryScheduledParamsChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTopicLastCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScheduledParamsChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInferenceRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInferenceRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInferenceRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInferenceRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInferenceRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInferenceRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInferenceRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInferenceRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInferenceRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInferenceRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInferenceRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInferenceRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeRemovalsForBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeRemovalsForBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeRemovalsForBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeRemovalsForBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeRemovalsForBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeRemovalsForBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removals) > 0 {
+		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removals) > 0 {
+		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeRemovalInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeRemovalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeRemovalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeRemovalInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeRemovalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeRemovalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removal != nil {
+		{
+			size, err := m.Removal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakeRemovalInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakeRemovalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakeRemovalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakeRemovalInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakeRemovalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakeRemovalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removal != nil {
+		{
+			size, err := m.Removal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicLastCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicLastCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicLastCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicLastCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicLastCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicLastCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommit != nil {
+		{
+			size, err := m.LastCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegretHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegretHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegretHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Inferer) > 0 {
+		i -= len(m.Inferer)
+		copy(dAtA[i:], m.Inferer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Inferer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegretHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegretHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegretHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Regrets) > 0 {
+		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Regrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalPayoutAddress) > 0 {
+		i -= len(m.GlobalPayoutAddress)
+		copy(dAtA[i:], m.GlobalPayoutAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GlobalPayoutAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TopicPayoutAddress) > 0 {
+		i -= len(m.TopicPayoutAddress)
+		copy(dAtA[i:], m.TopicPayoutAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TopicPayoutAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTopicRevenueBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTopicRevenueBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReputerStakeInTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryReputerStakeInTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiReputerStakeInTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryMultiReputerStakeInTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakeFromReputerInTopicInSelfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryStakeFromReputerInTopicInSelfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegateStakeInTopicInReputerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeInTopicInReputerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTopicStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNetworkLossBundleAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryNetworkLossBundleAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LossBundle != nil {
+		l = m.LossBundle.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextTopicIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextTopicIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextTopicId != 0 {
+		n += 1 + sovQuery(uint64(m.NextTopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EffectiveRevenue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveTopicsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveTopicsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInferencesAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryInferencesAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inferences != nil {
+		l = m.Inferences.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForecastsAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryForecastsAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Forecasts != nil {
+		l = m.Forecasts.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerLatestInferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.WorkerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerLatestInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestInference != nil {
+		l = m.LatestInference.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeInfo != nil {
+		l = m.NodeInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeInfo != nil {
+		l = m.NodeInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerAddressByP2PKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerAddressByP2PKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerAddressByP2PKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerAddressByP2PKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetworkInferencesAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeightLastInference != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeightLastInference))
+	}
+	if m.BlockHeightLastReward != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeightLastReward))
+	}
+	return n
+}
+
+func (m *QueryLatestNetworkInferencesAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NetworkInferences != nil {
+		l = m.NetworkInferences.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestNetworkInferencesAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NetworkInferences != nil {
+		l = m.NetworkInferences.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.InfererWeights) > 0 {
+		for _, e := range m.InfererWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForecasterWeights) > 0 {
+		for _, e := range m.ForecasterWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForecastImpliedInferences) > 0 {
+		for _, e := range m.ForecastImpliedInferences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRegistered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRegistered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsWhitelistAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsAdmin {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopicParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EffectiveParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Overrides.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledParamsChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledParamsChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInferenceRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *QueryInferenceRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenInferenceRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryOpenInferenceRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopicSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRoleMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoleMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakeRemovalsForBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryStakeRemovalsForBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Removals) > 0 {
+		for _, e := range m.Removals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeRemovalsForBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Removals) > 0 {
+		for _, e := range m.Removals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakeRemovalInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakeRemovalInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removal != nil {
+		l = m.Removal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeRemovalInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeRemovalInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removal != nil {
+		l = m.Removal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopicLastCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicLastCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastCommit != nil {
+		l = m.LastCommit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegretHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Inferer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegretHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Regrets) > 0 {
+		for _, e := range m.Regrets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TopicPayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GlobalPayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicRevenueBurnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicRevenueBurnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicRevenueBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicRevenueBurnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicRevenueBurnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicRevenueBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, &StakeInfo{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromReputerInTopicInSelfRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromReputerInTopicInSelfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryTopicLastCommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastCommit != nil {
-		l = m.LastCommit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7353,12 +11618,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7380,7 +11683,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7403,15 +11706,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7438,7 +11741,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LossBundle == nil {
+				m.LossBundle = &ValueBundle{}
+			}
+			if err := m.LossBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7463,7 +11769,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7486,10 +11792,10 @@ func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextTopicIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7513,7 +11819,7 @@ func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7536,17 +11842,17 @@ func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextTopicIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
 			}
-			var stringLen uint64
+			m.NextTopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7556,26 +11862,80 @@ func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextTopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7597,7 +11957,7 @@ func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7620,15 +11980,51 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7656,13 +12052,13 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveRevenue", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7672,11 +12068,24 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveRevenue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7698,7 +12107,7 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7721,17 +12130,17 @@ func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveTopicsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7741,23 +12150,25 @@ func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &SimpleCursorPaginationRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7782,7 +12193,7 @@ func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7805,17 +12216,17 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveTopicsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7825,29 +12236,31 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Topics = append(m.Topics, &Topic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.TopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7857,11 +12270,28 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &SimpleCursorPaginationResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7883,7 +12313,7 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7906,17 +12336,17 @@ func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7926,26 +12356,30 @@ func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Amounts = append(m.Amounts, &StakeInfo{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7967,7 +12401,7 @@ func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeFromReputerInTopicInSelfRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7990,17 +12424,17 @@ func (m *QueryStakeFromReputerInTopicInSelfRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8010,43 +12444,28 @@ func (m *QueryStakeFromReputerInTopicInSelfRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if m.Inferences == nil {
+				m.Inferences = &Inferences{}
 			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8068,7 +12487,7 @@ func (m *QueryStakeFromReputerInTopicInSelfRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryStakeFromReputerInTopicInSelfResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8091,17 +12510,17 @@ func (m *QueryStakeFromReputerInTopicInSelfResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromReputerInTopicInSelfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8111,26 +12530,30 @@ func (m *QueryStakeFromReputerInTopicInSelfResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8152,7 +12575,7 @@ func (m *QueryStakeFromReputerInTopicInSelfResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8175,17 +12598,17 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8195,43 +12618,28 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forecasts == nil {
+				m.Forecasts = &Forecasts{}
+			}
+			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8253,7 +12661,7 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8276,15 +12684,34 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8312,9 +12739,7 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8337,7 +12762,7 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8360,17 +12785,17 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInference", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8380,75 +12805,28 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.LatestInference == nil {
+				m.LatestInference = &Inference{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.LatestInference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8470,7 +12848,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8493,15 +12871,15 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8529,9 +12907,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8554,7 +12930,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8577,17 +12953,17 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8597,43 +12973,28 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if m.NodeInfo == nil {
+				m.NodeInfo = &OffchainNode{}
 			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8655,7 +13016,7 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8678,15 +13039,15 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8714,9 +13075,7 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8739,7 +13098,7 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8762,17 +13121,17 @@ func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
 			}
-			m.TopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8782,11 +13141,28 @@ func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeInfo == nil {
+				m.NodeInfo = &OffchainNode{}
+			}
+			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8808,7 +13184,7 @@ func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8831,15 +13207,15 @@ func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8867,9 +13243,7 @@ func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8892,7 +13266,7 @@ func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8915,17 +13289,17 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8935,30 +13309,24 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8980,7 +13348,7 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9003,17 +13371,17 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9023,27 +13391,23 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LossBundle == nil {
-				m.LossBundle = &ValueBundle{}
-			}
-			if err := m.LossBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9066,7 +13430,7 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9089,12 +13453,44 @@ func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextTopicIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9116,7 +13512,7 @@ func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9139,17 +13535,17 @@ func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextTopicIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.NextTopicId = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9159,7 +13555,45 @@ func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTopicId |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastInference", wireType)
+			}
+			m.BlockHeightLastInference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightLastInference |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastReward", wireType)
+			}
+			m.BlockHeightLastReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightLastReward |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9185,7 +13619,7 @@ func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9208,10 +13642,10 @@ func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9254,7 +13688,7 @@ func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9277,15 +13711,15 @@ func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9312,77 +13746,13 @@ func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
+			if m.NetworkInferences == nil {
+				m.NetworkInferences = &ValueBundle{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveRevenue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EffectiveRevenue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9404,7 +13774,7 @@ func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9427,15 +13797,15 @@ func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveTopicsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9462,66 +13832,50 @@ func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &SimpleCursorPaginationRequest{}
+			if m.NetworkInferences == nil {
+				m.NetworkInferences = &ValueBundle{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererWeights", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InfererWeights = append(m.InfererWeights, &RegretInformedWeight{})
+			if err := m.InfererWeights[len(m.InfererWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveTopicsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9548,14 +13902,14 @@ func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &Topic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForecasterWeights = append(m.ForecasterWeights, &RegretInformedWeight{})
+			if err := m.ForecasterWeights[len(m.ForecasterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastImpliedInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9582,10 +13936,8 @@ func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &SimpleCursorPaginationResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForecastImpliedInferences = append(m.ForecastImpliedInferences, &WorkerAttributedValue{})
+			if err := m.ForecastImpliedInferences[len(m.ForecastImpliedInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9610,7 +13962,7 @@ func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9633,10 +13985,10 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9659,10 +14011,10 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9672,11 +14024,24 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9698,7 +14063,7 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9721,17 +14086,17 @@ func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9741,28 +14106,12 @@ func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Inferences == nil {
-				m.Inferences = &Inferences{}
-			}
-			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsRegistered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9784,7 +14133,7 @@ func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9807,10 +14156,10 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9833,10 +14182,10 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9846,11 +14195,24 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9872,7 +14234,7 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9895,17 +14257,17 @@ func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9915,28 +14277,12 @@ func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Forecasts == nil {
-				m.Forecasts = &Forecasts{}
-			}
-			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsRegistered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9958,7 +14304,7 @@ func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9981,34 +14327,15 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10036,7 +14363,7 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10059,7 +14386,7 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10082,17 +14409,17 @@ func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInference", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAdmin", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10102,28 +14429,12 @@ func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LatestInference == nil {
-				m.LatestInference = &Inference{}
-			}
-			if err := m.LatestInference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsAdmin = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10145,7 +14456,7 @@ func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10168,17 +14479,17 @@ func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10188,24 +14499,11 @@ func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+					break
+				}
 			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10227,7 +14525,7 @@ func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10250,15 +14548,15 @@ func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10285,10 +14583,40 @@ func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeInfo == nil {
-				m.NodeInfo = &OffchainNode{}
+			if err := m.EffectiveParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10313,7 +14641,7 @@ func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledParamsChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10336,44 +14664,12 @@ func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledParamsChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledParamsChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10395,7 +14691,7 @@ func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledParamsChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10418,15 +14714,15 @@ func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledParamsChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledParamsChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10453,10 +14749,8 @@ func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeInfo == nil {
-				m.NodeInfo = &OffchainNode{}
-			}
-			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, &ScheduledParamsChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10481,7 +14775,7 @@ func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInferenceRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10504,17 +14798,17 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInferenceRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInferenceRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var stringLen uint64
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10524,24 +14818,11 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10563,7 +14844,7 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInferenceRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10586,17 +14867,17 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInferenceRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInferenceRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10606,23 +14887,27 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &InferenceRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10645,7 +14930,7 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOpenInferenceRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10668,17 +14953,17 @@ func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOpenInferenceRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOpenInferenceRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10688,24 +14973,11 @@ func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10727,7 +14999,7 @@ func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOpenInferenceRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10750,17 +15022,17 @@ func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOpenInferenceRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOpenInferenceRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10770,23 +15042,25 @@ func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Requests = append(m.Requests, &InferenceRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10809,7 +15083,7 @@ func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicSubscriptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10832,10 +15106,10 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicSubscriptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10857,30 +15131,61 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastInference", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlockHeightLastInference = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeightLastInference |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastReward", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockHeightLastReward = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicSubscriptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicSubscriptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10890,11 +15195,26 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeightLastReward |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, &Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10916,7 +15236,7 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerSubscriptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10939,17 +15259,17 @@ func (m *QueryLatestNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerSubscriptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10959,11 +15279,24 @@ func (m *QueryLatestNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10985,7 +15318,7 @@ func (m *QueryLatestNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerSubscriptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11008,15 +15341,15 @@ func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerSubscriptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11043,10 +15376,8 @@ func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkInferences == nil {
-				m.NetworkInferences = &ValueBundle{}
-			}
-			if err := m.NetworkInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subscriptions = append(m.Subscriptions, &Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11071,7 +15402,7 @@ func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoleMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11094,17 +15425,17 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoleMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestNetworkInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoleMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11114,101 +15445,79 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkInferences == nil {
-				m.NetworkInferences = &ValueBundle{}
-			}
-			if err := m.NetworkInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererWeights", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererWeights = append(m.InfererWeights, &RegretInformedWeight{})
-			if err := m.InfererWeights[len(m.InfererWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterWeights", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterWeights = append(m.ForecasterWeights, &RegretInformedWeight{})
-			if err := m.ForecasterWeights[len(m.ForecasterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecastImpliedInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11218,25 +15527,23 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecastImpliedInferences = append(m.ForecastImpliedInferences, &WorkerAttributedValue{})
-			if err := m.ForecastImpliedInferences[len(m.ForecastImpliedInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11259,7 +15566,7 @@ func (m *QueryLatestNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11282,32 +15589,13 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -11360,7 +15648,7 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11383,17 +15671,17 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11403,12 +15691,24 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRegistered = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11430,7 +15730,7 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11453,36 +15753,17 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeRemovalsForBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeRemovalsForBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11492,24 +15773,11 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11531,7 +15799,7 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11554,17 +15822,17 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeRemovalsForBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeRemovalsForBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11574,12 +15842,26 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRegistered = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removals = append(m.Removals, &StakeRemovalInfo{})
+			if err := m.Removals[len(m.Removals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11601,7 +15883,7 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11624,17 +15906,17 @@ func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11644,24 +15926,11 @@ func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11683,7 +15952,7 @@ func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11706,17 +15975,17 @@ func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAdmin", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11726,12 +15995,26 @@ func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsAdmin = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removals = append(m.Removals, &DelegateStakeRemovalInfo{})
+			if err := m.Removals[len(m.Removals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11753,7 +16036,7 @@ func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11776,17 +16059,17 @@ func (m *QueryStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeRemovalsForBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeRemovalInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeRemovalsForBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeRemovalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockHeight = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11796,11 +16079,43 @@ func (m *QueryStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11822,7 +16137,7 @@ func (m *QueryStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11845,15 +16160,15 @@ func (m *QueryStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeRemovalsForBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeRemovalInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeRemovalsForBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeRemovalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11880,8 +16195,10 @@ func (m *QueryStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removals = append(m.Removals, &StakeRemovalInfo{})
-			if err := m.Removals[len(m.Removals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Removal == nil {
+				m.Removal = &StakeRemovalInfo{}
+			}
+			if err := m.Removal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11906,7 +16223,7 @@ func (m *QueryStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11929,17 +16246,17 @@ func (m *QueryDelegateStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockHeight = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11949,11 +16266,75 @@ func (m *QueryDelegateStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11975,7 +16356,7 @@ func (m *QueryDelegateStakeRemovalsForBlockRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegateStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11998,15 +16379,15 @@ func (m *QueryDelegateStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalsForBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12033,8 +16414,10 @@ func (m *QueryDelegateStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removals = append(m.Removals, &DelegateStakeRemovalInfo{})
-			if err := m.Removals[len(m.Removals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Removal == nil {
+				m.Removal = &DelegateStakeRemovalInfo{}
+			}
+			if err := m.Removal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12059,7 +16442,7 @@ func (m *QueryDelegateStakeRemovalsForBlockResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicLastCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12079,39 +16462,20 @@ func (m *QueryStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeRemovalInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeRemovalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicLastCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicLastCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12121,24 +16485,11 @@ func (m *QueryStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12160,7 +16511,7 @@ func (m *QueryStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicLastCommitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12183,15 +16534,15 @@ func (m *QueryStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeRemovalInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicLastCommitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeRemovalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicLastCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12218,10 +16569,10 @@ func (m *QueryStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Removal == nil {
-				m.Removal = &StakeRemovalInfo{}
+			if m.LastCommit == nil {
+				m.LastCommit = &TimestampedActorNonce{}
 			}
-			if err := m.Removal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12246,7 +16597,7 @@ func (m *QueryStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRegretHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12269,10 +16620,10 @@ func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegretHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegretHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12295,8 +16646,27 @@ func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= RegretRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12324,11 +16694,11 @@ func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12356,7 +16726,43 @@ func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Inferer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &SimpleCursorPaginationRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12379,7 +16785,7 @@ func (m *QueryDelegateStakeRemovalInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRegretHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12402,15 +16808,15 @@ func (m *QueryDelegateStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegretHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeRemovalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegretHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12437,10 +16843,44 @@ func (m *QueryDelegateStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Removal == nil {
-				m.Removal = &DelegateStakeRemovalInfo{}
+			m.Regrets = append(m.Regrets, &TimestampedValue{})
+			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Removal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &SimpleCursorPaginationResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12465,7 +16905,7 @@ func (m *QueryDelegateStakeRemovalInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicLastCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12488,10 +16928,10 @@ func (m *QueryTopicLastCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicLastCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicLastCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12513,6 +16953,38 @@ func (m *QueryTopicLastCommitRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12534,7 +17006,7 @@ func (m *QueryTopicLastCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicLastCommitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12557,17 +17029,17 @@ func (m *QueryTopicLastCommitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicLastCommitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicLastCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12577,27 +17049,87 @@ func (m *QueryTopicLastCommitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCommit == nil {
-				m.LastCommit = &TimestampedActorNonce{}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicPayoutAddress", wireType)
 			}
-			if err := m.LastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicPayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalPayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.GlobalPayoutAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/queues.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The reward of a topic waiting to be paid out to its participants, in the order it was queued
+type PendingTopicReward struct {
+	Id          uint64                                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId     uint64                                          `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reward      github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=reward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward"`
+	RewardNonce int64                                           `protobuf:"varint,4,opt,name=reward_nonce,json=rewardNonce,proto3" json:"reward_nonce,omitempty"`
+}
+
+func (m *PendingTopicReward) Reset()         { *m = PendingTopicReward{} }
+func (m *PendingTopicReward) String() string { return proto.CompactTextString(m) }
+func (*PendingTopicReward) ProtoMessage()    {}
+func (*PendingTopicReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b3e373cbd3d1753, []int{0}
+}
+func (m *PendingTopicReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTopicReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTopicReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTopicReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTopicReward.Merge(m, src)
+}
+func (m *PendingTopicReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTopicReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTopicReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTopicReward proto.InternalMessageInfo
+
+func (m *PendingTopicReward) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingTopicReward) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *PendingTopicReward) GetRewardNonce() int64 {
+	if m != nil {
+		return m.RewardNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingTopicReward)(nil), "emissions.v1.PendingTopicReward")
+}
+
+func init() { proto.RegisterFile("emissions/v1/queues.proto", fileDescriptor_8b3e373cbd3d1753) }
+
+var fileDescriptor_8b3e373cbd3d1753 = []byte{
+	// 264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xcd, 0xcd, 0x2c,
+	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0x4d, 0x2d, 0xd6,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4b, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7,
+	0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0xa5, 0xcd, 0x8c, 0x5c, 0x42, 0x01, 0xa9,
+	0x79, 0x29, 0x99, 0x79, 0xe9, 0x21, 0xf9, 0x05, 0x99, 0xc9, 0x41, 0xa9, 0xe5, 0x89, 0x45, 0x29,
+	0x42, 0x7c, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x4c, 0x99, 0x29,
+	0x42, 0x92, 0x5c, 0x1c, 0x25, 0x20, 0xe9, 0xf8, 0xcc, 0x14, 0x09, 0x26, 0xb0, 0x28, 0x3b, 0x98,
+	0xef, 0x99, 0x22, 0xe4, 0xcf, 0xc5, 0x56, 0x04, 0xd6, 0x24, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0xe9,
+	0x64, 0x7e, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0xfa, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
+	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x89, 0x39, 0x39, 0xf9, 0x45, 0x89, 0xba, 0x79, 0xa9, 0x25, 0xe5,
+	0xf9, 0x45, 0xd9, 0x30, 0x6e, 0x72, 0x46, 0x62, 0x66, 0x9e, 0x7e, 0x6e, 0x62, 0x49, 0x86, 0x9e,
+	0x4b, 0x6a, 0x72, 0x10, 0xd4, 0x18, 0x21, 0x45, 0x2e, 0x1e, 0x08, 0x2b, 0x3e, 0x2f, 0x3f, 0x2f,
+	0x39, 0x55, 0x82, 0x45, 0x81, 0x51, 0x83, 0x39, 0x88, 0x1b, 0x22, 0xe6, 0x07, 0x12, 0x72, 0x0a,
+	0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96,
+	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x0b, 0x22, 0x6d, 0xad, 0xd0,
+	0x47, 0x84, 0x5b, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x40, 0x8c, 0x01, 0x03, 0x00,
+	0x75, 0x25, 0x92, 0xec, 0x51, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingTopicReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTopicReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTopicReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardNonce != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.RewardNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueues(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueues(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueues(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingTopicReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQueues(uint64(m.Id))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQueues(uint64(m.TopicId))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovQueues(uint64(l))
+	if m.RewardNonce != 0 {
+		n += 1 + sovQueues(uint64(m.RewardNonce))
+	}
+	return n
+}
+
+func sovQueues(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueues(x uint64) (n int) {
+	return sovQueues(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingTopicReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueues
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTopicReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTopicReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardNonce", wireType)
+			}
+			m.RewardNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueues(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueues(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueues
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueues
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueues
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueues
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueues        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueues          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueues = fmt.Errorf("proto: unexpected end of group")
+)
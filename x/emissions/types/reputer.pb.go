@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type WorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// Set instead of value for the inferences of vector topics
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WorkerAttributedValue) Reset()         { *m = WorkerAttributedValue{} }
@@ -73,6 +75,8 @@ func (m *WorkerAttributedValue) GetWorker() string {
 type WithheldWorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// Set instead of value for the inferences of vector topics
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WithheldWorkerAttributedValue) Reset()         { *m = WithheldWorkerAttributedValue{} }
@@ -141,6 +145,9 @@ type ValueBundle struct {
 	// aka one_in_forecaster_values because equivalent to using only one
 	// forecast-implied inference
 	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
+	// I_i and I^-_i of vector topics, combined element-wise
+	CombinedValues []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,rep,name=combined_values,json=combinedValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_values"`
+	NaiveValues    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=naive_values,json=naiveValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_values"`
 }
 
 func (m *ValueBundle) Reset()         { *m = ValueBundle{} }
@@ -245,6 +252,7 @@ type ReputerValueBundle struct {
 	ValueBundle *ValueBundle `protobuf:"bytes,1,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
 	Signature   []byte       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	Pubkey      string       `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	PubkeyType  PubKeyType   `protobuf:"varint,4,opt,name=pubkey_type,json=pubkeyType,proto3,enum=emissions.v1.PubKeyType" json:"pubkey_type,omitempty"`
 }
 
 func (m *ReputerValueBundle) Reset()         { *m = ReputerValueBundle{} }
@@ -301,6 +309,13 @@ func (m *ReputerValueBundle) GetPubkey() string {
 	return ""
 }
 
+func (m *ReputerValueBundle) GetPubkeyType() PubKeyType {
+	if m != nil {
+		return m.PubkeyType
+	}
+	return PubKeyType_PUB_KEY_TYPE_SECP256K1
+}
+
 type ReputerValueBundles struct {
 	ReputerValueBundles []*ReputerValueBundle `protobuf:"bytes,1,rep,name=reputer_value_bundles,json=reputerValueBundles,proto3" json:"reputer_value_bundles,omitempty"`
 }
@@ -356,46 +371,51 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/reputer.proto", fileDescriptor_87b9bd856742251e) }
 
 var fileDescriptor_87b9bd856742251e = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x9b, 0x36, 0xe3, 0xb4, 0x02, 0x97, 0x56, 0x6e, 0x45, 0xdd, 0x50, 0x2e, 0x95,
-	0x10, 0xb6, 0x5a, 0x0e, 0x20, 0xc4, 0x85, 0xaa, 0x42, 0x2a, 0x12, 0x1f, 0x5a, 0x01, 0x45, 0x08,
-	0x61, 0xd6, 0xf6, 0x34, 0x59, 0x35, 0xd9, 0x0d, 0xeb, 0x75, 0x68, 0xef, 0x1c, 0x11, 0xe2, 0x27,
-	0xf0, 0x4b, 0x38, 0xf7, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xe6, 0xc2, 0xcf, 0x40, 0x5e, 0x3b, 0xc4,
-	0xf9, 0x10, 0x0a, 0xea, 0x85, 0x9b, 0x67, 0x76, 0xe6, 0xbd, 0x99, 0xb7, 0x7e, 0x0b, 0xab, 0xd8,
-	0x62, 0x71, 0xcc, 0x04, 0x8f, 0xbd, 0xce, 0x96, 0x27, 0xb1, 0x9d, 0x28, 0x94, 0x6e, 0x5b, 0x0a,
-	0x25, 0xac, 0xea, 0x9f, 0x33, 0xb7, 0xb3, 0xb5, 0x7a, 0xb5, 0x2e, 0xea, 0x42, 0x1f, 0x78, 0xe9,
-	0x57, 0x56, 0xb3, 0x6a, 0x0f, 0xf4, 0x73, 0xc1, 0x43, 0xcc, 0x4e, 0x36, 0x3e, 0x1a, 0xb0, 0xb4,
-	0x2f, 0xe4, 0x21, 0xca, 0x07, 0x4a, 0x49, 0x16, 0x24, 0x0a, 0xa3, 0x97, 0xb4, 0x99, 0xa0, 0xb5,
-	0x0c, 0xe5, 0x0f, 0xfa, 0xc0, 0x36, 0x6a, 0xc6, 0x66, 0x85, 0xe4, 0x91, 0xf5, 0x18, 0x66, 0x3a,
-	0x69, 0x81, 0x7d, 0x29, 0x4d, 0xef, 0xdc, 0x39, 0x39, 0x5b, 0x2f, 0xfd, 0x38, 0x5b, 0xf7, 0xea,
-	0x4c, 0x35, 0x92, 0xc0, 0x0d, 0x45, 0xcb, 0xa3, 0xcd, 0xa6, 0x90, 0xf4, 0x16, 0x47, 0x95, 0xf6,
-	0xf4, 0xc2, 0xb0, 0x41, 0x19, 0xf7, 0x5a, 0x54, 0x35, 0xdc, 0x5d, 0x0c, 0x49, 0x86, 0x72, 0x6f,
-	0xfa, 0xd7, 0xd7, 0x75, 0x63, 0xe3, 0x93, 0x01, 0x6b, 0xfb, 0x4c, 0x35, 0x1a, 0xd8, 0x8c, 0xfe,
-	0x83, 0x71, 0xbe, 0x95, 0xc1, 0xd4, 0xb4, 0x3b, 0x09, 0x8f, 0x9a, 0x68, 0xad, 0xc0, 0x9c, 0x12,
-	0x6d, 0x16, 0xfa, 0x2c, 0xd2, 0xf4, 0xd3, 0x64, 0x56, 0xc7, 0x7b, 0x91, 0xf5, 0x02, 0x96, 0xf2,
-	0xfb, 0xf0, 0x25, 0xbe, 0x4f, 0x30, 0x56, 0xbe, 0xd6, 0x57, 0xcf, 0x63, 0x6e, 0x5f, 0x77, 0x8b,
-	0xd7, 0xe3, 0x92, 0xac, 0x94, 0x64, 0x95, 0x4f, 0xd2, 0x42, 0xb2, 0x28, 0x47, 0x93, 0x96, 0x0d,
-	0xb3, 0x79, 0xda, 0x9e, 0xd2, 0xfb, 0xf6, 0x42, 0x6b, 0x0d, 0x00, 0x8f, 0x94, 0xa4, 0x7e, 0x44,
-	0x15, 0xb5, 0xa7, 0x6b, 0xc6, 0x66, 0x95, 0x54, 0x74, 0x66, 0x97, 0x2a, 0x6a, 0xbd, 0x85, 0x85,
-	0x50, 0xb4, 0x02, 0xc6, 0x31, 0xf2, 0x33, 0x61, 0x66, 0x2e, 0x26, 0xcc, 0x7c, 0x0f, 0x2e, 0xbb,
-	0x87, 0x47, 0xb0, 0xc0, 0xf8, 0x01, 0x4a, 0x94, 0x19, 0x7c, 0x6c, 0x97, 0x6b, 0x53, 0x9b, 0xe6,
-	0xf6, 0x8d, 0xc1, 0x45, 0xc7, 0x5e, 0x22, 0x99, 0xcf, 0x5b, 0x75, 0x14, 0x5b, 0xcf, 0xe0, 0xca,
-	0x81, 0x90, 0x18, 0xd2, 0x58, 0xf5, 0xe1, 0x66, 0x27, 0x87, 0xbb, 0xdc, 0xef, 0xce, 0x11, 0x5f,
-	0x81, 0xc9, 0x29, 0xeb, 0x60, 0xbe, 0xfa, 0xdc, 0xc5, 0x56, 0x07, 0x8d, 0x95, 0xed, 0xfd, 0x0e,
-	0x96, 0x05, 0x47, 0x5f, 0x24, 0xca, 0x1f, 0xda, 0xbf, 0xa2, 0x07, 0xbe, 0x39, 0x34, 0xf0, 0xdf,
-	0x7e, 0x66, 0xb2, 0x28, 0x38, 0x3e, 0x4d, 0xd4, 0xde, 0x80, 0x1a, 0x07, 0xb0, 0xd2, 0x63, 0x18,
-	0x55, 0x05, 0xfe, 0x9d, 0x64, 0x39, 0x23, 0x79, 0x38, 0xac, 0xd1, 0x1b, 0xb0, 0x53, 0x1e, 0xc6,
-	0xc7, 0xd0, 0x98, 0x93, 0x8b, 0xbf, 0x24, 0x38, 0xee, 0xf1, 0x61, 0xf4, 0xdc, 0x40, 0x9f, 0x0d,
-	0xb0, 0xf2, 0x7f, 0xbd, 0xe8, 0xa3, 0xfb, 0x50, 0xd5, 0x44, 0x7e, 0xa0, 0x63, 0xed, 0x25, 0x73,
-	0x7b, 0x65, 0x90, 0xae, 0xd0, 0x40, 0xcc, 0x4e, 0xa1, 0xfb, 0x1a, 0x54, 0x62, 0x56, 0xe7, 0x54,
-	0x25, 0x32, 0xb3, 0x57, 0x95, 0xf4, 0x13, 0xe9, 0x03, 0xd1, 0x4e, 0x82, 0x43, 0x3c, 0xce, 0x0d,
-	0x93, 0x47, 0xf9, 0x40, 0x87, 0xb0, 0x38, 0x3a, 0x4f, 0x6c, 0x3d, 0xef, 0xbb, 0xb7, 0x38, 0x58,
-	0x6c, 0x1b, 0x5a, 0x88, 0xda, 0x58, 0xf7, 0x16, 0x07, 0xec, 0x99, 0xb7, 0x88, 0xba, 0x43, 0x4e,
-	0xce, 0x1d, 0xe3, 0xf4, 0xdc, 0x31, 0x7e, 0x9e, 0x3b, 0xc6, 0x97, 0xae, 0x53, 0x3a, 0xed, 0x3a,
-	0xa5, 0xef, 0x5d, 0xa7, 0xf4, 0xfa, 0xee, 0x84, 0xbf, 0xe0, 0x91, 0xd7, 0x7f, 0xb1, 0xd5, 0x71,
-	0x1b, 0xe3, 0xa0, 0xac, 0xdf, 0xeb, 0xdb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0x50,
-	0x71, 0x0b, 0x06, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x5d, 0x4f, 0x13, 0x4d,
+	0x14, 0xee, 0xbe, 0x85, 0x96, 0x9e, 0x2d, 0x7d, 0xdf, 0x77, 0x2a, 0x64, 0x21, 0x52, 0x2a, 0xde,
+	0x90, 0x18, 0xbb, 0xa1, 0x5e, 0xf8, 0x11, 0x6f, 0x24, 0xc4, 0x04, 0x8d, 0x42, 0x36, 0x28, 0x86,
+	0x18, 0x97, 0xfd, 0x18, 0xda, 0x09, 0xed, 0x4c, 0x9d, 0x99, 0xad, 0xf4, 0x5f, 0xf8, 0x13, 0xfc,
+	0x39, 0x78, 0xc7, 0x8d, 0x89, 0x31, 0x91, 0x18, 0xb8, 0xf1, 0x47, 0x78, 0x61, 0x76, 0x76, 0x6a,
+	0x77, 0x29, 0x31, 0x98, 0xde, 0x79, 0x37, 0xe7, 0xeb, 0x39, 0xf3, 0x9c, 0x39, 0xe7, 0x0c, 0x2c,
+	0xe2, 0x2e, 0x11, 0x82, 0x30, 0x2a, 0xec, 0xfe, 0x9a, 0xcd, 0x71, 0x2f, 0x92, 0x98, 0x37, 0x7a,
+	0x9c, 0x49, 0x86, 0xca, 0xbf, 0x6c, 0x8d, 0xfe, 0xda, 0xe2, 0xb5, 0x16, 0x6b, 0x31, 0x65, 0xb0,
+	0xe3, 0x53, 0xe2, 0xb3, 0x68, 0x65, 0xe2, 0x29, 0xa3, 0x01, 0xd6, 0x96, 0x2c, 0xb2, 0x20, 0x2d,
+	0x4a, 0x68, 0x2b, 0xb1, 0xad, 0x7c, 0x32, 0x60, 0x6e, 0x97, 0xf1, 0x43, 0xcc, 0x1f, 0x49, 0xc9,
+	0x89, 0x1f, 0x49, 0x1c, 0xbe, 0xf4, 0x3a, 0x11, 0x46, 0xf3, 0x50, 0x78, 0xa7, 0x0c, 0x96, 0x51,
+	0x37, 0x56, 0x4b, 0x8e, 0x96, 0xd0, 0x33, 0x98, 0xee, 0xc7, 0x0e, 0xd6, 0x3f, 0xb1, 0x7a, 0xfd,
+	0xee, 0xf1, 0xe9, 0x72, 0xee, 0xcb, 0xe9, 0xb2, 0xdd, 0x22, 0xb2, 0x1d, 0xf9, 0x8d, 0x80, 0x75,
+	0x6d, 0xaf, 0xd3, 0x61, 0xdc, 0xbb, 0x4d, 0xb1, 0x8c, 0x63, 0x86, 0x62, 0xd0, 0xf6, 0x08, 0xb5,
+	0xbb, 0x9e, 0x6c, 0x37, 0x36, 0x70, 0xe0, 0x24, 0x28, 0x68, 0x0b, 0x0a, 0xea, 0x20, 0xac, 0x7c,
+	0x3d, 0x3f, 0x09, 0x9e, 0x86, 0x79, 0x30, 0xf5, 0xfd, 0xc3, 0xb2, 0xb1, 0xf2, 0xd5, 0x80, 0xa5,
+	0x5d, 0x22, 0xdb, 0x6d, 0xdc, 0x09, 0xff, 0x46, 0x7e, 0x3f, 0x8a, 0x60, 0x2a, 0x1e, 0xeb, 0x11,
+	0x0d, 0x3b, 0x18, 0x2d, 0xc0, 0x8c, 0x64, 0x3d, 0x12, 0xb8, 0x24, 0x54, 0x7c, 0xa6, 0x9c, 0xa2,
+	0x92, 0x37, 0x43, 0xf4, 0x02, 0xe6, 0x74, 0x37, 0xb9, 0x1c, 0xbf, 0x8d, 0xb0, 0x90, 0xae, 0xea,
+	0x0e, 0x45, 0xd0, 0x6c, 0xde, 0x68, 0xa4, 0x9b, 0xab, 0xe1, 0x24, 0xae, 0x4e, 0xe2, 0xf9, 0x3c,
+	0x76, 0x74, 0xaa, 0x7c, 0x5c, 0x89, 0x2c, 0x28, 0x6a, 0xb5, 0x95, 0x57, 0x05, 0x1c, 0x8a, 0x68,
+	0x09, 0x00, 0x1f, 0x49, 0xee, 0xb9, 0xa1, 0x27, 0x3d, 0x6b, 0xaa, 0x6e, 0xac, 0x96, 0x9d, 0x92,
+	0xd2, 0x6c, 0x78, 0xd2, 0x43, 0x6f, 0xa0, 0x12, 0xb0, 0xae, 0x4f, 0x28, 0x0e, 0xdd, 0xa4, 0xd2,
+	0xd3, 0x93, 0x55, 0x7a, 0x76, 0x08, 0x97, 0x3c, 0xec, 0x13, 0xa8, 0x10, 0x7a, 0x80, 0x39, 0xe6,
+	0xae, 0xae, 0x7c, 0xa1, 0x9e, 0x5f, 0x35, 0x9b, 0x37, 0xb3, 0x44, 0x2f, 0xed, 0x0a, 0x67, 0x56,
+	0x87, 0x2a, 0x49, 0xa0, 0x6d, 0xf8, 0xff, 0x80, 0x71, 0x1c, 0x78, 0x42, 0x8e, 0xe0, 0x8a, 0x57,
+	0x87, 0xfb, 0x6f, 0x14, 0xad, 0x11, 0x5f, 0x81, 0x49, 0x3d, 0xd2, 0xc7, 0x9a, 0xfa, 0xcc, 0x64,
+	0xd4, 0x41, 0x61, 0x25, 0xbc, 0xf7, 0x61, 0x9e, 0x51, 0xec, 0xb2, 0x48, 0xba, 0x17, 0xf8, 0x97,
+	0xd4, 0x85, 0x6f, 0x5d, 0xb8, 0xf0, 0xef, 0xa6, 0xc3, 0xa9, 0x32, 0x8a, 0xb7, 0x22, 0xb9, 0x99,
+	0xa9, 0xc6, 0x01, 0x2c, 0x0c, 0x33, 0x8c, 0x57, 0x05, 0xfe, 0x3c, 0xc9, 0x7c, 0x92, 0xe4, 0xf1,
+	0xc5, 0x1a, 0xbd, 0x06, 0x2b, 0xce, 0x43, 0xe8, 0x25, 0x69, 0xcc, 0xab, 0x17, 0x7f, 0x8e, 0x51,
+	0xbc, 0x49, 0xc7, 0xd0, 0xf7, 0xe1, 0xdf, 0x6c, 0xff, 0x09, 0xab, 0x3c, 0xd9, 0x68, 0x56, 0x32,
+	0x0d, 0x28, 0xd0, 0x1e, 0x94, 0x53, 0x6f, 0x2c, 0xac, 0xd9, 0xc9, 0xe0, 0xcd, 0xd1, 0x23, 0x0f,
+	0xc7, 0xff, 0xa3, 0x01, 0x48, 0x4f, 0x6a, 0x7a, 0x0b, 0x3c, 0x84, 0xb2, 0x4a, 0xe9, 0xfa, 0x4a,
+	0x56, 0x9b, 0xc0, 0x6c, 0x2e, 0x64, 0x8b, 0x95, 0x0a, 0x70, 0xcc, 0x7e, 0x2a, 0xfa, 0x3a, 0x94,
+	0xe2, 0xcf, 0xc1, 0x93, 0x11, 0x4f, 0x96, 0x43, 0xd9, 0x19, 0x29, 0xe2, 0x7d, 0xd9, 0x8b, 0xfc,
+	0x43, 0x3c, 0xd0, 0xe3, 0xae, 0x25, 0x74, 0x1f, 0xcc, 0xe4, 0xe4, 0xca, 0x41, 0x0f, 0xab, 0x71,
+	0xaf, 0x34, 0xad, 0x6c, 0xca, 0xed, 0xc8, 0x7f, 0x8a, 0x07, 0x3b, 0x83, 0x1e, 0x76, 0x20, 0x71,
+	0x8e, 0xcf, 0x9a, 0xcb, 0x21, 0x54, 0xc7, 0xa9, 0x08, 0xb4, 0x33, 0x5a, 0x5b, 0x69, 0x4e, 0xc2,
+	0x32, 0x54, 0x07, 0xd4, 0x2f, 0x5d, 0x5b, 0x69, 0x6e, 0x55, 0x3e, 0xa6, 0x13, 0xeb, 0xce, 0xf1,
+	0x59, 0xcd, 0x38, 0x39, 0xab, 0x19, 0xdf, 0xce, 0x6a, 0xc6, 0xfb, 0xf3, 0x5a, 0xee, 0xe4, 0xbc,
+	0x96, 0xfb, 0x7c, 0x5e, 0xcb, 0xed, 0xdd, 0xbb, 0xe2, 0xb3, 0x1c, 0xd9, 0xa3, 0xef, 0x34, 0x66,
+	0x2c, 0xfc, 0x82, 0xfa, 0x4a, 0xef, 0xfc, 0x1c, 0x00, 0x4e, 0x60, 0x7a, 0xeb, 0xc2, 0x07, 0x00,
+	0x00,
 }
 
 func (this *WorkerAttributedValue) Equal(that interface{}) bool {
@@ -423,6 +443,14 @@ func (this *WorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
@@ -450,6 +478,14 @@ func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValueBundle) Equal(that interface{}) bool {
@@ -529,6 +565,22 @@ func (this *ValueBundle) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CombinedValues) != len(that1.CombinedValues) {
+		return false
+	}
+	for i := range this.CombinedValues {
+		if !this.CombinedValues[i].Equal(that1.CombinedValues[i]) {
+			return false
+		}
+	}
+	if len(this.NaiveValues) != len(that1.NaiveValues) {
+		return false
+	}
+	for i := range this.NaiveValues {
+		if !this.NaiveValues[i].Equal(that1.NaiveValues[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReputerValueBundle) Equal(that interface{}) bool {
@@ -559,6 +611,9 @@ func (this *ReputerValueBundle) Equal(that interface{}) bool {
 	if this.Pubkey != that1.Pubkey {
 		return false
 	}
+	if this.PubkeyType != that1.PubkeyType {
+		return false
+	}
 	return true
 }
 func (m *WorkerAttributedValue) Marshal() (dAtA []byte, err error) {
@@ -581,6 +636,20 @@ func (m *WorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -621,6 +690,20 @@ func (m *WithheldWorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -661,6 +744,34 @@ func (m *ValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NaiveValues) > 0 {
+		for iNdEx := len(m.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NaiveValues[iNdEx].Size()
+				i -= size
+				if _, err := m.NaiveValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CombinedValues) > 0 {
+		for iNdEx := len(m.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CombinedValues[iNdEx].Size()
+				i -= size
+				if _, err := m.CombinedValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.OneInForecasterValues) > 0 {
 		for iNdEx := len(m.OneInForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -805,6 +916,11 @@ func (m *ReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubkeyType != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.PubkeyType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Pubkey) > 0 {
 		i -= len(m.Pubkey)
 		copy(dAtA[i:], m.Pubkey)
@@ -894,6 +1010,12 @@ func (m *WorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -909,6 +1031,12 @@ func (m *WithheldWorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -967,6 +1095,18 @@ func (m *ValueBundle) Size() (n int) {
 			n += 1 + l + sovReputer(uint64(l))
 		}
 	}
+	if len(m.CombinedValues) > 0 {
+		for _, e := range m.CombinedValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for _, e := range m.NaiveValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -988,6 +1128,9 @@ func (m *ReputerValueBundle) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReputer(uint64(l))
 	}
+	if m.PubkeyType != 0 {
+		n += 1 + sovReputer(uint64(m.PubkeyType))
+	}
 	return n
 }
 
@@ -1107,6 +1250,42 @@ func (m *WorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1223,6 +1402,42 @@ func (m *WithheldWorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1632,6 +1847,78 @@ func (m *ValueBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CombinedValues = append(m.CombinedValues, v)
+			if err := m.CombinedValues[len(m.CombinedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.NaiveValues = append(m.NaiveValues, v)
+			if err := m.NaiveValues[len(m.NaiveValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1784,6 +2071,25 @@ func (m *ReputerValueBundle) Unmarshal(dAtA []byte) error {
 			}
 			m.Pubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyType", wireType)
+			}
+			m.PubkeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubkeyType |= PubKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
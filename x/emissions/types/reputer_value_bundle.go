@@ -1,15 +1,12 @@
 package types
 
 import (
-	"encoding/hex"
-
 	"cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-func (bundle *ReputerValueBundle) Validate() error {
+func (bundle *ReputerValueBundle) Validate(opts BundleSignatureOptions) error {
 	// Validate top level, then validate all the values within bundle
 
 	if bundle.ValueBundle == nil {
@@ -58,17 +55,32 @@ func (bundle *ReputerValueBundle) Validate() error {
 	}
 
 	// Check signature from the bundle, throw if invalid!
-	pk, err := hex.DecodeString(bundle.Pubkey)
-	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
-	pubkey := secp256k1.PubKey(pk)
+	return verifyBundleSignature(
+		bundle.Pubkey,
+		bundle.PubkeyType,
+		bundle.Signature,
+		bundle.ValueBundle.payload(),
+		PayloadKind_PAYLOAD_KIND_REPUTER_VALUE_BUNDLE,
+		bundle.ValueBundle.TopicId,
+		bundle.ValueBundle.nonce(),
+		opts,
+	)
+}
 
-	src := make([]byte, 0)
-	src, _ = bundle.ValueBundle.XXX_Marshal(src, true)
-	if !pubkey.VerifySignature(src, bundle.Signature) {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
+// Returns the bytes a reputer signs to submit the bundle on the given chain
+func (bundle *ValueBundle) SignBytes(chainId string) ([]byte, error) {
+	return BundleSignBytes(chainId, PayloadKind_PAYLOAD_KIND_REPUTER_VALUE_BUNDLE, bundle.TopicId, bundle.nonce(), bundle.payload())
+}
+
+func (bundle *ValueBundle) payload() []byte {
+	src, _ := bundle.XXX_Marshal(nil, true)
+	return src
+}
 
-	return nil
+// Block height of the reputer nonce the bundle responds to
+func (bundle *ValueBundle) nonce() int64 {
+	if bundle.ReputerRequestNonce == nil || bundle.ReputerRequestNonce.ReputerNonce == nil {
+		return 0
+	}
+	return bundle.ReputerRequestNonce.ReputerNonce.BlockHeight
 }
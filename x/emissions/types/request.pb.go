@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/request.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An ad-hoc inference requested by a consumer, outside of the topic's epoch cadence.
+// Workers answer at the one-off nonce block_height, reputers answer once ground_truth_block is reached
+// under a reputer nonce sharing the same block height.
+type InferenceRequest struct {
+	Id               uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender           string                `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId          uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Argument         string                `protobuf:"bytes,4,opt,name=argument,proto3" json:"argument,omitempty"`
+	Bounty           cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=bounty,proto3,customtype=cosmossdk.io/math.Int" json:"bounty"`
+	BlockHeight      int64                 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	GroundTruthBlock int64                 `protobuf:"varint,7,opt,name=ground_truth_block,json=groundTruthBlock,proto3" json:"ground_truth_block,omitempty"`
+	Deadline         int64                 `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *InferenceRequest) Reset()         { *m = InferenceRequest{} }
+func (m *InferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*InferenceRequest) ProtoMessage()    {}
+func (*InferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_064c889420489fec, []int{0}
+}
+func (m *InferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferenceRequest.Merge(m, src)
+}
+func (m *InferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferenceRequest proto.InternalMessageInfo
+
+func (m *InferenceRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *InferenceRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *InferenceRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *InferenceRequest) GetArgument() string {
+	if m != nil {
+		return m.Argument
+	}
+	return ""
+}
+
+func (m *InferenceRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *InferenceRequest) GetGroundTruthBlock() int64 {
+	if m != nil {
+		return m.GroundTruthBlock
+	}
+	return 0
+}
+
+func (m *InferenceRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InferenceRequest)(nil), "emissions.v1.InferenceRequest")
+}
+
+func init() { proto.RegisterFile("emissions/v1/request.proto", fileDescriptor_064c889420489fec) }
+
+var fileDescriptor_064c889420489fec = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xb1, 0x8e, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0xdc, 0x91, 0x2b, 0xe6, 0x84, 0x0e, 0x0b, 0x90, 0x2f, 0x43, 0xae, 0x30, 0x55,
+	0x88, 0x8b, 0x39, 0xb1, 0x30, 0x77, 0xba, 0xac, 0x11, 0x13, 0x4b, 0x94, 0xc4, 0x26, 0xb1, 0x9a,
+	0xf8, 0x2b, 0xb6, 0x53, 0xe8, 0x5b, 0xf0, 0x18, 0x1d, 0x19, 0x78, 0x88, 0x8e, 0x15, 0x13, 0x62,
+	0xa8, 0x50, 0x3b, 0xf0, 0x1a, 0x28, 0x4e, 0x28, 0x4b, 0x94, 0xdf, 0xff, 0xf7, 0xff, 0x94, 0x2f,
+	0xfa, 0x70, 0x28, 0x5a, 0x69, 0x8c, 0x04, 0x65, 0xd8, 0xea, 0x8e, 0x69, 0xf1, 0xa9, 0x13, 0xc6,
+	0xc6, 0x4b, 0x0d, 0x16, 0xc8, 0xe5, 0xc9, 0xc5, 0xab, 0xbb, 0xf0, 0x69, 0x05, 0x15, 0x38, 0xc1,
+	0xfa, 0xb7, 0xa1, 0x13, 0x5e, 0x97, 0x60, 0x5a, 0x30, 0xd9, 0x20, 0x06, 0x18, 0xd5, 0x93, 0xbc,
+	0x95, 0x0a, 0x98, 0x7b, 0x0e, 0xd1, 0xcb, 0x8d, 0x8f, 0xaf, 0x12, 0xf5, 0x51, 0x68, 0xa1, 0x4a,
+	0x91, 0x0e, 0x1f, 0x23, 0x8f, 0xb1, 0x2f, 0x39, 0x45, 0x53, 0x34, 0x3b, 0x4f, 0x7d, 0xc9, 0xc9,
+	0x73, 0x1c, 0x18, 0xa1, 0xb8, 0xd0, 0xd4, 0x9f, 0xa2, 0xd9, 0xc3, 0x74, 0x24, 0x72, 0x8d, 0x27,
+	0x16, 0x96, 0xb2, 0xcc, 0x24, 0xa7, 0x67, 0xae, 0x7d, 0xe1, 0x38, 0xe1, 0x24, 0xc4, 0x93, 0x5c,
+	0x57, 0x5d, 0x2b, 0x94, 0xa5, 0xe7, 0x6e, 0xe8, 0xc4, 0xe4, 0x1e, 0x07, 0x05, 0x74, 0xca, 0xae,
+	0xe9, 0x83, 0xde, 0xcc, 0xdf, 0x6c, 0xf7, 0x37, 0xde, 0xaf, 0xfd, 0xcd, 0xb3, 0x61, 0x59, 0xc3,
+	0x17, 0xb1, 0x04, 0xd6, 0xe6, 0xb6, 0x8e, 0x13, 0x65, 0x7f, 0x7c, 0xbf, 0xc5, 0xe3, 0x5f, 0x24,
+	0xca, 0x6e, 0xfe, 0x7c, 0x7b, 0x85, 0xd2, 0x71, 0x9e, 0xbc, 0xc0, 0x97, 0x45, 0x03, 0xe5, 0x22,
+	0xab, 0x85, 0xac, 0x6a, 0x4b, 0x83, 0x29, 0x9a, 0x9d, 0xa5, 0x8f, 0x5c, 0x76, 0xef, 0x22, 0xf2,
+	0x1a, 0x93, 0x4a, 0x43, 0xa7, 0x78, 0x66, 0x75, 0x67, 0xeb, 0xcc, 0x39, 0x7a, 0xe1, 0x8a, 0x57,
+	0x83, 0x79, 0xdf, 0x8b, 0x79, 0x9f, 0xf7, 0x6b, 0x73, 0x91, 0xf3, 0x46, 0x2a, 0x41, 0x27, 0xae,
+	0x73, 0xe2, 0x79, 0xba, 0x3d, 0x44, 0x68, 0x77, 0x88, 0xd0, 0xef, 0x43, 0x84, 0xbe, 0x1e, 0x23,
+	0x6f, 0x77, 0x8c, 0xbc, 0x9f, 0xc7, 0xc8, 0xfb, 0xf0, 0xae, 0x92, 0xb6, 0xee, 0x8a, 0xb8, 0x84,
+	0x96, 0xe5, 0x4d, 0x03, 0x3a, 0xbf, 0x55, 0xc2, 0x7e, 0x06, 0xbd, 0xf8, 0x87, 0x65, 0x9d, 0x4b,
+	0xc5, 0xbe, 0xb0, 0xff, 0xb7, 0xb5, 0xeb, 0xa5, 0x30, 0x45, 0xe0, 0xae, 0xf0, 0xf6, 0xef, 0x00,
+	0x89, 0xb6, 0xbc, 0x46, 0xf5, 0x01, 0x00, 0x00,
+}
+
+func (m *InferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GroundTruthBlock != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.GroundTruthBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Bounty.Size()
+		i -= size
+		if _, err := m.Bounty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRequest(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Argument) > 0 {
+		i -= len(m.Argument)
+		copy(dAtA[i:], m.Argument)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.Argument)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequest(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequest(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRequest(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovRequest(uint64(m.TopicId))
+	}
+	l = len(m.Argument)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	l = m.Bounty.Size()
+	n += 1 + l + sovRequest(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovRequest(uint64(m.BlockHeight))
+	}
+	if m.GroundTruthBlock != 0 {
+		n += 1 + sovRequest(uint64(m.GroundTruthBlock))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovRequest(uint64(m.Deadline))
+	}
+	return n
+}
+
+func sovRequest(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequest(x uint64) (n int) {
+	return sovRequest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InferenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InferenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Argument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Argument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthBlock", wireType)
+			}
+			m.GroundTruthBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRequest(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRequest
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRequest
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRequest
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRequest
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRequest        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRequest          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRequest = fmt.Errorf("proto: unexpected end of group")
+)
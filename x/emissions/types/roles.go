@@ -0,0 +1,34 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// Named roles gating the admin functions of the chain. An address can hold any
+// number of roles and every role can be held by any number of addresses.
+const (
+	// may update the emissions module params
+	RoleParamsAdmin = "params-admin"
+	// may update the mint module params
+	RoleMintAdmin = "mint-admin"
+	// may moderate topics and their per-topic settings
+	RoleTopicModerator = "topic-moderator"
+	// may grant and revoke roles, including its own
+	RoleAdminManager = "admin-manager"
+)
+
+// AllRoles lists every role in a fixed order.
+var AllRoles = []string{
+	RoleParamsAdmin,
+	RoleMintAdmin,
+	RoleTopicModerator,
+	RoleAdminManager,
+}
+
+// ValidateRole checks that the role is one of the known roles.
+func ValidateRole(role string) error {
+	for _, known := range AllRoles {
+		if role == known {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrUnknownRole, "%q, expected one of %v", role, AllRoles)
+}
@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/signing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Type of the public key that signed a worker or reputer bundle
+type PubKeyType int32
+
+const (
+	PubKeyType_PUB_KEY_TYPE_SECP256K1 PubKeyType = 0
+	PubKeyType_PUB_KEY_TYPE_ED25519   PubKeyType = 1
+	PubKeyType_PUB_KEY_TYPE_SECP256R1 PubKeyType = 2
+)
+
+var PubKeyType_name = map[int32]string{
+	0: "PUB_KEY_TYPE_SECP256K1",
+	1: "PUB_KEY_TYPE_ED25519",
+	2: "PUB_KEY_TYPE_SECP256R1",
+}
+
+var PubKeyType_value = map[string]int32{
+	"PUB_KEY_TYPE_SECP256K1": 0,
+	"PUB_KEY_TYPE_ED25519":   1,
+	"PUB_KEY_TYPE_SECP256R1": 2,
+}
+
+func (x PubKeyType) String() string {
+	return proto.EnumName(PubKeyType_name, int32(x))
+}
+
+func (PubKeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3501bd3c6a9fe3e6, []int{0}
+}
+
+// Kind of payload a bundle signature commits to
+type PayloadKind int32
+
+const (
+	PayloadKind_PAYLOAD_KIND_UNSPECIFIED          PayloadKind = 0
+	PayloadKind_PAYLOAD_KIND_WORKER_DATA_BUNDLE   PayloadKind = 1
+	PayloadKind_PAYLOAD_KIND_REPUTER_VALUE_BUNDLE PayloadKind = 2
+)
+
+var PayloadKind_name = map[int32]string{
+	0: "PAYLOAD_KIND_UNSPECIFIED",
+	1: "PAYLOAD_KIND_WORKER_DATA_BUNDLE",
+	2: "PAYLOAD_KIND_REPUTER_VALUE_BUNDLE",
+}
+
+var PayloadKind_value = map[string]int32{
+	"PAYLOAD_KIND_UNSPECIFIED":          0,
+	"PAYLOAD_KIND_WORKER_DATA_BUNDLE":   1,
+	"PAYLOAD_KIND_REPUTER_VALUE_BUNDLE": 2,
+}
+
+func (x PayloadKind) String() string {
+	return proto.EnumName(PayloadKind_name, int32(x))
+}
+
+func (PayloadKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3501bd3c6a9fe3e6, []int{1}
+}
+
+// Document whose deterministic proto encoding is signed by workers and reputers.
+// It binds the payload to a chain, topic and nonce so that a signature cannot be
+// replayed on another chain or for another kind of payload.
+type BundleSignDoc struct {
+	Domain      string      `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ChainId     string      `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	PayloadKind PayloadKind `protobuf:"varint,3,opt,name=payload_kind,json=payloadKind,proto3,enum=emissions.v1.PayloadKind" json:"payload_kind,omitempty"`
+	TopicId     uint64      `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Nonce       int64       `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Payload     []byte      `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *BundleSignDoc) Reset()         { *m = BundleSignDoc{} }
+func (m *BundleSignDoc) String() string { return proto.CompactTextString(m) }
+func (*BundleSignDoc) ProtoMessage()    {}
+func (*BundleSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3501bd3c6a9fe3e6, []int{0}
+}
+func (m *BundleSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleSignDoc.Merge(m, src)
+}
+func (m *BundleSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleSignDoc proto.InternalMessageInfo
+
+func (m *BundleSignDoc) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *BundleSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BundleSignDoc) GetPayloadKind() PayloadKind {
+	if m != nil {
+		return m.PayloadKind
+	}
+	return PayloadKind_PAYLOAD_KIND_UNSPECIFIED
+}
+
+func (m *BundleSignDoc) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *BundleSignDoc) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BundleSignDoc) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("emissions.v1.PubKeyType", PubKeyType_name, PubKeyType_value)
+	proto.RegisterEnum("emissions.v1.PayloadKind", PayloadKind_name, PayloadKind_value)
+	proto.RegisterType((*BundleSignDoc)(nil), "emissions.v1.BundleSignDoc")
+}
+
+func init() { proto.RegisterFile("emissions/v1/signing.proto", fileDescriptor_3501bd3c6a9fe3e6) }
+
+var fileDescriptor_3501bd3c6a9fe3e6 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x6b, 0xd3, 0x40,
+	0x1c, 0xc7, 0x73, 0xdd, 0xd6, 0xea, 0xad, 0x4a, 0x38, 0xc6, 0xc8, 0x86, 0xc4, 0xa8, 0x08, 0x61,
+	0x60, 0x42, 0x2a, 0x15, 0x05, 0x5f, 0xd2, 0xe5, 0x84, 0x90, 0xd2, 0x1d, 0xd7, 0x46, 0xa9, 0x0f,
+	0x1e, 0x69, 0x2e, 0x74, 0xc7, 0xda, 0xbb, 0xd0, 0xa4, 0x9b, 0xf9, 0x2f, 0xfc, 0xb3, 0x04, 0x5f,
+	0xf6, 0xe8, 0xa3, 0xb4, 0xff, 0x88, 0x2c, 0x5b, 0xdd, 0xfa, 0xe0, 0xe3, 0x87, 0xcf, 0xf7, 0xf7,
+	0x3b, 0x8e, 0xdf, 0x17, 0x1e, 0x67, 0x73, 0x51, 0x14, 0x42, 0xc9, 0xc2, 0xbd, 0xf4, 0xdc, 0x42,
+	0x4c, 0xa5, 0x90, 0x53, 0x27, 0x5f, 0xa8, 0x52, 0xa1, 0xf6, 0x3f, 0xe7, 0x5c, 0x7a, 0x2f, 0x7f,
+	0x01, 0xf8, 0xa4, 0xb7, 0x94, 0x7c, 0x96, 0x0d, 0xc5, 0x54, 0x06, 0x2a, 0x45, 0x87, 0xb0, 0xc9,
+	0xd5, 0x3c, 0x11, 0xd2, 0x00, 0x16, 0xb0, 0x1f, 0xd3, 0x3b, 0x42, 0x47, 0xf0, 0x51, 0x7a, 0x9e,
+	0x08, 0xc9, 0x04, 0x37, 0x1a, 0xb5, 0x69, 0xd5, 0x1c, 0x72, 0xf4, 0x11, 0xb6, 0xf3, 0xa4, 0x9a,
+	0xa9, 0x84, 0xb3, 0x0b, 0x21, 0xb9, 0xb1, 0x63, 0x01, 0xfb, 0x69, 0xe7, 0xc8, 0x79, 0xf8, 0x92,
+	0x43, 0x6e, 0x13, 0x91, 0x90, 0x9c, 0xee, 0xe7, 0xf7, 0x70, 0xb3, 0xb8, 0x54, 0xb9, 0x48, 0x6f,
+	0x16, 0xef, 0x5a, 0xc0, 0xde, 0xa5, 0xad, 0x9a, 0x43, 0x8e, 0x0e, 0xe0, 0x9e, 0x54, 0x32, 0xcd,
+	0x8c, 0x3d, 0x0b, 0xd8, 0x3b, 0xf4, 0x16, 0x90, 0x01, 0x5b, 0x77, 0xf3, 0x46, 0xd3, 0x02, 0x76,
+	0x9b, 0x6e, 0xf0, 0xe4, 0x1b, 0x84, 0x64, 0x39, 0x89, 0xb2, 0x6a, 0x54, 0xe5, 0x19, 0x3a, 0x86,
+	0x87, 0x24, 0xee, 0xb1, 0x08, 0x8f, 0xd9, 0x68, 0x4c, 0x30, 0x1b, 0xe2, 0x53, 0xd2, 0xe9, 0xbe,
+	0x8b, 0x3c, 0x5d, 0x43, 0x06, 0x3c, 0xd8, 0x72, 0x38, 0xe8, 0x74, 0xbb, 0xde, 0x07, 0x1d, 0xfc,
+	0x6f, 0x8a, 0x7a, 0x7a, 0xe3, 0xe4, 0x0a, 0xee, 0x3f, 0xf8, 0x06, 0x7a, 0x06, 0x0d, 0xe2, 0x8f,
+	0xfb, 0x67, 0x7e, 0xc0, 0xa2, 0x70, 0x10, 0xb0, 0x78, 0x30, 0x24, 0xf8, 0x34, 0xfc, 0x14, 0xe2,
+	0x40, 0xd7, 0xd0, 0x2b, 0xf8, 0x7c, 0xcb, 0x7e, 0x39, 0xa3, 0x11, 0xa6, 0x2c, 0xf0, 0x47, 0x3e,
+	0xeb, 0xc5, 0x83, 0xa0, 0x8f, 0x75, 0x80, 0x5e, 0xc3, 0x17, 0x5b, 0x21, 0x8a, 0x49, 0x3c, 0xc2,
+	0x94, 0x7d, 0xf6, 0xfb, 0x31, 0xde, 0xc4, 0x1a, 0x3d, 0xfa, 0x73, 0x65, 0x82, 0xeb, 0x95, 0x09,
+	0xfe, 0xac, 0x4c, 0xf0, 0x63, 0x6d, 0x6a, 0xd7, 0x6b, 0x53, 0xfb, 0xbd, 0x36, 0xb5, 0xaf, 0xef,
+	0xa7, 0xa2, 0x3c, 0x5f, 0x4e, 0x9c, 0x54, 0xcd, 0xdd, 0x64, 0x36, 0x53, 0x8b, 0xe4, 0x8d, 0xcc,
+	0xca, 0x2b, 0xb5, 0xb8, 0xd8, 0x60, 0x7d, 0x25, 0xf7, 0xbb, 0x7b, 0xdf, 0x89, 0xb2, 0xca, 0xb3,
+	0x62, 0xd2, 0xac, 0xfb, 0xf0, 0xf6, 0xef, 0x00, 0x82, 0x61, 0xf7, 0xc5, 0x2d, 0x02, 0x00, 0x00,
+}
+
+func (m *BundleSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PayloadKind != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.PayloadKind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BundleSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	if m.PayloadKind != 0 {
+		n += 1 + sovSigning(uint64(m.PayloadKind))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovSigning(uint64(m.TopicId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovSigning(uint64(m.Nonce))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	return n
+}
+
+func sovSigning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigning(x uint64) (n int) {
+	return sovSigning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BundleSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadKind", wireType)
+			}
+			m.PayloadKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadKind |= PayloadKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigning = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Collects what became of each bundle of a bulk payload, so the leader submitting it
+// can tell which participants were left out and why
+type SubmissionOutcomes struct {
+	topicId   TopicId
+	actorType ActorType
+	outcomes  []*SubmissionOutcome
+}
+
+func NewSubmissionOutcomes(topicId TopicId, actorType ActorType) *SubmissionOutcomes {
+	return &SubmissionOutcomes{
+		topicId:   topicId,
+		actorType: actorType,
+		outcomes:  make([]*SubmissionOutcome, 0),
+	}
+}
+
+func (o *SubmissionOutcomes) Accept(bundleIndex int, actor string) {
+	o.outcomes = append(o.outcomes, &SubmissionOutcome{
+		BundleIndex: uint32(bundleIndex),
+		Actor:       actor,
+		Code:        SubmissionOutcomeCode_SUBMISSION_ACCEPTED,
+	})
+}
+
+func (o *SubmissionOutcomes) Reject(bundleIndex int, actor string, code SubmissionOutcomeCode) {
+	o.outcomes = append(o.outcomes, &SubmissionOutcome{
+		BundleIndex: uint32(bundleIndex),
+		Actor:       actor,
+		Code:        code,
+	})
+}
+
+// The outcomes in the order of the bundles in the payload
+func (o *SubmissionOutcomes) List() []*SubmissionOutcome {
+	sort.SliceStable(o.outcomes, func(i, j int) bool {
+		return o.outcomes[i].BundleIndex < o.outcomes[j].BundleIndex
+	})
+	return o.outcomes
+}
+
+// Human readable outcomes, to explain why a payload was rejected as a whole
+func (o *SubmissionOutcomes) String() string {
+	outcomes := o.List()
+	descriptions := make([]string, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		descriptions = append(descriptions, fmt.Sprintf("bundle %d (%s): %s", outcome.BundleIndex, outcome.Actor, outcome.Code))
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// Label of the code in telemetry, e.g. "not_top_ranked"
+func (c SubmissionOutcomeCode) MetricLabel() string {
+	return strings.ToLower(strings.TrimPrefix(c.String(), "SUBMISSION_"))
+}
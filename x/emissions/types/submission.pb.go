@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/submission.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// What became of a submission in a bulk payload.
+// The codes are stable: new codes are only ever appended.
+type SubmissionOutcomeCode int32
+
+const (
+	// Never set by the chain, so that an outcome without a code is not read as accepted
+	SubmissionOutcomeCode_SUBMISSION_OUTCOME_UNSPECIFIED SubmissionOutcomeCode = 0
+	SubmissionOutcomeCode_SUBMISSION_ACCEPTED            SubmissionOutcomeCode = 1
+	// The bundle failed validation, e.g. a bad signature or a malformed value
+	SubmissionOutcomeCode_SUBMISSION_INVALID                 SubmissionOutcomeCode = 2
+	SubmissionOutcomeCode_SUBMISSION_TOPIC_OR_NONCE_MISMATCH SubmissionOutcomeCode = 3
+	// Only the first submission of an actor in a payload is considered
+	SubmissionOutcomeCode_SUBMISSION_DUPLICATE          SubmissionOutcomeCode = 4
+	SubmissionOutcomeCode_SUBMISSION_UNREGISTERED       SubmissionOutcomeCode = 5
+	SubmissionOutcomeCode_SUBMISSION_INSUFFICIENT_STAKE SubmissionOutcomeCode = 6
+	// None of the workers the forecast or losses are about had their inferences accepted
+	SubmissionOutcomeCode_SUBMISSION_NO_ACCEPTED_WORKERS SubmissionOutcomeCode = 7
+	// Left out for actors with a higher score, only the top actors of a topic are rewarded
+	SubmissionOutcomeCode_SUBMISSION_NOT_TOP_RANKED SubmissionOutcomeCode = 8
+	// The chain failed to read the state needed to check the submission
+	SubmissionOutcomeCode_SUBMISSION_STORE_ERROR SubmissionOutcomeCode = 9
+	// The inference does not have the number of values the topic declares
+	SubmissionOutcomeCode_SUBMISSION_WRONG_DIMENSION SubmissionOutcomeCode = 10
+	// The quantiles of the inference decrease as the quantile level increases
+	SubmissionOutcomeCode_SUBMISSION_NON_MONOTONIC_QUANTILES SubmissionOutcomeCode = 11
+	// The probabilities of the inference are negative or do not sum to one
+	SubmissionOutcomeCode_SUBMISSION_INVALID_PROBABILITIES SubmissionOutcomeCode = 12
+)
+
+var SubmissionOutcomeCode_name = map[int32]string{
+	0:  "SUBMISSION_OUTCOME_UNSPECIFIED",
+	1:  "SUBMISSION_ACCEPTED",
+	2:  "SUBMISSION_INVALID",
+	3:  "SUBMISSION_TOPIC_OR_NONCE_MISMATCH",
+	4:  "SUBMISSION_DUPLICATE",
+	5:  "SUBMISSION_UNREGISTERED",
+	6:  "SUBMISSION_INSUFFICIENT_STAKE",
+	7:  "SUBMISSION_NO_ACCEPTED_WORKERS",
+	8:  "SUBMISSION_NOT_TOP_RANKED",
+	9:  "SUBMISSION_STORE_ERROR",
+	10: "SUBMISSION_WRONG_DIMENSION",
+	11: "SUBMISSION_NON_MONOTONIC_QUANTILES",
+	12: "SUBMISSION_INVALID_PROBABILITIES",
+}
+
+var SubmissionOutcomeCode_value = map[string]int32{
+	"SUBMISSION_OUTCOME_UNSPECIFIED":     0,
+	"SUBMISSION_ACCEPTED":                1,
+	"SUBMISSION_INVALID":                 2,
+	"SUBMISSION_TOPIC_OR_NONCE_MISMATCH": 3,
+	"SUBMISSION_DUPLICATE":               4,
+	"SUBMISSION_UNREGISTERED":            5,
+	"SUBMISSION_INSUFFICIENT_STAKE":      6,
+	"SUBMISSION_NO_ACCEPTED_WORKERS":     7,
+	"SUBMISSION_NOT_TOP_RANKED":          8,
+	"SUBMISSION_STORE_ERROR":             9,
+	"SUBMISSION_WRONG_DIMENSION":         10,
+	"SUBMISSION_NON_MONOTONIC_QUANTILES": 11,
+	"SUBMISSION_INVALID_PROBABILITIES":   12,
+}
+
+func (x SubmissionOutcomeCode) String() string {
+	return proto.EnumName(SubmissionOutcomeCode_name, int32(x))
+}
+
+func (SubmissionOutcomeCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d5a1e1603cb4c576, []int{0}
+}
+
+type SubmissionOutcome struct {
+	// Position of the bundle in the payload
+	BundleIndex uint32                `protobuf:"varint,1,opt,name=bundle_index,json=bundleIndex,proto3" json:"bundle_index,omitempty"`
+	Actor       string                `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Code        SubmissionOutcomeCode `protobuf:"varint,3,opt,name=code,proto3,enum=emissions.v1.SubmissionOutcomeCode" json:"code,omitempty"`
+}
+
+func (m *SubmissionOutcome) Reset()         { *m = SubmissionOutcome{} }
+func (m *SubmissionOutcome) String() string { return proto.CompactTextString(m) }
+func (*SubmissionOutcome) ProtoMessage()    {}
+func (*SubmissionOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5a1e1603cb4c576, []int{0}
+}
+func (m *SubmissionOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionOutcome.Merge(m, src)
+}
+func (m *SubmissionOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionOutcome proto.InternalMessageInfo
+
+func (m *SubmissionOutcome) GetBundleIndex() uint32 {
+	if m != nil {
+		return m.BundleIndex
+	}
+	return 0
+}
+
+func (m *SubmissionOutcome) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *SubmissionOutcome) GetCode() SubmissionOutcomeCode {
+	if m != nil {
+		return m.Code
+	}
+	return SubmissionOutcomeCode_SUBMISSION_OUTCOME_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("emissions.v1.SubmissionOutcomeCode", SubmissionOutcomeCode_name, SubmissionOutcomeCode_value)
+	proto.RegisterType((*SubmissionOutcome)(nil), "emissions.v1.SubmissionOutcome")
+}
+
+func init() { proto.RegisterFile("emissions/v1/submission.proto", fileDescriptor_d5a1e1603cb4c576) }
+
+var fileDescriptor_d5a1e1603cb4c576 = []byte{
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x9b, 0x75, 0xdb, 0xff, 0x3f, 0xaf, 0x20, 0x63, 0xc6, 0x56, 0x86, 0x1a, 0x75, 0x05,
+	0xa1, 0x0a, 0x89, 0x44, 0x83, 0x03, 0x5c, 0x53, 0xc7, 0x1d, 0x56, 0x1b, 0x3b, 0xd8, 0x0e, 0x93,
+	0xb8, 0x58, 0x6d, 0x1a, 0xb1, 0x88, 0x36, 0x9e, 0x9a, 0x74, 0x8c, 0x0f, 0xc0, 0x9d, 0xef, 0xc3,
+	0x17, 0xe0, 0xb8, 0x23, 0x47, 0xd4, 0x7e, 0x11, 0xd4, 0x0d, 0x4a, 0xb6, 0x71, 0x7c, 0x9e, 0xdf,
+	0x63, 0x3d, 0xaf, 0x5f, 0xbd, 0xa0, 0x91, 0x4c, 0xd2, 0x3c, 0x4f, 0x4d, 0x96, 0xbb, 0x67, 0x87,
+	0x6e, 0x3e, 0x1b, 0xfe, 0x96, 0xce, 0xe9, 0xd4, 0x14, 0x06, 0xd5, 0x56, 0xd8, 0x39, 0x3b, 0x6c,
+	0x7d, 0xb1, 0xc0, 0x3d, 0xb9, 0x8a, 0xf0, 0x59, 0x11, 0x9b, 0x49, 0x82, 0x0e, 0x40, 0x6d, 0x38,
+	0xcb, 0x46, 0xe3, 0x44, 0xa7, 0xd9, 0x28, 0x39, 0xaf, 0x5b, 0x4d, 0xab, 0x7d, 0x47, 0x6c, 0x5f,
+	0x79, 0x74, 0x69, 0xa1, 0x1d, 0xb0, 0x31, 0x88, 0x0b, 0x33, 0xad, 0xaf, 0x35, 0xad, 0xf6, 0x96,
+	0xb8, 0x12, 0xe8, 0x15, 0x58, 0x8f, 0xcd, 0x28, 0xa9, 0x57, 0x9b, 0x56, 0xfb, 0xee, 0x8b, 0xc7,
+	0x4e, 0xb9, 0xcb, 0xb9, 0xd5, 0x83, 0xcd, 0x28, 0x11, 0x97, 0x0f, 0x9e, 0x7d, 0xab, 0x82, 0x07,
+	0xff, 0xe4, 0xa8, 0x05, 0x6c, 0x19, 0x75, 0x02, 0x2a, 0x25, 0xe5, 0x4c, 0xf3, 0x48, 0x61, 0x1e,
+	0x10, 0x1d, 0x31, 0x19, 0x12, 0x4c, 0xbb, 0x94, 0xf8, 0xb0, 0x82, 0xf6, 0xc0, 0xfd, 0x52, 0xc6,
+	0xc3, 0x98, 0x84, 0x8a, 0xf8, 0xd0, 0x42, 0xbb, 0x00, 0x95, 0x00, 0x65, 0xef, 0xbc, 0x3e, 0xf5,
+	0xe1, 0x1a, 0x7a, 0x0a, 0x5a, 0x25, 0x5f, 0xf1, 0x90, 0x62, 0xcd, 0x85, 0x66, 0x9c, 0x61, 0xa2,
+	0x03, 0x2a, 0x03, 0x4f, 0xe1, 0x37, 0xb0, 0x8a, 0xea, 0x60, 0xa7, 0x94, 0xf3, 0xa3, 0xb0, 0x4f,
+	0xb1, 0xa7, 0x08, 0x5c, 0x47, 0x8f, 0xc0, 0x5e, 0x89, 0x44, 0x4c, 0x90, 0x23, 0x2a, 0x15, 0x11,
+	0xc4, 0x87, 0x1b, 0xe8, 0x00, 0x34, 0xae, 0xd5, 0xca, 0xa8, 0xdb, 0xa5, 0x98, 0x12, 0xa6, 0xb4,
+	0x54, 0x5e, 0x8f, 0xc0, 0xcd, 0x1b, 0xdf, 0x62, 0x7c, 0x35, 0xb5, 0x3e, 0xe6, 0xa2, 0x47, 0x84,
+	0x84, 0xff, 0xa1, 0x06, 0x78, 0x78, 0x2d, 0xa3, 0x96, 0x93, 0x6a, 0xe1, 0xb1, 0x1e, 0xf1, 0xe1,
+	0xff, 0x68, 0x1f, 0xec, 0x96, 0xb0, 0x54, 0x5c, 0x10, 0x4d, 0x84, 0xe0, 0x02, 0x6e, 0x21, 0x1b,
+	0xec, 0x97, 0xd8, 0xb1, 0xe0, 0xec, 0x48, 0xfb, 0x34, 0x20, 0x6c, 0xa9, 0x21, 0xb8, 0xb1, 0x00,
+	0xc6, 0x99, 0x0e, 0x38, 0xe3, 0x8a, 0x33, 0x8a, 0xf5, 0xdb, 0xc8, 0x63, 0x8a, 0xf6, 0x89, 0x84,
+	0xdb, 0xe8, 0x09, 0x68, 0xde, 0x5e, 0xa0, 0x0e, 0x05, 0xef, 0x78, 0x1d, 0xda, 0xa7, 0x8a, 0x12,
+	0x09, 0x6b, 0x1d, 0xf1, 0x7d, 0x6e, 0x5b, 0x17, 0x73, 0xdb, 0xfa, 0x39, 0xb7, 0xad, 0xaf, 0x0b,
+	0xbb, 0x72, 0xb1, 0xb0, 0x2b, 0x3f, 0x16, 0x76, 0xe5, 0xfd, 0xeb, 0x0f, 0x69, 0x71, 0x32, 0x1b,
+	0x3a, 0xb1, 0x99, 0xb8, 0x83, 0xf1, 0xd8, 0x4c, 0x07, 0xcf, 0xb3, 0xa4, 0xf8, 0x64, 0xa6, 0x1f,
+	0xff, 0xc8, 0xf8, 0x64, 0x90, 0x66, 0xee, 0xb9, 0xfb, 0xf7, 0x6a, 0x8b, 0xcf, 0xa7, 0x49, 0x3e,
+	0xdc, 0xbc, 0x3c, 0xd7, 0x97, 0xbf, 0x06, 0x00, 0x58, 0xc2, 0x5c, 0x82, 0xcf, 0x02, 0x00, 0x00,
+}
+
+func (m *SubmissionOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintSubmission(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintSubmission(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BundleIndex != 0 {
+		i = encodeVarintSubmission(dAtA, i, uint64(m.BundleIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubmission(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmission(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubmissionOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BundleIndex != 0 {
+		n += 1 + sovSubmission(uint64(m.BundleIndex))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovSubmission(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovSubmission(uint64(m.Code))
+	}
+	return n
+}
+
+func sovSubmission(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmission(x uint64) (n int) {
+	return sovSubmission(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubmissionOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleIndex", wireType)
+			}
+			m.BundleIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= SubmissionOutcomeCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubmission(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubmission
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubmission
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubmission
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubmission
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubmission        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubmission          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubmission = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/subscription.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A consumer's recurring funding of a topic. Every epoch of the topic, the payment
+// is moved from the escrow into the topic's fee revenue until the escrow runs out.
+type Subscription struct {
+	Id               uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Consumer         string                `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	TopicId          uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Escrow           cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=escrow,proto3,customtype=cosmossdk.io/math.Int" json:"escrow"`
+	AmountPerEpoch   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_per_epoch,json=amountPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"amount_per_epoch"`
+	NextPaymentBlock int64                 `protobuf:"varint,6,opt,name=next_payment_block,json=nextPaymentBlock,proto3" json:"next_payment_block,omitempty"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6931243f45e616da, []int{0}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Subscription) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *Subscription) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *Subscription) GetNextPaymentBlock() int64 {
+	if m != nil {
+		return m.NextPaymentBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Subscription)(nil), "emissions.v1.Subscription")
+}
+
+func init() { proto.RegisterFile("emissions/v1/subscription.proto", fileDescriptor_6931243f45e616da) }
+
+var fileDescriptor_6931243f45e616da = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xbf, 0xce, 0xda, 0x30,
+	0x14, 0xc5, 0xe3, 0x40, 0x29, 0xb5, 0x10, 0xa2, 0x56, 0x2b, 0x05, 0x86, 0x80, 0x3a, 0xa1, 0xaa,
+	0xc4, 0x45, 0x5d, 0x3a, 0x23, 0x55, 0x2a, 0x1b, 0x4a, 0x37, 0x96, 0x28, 0x71, 0x2c, 0x62, 0x81,
+	0x7d, 0x23, 0xdb, 0xe1, 0xcf, 0x5b, 0xf4, 0x31, 0xda, 0xad, 0x43, 0x1f, 0x82, 0x11, 0x75, 0xaa,
+	0x3a, 0xa0, 0x0a, 0x86, 0xbe, 0x46, 0x95, 0x84, 0xd2, 0x6f, 0xfe, 0x16, 0xcb, 0xe7, 0xfe, 0xee,
+	0x39, 0xf7, 0x4a, 0x17, 0x0f, 0xb9, 0x14, 0xc6, 0x08, 0x50, 0x86, 0x6e, 0xa7, 0xd4, 0x14, 0x89,
+	0x61, 0x5a, 0xe4, 0x56, 0x80, 0x0a, 0x72, 0x0d, 0x16, 0x48, 0xe7, 0xde, 0x10, 0x6c, 0xa7, 0x83,
+	0x17, 0x2b, 0x58, 0x41, 0x05, 0x68, 0xf9, 0xab, 0x7b, 0x06, 0x7d, 0x06, 0x46, 0x82, 0x89, 0x6a,
+	0x50, 0x8b, 0x1b, 0x7a, 0x1e, 0x4b, 0xa1, 0x80, 0x56, 0x6f, 0x5d, 0x7a, 0xf5, 0xd5, 0xc5, 0x9d,
+	0x4f, 0x0f, 0x06, 0x91, 0x2e, 0x76, 0x45, 0xea, 0xa1, 0x11, 0x1a, 0x37, 0x43, 0x57, 0xa4, 0x64,
+	0x80, 0xdb, 0x0c, 0x94, 0x29, 0x24, 0xd7, 0x9e, 0x3b, 0x42, 0xe3, 0x67, 0xe1, 0x5d, 0x93, 0x3e,
+	0x6e, 0x5b, 0xc8, 0x05, 0x8b, 0x44, 0xea, 0x35, 0x2a, 0xc7, 0xd3, 0x4a, 0xcf, 0x53, 0xf2, 0x11,
+	0xb7, 0xb8, 0x61, 0x1a, 0x76, 0x5e, 0xb3, 0x34, 0xcd, 0xde, 0x1e, 0xcf, 0x43, 0xe7, 0xd7, 0x79,
+	0xf8, 0xb2, 0x5e, 0xc8, 0xa4, 0xeb, 0x40, 0x00, 0x95, 0xb1, 0xcd, 0x82, 0xb9, 0xb2, 0x3f, 0xbe,
+	0x4f, 0xf0, 0x6d, 0xd3, 0xb9, 0xb2, 0x5f, 0xfe, 0x7c, 0x7b, 0x8d, 0xc2, 0x9b, 0x9f, 0x2c, 0x71,
+	0x2f, 0x96, 0x50, 0x28, 0x1b, 0xe5, 0x5c, 0x47, 0x3c, 0x07, 0x96, 0x79, 0x4f, 0x1e, 0x99, 0xd9,
+	0xad, 0x93, 0x16, 0x5c, 0x7f, 0x28, 0x73, 0xc8, 0x1b, 0x4c, 0x14, 0xdf, 0xdb, 0x28, 0x8f, 0x0f,
+	0x92, 0x2b, 0x1b, 0x25, 0x1b, 0x60, 0x6b, 0xaf, 0x35, 0x42, 0xe3, 0x46, 0xd8, 0x2b, 0xc9, 0xa2,
+	0x06, 0xb3, 0xb2, 0x3e, 0x0b, 0x8f, 0x17, 0x1f, 0x9d, 0x2e, 0x3e, 0xfa, 0x7d, 0xf1, 0xd1, 0xe7,
+	0xab, 0xef, 0x9c, 0xae, 0xbe, 0xf3, 0xf3, 0xea, 0x3b, 0xcb, 0xf7, 0x2b, 0x61, 0xb3, 0x22, 0x09,
+	0x18, 0x48, 0x1a, 0x6f, 0x36, 0xa0, 0xe3, 0x89, 0xe2, 0x76, 0x07, 0x7a, 0xfd, 0x4f, 0xb2, 0x2c,
+	0x16, 0x8a, 0xee, 0xe9, 0xff, 0x0b, 0xdb, 0x43, 0xce, 0x4d, 0xd2, 0xaa, 0xce, 0xf0, 0xee, 0xef,
+	0x00, 0x27, 0x03, 0x80, 0x48, 0xfb, 0x01, 0x00, 0x00,
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPaymentBlock != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.NextPaymentBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AmountPerEpoch.Size()
+		i -= size
+		if _, err := m.AmountPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSubscription(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Escrow.Size()
+		i -= size
+		if _, err := m.Escrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSubscription(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TopicId != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubscription(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubscription(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSubscription(uint64(m.Id))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovSubscription(uint64(m.TopicId))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovSubscription(uint64(l))
+	l = m.AmountPerEpoch.Size()
+	n += 1 + l + sovSubscription(uint64(l))
+	if m.NextPaymentBlock != 0 {
+		n += 1 + sovSubscription(uint64(m.NextPaymentBlock))
+	}
+	return n
+}
+
+func sovSubscription(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubscription(x uint64) (n int) {
+	return sovSubscription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentBlock", wireType)
+			}
+			m.NextPaymentBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubscription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubscription
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubscription
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubscription
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubscription        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubscription          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubscription = fmt.Errorf("proto: unexpected end of group")
+)
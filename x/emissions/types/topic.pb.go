@@ -26,6 +26,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// How the network combines the inferences of a topic, given the regret-informed weights of
+// its workers
+type AggregationStrategy int32
+
+const (
+	// Σ_l w_l I_l / Σ_l w_l
+	AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN AggregationStrategy = 0
+	// The inference at which the cumulative weight reaches half of the total weight
+	AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEDIAN AggregationStrategy = 1
+	// Weighted mean once the trim fraction of the total weight is cut off each tail
+	AggregationStrategy_AGGREGATION_TRIMMED_MEAN AggregationStrategy = 2
+	// Weighted mean once the inferences in either tail of the trim fraction of the total weight
+	// are clamped to the edge of that tail
+	AggregationStrategy_AGGREGATION_WINSORIZED_MEAN AggregationStrategy = 3
+)
+
+var AggregationStrategy_name = map[int32]string{
+	0: "AGGREGATION_REGRET_WEIGHTED_MEAN",
+	1: "AGGREGATION_REGRET_WEIGHTED_MEDIAN",
+	2: "AGGREGATION_TRIMMED_MEAN",
+	3: "AGGREGATION_WINSORIZED_MEAN",
+}
+
+var AggregationStrategy_value = map[string]int32{
+	"AGGREGATION_REGRET_WEIGHTED_MEAN":   0,
+	"AGGREGATION_REGRET_WEIGHTED_MEDIAN": 1,
+	"AGGREGATION_TRIMMED_MEAN":           2,
+	"AGGREGATION_WINSORIZED_MEAN":        3,
+}
+
+func (x AggregationStrategy) String() string {
+	return proto.EnumName(AggregationStrategy_name, int32(x))
+}
+
+func (AggregationStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{0}
+}
+
 type Topic struct {
 	Id              uint64                                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Creator         string                                          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -42,6 +80,20 @@ type Topic struct {
 	AlphaRegret     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
 	AllowNegative   bool                                            `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
 	Epsilon         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,15,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	// Length of the inferences of a vector topic, 0 for a topic of scalar inferences.
+	// Fixed at topic creation.
+	InferenceDimension uint64 `protobuf:"varint,16,opt,name=inference_dimension,json=inferenceDimension,proto3" json:"inference_dimension,omitempty"`
+	// Quantile levels, strictly increasing in (0, 1), of a topic whose inferences are quantiles
+	// of a distribution, e.g. 0.05, 0.5, 0.95. The inferences of such a topic carry one value per
+	// level, so its inference dimension is the number of levels.
+	QuantileLevels []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,17,rep,name=quantile_levels,json=quantileLevels,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"quantile_levels"`
+	// Labels of the outcomes of a topic predicting one of several discrete outcomes, e.g. the
+	// candidates of an election. The inferences of such a topic are probability vectors with one
+	// probability per label, so its inference dimension is the number of labels.
+	CategoryLabels      []string            `protobuf:"bytes,18,rep,name=category_labels,json=categoryLabels,proto3" json:"category_labels,omitempty"`
+	AggregationStrategy AggregationStrategy `protobuf:"varint,19,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=emissions.v1.AggregationStrategy" json:"aggregation_strategy,omitempty"`
+	// Fraction of the total weight in each tail, in (0, 0.5), for trimmed and winsorized means
+	AggregationTrimFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,20,opt,name=aggregation_trim_fraction,json=aggregationTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"aggregation_trim_fraction"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -161,6 +213,27 @@ func (m *Topic) GetAllowNegative() bool {
 	return false
 }
 
+func (m *Topic) GetInferenceDimension() uint64 {
+	if m != nil {
+		return m.InferenceDimension
+	}
+	return 0
+}
+
+func (m *Topic) GetCategoryLabels() []string {
+	if m != nil {
+		return m.CategoryLabels
+	}
+	return nil
+}
+
+func (m *Topic) GetAggregationStrategy() AggregationStrategy {
+	if m != nil {
+		return m.AggregationStrategy
+	}
+	return AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN
+}
+
 type TopicList struct {
 	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
@@ -265,54 +338,237 @@ func (m *TimestampedActorNonce) GetNonce() *Nonce {
 	return nil
 }
 
+// Per topic overrides of a subset of the global params, used to tune topics with very
+// different worker counts or loss scales independently. Like OptionalParams, every
+// field is a repeated field with at most one element and an empty field means the
+// global param applies.
+type TopicParamsOverrides struct {
+	PRewardInference          []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,rep,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
+	PRewardForecast           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,rep,name=p_reward_forecast,json=pRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_forecast"`
+	PRewardReputer            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=p_reward_reputer,json=pRewardReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_reputer"`
+	CRewardInference          []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,rep,name=c_reward_inference,json=cRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_inference"`
+	CRewardForecast           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,rep,name=c_reward_forecast,json=cRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_forecast"`
+	CNorm                     []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,rep,name=c_norm,json=cNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_norm"`
+	MaxTopInferersToReward    []uint64                                          `protobuf:"varint,7,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward []uint64                                          `protobuf:"varint,8,rep,packed,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    []uint64                                          `protobuf:"varint,9,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	BetaEntropy               []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,rep,name=beta_entropy,json=betaEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"beta_entropy"`
+	LearningRate              []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,rep,name=learning_rate,json=learningRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"learning_rate"`
+	TaskRewardAlpha           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,rep,name=task_reward_alpha,json=taskRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"task_reward_alpha"`
+	EpsilonReputer            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
+}
+
+func (m *TopicParamsOverrides) Reset()         { *m = TopicParamsOverrides{} }
+func (m *TopicParamsOverrides) String() string { return proto.CompactTextString(m) }
+func (*TopicParamsOverrides) ProtoMessage()    {}
+func (*TopicParamsOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{3}
+}
+func (m *TopicParamsOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicParamsOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicParamsOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicParamsOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicParamsOverrides.Merge(m, src)
+}
+func (m *TopicParamsOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicParamsOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicParamsOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicParamsOverrides proto.InternalMessageInfo
+
+func (m *TopicParamsOverrides) GetMaxTopInferersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopInferersToReward
+	}
+	return nil
+}
+
+func (m *TopicParamsOverrides) GetMaxTopForecastersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopForecastersToReward
+	}
+	return nil
+}
+
+func (m *TopicParamsOverrides) GetMaxTopReputersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopReputersToReward
+	}
+	return nil
+}
+
+// The values of the overridable params that apply to a topic,
+// the override when set and the global param otherwise.
+type EffectiveTopicParams struct {
+	PRewardInference          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
+	PRewardForecast           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=p_reward_forecast,json=pRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_forecast"`
+	PRewardReputer            github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=p_reward_reputer,json=pRewardReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_reputer"`
+	CRewardInference          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=c_reward_inference,json=cRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_inference"`
+	CRewardForecast           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=c_reward_forecast,json=cRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_forecast"`
+	CNorm                     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,opt,name=c_norm,json=cNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_norm"`
+	MaxTopInferersToReward    uint64                                          `protobuf:"varint,7,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward uint64                                          `protobuf:"varint,8,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    uint64                                          `protobuf:"varint,9,opt,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	BetaEntropy               github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,opt,name=beta_entropy,json=betaEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"beta_entropy"`
+	LearningRate              github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,opt,name=learning_rate,json=learningRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"learning_rate"`
+	TaskRewardAlpha           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"task_reward_alpha"`
+	EpsilonReputer            github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
+}
+
+func (m *EffectiveTopicParams) Reset()         { *m = EffectiveTopicParams{} }
+func (m *EffectiveTopicParams) String() string { return proto.CompactTextString(m) }
+func (*EffectiveTopicParams) ProtoMessage()    {}
+func (*EffectiveTopicParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{4}
+}
+func (m *EffectiveTopicParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveTopicParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveTopicParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveTopicParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveTopicParams.Merge(m, src)
+}
+func (m *EffectiveTopicParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveTopicParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveTopicParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveTopicParams proto.InternalMessageInfo
+
+func (m *EffectiveTopicParams) GetMaxTopInferersToReward() uint64 {
+	if m != nil {
+		return m.MaxTopInferersToReward
+	}
+	return 0
+}
+
+func (m *EffectiveTopicParams) GetMaxTopForecastersToReward() uint64 {
+	if m != nil {
+		return m.MaxTopForecastersToReward
+	}
+	return 0
+}
+
+func (m *EffectiveTopicParams) GetMaxTopReputersToReward() uint64 {
+	if m != nil {
+		return m.MaxTopReputersToReward
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("emissions.v1.AggregationStrategy", AggregationStrategy_name, AggregationStrategy_value)
 	proto.RegisterType((*Topic)(nil), "emissions.v1.Topic")
 	proto.RegisterType((*TopicList)(nil), "emissions.v1.TopicList")
 	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v1.TimestampedActorNonce")
+	proto.RegisterType((*TopicParamsOverrides)(nil), "emissions.v1.TopicParamsOverrides")
+	proto.RegisterType((*EffectiveTopicParams)(nil), "emissions.v1.EffectiveTopicParams")
 }
 
 func init() { proto.RegisterFile("emissions/v1/topic.proto", fileDescriptor_ae5610c9d5deb158) }
 
 var fileDescriptor_ae5610c9d5deb158 = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0x75, 0xed, 0x56, 0xb7, 0xeb, 0x86, 0x19, 0x92, 0x99, 0x44, 0x5b, 0x26, 0x21,
-	0x3a, 0x21, 0x12, 0x0d, 0x0e, 0x4c, 0xdc, 0x36, 0x81, 0xc4, 0xa1, 0x54, 0x22, 0xda, 0x69, 0x97,
-	0xc8, 0x73, 0x3c, 0xc7, 0x5a, 0x62, 0x47, 0xb6, 0xbb, 0xc1, 0x91, 0x6f, 0xc0, 0x37, 0x80, 0x8f,
-	0xb3, 0xe3, 0x8e, 0x88, 0xc3, 0x84, 0xb6, 0x0b, 0x1f, 0x03, 0xf9, 0x25, 0x6b, 0x41, 0x5c, 0x90,
-	0x76, 0x89, 0xf2, 0x7e, 0xff, 0x7f, 0xfe, 0x4f, 0x8e, 0xdf, 0x43, 0x84, 0x17, 0xd2, 0x5a, 0xa9,
-	0x95, 0x8d, 0xce, 0x76, 0x23, 0xa7, 0x4b, 0xc9, 0xc2, 0xd2, 0x68, 0xa7, 0x71, 0x6f, 0xae, 0x84,
-	0x67, 0xbb, 0x5b, 0x0f, 0x99, 0xb6, 0x85, 0xb6, 0x09, 0x68, 0x51, 0x55, 0x54, 0xc6, 0xad, 0x7b,
-	0xb4, 0x90, 0x4a, 0x47, 0xf0, 0xac, 0xd1, 0xa6, 0xd0, 0x42, 0x57, 0x56, 0xff, 0x56, 0xd3, 0xbf,
-	0x7b, 0x29, 0xad, 0x18, 0xaf, 0x94, 0xed, 0xaf, 0x2d, 0xd4, 0x3a, 0xf4, 0xbd, 0x71, 0x1f, 0x2d,
-	0xc9, 0x94, 0x04, 0xa3, 0x60, 0xbc, 0x1c, 0x2f, 0xc9, 0x14, 0x13, 0xb4, 0xc2, 0x0c, 0xa7, 0x4e,
-	0x1b, 0xb2, 0x34, 0x0a, 0xc6, 0x9d, 0xf8, 0xb6, 0xc4, 0x5b, 0x68, 0xb5, 0xe0, 0x8e, 0xa6, 0xd4,
-	0x51, 0xd2, 0x04, 0x69, 0x5e, 0xe3, 0x47, 0x08, 0xe5, 0xda, 0xda, 0x24, 0xd7, 0x42, 0x32, 0xb2,
-	0x0c, 0x6a, 0xc7, 0x93, 0x89, 0x07, 0x78, 0x88, 0xba, 0x20, 0x17, 0xdc, 0x65, 0x3a, 0x25, 0x2d,
-	0xd0, 0xe1, 0x8b, 0xf7, 0x40, 0xf0, 0x53, 0xb4, 0x2e, 0xd5, 0x09, 0x37, 0x5c, 0x31, 0x5e, 0x87,
-	0xb4, 0xc1, 0xd4, 0x9f, 0xe3, 0x2a, 0x69, 0x07, 0x6d, 0x2c, 0x8c, 0x75, 0xdc, 0x0a, 0x38, 0x17,
-	0x01, 0x75, 0xe6, 0x18, 0x6d, 0xf0, 0x52, 0xb3, 0x2c, 0xc9, 0xa9, 0x75, 0x09, 0x57, 0x29, 0x4f,
-	0xc9, 0xea, 0x28, 0x18, 0x37, 0xe3, 0x3e, 0xf0, 0x09, 0xb5, 0xee, 0xad, 0xa7, 0xf8, 0x31, 0xea,
-	0xd5, 0x4e, 0xae, 0x84, 0xcb, 0x48, 0x07, 0x5c, 0xdd, 0xca, 0x05, 0xc8, 0x87, 0x09, 0xa3, 0x67,
-	0x2a, 0x4d, 0x9c, 0x99, 0x39, 0x9f, 0x29, 0x08, 0xaa, 0xc2, 0x2a, 0x7e, 0xe8, 0xf1, 0x84, 0x0a,
-	0x7f, 0xd6, 0x94, 0x9f, 0xd0, 0x59, 0xee, 0x12, 0x6a, 0x04, 0xe9, 0x56, 0x67, 0xad, 0xd1, 0xbe,
-	0x11, 0x78, 0x8a, 0xda, 0x65, 0xa2, 0xb4, 0x29, 0x48, 0xcf, 0x6b, 0x07, 0xaf, 0x2e, 0xae, 0x86,
-	0x8d, 0x1f, 0x57, 0xc3, 0x48, 0x48, 0x97, 0xcd, 0x8e, 0x43, 0xa6, 0x8b, 0x88, 0xe6, 0xb9, 0x36,
-	0xf4, 0xb9, 0xe2, 0xee, 0x5c, 0x9b, 0xd3, 0xdb, 0x92, 0x65, 0x54, 0xaa, 0xa8, 0xa0, 0x2e, 0x0b,
-	0xdf, 0x70, 0x16, 0xb7, 0xca, 0xa9, 0x36, 0x05, 0x3e, 0x42, 0x3d, 0x9a, 0x97, 0x19, 0x4d, 0x0c,
-	0x17, 0x86, 0x3b, 0xb2, 0x76, 0xb7, 0xd4, 0x2e, 0x84, 0xc5, 0x90, 0x85, 0x9f, 0xa0, 0xbe, 0x77,
-	0x9d, 0x27, 0x8a, 0x0b, 0xea, 0xe4, 0x19, 0x27, 0xfd, 0x51, 0x30, 0x5e, 0x8d, 0xd7, 0x80, 0x4e,
-	0x6b, 0x88, 0x3f, 0xa0, 0x15, 0x5e, 0x5a, 0x99, 0x6b, 0x45, 0xd6, 0xef, 0xd6, 0xfd, 0x36, 0x67,
-	0x7b, 0x0f, 0x75, 0x60, 0x40, 0x27, 0xd2, 0x3a, 0xfc, 0x0c, 0xb5, 0x61, 0x53, 0x2c, 0x09, 0x46,
-	0xcd, 0x71, 0xf7, 0xc5, 0xfd, 0xf0, 0xcf, 0x5d, 0x09, 0xc1, 0x18, 0xd7, 0x96, 0xed, 0xcf, 0x01,
-	0x7a, 0x70, 0x28, 0x0b, 0x6e, 0x1d, 0x2d, 0x4a, 0x9e, 0xee, 0x33, 0xa7, 0xcd, 0xd4, 0xcf, 0xbe,
-	0xbf, 0xe7, 0xe3, 0x5c, 0xb3, 0xd3, 0x24, 0xe3, 0x52, 0x64, 0x0e, 0xa6, 0xbe, 0x19, 0x77, 0x81,
-	0xbd, 0x03, 0x84, 0x37, 0x51, 0x8b, 0xb2, 0xc5, 0xf0, 0x57, 0x05, 0xde, 0x41, 0x2d, 0xd8, 0x1e,
-	0x98, 0xfb, 0x7f, 0xda, 0x43, 0x78, 0x5c, 0x39, 0x5e, 0x2f, 0xff, 0xfa, 0x36, 0x0c, 0x0e, 0xe2,
-	0x8b, 0xeb, 0x41, 0x70, 0x79, 0x3d, 0x08, 0x7e, 0x5e, 0x0f, 0x82, 0x2f, 0x37, 0x83, 0xc6, 0xe5,
-	0xcd, 0xa0, 0xf1, 0xfd, 0x66, 0xd0, 0x38, 0xda, 0xfb, 0xcf, 0x3f, 0xf2, 0x31, 0x5a, 0x2c, 0xaf,
-	0xfb, 0x54, 0x72, 0x7b, 0xdc, 0x86, 0xd5, 0x7d, 0xf9, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xf0,
-	0x96, 0x68, 0x42, 0x04, 0x00, 0x00,
+	// 1145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0xe3, 0x36,
+	0x17, 0x0d, 0xe3, 0xd8, 0x89, 0x69, 0xc7, 0xf1, 0x30, 0xf9, 0xbe, 0x32, 0x69, 0xc7, 0xf1, 0x04,
+	0xfd, 0xf1, 0xb4, 0xa8, 0x8d, 0x99, 0x2e, 0x3a, 0x98, 0x55, 0x3d, 0x88, 0x93, 0x31, 0xe0, 0x38,
+	0xad, 0x46, 0xc0, 0x00, 0x41, 0x01, 0x96, 0xa1, 0x68, 0x59, 0x88, 0x24, 0xaa, 0x14, 0x9d, 0x9f,
+	0x65, 0xdf, 0xa0, 0x0f, 0xd0, 0x45, 0xd1, 0x45, 0x9f, 0x65, 0x96, 0xb3, 0x2c, 0xba, 0x18, 0x14,
+	0xc9, 0xa6, 0x8f, 0x51, 0x90, 0x92, 0x6c, 0xa7, 0x99, 0x16, 0x2d, 0xd4, 0x64, 0x13, 0x84, 0xe7,
+	0x1e, 0x9e, 0xab, 0x7b, 0x75, 0x79, 0x68, 0x41, 0xcc, 0x03, 0x2f, 0x8e, 0x3d, 0x11, 0xc6, 0x9d,
+	0xd3, 0x47, 0x1d, 0x25, 0x22, 0x8f, 0xb5, 0x23, 0x29, 0x94, 0x40, 0xd5, 0x69, 0xa4, 0x7d, 0xfa,
+	0x68, 0x6b, 0x93, 0x89, 0x38, 0x10, 0x31, 0x31, 0xb1, 0x4e, 0xb2, 0x48, 0x88, 0x5b, 0xf7, 0x68,
+	0xe0, 0x85, 0xa2, 0x63, 0xfe, 0xa6, 0xd0, 0x86, 0x2b, 0x5c, 0x91, 0x50, 0xf5, 0x7f, 0x29, 0x7a,
+	0x3d, 0x57, 0x28, 0x42, 0xc6, 0x93, 0xc8, 0xce, 0xcf, 0x2b, 0xb0, 0x68, 0xeb, 0xdc, 0xa8, 0x06,
+	0x17, 0x3d, 0x07, 0x83, 0x26, 0x68, 0x2d, 0x59, 0x8b, 0x9e, 0x83, 0x30, 0x5c, 0x66, 0x92, 0x53,
+	0x25, 0x24, 0x5e, 0x6c, 0x82, 0x56, 0xd9, 0xca, 0x96, 0x68, 0x0b, 0xae, 0x04, 0x5c, 0x51, 0x87,
+	0x2a, 0x8a, 0x0b, 0x26, 0x34, 0x5d, 0xa3, 0xfb, 0x10, 0xfa, 0x22, 0x8e, 0x89, 0x2f, 0x5c, 0x8f,
+	0xe1, 0x25, 0x13, 0x2d, 0x6b, 0x64, 0xa0, 0x01, 0xb4, 0x0d, 0x2b, 0x26, 0x1c, 0x70, 0x35, 0x16,
+	0x0e, 0x2e, 0x9a, 0xb8, 0xd9, 0x71, 0x60, 0x10, 0xf4, 0x11, 0x5c, 0xf3, 0xc2, 0x11, 0x97, 0x3c,
+	0x64, 0x3c, 0x15, 0x29, 0x19, 0x52, 0x6d, 0x0a, 0x27, 0x4a, 0x0f, 0x61, 0x7d, 0x46, 0x4c, 0xe5,
+	0x96, 0x0d, 0x73, 0x26, 0x90, 0x6a, 0xb6, 0x60, 0x9d, 0x47, 0x82, 0x8d, 0x89, 0x4f, 0x63, 0x45,
+	0x78, 0xe8, 0x70, 0x07, 0xaf, 0x34, 0x41, 0xab, 0x60, 0xd5, 0x0c, 0x3e, 0xa0, 0xb1, 0xea, 0x69,
+	0x14, 0x3d, 0x80, 0xd5, 0x94, 0xc9, 0x43, 0x57, 0x8d, 0x71, 0xd9, 0xb0, 0x2a, 0x09, 0xcb, 0x40,
+	0x5a, 0xcc, 0x95, 0x62, 0x12, 0x3a, 0x44, 0xc9, 0x89, 0xd2, 0x9a, 0x2e, 0x86, 0x89, 0x58, 0x82,
+	0xdb, 0x1a, 0x1e, 0x50, 0x57, 0xd7, 0xea, 0xf0, 0x11, 0x9d, 0xf8, 0x8a, 0x50, 0xe9, 0xe2, 0x4a,
+	0x52, 0x6b, 0x0a, 0x75, 0xa5, 0x8b, 0x86, 0xb0, 0x14, 0x91, 0x50, 0xc8, 0x00, 0x57, 0x75, 0xec,
+	0xd9, 0xe7, 0xaf, 0xde, 0x6c, 0x2f, 0xfc, 0xfa, 0x66, 0xbb, 0xe3, 0x7a, 0x6a, 0x3c, 0x39, 0x6e,
+	0x33, 0x11, 0x74, 0xa8, 0xef, 0x0b, 0x49, 0x3f, 0x0d, 0xb9, 0x3a, 0x13, 0xf2, 0x24, 0x5b, 0xb2,
+	0x31, 0xf5, 0xc2, 0x4e, 0x40, 0xd5, 0xb8, 0xbd, 0xcb, 0x99, 0x55, 0x8c, 0x86, 0x42, 0x06, 0xe8,
+	0x08, 0x56, 0xa9, 0x1f, 0x8d, 0x29, 0x91, 0xdc, 0x95, 0x5c, 0xe1, 0xd5, 0x7c, 0xaa, 0x15, 0x23,
+	0x66, 0x19, 0x2d, 0xf4, 0x01, 0xac, 0x69, 0xd6, 0x19, 0x09, 0xb9, 0x4b, 0x95, 0x77, 0xca, 0x71,
+	0xad, 0x09, 0x5a, 0x2b, 0xd6, 0xaa, 0x41, 0x87, 0x29, 0x88, 0xbe, 0x82, 0xcb, 0x3c, 0x8a, 0x3d,
+	0x5f, 0x84, 0x78, 0x2d, 0x5f, 0xf6, 0x4c, 0x07, 0x75, 0xe0, 0xfa, 0xec, 0x45, 0x3b, 0x5e, 0xc0,
+	0x43, 0x3d, 0xc8, 0xb8, 0x6e, 0x06, 0x15, 0x4d, 0x43, 0xbb, 0x59, 0x04, 0x7d, 0x03, 0xd7, 0xbe,
+	0x9d, 0xd0, 0x50, 0x79, 0x3e, 0x27, 0x3e, 0x3f, 0xe5, 0x7e, 0x8c, 0xef, 0x35, 0x0b, 0x79, 0x9e,
+	0xa5, 0x96, 0xe9, 0x0d, 0x8c, 0x9c, 0x1e, 0x52, 0x46, 0x15, 0x77, 0x85, 0xbc, 0x20, 0x3e, 0x3d,
+	0xd6, 0x19, 0x90, 0xce, 0x60, 0xd5, 0x32, 0x78, 0x60, 0x50, 0x64, 0xc3, 0x0d, 0xea, 0xba, 0xd2,
+	0x74, 0x47, 0x84, 0x24, 0x56, 0x52, 0xc7, 0x2f, 0xf0, 0x7a, 0x13, 0xb4, 0x6a, 0x8f, 0x1f, 0xb4,
+	0xe7, 0x0f, 0x7a, 0xbb, 0x3b, 0x63, 0xbe, 0x48, 0x89, 0xd6, 0x3a, 0xbd, 0x09, 0xa2, 0x18, 0x6e,
+	0xce, 0xab, 0x2a, 0xe9, 0x05, 0x64, 0x24, 0x29, 0xd3, 0x2b, 0xbc, 0x91, 0xaf, 0xed, 0xef, 0xcc,
+	0x29, 0xdb, 0xd2, 0x0b, 0xf6, 0x52, 0xdd, 0x9d, 0x27, 0xb0, 0x6c, 0x7c, 0x62, 0xe0, 0xc5, 0x0a,
+	0x7d, 0x02, 0x4b, 0xc6, 0xb0, 0x62, 0x0c, 0x9a, 0x85, 0x56, 0xe5, 0xf1, 0xfa, 0xf5, 0x4a, 0x0c,
+	0xd1, 0x4a, 0x29, 0x3b, 0xdf, 0x01, 0xf8, 0x3f, 0xdb, 0x0b, 0x78, 0xac, 0x68, 0x10, 0x71, 0xa7,
+	0xcb, 0x94, 0x90, 0x43, 0x6d, 0x41, 0xfa, 0xb8, 0x1d, 0xfb, 0x82, 0x9d, 0x90, 0x31, 0xf7, 0xdc,
+	0xb1, 0x32, 0xe6, 0x53, 0xb0, 0x2a, 0x06, 0x7b, 0x6e, 0x20, 0xb4, 0x01, 0x8b, 0x94, 0xcd, 0x3c,
+	0x28, 0x59, 0xa0, 0x87, 0xb0, 0x68, 0x4c, 0xcc, 0xd8, 0xcf, 0x8d, 0xf4, 0x46, 0xdc, 0x4a, 0x18,
+	0x4f, 0x97, 0x7e, 0xff, 0x71, 0x1b, 0xec, 0xfc, 0x50, 0x86, 0x1b, 0xe6, 0xa9, 0xbe, 0xa4, 0x92,
+	0x06, 0xf1, 0xe1, 0x29, 0x97, 0xd2, 0x73, 0x78, 0x8c, 0x38, 0x44, 0x11, 0x91, 0xfc, 0x8c, 0x4a,
+	0x87, 0x4c, 0x67, 0xc9, 0x54, 0x95, 0xa3, 0x89, 0xf5, 0xc8, 0x32, 0x8a, 0xfd, 0x4c, 0x10, 0x31,
+	0x78, 0x6f, 0x9a, 0x66, 0x24, 0x24, 0x67, 0x34, 0x56, 0x78, 0x31, 0x5f, 0x96, 0xb5, 0x34, 0xcb,
+	0x5e, 0xaa, 0x87, 0x28, 0xac, 0x4f, 0x93, 0x48, 0x1e, 0x4d, 0x14, 0x97, 0xb8, 0x90, 0x73, 0xf2,
+	0xd3, 0x1c, 0x56, 0x22, 0xa7, 0xdb, 0xc5, 0x6e, 0xb6, 0x6b, 0x29, 0x67, 0xbb, 0xd8, 0x5b, 0xda,
+	0xc5, 0x6e, 0xb4, 0xab, 0x98, 0xb3, 0x5d, 0xec, 0x4f, 0xed, 0x1a, 0xc2, 0x12, 0x4b, 0xec, 0xb7,
+	0x94, 0x4f, 0xb9, 0xc8, 0x8c, 0xfd, 0x3e, 0x85, 0x5b, 0x01, 0x3d, 0x27, 0x4a, 0x44, 0x69, 0x6b,
+	0x64, 0x4c, 0x94, 0x48, 0xcb, 0xc0, 0xcb, 0xcd, 0x42, 0x6b, 0xc9, 0xfa, 0x7f, 0x40, 0xcf, 0x6d,
+	0x11, 0xf5, 0xd3, 0xb8, 0x2d, 0x92, 0x67, 0x42, 0x5f, 0xc0, 0xfb, 0xd9, 0xde, 0xac, 0xde, 0xeb,
+	0xdb, 0x57, 0xcc, 0xf6, 0xcd, 0x64, 0xfb, 0xde, 0x8c, 0x32, 0x55, 0x98, 0xcb, 0x9e, 0xbe, 0xfb,
+	0xf9, 0xed, 0xe5, 0xf9, 0xec, 0xe9, 0xcb, 0x9c, 0xed, 0x3d, 0x82, 0xd5, 0x63, 0xae, 0x28, 0xe1,
+	0xa1, 0x92, 0x22, 0xba, 0xc0, 0x30, 0x5f, 0x3f, 0x2a, 0x5a, 0xac, 0x97, 0x68, 0xa1, 0xaf, 0xe1,
+	0xaa, 0xcf, 0xa9, 0x0c, 0xbd, 0xd0, 0x25, 0x92, 0x2a, 0x8e, 0x2b, 0xf9, 0xc4, 0xab, 0x99, 0x9a,
+	0x45, 0x95, 0x19, 0x14, 0x45, 0xe3, 0x93, 0x6c, 0x56, 0xcc, 0x8d, 0x85, 0xab, 0x39, 0x07, 0x45,
+	0x2b, 0x26, 0x9d, 0xe9, 0x6a, 0x3d, 0x7d, 0xa1, 0xa4, 0x97, 0xd1, 0xf4, 0x58, 0xad, 0xe6, 0x3c,
+	0x56, 0xa9, 0x5e, 0xfa, 0x26, 0x8c, 0x3d, 0xf5, 0x46, 0x23, 0xce, 0xf4, 0x25, 0x3a, 0xe7, 0x53,
+	0x7f, 0x69, 0x4f, 0xe0, 0x4e, 0xec, 0x09, 0xdc, 0x81, 0x3d, 0x81, 0xbb, 0xb0, 0x27, 0x70, 0x27,
+	0xf6, 0x04, 0x6e, 0xcd, 0x9e, 0xc0, 0x1d, 0xd8, 0x13, 0xc8, 0x67, 0x4f, 0x20, 0x9f, 0x3d, 0x81,
+	0x7f, 0x65, 0x4f, 0xe0, 0x36, 0xed, 0x09, 0xdc, 0xba, 0x3d, 0x81, 0xdb, 0xb7, 0x27, 0xf0, 0x1f,
+	0xda, 0xd3, 0xc7, 0x3f, 0x01, 0xb8, 0xfe, 0x96, 0x5f, 0xa7, 0xe8, 0x7d, 0xd8, 0xec, 0xee, 0xef,
+	0x5b, 0xbd, 0xfd, 0xae, 0xdd, 0x3f, 0x1c, 0x12, 0xab, 0xb7, 0x6f, 0xf5, 0x6c, 0xf2, 0xb2, 0xd7,
+	0xdf, 0x7f, 0x6e, 0xf7, 0x76, 0xc9, 0x41, 0xaf, 0x3b, 0xac, 0x2f, 0xa0, 0x0f, 0xe1, 0xce, 0xdf,
+	0xb3, 0x76, 0xfb, 0xdd, 0x61, 0x1d, 0xa0, 0xf7, 0x20, 0x9e, 0xe7, 0xd9, 0x56, 0xff, 0xe0, 0x20,
+	0x53, 0x59, 0x44, 0xdb, 0xf0, 0xdd, 0xf9, 0xe8, 0xcb, 0xfe, 0xf0, 0xc5, 0xa1, 0xd5, 0x3f, 0xca,
+	0x08, 0x85, 0x67, 0xd6, 0xab, 0xcb, 0x06, 0x78, 0x7d, 0xd9, 0x00, 0xbf, 0x5d, 0x36, 0xc0, 0xf7,
+	0x57, 0x8d, 0x85, 0xd7, 0x57, 0x8d, 0x85, 0x5f, 0xae, 0x1a, 0x0b, 0x47, 0x4f, 0xfe, 0x61, 0xfd,
+	0xe7, 0x9d, 0xd9, 0x67, 0xb2, 0xba, 0x88, 0x78, 0x7c, 0x5c, 0x32, 0x1f, 0xc9, 0x9f, 0xfd, 0x31,
+	0x00, 0xd2, 0x14, 0x45, 0xf4, 0xac, 0x0f, 0x00, 0x00,
 }
 
 func (this *TimestampedActorNonce) Equal(that interface{}) bool {
@@ -365,6 +621,59 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AggregationTrimFraction.Size()
+		i -= size
+		if _, err := m.AggregationTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.AggregationStrategy != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.AggregationStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.CategoryLabels) > 0 {
+		for iNdEx := len(m.CategoryLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CategoryLabels[iNdEx])
+			copy(dAtA[i:], m.CategoryLabels[iNdEx])
+			i = encodeVarintTopic(dAtA, i, uint64(len(m.CategoryLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.QuantileLevels) > 0 {
+		for iNdEx := len(m.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.QuantileLevels[iNdEx].Size()
+				i -= size
+				if _, err := m.QuantileLevels[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.InferenceDimension != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.InferenceDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	{
 		size := m.Epsilon.Size()
 		i -= size
@@ -561,65 +870,420 @@ func (m *TimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTopic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TopicParamsOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Topic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TopicParamsOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicParamsOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTopic(uint64(m.Id))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.EpsilonReputer) > 0 {
+		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	l = len(m.LossLogic)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.TaskRewardAlpha) > 0 {
+		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TaskRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.LearningRate) > 0 {
+		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LearningRate[iNdEx].Size()
+				i -= size
+				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	l = len(m.InferenceLogic)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.BetaEntropy) > 0 {
+		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BetaEntropy[iNdEx].Size()
+				i -= size
+				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.InferenceMethod)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA3 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j2 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTopic(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		dAtA5 := make([]byte, len(m.MaxTopForecastersToReward)*10)
+		var j4 int
+		for _, num := range m.MaxTopForecastersToReward {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTopic(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA7 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j6 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTopic(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.EpochLastEnded != 0 {
-		n += 1 + sovTopic(uint64(m.EpochLastEnded))
+	if len(m.CNorm) > 0 {
+		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTopic(uint64(m.EpochLength))
+	if len(m.CRewardForecast) > 0 {
+		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.GroundTruthLag != 0 {
-		n += 1 + sovTopic(uint64(m.GroundTruthLag))
+	if len(m.CRewardInference) > 0 {
+		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.DefaultArg)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.PRewardReputer) > 0 {
+		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.PNorm.Size()
-	n += 1 + l + sovTopic(uint64(l))
+	if len(m.PRewardForecast) > 0 {
+		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PRewardInference) > 0 {
+		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveTopicParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveTopicParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveTopicParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpsilonReputer.Size()
+		i -= size
+		if _, err := m.EpsilonReputer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TaskRewardAlpha.Size()
+		i -= size
+		if _, err := m.TaskRewardAlpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.BetaEntropy.Size()
+		i -= size
+		if _, err := m.BetaEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MaxTopReputersToReward != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.MaxTopReputersToReward))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxTopForecastersToReward != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.MaxTopForecastersToReward))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxTopInferersToReward != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.MaxTopInferersToReward))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CNorm.Size()
+		i -= size
+		if _, err := m.CNorm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CRewardForecast.Size()
+		i -= size
+		if _, err := m.CRewardForecast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CRewardInference.Size()
+		i -= size
+		if _, err := m.CRewardInference.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PRewardReputer.Size()
+		i -= size
+		if _, err := m.PRewardReputer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PRewardForecast.Size()
+		i -= size
+		if _, err := m.PRewardForecast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PRewardInference.Size()
+		i -= size
+		if _, err := m.PRewardInference.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Topic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossLogic)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.InferenceLogic)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.InferenceMethod)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.EpochLastEnded != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLastEnded))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTopic(uint64(m.GroundTruthLag))
+	}
+	l = len(m.DefaultArg)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = m.PNorm.Size()
+	n += 1 + l + sovTopic(uint64(l))
 	l = m.AlphaRegret.Size()
 	n += 1 + l + sovTopic(uint64(l))
 	if m.AllowNegative {
@@ -627,6 +1291,26 @@ func (m *Topic) Size() (n int) {
 	}
 	l = m.Epsilon.Size()
 	n += 1 + l + sovTopic(uint64(l))
+	if m.InferenceDimension != 0 {
+		n += 2 + sovTopic(uint64(m.InferenceDimension))
+	}
+	if len(m.QuantileLevels) > 0 {
+		for _, e := range m.QuantileLevels {
+			l = e.Size()
+			n += 2 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.CategoryLabels) > 0 {
+		for _, s := range m.CategoryLabels {
+			l = len(s)
+			n += 2 + l + sovTopic(uint64(l))
+		}
+	}
+	if m.AggregationStrategy != 0 {
+		n += 2 + sovTopic(uint64(m.AggregationStrategy))
+	}
+	l = m.AggregationTrimFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
 	return n
 }
 
@@ -642,36 +1326,997 @@ func (m *TopicList) Size() (n int) {
 			n += 1 + l + sovTopic(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *TimestampedActorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTopic(uint64(m.BlockHeight))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicParamsOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PRewardInference) > 0 {
+		for _, e := range m.PRewardInference {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for _, e := range m.PRewardForecast {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for _, e := range m.PRewardReputer {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for _, e := range m.CRewardInference {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for _, e := range m.CRewardForecast {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for _, e := range m.CNorm {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopForecastersToReward {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.BetaEntropy) > 0 {
+		for _, e := range m.BetaEntropy {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for _, e := range m.LearningRate {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for _, e := range m.TaskRewardAlpha {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for _, e := range m.EpsilonReputer {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EffectiveTopicParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PRewardInference.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.PRewardForecast.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.PRewardReputer.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.CRewardInference.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.CRewardForecast.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.CNorm.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.MaxTopInferersToReward != 0 {
+		n += 1 + sovTopic(uint64(m.MaxTopInferersToReward))
+	}
+	if m.MaxTopForecastersToReward != 0 {
+		n += 1 + sovTopic(uint64(m.MaxTopForecastersToReward))
+	}
+	if m.MaxTopReputersToReward != 0 {
+		n += 1 + sovTopic(uint64(m.MaxTopReputersToReward))
+	}
+	l = m.BetaEntropy.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.TaskRewardAlpha.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.EpsilonReputer.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	return n
+}
+
+func sovTopic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopic(x uint64) (n int) {
+	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Topic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
+			}
+			m.EpochLastEnded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLastEnded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultArg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNegative = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceDimension", wireType)
+			}
+			m.InferenceDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InferenceDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.QuantileLevels = append(m.QuantileLevels, v)
+			if err := m.QuantileLevels[len(m.QuantileLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryLabels = append(m.CategoryLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
+			}
+			m.AggregationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationStrategy |= AggregationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationTrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregationTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &Topic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *TimestampedActorNonce) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTopic(uint64(m.BlockHeight))
-	}
-	l = len(m.Actor)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	if m.Nonce != nil {
-		l = m.Nonce.Size()
-		n += 1 + l + sovTopic(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTopic(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTopic(x uint64) (n int) {
-	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Topic) Unmarshal(dAtA []byte) error {
+func (m *TopicParamsOverrides) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -694,17 +2339,17 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicParamsOverrides: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicParamsOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -714,14 +2359,31 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardInference = append(m.PRewardInference, v)
+			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -749,11 +2411,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardForecast = append(m.PRewardForecast, v)
+			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -781,11 +2447,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardReputer = append(m.PRewardReputer, v)
+			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -813,11 +2483,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardInference = append(m.CRewardInference, v)
+			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -845,11 +2519,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardForecast = append(m.CRewardForecast, v)
+			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -877,11 +2555,243 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CNorm = append(m.CNorm, v)
+			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
+					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -909,13 +2819,17 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BetaEntropy = append(m.BetaEntropy, v)
+			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
 			}
-			m.EpochLastEnded = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -925,16 +2839,33 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLastEnded |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
 			}
-			m.EpochLength = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.LearningRate = append(m.LearningRate, v)
+			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -944,16 +2875,119 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
+			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonReputer = append(m.EpsilonReputer, v)
+			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveTopicParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveTopicParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
 			}
-			m.GroundTruthLag = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -963,14 +2997,29 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PRewardInference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,11 +3047,13 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultArg = string(dAtA[iNdEx:postIndex])
+			if err := m.PRewardForecast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1030,13 +3081,13 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PRewardReputer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1064,15 +3115,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CRewardInference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1082,15 +3133,29 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowNegative = bool(v != 0)
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CRewardForecast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1118,65 +3183,72 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTopic(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTopic
+			m.MaxTopInferersToReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopInferersToReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TopicList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTopic
+			m.MaxTopForecastersToReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopForecastersToReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxTopReputersToReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopReputersToReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TopicList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1186,81 +3258,31 @@ func (m *TopicList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &Topic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BetaEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTopic(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTopic
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1270,14 +3292,29 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,13 +3342,15 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actor = string(dAtA[iNdEx:postIndex])
+			if err := m.TaskRewardAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1321,25 +3360,23 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
-			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpsilonReputer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// ResolveTopicParams returns the params that apply to a topic, the override when
+// one is set and the global param otherwise.
+func ResolveTopicParams(params Params, overrides TopicParamsOverrides) EffectiveTopicParams {
+	return EffectiveTopicParams{
+		PRewardInference:          override(params.PRewardInference, overrides.PRewardInference),
+		PRewardForecast:           override(params.PRewardForecast, overrides.PRewardForecast),
+		PRewardReputer:            override(params.PRewardReputer, overrides.PRewardReputer),
+		CRewardInference:          override(params.CRewardInference, overrides.CRewardInference),
+		CRewardForecast:           override(params.CRewardForecast, overrides.CRewardForecast),
+		CNorm:                     override(params.CNorm, overrides.CNorm),
+		MaxTopInferersToReward:    override(params.MaxTopInferersToReward, overrides.MaxTopInferersToReward),
+		MaxTopForecastersToReward: override(params.MaxTopForecastersToReward, overrides.MaxTopForecastersToReward),
+		MaxTopReputersToReward:    override(params.MaxTopReputersToReward, overrides.MaxTopReputersToReward),
+		BetaEntropy:               override(params.BetaEntropy, overrides.BetaEntropy),
+		LearningRate:              override(params.LearningRate, overrides.LearningRate),
+		TaskRewardAlpha:           override(params.TaskRewardAlpha, overrides.TaskRewardAlpha),
+		EpsilonReputer:            override(params.EpsilonReputer, overrides.EpsilonReputer),
+	}
+}
+
+func override[T any](global T, values []T) T {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return global
+}
+
+// Validate checks every override holds at most one value, and that the value
+// passes the same validation as the global param.
+func (o TopicParamsOverrides) Validate() error {
+	if err := validateOverride("p_reward_inference", o.PRewardInference, validatePRewardInference); err != nil {
+		return err
+	}
+	if err := validateOverride("p_reward_forecast", o.PRewardForecast, validatePRewardForecast); err != nil {
+		return err
+	}
+	if err := validateOverride("p_reward_reputer", o.PRewardReputer, validatePRewardReputer); err != nil {
+		return err
+	}
+	if err := validateOverride("c_reward_inference", o.CRewardInference, validateCRewardInference); err != nil {
+		return err
+	}
+	if err := validateOverride("c_reward_forecast", o.CRewardForecast, validateCRewardForecast); err != nil {
+		return err
+	}
+	if err := validateOverride("c_norm", o.CNorm, validateCNorm); err != nil {
+		return err
+	}
+	if err := validateOverride("max_top_inferers_to_reward", o.MaxTopInferersToReward, validateMaxTopInferersToReward); err != nil {
+		return err
+	}
+	if err := validateOverride("max_top_forecasters_to_reward", o.MaxTopForecastersToReward, validateMaxTopForecastersToReward); err != nil {
+		return err
+	}
+	if err := validateOverride("max_top_reputers_to_reward", o.MaxTopReputersToReward, validateMaxTopReputersToReward); err != nil {
+		return err
+	}
+	if err := validateOverride("beta_entropy", o.BetaEntropy, validateBetaEntropy); err != nil {
+		return err
+	}
+	if err := validateOverride("learning_rate", o.LearningRate, validateLearningRate); err != nil {
+		return err
+	}
+	if err := validateOverride("task_reward_alpha", o.TaskRewardAlpha, validateTaskRewardAlpha); err != nil {
+		return err
+	}
+	if err := validateOverride("epsilon_reputer", o.EpsilonReputer, validateEpsilonReputer); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateOverride[T any](name string, values []T, validate func(T) error) error {
+	if len(values) > 1 {
+		return errors.Wrapf(ErrInvalidValue, "%s override has %d values, expected at most one", name, len(values))
+	}
+	for _, value := range values {
+		if err := validate(value); err != nil {
+			return errors.Wrapf(err, "%s override", name)
+		}
+	}
+	return nil
+}
@@ -57,29 +57,41 @@ type OptionalParams struct {
 	TopicRewardFeeRevenueImportance []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,rep,name=topic_reward_fee_revenue_importance,json=topicRewardFeeRevenueImportance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_reward_fee_revenue_importance"`
 	TopicRewardAlpha                []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,17,rep,name=topic_reward_alpha,json=topicRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_reward_alpha"`
 	TaskRewardAlpha                 []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,18,rep,name=task_reward_alpha,json=taskRewardAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"task_reward_alpha"`
-	ValidatorsVsAlloraPercentReward []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,rep,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"validators_vs_allora_percent_reward"`
-	MaxSamplesToScaleScores         []uint64                                          `protobuf:"varint,20,rep,packed,name=max_samples_to_scale_scores,json=maxSamplesToScaleScores,proto3" json:"max_samples_to_scale_scores,omitempty"`
-	MaxTopInferersToReward          []uint64                                          `protobuf:"varint,21,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
-	MaxTopForecastersToReward       []uint64                                          `protobuf:"varint,22,rep,packed,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
-	MaxTopReputersToReward          []uint64                                          `protobuf:"varint,23,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
-	CreateTopicFee                  []cosmossdk_io_math.Int                           `protobuf:"bytes,24,rep,name=create_topic_fee,json=createTopicFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_topic_fee"`
-	GradientDescentMaxIters         []uint64                                          `protobuf:"varint,25,rep,packed,name=gradient_descent_max_iters,json=gradientDescentMaxIters,proto3" json:"gradient_descent_max_iters,omitempty"`
-	MaxRetriesToFulfilNoncesWorker  []int64                                           `protobuf:"varint,26,rep,packed,name=max_retries_to_fulfil_nonces_worker,json=maxRetriesToFulfilNoncesWorker,proto3" json:"max_retries_to_fulfil_nonces_worker,omitempty"`
-	MaxRetriesToFulfilNoncesReputer []int64                                           `protobuf:"varint,27,rep,packed,name=max_retries_to_fulfil_nonces_reputer,json=maxRetriesToFulfilNoncesReputer,proto3" json:"max_retries_to_fulfil_nonces_reputer,omitempty"`
-	RegistrationFee                 []cosmossdk_io_math.Int                           `protobuf:"bytes,28,rep,name=registration_fee,json=registrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"registration_fee"`
-	DefaultPageLimit                []uint64                                          `protobuf:"varint,29,rep,packed,name=default_page_limit,json=defaultPageLimit,proto3" json:"default_page_limit,omitempty"`
-	MaxPageLimit                    []uint64                                          `protobuf:"varint,30,rep,packed,name=max_page_limit,json=maxPageLimit,proto3" json:"max_page_limit,omitempty"`
-	MinEpochLengthRecordLimit       []int64                                           `protobuf:"varint,31,rep,packed,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
-	BlocksPerMonth                  []uint64                                          `protobuf:"varint,32,rep,packed,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
-	PRewardInference                []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,33,rep,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
-	PRewardForecast                 []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,34,rep,name=p_reward_forecast,json=pRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_forecast"`
-	PRewardReputer                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,35,rep,name=p_reward_reputer,json=pRewardReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_reputer"`
-	CRewardInference                []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,36,rep,name=c_reward_inference,json=cRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_inference"`
-	CRewardForecast                 []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,37,rep,name=c_reward_forecast,json=cRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_forecast"`
-	CNorm                           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,38,rep,name=c_norm,json=cNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_norm"`
-	TopicFeeRevenueDecayRate        []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,39,rep,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_fee_revenue_decay_rate"`
-	EpsilonReputer                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,rep,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
-	MinEffectiveTopicRevenue        []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,41,rep,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"min_effective_topic_revenue"`
+	// Deprecated: rejected, see Params.validators_vs_allora_percent_reward
+	ValidatorsVsAlloraPercentReward  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,rep,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"validators_vs_allora_percent_reward"` // Deprecated: Do not use.
+	MaxSamplesToScaleScores          []uint64                                          `protobuf:"varint,20,rep,packed,name=max_samples_to_scale_scores,json=maxSamplesToScaleScores,proto3" json:"max_samples_to_scale_scores,omitempty"`
+	MaxTopInferersToReward           []uint64                                          `protobuf:"varint,21,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward        []uint64                                          `protobuf:"varint,22,rep,packed,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward           []uint64                                          `protobuf:"varint,23,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	CreateTopicFee                   []cosmossdk_io_math.Int                           `protobuf:"bytes,24,rep,name=create_topic_fee,json=createTopicFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_topic_fee"`
+	GradientDescentMaxIters          []uint64                                          `protobuf:"varint,25,rep,packed,name=gradient_descent_max_iters,json=gradientDescentMaxIters,proto3" json:"gradient_descent_max_iters,omitempty"`
+	MaxRetriesToFulfilNoncesWorker   []int64                                           `protobuf:"varint,26,rep,packed,name=max_retries_to_fulfil_nonces_worker,json=maxRetriesToFulfilNoncesWorker,proto3" json:"max_retries_to_fulfil_nonces_worker,omitempty"`
+	MaxRetriesToFulfilNoncesReputer  []int64                                           `protobuf:"varint,27,rep,packed,name=max_retries_to_fulfil_nonces_reputer,json=maxRetriesToFulfilNoncesReputer,proto3" json:"max_retries_to_fulfil_nonces_reputer,omitempty"`
+	RegistrationFee                  []cosmossdk_io_math.Int                           `protobuf:"bytes,28,rep,name=registration_fee,json=registrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"registration_fee"`
+	DefaultPageLimit                 []uint64                                          `protobuf:"varint,29,rep,packed,name=default_page_limit,json=defaultPageLimit,proto3" json:"default_page_limit,omitempty"`
+	MaxPageLimit                     []uint64                                          `protobuf:"varint,30,rep,packed,name=max_page_limit,json=maxPageLimit,proto3" json:"max_page_limit,omitempty"`
+	MinEpochLengthRecordLimit        []int64                                           `protobuf:"varint,31,rep,packed,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
+	BlocksPerMonth                   []uint64                                          `protobuf:"varint,32,rep,packed,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
+	PRewardInference                 []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,33,rep,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
+	PRewardForecast                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,34,rep,name=p_reward_forecast,json=pRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_forecast"`
+	PRewardReputer                   []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,35,rep,name=p_reward_reputer,json=pRewardReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_reputer"`
+	CRewardInference                 []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,36,rep,name=c_reward_inference,json=cRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_inference"`
+	CRewardForecast                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,37,rep,name=c_reward_forecast,json=cRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_forecast"`
+	CNorm                            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,38,rep,name=c_norm,json=cNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_norm"`
+	TopicFeeRevenueDecayRate         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,39,rep,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_fee_revenue_decay_rate"`
+	EpsilonReputer                   []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,rep,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
+	MinEffectiveTopicRevenue         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,41,rep,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"min_effective_topic_revenue"`
+	TopicRevenueBurnFraction         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,42,rep,name=topic_revenue_burn_fraction,json=topicRevenueBurnFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_revenue_burn_fraction"`
+	MinParamChangeDelay              []int64                                           `protobuf:"varint,43,rep,packed,name=min_param_change_delay,json=minParamChangeDelay,proto3" json:"min_param_change_delay,omitempty"`
+	MaxOpenInferenceRequestsPerTopic []uint64                                          `protobuf:"varint,44,rep,packed,name=max_open_inference_requests_per_topic,json=maxOpenInferenceRequestsPerTopic,proto3" json:"max_open_inference_requests_per_topic,omitempty"`
+	MaxStakeRemovalGasPerBlock       []uint64                                          `protobuf:"varint,45,rep,packed,name=max_stake_removal_gas_per_block,json=maxStakeRemovalGasPerBlock,proto3" json:"max_stake_removal_gas_per_block,omitempty"`
+	MaxRewardGasPerBlock             []uint64                                          `protobuf:"varint,46,rep,packed,name=max_reward_gas_per_block,json=maxRewardGasPerBlock,proto3" json:"max_reward_gas_per_block,omitempty"`
+	MaxPruningGasPerBlock            []uint64                                          `protobuf:"varint,47,rep,packed,name=max_pruning_gas_per_block,json=maxPruningGasPerBlock,proto3" json:"max_pruning_gas_per_block,omitempty"`
+	MaxInferenceDimension            []uint64                                          `protobuf:"varint,48,rep,packed,name=max_inference_dimension,json=maxInferenceDimension,proto3" json:"max_inference_dimension,omitempty"`
+	ProbabilitySumTolerance          []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,rep,name=probability_sum_tolerance,json=probabilitySumTolerance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"probability_sum_tolerance"`
+	MaxRegretHistoryLength           []uint64                                          `protobuf:"varint,50,rep,packed,name=max_regret_history_length,json=maxRegretHistoryLength,proto3" json:"max_regret_history_length,omitempty"`
+	LegacyBundleSignaturesEndBlock   []int64                                           `protobuf:"varint,51,rep,packed,name=legacy_bundle_signatures_end_block,json=legacyBundleSignaturesEndBlock,proto3" json:"legacy_bundle_signatures_end_block,omitempty"`
+	PayloadSubmissionWindow          []int64                                           `protobuf:"varint,52,rep,packed,name=payload_submission_window,json=payloadSubmissionWindow,proto3" json:"payload_submission_window,omitempty"`
 }
 
 func (m *OptionalParams) Reset()         { *m = OptionalParams{} }
@@ -241,6 +253,69 @@ func (m *OptionalParams) GetBlocksPerMonth() []uint64 {
 	return nil
 }
 
+func (m *OptionalParams) GetMinParamChangeDelay() []int64 {
+	if m != nil {
+		return m.MinParamChangeDelay
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxOpenInferenceRequestsPerTopic() []uint64 {
+	if m != nil {
+		return m.MaxOpenInferenceRequestsPerTopic
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxStakeRemovalGasPerBlock() []uint64 {
+	if m != nil {
+		return m.MaxStakeRemovalGasPerBlock
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxRewardGasPerBlock() []uint64 {
+	if m != nil {
+		return m.MaxRewardGasPerBlock
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxPruningGasPerBlock() []uint64 {
+	if m != nil {
+		return m.MaxPruningGasPerBlock
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxInferenceDimension() []uint64 {
+	if m != nil {
+		return m.MaxInferenceDimension
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxRegretHistoryLength() []uint64 {
+	if m != nil {
+		return m.MaxRegretHistoryLength
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetLegacyBundleSignaturesEndBlock() []int64 {
+	if m != nil {
+		return m.LegacyBundleSignaturesEndBlock
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetPayloadSubmissionWindow() []int64 {
+	if m != nil {
+		return m.PayloadSubmissionWindow
+	}
+	return nil
+}
+
 type MsgUpdateParams struct {
 	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Params *OptionalParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
@@ -329,6 +404,200 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// Schedule a params change to be applied at the beginning of block apply_at_block,
+// which must be at least min_param_change_delay blocks away
+type MsgScheduleParamsChange struct {
+	Sender       string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Params       *OptionalParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	ApplyAtBlock int64           `protobuf:"varint,3,opt,name=apply_at_block,json=applyAtBlock,proto3" json:"apply_at_block,omitempty"`
+}
+
+func (m *MsgScheduleParamsChange) Reset()         { *m = MsgScheduleParamsChange{} }
+func (m *MsgScheduleParamsChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsChange) ProtoMessage()    {}
+func (*MsgScheduleParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{3}
+}
+func (m *MsgScheduleParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsChange.Merge(m, src)
+}
+func (m *MsgScheduleParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsChange proto.InternalMessageInfo
+
+func (m *MsgScheduleParamsChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgScheduleParamsChange) GetParams() *OptionalParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *MsgScheduleParamsChange) GetApplyAtBlock() int64 {
+	if m != nil {
+		return m.ApplyAtBlock
+	}
+	return 0
+}
+
+type MsgScheduleParamsChangeResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleParamsChangeResponse) Reset()         { *m = MsgScheduleParamsChangeResponse{} }
+func (m *MsgScheduleParamsChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleParamsChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{4}
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsChangeResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleParamsChangeResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelParamsChange struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelParamsChange) Reset()         { *m = MsgCancelParamsChange{} }
+func (m *MsgCancelParamsChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamsChange) ProtoMessage()    {}
+func (*MsgCancelParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{5}
+}
+func (m *MsgCancelParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamsChange.Merge(m, src)
+}
+func (m *MsgCancelParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelParamsChange proto.InternalMessageInfo
+
+func (m *MsgCancelParamsChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelParamsChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelParamsChangeResponse struct {
+}
+
+func (m *MsgCancelParamsChangeResponse) Reset()         { *m = MsgCancelParamsChangeResponse{} }
+func (m *MsgCancelParamsChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamsChangeResponse) ProtoMessage()    {}
+func (*MsgCancelParamsChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{6}
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamsChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamsChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamsChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamsChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelParamsChangeResponse proto.InternalMessageInfo
+
 type MsgCreateNewTopic struct {
 	// creator is the message sender.
 	Creator         string                                          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -344,13 +613,23 @@ type MsgCreateNewTopic struct {
 	AlphaRegret     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
 	AllowNegative   bool                                            `protobuf:"varint,12,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
 	Epsilon         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	ParamsOverrides *TopicParamsOverrides                           `protobuf:"bytes,14,opt,name=params_overrides,json=paramsOverrides,proto3" json:"params_overrides,omitempty"`
+	// Length of the inferences of a vector topic, 0 for a topic of scalar inferences
+	InferenceDimension uint64 `protobuf:"varint,15,opt,name=inference_dimension,json=inferenceDimension,proto3" json:"inference_dimension,omitempty"`
+	// Makes this a topic of quantile inferences, the inference dimension is then the number of levels
+	QuantileLevels []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,rep,name=quantile_levels,json=quantileLevels,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"quantile_levels"`
+	// Makes this a categorical topic, the inference dimension is then the number of labels
+	CategoryLabels      []string            `protobuf:"bytes,17,rep,name=category_labels,json=categoryLabels,proto3" json:"category_labels,omitempty"`
+	AggregationStrategy AggregationStrategy `protobuf:"varint,18,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=emissions.v1.AggregationStrategy" json:"aggregation_strategy,omitempty"`
+	// Required by trimmed and winsorized means, in (0, 0.5)
+	AggregationTrimFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,opt,name=aggregation_trim_fraction,json=aggregationTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"aggregation_trim_fraction"`
 }
 
 func (m *MsgCreateNewTopic) Reset()         { *m = MsgCreateNewTopic{} }
 func (m *MsgCreateNewTopic) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateNewTopic) ProtoMessage()    {}
 func (*MsgCreateNewTopic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{3}
+	return fileDescriptor_8293ea1b0f4b608c, []int{7}
 }
 func (m *MsgCreateNewTopic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,6 +728,34 @@ func (m *MsgCreateNewTopic) GetAllowNegative() bool {
 	return false
 }
 
+func (m *MsgCreateNewTopic) GetParamsOverrides() *TopicParamsOverrides {
+	if m != nil {
+		return m.ParamsOverrides
+	}
+	return nil
+}
+
+func (m *MsgCreateNewTopic) GetInferenceDimension() uint64 {
+	if m != nil {
+		return m.InferenceDimension
+	}
+	return 0
+}
+
+func (m *MsgCreateNewTopic) GetCategoryLabels() []string {
+	if m != nil {
+		return m.CategoryLabels
+	}
+	return nil
+}
+
+func (m *MsgCreateNewTopic) GetAggregationStrategy() AggregationStrategy {
+	if m != nil {
+		return m.AggregationStrategy
+	}
+	return AggregationStrategy_AGGREGATION_REGRET_WEIGHTED_MEAN
+}
+
 type MsgCreateNewTopicResponse struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
@@ -457,7 +764,7 @@ func (m *MsgCreateNewTopicResponse) Reset()         { *m = MsgCreateNewTopicResp
 func (m *MsgCreateNewTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateNewTopicResponse) ProtoMessage()    {}
 func (*MsgCreateNewTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{4}
+	return fileDescriptor_8293ea1b0f4b608c, []int{8}
 }
 func (m *MsgCreateNewTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,6 +800,104 @@ func (m *MsgCreateNewTopicResponse) GetTopicId() uint64 {
 	return 0
 }
 
+// Replace the params overrides of a topic, an empty set of overrides clears them.
+// The sender must hold the "topic-moderator" role.
+type MsgUpdateTopicParamsOverrides struct {
+	Sender    string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Overrides *TopicParamsOverrides `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+}
+
+func (m *MsgUpdateTopicParamsOverrides) Reset()         { *m = MsgUpdateTopicParamsOverrides{} }
+func (m *MsgUpdateTopicParamsOverrides) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicParamsOverrides) ProtoMessage()    {}
+func (*MsgUpdateTopicParamsOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{9}
+}
+func (m *MsgUpdateTopicParamsOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicParamsOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicParamsOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTopicParamsOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicParamsOverrides.Merge(m, src)
+}
+func (m *MsgUpdateTopicParamsOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicParamsOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicParamsOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTopicParamsOverrides proto.InternalMessageInfo
+
+func (m *MsgUpdateTopicParamsOverrides) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateTopicParamsOverrides) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *MsgUpdateTopicParamsOverrides) GetOverrides() *TopicParamsOverrides {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+type MsgUpdateTopicParamsOverridesResponse struct {
+}
+
+func (m *MsgUpdateTopicParamsOverridesResponse) Reset()         { *m = MsgUpdateTopicParamsOverridesResponse{} }
+func (m *MsgUpdateTopicParamsOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicParamsOverridesResponse) ProtoMessage()    {}
+func (*MsgUpdateTopicParamsOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{10}
+}
+func (m *MsgUpdateTopicParamsOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicParamsOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicParamsOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTopicParamsOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicParamsOverridesResponse.Merge(m, src)
+}
+func (m *MsgUpdateTopicParamsOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicParamsOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicParamsOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTopicParamsOverridesResponse proto.InternalMessageInfo
+
 type MsgInsertBulkReputerPayload struct {
 	Sender              string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	ReputerRequestNonce *ReputerRequestNonce  `protobuf:"bytes,2,opt,name=reputer_request_nonce,json=reputerRequestNonce,proto3" json:"reputer_request_nonce,omitempty"`
@@ -504,7 +909,7 @@ func (m *MsgInsertBulkReputerPayload) Reset()         { *m = MsgInsertBulkRepute
 func (m *MsgInsertBulkReputerPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgInsertBulkReputerPayload) ProtoMessage()    {}
 func (*MsgInsertBulkReputerPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{5}
+	return fileDescriptor_8293ea1b0f4b608c, []int{11}
 }
 func (m *MsgInsertBulkReputerPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,13 +967,18 @@ func (m *MsgInsertBulkReputerPayload) GetReputerValueBundles() []*ReputerValueBu
 }
 
 type MsgInsertBulkReputerPayloadResponse struct {
+	// When the bundles are queued until the nonce closes, only the outcomes of the
+	// bundles left out of the queue. The queued bundles get theirs in the
+	// submission outcomes event emitted when the nonce closes.
+	Outcomes   []*SubmissionOutcome `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	CloseBlock int64                `protobuf:"varint,2,opt,name=close_block,json=closeBlock,proto3" json:"close_block,omitempty"`
 }
 
 func (m *MsgInsertBulkReputerPayloadResponse) Reset()         { *m = MsgInsertBulkReputerPayloadResponse{} }
 func (m *MsgInsertBulkReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInsertBulkReputerPayloadResponse) ProtoMessage()    {}
 func (*MsgInsertBulkReputerPayloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{6}
+	return fileDescriptor_8293ea1b0f4b608c, []int{12}
 }
 func (m *MsgInsertBulkReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,8 +1007,22 @@ func (m *MsgInsertBulkReputerPayloadResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInsertBulkReputerPayloadResponse proto.InternalMessageInfo
 
-type MsgInsertBulkWorkerPayload struct {
-	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+func (m *MsgInsertBulkReputerPayloadResponse) GetOutcomes() []*SubmissionOutcome {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+func (m *MsgInsertBulkReputerPayloadResponse) GetCloseBlock() int64 {
+	if m != nil {
+		return m.CloseBlock
+	}
+	return 0
+}
+
+type MsgInsertBulkWorkerPayload struct {
+	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Nonce             *Nonce              `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	TopicId           uint64              `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	WorkerDataBundles []*WorkerDataBundle `protobuf:"bytes,4,rep,name=worker_data_bundles,json=workerDataBundles,proto3" json:"worker_data_bundles,omitempty"`
@@ -608,7 +1032,7 @@ func (m *MsgInsertBulkWorkerPayload) Reset()         { *m = MsgInsertBulkWorkerP
 func (m *MsgInsertBulkWorkerPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgInsertBulkWorkerPayload) ProtoMessage()    {}
 func (*MsgInsertBulkWorkerPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{7}
+	return fileDescriptor_8293ea1b0f4b608c, []int{13}
 }
 func (m *MsgInsertBulkWorkerPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,13 +1090,19 @@ func (m *MsgInsertBulkWorkerPayload) GetWorkerDataBundles() []*WorkerDataBundle
 }
 
 type MsgInsertBulkWorkerPayloadResponse struct {
+	// When the bundles are queued until the nonce closes, only the outcomes of the
+	// bundles left out of the queue. The queued bundles get theirs in the
+	// submission outcomes event emitted when the nonce closes.
+	InferenceOutcomes []*SubmissionOutcome `protobuf:"bytes,1,rep,name=inference_outcomes,json=inferenceOutcomes,proto3" json:"inference_outcomes,omitempty"`
+	ForecastOutcomes  []*SubmissionOutcome `protobuf:"bytes,2,rep,name=forecast_outcomes,json=forecastOutcomes,proto3" json:"forecast_outcomes,omitempty"`
+	CloseBlock        int64                `protobuf:"varint,3,opt,name=close_block,json=closeBlock,proto3" json:"close_block,omitempty"`
 }
 
 func (m *MsgInsertBulkWorkerPayloadResponse) Reset()         { *m = MsgInsertBulkWorkerPayloadResponse{} }
 func (m *MsgInsertBulkWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInsertBulkWorkerPayloadResponse) ProtoMessage()    {}
 func (*MsgInsertBulkWorkerPayloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{8}
+	return fileDescriptor_8293ea1b0f4b608c, []int{14}
 }
 func (m *MsgInsertBulkWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,6 +1131,223 @@ func (m *MsgInsertBulkWorkerPayloadResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInsertBulkWorkerPayloadResponse proto.InternalMessageInfo
 
+func (m *MsgInsertBulkWorkerPayloadResponse) GetInferenceOutcomes() []*SubmissionOutcome {
+	if m != nil {
+		return m.InferenceOutcomes
+	}
+	return nil
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) GetForecastOutcomes() []*SubmissionOutcome {
+	if m != nil {
+		return m.ForecastOutcomes
+	}
+	return nil
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) GetCloseBlock() int64 {
+	if m != nil {
+		return m.CloseBlock
+	}
+	return 0
+}
+
+// Payload of a single reputer, submitted by the reputer itself rather than through a leader.
+// It is ranked with the other payloads of its nonce when the nonce closes.
+type MsgInsertReputerPayload struct {
+	Sender             string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,2,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
+}
+
+func (m *MsgInsertReputerPayload) Reset()         { *m = MsgInsertReputerPayload{} }
+func (m *MsgInsertReputerPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgInsertReputerPayload) ProtoMessage()    {}
+func (*MsgInsertReputerPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{15}
+}
+func (m *MsgInsertReputerPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInsertReputerPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInsertReputerPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInsertReputerPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInsertReputerPayload.Merge(m, src)
+}
+func (m *MsgInsertReputerPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInsertReputerPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInsertReputerPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInsertReputerPayload proto.InternalMessageInfo
+
+func (m *MsgInsertReputerPayload) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgInsertReputerPayload) GetReputerValueBundle() *ReputerValueBundle {
+	if m != nil {
+		return m.ReputerValueBundle
+	}
+	return nil
+}
+
+type MsgInsertReputerPayloadResponse struct {
+	CloseBlock int64 `protobuf:"varint,1,opt,name=close_block,json=closeBlock,proto3" json:"close_block,omitempty"`
+}
+
+func (m *MsgInsertReputerPayloadResponse) Reset()         { *m = MsgInsertReputerPayloadResponse{} }
+func (m *MsgInsertReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInsertReputerPayloadResponse) ProtoMessage()    {}
+func (*MsgInsertReputerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{16}
+}
+func (m *MsgInsertReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInsertReputerPayloadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInsertReputerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInsertReputerPayloadResponse.Merge(m, src)
+}
+func (m *MsgInsertReputerPayloadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInsertReputerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInsertReputerPayloadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInsertReputerPayloadResponse proto.InternalMessageInfo
+
+func (m *MsgInsertReputerPayloadResponse) GetCloseBlock() int64 {
+	if m != nil {
+		return m.CloseBlock
+	}
+	return 0
+}
+
+// Payload of a single worker, submitted by the worker itself rather than through a leader.
+// It is ranked with the other payloads of its nonce when the nonce closes.
+type MsgInsertWorkerPayload struct {
+	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
+}
+
+func (m *MsgInsertWorkerPayload) Reset()         { *m = MsgInsertWorkerPayload{} }
+func (m *MsgInsertWorkerPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgInsertWorkerPayload) ProtoMessage()    {}
+func (*MsgInsertWorkerPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{17}
+}
+func (m *MsgInsertWorkerPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInsertWorkerPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInsertWorkerPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInsertWorkerPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInsertWorkerPayload.Merge(m, src)
+}
+func (m *MsgInsertWorkerPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInsertWorkerPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInsertWorkerPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInsertWorkerPayload proto.InternalMessageInfo
+
+func (m *MsgInsertWorkerPayload) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgInsertWorkerPayload) GetWorkerDataBundle() *WorkerDataBundle {
+	if m != nil {
+		return m.WorkerDataBundle
+	}
+	return nil
+}
+
+type MsgInsertWorkerPayloadResponse struct {
+	CloseBlock int64 `protobuf:"varint,1,opt,name=close_block,json=closeBlock,proto3" json:"close_block,omitempty"`
+}
+
+func (m *MsgInsertWorkerPayloadResponse) Reset()         { *m = MsgInsertWorkerPayloadResponse{} }
+func (m *MsgInsertWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInsertWorkerPayloadResponse) ProtoMessage()    {}
+func (*MsgInsertWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{18}
+}
+func (m *MsgInsertWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInsertWorkerPayloadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInsertWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInsertWorkerPayloadResponse.Merge(m, src)
+}
+func (m *MsgInsertWorkerPayloadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInsertWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInsertWorkerPayloadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInsertWorkerPayloadResponse proto.InternalMessageInfo
+
+func (m *MsgInsertWorkerPayloadResponse) GetCloseBlock() int64 {
+	if m != nil {
+		return m.CloseBlock
+	}
+	return 0
+}
+
 type MsgRegister struct {
 	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	LibP2PKey    string `protobuf:"bytes,2,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
@@ -714,7 +1361,7 @@ func (m *MsgRegister) Reset()         { *m = MsgRegister{} }
 func (m *MsgRegister) String() string { return proto.CompactTextString(m) }
 func (*MsgRegister) ProtoMessage()    {}
 func (*MsgRegister) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{9}
+	return fileDescriptor_8293ea1b0f4b608c, []int{19}
 }
 func (m *MsgRegister) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +1441,7 @@ func (m *MsgRegisterResponse) Reset()         { *m = MsgRegisterResponse{} }
 func (m *MsgRegisterResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterResponse) ProtoMessage()    {}
 func (*MsgRegisterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{10}
+	return fileDescriptor_8293ea1b0f4b608c, []int{20}
 }
 func (m *MsgRegisterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,7 +1494,7 @@ func (m *MsgRemoveRegistration) Reset()         { *m = MsgRemoveRegistration{} }
 func (m *MsgRemoveRegistration) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRegistration) ProtoMessage()    {}
 func (*MsgRemoveRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{11}
+	return fileDescriptor_8293ea1b0f4b608c, []int{21}
 }
 func (m *MsgRemoveRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -906,7 +1553,7 @@ func (m *MsgRemoveRegistrationResponse) Reset()         { *m = MsgRemoveRegistra
 func (m *MsgRemoveRegistrationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRegistrationResponse) ProtoMessage()    {}
 func (*MsgRemoveRegistrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{12}
+	return fileDescriptor_8293ea1b0f4b608c, []int{22}
 }
 func (m *MsgRemoveRegistrationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,24 +1596,28 @@ func (m *MsgRemoveRegistrationResponse) GetMessage() string {
 	return ""
 }
 
-type MsgAddStake struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+// Rotate the worker or reputer node the sender registered under previous_lib_p2p_key to a new
+// libp2p key and multiaddress, in every topic it was registered in, without registering again
+type MsgUpdateNodeInfo struct {
+	Sender            string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LibP2PKey         string `protobuf:"bytes,2,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
+	MultiAddress      string `protobuf:"bytes,3,opt,name=multi_address,json=multiAddress,proto3" json:"multi_address,omitempty"`
+	IsReputer         bool   `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	PreviousLibP2PKey string `protobuf:"bytes,5,opt,name=previous_lib_p2p_key,json=previousLibP2pKey,proto3" json:"previous_lib_p2p_key,omitempty"`
 }
 
-func (m *MsgAddStake) Reset()         { *m = MsgAddStake{} }
-func (m *MsgAddStake) String() string { return proto.CompactTextString(m) }
-func (*MsgAddStake) ProtoMessage()    {}
-func (*MsgAddStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{13}
+func (m *MsgUpdateNodeInfo) Reset()         { *m = MsgUpdateNodeInfo{} }
+func (m *MsgUpdateNodeInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNodeInfo) ProtoMessage()    {}
+func (*MsgUpdateNodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{23}
 }
-func (m *MsgAddStake) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateNodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateNodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateNodeInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -976,47 +1627,68 @@ func (m *MsgAddStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddStake.Merge(m, src)
+func (m *MsgUpdateNodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNodeInfo.Merge(m, src)
 }
-func (m *MsgAddStake) XXX_Size() int {
+func (m *MsgUpdateNodeInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddStake) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddStake.DiscardUnknown(m)
+func (m *MsgUpdateNodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNodeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddStake proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateNodeInfo proto.InternalMessageInfo
 
-func (m *MsgAddStake) GetSender() string {
+func (m *MsgUpdateNodeInfo) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgAddStake) GetTopicId() uint64 {
+func (m *MsgUpdateNodeInfo) GetLibP2PKey() string {
 	if m != nil {
-		return m.TopicId
+		return m.LibP2PKey
 	}
-	return 0
+	return ""
 }
 
-type MsgAddStakeResponse struct {
+func (m *MsgUpdateNodeInfo) GetMultiAddress() string {
+	if m != nil {
+		return m.MultiAddress
+	}
+	return ""
 }
 
-func (m *MsgAddStakeResponse) Reset()         { *m = MsgAddStakeResponse{} }
-func (m *MsgAddStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddStakeResponse) ProtoMessage()    {}
-func (*MsgAddStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{14}
+func (m *MsgUpdateNodeInfo) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
 }
-func (m *MsgAddStakeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateNodeInfo) GetPreviousLibP2PKey() string {
+	if m != nil {
+		return m.PreviousLibP2PKey
+	}
+	return ""
+}
+
+type MsgUpdateNodeInfoResponse struct {
+}
+
+func (m *MsgUpdateNodeInfoResponse) Reset()         { *m = MsgUpdateNodeInfoResponse{} }
+func (m *MsgUpdateNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNodeInfoResponse) ProtoMessage()    {}
+func (*MsgUpdateNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{24}
+}
+func (m *MsgUpdateNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateNodeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1026,36 +1698,40 @@ func (m *MsgAddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddStakeResponse.Merge(m, src)
+func (m *MsgUpdateNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNodeInfoResponse.Merge(m, src)
 }
-func (m *MsgAddStakeResponse) XXX_Size() int {
+func (m *MsgUpdateNodeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddStakeResponse.DiscardUnknown(m)
+func (m *MsgUpdateNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNodeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateNodeInfoResponse proto.InternalMessageInfo
 
-type MsgRemoveStake struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+// Send the worker rewards earned by the sender in a topic, or in every topic when global,
+// to another address. A topic payout address takes precedence over the global one.
+// An empty payout address sends the rewards back to the sender.
+type MsgSetPayoutAddress struct {
+	Sender        string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId       uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Global        bool   `protobuf:"varint,3,opt,name=global,proto3" json:"global,omitempty"`
+	PayoutAddress string `protobuf:"bytes,4,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
 }
 
-func (m *MsgRemoveStake) Reset()         { *m = MsgRemoveStake{} }
-func (m *MsgRemoveStake) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveStake) ProtoMessage()    {}
-func (*MsgRemoveStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{15}
+func (m *MsgSetPayoutAddress) Reset()         { *m = MsgSetPayoutAddress{} }
+func (m *MsgSetPayoutAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPayoutAddress) ProtoMessage()    {}
+func (*MsgSetPayoutAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{25}
 }
-func (m *MsgRemoveStake) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetPayoutAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetPayoutAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetPayoutAddress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1065,42 +1741,234 @@ func (m *MsgRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveStake.Merge(m, src)
+func (m *MsgSetPayoutAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPayoutAddress.Merge(m, src)
 }
-func (m *MsgRemoveStake) XXX_Size() int {
+func (m *MsgSetPayoutAddress) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveStake) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveStake.DiscardUnknown(m)
+func (m *MsgSetPayoutAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPayoutAddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveStake proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetPayoutAddress proto.InternalMessageInfo
 
-func (m *MsgRemoveStake) GetSender() string {
+func (m *MsgSetPayoutAddress) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgRemoveStake) GetTopicId() uint64 {
+func (m *MsgSetPayoutAddress) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type MsgRemoveStakeResponse struct {
+func (m *MsgSetPayoutAddress) GetGlobal() bool {
+	if m != nil {
+		return m.Global
+	}
+	return false
 }
 
-func (m *MsgRemoveStakeResponse) Reset()         { *m = MsgRemoveStakeResponse{} }
-func (m *MsgRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveStakeResponse) ProtoMessage()    {}
-func (*MsgRemoveStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{16}
+func (m *MsgSetPayoutAddress) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
 }
-func (m *MsgRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+
+type MsgSetPayoutAddressResponse struct {
+}
+
+func (m *MsgSetPayoutAddressResponse) Reset()         { *m = MsgSetPayoutAddressResponse{} }
+func (m *MsgSetPayoutAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPayoutAddressResponse) ProtoMessage()    {}
+func (*MsgSetPayoutAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{26}
+}
+func (m *MsgSetPayoutAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPayoutAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPayoutAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPayoutAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPayoutAddressResponse.Merge(m, src)
+}
+func (m *MsgSetPayoutAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPayoutAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPayoutAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPayoutAddressResponse proto.InternalMessageInfo
+
+type MsgAddStake struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgAddStake) Reset()         { *m = MsgAddStake{} }
+func (m *MsgAddStake) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStake) ProtoMessage()    {}
+func (*MsgAddStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{27}
+}
+func (m *MsgAddStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStake.Merge(m, src)
+}
+func (m *MsgAddStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddStake proto.InternalMessageInfo
+
+func (m *MsgAddStake) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAddStake) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+type MsgAddStakeResponse struct {
+}
+
+func (m *MsgAddStakeResponse) Reset()         { *m = MsgAddStakeResponse{} }
+func (m *MsgAddStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStakeResponse) ProtoMessage()    {}
+func (*MsgAddStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{28}
+}
+func (m *MsgAddStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStakeResponse.Merge(m, src)
+}
+func (m *MsgAddStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddStakeResponse proto.InternalMessageInfo
+
+type MsgRemoveStake struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgRemoveStake) Reset()         { *m = MsgRemoveStake{} }
+func (m *MsgRemoveStake) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveStake) ProtoMessage()    {}
+func (*MsgRemoveStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{29}
+}
+func (m *MsgRemoveStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveStake.Merge(m, src)
+}
+func (m *MsgRemoveStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveStake proto.InternalMessageInfo
+
+func (m *MsgRemoveStake) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveStake) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+type MsgRemoveStakeResponse struct {
+}
+
+func (m *MsgRemoveStakeResponse) Reset()         { *m = MsgRemoveStakeResponse{} }
+func (m *MsgRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveStakeResponse) ProtoMessage()    {}
+func (*MsgRemoveStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{30}
+}
+func (m *MsgRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *MsgRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -1136,7 +2004,7 @@ func (m *MsgCancelRemoveStake) Reset()         { *m = MsgCancelRemoveStake{} }
 func (m *MsgCancelRemoveStake) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveStake) ProtoMessage()    {}
 func (*MsgCancelRemoveStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{17}
+	return fileDescriptor_8293ea1b0f4b608c, []int{31}
 }
 func (m *MsgCancelRemoveStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +2054,7 @@ func (m *MsgCancelRemoveStakeResponse) Reset()         { *m = MsgCancelRemoveSta
 func (m *MsgCancelRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveStakeResponse) ProtoMessage()    {}
 func (*MsgCancelRemoveStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{18}
+	return fileDescriptor_8293ea1b0f4b608c, []int{32}
 }
 func (m *MsgCancelRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +2094,7 @@ func (m *MsgDelegateStake) Reset()         { *m = MsgDelegateStake{} }
 func (m *MsgDelegateStake) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateStake) ProtoMessage()    {}
 func (*MsgDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{19}
+	return fileDescriptor_8293ea1b0f4b608c, []int{33}
 }
 func (m *MsgDelegateStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +2151,7 @@ func (m *MsgDelegateStakeResponse) Reset()         { *m = MsgDelegateStakeRespon
 func (m *MsgDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateStakeResponse) ProtoMessage()    {}
 func (*MsgDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{20}
+	return fileDescriptor_8293ea1b0f4b608c, []int{34}
 }
 func (m *MsgDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1323,7 +2191,7 @@ func (m *MsgRemoveDelegateStake) Reset()         { *m = MsgRemoveDelegateStake{}
 func (m *MsgRemoveDelegateStake) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveDelegateStake) ProtoMessage()    {}
 func (*MsgRemoveDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{21}
+	return fileDescriptor_8293ea1b0f4b608c, []int{35}
 }
 func (m *MsgRemoveDelegateStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +2248,7 @@ func (m *MsgRemoveDelegateStakeResponse) Reset()         { *m = MsgRemoveDelegat
 func (m *MsgRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveDelegateStakeResponse) ProtoMessage()    {}
 func (*MsgRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{22}
+	return fileDescriptor_8293ea1b0f4b608c, []int{36}
 }
 func (m *MsgRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1420,7 +2288,7 @@ func (m *MsgCancelRemoveDelegateStake) Reset()         { *m = MsgCancelRemoveDel
 func (m *MsgCancelRemoveDelegateStake) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveDelegateStake) ProtoMessage()    {}
 func (*MsgCancelRemoveDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{23}
+	return fileDescriptor_8293ea1b0f4b608c, []int{37}
 }
 func (m *MsgCancelRemoveDelegateStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +2352,7 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Reset()         { *m = MsgCancelR
 func (m *MsgCancelRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveDelegateStakeResponse) ProtoMessage()    {}
 func (*MsgCancelRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{24}
+	return fileDescriptor_8293ea1b0f4b608c, []int{38}
 }
 func (m *MsgCancelRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +2393,7 @@ func (m *MsgFundTopic) Reset()         { *m = MsgFundTopic{} }
 func (m *MsgFundTopic) String() string { return proto.CompactTextString(m) }
 func (*MsgFundTopic) ProtoMessage()    {}
 func (*MsgFundTopic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{25}
+	return fileDescriptor_8293ea1b0f4b608c, []int{39}
 }
 func (m *MsgFundTopic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1575,7 +2443,7 @@ func (m *MsgFundTopicResponse) Reset()         { *m = MsgFundTopicResponse{} }
 func (m *MsgFundTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundTopicResponse) ProtoMessage()    {}
 func (*MsgFundTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{26}
+	return fileDescriptor_8293ea1b0f4b608c, []int{40}
 }
 func (m *MsgFundTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1604,23 +2472,29 @@ func (m *MsgFundTopicResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundTopicResponse proto.InternalMessageInfo
 
-type MsgAddToWhitelistAdmin struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+// Request an ad-hoc inference for a topic, answered by workers at a one-off nonce
+// at the current block. The bounty is escrowed and paid to the participants by score
+// at the deadline, or refunded if nobody responded.
+type MsgRequestInference struct {
+	Sender   string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId  uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Argument string                `protobuf:"bytes,3,opt,name=argument,proto3" json:"argument,omitempty"`
+	Deadline int64                 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Bounty   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=bounty,proto3,customtype=cosmossdk.io/math.Int" json:"bounty"`
 }
 
-func (m *MsgAddToWhitelistAdmin) Reset()         { *m = MsgAddToWhitelistAdmin{} }
-func (m *MsgAddToWhitelistAdmin) String() string { return proto.CompactTextString(m) }
-func (*MsgAddToWhitelistAdmin) ProtoMessage()    {}
-func (*MsgAddToWhitelistAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{27}
+func (m *MsgRequestInference) Reset()         { *m = MsgRequestInference{} }
+func (m *MsgRequestInference) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestInference) ProtoMessage()    {}
+func (*MsgRequestInference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{41}
 }
-func (m *MsgAddToWhitelistAdmin) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestInference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddToWhitelistAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestInference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddToWhitelistAdmin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestInference.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1630,47 +2504,63 @@ func (m *MsgAddToWhitelistAdmin) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgAddToWhitelistAdmin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddToWhitelistAdmin.Merge(m, src)
+func (m *MsgRequestInference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestInference.Merge(m, src)
 }
-func (m *MsgAddToWhitelistAdmin) XXX_Size() int {
+func (m *MsgRequestInference) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddToWhitelistAdmin) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddToWhitelistAdmin.DiscardUnknown(m)
+func (m *MsgRequestInference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestInference.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddToWhitelistAdmin proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestInference proto.InternalMessageInfo
 
-func (m *MsgAddToWhitelistAdmin) GetSender() string {
+func (m *MsgRequestInference) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgAddToWhitelistAdmin) GetAddress() string {
+func (m *MsgRequestInference) GetTopicId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *MsgRequestInference) GetArgument() string {
+	if m != nil {
+		return m.Argument
 	}
 	return ""
 }
 
-type MsgAddToWhitelistAdminResponse struct {
+func (m *MsgRequestInference) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
 }
 
-func (m *MsgAddToWhitelistAdminResponse) Reset()         { *m = MsgAddToWhitelistAdminResponse{} }
-func (m *MsgAddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddToWhitelistAdminResponse) ProtoMessage()    {}
-func (*MsgAddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{28}
+type MsgRequestInferenceResponse struct {
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Nonce     *Nonce `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
-func (m *MsgAddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRequestInferenceResponse) Reset()         { *m = MsgRequestInferenceResponse{} }
+func (m *MsgRequestInferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestInferenceResponse) ProtoMessage()    {}
+func (*MsgRequestInferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{42}
+}
+func (m *MsgRequestInferenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestInferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddToWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestInferenceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1680,35 +2570,53 @@ func (m *MsgAddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgAddToWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddToWhitelistAdminResponse.Merge(m, src)
+func (m *MsgRequestInferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestInferenceResponse.Merge(m, src)
 }
-func (m *MsgAddToWhitelistAdminResponse) XXX_Size() int {
+func (m *MsgRequestInferenceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddToWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddToWhitelistAdminResponse.DiscardUnknown(m)
+func (m *MsgRequestInferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestInferenceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddToWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestInferenceResponse proto.InternalMessageInfo
 
-type MsgRemoveFromWhitelistAdmin struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (m *MsgRequestInferenceResponse) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) Reset()         { *m = MsgRemoveFromWhitelistAdmin{} }
-func (m *MsgRemoveFromWhitelistAdmin) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveFromWhitelistAdmin) ProtoMessage()    {}
-func (*MsgRemoveFromWhitelistAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{29}
+func (m *MsgRequestInferenceResponse) GetNonce() *Nonce {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
 }
-func (m *MsgRemoveFromWhitelistAdmin) XXX_Unmarshal(b []byte) error {
+
+// Escrow funds paid into the topic's fee revenue, amount_per_epoch at a time,
+// every epoch of the topic starting with the current block
+type MsgCreateSubscription struct {
+	Sender         string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId        uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Escrow         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=escrow,proto3,customtype=cosmossdk.io/math.Int" json:"escrow"`
+	AmountPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_per_epoch,json=amountPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"amount_per_epoch"`
+}
+
+func (m *MsgCreateSubscription) Reset()         { *m = MsgCreateSubscription{} }
+func (m *MsgCreateSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubscription) ProtoMessage()    {}
+func (*MsgCreateSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{43}
+}
+func (m *MsgCreateSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveFromWhitelistAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveFromWhitelistAdmin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSubscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1718,47 +2626,48 @@ func (m *MsgRemoveFromWhitelistAdmin) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveFromWhitelistAdmin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveFromWhitelistAdmin.Merge(m, src)
+func (m *MsgCreateSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubscription.Merge(m, src)
 }
-func (m *MsgRemoveFromWhitelistAdmin) XXX_Size() int {
+func (m *MsgCreateSubscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveFromWhitelistAdmin) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveFromWhitelistAdmin.DiscardUnknown(m)
+func (m *MsgCreateSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveFromWhitelistAdmin proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSubscription proto.InternalMessageInfo
 
-func (m *MsgRemoveFromWhitelistAdmin) GetSender() string {
+func (m *MsgCreateSubscription) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) GetAddress() string {
+func (m *MsgCreateSubscription) GetTopicId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type MsgRemoveFromWhitelistAdminResponse struct {
+type MsgCreateSubscriptionResponse struct {
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) Reset()         { *m = MsgRemoveFromWhitelistAdminResponse{} }
-func (m *MsgRemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveFromWhitelistAdminResponse) ProtoMessage()    {}
-func (*MsgRemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{30}
+func (m *MsgCreateSubscriptionResponse) Reset()         { *m = MsgCreateSubscriptionResponse{} }
+func (m *MsgCreateSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{44}
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSubscriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSubscriptionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1768,33 +2677,43 @@ func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse.Merge(m, src)
+func (m *MsgCreateSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubscriptionResponse.Merge(m, src)
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Size() int {
+func (m *MsgCreateSubscriptionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse.DiscardUnknown(m)
+func (m *MsgCreateSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubscriptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSubscriptionResponse proto.InternalMessageInfo
 
-type MsgRewardDelegateStakeResponse struct {
+func (m *MsgCreateSubscriptionResponse) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
 
-func (m *MsgRewardDelegateStakeResponse) Reset()         { *m = MsgRewardDelegateStakeResponse{} }
-func (m *MsgRewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRewardDelegateStakeResponse) ProtoMessage()    {}
-func (*MsgRewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{31}
+// Stop a subscription, refunding what is left of its escrow to the consumer
+type MsgCancelSubscription struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubscriptionId uint64 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCancelSubscription) Reset()         { *m = MsgCancelSubscription{} }
+func (m *MsgCancelSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscription) ProtoMessage()    {}
+func (*MsgCancelSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{45}
+}
+func (m *MsgCancelSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRewardDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSubscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1804,36 +2723,47 @@ func (m *MsgRewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRewardDelegateStakeResponse.Merge(m, src)
+func (m *MsgCancelSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscription.Merge(m, src)
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Size() int {
+func (m *MsgCancelSubscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRewardDelegateStakeResponse.DiscardUnknown(m)
+func (m *MsgCancelSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRewardDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSubscription proto.InternalMessageInfo
 
-type MsgRewardDelegateStake struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+func (m *MsgCancelSubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgRewardDelegateStake) Reset()         { *m = MsgRewardDelegateStake{} }
-func (m *MsgRewardDelegateStake) String() string { return proto.CompactTextString(m) }
-func (*MsgRewardDelegateStake) ProtoMessage()    {}
-func (*MsgRewardDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{32}
+func (m *MsgCancelSubscription) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
-func (m *MsgRewardDelegateStake) XXX_Unmarshal(b []byte) error {
+
+type MsgCancelSubscriptionResponse struct {
+}
+
+func (m *MsgCancelSubscriptionResponse) Reset()         { *m = MsgCancelSubscriptionResponse{} }
+func (m *MsgCancelSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{46}
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRewardDelegateStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRewardDelegateStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSubscriptionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1843,2007 +2773,1833 @@ func (m *MsgRewardDelegateStake) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgRewardDelegateStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRewardDelegateStake.Merge(m, src)
+func (m *MsgCancelSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.Merge(m, src)
 }
-func (m *MsgRewardDelegateStake) XXX_Size() int {
+func (m *MsgCancelSubscriptionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRewardDelegateStake) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRewardDelegateStake.DiscardUnknown(m)
+func (m *MsgCancelSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRewardDelegateStake proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSubscriptionResponse proto.InternalMessageInfo
 
-func (m *MsgRewardDelegateStake) GetSender() string {
-	if m != nil {
-		return m.Sender
+type MsgAddToWhitelistAdmin struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgAddToWhitelistAdmin) Reset()         { *m = MsgAddToWhitelistAdmin{} }
+func (m *MsgAddToWhitelistAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToWhitelistAdmin) ProtoMessage()    {}
+func (*MsgAddToWhitelistAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{47}
+}
+func (m *MsgAddToWhitelistAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToWhitelistAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToWhitelistAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgAddToWhitelistAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToWhitelistAdmin.Merge(m, src)
+}
+func (m *MsgAddToWhitelistAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToWhitelistAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToWhitelistAdmin.DiscardUnknown(m)
 }
 
-func (m *MsgRewardDelegateStake) GetTopicId() uint64 {
+var xxx_messageInfo_MsgAddToWhitelistAdmin proto.InternalMessageInfo
+
+func (m *MsgAddToWhitelistAdmin) GetSender() string {
 	if m != nil {
-		return m.TopicId
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgRewardDelegateStake) GetReputer() string {
+func (m *MsgAddToWhitelistAdmin) GetAddress() string {
 	if m != nil {
-		return m.Reputer
+		return m.Address
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*OptionalParams)(nil), "emissions.v1.OptionalParams")
-	proto.RegisterType((*MsgUpdateParams)(nil), "emissions.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "emissions.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateNewTopic)(nil), "emissions.v1.MsgCreateNewTopic")
-	proto.RegisterType((*MsgCreateNewTopicResponse)(nil), "emissions.v1.MsgCreateNewTopicResponse")
-	proto.RegisterType((*MsgInsertBulkReputerPayload)(nil), "emissions.v1.MsgInsertBulkReputerPayload")
-	proto.RegisterType((*MsgInsertBulkReputerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkReputerPayloadResponse")
-	proto.RegisterType((*MsgInsertBulkWorkerPayload)(nil), "emissions.v1.MsgInsertBulkWorkerPayload")
-	proto.RegisterType((*MsgInsertBulkWorkerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkWorkerPayloadResponse")
-	proto.RegisterType((*MsgRegister)(nil), "emissions.v1.MsgRegister")
-	proto.RegisterType((*MsgRegisterResponse)(nil), "emissions.v1.MsgRegisterResponse")
-	proto.RegisterType((*MsgRemoveRegistration)(nil), "emissions.v1.MsgRemoveRegistration")
-	proto.RegisterType((*MsgRemoveRegistrationResponse)(nil), "emissions.v1.MsgRemoveRegistrationResponse")
-	proto.RegisterType((*MsgAddStake)(nil), "emissions.v1.MsgAddStake")
-	proto.RegisterType((*MsgAddStakeResponse)(nil), "emissions.v1.MsgAddStakeResponse")
-	proto.RegisterType((*MsgRemoveStake)(nil), "emissions.v1.MsgRemoveStake")
-	proto.RegisterType((*MsgRemoveStakeResponse)(nil), "emissions.v1.MsgRemoveStakeResponse")
-	proto.RegisterType((*MsgCancelRemoveStake)(nil), "emissions.v1.MsgCancelRemoveStake")
-	proto.RegisterType((*MsgCancelRemoveStakeResponse)(nil), "emissions.v1.MsgCancelRemoveStakeResponse")
-	proto.RegisterType((*MsgDelegateStake)(nil), "emissions.v1.MsgDelegateStake")
-	proto.RegisterType((*MsgDelegateStakeResponse)(nil), "emissions.v1.MsgDelegateStakeResponse")
-	proto.RegisterType((*MsgRemoveDelegateStake)(nil), "emissions.v1.MsgRemoveDelegateStake")
-	proto.RegisterType((*MsgRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgRemoveDelegateStakeResponse")
-	proto.RegisterType((*MsgCancelRemoveDelegateStake)(nil), "emissions.v1.MsgCancelRemoveDelegateStake")
-	proto.RegisterType((*MsgCancelRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgCancelRemoveDelegateStakeResponse")
-	proto.RegisterType((*MsgFundTopic)(nil), "emissions.v1.MsgFundTopic")
-	proto.RegisterType((*MsgFundTopicResponse)(nil), "emissions.v1.MsgFundTopicResponse")
-	proto.RegisterType((*MsgAddToWhitelistAdmin)(nil), "emissions.v1.MsgAddToWhitelistAdmin")
-	proto.RegisterType((*MsgAddToWhitelistAdminResponse)(nil), "emissions.v1.MsgAddToWhitelistAdminResponse")
-	proto.RegisterType((*MsgRemoveFromWhitelistAdmin)(nil), "emissions.v1.MsgRemoveFromWhitelistAdmin")
-	proto.RegisterType((*MsgRemoveFromWhitelistAdminResponse)(nil), "emissions.v1.MsgRemoveFromWhitelistAdminResponse")
-	proto.RegisterType((*MsgRewardDelegateStakeResponse)(nil), "emissions.v1.MsgRewardDelegateStakeResponse")
-	proto.RegisterType((*MsgRewardDelegateStake)(nil), "emissions.v1.MsgRewardDelegateStake")
+type MsgAddToWhitelistAdminResponse struct {
 }
 
-func init() { proto.RegisterFile("emissions/v1/tx.proto", fileDescriptor_8293ea1b0f4b608c) }
-
-var fileDescriptor_8293ea1b0f4b608c = []byte{
-	// 2430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0xcf, 0xc4, 0xef, 0x6f, 0xc6, 0xaf, 0xf6, 0x23, 0xed, 0x89, 0x3d, 0x76, 0x6c, 0x27, 0x99,
-	0x84, 0x5d, 0x7b, 0x63, 0x10, 0x8f, 0xc0, 0x01, 0x07, 0xc7, 0xbb, 0x56, 0x32, 0xc6, 0xdb, 0x71,
-	0x62, 0x29, 0xac, 0xd4, 0x94, 0xbb, 0x6b, 0x7a, 0x5a, 0xee, 0xee, 0x6a, 0xaa, 0x7a, 0xc6, 0xf6,
-	0x72, 0x41, 0x48, 0x5c, 0x38, 0x71, 0x02, 0xc4, 0x1f, 0x80, 0x38, 0xee, 0x01, 0x71, 0xe2, 0xc2,
-	0x89, 0x3d, 0xae, 0xb8, 0x80, 0x38, 0xac, 0x50, 0x72, 0xd8, 0x3f, 0x03, 0x54, 0x8f, 0xee, 0xe9,
-	0x9e, 0x57, 0xac, 0x74, 0x90, 0xf6, 0x12, 0xa5, 0xeb, 0xfb, 0xea, 0xf7, 0x7d, 0xdf, 0xaf, 0xea,
-	0x7b, 0x94, 0x35, 0xb0, 0x80, 0x7d, 0x97, 0x31, 0x97, 0x04, 0x6c, 0xbb, 0xf5, 0x60, 0x3b, 0xba,
-	0xd8, 0x0a, 0x29, 0x89, 0x88, 0x56, 0x4a, 0x96, 0xb7, 0x5a, 0x0f, 0xca, 0x37, 0x2c, 0xc2, 0x7c,
-	0xc2, 0xb6, 0x7d, 0xe6, 0x70, 0x2d, 0x9f, 0x39, 0x52, 0xad, 0x3c, 0xef, 0x10, 0x87, 0x88, 0xff,
-	0x6e, 0xf3, 0xff, 0xa9, 0xd5, 0x59, 0xe4, 0xbb, 0x01, 0xd9, 0x16, 0xff, 0xaa, 0x25, 0x3d, 0x6b,
-	0xe6, 0x32, 0xc4, 0x4c, 0x49, 0x96, 0x24, 0xb6, 0x29, 0x51, 0xe4, 0x47, 0xcf, 0x4d, 0x01, 0x09,
-	0x2c, 0xac, 0x24, 0xe5, 0x8c, 0x84, 0xe2, 0xb0, 0x19, 0x61, 0x1a, 0x03, 0x66, 0x64, 0xe7, 0x84,
-	0x9e, 0xc5, 0xa2, 0xf5, 0x3f, 0x96, 0x61, 0xea, 0xc7, 0x61, 0xe4, 0x92, 0x00, 0x79, 0x47, 0x88,
-	0x22, 0x9f, 0x69, 0x3a, 0x8c, 0xb5, 0x30, 0xe5, 0xea, 0x7a, 0x61, 0x6d, 0xa8, 0x3a, 0x61, 0xc4,
-	0x9f, 0xda, 0xf7, 0x60, 0xc9, 0x47, 0x17, 0x26, 0xc3, 0xd4, 0x45, 0x9e, 0xfb, 0x29, 0xb6, 0x4d,
-	0x9f, 0x39, 0xa6, 0x87, 0x03, 0x27, 0x6a, 0xe8, 0xd7, 0xd7, 0x86, 0xaa, 0x43, 0xc6, 0xa2, 0x8f,
-	0x2e, 0x9e, 0x25, 0xf2, 0x1a, 0x73, 0x9e, 0x0a, 0xa9, 0x86, 0x60, 0xc6, 0x77, 0x03, 0x33, 0x22,
-	0xa1, 0x6b, 0x99, 0xe7, 0xd8, 0x75, 0x1a, 0x91, 0x3e, 0xc4, 0xd1, 0x1f, 0x7d, 0xe7, 0xf3, 0x2f,
-	0x57, 0xaf, 0xfd, 0xfb, 0xcb, 0xd5, 0x6d, 0xc7, 0x8d, 0x1a, 0xcd, 0xd3, 0x2d, 0x8b, 0xf8, 0xdb,
-	0xc8, 0xf3, 0x08, 0x45, 0xef, 0x07, 0x38, 0xe2, 0xce, 0xc6, 0x9f, 0x56, 0x03, 0xb9, 0xc1, 0xb6,
-	0x8f, 0xa2, 0xc6, 0xd6, 0x1e, 0xb6, 0x8c, 0x29, 0xdf, 0x0d, 0x8e, 0x39, 0xde, 0x89, 0x80, 0xd3,
-	0xb6, 0x61, 0x9e, 0x7b, 0x27, 0x4c, 0x30, 0x33, 0xc4, 0xd4, 0x3c, 0xf5, 0x88, 0x75, 0xa6, 0x0f,
-	0xaf, 0x0d, 0x55, 0x87, 0x8d, 0x59, 0x1f, 0x5d, 0x08, 0x6d, 0x76, 0x84, 0xe9, 0x23, 0x2e, 0xd0,
-	0xea, 0xb0, 0x48, 0xf1, 0xcf, 0x9a, 0x2e, 0xe5, 0x81, 0xb8, 0x81, 0xeb, 0x37, 0x7d, 0x93, 0x45,
-	0xe8, 0x0c, 0xeb, 0x23, 0xc2, 0xb3, 0x0f, 0x94, 0x67, 0x0b, 0xf2, 0x08, 0x98, 0x7d, 0xb6, 0xe5,
-	0x12, 0x69, 0xff, 0x20, 0x88, 0xfe, 0xf1, 0xe7, 0xf7, 0x41, 0x9d, 0xcd, 0x41, 0x10, 0xfd, 0xe9,
-	0xab, 0xcf, 0xee, 0x17, 0x8c, 0xf9, 0x18, 0xaf, 0x26, 0xe1, 0x9e, 0x71, 0x34, 0x4e, 0x1b, 0xc5,
-	0x3e, 0x69, 0x61, 0x89, 0x6e, 0xda, 0xd8, 0x43, 0x97, 0xe6, 0xb9, 0x1b, 0xd8, 0xe4, 0x5c, 0x1f,
-	0x95, 0xb4, 0x49, 0x05, 0xa1, 0xbf, 0xc7, 0xc5, 0x27, 0x42, 0xaa, 0x55, 0x25, 0x6d, 0x38, 0x24,
-	0x56, 0x23, 0x26, 0x7a, 0x4c, 0xec, 0xe0, 0xd1, 0x3f, 0xe6, 0xcb, 0x8a, 0xe0, 0x97, 0x50, 0x3a,
-	0xc5, 0x11, 0x32, 0x71, 0x10, 0x51, 0x12, 0x5e, 0xea, 0xe3, 0xf9, 0xc8, 0x2d, 0x72, 0xb0, 0xc7,
-	0x12, 0x4b, 0xfb, 0x04, 0x26, 0x3d, 0x8c, 0x68, 0xe0, 0x06, 0x8e, 0x49, 0x51, 0x84, 0xf5, 0x89,
-	0x7c, 0xe0, 0xa5, 0x18, 0xcd, 0x40, 0x11, 0xd6, 0x7c, 0xe0, 0x97, 0xc6, 0x74, 0x28, 0xb2, 0x5d,
-	0x1c, 0x44, 0x66, 0xd4, 0xa0, 0x98, 0x35, 0x88, 0x67, 0xeb, 0x90, 0xcf, 0x0c, 0xbf, 0x0e, 0x1f,
-	0x2a, 0xd4, 0xe3, 0x18, 0x54, 0xc3, 0xa0, 0x71, 0x4a, 0xe5, 0x51, 0xd4, 0x29, 0xb2, 0xf8, 0xe5,
-	0xd7, 0x8b, 0xf9, 0x4c, 0xf1, 0x53, 0x12, 0x87, 0xb7, 0xaf, 0x00, 0xb5, 0xc7, 0xb0, 0xca, 0xa3,
-	0x6a, 0x06, 0xf5, 0xa6, 0x57, 0x77, 0x3d, 0x0f, 0xdb, 0xa6, 0x4c, 0x3c, 0x93, 0xdf, 0x11, 0xcc,
-	0x22, 0xa6, 0x4f, 0x8a, 0x8b, 0xb9, 0xec, 0xa3, 0x8b, 0xe7, 0x6d, 0xad, 0x13, 0xa1, 0x64, 0x28,
-	0x1d, 0xed, 0x43, 0x58, 0xeb, 0x84, 0x51, 0xb9, 0xdd, 0xc6, 0x99, 0x12, 0x38, 0x2b, 0x59, 0x1c,
-	0x43, 0x6a, 0x25, 0x40, 0x9f, 0xc2, 0x8a, 0x4c, 0x3e, 0x8a, 0xcf, 0x11, 0xb5, 0x55, 0xfc, 0xae,
-	0x1f, 0x12, 0x1a, 0xa1, 0xc0, 0xc2, 0xfa, 0x74, 0x3e, 0x06, 0xca, 0x02, 0xdd, 0x10, 0xe0, 0x82,
-	0x89, 0x83, 0x04, 0x5a, 0xfb, 0x55, 0x01, 0x36, 0x32, 0xc6, 0xeb, 0x18, 0x9b, 0x14, 0xb7, 0x70,
-	0xd0, 0xcc, 0xb8, 0x30, 0x93, 0xcf, 0x85, 0xd5, 0x94, 0x0b, 0xfb, 0x18, 0x1b, 0xd2, 0x40, 0xca,
-	0x0f, 0x0c, 0x5a, 0xc6, 0x0d, 0xe4, 0x85, 0x0d, 0xa4, 0xcf, 0xe6, 0x3c, 0xfa, 0x94, 0xd5, 0x5d,
-	0x0e, 0xa8, 0x59, 0x30, 0x1b, 0x21, 0x76, 0x96, 0xb5, 0xa2, 0xe5, 0xb3, 0x32, 0xcd, 0x11, 0xd3,
-	0x46, 0x38, 0xa7, 0x2d, 0xe4, 0xb9, 0x36, 0x8a, 0x08, 0x65, 0x66, 0x8b, 0x99, 0x72, 0x23, 0x2f,
-	0x7c, 0x16, 0x4f, 0x23, 0x69, 0x5d, 0x9f, 0xcb, 0xc9, 0x69, 0xdb, 0xc6, 0x0b, 0xb6, 0x2b, 0x54,
-	0x8e, 0xa4, 0x01, 0xe9, 0x8c, 0xf6, 0x03, 0xb8, 0x29, 0x7a, 0x02, 0xf2, 0x43, 0x0f, 0x33, 0x33,
-	0x22, 0x26, 0xb3, 0x90, 0x87, 0x4d, 0x66, 0x11, 0x8a, 0x99, 0x3e, 0x2f, 0xee, 0xe6, 0x0d, 0xde,
-	0x15, 0xa4, 0xc6, 0x31, 0x79, 0xc6, 0xe5, 0xcf, 0x84, 0x58, 0x7b, 0x08, 0x65, 0x55, 0xb3, 0x4d,
-	0x37, 0xa8, 0x63, 0x8a, 0xa9, 0x80, 0x50, 0xbe, 0x2f, 0x88, 0xcd, 0x8b, 0xb2, 0x72, 0x1f, 0x28,
-	0xf9, 0x31, 0x51, 0x96, 0x7f, 0x08, 0x2b, 0xf1, 0xde, 0x3a, 0xa1, 0xd8, 0x42, 0x2c, 0xca, 0x6e,
-	0x5f, 0x14, 0xdb, 0x97, 0xe4, 0xf6, 0xfd, 0xb6, 0x4a, 0x82, 0x90, 0xb2, 0xae, 0x92, 0x2a, 0xbd,
-	0xfd, 0x46, 0xda, 0xba, 0x4a, 0xa7, 0xf6, 0xde, 0x97, 0x30, 0x63, 0x51, 0x8c, 0x22, 0xac, 0x7a,
-	0x5a, 0x1d, 0x63, 0x5d, 0x7f, 0xcb, 0xb6, 0x31, 0x25, 0x91, 0x44, 0x7b, 0xda, 0xc7, 0x58, 0xfb,
-	0x3e, 0x94, 0x93, 0x6a, 0x68, 0x63, 0x26, 0x8e, 0x93, 0x3b, 0xea, 0x72, 0x0f, 0xf4, 0x25, 0x49,
-	0x69, 0xac, 0xb1, 0x27, 0x15, 0x6a, 0xe8, 0xe2, 0x80, 0x8b, 0xb5, 0x27, 0xb0, 0xc1, 0x75, 0x29,
-	0x8e, 0xa8, 0x2b, 0x0f, 0x44, 0xd6, 0x04, 0x53, 0x4c, 0x0b, 0x4c, 0x55, 0x21, 0xbd, 0x2c, 0xba,
-	0x48, 0xc5, 0x47, 0x17, 0x86, 0xd4, 0x3c, 0x26, 0xfb, 0x42, 0xef, 0x50, 0xa8, 0xc9, 0x32, 0xa4,
-	0xd5, 0x60, 0x73, 0x20, 0x98, 0xa2, 0x4d, 0xbf, 0x29, 0xd0, 0x56, 0xfb, 0xa1, 0x29, 0xf6, 0xb4,
-	0x9f, 0xc0, 0x0c, 0xc5, 0x8e, 0xcb, 0x22, 0x8a, 0x78, 0x91, 0x14, 0xa4, 0x2d, 0xbf, 0x25, 0x69,
-	0xd3, 0x69, 0x24, 0xce, 0xda, 0x7b, 0xa0, 0xd9, 0xb8, 0x8e, 0x9a, 0x5e, 0x64, 0x86, 0xc8, 0xc1,
-	0xa6, 0xe7, 0xfa, 0x6e, 0xa4, 0xaf, 0x08, 0xb6, 0x66, 0x94, 0xe4, 0x08, 0x39, 0xf8, 0x29, 0x5f,
-	0xd7, 0x36, 0x61, 0x8a, 0x47, 0x96, 0xd2, 0xac, 0x08, 0xcd, 0x92, 0x8f, 0x2e, 0xda, 0x5a, 0xfc,
-	0x8e, 0x75, 0xf4, 0x5f, 0x93, 0x62, 0x8b, 0x50, 0x5b, 0x6d, 0x5a, 0x15, 0x81, 0x2f, 0x65, 0x9b,
-	0xb1, 0x21, 0x34, 0x24, 0x42, 0x15, 0x66, 0xc4, 0x18, 0x22, 0x27, 0x12, 0x9f, 0x04, 0x51, 0x43,
-	0x5f, 0x13, 0x96, 0xa6, 0xe4, 0xfa, 0x11, 0xa6, 0x35, 0xbe, 0xca, 0xab, 0x53, 0x18, 0xd7, 0x0c,
-	0x99, 0x0c, 0xbc, 0x26, 0xde, 0xca, 0x59, 0x9d, 0x42, 0x79, 0x5f, 0x0f, 0x62, 0x40, 0x5e, 0x9d,
-	0x12, 0x33, 0x71, 0xde, 0xe8, 0xeb, 0x39, 0xab, 0x93, 0xb2, 0x12, 0x27, 0x19, 0x1f, 0xf7, 0x12,
-	0x23, 0xf1, 0x1d, 0xd9, 0xc8, 0x39, 0xee, 0x29, 0x1b, 0xf1, 0x5d, 0xc2, 0xa0, 0x59, 0xdd, 0x74,
-	0x6d, 0xe6, 0xa4, 0xcb, 0xea, 0x41, 0x97, 0xd5, 0x45, 0xd7, 0xed, 0x9c, 0x74, 0x59, 0x1d, 0x74,
-	0x1d, 0xc2, 0xa8, 0x65, 0x06, 0x84, 0xfa, 0xfa, 0x9d, 0x7c, 0xc8, 0x23, 0xd6, 0x21, 0xa1, 0xbe,
-	0x76, 0x0e, 0xcb, 0x49, 0x55, 0x4a, 0x1a, 0xad, 0x8d, 0x2d, 0x74, 0x29, 0xe7, 0xb7, 0xbb, 0xf9,
-	0xac, 0xe8, 0x91, 0xaa, 0x54, 0xaa, 0xc5, 0xee, 0x71, 0x64, 0x31, 0xcb, 0xfd, 0x14, 0xa6, 0x71,
-	0xc8, 0x5c, 0x8f, 0x04, 0xc9, 0xb1, 0x57, 0x73, 0x1e, 0xbb, 0xc2, 0x8b, 0x8f, 0xbd, 0x05, 0x37,
-	0x45, 0x46, 0xd6, 0xeb, 0xd8, 0x8a, 0xdc, 0x56, 0x5c, 0x7e, 0x55, 0x90, 0xfa, 0xbd, 0x9c, 0x91,
-	0xf1, 0x44, 0x8e, 0xa1, 0x8f, 0x65, 0x63, 0x17, 0xc0, 0xeb, 0x1e, 0x4c, 0xd7, 0x98, 0xf3, 0x3c,
-	0xb4, 0x51, 0x84, 0xd5, 0x43, 0x69, 0x11, 0x46, 0x19, 0x0e, 0x6c, 0x4c, 0xf5, 0xc2, 0x5a, 0xa1,
-	0x3a, 0x61, 0xa8, 0x2f, 0xed, 0x5b, 0x30, 0x1a, 0x0a, 0x0d, 0xfd, 0xfa, 0x5a, 0xa1, 0x5a, 0xdc,
-	0x59, 0xde, 0x4a, 0x3f, 0x1d, 0xb7, 0xb2, 0xcf, 0x2d, 0x43, 0xe9, 0x3e, 0x2c, 0xfe, 0xf2, 0xab,
-	0xcf, 0xee, 0x2b, 0x88, 0xf5, 0x25, 0xb8, 0xd1, 0x61, 0xcd, 0xc0, 0x2c, 0x24, 0x01, 0xc3, 0xeb,
-	0xff, 0x1d, 0x86, 0xd9, 0x1a, 0x73, 0x7e, 0x24, 0x5a, 0xc6, 0x21, 0x3e, 0x17, 0x5e, 0xf2, 0x47,
-	0x9b, 0x68, 0x22, 0x24, 0x76, 0x26, 0xfe, 0xd4, 0xca, 0x30, 0xee, 0xe3, 0x08, 0xd9, 0x28, 0x42,
-	0xc2, 0x9f, 0x09, 0x23, 0xf9, 0xd6, 0x56, 0x00, 0x3c, 0xc2, 0x98, 0xe9, 0x11, 0xc7, 0xb5, 0xf4,
-	0x21, 0x21, 0x9d, 0xe0, 0x2b, 0x4f, 0xf9, 0x82, 0xb6, 0x0a, 0x45, 0x21, 0xf6, 0x71, 0xd4, 0x20,
-	0xb6, 0x3e, 0x2c, 0xe4, 0x62, 0x47, 0x4d, 0xac, 0x68, 0x77, 0x61, 0x3a, 0x49, 0x3d, 0x05, 0x32,
-	0x22, 0x94, 0xa6, 0x92, 0x65, 0x89, 0x74, 0x0f, 0x66, 0xda, 0x8a, 0x0a, 0x6e, 0x54, 0x68, 0xb6,
-	0x01, 0x14, 0xe6, 0x2d, 0x28, 0x75, 0x3c, 0x77, 0x0a, 0xd5, 0x21, 0xa3, 0x88, 0x53, 0x6f, 0x9d,
-	0x2a, 0xcc, 0x38, 0x94, 0x34, 0x03, 0xdb, 0x8c, 0x68, 0x33, 0x6a, 0x98, 0x1e, 0x72, 0xf4, 0x71,
-	0xa1, 0x36, 0x25, 0xd7, 0x8f, 0xf9, 0xf2, 0x53, 0xe4, 0xf0, 0x08, 0xe2, 0x9e, 0x80, 0xa8, 0xa3,
-	0x4f, 0xc8, 0x08, 0xd4, 0xd2, 0x2e, 0x75, 0x78, 0xe6, 0x85, 0x32, 0xf3, 0x80, 0xcb, 0x72, 0x64,
-	0x5e, 0x28, 0x32, 0xef, 0x25, 0x94, 0xc4, 0xbc, 0x67, 0x52, 0xec, 0x50, 0x1c, 0xe9, 0xc5, 0x7c,
-	0xa8, 0x45, 0x01, 0x66, 0x08, 0x2c, 0xed, 0x36, 0x4c, 0x71, 0xad, 0x73, 0x33, 0xc0, 0x0e, 0xe2,
-	0x17, 0x54, 0x2f, 0xad, 0x15, 0xaa, 0xe3, 0xc6, 0xa4, 0x58, 0x3d, 0x54, 0x8b, 0xda, 0xc7, 0x30,
-	0xa6, 0x72, 0x46, 0x9f, 0xcc, 0x67, 0x3d, 0xc6, 0x79, 0x58, 0xe2, 0x77, 0x33, 0xbe, 0x51, 0xeb,
-	0xdf, 0x86, 0xa5, 0xae, 0x0b, 0x18, 0x5f, 0x4f, 0x6d, 0x09, 0xc6, 0x65, 0x46, 0xba, 0xb6, 0xb8,
-	0x89, 0xc3, 0xc6, 0x98, 0xf8, 0x3e, 0xb0, 0xd7, 0x7f, 0x7d, 0x1d, 0x6e, 0xd6, 0x98, 0x73, 0x10,
-	0x30, 0x4c, 0xa3, 0x47, 0x4d, 0xef, 0x4c, 0xe5, 0xf4, 0x11, 0xba, 0xf4, 0x08, 0xb2, 0xfb, 0xe6,
-	0xd3, 0x73, 0x58, 0xe8, 0x78, 0xf3, 0xc8, 0xf1, 0x43, 0xa5, 0xd7, 0xad, 0x6c, 0x7a, 0x65, 0x1f,
-	0x3e, 0x62, 0xfe, 0x30, 0xe6, 0x68, 0xf7, 0x62, 0xc6, 0xd3, 0xa1, 0x8c, 0xa7, 0xda, 0x71, 0xdb,
-	0x62, 0x0b, 0x79, 0x4d, 0x6c, 0x9e, 0x36, 0x03, 0xdb, 0xc3, 0x4c, 0xfc, 0x2d, 0xa1, 0xb8, 0xb3,
-	0xd6, 0xd3, 0xe2, 0x0b, 0xae, 0xf9, 0x48, 0x28, 0x26, 0x06, 0x53, 0x6b, 0x1d, 0x19, 0x7e, 0x1b,
-	0x36, 0x06, 0x70, 0x91, 0x64, 0xfb, 0x3f, 0x0b, 0x50, 0xce, 0xe8, 0xc9, 0xc1, 0xec, 0x4d, 0x94,
-	0xdd, 0x83, 0x91, 0x34, 0x45, 0x73, 0x59, 0x87, 0x25, 0x29, 0x52, 0x63, 0x10, 0x0d, 0x87, 0x30,
-	0xa7, 0xde, 0xac, 0xbc, 0x5a, 0x74, 0x90, 0x50, 0xc9, 0x62, 0x4a, 0xbf, 0xf6, 0x50, 0x84, 0x14,
-	0x05, 0xb3, 0xe7, 0x1d, 0x2b, 0x1d, 0x04, 0x6c, 0xc2, 0x7a, 0xff, 0xc0, 0x92, 0xf8, 0xff, 0x5e,
-	0x80, 0x62, 0x8d, 0x39, 0x86, 0x98, 0xf5, 0x30, 0xed, 0x1b, 0x70, 0x05, 0x8a, 0x9e, 0x7b, 0x6a,
-	0x86, 0x3b, 0xa1, 0x79, 0x86, 0x2f, 0x55, 0xa1, 0x9b, 0xf0, 0xdc, 0xd3, 0xa3, 0x9d, 0xf0, 0x09,
-	0xbe, 0xd4, 0x36, 0x60, 0xd2, 0x6f, 0x7a, 0x91, 0x6b, 0x22, 0xdb, 0xa6, 0x98, 0x31, 0x55, 0xec,
-	0x4a, 0x62, 0x71, 0x57, 0xae, 0x65, 0xa8, 0x18, 0xce, 0x52, 0x31, 0x0f, 0x23, 0xe4, 0x3c, 0xc0,
-	0x54, 0xd5, 0x37, 0xf9, 0xc1, 0xeb, 0xa7, 0xdb, 0x1e, 0x82, 0x47, 0x45, 0x36, 0x4e, 0xb8, 0xf1,
-	0xb8, 0x9b, 0x8d, 0xf7, 0x00, 0xe6, 0x52, 0x81, 0x24, 0xf9, 0xa2, 0xc3, 0x18, 0x6b, 0x5a, 0x16,
-	0x77, 0xa9, 0x20, 0xf6, 0xc7, 0x9f, 0x5c, 0xe2, 0x63, 0xc6, 0x90, 0x83, 0x55, 0x38, 0xf1, 0xe7,
-	0x7a, 0x0b, 0x16, 0x04, 0x94, 0x4f, 0x5a, 0xd8, 0x48, 0x4d, 0xc1, 0x7d, 0xd9, 0x49, 0x07, 0x76,
-	0x3d, 0x1b, 0x58, 0x36, 0x84, 0xa1, 0x81, 0x21, 0x3c, 0x83, 0x95, 0x9e, 0x76, 0x73, 0x05, 0xf3,
-	0x3b, 0x79, 0xc2, 0xbb, 0xb6, 0xfc, 0xb3, 0xc1, 0xdb, 0xc4, 0xf0, 0x11, 0x8c, 0x22, 0x9f, 0x34,
-	0x83, 0x48, 0x9e, 0xea, 0x5b, 0x3c, 0x26, 0xd4, 0xfe, 0x6c, 0xb8, 0x0b, 0xe2, 0xc4, 0x62, 0xc7,
-	0x92, 0x2b, 0xf9, 0x87, 0x02, 0x4c, 0x25, 0x34, 0x7c, 0xdd, 0x7c, 0xd6, 0x61, 0x31, 0xeb, 0x5b,
-	0xe2, 0xf6, 0x0b, 0x98, 0xe7, 0x55, 0x1b, 0x05, 0x16, 0xf6, 0xf2, 0xf9, 0x9e, 0xb5, 0x58, 0x81,
-	0xe5, 0x5e, 0xb8, 0x89, 0xdd, 0xbf, 0x14, 0x60, 0xa6, 0xc6, 0x9c, 0x3d, 0xec, 0xf1, 0x06, 0xf5,
-	0xf6, 0x84, 0xe9, 0x30, 0x96, 0xbe, 0xa5, 0x13, 0x46, 0xfc, 0x99, 0xa2, 0x72, 0xf8, 0x5d, 0x52,
-	0x59, 0x06, 0xbd, 0xd3, 0xef, 0x24, 0xa8, 0xbf, 0x16, 0x52, 0x3c, 0x5f, 0x2d, 0xb4, 0x94, 0xff,
-	0xd7, 0xb3, 0xfe, 0x0f, 0xa8, 0xc0, 0xff, 0xa7, 0xd0, 0xd6, 0xa0, 0xd2, 0xdb, 0xfb, 0x24, 0xc0,
-	0xdf, 0x16, 0xba, 0x8e, 0x35, 0xf7, 0x09, 0x2e, 0xc3, 0x84, 0x2d, 0x31, 0x48, 0x7c, 0x86, 0xed,
-	0x85, 0x34, 0x3f, 0xc3, 0x19, 0x7e, 0xb2, 0xae, 0xdf, 0x81, 0xcd, 0x41, 0x7e, 0x25, 0x01, 0xfc,
-	0xbe, 0x00, 0xa5, 0x1a, 0x73, 0xf6, 0xf9, 0x34, 0x28, 0x26, 0xe4, 0xaf, 0x4f, 0x8e, 0x2e, 0x8a,
-	0x4c, 0x4c, 0x3c, 0x4b, 0x5c, 0x3e, 0x11, 0x77, 0x6a, 0xd7, 0xb6, 0x8f, 0xc9, 0x49, 0xc3, 0x8d,
-	0xb0, 0xe7, 0xb2, 0x68, 0xd7, 0xf6, 0xdd, 0x60, 0xd0, 0x9d, 0x8a, 0xfb, 0x99, 0xba, 0x53, 0xea,
-	0xb3, 0xd7, 0x71, 0xf7, 0x00, 0x4e, 0x4c, 0x7f, 0x22, 0x26, 0x33, 0xc9, 0xe7, 0x3e, 0x25, 0xfe,
-	0xbb, 0xb5, 0x2f, 0x67, 0x9d, 0x7e, 0xe8, 0x89, 0x13, 0xf1, 0xad, 0xe4, 0x4f, 0xe3, 0xde, 0x87,
-	0x4a, 0x55, 0xd6, 0x75, 0x69, 0xbc, 0xd3, 0x82, 0x92, 0x71, 0x7e, 0xe7, 0x6f, 0x25, 0x18, 0xaa,
-	0x31, 0x47, 0x3b, 0x86, 0x52, 0xe6, 0xf5, 0xb7, 0x92, 0x9d, 0x7f, 0x3a, 0x9e, 0x6b, 0xe5, 0xdb,
-	0x03, 0xc5, 0x49, 0xc7, 0x6c, 0xc2, 0x8d, 0x7e, 0xb3, 0x5d, 0xb5, 0x0b, 0xa1, 0x8f, 0x66, 0xf9,
-	0x83, 0xab, 0x6a, 0x26, 0x66, 0x5f, 0xc2, 0x54, 0xc7, 0x03, 0x72, 0xb5, 0x0b, 0x23, 0xab, 0x50,
-	0xbe, 0xfb, 0x06, 0x85, 0x04, 0xfb, 0x23, 0x18, 0x4f, 0xc6, 0xb5, 0xa5, 0xae, 0x4d, 0xb1, 0xa8,
-	0x7c, 0xab, 0xaf, 0x28, 0x41, 0xaa, 0x83, 0xd6, 0x63, 0xc8, 0xd9, 0xe8, 0xb1, 0xb1, 0x53, 0xa9,
-	0xfc, 0x8d, 0x2b, 0x28, 0x25, 0x76, 0x2e, 0x40, 0xef, 0xfb, 0x28, 0xb9, 0x37, 0x80, 0xdb, 0xac,
-	0x6a, 0xf9, 0xc1, 0x95, 0x55, 0xd3, 0x5c, 0x25, 0x83, 0x4f, 0x37, 0x57, 0xb1, 0xa8, 0x07, 0x57,
-	0x9d, 0x53, 0x89, 0xf6, 0x31, 0x14, 0xd3, 0x5d, 0x7d, 0xb9, 0x4f, 0xfc, 0x12, 0x6f, 0x73, 0x90,
-	0x34, 0x81, 0xb4, 0x60, 0xb6, 0x7b, 0x5c, 0x58, 0xef, 0xbe, 0x06, 0x9d, 0x3a, 0xe5, 0xfb, 0x6f,
-	0xd6, 0x49, 0x8c, 0x9c, 0xc0, 0x64, 0x36, 0x93, 0x2b, 0x5d, 0x9b, 0x33, 0xf2, 0xf2, 0x9d, 0xc1,
-	0xf2, 0x04, 0xd8, 0x85, 0xb9, 0x5e, 0x85, 0xa2, 0x57, 0xe8, 0x5d, 0x5a, 0xe5, 0xf7, 0xae, 0xa2,
-	0x95, 0x35, 0xd5, 0xdd, 0x22, 0xfb, 0xb1, 0xfc, 0x66, 0x53, 0x7d, 0xdb, 0x9a, 0xf6, 0x73, 0x58,
-	0xea, 0xdf, 0x93, 0x07, 0xf3, 0x9e, 0x35, 0xbb, 0x73, 0x75, 0xdd, 0xc4, 0xf8, 0x13, 0x98, 0x68,
-	0xf7, 0xd3, 0x72, 0x17, 0x40, 0x22, 0x2b, 0xaf, 0xf7, 0x97, 0xa5, 0x49, 0xeb, 0xd5, 0xea, 0x36,
-	0x7b, 0x5d, 0xf5, 0x4e, 0xad, 0x1e, 0xa4, 0x0d, 0xe8, 0x6e, 0x3c, 0xbf, 0xfb, 0xb6, 0xb6, 0x7b,
-	0x7d, 0xe8, 0xef, 0x56, 0xed, 0x91, 0xdf, 0x6f, 0x6a, 0x69, 0xe5, 0x91, 0x5f, 0xf0, 0x31, 0xe0,
-	0x91, 0xf1, 0xf9, 0xab, 0x4a, 0xe1, 0x8b, 0x57, 0x95, 0xc2, 0x7f, 0x5e, 0x55, 0x0a, 0xbf, 0x79,
-	0x5d, 0xb9, 0xf6, 0xc5, 0xeb, 0xca, 0xb5, 0x7f, 0xbd, 0xae, 0x5c, 0x7b, 0xf9, 0xdd, 0x2b, 0xfe,
-	0x4d, 0xe6, 0x62, 0xbb, 0xfd, 0x1b, 0x0e, 0xf1, 0x5b, 0x91, 0xd3, 0x51, 0xf1, 0x03, 0x8e, 0x6f,
-	0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x5e, 0xa3, 0x5d, 0xaf, 0x22, 0x00, 0x00,
+func (m *MsgAddToWhitelistAdminResponse) Reset()         { *m = MsgAddToWhitelistAdminResponse{} }
+func (m *MsgAddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToWhitelistAdminResponse) ProtoMessage()    {}
+func (*MsgAddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{48}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
-	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
-	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
-	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
-	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
-	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
-	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
-	CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error)
-	DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error)
-	RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error)
-	RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error)
-	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
-	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+func (m *MsgAddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddToWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToWhitelistAdminResponse.Merge(m, src)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddToWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error) {
-	out := new(MsgInsertBulkWorkerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkWorkerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddToWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
-	out := new(MsgCreateNewTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CreateNewTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgAddToWhitelistAdminResponse proto.InternalMessageInfo
 
-func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
-	out := new(MsgRegisterResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRemoveFromWhitelistAdmin struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error) {
-	out := new(MsgRemoveRegistrationResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveRegistration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRemoveFromWhitelistAdmin) Reset()         { *m = MsgRemoveFromWhitelistAdmin{} }
+func (m *MsgRemoveFromWhitelistAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromWhitelistAdmin) ProtoMessage()    {}
+func (*MsgRemoveFromWhitelistAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{49}
 }
-
-func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
-	out := new(MsgInsertBulkReputerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkReputerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRemoveFromWhitelistAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error) {
-	out := new(MsgAddStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromWhitelistAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromWhitelistAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveFromWhitelistAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromWhitelistAdmin.Merge(m, src)
+}
+func (m *MsgRemoveFromWhitelistAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromWhitelistAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromWhitelistAdmin.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error) {
-	out := new(MsgRemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveFromWhitelistAdmin proto.InternalMessageInfo
+
+func (m *MsgRemoveFromWhitelistAdmin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error) {
-	out := new(MsgCancelRemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromWhitelistAdmin) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error) {
-	out := new(MsgDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/DelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRemoveFromWhitelistAdminResponse struct {
 }
 
-func (c *msgClient) RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error) {
-	out := new(MsgRewardDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RewardDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromWhitelistAdminResponse) Reset()         { *m = MsgRemoveFromWhitelistAdminResponse{} }
+func (m *MsgRemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromWhitelistAdminResponse) ProtoMessage()    {}
+func (*MsgRemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{50}
+}
+func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse.Merge(m, src)
+}
+func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error) {
-	out := new(MsgRemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse proto.InternalMessageInfo
+
+// Grant a single named role, e.g. "params-admin", to an address.
+// The sender must hold the "admin-manager" role.
+type MsgGrantRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *msgClient) CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error) {
-	out := new(MsgCancelRemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantRole) Reset()         { *m = MsgGrantRole{} }
+func (m *MsgGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRole) ProtoMessage()    {}
+func (*MsgGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{51}
+}
+func (m *MsgGrantRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRole.Merge(m, src)
+}
+func (m *MsgGrantRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRole.DiscardUnknown(m)
 }
 
-func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error) {
-	out := new(MsgFundTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/FundTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantRole proto.InternalMessageInfo
+
+func (m *MsgGrantRole) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error) {
-	out := new(MsgAddToWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddToWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantRole) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error) {
-	out := new(MsgRemoveFromWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveFromWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantRole) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
-	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
-	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
-	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
-	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
-	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
-	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
-	CancelRemoveStake(context.Context, *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error)
-	DelegateStake(context.Context, *MsgDelegateStake) (*MsgDelegateStakeResponse, error)
-	RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error)
-	RemoveDelegateStake(context.Context, *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error)
-	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
-	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+type MsgGrantRoleResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgGrantRoleResponse) Reset()         { *m = MsgGrantRoleResponse{} }
+func (m *MsgGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleResponse) ProtoMessage()    {}
+func (*MsgGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{52}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgGrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) InsertBulkWorkerPayload(ctx context.Context, req *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
+func (m *MsgGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateNewTopic(ctx context.Context, req *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
+func (m *MsgGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Register(ctx context.Context, req *MsgRegister) (*MsgRegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (m *MsgGrantRoleResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveRegistration(ctx context.Context, req *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+func (m *MsgGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) InsertBulkReputerPayload(ctx context.Context, req *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
+
+var xxx_messageInfo_MsgGrantRoleResponse proto.InternalMessageInfo
+
+// Revoke a single named role from an address.
+// The sender must hold the "admin-manager" role.
+type MsgRevokeRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
-func (*UnimplementedMsgServer) AddStake(ctx context.Context, req *MsgAddStake) (*MsgAddStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+
+func (m *MsgRevokeRole) Reset()         { *m = MsgRevokeRole{} }
+func (m *MsgRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRole) ProtoMessage()    {}
+func (*MsgRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{53}
 }
-func (*UnimplementedMsgServer) RemoveStake(ctx context.Context, req *MsgRemoveStake) (*MsgRemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+func (m *MsgRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelRemoveStake(ctx context.Context, req *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+func (m *MsgRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DelegateStake(ctx context.Context, req *MsgDelegateStake) (*MsgDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+func (m *MsgRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRole.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RewardDelegateStake(ctx context.Context, req *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+func (m *MsgRevokeRole) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveDelegateStake(ctx context.Context, req *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+func (m *MsgRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRole.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CancelRemoveDelegateStake(ctx context.Context, req *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+
+var xxx_messageInfo_MsgRevokeRole proto.InternalMessageInfo
+
+func (m *MsgRevokeRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) FundTopic(ctx context.Context, req *MsgFundTopic) (*MsgFundTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+
+func (m *MsgRevokeRole) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) AddToWhitelistAdmin(ctx context.Context, req *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+
+func (m *MsgRevokeRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RemoveFromWhitelistAdmin(ctx context.Context, req *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+
+type MsgRevokeRoleResponse struct {
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRevokeRoleResponse) Reset()         { *m = MsgRevokeRoleResponse{} }
+func (m *MsgRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{54}
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInsertBulkWorkerPayload)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/InsertBulkWorkerPayload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, req.(*MsgInsertBulkWorkerPayload))
+func (m *MsgRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateNewTopic)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateNewTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/CreateNewTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateNewTopic(ctx, req.(*MsgCreateNewTopic))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleResponse.DiscardUnknown(m)
 }
 
-func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegister)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Register(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/Register",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Register(ctx, req.(*MsgRegister))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRevokeRoleResponse proto.InternalMessageInfo
+
+type MsgRewardDelegateStakeResponse struct {
 }
 
-func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveRegistration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveRegistration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveRegistration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveRegistration(ctx, req.(*MsgRemoveRegistration))
+func (m *MsgRewardDelegateStakeResponse) Reset()         { *m = MsgRewardDelegateStakeResponse{} }
+func (m *MsgRewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRewardDelegateStakeResponse) ProtoMessage()    {}
+func (*MsgRewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{55}
+}
+func (m *MsgRewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRewardDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRewardDelegateStakeResponse.Merge(m, src)
+}
+func (m *MsgRewardDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRewardDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRewardDelegateStakeResponse.DiscardUnknown(m)
 }
 
-func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInsertBulkReputerPayload)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InsertBulkReputerPayload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/InsertBulkReputerPayload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InsertBulkReputerPayload(ctx, req.(*MsgInsertBulkReputerPayload))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRewardDelegateStakeResponse proto.InternalMessageInfo
+
+type MsgRewardDelegateStake struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
 
-func _Msg_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/AddStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddStake(ctx, req.(*MsgAddStake))
+func (m *MsgRewardDelegateStake) Reset()         { *m = MsgRewardDelegateStake{} }
+func (m *MsgRewardDelegateStake) String() string { return proto.CompactTextString(m) }
+func (*MsgRewardDelegateStake) ProtoMessage()    {}
+func (*MsgRewardDelegateStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{56}
+}
+func (m *MsgRewardDelegateStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRewardDelegateStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRewardDelegateStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRewardDelegateStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRewardDelegateStake.Merge(m, src)
+}
+func (m *MsgRewardDelegateStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRewardDelegateStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRewardDelegateStake.DiscardUnknown(m)
 }
 
-func _Msg_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveStake(ctx, req.(*MsgRemoveStake))
+var xxx_messageInfo_MsgRewardDelegateStake proto.InternalMessageInfo
+
+func (m *MsgRewardDelegateStake) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRemoveStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/CancelRemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRemoveStake(ctx, req.(*MsgCancelRemoveStake))
+func (m *MsgRewardDelegateStake) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/DelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateStake(ctx, req.(*MsgDelegateStake))
+func (m *MsgRewardDelegateStake) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRewardDelegateStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RewardDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RewardDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RewardDelegateStake(ctx, req.(*MsgRewardDelegateStake))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*OptionalParams)(nil), "emissions.v1.OptionalParams")
+	proto.RegisterType((*MsgUpdateParams)(nil), "emissions.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "emissions.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleParamsChange)(nil), "emissions.v1.MsgScheduleParamsChange")
+	proto.RegisterType((*MsgScheduleParamsChangeResponse)(nil), "emissions.v1.MsgScheduleParamsChangeResponse")
+	proto.RegisterType((*MsgCancelParamsChange)(nil), "emissions.v1.MsgCancelParamsChange")
+	proto.RegisterType((*MsgCancelParamsChangeResponse)(nil), "emissions.v1.MsgCancelParamsChangeResponse")
+	proto.RegisterType((*MsgCreateNewTopic)(nil), "emissions.v1.MsgCreateNewTopic")
+	proto.RegisterType((*MsgCreateNewTopicResponse)(nil), "emissions.v1.MsgCreateNewTopicResponse")
+	proto.RegisterType((*MsgUpdateTopicParamsOverrides)(nil), "emissions.v1.MsgUpdateTopicParamsOverrides")
+	proto.RegisterType((*MsgUpdateTopicParamsOverridesResponse)(nil), "emissions.v1.MsgUpdateTopicParamsOverridesResponse")
+	proto.RegisterType((*MsgInsertBulkReputerPayload)(nil), "emissions.v1.MsgInsertBulkReputerPayload")
+	proto.RegisterType((*MsgInsertBulkReputerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkReputerPayloadResponse")
+	proto.RegisterType((*MsgInsertBulkWorkerPayload)(nil), "emissions.v1.MsgInsertBulkWorkerPayload")
+	proto.RegisterType((*MsgInsertBulkWorkerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkWorkerPayloadResponse")
+	proto.RegisterType((*MsgInsertReputerPayload)(nil), "emissions.v1.MsgInsertReputerPayload")
+	proto.RegisterType((*MsgInsertReputerPayloadResponse)(nil), "emissions.v1.MsgInsertReputerPayloadResponse")
+	proto.RegisterType((*MsgInsertWorkerPayload)(nil), "emissions.v1.MsgInsertWorkerPayload")
+	proto.RegisterType((*MsgInsertWorkerPayloadResponse)(nil), "emissions.v1.MsgInsertWorkerPayloadResponse")
+	proto.RegisterType((*MsgRegister)(nil), "emissions.v1.MsgRegister")
+	proto.RegisterType((*MsgRegisterResponse)(nil), "emissions.v1.MsgRegisterResponse")
+	proto.RegisterType((*MsgRemoveRegistration)(nil), "emissions.v1.MsgRemoveRegistration")
+	proto.RegisterType((*MsgRemoveRegistrationResponse)(nil), "emissions.v1.MsgRemoveRegistrationResponse")
+	proto.RegisterType((*MsgUpdateNodeInfo)(nil), "emissions.v1.MsgUpdateNodeInfo")
+	proto.RegisterType((*MsgUpdateNodeInfoResponse)(nil), "emissions.v1.MsgUpdateNodeInfoResponse")
+	proto.RegisterType((*MsgSetPayoutAddress)(nil), "emissions.v1.MsgSetPayoutAddress")
+	proto.RegisterType((*MsgSetPayoutAddressResponse)(nil), "emissions.v1.MsgSetPayoutAddressResponse")
+	proto.RegisterType((*MsgAddStake)(nil), "emissions.v1.MsgAddStake")
+	proto.RegisterType((*MsgAddStakeResponse)(nil), "emissions.v1.MsgAddStakeResponse")
+	proto.RegisterType((*MsgRemoveStake)(nil), "emissions.v1.MsgRemoveStake")
+	proto.RegisterType((*MsgRemoveStakeResponse)(nil), "emissions.v1.MsgRemoveStakeResponse")
+	proto.RegisterType((*MsgCancelRemoveStake)(nil), "emissions.v1.MsgCancelRemoveStake")
+	proto.RegisterType((*MsgCancelRemoveStakeResponse)(nil), "emissions.v1.MsgCancelRemoveStakeResponse")
+	proto.RegisterType((*MsgDelegateStake)(nil), "emissions.v1.MsgDelegateStake")
+	proto.RegisterType((*MsgDelegateStakeResponse)(nil), "emissions.v1.MsgDelegateStakeResponse")
+	proto.RegisterType((*MsgRemoveDelegateStake)(nil), "emissions.v1.MsgRemoveDelegateStake")
+	proto.RegisterType((*MsgRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgRemoveDelegateStakeResponse")
+	proto.RegisterType((*MsgCancelRemoveDelegateStake)(nil), "emissions.v1.MsgCancelRemoveDelegateStake")
+	proto.RegisterType((*MsgCancelRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgCancelRemoveDelegateStakeResponse")
+	proto.RegisterType((*MsgFundTopic)(nil), "emissions.v1.MsgFundTopic")
+	proto.RegisterType((*MsgFundTopicResponse)(nil), "emissions.v1.MsgFundTopicResponse")
+	proto.RegisterType((*MsgRequestInference)(nil), "emissions.v1.MsgRequestInference")
+	proto.RegisterType((*MsgRequestInferenceResponse)(nil), "emissions.v1.MsgRequestInferenceResponse")
+	proto.RegisterType((*MsgCreateSubscription)(nil), "emissions.v1.MsgCreateSubscription")
+	proto.RegisterType((*MsgCreateSubscriptionResponse)(nil), "emissions.v1.MsgCreateSubscriptionResponse")
+	proto.RegisterType((*MsgCancelSubscription)(nil), "emissions.v1.MsgCancelSubscription")
+	proto.RegisterType((*MsgCancelSubscriptionResponse)(nil), "emissions.v1.MsgCancelSubscriptionResponse")
+	proto.RegisterType((*MsgAddToWhitelistAdmin)(nil), "emissions.v1.MsgAddToWhitelistAdmin")
+	proto.RegisterType((*MsgAddToWhitelistAdminResponse)(nil), "emissions.v1.MsgAddToWhitelistAdminResponse")
+	proto.RegisterType((*MsgRemoveFromWhitelistAdmin)(nil), "emissions.v1.MsgRemoveFromWhitelistAdmin")
+	proto.RegisterType((*MsgRemoveFromWhitelistAdminResponse)(nil), "emissions.v1.MsgRemoveFromWhitelistAdminResponse")
+	proto.RegisterType((*MsgGrantRole)(nil), "emissions.v1.MsgGrantRole")
+	proto.RegisterType((*MsgGrantRoleResponse)(nil), "emissions.v1.MsgGrantRoleResponse")
+	proto.RegisterType((*MsgRevokeRole)(nil), "emissions.v1.MsgRevokeRole")
+	proto.RegisterType((*MsgRevokeRoleResponse)(nil), "emissions.v1.MsgRevokeRoleResponse")
+	proto.RegisterType((*MsgRewardDelegateStakeResponse)(nil), "emissions.v1.MsgRewardDelegateStakeResponse")
+	proto.RegisterType((*MsgRewardDelegateStake)(nil), "emissions.v1.MsgRewardDelegateStake")
 }
 
-func _Msg_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveDelegateStake)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("emissions/v1/tx.proto", fileDescriptor_8293ea1b0f4b608c) }
+
+var fileDescriptor_8293ea1b0f4b608c = []byte{
+	// 3641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcb, 0x6f, 0xdc, 0x48,
+	0x7a, 0x1f, 0x4a, 0xb2, 0x2c, 0x7d, 0x92, 0x5b, 0x12, 0xf5, 0xa2, 0x68, 0xeb, 0xe1, 0x96, 0x3d,
+	0x96, 0xc7, 0x0f, 0x8d, 0x35, 0x8b, 0xcd, 0xee, 0x6c, 0x0e, 0x2b, 0x8f, 0xc7, 0x63, 0x65, 0x24,
+	0x5b, 0x4b, 0x69, 0xc6, 0x80, 0xb3, 0x00, 0xa7, 0x44, 0x96, 0x28, 0x42, 0x24, 0x8b, 0x5b, 0xc5,
+	0xd6, 0x63, 0x03, 0x04, 0x41, 0x82, 0x20, 0x40, 0x72, 0x09, 0x82, 0x20, 0x09, 0x72, 0xcb, 0x2d,
+	0xc7, 0x3d, 0x04, 0x39, 0xe5, 0x9e, 0xbd, 0x2c, 0xb0, 0x08, 0x10, 0x6c, 0x90, 0xc3, 0x22, 0x18,
+	0x1f, 0xf6, 0xaf, 0x58, 0x20, 0xa8, 0x07, 0xab, 0xc9, 0x26, 0x5b, 0xea, 0x51, 0x7b, 0x80, 0xb9,
+	0x18, 0xea, 0xfa, 0xbe, 0xfa, 0x7d, 0x8f, 0xfa, 0xea, 0x7b, 0x14, 0x61, 0x98, 0xc5, 0x71, 0xc8,
+	0x58, 0x48, 0x12, 0xb6, 0x7e, 0xf2, 0x64, 0x3d, 0x3b, 0x7b, 0x9c, 0x52, 0x92, 0x11, 0x73, 0x5c,
+	0x2f, 0x3f, 0x3e, 0x79, 0x62, 0xcf, 0x7b, 0x84, 0xc5, 0x84, 0xad, 0xc7, 0x2c, 0xe0, 0x5c, 0x31,
+	0x0b, 0x24, 0x9b, 0x3d, 0x13, 0x90, 0x80, 0x88, 0x3f, 0xd7, 0xf9, 0x5f, 0x6a, 0x75, 0x0a, 0xc5,
+	0x61, 0x42, 0xd6, 0xc5, 0xbf, 0x6a, 0xc9, 0x2a, 0x8b, 0x39, 0x4f, 0x31, 0x53, 0x94, 0x05, 0x89,
+	0xed, 0x4a, 0x14, 0xf9, 0xa3, 0x76, 0x53, 0x42, 0x12, 0x0f, 0x2b, 0x8a, 0x5d, 0xa2, 0x50, 0x9c,
+	0xb6, 0x32, 0x4c, 0x73, 0xc0, 0x12, 0xed, 0x94, 0xd0, 0x63, 0x4d, 0x5a, 0x2c, 0x91, 0x58, 0xeb,
+	0x40, 0xfd, 0xac, 0x57, 0x92, 0xa4, 0xa1, 0x27, 0x29, 0xcd, 0x5f, 0xad, 0x40, 0xe3, 0x55, 0x9a,
+	0x85, 0x24, 0x41, 0xd1, 0x2e, 0xa2, 0x28, 0x66, 0xa6, 0x05, 0xd7, 0x4f, 0x30, 0xe5, 0xdc, 0x96,
+	0xb1, 0x32, 0xb8, 0x36, 0xea, 0xe4, 0x3f, 0xcd, 0x1f, 0xc2, 0x42, 0x8c, 0xce, 0x5c, 0x86, 0x69,
+	0x88, 0xa2, 0xf0, 0xe7, 0xd8, 0x77, 0x63, 0x16, 0xb8, 0x11, 0x4e, 0x82, 0xec, 0xc8, 0x1a, 0x58,
+	0x19, 0x5c, 0x1b, 0x74, 0xe6, 0x62, 0x74, 0xb6, 0xa7, 0xe9, 0x3b, 0x2c, 0xd8, 0x16, 0x54, 0x13,
+	0xc1, 0x64, 0x1c, 0x26, 0xae, 0x10, 0xed, 0x9e, 0xe2, 0x30, 0x38, 0xca, 0xac, 0x41, 0x8e, 0xfe,
+	0xf4, 0x0f, 0x7e, 0xf9, 0xdb, 0xe5, 0xf7, 0xfe, 0xf7, 0xb7, 0xcb, 0xeb, 0x41, 0x98, 0x1d, 0xb5,
+	0x0e, 0x1e, 0x7b, 0x24, 0x5e, 0x47, 0x51, 0x44, 0x28, 0x7a, 0x94, 0xe0, 0x8c, 0x5b, 0x99, 0xff,
+	0xf4, 0x8e, 0x50, 0x98, 0xac, 0xc7, 0x28, 0x3b, 0x7a, 0xfc, 0x0c, 0x7b, 0x4e, 0x23, 0x0e, 0x93,
+	0x7d, 0x8e, 0xf7, 0x5a, 0xc0, 0x99, 0xeb, 0x30, 0xc3, 0xb5, 0x13, 0x22, 0x98, 0x9b, 0x62, 0xea,
+	0x1e, 0x44, 0xc4, 0x3b, 0xb6, 0x86, 0x56, 0x06, 0xd7, 0x86, 0x9c, 0xa9, 0x18, 0x9d, 0x09, 0x6e,
+	0xb6, 0x8b, 0xe9, 0x53, 0x4e, 0x30, 0x0f, 0x61, 0x8e, 0xe2, 0x9f, 0xb5, 0x42, 0xca, 0x0d, 0x09,
+	0x93, 0x30, 0x6e, 0xc5, 0x2e, 0xcb, 0xd0, 0x31, 0xb6, 0xae, 0x09, 0xcd, 0x3e, 0x54, 0x9a, 0xcd,
+	0xca, 0xb3, 0x63, 0xfe, 0xf1, 0xe3, 0x90, 0x48, 0xf9, 0x5b, 0x49, 0xf6, 0x5f, 0xff, 0xf6, 0x08,
+	0xd4, 0xa1, 0x6e, 0x25, 0xd9, 0xbf, 0xfe, 0xee, 0x17, 0x1f, 0x18, 0xce, 0x4c, 0x8e, 0xb7, 0x23,
+	0xe1, 0xf6, 0x38, 0x1a, 0x77, 0x1b, 0xc5, 0x31, 0x39, 0xc1, 0x12, 0xdd, 0xf5, 0x71, 0x84, 0xce,
+	0xdd, 0xd3, 0x30, 0xf1, 0xc9, 0xa9, 0x35, 0x2c, 0xdd, 0x26, 0x19, 0x04, 0xff, 0x33, 0x4e, 0x7e,
+	0x2d, 0xa8, 0xe6, 0x9a, 0x74, 0x1b, 0x4e, 0x89, 0x77, 0x94, 0x3b, 0xfa, 0xba, 0xd8, 0xc1, 0xad,
+	0xff, 0x94, 0x2f, 0x2b, 0x07, 0xbf, 0x81, 0xf1, 0x03, 0x9c, 0x21, 0x17, 0x27, 0x19, 0x25, 0xe9,
+	0xb9, 0x35, 0xd2, 0x9f, 0x73, 0xc7, 0x38, 0xd8, 0xa7, 0x12, 0xcb, 0xfc, 0x29, 0xdc, 0x88, 0x30,
+	0xa2, 0x49, 0x98, 0x04, 0x2e, 0x45, 0x19, 0xb6, 0x46, 0xfb, 0x03, 0x1f, 0xcf, 0xd1, 0x1c, 0x94,
+	0x61, 0x33, 0x06, 0x1e, 0x34, 0x6e, 0x40, 0x91, 0x1f, 0xe2, 0x24, 0x73, 0xb3, 0x23, 0x8a, 0xd9,
+	0x11, 0x89, 0x7c, 0x0b, 0xfa, 0x13, 0xc3, 0xc3, 0xe1, 0x33, 0x85, 0xba, 0x9f, 0x83, 0x9a, 0x18,
+	0x4c, 0xee, 0x52, 0x79, 0x14, 0x87, 0x14, 0x79, 0x3c, 0xf8, 0xad, 0xb1, 0xfe, 0x44, 0xf1, 0x53,
+	0x12, 0x87, 0xf7, 0x5c, 0x01, 0x9a, 0x9f, 0xc2, 0x32, 0xb7, 0xaa, 0x95, 0x1c, 0xb6, 0xa2, 0xc3,
+	0x30, 0x8a, 0xb0, 0xef, 0xca, 0x1b, 0xeb, 0xf2, 0x18, 0xc1, 0x2c, 0x63, 0xd6, 0x0d, 0x11, 0x98,
+	0xb7, 0x62, 0x74, 0xf6, 0x45, 0x9b, 0xeb, 0xb5, 0x60, 0x72, 0x14, 0x8f, 0xf9, 0x19, 0xac, 0x74,
+	0xc2, 0xa8, 0xa4, 0xd0, 0xc6, 0x69, 0x08, 0x9c, 0xc5, 0x32, 0x8e, 0x23, 0xb9, 0x34, 0xd0, 0xcf,
+	0x61, 0x51, 0x5e, 0x3e, 0x8a, 0x4f, 0x11, 0xf5, 0x95, 0xfd, 0x61, 0x9c, 0x12, 0x9a, 0xa1, 0xc4,
+	0xc3, 0xd6, 0x44, 0x7f, 0x1e, 0xb0, 0x05, 0xba, 0x23, 0xc0, 0x85, 0x27, 0xb6, 0x34, 0xb4, 0xf9,
+	0x97, 0x06, 0xac, 0x96, 0x84, 0x1f, 0x62, 0xec, 0x52, 0x7c, 0x82, 0x93, 0x56, 0x49, 0x85, 0xc9,
+	0xfe, 0x54, 0x58, 0x2e, 0xa8, 0xf0, 0x1c, 0x63, 0x47, 0x0a, 0x28, 0xe8, 0x81, 0xc1, 0x2c, 0xa9,
+	0x81, 0xa2, 0xf4, 0x08, 0x59, 0x53, 0x7d, 0x1e, 0x7d, 0x41, 0xea, 0x26, 0x07, 0x34, 0x3d, 0x98,
+	0xca, 0x10, 0x3b, 0x2e, 0x4b, 0x31, 0xfb, 0x93, 0x32, 0xc1, 0x11, 0x8b, 0x42, 0xfe, 0xca, 0x80,
+	0xd5, 0x13, 0x14, 0x85, 0x3e, 0xca, 0x08, 0x65, 0xee, 0x09, 0x73, 0xe5, 0x46, 0x9e, 0xf8, 0x3c,
+	0x7e, 0x8d, 0xa4, 0x74, 0x6b, 0x5a, 0xc8, 0xfd, 0xe1, 0x15, 0xe5, 0x5a, 0x86, 0xb3, 0xdc, 0x96,
+	0xf2, 0x25, 0xdb, 0x14, 0x4c, 0xbb, 0x52, 0x84, 0x54, 0xc7, 0xfc, 0x43, 0xb8, 0x29, 0xaa, 0x02,
+	0x8a, 0xd3, 0x08, 0x33, 0x37, 0x23, 0x2e, 0xf3, 0x50, 0x84, 0x5d, 0xe6, 0x11, 0x8a, 0x99, 0x35,
+	0x23, 0xa2, 0x73, 0x9e, 0xd7, 0x05, 0xc9, 0xb1, 0x4f, 0xf6, 0x38, 0x7d, 0x4f, 0x90, 0xcd, 0x8f,
+	0xc1, 0x56, 0x59, 0xdb, 0x0d, 0x93, 0x43, 0x4c, 0x31, 0x15, 0x10, 0x4a, 0xfb, 0x59, 0xb1, 0x79,
+	0x4e, 0xe6, 0xee, 0x2d, 0x45, 0xdf, 0x27, 0x4a, 0xf2, 0x8f, 0x61, 0x31, 0xdf, 0x7b, 0x48, 0x28,
+	0xf6, 0x10, 0xcb, 0xca, 0xdb, 0xe7, 0xc4, 0xf6, 0x05, 0xb9, 0xfd, 0x79, 0x9b, 0x45, 0x23, 0x14,
+	0xa4, 0xab, 0x6b, 0x55, 0xdc, 0x3e, 0x5f, 0x94, 0xae, 0x2e, 0x54, 0x7b, 0xef, 0x1b, 0x98, 0xf4,
+	0x28, 0x46, 0x19, 0x56, 0x55, 0xed, 0x10, 0x63, 0xcb, 0xba, 0x62, 0xe1, 0x68, 0x48, 0x24, 0x51,
+	0xa0, 0x9e, 0x63, 0x6c, 0xfe, 0x08, 0x6c, 0x9d, 0x0f, 0x7d, 0xcc, 0xc4, 0x81, 0x72, 0x45, 0x43,
+	0xae, 0x81, 0xb5, 0x20, 0x5d, 0x9a, 0x73, 0x3c, 0x93, 0x0c, 0x3b, 0xe8, 0x6c, 0x8b, 0x93, 0xcd,
+	0xcf, 0x61, 0x95, 0xf3, 0x52, 0x9c, 0xd1, 0x50, 0x1e, 0x88, 0xcc, 0x0a, 0xae, 0x68, 0x34, 0x98,
+	0xca, 0x43, 0x96, 0x2d, 0xea, 0xc8, 0x52, 0x8c, 0xce, 0x1c, 0xc9, 0xb9, 0x4f, 0x9e, 0x0b, 0xbe,
+	0x97, 0x82, 0x4d, 0x26, 0x22, 0x73, 0x07, 0xee, 0x5c, 0x08, 0xa6, 0xdc, 0x66, 0xdd, 0x14, 0x68,
+	0xcb, 0xdd, 0xd0, 0x94, 0xf7, 0xcc, 0x3f, 0x86, 0x49, 0x8a, 0x83, 0x90, 0x65, 0x14, 0xf1, 0x34,
+	0x29, 0x9c, 0x76, 0xeb, 0x8a, 0x4e, 0x9b, 0x28, 0x22, 0x71, 0xaf, 0x3d, 0x04, 0xd3, 0xc7, 0x87,
+	0xa8, 0x15, 0x65, 0x6e, 0x8a, 0x02, 0xec, 0x46, 0x61, 0x1c, 0x66, 0xd6, 0xa2, 0xf0, 0xd6, 0xa4,
+	0xa2, 0xec, 0xa2, 0x00, 0x6f, 0xf3, 0x75, 0xf3, 0x0e, 0x34, 0xb8, 0x65, 0x05, 0xce, 0x25, 0xc1,
+	0x39, 0x1e, 0xa3, 0xb3, 0x36, 0x17, 0x8f, 0xb1, 0x8e, 0x0a, 0xec, 0x52, 0xec, 0x11, 0xea, 0xab,
+	0x4d, 0xcb, 0xc2, 0xf0, 0x85, 0x72, 0x39, 0x76, 0x04, 0x87, 0x44, 0x58, 0x83, 0x49, 0xd1, 0x88,
+	0xc8, 0x9e, 0x24, 0x26, 0x49, 0x76, 0x64, 0xad, 0x08, 0x49, 0x0d, 0xb9, 0xbe, 0x8b, 0xe9, 0x0e,
+	0x5f, 0xe5, 0xf9, 0x29, 0xcd, 0xb3, 0x86, 0xbc, 0x0c, 0x3c, 0x2b, 0xde, 0xee, 0x33, 0x3f, 0xa5,
+	0x32, 0x5e, 0xb7, 0x72, 0x40, 0x9e, 0x9f, 0xb4, 0x98, 0xfc, 0xde, 0x58, 0xcd, 0x3e, 0xf3, 0x93,
+	0x92, 0x92, 0x5f, 0x32, 0xde, 0xf0, 0x69, 0x21, 0x79, 0x8c, 0xac, 0xf6, 0xd9, 0xf0, 0x29, 0x19,
+	0x79, 0x2c, 0x61, 0x30, 0xbd, 0xaa, 0xbb, 0xee, 0xf4, 0xe9, 0x2e, 0xaf, 0xc6, 0x5d, 0x5e, 0xc5,
+	0x5d, 0x77, 0xfb, 0x74, 0x97, 0xd7, 0xe1, 0xae, 0x97, 0x30, 0xec, 0xb9, 0x09, 0xa1, 0xb1, 0xf5,
+	0x7e, 0x7f, 0xc8, 0xd7, 0xbc, 0x97, 0x84, 0xc6, 0xe6, 0x29, 0xdc, 0xd2, 0x59, 0x49, 0x97, 0x5a,
+	0x1f, 0x7b, 0xe8, 0x5c, 0x76, 0x70, 0xf7, 0xfa, 0x93, 0x62, 0x65, 0x2a, 0x53, 0xa9, 0x22, 0xfb,
+	0x8c, 0x23, 0x8b, 0x6e, 0xee, 0x2b, 0x98, 0xc0, 0x29, 0x0b, 0x23, 0x92, 0xe8, 0x63, 0x5f, 0xeb,
+	0xf3, 0xd8, 0x15, 0x5e, 0x7e, 0xec, 0x27, 0x70, 0x53, 0xdc, 0xc8, 0xc3, 0x43, 0xec, 0x65, 0xe1,
+	0x49, 0x9e, 0x7e, 0x95, 0x91, 0xd6, 0xfd, 0x3e, 0x2d, 0xe3, 0x17, 0x39, 0x87, 0xde, 0x97, 0xa5,
+	0x5d, 0x00, 0x73, 0xb9, 0x25, 0x49, 0xee, 0x41, 0x8b, 0x26, 0xed, 0x0e, 0xf2, 0x83, 0x77, 0xe1,
+	0x51, 0x25, 0xeb, 0x69, 0x8b, 0x26, 0xba, 0x93, 0xfc, 0x08, 0xe6, 0xb8, 0xbd, 0x29, 0x9f, 0xce,
+	0x5c, 0xef, 0x08, 0x25, 0x81, 0x1a, 0x21, 0xac, 0x07, 0x22, 0xf5, 0x4c, 0xc7, 0x61, 0x22, 0x46,
+	0xb7, 0x4f, 0x04, 0x4d, 0x8c, 0x0f, 0xe6, 0x2b, 0xb8, 0xcb, 0x93, 0x1b, 0x49, 0x71, 0xd2, 0xbe,
+	0x1b, 0xba, 0x65, 0x14, 0x99, 0x48, 0x08, 0xb4, 0x1e, 0x8a, 0x4c, 0xc4, 0x9b, 0xcc, 0x57, 0x29,
+	0x4e, 0x74, 0xd4, 0xe7, 0x7d, 0xe3, 0x2e, 0xa6, 0xc2, 0x09, 0xe6, 0x27, 0xb2, 0x9f, 0x95, 0x6d,
+	0xa3, 0x98, 0x56, 0x50, 0xe4, 0x06, 0xa8, 0x38, 0x68, 0x3d, 0x12, 0x50, 0xbc, 0xa0, 0x8a, 0x06,
+	0xd0, 0x91, 0x4c, 0x9f, 0xa1, 0xf6, 0xc4, 0xf5, 0x7d, 0xb0, 0x64, 0x31, 0x11, 0x97, 0xa9, 0xbc,
+	0xfb, 0xb1, 0xd8, 0x3d, 0x23, 0x0a, 0x08, 0x27, 0x17, 0xf7, 0xfd, 0x40, 0x0e, 0x9e, 0x29, 0x6d,
+	0x89, 0x19, 0xa4, 0xbc, 0x71, 0x5d, 0x6c, 0x9c, 0xe5, 0x59, 0x5b, 0xd2, 0xcb, 0x12, 0x79, 0xe7,
+	0x51, 0x70, 0x81, 0x1f, 0xc6, 0x38, 0x11, 0xc3, 0xed, 0x87, 0x7a, 0x9f, 0xb6, 0xfa, 0x59, 0x4e,
+	0x34, 0x19, 0x2c, 0xa4, 0x94, 0x1c, 0xa0, 0x83, 0x30, 0x0a, 0xb3, 0x73, 0x97, 0xb5, 0x62, 0x37,
+	0x23, 0x11, 0xa6, 0xa2, 0x4f, 0x7d, 0xd2, 0xdf, 0x51, 0xcf, 0x17, 0x90, 0xf7, 0x5a, 0xf1, 0x7e,
+	0x8e, 0x9b, 0xcf, 0xd7, 0x14, 0x07, 0x14, 0x67, 0xee, 0x51, 0xc8, 0x32, 0x42, 0xcf, 0xf3, 0xb1,
+	0x6f, 0x43, 0x37, 0x23, 0x8e, 0xa0, 0xbf, 0x90, 0x64, 0x35, 0xfe, 0xfd, 0x11, 0x34, 0x23, 0x1c,
+	0x20, 0xef, 0xdc, 0x3d, 0x68, 0x25, 0x3e, 0xef, 0xbe, 0xc2, 0x20, 0x41, 0x59, 0x8b, 0x62, 0xe6,
+	0xe2, 0xc4, 0x57, 0xae, 0xfa, 0x48, 0x96, 0x7c, 0xc9, 0xf9, 0x54, 0x30, 0xee, 0x69, 0xbe, 0x4f,
+	0x13, 0x5f, 0xfa, 0xec, 0x63, 0x58, 0x48, 0xd1, 0x79, 0x44, 0x90, 0xef, 0xb6, 0x5f, 0x12, 0xf2,
+	0x79, 0xf5, 0x7b, 0x02, 0x62, 0x5e, 0x31, 0xec, 0x69, 0xba, 0x1c, 0x58, 0x9b, 0x11, 0x4c, 0xec,
+	0xb0, 0xe0, 0x8b, 0xd4, 0x47, 0x19, 0x56, 0xef, 0x09, 0x73, 0x30, 0xcc, 0x70, 0xe2, 0x63, 0x6a,
+	0x19, 0x2b, 0xc6, 0xda, 0xa8, 0xa3, 0x7e, 0x99, 0xdf, 0x83, 0x61, 0x11, 0xd3, 0xcc, 0x1a, 0x58,
+	0x31, 0xd6, 0xc6, 0x36, 0x6e, 0x3d, 0x2e, 0x3e, 0xcd, 0x3c, 0x2e, 0xbf, 0x4a, 0x38, 0x8a, 0xf7,
+	0xe3, 0xb1, 0x3f, 0xff, 0xdd, 0x2f, 0x3e, 0x50, 0x10, 0xcd, 0x05, 0x98, 0xef, 0x90, 0xe6, 0x60,
+	0x96, 0x92, 0x84, 0xe1, 0xe6, 0x3f, 0x1b, 0x82, 0xb6, 0xe7, 0x1d, 0x61, 0xbf, 0x15, 0x29, 0xaa,
+	0xbc, 0x21, 0xef, 0x56, 0x23, 0xde, 0x47, 0xa0, 0x34, 0x8d, 0xce, 0x5d, 0x94, 0x29, 0x37, 0x0f,
+	0xae, 0x18, 0x6b, 0x83, 0xce, 0xb8, 0x58, 0xdd, 0xcc, 0x84, 0x53, 0xcb, 0x7a, 0x3f, 0x81, 0xe5,
+	0x2e, 0xba, 0xe5, 0xfa, 0x9b, 0x0d, 0x18, 0x08, 0x7d, 0xa1, 0xdf, 0x90, 0x33, 0x10, 0xfa, 0xcd,
+	0x6d, 0x98, 0xdd, 0x61, 0xc1, 0x27, 0x3c, 0x4e, 0xa2, 0x9e, 0x8c, 0x91, 0x00, 0x03, 0x39, 0x40,
+	0x59, 0x81, 0x65, 0x58, 0xac, 0x45, 0xd3, 0xee, 0xfb, 0xef, 0x11, 0x98, 0xe2, 0x1c, 0xa2, 0x2d,
+	0x7d, 0x89, 0x4f, 0x65, 0x12, 0xb0, 0xe0, 0xba, 0x68, 0x54, 0x49, 0x2e, 0x2c, 0xff, 0x69, 0xda,
+	0x30, 0x12, 0xe3, 0x0c, 0xf9, 0x28, 0x43, 0x42, 0xe6, 0xa8, 0xa3, 0x7f, 0x9b, 0x8b, 0x00, 0x11,
+	0x61, 0xcc, 0x8d, 0x48, 0x10, 0x7a, 0xc2, 0x39, 0xa3, 0xce, 0x28, 0x5f, 0xd9, 0xe6, 0x0b, 0xe6,
+	0x32, 0x8c, 0x09, 0x72, 0x8c, 0xb3, 0x23, 0xe2, 0x5b, 0x43, 0x82, 0x2e, 0x76, 0xec, 0x88, 0x15,
+	0xf3, 0x1e, 0x4c, 0xb4, 0xef, 0xaf, 0x04, 0xb9, 0x26, 0x98, 0x1a, 0x7a, 0x59, 0x22, 0xdd, 0x87,
+	0xc9, 0x36, 0xa3, 0x82, 0x1b, 0x16, 0x9c, 0x6d, 0x00, 0x85, 0x79, 0x1b, 0xc6, 0x3b, 0x1e, 0x55,
+	0xf8, 0x91, 0x8d, 0xe1, 0xc2, 0x8b, 0xca, 0x1a, 0x4c, 0x06, 0x94, 0xb4, 0x12, 0xdf, 0xcd, 0x68,
+	0x2b, 0x3b, 0x72, 0x23, 0x14, 0x58, 0x23, 0x82, 0xad, 0x21, 0xd7, 0xf7, 0xf9, 0xf2, 0x36, 0x0a,
+	0xb8, 0x05, 0x79, 0xdf, 0x89, 0x68, 0x60, 0x8d, 0x4a, 0x0b, 0xd4, 0xd2, 0x26, 0x0d, 0x78, 0x75,
+	0x4f, 0x65, 0x75, 0x87, 0x15, 0xa3, 0x9f, 0xd4, 0x71, 0x2d, 0x15, 0xd5, 0xfd, 0x0d, 0x8c, 0x8b,
+	0xa9, 0x52, 0xa5, 0x0a, 0x6b, 0xac, 0x3f, 0xd4, 0x31, 0x01, 0x26, 0xd3, 0x8a, 0x79, 0x17, 0x1a,
+	0x9c, 0xeb, 0xd4, 0x4d, 0x70, 0x80, 0x78, 0x11, 0xb4, 0xc6, 0x57, 0x8c, 0xb5, 0x11, 0xe7, 0x86,
+	0x58, 0x7d, 0xa9, 0x16, 0xcd, 0x9f, 0xc0, 0x75, 0x55, 0x97, 0xad, 0x1b, 0xfd, 0x49, 0xcf, 0x71,
+	0xcc, 0x1d, 0x98, 0x94, 0x57, 0xca, 0x25, 0x27, 0x98, 0xd2, 0xd0, 0xc7, 0xfc, 0x6d, 0x83, 0x5f,
+	0xc4, 0x66, 0xf9, 0x22, 0x8a, 0x60, 0x94, 0x61, 0xfb, 0x2a, 0xe7, 0x74, 0x26, 0xd2, 0xf2, 0x82,
+	0xb9, 0x0e, 0xd3, 0x75, 0x69, 0x7f, 0x42, 0xdc, 0x08, 0x33, 0xac, 0xe6, 0xfc, 0xaf, 0x60, 0xe2,
+	0x67, 0x2d, 0x94, 0x64, 0x61, 0x84, 0xdd, 0x08, 0x9f, 0xe0, 0x88, 0xf5, 0xfb, 0x22, 0xd1, 0xc8,
+	0xf1, 0xb6, 0x05, 0x1c, 0x8f, 0x64, 0x0f, 0x65, 0x38, 0x10, 0x69, 0x1d, 0x1d, 0x70, 0x09, 0xe2,
+	0xf5, 0xc1, 0x69, 0xe4, 0xcb, 0xdb, 0x62, 0xd5, 0xdc, 0x87, 0x19, 0x14, 0x04, 0x54, 0x38, 0x9b,
+	0xf0, 0xc7, 0x2a, 0xca, 0xe9, 0xe7, 0x96, 0xb9, 0x62, 0xac, 0x35, 0x36, 0x6e, 0x97, 0xdd, 0xb1,
+	0xd9, 0xe6, 0xdc, 0x53, 0x8c, 0xce, 0x34, 0xaa, 0x2e, 0xf2, 0xa2, 0x56, 0x44, 0xcd, 0x68, 0x18,
+	0xb7, 0xfb, 0x97, 0xe9, 0xfe, 0x4e, 0x71, 0xbe, 0x80, 0xbc, 0x4f, 0xc3, 0x38, 0x6f, 0x5f, 0x3e,
+	0x1e, 0xe7, 0x79, 0x27, 0xcf, 0x13, 0xcd, 0xef, 0xc3, 0x42, 0x25, 0xad, 0xe8, 0x9c, 0xb7, 0x00,
+	0x23, 0xb2, 0xc3, 0xd2, 0x99, 0xef, 0xba, 0xf8, 0xbd, 0xe5, 0x37, 0xff, 0xc5, 0x80, 0x45, 0x9d,
+	0xea, 0xeb, 0xce, 0xbf, 0x6b, 0x1e, 0x2c, 0x82, 0x0e, 0x94, 0x40, 0xcd, 0x1f, 0xc3, 0x68, 0x3b,
+	0xd2, 0x06, 0x7b, 0x8e, 0xb4, 0xf6, 0xa6, 0x72, 0x52, 0xbd, 0x07, 0x77, 0x2f, 0x54, 0x51, 0x27,
+	0xd7, 0xbf, 0x1e, 0x80, 0x9b, 0x3b, 0x2c, 0xd8, 0x4a, 0x18, 0xa6, 0xd9, 0xd3, 0x56, 0x74, 0xac,
+	0x5a, 0xdb, 0x5d, 0x59, 0x54, 0xbb, 0x9a, 0xf2, 0x05, 0xcc, 0x76, 0x3c, 0xfe, 0xc9, 0x29, 0x5c,
+	0x95, 0xab, 0x8e, 0xb0, 0x28, 0xbf, 0x00, 0x8a, 0x31, 0xdc, 0x99, 0xa6, 0xd5, 0xc5, 0x92, 0x87,
+	0x06, 0xcb, 0x1e, 0xda, 0x6f, 0x4b, 0x3c, 0x41, 0x91, 0xe8, 0x79, 0x79, 0xd3, 0xc0, 0xc4, 0xa3,
+	0xfa, 0xd8, 0xc6, 0x4a, 0xad, 0xc4, 0x2f, 0x39, 0xa7, 0xec, 0x2e, 0xb4, 0xc0, 0xc2, 0x5a, 0x87,
+	0xd7, 0xfe, 0xc2, 0x80, 0xd5, 0x0b, 0x9c, 0xa1, 0x83, 0xe3, 0x47, 0x30, 0x42, 0x5a, 0x99, 0x47,
+	0x62, 0xcc, 0xc4, 0x77, 0x89, 0xb1, 0x8d, 0xe5, 0xb2, 0xf4, 0x76, 0x2f, 0xf2, 0x4a, 0xf2, 0x39,
+	0x7a, 0x03, 0xcf, 0xd0, 0x5e, 0x44, 0x18, 0x56, 0x05, 0x7a, 0x40, 0xa4, 0x71, 0x10, 0x4b, 0xa2,
+	0x3c, 0x37, 0x7f, 0x63, 0x80, 0x5d, 0xd2, 0x42, 0x3e, 0x7f, 0x5c, 0x76, 0x22, 0xf7, 0xe1, 0x5a,
+	0xf1, 0x04, 0xa6, 0xcb, 0x1a, 0x49, 0x9f, 0x5f, 0x4b, 0x2e, 0xf3, 0xf2, 0x4b, 0x98, 0x56, 0x6f,
+	0xc3, 0xbc, 0x5e, 0x76, 0xf8, 0x78, 0xa9, 0x8c, 0x29, 0xf5, 0x7a, 0x86, 0x32, 0xa4, 0x3c, 0x3c,
+	0x75, 0xda, 0xb1, 0xd2, 0xe1, 0xdf, 0xb7, 0x06, 0x34, 0xbb, 0x5b, 0xa6, 0xdd, 0xfb, 0x12, 0xda,
+	0x29, 0xd1, 0xfd, 0xa6, 0x8e, 0x9e, 0xd2, 0x5b, 0x5f, 0xe5, 0x1e, 0xdf, 0x86, 0xa9, 0x7c, 0x58,
+	0x6e, 0xc3, 0x0d, 0xf4, 0x06, 0x37, 0x99, 0xef, 0x7c, 0xd5, 0xe5, 0xfc, 0x06, 0x2b, 0xe7, 0xf7,
+	0x77, 0xb2, 0xdd, 0x93, 0x56, 0xf6, 0x78, 0x9d, 0x1c, 0x98, 0xa9, 0x0b, 0x6e, 0x75, 0x96, 0x97,
+	0xc7, 0xb6, 0x59, 0x8d, 0xed, 0xb2, 0xeb, 0x9f, 0xc2, 0x72, 0x17, 0x9d, 0xb4, 0xdb, 0x3b, 0x0c,
+	0x33, 0x2a, 0x86, 0xfd, 0x8d, 0x01, 0x73, 0x1a, 0xa4, 0xb7, 0xa0, 0xdc, 0x06, 0xb3, 0x1a, 0x4e,
+	0xca, 0xaa, 0xcb, 0xa2, 0x69, 0xb2, 0x33, 0x9a, 0xca, 0x16, 0x6d, 0xc2, 0x52, 0xbd, 0x32, 0xbd,
+	0x1b, 0xf4, 0x9f, 0x06, 0x8c, 0xed, 0xb0, 0xc0, 0x11, 0x6f, 0x77, 0x98, 0x76, 0xb5, 0x62, 0x09,
+	0xc6, 0xa2, 0xf0, 0xc0, 0x4d, 0x37, 0x52, 0xf7, 0x18, 0x9f, 0xab, 0xa6, 0x72, 0x34, 0x0a, 0x0f,
+	0x76, 0x37, 0xd2, 0xcf, 0xf1, 0xb9, 0xb9, 0x0a, 0x37, 0xe2, 0x56, 0x94, 0x85, 0x2e, 0xf2, 0x7d,
+	0x8a, 0x19, 0x53, 0x8d, 0xe5, 0xb8, 0x58, 0xdc, 0x94, 0x6b, 0xa5, 0x4b, 0x37, 0x54, 0xbe, 0x74,
+	0x33, 0x70, 0x8d, 0x9c, 0x26, 0x98, 0xaa, 0x5e, 0x52, 0xfe, 0xe0, 0xbd, 0x6a, 0xd8, 0x7e, 0xd4,
+	0x1c, 0x16, 0x9d, 0xcf, 0x68, 0x98, 0x3f, 0x5f, 0x96, 0x9d, 0xb1, 0x05, 0xd3, 0x05, 0x43, 0xb4,
+	0x07, 0x2c, 0xb8, 0xce, 0x5a, 0x9e, 0xc7, 0x55, 0x32, 0xc4, 0xfe, 0xfc, 0x27, 0xa7, 0xc4, 0x98,
+	0x31, 0x14, 0x60, 0x65, 0x4e, 0xfe, 0xb3, 0x79, 0x22, 0xba, 0x7b, 0x31, 0x31, 0x63, 0xa7, 0xf0,
+	0xaa, 0x79, 0x95, 0xaa, 0x56, 0x36, 0x61, 0xf0, 0x42, 0x13, 0xf6, 0x60, 0xb1, 0x56, 0x6e, 0x5f,
+	0xc6, 0xfc, 0xca, 0x80, 0x29, 0x5d, 0x08, 0x5f, 0x12, 0x1f, 0x6f, 0x25, 0x87, 0xe4, 0xdb, 0x3d,
+	0xe7, 0xb2, 0xcd, 0x43, 0x1d, 0x36, 0xf3, 0x4f, 0xc3, 0x29, 0xc5, 0x27, 0x21, 0x69, 0x31, 0xb7,
+	0x28, 0x4c, 0x1e, 0xfd, 0x54, 0x4e, 0xdb, 0xce, 0x85, 0x96, 0x9d, 0x74, 0x13, 0x16, 0x2a, 0xe6,
+	0xe8, 0x5a, 0xfe, 0xf7, 0x86, 0x88, 0x82, 0x3d, 0x9c, 0xed, 0xa2, 0x73, 0xd2, 0xca, 0x72, 0x8d,
+	0xae, 0x70, 0x70, 0x73, 0x30, 0x1c, 0x44, 0xe4, 0x00, 0x45, 0xea, 0xd0, 0xd4, 0x2f, 0xde, 0x91,
+	0xa7, 0x02, 0x5b, 0xbb, 0x40, 0xce, 0x48, 0x37, 0xd2, 0xa2, 0xc4, 0xb2, 0xce, 0x8b, 0x70, 0xb3,
+	0x46, 0x2b, 0xad, 0xf5, 0x3f, 0xca, 0x4b, 0xb8, 0xe9, 0xcb, 0x6f, 0x75, 0x57, 0xd1, 0xf6, 0x05,
+	0x0c, 0xa3, 0x98, 0xb4, 0x92, 0x4c, 0x1e, 0xc8, 0x15, 0xde, 0xef, 0xd5, 0xfe, 0xb2, 0xe2, 0xb3,
+	0x30, 0x5d, 0x50, 0xac, 0x38, 0xce, 0x37, 0x74, 0xa4, 0x7e, 0xd7, 0x74, 0xb6, 0x60, 0xae, 0xac,
+	0x9b, 0x56, 0xfb, 0x4b, 0x98, 0xd1, 0x73, 0x76, 0x7f, 0xba, 0x97, 0x25, 0x2e, 0xc1, 0xad, 0x3a,
+	0x5c, 0x2d, 0xf7, 0xdf, 0x0d, 0x98, 0xdc, 0x61, 0xc1, 0x33, 0x1c, 0xf1, 0x96, 0xfc, 0xea, 0x0e,
+	0xb3, 0xe0, 0x7a, 0x31, 0x91, 0x8c, 0x3a, 0xf9, 0xcf, 0x82, 0x2b, 0x87, 0xde, 0xa5, 0x2b, 0x6d,
+	0xb0, 0x3a, 0xf5, 0xd6, 0x46, 0xfd, 0x87, 0x51, 0xf0, 0x73, 0x6f, 0xa6, 0x15, 0xf4, 0x1f, 0x28,
+	0xeb, 0x7f, 0x41, 0x3b, 0xf6, 0x2d, 0x99, 0xb6, 0x02, 0x4b, 0xf5, 0xda, 0x6b, 0x03, 0xff, 0xc1,
+	0xa8, 0x1c, 0x6b, 0xdf, 0x27, 0x78, 0x0b, 0x46, 0x7d, 0x89, 0x41, 0xf2, 0x33, 0x6c, 0x2f, 0x14,
+	0xfd, 0x33, 0x54, 0xf2, 0x4f, 0x59, 0xf5, 0xf7, 0xe1, 0xce, 0x45, 0x7a, 0x69, 0x03, 0xfe, 0xc9,
+	0x80, 0xf1, 0x1d, 0x16, 0x3c, 0xe7, 0x8f, 0x23, 0xe2, 0xc1, 0xe8, 0xbb, 0x73, 0x47, 0xe7, 0x60,
+	0xa6, 0xa8, 0x99, 0x56, 0xf9, 0x37, 0x86, 0xaa, 0xe2, 0x62, 0x20, 0x6a, 0x7f, 0xf5, 0xb9, 0x82,
+	0xe6, 0x36, 0x8c, 0x20, 0x1a, 0xb4, 0x62, 0x9c, 0xeb, 0xee, 0xe8, 0xdf, 0x9c, 0xe6, 0x63, 0xe4,
+	0x47, 0x61, 0x82, 0x85, 0xa7, 0x07, 0x1d, 0xfd, 0x9b, 0x5b, 0x7c, 0xc0, 0x15, 0x56, 0xf5, 0xe8,
+	0x2a, 0x16, 0xcb, 0xfd, 0x65, 0x8b, 0x03, 0xb8, 0x59, 0x63, 0x98, 0xae, 0xec, 0x8b, 0x00, 0xf9,
+	0x10, 0xa9, 0xc7, 0xed, 0x51, 0xb5, 0xb2, 0xe5, 0x7f, 0x83, 0xc9, 0xa6, 0xf9, 0x7b, 0x03, 0x66,
+	0xf5, 0x50, 0xbf, 0xd7, 0x3a, 0x60, 0x1e, 0x0d, 0xd3, 0xab, 0x76, 0x2f, 0x2f, 0x60, 0x18, 0x33,
+	0x8f, 0x92, 0xd3, 0xab, 0x1f, 0xbf, 0xdc, 0xcf, 0xbf, 0xcf, 0xcb, 0x40, 0x10, 0xdf, 0x0a, 0xc4,
+	0xcb, 0xde, 0x95, 0x2f, 0x74, 0x43, 0x22, 0xed, 0x62, 0x2a, 0x3e, 0xf2, 0x96, 0x1d, 0xfd, 0x02,
+	0x16, 0x6b, 0xcd, 0xd7, 0xae, 0xbe, 0x07, 0x13, 0xac, 0xb0, 0xde, 0xf6, 0x77, 0xa3, 0xb8, 0xbc,
+	0xe5, 0x37, 0x71, 0xe1, 0x91, 0xb7, 0x27, 0x47, 0xd6, 0x20, 0x0f, 0xd4, 0x21, 0x77, 0x7f, 0xfd,
+	0xad, 0x53, 0xb8, 0xf9, 0x5a, 0x24, 0xda, 0x4d, 0xdf, 0xdf, 0x27, 0xaf, 0x8f, 0xc2, 0x0c, 0x47,
+	0x21, 0xcb, 0x36, 0xfd, 0x38, 0x4c, 0x2e, 0x4a, 0xb4, 0x79, 0x73, 0xa2, 0x12, 0x2d, 0xaa, 0x6b,
+	0x4b, 0x64, 0x0e, 0xac, 0x01, 0xd6, 0xa2, 0x7f, 0xaa, 0xa2, 0x96, 0x27, 0x99, 0xe7, 0x94, 0xc4,
+	0xef, 0x56, 0xfe, 0x5d, 0x58, 0xbd, 0x00, 0x5d, 0x2b, 0x81, 0x44, 0x1a, 0xfb, 0x8c, 0xa2, 0x24,
+	0x73, 0x48, 0xd4, 0x3d, 0x19, 0x98, 0x30, 0x44, 0x49, 0x94, 0x37, 0xc0, 0xe2, 0xef, 0xa2, 0x26,
+	0x83, 0x17, 0x68, 0x22, 0xf3, 0x91, 0x16, 0xa1, 0x45, 0x1f, 0xc0, 0x0d, 0xa1, 0xe1, 0x09, 0x39,
+	0xc6, 0xdf, 0x96, 0xec, 0x79, 0x98, 0x2d, 0xc9, 0xd0, 0xc2, 0xf3, 0x12, 0xc5, 0xbf, 0xbf, 0xd5,
+	0x67, 0x78, 0x0a, 0x73, 0xf5, 0x1c, 0xef, 0xb4, 0xbb, 0x28, 0xa9, 0xbb, 0xf1, 0xfb, 0x59, 0x18,
+	0xdc, 0x61, 0x81, 0xb9, 0x0f, 0xe3, 0xa5, 0x0f, 0x4b, 0x8b, 0xe5, 0x9c, 0xd4, 0xf1, 0x25, 0xc8,
+	0xbe, 0x7b, 0x21, 0x59, 0x5f, 0xce, 0x08, 0x66, 0x6a, 0x3f, 0x12, 0x55, 0xb7, 0xd7, 0xb1, 0xd9,
+	0x8f, 0x7a, 0x62, 0xd3, 0xd2, 0x0e, 0xc1, 0xac, 0xf9, 0x86, 0xb3, 0x5a, 0x01, 0xa9, 0x32, 0xd9,
+	0x0f, 0x7a, 0x60, 0xd2, 0x72, 0x5a, 0x30, 0xdf, 0xed, 0x2d, 0x6b, 0xad, 0x82, 0xd3, 0x85, 0xd3,
+	0xfe, 0xb0, 0x57, 0x4e, 0x2d, 0x36, 0x84, 0xe9, 0xba, 0x97, 0x8a, 0x3b, 0x5d, 0x80, 0xca, 0xe2,
+	0x1e, 0xf6, 0xc2, 0xa5, 0x45, 0xbd, 0x81, 0x46, 0xc7, 0xd7, 0xa9, 0xe5, 0xaa, 0x83, 0x4a, 0x0c,
+	0xf6, 0xbd, 0x4b, 0x18, 0x34, 0xf6, 0x9f, 0x82, 0x7d, 0xc1, 0x4b, 0xf3, 0x83, 0x2e, 0x81, 0x55,
+	0xc7, 0x6c, 0x7f, 0xf4, 0x0d, 0x98, 0xb5, 0xfc, 0x17, 0x30, 0xa2, 0xdf, 0x47, 0x16, 0x2a, 0x00,
+	0x39, 0xc9, 0xbe, 0xdd, 0x95, 0x54, 0x8c, 0xb7, 0x9a, 0x57, 0x85, 0xd5, 0x9a, 0x8d, 0x9d, 0x4c,
+	0xf6, 0x83, 0x1e, 0x98, 0x8a, 0xa7, 0xd1, 0x31, 0xef, 0x2f, 0x77, 0x31, 0x3c, 0x67, 0xb0, 0xef,
+	0x5d, 0xc2, 0xa0, 0xb1, 0xbf, 0x82, 0xc9, 0xca, 0x78, 0x5d, 0x35, 0xbd, 0x93, 0xc5, 0xbe, 0x7f,
+	0x29, 0x8b, 0x96, 0x70, 0x06, 0x56, 0xd7, 0xc7, 0xf8, 0xfb, 0x17, 0x5c, 0x82, 0x32, 0xab, 0xfd,
+	0xa4, 0x67, 0xd6, 0x62, 0xf6, 0xa9, 0x7d, 0xb3, 0xbc, 0xdb, 0x05, 0xaa, 0x43, 0xe2, 0xa3, 0x9e,
+	0xd8, 0x8a, 0x71, 0xa5, 0x47, 0xfe, 0x6a, 0x5c, 0xe5, 0x24, 0xfb, 0x76, 0x57, 0x92, 0x46, 0xfa,
+	0x09, 0x8c, 0x15, 0xe7, 0xd9, 0x5b, 0x5d, 0x62, 0x45, 0xe2, 0xdd, 0xb9, 0x88, 0xaa, 0x21, 0x3d,
+	0x98, 0xaa, 0x0e, 0xca, 0xcd, 0x2e, 0x49, 0xaf, 0x08, 0xff, 0xc1, 0xe5, 0x3c, 0x5a, 0xc8, 0x6b,
+	0xb8, 0x51, 0x2e, 0x5b, 0x4b, 0x95, 0xcd, 0x25, 0xba, 0xfd, 0xfe, 0xc5, 0xf4, 0x62, 0xe6, 0xab,
+	0xab, 0x8a, 0x75, 0xa6, 0x57, 0xb8, 0xec, 0x87, 0xbd, 0x70, 0x95, 0x45, 0x55, 0x87, 0xc3, 0x6e,
+	0x5e, 0xbe, 0x5c, 0x54, 0xd7, 0x81, 0xce, 0xfc, 0x13, 0x58, 0xe8, 0x3e, 0x8d, 0x5e, 0xec, 0xf7,
+	0xb2, 0xd8, 0x8d, 0xde, 0x79, 0xb5, 0xf0, 0xcf, 0x61, 0xb4, 0x3d, 0x49, 0xda, 0x15, 0x00, 0x4d,
+	0xb3, 0x9b, 0xdd, 0x69, 0xc5, 0x24, 0x52, 0x99, 0xf1, 0xea, 0xf2, 0x67, 0x99, 0xc5, 0xbe, 0x7f,
+	0x29, 0x4b, 0xa9, 0xb4, 0x57, 0x47, 0xa0, 0xd5, 0x2e, 0x35, 0xa7, 0xc8, 0x64, 0x3f, 0xe8, 0x81,
+	0xa9, 0xda, 0x42, 0x5c, 0x26, 0xa7, 0xc2, 0x64, 0x3f, 0xe8, 0x81, 0xa9, 0x18, 0x66, 0x75, 0x13,
+	0xc0, 0x9d, 0xba, 0xe4, 0xd0, 0xc9, 0x65, 0x3f, 0xec, 0x85, 0xab, 0x98, 0x7f, 0xbb, 0x76, 0xfc,
+	0xf7, 0xbb, 0x04, 0x6c, 0x95, 0xd5, 0x7e, 0xd2, 0x33, 0x6b, 0x31, 0xc6, 0xda, 0x6d, 0x7e, 0x35,
+	0xc6, 0x34, 0xcd, 0x6e, 0x76, 0xa7, 0x15, 0xbe, 0xa1, 0x41, 0xa1, 0x71, 0xbf, 0x59, 0xa3, 0x4d,
+	0x4e, 0xb4, 0x57, 0x2f, 0x20, 0xe6, 0x78, 0xf6, 0xb5, 0x3f, 0xe3, 0xc3, 0xe7, 0x53, 0xe7, 0x97,
+	0x5f, 0x2f, 0x19, 0xbf, 0xfe, 0x7a, 0xc9, 0xf8, 0xbf, 0xaf, 0x97, 0x8c, 0xbf, 0x7d, 0xbb, 0xf4,
+	0xde, 0xaf, 0xdf, 0x2e, 0xbd, 0xf7, 0x3f, 0x6f, 0x97, 0xde, 0x7b, 0xf3, 0x83, 0x1e, 0xbf, 0xd2,
+	0x9f, 0xad, 0x6b, 0x69, 0xf2, 0xbf, 0xa8, 0x1d, 0x0c, 0x8b, 0xff, 0xfe, 0xf5, 0xd1, 0xff, 0x0f,
+	0x00, 0x76, 0xdc, 0x38, 0x19, 0x26, 0x37, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error)
+	CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error)
+	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
+	InsertWorkerPayload(ctx context.Context, in *MsgInsertWorkerPayload, opts ...grpc.CallOption) (*MsgInsertWorkerPayloadResponse, error)
+	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
+	UpdateTopicParamsOverrides(ctx context.Context, in *MsgUpdateTopicParamsOverrides, opts ...grpc.CallOption) (*MsgUpdateTopicParamsOverridesResponse, error)
+	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
+	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error)
+	SetPayoutAddress(ctx context.Context, in *MsgSetPayoutAddress, opts ...grpc.CallOption) (*MsgSetPayoutAddressResponse, error)
+	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
+	InsertReputerPayload(ctx context.Context, in *MsgInsertReputerPayload, opts ...grpc.CallOption) (*MsgInsertReputerPayloadResponse, error)
+	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
+	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
+	CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error)
+	DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error)
+	RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error)
+	RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error)
+	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
+	RequestInference(ctx context.Context, in *MsgRequestInference, opts ...grpc.CallOption) (*MsgRequestInferenceResponse, error)
+	CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error)
+	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveDelegateStake",
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error) {
+	out := new(MsgScheduleParamsChangeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/ScheduleParamsChange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveDelegateStake(ctx, req.(*MsgRemoveDelegateStake))
+	return out, nil
+}
+
+func (c *msgClient) CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error) {
+	out := new(MsgCancelParamsChangeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelParamsChange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRemoveDelegateStake)
-	if err := dec(in); err != nil {
+func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error) {
+	out := new(MsgInsertBulkWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkWorkerPayload", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) InsertWorkerPayload(ctx context.Context, in *MsgInsertWorkerPayload, opts ...grpc.CallOption) (*MsgInsertWorkerPayloadResponse, error) {
+	out := new(MsgInsertWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertWorkerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/CancelRemoveDelegateStake",
+	return out, nil
+}
+
+func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
+	out := new(MsgCreateNewTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CreateNewTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, req.(*MsgCancelRemoveDelegateStake))
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicParamsOverrides(ctx context.Context, in *MsgUpdateTopicParamsOverrides, opts ...grpc.CallOption) (*MsgUpdateTopicParamsOverridesResponse, error) {
+	out := new(MsgUpdateTopicParamsOverridesResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateTopicParamsOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundTopic)
-	if err := dec(in); err != nil {
+func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
+	out := new(MsgRegisterResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/Register", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundTopic(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error) {
+	out := new(MsgRemoveRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/FundTopic",
+	return out, nil
+}
+
+func (c *msgClient) UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error) {
+	out := new(MsgUpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundTopic(ctx, req.(*MsgFundTopic))
+	return out, nil
+}
+
+func (c *msgClient) SetPayoutAddress(ctx context.Context, in *MsgSetPayoutAddress, opts ...grpc.CallOption) (*MsgSetPayoutAddressResponse, error) {
+	out := new(MsgSetPayoutAddressResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/SetPayoutAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddToWhitelistAdmin)
-	if err := dec(in); err != nil {
+func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
+	out := new(MsgInsertBulkReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkReputerPayload", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddToWhitelistAdmin(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) InsertReputerPayload(ctx context.Context, in *MsgInsertReputerPayload, opts ...grpc.CallOption) (*MsgInsertReputerPayloadResponse, error) {
+	out := new(MsgInsertReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertReputerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/AddToWhitelistAdmin",
+	return out, nil
+}
+
+func (c *msgClient) AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error) {
+	out := new(MsgAddStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddToWhitelistAdmin(ctx, req.(*MsgAddToWhitelistAdmin))
+	return out, nil
+}
+
+func (c *msgClient) RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error) {
+	out := new(MsgRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveFromWhitelistAdmin)
-	if err := dec(in); err != nil {
+func (c *msgClient) CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error) {
+	out := new(MsgCancelRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveStake", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error) {
+	out := new(MsgDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/DelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveFromWhitelistAdmin",
+	return out, nil
+}
+
+func (c *msgClient) RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error) {
+	out := new(MsgRewardDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RewardDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, req.(*MsgRemoveFromWhitelistAdmin))
+	return out, nil
+}
+
+func (c *msgClient) RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error) {
+	out := new(MsgRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "emissions.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "InsertBulkWorkerPayload",
-			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
-		},
-		{
-			MethodName: "CreateNewTopic",
-			Handler:    _Msg_CreateNewTopic_Handler,
-		},
-		{
-			MethodName: "Register",
-			Handler:    _Msg_Register_Handler,
-		},
-		{
-			MethodName: "RemoveRegistration",
-			Handler:    _Msg_RemoveRegistration_Handler,
-		},
-		{
-			MethodName: "InsertBulkReputerPayload",
-			Handler:    _Msg_InsertBulkReputerPayload_Handler,
-		},
-		{
-			MethodName: "AddStake",
-			Handler:    _Msg_AddStake_Handler,
-		},
-		{
-			MethodName: "RemoveStake",
-			Handler:    _Msg_RemoveStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveStake",
-			Handler:    _Msg_CancelRemoveStake_Handler,
-		},
-		{
-			MethodName: "DelegateStake",
-			Handler:    _Msg_DelegateStake_Handler,
-		},
-		{
-			MethodName: "RewardDelegateStake",
-			Handler:    _Msg_RewardDelegateStake_Handler,
-		},
-		{
-			MethodName: "RemoveDelegateStake",
-			Handler:    _Msg_RemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveDelegateStake",
-			Handler:    _Msg_CancelRemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "FundTopic",
-			Handler:    _Msg_FundTopic_Handler,
-		},
-		{
-			MethodName: "AddToWhitelistAdmin",
-			Handler:    _Msg_AddToWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "RemoveFromWhitelistAdmin",
-			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "emissions/v1/tx.proto",
+func (c *msgClient) CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error) {
+	out := new(MsgCancelRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error) {
+	out := new(MsgFundTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/FundTopic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestInference(ctx context.Context, in *MsgRequestInference, opts ...grpc.CallOption) (*MsgRequestInferenceResponse, error) {
+	out := new(MsgRequestInferenceResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RequestInference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinEffectiveTopicRevenue) > 0 {
-		for iNdEx := len(m.MinEffectiveTopicRevenue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinEffectiveTopicRevenue[iNdEx].Size()
-				i -= size
-				if _, err := m.MinEffectiveTopicRevenue[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xca
-		}
-	}
-	if len(m.EpsilonReputer) > 0 {
-		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.EpsilonReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc2
-		}
-	}
-	if len(m.TopicFeeRevenueDecayRate) > 0 {
-		for iNdEx := len(m.TopicFeeRevenueDecayRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicFeeRevenueDecayRate[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicFeeRevenueDecayRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xba
-		}
+func (c *msgClient) CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error) {
+	out := new(MsgCreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CNorm) > 0 {
-		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CNorm[iNdEx].Size()
-				i -= size
-				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xb2
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error) {
+	out := new(MsgCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CRewardForecast) > 0 {
-		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xaa
-		}
+	return out, nil
+}
+
+func (c *msgClient) AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error) {
+	out := new(MsgAddToWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddToWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CRewardInference) > 0 {
-		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xa2
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error) {
+	out := new(MsgRemoveFromWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveFromWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardReputer) > 0 {
-		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x9a
-		}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardForecast) > 0 {
-		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x92
-		}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardInference) > 0 {
-		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x8a
-		}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ScheduleParamsChange(context.Context, *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error)
+	CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error)
+	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
+	InsertWorkerPayload(context.Context, *MsgInsertWorkerPayload) (*MsgInsertWorkerPayloadResponse, error)
+	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
+	UpdateTopicParamsOverrides(context.Context, *MsgUpdateTopicParamsOverrides) (*MsgUpdateTopicParamsOverridesResponse, error)
+	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
+	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
+	UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error)
+	SetPayoutAddress(context.Context, *MsgSetPayoutAddress) (*MsgSetPayoutAddressResponse, error)
+	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
+	InsertReputerPayload(context.Context, *MsgInsertReputerPayload) (*MsgInsertReputerPayloadResponse, error)
+	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
+	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
+	CancelRemoveStake(context.Context, *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error)
+	DelegateStake(context.Context, *MsgDelegateStake) (*MsgDelegateStakeResponse, error)
+	RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error)
+	RemoveDelegateStake(context.Context, *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error)
+	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
+	RequestInference(context.Context, *MsgRequestInference) (*MsgRequestInferenceResponse, error)
+	CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error)
+	CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error)
+	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleParamsChange(ctx context.Context, req *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsChange not implemented")
+}
+func (*UnimplementedMsgServer) CancelParamsChange(ctx context.Context, req *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamsChange not implemented")
+}
+func (*UnimplementedMsgServer) InsertBulkWorkerPayload(ctx context.Context, req *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServer) InsertWorkerPayload(ctx context.Context, req *MsgInsertWorkerPayload) (*MsgInsertWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServer) CreateNewTopic(ctx context.Context, req *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTopicParamsOverrides(ctx context.Context, req *MsgUpdateTopicParamsOverrides) (*MsgUpdateTopicParamsOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicParamsOverrides not implemented")
+}
+func (*UnimplementedMsgServer) Register(ctx context.Context, req *MsgRegister) (*MsgRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRegistration(ctx context.Context, req *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNodeInfo(ctx context.Context, req *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
+func (*UnimplementedMsgServer) SetPayoutAddress(ctx context.Context, req *MsgSetPayoutAddress) (*MsgSetPayoutAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPayoutAddress not implemented")
+}
+func (*UnimplementedMsgServer) InsertBulkReputerPayload(ctx context.Context, req *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
+}
+func (*UnimplementedMsgServer) InsertReputerPayload(ctx context.Context, req *MsgInsertReputerPayload) (*MsgInsertReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+}
+func (*UnimplementedMsgServer) AddStake(ctx context.Context, req *MsgAddStake) (*MsgAddStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+}
+func (*UnimplementedMsgServer) RemoveStake(ctx context.Context, req *MsgRemoveStake) (*MsgRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+}
+func (*UnimplementedMsgServer) CancelRemoveStake(ctx context.Context, req *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+}
+func (*UnimplementedMsgServer) DelegateStake(ctx context.Context, req *MsgDelegateStake) (*MsgDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) RewardDelegateStake(ctx context.Context, req *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDelegateStake(ctx context.Context, req *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) CancelRemoveDelegateStake(ctx context.Context, req *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) FundTopic(ctx context.Context, req *MsgFundTopic) (*MsgFundTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+}
+func (*UnimplementedMsgServer) RequestInference(ctx context.Context, req *MsgRequestInference) (*MsgRequestInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestInference not implemented")
+}
+func (*UnimplementedMsgServer) CreateSubscription(ctx context.Context, req *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedMsgServer) CancelSubscription(ctx context.Context, req *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (*UnimplementedMsgServer) AddToWhitelistAdmin(ctx context.Context, req *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromWhitelistAdmin(ctx context.Context, req *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		dAtA2 := make([]byte, len(m.BlocksPerMonth)*10)
-		var j1 int
-		for _, num := range m.BlocksPerMonth {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		dAtA4 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
-		var j3 int
-		for _, num1 := range m.MinEpochLengthRecordLimit {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateParams",
 	}
-	if len(m.MaxPageLimit) > 0 {
-		dAtA6 := make([]byte, len(m.MaxPageLimit)*10)
-		var j5 int
-		for _, num := range m.MaxPageLimit {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		dAtA8 := make([]byte, len(m.DefaultPageLimit)*10)
-		var j7 int
-		for _, num := range m.DefaultPageLimit {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RegistrationFee) > 0 {
-		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RegistrationFee[iNdEx].Size()
-				i -= size
-				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, in)
 	}
-	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
-		dAtA10 := make([]byte, len(m.MaxRetriesToFulfilNoncesReputer)*10)
-		var j9 int
-		for _, num1 := range m.MaxRetriesToFulfilNoncesReputer {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTx(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/ScheduleParamsChange",
 	}
-	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
-		dAtA12 := make([]byte, len(m.MaxRetriesToFulfilNoncesWorker)*10)
-		var j11 int
-		for _, num1 := range m.MaxRetriesToFulfilNoncesWorker {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, req.(*MsgScheduleParamsChange))
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		dAtA14 := make([]byte, len(m.GradientDescentMaxIters)*10)
-		var j13 int
-		for _, num := range m.GradientDescentMaxIters {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTx(dAtA, i, uint64(j13))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CreateTopicFee[iNdEx].Size()
-				i -= size
-				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamsChange(ctx, in)
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		dAtA16 := make([]byte, len(m.MaxTopReputersToReward)*10)
-		var j15 int
-		for _, num := range m.MaxTopReputersToReward {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
-		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintTx(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CancelParamsChange",
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		dAtA18 := make([]byte, len(m.MaxTopForecastersToReward)*10)
-		var j17 int
-		for _, num := range m.MaxTopForecastersToReward {
-			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA18[j17] = uint8(num)
-			j17++
-		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintTx(dAtA, i, uint64(j17))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamsChange(ctx, req.(*MsgCancelParamsChange))
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		dAtA20 := make([]byte, len(m.MaxTopInferersToReward)*10)
-		var j19 int
-		for _, num := range m.MaxTopInferersToReward {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
-		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintTx(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertBulkWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		dAtA22 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
-		var j21 int
-		for _, num := range m.MaxSamplesToScaleScores {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
-		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintTx(dAtA, i, uint64(j21))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	if interceptor == nil {
+		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, in)
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
-				i -= size
-				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/InsertBulkWorkerPayload",
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TaskRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, req.(*MsgInsertBulkWorkerPayload))
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertWorkerPayload(ctx, in)
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardStakeImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/InsertWorkerPayload",
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		dAtA24 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
-		var j23 int
-		for _, num := range m.MaxUnfulfilledReputerRequests {
-			for num >= 1<<7 {
-				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j23++
-			}
-			dAtA24[j23] = uint8(num)
-			j23++
-		}
-		i -= j23
-		copy(dAtA[i:], dAtA24[:j23])
-		i = encodeVarintTx(dAtA, i, uint64(j23))
-		i--
-		dAtA[i] = 0x72
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertWorkerPayload(ctx, req.(*MsgInsertWorkerPayload))
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		dAtA26 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
-		var j25 int
-		for _, num := range m.MaxUnfulfilledWorkerRequests {
-			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j25++
-			}
-			dAtA26[j25] = uint8(num)
-			j25++
-		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintTx(dAtA, i, uint64(j25))
-		i--
-		dAtA[i] = 0x6a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNewTopic)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinStakeFraction[iNdEx].Size()
-				i -= size
-				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNewTopic(ctx, in)
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MaxGradientThreshold[iNdEx].Size()
-				i -= size
-				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CreateNewTopic",
 	}
-	if len(m.LearningRate) > 0 {
-		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.LearningRate[iNdEx].Size()
-				i -= size
-				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNewTopic(ctx, req.(*MsgCreateNewTopic))
 	}
-	if len(m.BetaEntropy) > 0 {
-		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.BetaEntropy[iNdEx].Size()
-				i -= size
-				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTopicParamsOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicParamsOverrides)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MinEpochLength) > 0 {
-		dAtA28 := make([]byte, len(m.MinEpochLength)*10)
-		var j27 int
-		for _, num1 := range m.MinEpochLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
-			}
-			dAtA28[j27] = uint8(num)
-			j27++
-		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintTx(dAtA, i, uint64(j27))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicParamsOverrides(ctx, in)
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		dAtA30 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
-		var j29 int
-		for _, num1 := range m.RemoveStakeDelayWindow {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j29++
-			}
-			dAtA30[j29] = uint8(num)
-			j29++
-		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintTx(dAtA, i, uint64(j29))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateTopicParamsOverrides",
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RequiredMinimumStake[iNdEx].Size()
-				i -= size
-				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicParamsOverrides(ctx, req.(*MsgUpdateTopicParamsOverrides))
 	}
-	if len(m.MaxTopicsPerBlock) > 0 {
-		dAtA32 := make([]byte, len(m.MaxTopicsPerBlock)*10)
-		var j31 int
-		for _, num := range m.MaxTopicsPerBlock {
-			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j31++
-			}
-			dAtA32[j31] = uint8(num)
-			j31++
-		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintTx(dAtA, i, uint64(j31))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegister)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MinTopicWeight) > 0 {
-		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinTopicWeight[iNdEx].Size()
-				i -= size
-				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).Register(ctx, in)
 	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		dAtA34 := make([]byte, len(m.MaxSerializedMsgLength)*10)
-		var j33 int
-		for _, num1 := range m.MaxSerializedMsgLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j33++
-			}
-			dAtA34[j33] = uint8(num)
-			j33++
-		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintTx(dAtA, i, uint64(j33))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/Register",
 	}
-	if len(m.Version) > 0 {
-		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Version[iNdEx])
-			copy(dAtA[i:], m.Version[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Register(ctx, req.(*MsgRegister))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRegistration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRegistration(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveRegistration",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRegistration(ctx, req.(*MsgRemoveRegistration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNodeInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, req.(*MsgUpdateNodeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetPayoutAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPayoutAddress)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetPayoutAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/SetPayoutAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPayoutAddress(ctx, req.(*MsgSetPayoutAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertBulkReputerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertBulkReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/InsertBulkReputerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertBulkReputerPayload(ctx, req.(*MsgInsertBulkReputerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Epsilon.Size()
-		i -= size
-		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertReputerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AllowNegative {
-		i--
-		if m.AllowNegative {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	if interceptor == nil {
+		return srv.(MsgServer).InsertReputerPayload(ctx, in)
 	}
-	{
-		size := m.AlphaRegret.Size()
-		i -= size
-		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/InsertReputerPayload",
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PNorm.Size()
-		i -= size
-		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertReputerPayload(ctx, req.(*MsgInsertReputerPayload))
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.DefaultArg) > 0 {
-		i -= len(m.DefaultArg)
-		copy(dAtA[i:], m.DefaultArg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DefaultArg)))
-		i--
-		dAtA[i] = 0x4a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GroundTruthLag != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
-		i--
-		dAtA[i] = 0x40
+	if interceptor == nil {
+		return srv.(MsgServer).AddStake(ctx, in)
 	}
-	if m.EpochLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x38
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/AddStake",
 	}
-	if len(m.InferenceMethod) > 0 {
-		i -= len(m.InferenceMethod)
-		copy(dAtA[i:], m.InferenceMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceMethod)))
-		i--
-		dAtA[i] = 0x32
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddStake(ctx, req.(*MsgAddStake))
 	}
-	if len(m.InferenceLogic) > 0 {
-		i -= len(m.InferenceLogic)
-		copy(dAtA[i:], m.InferenceLogic)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceLogic)))
-		i--
-		dAtA[i] = 0x2a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.LossMethod) > 0 {
-		i -= len(m.LossMethod)
-		copy(dAtA[i:], m.LossMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
-		i--
-		dAtA[i] = 0x22
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveStake(ctx, in)
 	}
-	if len(m.LossLogic) > 0 {
-		i -= len(m.LossLogic)
-		copy(dAtA[i:], m.LossLogic)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LossLogic)))
-		i--
-		dAtA[i] = 0x1a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveStake",
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x12
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveStake(ctx, req.(*MsgRemoveStake))
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRemoveStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRemoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CancelRemoveStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRemoveStake(ctx, req.(*MsgCancelRemoveStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateStake)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/DelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateStake(ctx, req.(*MsgDelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRewardDelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RewardDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RewardDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RewardDelegateStake(ctx, req.(*MsgRewardDelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func _Msg_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDelegateStake(ctx, req.(*MsgRemoveDelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRemoveDelegateStake)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CancelRemoveDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, req.(*MsgCancelRemoveDelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInsertBulkReputerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReputerValueBundles) > 0 {
-		for iNdEx := len(m.ReputerValueBundles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReputerValueBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundTopic)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).FundTopic(ctx, in)
 	}
-	if m.ReputerRequestNonce != nil {
-		{
-			size, err := m.ReputerRequestNonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/FundTopic",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundTopic(ctx, req.(*MsgFundTopic))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInsertBulkReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInsertBulkReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RequestInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestInference(ctx, req.(*MsgRequestInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkWorkerPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubscription)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInsertBulkWorkerPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubscription(ctx, req.(*MsgCreateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkWorkerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerDataBundles) > 0 {
-		for iNdEx := len(m.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WorkerDataBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubscription(ctx, in)
 	}
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CancelSubscription",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubscription(ctx, req.(*MsgCancelSubscription))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToWhitelistAdmin)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInsertBulkWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInsertBulkWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddToWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/AddToWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToWhitelistAdmin(ctx, req.(*MsgAddToWhitelistAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegister) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromWhitelistAdmin)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveFromWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, req.(*MsgRemoveFromWhitelistAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegister) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RevokeRole",
 	}
-	if len(m.MultiAddress) > 0 {
-		i -= len(m.MultiAddress)
-		copy(dAtA[i:], m.MultiAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
 	}
-	if len(m.LibP2PKey) > 0 {
-		i -= len(m.LibP2PKey)
-		copy(dAtA[i:], m.LibP2PKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterResponse) Marshal() (dAtA []byte, err error) {
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "emissions.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ScheduleParamsChange",
+			Handler:    _Msg_ScheduleParamsChange_Handler,
+		},
+		{
+			MethodName: "CancelParamsChange",
+			Handler:    _Msg_CancelParamsChange_Handler,
+		},
+		{
+			MethodName: "InsertBulkWorkerPayload",
+			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
+		},
+		{
+			MethodName: "InsertWorkerPayload",
+			Handler:    _Msg_InsertWorkerPayload_Handler,
+		},
+		{
+			MethodName: "CreateNewTopic",
+			Handler:    _Msg_CreateNewTopic_Handler,
+		},
+		{
+			MethodName: "UpdateTopicParamsOverrides",
+			Handler:    _Msg_UpdateTopicParamsOverrides_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Msg_Register_Handler,
+		},
+		{
+			MethodName: "RemoveRegistration",
+			Handler:    _Msg_RemoveRegistration_Handler,
+		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _Msg_UpdateNodeInfo_Handler,
+		},
+		{
+			MethodName: "SetPayoutAddress",
+			Handler:    _Msg_SetPayoutAddress_Handler,
+		},
+		{
+			MethodName: "InsertBulkReputerPayload",
+			Handler:    _Msg_InsertBulkReputerPayload_Handler,
+		},
+		{
+			MethodName: "InsertReputerPayload",
+			Handler:    _Msg_InsertReputerPayload_Handler,
+		},
+		{
+			MethodName: "AddStake",
+			Handler:    _Msg_AddStake_Handler,
+		},
+		{
+			MethodName: "RemoveStake",
+			Handler:    _Msg_RemoveStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveStake",
+			Handler:    _Msg_CancelRemoveStake_Handler,
+		},
+		{
+			MethodName: "DelegateStake",
+			Handler:    _Msg_DelegateStake_Handler,
+		},
+		{
+			MethodName: "RewardDelegateStake",
+			Handler:    _Msg_RewardDelegateStake_Handler,
+		},
+		{
+			MethodName: "RemoveDelegateStake",
+			Handler:    _Msg_RemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveDelegateStake",
+			Handler:    _Msg_CancelRemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "FundTopic",
+			Handler:    _Msg_FundTopic_Handler,
+		},
+		{
+			MethodName: "RequestInference",
+			Handler:    _Msg_RequestInference_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Msg_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Msg_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "AddToWhitelistAdmin",
+			Handler:    _Msg_AddToWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveFromWhitelistAdmin",
+			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emissions/v1/tx.proto",
+}
+
+func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3853,515 +4609,916 @@ func (m *MsgRegisterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+	if len(m.PayloadSubmissionWindow) > 0 {
+		dAtA2 := make([]byte, len(m.PayloadSubmissionWindow)*10)
+		var j1 int
+		for _, num1 := range m.PayloadSubmissionWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.Success {
+	if len(m.LegacyBundleSignaturesEndBlock) > 0 {
+		dAtA4 := make([]byte, len(m.LegacyBundleSignaturesEndBlock)*10)
+		var j3 int
+		for _, num1 := range m.LegacyBundleSignaturesEndBlock {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
 		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.MaxRegretHistoryLength) > 0 {
+		dAtA6 := make([]byte, len(m.MaxRegretHistoryLength)*10)
+		var j5 int
+		for _, num := range m.MaxRegretHistoryLength {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveRegistration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ProbabilitySumTolerance) > 0 {
+		for iNdEx := len(m.ProbabilitySumTolerance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ProbabilitySumTolerance[iNdEx].Size()
+				i -= size
+				if _, err := m.ProbabilitySumTolerance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveRegistration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.MaxInferenceDimension) > 0 {
+		dAtA8 := make([]byte, len(m.MaxInferenceDimension)*10)
+		var j7 int
+		for _, num := range m.MaxInferenceDimension {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x82
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MaxPruningGasPerBlock) > 0 {
+		dAtA10 := make([]byte, len(m.MaxPruningGasPerBlock)*10)
+		var j9 int
+		for _, num := range m.MaxPruningGasPerBlock {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+	if len(m.MaxRewardGasPerBlock) > 0 {
+		dAtA12 := make([]byte, len(m.MaxRewardGasPerBlock)*10)
+		var j11 int
+		for _, num := range m.MaxRewardGasPerBlock {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
+		dAtA[i] = 0x2
 		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0xf2
+	}
+	if len(m.MaxStakeRemovalGasPerBlock) > 0 {
+		dAtA14 := make([]byte, len(m.MaxStakeRemovalGasPerBlock)*10)
+		var j13 int
+		for _, num := range m.MaxStakeRemovalGasPerBlock {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.MaxOpenInferenceRequestsPerTopic) > 0 {
+		dAtA16 := make([]byte, len(m.MaxOpenInferenceRequestsPerTopic)*10)
+		var j15 int
+		for _, num := range m.MaxOpenInferenceRequestsPerTopic {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MinParamChangeDelay) > 0 {
+		dAtA18 := make([]byte, len(m.MinParamChangeDelay)*10)
+		var j17 int
+		for _, num1 := range m.MinParamChangeDelay {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.TopicRevenueBurnFraction) > 0 {
+		for iNdEx := len(m.TopicRevenueBurnFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRevenueBurnFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRevenueBurnFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MinEffectiveTopicRevenue) > 0 {
+		for iNdEx := len(m.MinEffectiveTopicRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinEffectiveTopicRevenue[iNdEx].Size()
+				i -= size
+				if _, err := m.MinEffectiveTopicRevenue[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.EpsilonReputer) > 0 {
+		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.TopicFeeRevenueDecayRate) > 0 {
+		for iNdEx := len(m.TopicFeeRevenueDecayRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicFeeRevenueDecayRate[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicFeeRevenueDecayRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.CNorm) > 0 {
+		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.CRewardForecast) > 0 {
+		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.CRewardInference) > 0 {
+		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.PRewardReputer) > 0 {
+		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.PRewardForecast) > 0 {
+		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegateStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PRewardInference) > 0 {
+		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.BlocksPerMonth) > 0 {
+		dAtA20 := make([]byte, len(m.BlocksPerMonth)*10)
+		var j19 int
+		for _, num := range m.BlocksPerMonth {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x82
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		dAtA22 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
+		var j21 int
+		for _, num1 := range m.MinEpochLengthRecordLimit {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTx(dAtA, i, uint64(j21))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MaxPageLimit) > 0 {
+		dAtA24 := make([]byte, len(m.MaxPageLimit)*10)
+		var j23 int
+		for _, num := range m.MaxPageLimit {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintTx(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DefaultPageLimit) > 0 {
+		dAtA26 := make([]byte, len(m.DefaultPageLimit)*10)
+		var j25 int
+		for _, num := range m.DefaultPageLimit {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintTx(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RegistrationFee[iNdEx].Size()
+				i -= size
+				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
+		dAtA28 := make([]byte, len(m.MaxRetriesToFulfilNoncesReputer)*10)
+		var j27 int
+		for _, num1 := range m.MaxRetriesToFulfilNoncesReputer {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintTx(dAtA, i, uint64(j27))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xda
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
+		dAtA30 := make([]byte, len(m.MaxRetriesToFulfilNoncesWorker)*10)
+		var j29 int
+		for _, num1 := range m.MaxRetriesToFulfilNoncesWorker {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintTx(dAtA, i, uint64(j29))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.GradientDescentMaxIters) > 0 {
+		dAtA32 := make([]byte, len(m.GradientDescentMaxIters)*10)
+		var j31 int
+		for _, num := range m.GradientDescentMaxIters {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintTx(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.CreateTopicFee) > 0 {
+		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CreateTopicFee[iNdEx].Size()
+				i -= size
+				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA34 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j33 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintTx(dAtA, i, uint64(j33))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xba
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+	if len(m.MaxTopForecastersToReward) > 0 {
+		dAtA36 := make([]byte, len(m.MaxTopForecastersToReward)*10)
+		var j35 int
+		for _, num := range m.MaxTopForecastersToReward {
+			for num >= 1<<7 {
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA36[j35] = uint8(num)
+			j35++
+		}
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintTx(dAtA, i, uint64(j35))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA38 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j37 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintTx(dAtA, i, uint64(j37))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		dAtA40 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
+		var j39 int
+		for _, num := range m.MaxSamplesToScaleScores {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
+		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintTx(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
+				i -= size
+				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TaskRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardStakeImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		dAtA42 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
+		var j41 int
+		for _, num := range m.MaxUnfulfilledReputerRequests {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintTx(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		dAtA44 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
+		var j43 int
+		for _, num := range m.MaxUnfulfilledWorkerRequests {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintTx(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinStakeFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxGradientThreshold[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LearningRate[iNdEx].Size()
+				i -= size
+				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BetaEntropy) > 0 {
+		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BetaEntropy[iNdEx].Size()
+				i -= size
+				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MinEpochLength) > 0 {
+		dAtA46 := make([]byte, len(m.MinEpochLength)*10)
+		var j45 int
+		for _, num1 := range m.MinEpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j45++
+			}
+			dAtA46[j45] = uint8(num)
+			j45++
+		}
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintTx(dAtA, i, uint64(j45))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		dAtA48 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
+		var j47 int
+		for _, num1 := range m.RemoveStakeDelayWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j47++
+			}
+			dAtA48[j47] = uint8(num)
+			j47++
+		}
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintTx(dAtA, i, uint64(j47))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RequiredMinimumStake[iNdEx].Size()
+				i -= size
+				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxTopicsPerBlock) > 0 {
+		dAtA50 := make([]byte, len(m.MaxTopicsPerBlock)*10)
+		var j49 int
+		for _, num := range m.MaxTopicsPerBlock {
+			for num >= 1<<7 {
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j49++
+			}
+			dAtA50[j49] = uint8(num)
+			j49++
+		}
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintTx(dAtA, i, uint64(j49))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinTopicWeight[iNdEx].Size()
+				i -= size
+				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		dAtA52 := make([]byte, len(m.MaxSerializedMsgLength)*10)
+		var j51 int
+		for _, num1 := range m.MaxSerializedMsgLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j51++
+			}
+			dAtA52[j51] = uint8(num)
+			j51++
+		}
+		i -= j51
+		copy(dAtA[i:], dAtA52[:j51])
+		i = encodeVarintTx(dAtA, i, uint64(j51))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Version[iNdEx])
+			copy(dAtA[i:], m.Version[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4371,53 +5528,27 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgFundTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFundTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFundTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -4429,7 +5560,7 @@ func (m *MsgFundTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundTopicResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4439,12 +5570,12 @@ func (m *MsgFundTopicResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4452,7 +5583,7 @@ func (m *MsgFundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgScheduleParamsChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4462,20 +5593,30 @@ func (m *MsgAddToWhitelistAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddToWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgScheduleParamsChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddToWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgScheduleParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.ApplyAtBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplyAtBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4489,7 +5630,7 @@ func (m *MsgAddToWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgScheduleParamsChangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4499,20 +5640,25 @@ func (m *MsgAddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgScheduleParamsChangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgScheduleParamsChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelParamsChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4522,22 +5668,20 @@ func (m *MsgRemoveFromWhitelistAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelParamsChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -4549,7 +5693,7 @@ func (m *MsgRemoveFromWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelParamsChangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4559,12 +5703,12 @@ func (m *MsgRemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelParamsChangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelParamsChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4572,7 +5716,7 @@ func (m *MsgRemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateNewTopic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4582,20 +5726,182 @@ func (m *MsgRewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AggregationTrimFraction.Size()
+		i -= size
+		if _, err := m.AggregationTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.AggregationStrategy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AggregationStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.CategoryLabels) > 0 {
+		for iNdEx := len(m.CategoryLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CategoryLabels[iNdEx])
+			copy(dAtA[i:], m.CategoryLabels[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CategoryLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.QuantileLevels) > 0 {
+		for iNdEx := len(m.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.QuantileLevels[iNdEx].Size()
+				i -= size
+				if _, err := m.QuantileLevels[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.InferenceDimension != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InferenceDimension))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ParamsOverrides != nil {
+		{
+			size, err := m.ParamsOverrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size := m.Epsilon.Size()
+		i -= size
+		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AllowNegative {
+		i--
+		if m.AllowNegative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.AlphaRegret.Size()
+		i -= size
+		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PNorm.Size()
+		i -= size
+		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.DefaultArg) > 0 {
+		i -= len(m.DefaultArg)
+		copy(dAtA[i:], m.DefaultArg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefaultArg)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GroundTruthLag != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InferenceMethod) > 0 {
+		i -= len(m.InferenceMethod)
+		copy(dAtA[i:], m.InferenceMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceMethod)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InferenceLogic) > 0 {
+		i -= len(m.InferenceLogic)
+		copy(dAtA[i:], m.InferenceLogic)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceLogic)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LossMethod) > 0 {
+		i -= len(m.LossMethod)
+		copy(dAtA[i:], m.LossMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LossLogic) > 0 {
+		i -= len(m.LossLogic)
+		copy(dAtA[i:], m.LossLogic)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LossLogic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRewardDelegateStake) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4605,846 +5911,6594 @@ func (m *MsgRewardDelegateStake) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRewardDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRewardDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.TopicId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *OptionalParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateTopicParamsOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicParamsOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicParamsOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Version) > 0 {
-		for _, s := range m.Version {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		l = 0
-		for _, e := range m.MaxSerializedMsgLength {
-			l += sovTx(uint64(e))
+	if m.Overrides != nil {
+		{
+			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.MinTopicWeight) > 0 {
-		for _, e := range m.MinTopicWeight {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.MaxTopicsPerBlock) > 0 {
-		l = 0
-		for _, e := range m.MaxTopicsPerBlock {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for _, e := range m.RequiredMinimumStake {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicParamsOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		l = 0
-		for _, e := range m.RemoveStakeDelayWindow {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicParamsOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicParamsOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertBulkReputerPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MinEpochLength) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLength {
-			l += sovTx(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertBulkReputerPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertBulkReputerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputerValueBundles) > 0 {
+		for iNdEx := len(m.ReputerValueBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerValueBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	if len(m.BetaEntropy) > 0 {
-		for _, e := range m.BetaEntropy {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.LearningRate) > 0 {
-		for _, e := range m.LearningRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.ReputerRequestNonce != nil {
+		{
+			size, err := m.ReputerRequestNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for _, e := range m.MaxGradientThreshold {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for _, e := range m.MinStakeFraction {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertBulkReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledWorkerRequests {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertBulkReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertBulkReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CloseBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledReputerRequests {
-			l += sovTx(uint64(e))
+	if len(m.Outcomes) > 0 {
+		for iNdEx := len(m.Outcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for _, e := range m.TopicRewardStakeImportance {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertBulkWorkerPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for _, e := range m.TopicRewardFeeRevenueImportance {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertBulkWorkerPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertBulkWorkerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerDataBundles) > 0 {
+		for iNdEx := len(m.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerDataBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for _, e := range m.TopicRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for _, e := range m.TaskRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for _, e := range m.ValidatorsVsAlloraPercentReward {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		l = 0
-		for _, e := range m.MaxSamplesToScaleScores {
-			l += sovTx(uint64(e))
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopInferersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopForecastersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopReputersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CloseBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for _, e := range m.CreateTopicFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
+	if len(m.ForecastOutcomes) > 0 {
+		for iNdEx := len(m.ForecastOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecastOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		l = 0
-		for _, e := range m.GradientDescentMaxIters {
-			l += sovTx(uint64(e))
+	if len(m.InferenceOutcomes) > 0 {
+		for iNdEx := len(m.InferenceOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InferenceOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 2 + sovTx(uint64(l)) + l
 	}
-	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
-		l = 0
-		for _, e := range m.MaxRetriesToFulfilNoncesWorker {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertReputerPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
-		l = 0
-		for _, e := range m.MaxRetriesToFulfilNoncesReputer {
-			l += sovTx(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertReputerPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertReputerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundle != nil {
+		{
+			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RegistrationFee) > 0 {
-		for _, e := range m.RegistrationFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		l = 0
-		for _, e := range m.DefaultPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxPageLimit) > 0 {
-		l = 0
-		for _, e := range m.MaxPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CloseBlock))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLengthRecordLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertWorkerPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		l = 0
-		for _, e := range m.BlocksPerMonth {
-			l += sovTx(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertWorkerPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertWorkerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerDataBundle != nil {
+		{
+			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PRewardInference) > 0 {
-		for _, e := range m.PRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.PRewardForecast) > 0 {
-		for _, e := range m.PRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardReputer) > 0 {
-		for _, e := range m.PRewardReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CRewardInference) > 0 {
-		for _, e := range m.CRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CRewardForecast) > 0 {
-		for _, e := range m.CRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CNorm) > 0 {
-		for _, e := range m.CNorm {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.TopicFeeRevenueDecayRate) > 0 {
-		for _, e := range m.TopicFeeRevenueDecayRate {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.EpsilonReputer) > 0 {
-		for _, e := range m.EpsilonReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MinEffectiveTopicRevenue) > 0 {
-		for _, e := range m.MinEffectiveTopicRevenue {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgInsertWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.CloseBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CloseBlock))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegister) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateNewTopic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LossLogic)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceLogic)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.InferenceMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTx(uint64(m.EpochLength))
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.GroundTruthLag != 0 {
-		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	if len(m.MultiAddress) > 0 {
+		i -= len(m.MultiAddress)
+		copy(dAtA[i:], m.MultiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DefaultArg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.PNorm.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.AlphaRegret.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.AllowNegative {
-		n += 2
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Epsilon.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateNewTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+func (m *MsgRegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInsertBulkReputerPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ReputerRequestNonce != nil {
-		l = m.ReputerRequestNonce.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ReputerValueBundles) > 0 {
-		for _, e := range m.ReputerValueBundles {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertBulkReputerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInsertBulkWorkerPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != nil {
-		l = m.Nonce.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	if len(m.WorkerDataBundles) > 0 {
-		for _, e := range m.WorkerDataBundles {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertBulkWorkerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegister) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LibP2PKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MultiAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IsReputer {
-		n += 2
-	}
-	return n
+func (m *MsgRemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveRegistration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	if m.IsReputer {
-		n += 2
+func (m *MsgUpdateNodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveRegistrationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateNodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	if len(m.PreviousLibP2PKey) > 0 {
+		i -= len(m.PreviousLibP2PKey)
+		copy(dAtA[i:], m.PreviousLibP2PKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousLibP2PKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *MsgAddStake) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MultiAddress) > 0 {
+		i -= len(m.MultiAddress)
+		copy(dAtA[i:], m.MultiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUpdateNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelRemoveStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+func (m *MsgSetPayoutAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelRemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetPayoutAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetPayoutAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPayoutAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPayoutAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPayoutAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemoveStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundTopic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTopic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestInference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestInference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Bounty.Size()
+		i -= size
+		if _, err := m.Bounty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Argument) > 0 {
+		i -= len(m.Argument)
+		copy(dAtA[i:], m.Argument)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Argument)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestInferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountPerEpoch.Size()
+		i -= size
+		if _, err := m.AmountPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Escrow.Size()
+		i -= size
+		if _, err := m.Escrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRewardDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRewardDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRewardDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptionalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		for _, s := range m.Version {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		l = 0
+		for _, e := range m.MaxSerializedMsgLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for _, e := range m.MinTopicWeight {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxTopicsPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxTopicsPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for _, e := range m.RequiredMinimumStake {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		l = 0
+		for _, e := range m.RemoveStakeDelayWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLength) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BetaEntropy) > 0 {
+		for _, e := range m.BetaEntropy {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for _, e := range m.LearningRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for _, e := range m.MaxGradientThreshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for _, e := range m.MinStakeFraction {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledWorkerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledReputerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for _, e := range m.TopicRewardStakeImportance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for _, e := range m.TopicRewardFeeRevenueImportance {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for _, e := range m.TopicRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for _, e := range m.TaskRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for _, e := range m.ValidatorsVsAlloraPercentReward {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		l = 0
+		for _, e := range m.MaxSamplesToScaleScores {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopForecastersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.CreateTopicFee) > 0 {
+		for _, e := range m.CreateTopicFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GradientDescentMaxIters) > 0 {
+		l = 0
+		for _, e := range m.GradientDescentMaxIters {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
+		l = 0
+		for _, e := range m.MaxRetriesToFulfilNoncesWorker {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
+		l = 0
+		for _, e := range m.MaxRetriesToFulfilNoncesReputer {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DefaultPageLimit) > 0 {
+		l = 0
+		for _, e := range m.DefaultPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxPageLimit) > 0 {
+		l = 0
+		for _, e := range m.MaxPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLengthRecordLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.BlocksPerMonth) > 0 {
+		l = 0
+		for _, e := range m.BlocksPerMonth {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.PRewardInference) > 0 {
+		for _, e := range m.PRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for _, e := range m.PRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for _, e := range m.PRewardReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for _, e := range m.CRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for _, e := range m.CRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for _, e := range m.CNorm {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicFeeRevenueDecayRate) > 0 {
+		for _, e := range m.TopicFeeRevenueDecayRate {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for _, e := range m.EpsilonReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinEffectiveTopicRevenue) > 0 {
+		for _, e := range m.MinEffectiveTopicRevenue {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRevenueBurnFraction) > 0 {
+		for _, e := range m.TopicRevenueBurnFraction {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinParamChangeDelay) > 0 {
+		l = 0
+		for _, e := range m.MinParamChangeDelay {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxOpenInferenceRequestsPerTopic) > 0 {
+		l = 0
+		for _, e := range m.MaxOpenInferenceRequestsPerTopic {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxStakeRemovalGasPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxStakeRemovalGasPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxRewardGasPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxRewardGasPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxPruningGasPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxPruningGasPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxInferenceDimension) > 0 {
+		l = 0
+		for _, e := range m.MaxInferenceDimension {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ProbabilitySumTolerance) > 0 {
+		for _, e := range m.ProbabilitySumTolerance {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxRegretHistoryLength) > 0 {
+		l = 0
+		for _, e := range m.MaxRegretHistoryLength {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.LegacyBundleSignaturesEndBlock) > 0 {
+		l = 0
+		for _, e := range m.LegacyBundleSignaturesEndBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.PayloadSubmissionWindow) > 0 {
+		l = 0
+		for _, e := range m.PayloadSubmissionWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplyAtBlock != 0 {
+		n += 1 + sovTx(uint64(m.ApplyAtBlock))
+	}
+	return n
+}
+
+func (m *MsgScheduleParamsChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelParamsChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateNewTopic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LossLogic)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceLogic)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	}
+	l = len(m.DefaultArg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PNorm.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AlphaRegret.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AllowNegative {
+		n += 2
+	}
+	l = m.Epsilon.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ParamsOverrides != nil {
+		l = m.ParamsOverrides.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InferenceDimension != 0 {
+		n += 1 + sovTx(uint64(m.InferenceDimension))
+	}
+	if len(m.QuantileLevels) > 0 {
+		for _, e := range m.QuantileLevels {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CategoryLabels) > 0 {
+		for _, s := range m.CategoryLabels {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AggregationStrategy != 0 {
+		n += 2 + sovTx(uint64(m.AggregationStrategy))
+	}
+	l = m.AggregationTrimFraction.Size()
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateNewTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicParamsOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.Overrides != nil {
+		l = m.Overrides.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicParamsOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInsertBulkReputerPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReputerRequestNonce != nil {
+		l = m.ReputerRequestNonce.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if len(m.ReputerValueBundles) > 0 {
+		for _, e := range m.ReputerValueBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInsertBulkReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outcomes) > 0 {
+		for _, e := range m.Outcomes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CloseBlock != 0 {
+		n += 1 + sovTx(uint64(m.CloseBlock))
+	}
+	return n
+}
+
+func (m *MsgInsertBulkWorkerPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if len(m.WorkerDataBundles) > 0 {
+		for _, e := range m.WorkerDataBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InferenceOutcomes) > 0 {
+		for _, e := range m.InferenceOutcomes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ForecastOutcomes) > 0 {
+		for _, e := range m.ForecastOutcomes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CloseBlock != 0 {
+		n += 1 + sovTx(uint64(m.CloseBlock))
+	}
+	return n
+}
+
+func (m *MsgInsertReputerPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReputerValueBundle != nil {
+		l = m.ReputerValueBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInsertReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CloseBlock != 0 {
+		n += 1 + sovTx(uint64(m.CloseBlock))
+	}
+	return n
+}
+
+func (m *MsgInsertWorkerPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WorkerDataBundle != nil {
+		l = m.WorkerDataBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInsertWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CloseBlock != 0 {
+		n += 1 + sovTx(uint64(m.CloseBlock))
+	}
+	return n
+}
+
+func (m *MsgRegister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = len(m.PreviousLibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPayoutAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.Global {
+		n += 2
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPayoutAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRemoveStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *MsgCancelRemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRemoveDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundTopic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Argument)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	l = m.Bounty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountPerEpoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *MsgCancelSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *MsgCancelSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToWhitelistAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddToWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRewardDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRewardDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
+					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinTopicWeight = append(m.MinTopicWeight, v)
+			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopicsPerBlock) == 0 {
+					m.MaxTopicsPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicsPerBlock", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
+			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
+					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLength = append(m.MinEpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLength) == 0 {
+					m.MinEpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLength = append(m.MinEpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BetaEntropy = append(m.BetaEntropy, v)
+			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.LearningRate = append(m.LearningRate, v)
+			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
+			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinStakeFraction = append(m.MinStakeFraction, v)
+			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
+					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+			}
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
+					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
+			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
+			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
+			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
+			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
+			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
+					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+			}
+		case 21:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
+					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+			}
+		case 23:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CreateTopicFee = append(m.CreateTopicFee, v)
+			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
+					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
+			}
+		case 26:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesWorker) == 0 {
+					m.MaxRetriesToFulfilNoncesWorker = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesWorker", wireType)
+			}
+		case 27:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesReputer) == 0 {
+					m.MaxRetriesToFulfilNoncesReputer = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesReputer", wireType)
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RegistrationFee = append(m.RegistrationFee, v)
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
+					m.DefaultPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
+			}
+		case 30:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPageLimit = append(m.MaxPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
+					m.MaxPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPageLimit = append(m.MaxPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
+			}
+		case 31:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
+					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
+					m.BlocksPerMonth = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardInference = append(m.PRewardInference, v)
+			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardForecast = append(m.PRewardForecast, v)
+			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardReputer = append(m.PRewardReputer, v)
+			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardInference = append(m.CRewardInference, v)
+			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardForecast = append(m.CRewardForecast, v)
+			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CNorm = append(m.CNorm, v)
+			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenueDecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicFeeRevenueDecayRate = append(m.TopicFeeRevenueDecayRate, v)
+			if err := m.TopicFeeRevenueDecayRate[len(m.TopicFeeRevenueDecayRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonReputer = append(m.EpsilonReputer, v)
+			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEffectiveTopicRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinEffectiveTopicRevenue = append(m.MinEffectiveTopicRevenue, v)
+			if err := m.MinEffectiveTopicRevenue[len(m.MinEffectiveTopicRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRevenueBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRevenueBurnFraction = append(m.TopicRevenueBurnFraction, v)
+			if err := m.TopicRevenueBurnFraction[len(m.TopicRevenueBurnFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinParamChangeDelay = append(m.MinParamChangeDelay, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinParamChangeDelay) == 0 {
+					m.MinParamChangeDelay = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinParamChangeDelay = append(m.MinParamChangeDelay, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParamChangeDelay", wireType)
+			}
+		case 44:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxOpenInferenceRequestsPerTopic = append(m.MaxOpenInferenceRequestsPerTopic, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxOpenInferenceRequestsPerTopic) == 0 {
+					m.MaxOpenInferenceRequestsPerTopic = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxOpenInferenceRequestsPerTopic = append(m.MaxOpenInferenceRequestsPerTopic, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInferenceRequestsPerTopic", wireType)
+			}
+		case 45:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxStakeRemovalGasPerBlock = append(m.MaxStakeRemovalGasPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxStakeRemovalGasPerBlock) == 0 {
+					m.MaxStakeRemovalGasPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxStakeRemovalGasPerBlock = append(m.MaxStakeRemovalGasPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakeRemovalGasPerBlock", wireType)
+			}
+		case 46:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRewardGasPerBlock = append(m.MaxRewardGasPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRewardGasPerBlock) == 0 {
+					m.MaxRewardGasPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRewardGasPerBlock = append(m.MaxRewardGasPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardGasPerBlock", wireType)
+			}
+		case 47:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPruningGasPerBlock = append(m.MaxPruningGasPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPruningGasPerBlock) == 0 {
+					m.MaxPruningGasPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPruningGasPerBlock = append(m.MaxPruningGasPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPruningGasPerBlock", wireType)
+			}
+		case 48:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxInferenceDimension = append(m.MaxInferenceDimension, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxInferenceDimension) == 0 {
+					m.MaxInferenceDimension = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxInferenceDimension = append(m.MaxInferenceDimension, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInferenceDimension", wireType)
+			}
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilitySumTolerance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ProbabilitySumTolerance = append(m.ProbabilitySumTolerance, v)
+			if err := m.ProbabilitySumTolerance[len(m.ProbabilitySumTolerance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRegretHistoryLength = append(m.MaxRegretHistoryLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRegretHistoryLength) == 0 {
+					m.MaxRegretHistoryLength = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRegretHistoryLength = append(m.MaxRegretHistoryLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegretHistoryLength", wireType)
+			}
+		case 51:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LegacyBundleSignaturesEndBlock = append(m.LegacyBundleSignaturesEndBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LegacyBundleSignaturesEndBlock) == 0 {
+					m.LegacyBundleSignaturesEndBlock = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LegacyBundleSignaturesEndBlock = append(m.LegacyBundleSignaturesEndBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyBundleSignaturesEndBlock", wireType)
+			}
+		case 52:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PayloadSubmissionWindow = append(m.PayloadSubmissionWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PayloadSubmissionWindow) == 0 {
+					m.PayloadSubmissionWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PayloadSubmissionWindow = append(m.PayloadSubmissionWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSubmissionWindow", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgCancelRemoveDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCancelRemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgFundTopic) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	return nil
+}
+func (m *MsgScheduleParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyAtBlock", wireType)
+			}
+			m.ApplyAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyAtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgFundTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgAddToWhitelistAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgScheduleParamsChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddToWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveFromWhitelistAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveFromWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRewardDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelParamsChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamsChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamsChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgRewardDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5454,28 +12508,258 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNewTopic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNewTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5503,87 +12787,99 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			m.DefaultArg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
-					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
 			}
-		case 3:
+			m.AllowNegative = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5611,93 +12907,15 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinTopicWeight = append(m.MinTopicWeight, v)
-			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopicsPerBlock) == 0 {
-					m.MaxTopicsPerBlock = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicsPerBlock", wireType)
-			}
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsOverrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5707,183 +12925,50 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
-			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
-					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
+			if m.ParamsOverrides == nil {
+				m.ParamsOverrides = &TopicParamsOverrides{}
 			}
-		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MinEpochLength = append(m.MinEpochLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLength) == 0 {
-					m.MinEpochLength = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLength = append(m.MinEpochLength, v)
+			if err := m.ParamsOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceDimension", wireType)
+			}
+			m.InferenceDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InferenceDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
 			}
-		case 8:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5912,14 +12997,14 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_allora_network_allora_chain_math.Dec
-			m.BetaEntropy = append(m.BetaEntropy, v)
-			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuantileLevels = append(m.QuantileLevels, v)
+			if err := m.QuantileLevels[len(m.QuantileLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryLabels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5947,17 +13032,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.LearningRate = append(m.LearningRate, v)
-			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CategoryLabels = append(m.CategoryLabels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationStrategy", wireType)
 			}
-			var stringLen uint64
+			m.AggregationStrategy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5967,31 +13048,14 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AggregationStrategy |= AggregationStrategy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
-			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationTrimFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6019,167 +13083,132 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinStakeFraction = append(m.MinStakeFraction, v)
-			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregationTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
-					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 14:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
-					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNewTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
 			}
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTopicParamsOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTopicParamsOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTopicParamsOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6207,17 +13236,32 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
-			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6227,31 +13271,131 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
-			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Overrides == nil {
+				m.Overrides = &TopicParamsOverrides{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTopicParamsOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTopicParamsOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTopicParamsOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6279,17 +13423,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
-			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonce", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6299,33 +13439,52 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
-			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReputerRequestNonce == nil {
+				m.ReputerRequestNonce = &ReputerRequestNonce{}
+			}
+			if err := m.ReputerRequestNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 19:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6335,337 +13494,271 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
-			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerValueBundles = append(m.ReputerValueBundles, &ReputerValueBundle{})
+			if err := m.ReputerValueBundles[len(m.ReputerValueBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
-					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 21:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
-					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 22:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
-					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcomes = append(m.Outcomes, &SubmissionOutcome{})
+			if err := m.Outcomes[len(m.Outcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseBlock", wireType)
+			}
+			m.CloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
 			}
-		case 23:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
-					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-		case 24:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6675,261 +13768,81 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.CreateTopicFee = append(m.CreateTopicFee, v)
-			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerDataBundles = append(m.WorkerDataBundles, &WorkerDataBundle{})
+			if err := m.WorkerDataBundles[len(m.WorkerDataBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
-					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
-			}
-		case 26:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesWorker) == 0 {
-					m.MaxRetriesToFulfilNoncesWorker = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesWorker", wireType)
-			}
-		case 27:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesReputer) == 0 {
-					m.MaxRetriesToFulfilNoncesReputer = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesReputer", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 28:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceOutcomes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6939,335 +13852,319 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.RegistrationFee = append(m.RegistrationFee, v)
-			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InferenceOutcomes = append(m.InferenceOutcomes, &SubmissionOutcome{})
+			if err := m.InferenceOutcomes[len(m.InferenceOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
-					m.DefaultPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastOutcomes", wireType)
 			}
-		case 30:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxPageLimit = append(m.MaxPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
-					m.MaxPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxPageLimit = append(m.MaxPageLimit, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
-			}
-		case 31:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecastOutcomes = append(m.ForecastOutcomes, &SubmissionOutcome{})
+			if err := m.ForecastOutcomes[len(m.ForecastOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseBlock", wireType)
+			}
+			m.CloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
-					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertReputerPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertReputerPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertReputerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
 			}
-		case 32:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReputerValueBundle == nil {
+				m.ReputerValueBundle = &ReputerValueBundle{}
+			}
+			if err := m.ReputerValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseBlock", wireType)
+			}
+			m.CloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
-					m.BlocksPerMonth = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
 			}
-		case 33:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertWorkerPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertWorkerPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertWorkerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7295,17 +14192,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardInference = append(m.PRewardInference, v)
-			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 34:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7315,31 +14208,150 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardForecast = append(m.PRewardForecast, v)
-			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WorkerDataBundle == nil {
+				m.WorkerDataBundle = &WorkerDataBundle{}
+			}
+			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseBlock", wireType)
+			}
+			m.CloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegister) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegister: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7367,15 +14379,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardReputer = append(m.PRewardReputer, v)
-			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7403,15 +14411,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardInference = append(m.CRewardInference, v)
-			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 37:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7439,15 +14443,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardForecast = append(m.CRewardForecast, v)
-			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MultiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 38:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7475,17 +14494,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CNorm = append(m.CNorm, v)
-			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 39:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenueDecayRate", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7495,33 +14510,67 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicFeeRevenueDecayRate = append(m.TopicFeeRevenueDecayRate, v)
-			if err := m.TopicFeeRevenueDecayRate[len(m.TopicFeeRevenueDecayRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7531,31 +14580,15 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.EpsilonReputer = append(m.EpsilonReputer, v)
-			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 41:
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEffectiveTopicRevenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7583,11 +14616,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinEffectiveTopicRevenue = append(m.MinEffectiveTopicRevenue, v)
-			if err := m.MinEffectiveTopicRevenue[len(m.MinEffectiveTopicRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7610,7 +14639,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7633,10 +14662,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7672,10 +14701,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7685,28 +14714,31 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Params == nil {
-				m.Params = &OptionalParams{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IsReputer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7716,47 +14748,99 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7778,7 +14862,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7801,15 +14885,15 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNewTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNodeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNewTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7837,11 +14921,11 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7869,11 +14953,11 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7901,13 +14985,13 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			m.MultiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7917,27 +15001,15 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsReputer = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7965,11 +15037,111 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
+			m.PreviousLibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPayoutAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPayoutAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPayoutAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7997,13 +15169,13 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.EpochLength = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8013,16 +15185,16 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
 			}
-			m.GroundTruthLag = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8032,14 +15204,15 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.Global = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8067,45 +15240,111 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultArg = string(dAtA[iNdEx:postIndex])
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPayoutAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPayoutAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPayoutAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 11:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8133,15 +15372,13 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var v int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8151,15 +15388,14 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowNegative = bool(v != 0)
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8187,7 +15423,7 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8212,7 +15448,7 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8235,31 +15471,12 @@ func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNewTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8281,7 +15498,7 @@ func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8304,10 +15521,10 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8343,42 +15560,6 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonce", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReputerRequestNonce == nil {
-				m.ReputerRequestNonce = &ReputerRequestNonce{}
-			}
-			if err := m.ReputerRequestNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8397,11 +15578,11 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8411,23 +15592,23 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerValueBundles = append(m.ReputerValueBundles, &ReputerValueBundle{})
-			if err := m.ReputerValueBundles[len(m.ReputerValueBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8452,7 +15633,7 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8475,10 +15656,10 @@ func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8502,7 +15683,7 @@ func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8525,10 +15706,10 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8564,42 +15745,6 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
-			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8618,40 +15763,6 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkerDataBundles = append(m.WorkerDataBundles, &WorkerDataBundle{})
-			if err := m.WorkerDataBundles[len(m.WorkerDataBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8673,7 +15784,7 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8696,10 +15807,10 @@ func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8723,7 +15834,7 @@ func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegister) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8746,10 +15857,10 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegister: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8785,10 +15896,10 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8798,27 +15909,14 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8846,30 +15944,11 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultiAddress = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8897,28 +15976,10 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsReputer = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8940,7 +16001,7 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8963,64 +16024,12 @@ func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9042,7 +16051,7 @@ func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9065,10 +16074,10 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRegistration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9104,29 +16113,10 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9136,67 +16126,29 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReputer = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var v int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9206,15 +16158,14 @@ func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9239,11 +16190,63 @@ func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9265,7 +16268,7 @@ func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9288,10 +16291,10 @@ func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9347,7 +16350,7 @@ func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9375,9 +16378,39 @@ func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9400,7 +16433,7 @@ func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9423,10 +16456,10 @@ func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9450,7 +16483,7 @@ func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
+func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9473,10 +16506,10 @@ func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundTopic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundTopic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9585,7 +16618,7 @@ func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9608,10 +16641,10 @@ func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9635,7 +16668,7 @@ func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestInference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9658,10 +16691,10 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestInference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestInference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9715,6 +16748,91 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Argument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Argument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9736,7 +16854,7 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9759,12 +16877,67 @@ func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9786,7 +16959,7 @@ func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9809,10 +16982,10 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9868,7 +17041,7 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9896,11 +17069,13 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerEpoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9928,7 +17103,7 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9953,7 +17128,7 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9976,12 +17151,31 @@ func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10003,7 +17197,7 @@ func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10026,10 +17220,10 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10065,61 +17259,10 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
+			m.SubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10129,26 +17272,11 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10170,7 +17298,7 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10193,10 +17321,10 @@ func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10220,7 +17348,7 @@ func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10243,10 +17371,10 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10282,59 +17410,8 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10362,7 +17439,7 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10385,7 +17462,7 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10408,10 +17485,10 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10435,7 +17512,7 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10458,10 +17535,10 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10497,27 +17574,8 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10545,9 +17603,7 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10570,7 +17626,7 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10593,10 +17649,10 @@ func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10620,7 +17676,7 @@ func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10643,10 +17699,10 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10682,6 +17738,38 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -10734,7 +17822,7 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10757,10 +17845,10 @@ func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10784,7 +17872,7 @@ func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10807,10 +17895,10 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10846,6 +17934,38 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -10898,7 +18018,7 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10921,10 +18041,10 @@ func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
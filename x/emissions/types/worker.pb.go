@@ -25,6 +25,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Role in which a worker is attributed a network regret
+type RegretRole int32
+
+const (
+	RegretRole_REGRET_ROLE_INFERER    RegretRole = 0
+	RegretRole_REGRET_ROLE_FORECASTER RegretRole = 1
+	// Regret of an inferer when a forecaster is the only forecaster of the network
+	RegretRole_REGRET_ROLE_ONE_IN_FORECASTER RegretRole = 2
+	// Regret of a forecaster when it is the only forecaster of the network
+	RegretRole_REGRET_ROLE_ONE_IN_FORECASTER_SELF RegretRole = 3
+)
+
+var RegretRole_name = map[int32]string{
+	0: "REGRET_ROLE_INFERER",
+	1: "REGRET_ROLE_FORECASTER",
+	2: "REGRET_ROLE_ONE_IN_FORECASTER",
+	3: "REGRET_ROLE_ONE_IN_FORECASTER_SELF",
+}
+
+var RegretRole_value = map[string]int32{
+	"REGRET_ROLE_INFERER":                0,
+	"REGRET_ROLE_FORECASTER":             1,
+	"REGRET_ROLE_ONE_IN_FORECASTER":      2,
+	"REGRET_ROLE_ONE_IN_FORECASTER_SELF": 3,
+}
+
+func (x RegretRole) String() string {
+	return proto.EnumName(RegretRole_name, int32(x))
+}
+
+func (RegretRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f697c93e4bd2e89e, []int{0}
+}
+
 type TimestampedValue struct {
 	BlockHeight int64                                           `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
@@ -77,6 +111,9 @@ type Inference struct {
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
 	ExtraData   []byte                                          `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
 	Proof       string                                          `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Inference of a vector topic, set instead of value. Forecasts stay scalar in vector topics,
+	// they forecast the loss of an inference, which reputers compute as a scalar
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -413,6 +450,7 @@ type WorkerDataBundle struct {
 	InferenceForecastsBundle           *InferenceForecastBundle `protobuf:"bytes,2,opt,name=inference_forecasts_bundle,json=inferenceForecastsBundle,proto3" json:"inference_forecasts_bundle,omitempty"`
 	InferencesForecastsBundleSignature []byte                   `protobuf:"bytes,3,opt,name=inferences_forecasts_bundle_signature,json=inferencesForecastsBundleSignature,proto3" json:"inferences_forecasts_bundle_signature,omitempty"`
 	Pubkey                             string                   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	PubkeyType                         PubKeyType               `protobuf:"varint,5,opt,name=pubkey_type,json=pubkeyType,proto3,enum=emissions.v1.PubKeyType" json:"pubkey_type,omitempty"`
 }
 
 func (m *WorkerDataBundle) Reset()         { *m = WorkerDataBundle{} }
@@ -476,6 +514,13 @@ func (m *WorkerDataBundle) GetPubkey() string {
 	return ""
 }
 
+func (m *WorkerDataBundle) GetPubkeyType() PubKeyType {
+	if m != nil {
+		return m.PubkeyType
+	}
+	return PubKeyType_PUB_KEY_TYPE_SECP256K1
+}
+
 type WorkerDataBundles struct {
 	WorkerDataBundles []*WorkerDataBundle `protobuf:"bytes,1,rep,name=worker_data_bundles,json=workerDataBundles,proto3" json:"worker_data_bundles,omitempty"`
 }
@@ -521,6 +566,7 @@ func (m *WorkerDataBundles) GetWorkerDataBundles() []*WorkerDataBundle {
 }
 
 func init() {
+	proto.RegisterEnum("emissions.v1.RegretRole", RegretRole_name, RegretRole_value)
 	proto.RegisterType((*TimestampedValue)(nil), "emissions.v1.TimestampedValue")
 	proto.RegisterType((*Inference)(nil), "emissions.v1.Inference")
 	proto.RegisterType((*Inferences)(nil), "emissions.v1.Inferences")
@@ -535,46 +581,54 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/worker.proto", fileDescriptor_f697c93e4bd2e89e) }
 
 var fileDescriptor_f697c93e4bd2e89e = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x81, 0xe5, 0xc7, 0x3e, 0x36, 0x11, 0x46, 0x02, 0x85, 0x84, 0xb2, 0x6e, 0x42, 0xb2,
-	0x17, 0xb7, 0x01, 0x35, 0x18, 0x6f, 0x12, 0x30, 0x62, 0xa2, 0x89, 0xa3, 0xd1, 0xc4, 0x4b, 0x33,
-	0xdb, 0x9d, 0xdd, 0x9d, 0xd0, 0x76, 0x9a, 0xce, 0x2c, 0x3f, 0x6e, 0x9c, 0x3d, 0xf9, 0x27, 0xf8,
-	0xe7, 0x70, 0xe4, 0xa8, 0x1e, 0x88, 0x81, 0x8b, 0x17, 0xff, 0x07, 0xd3, 0xe9, 0xb4, 0x65, 0x8b,
-	0xab, 0x26, 0x72, 0xeb, 0x7b, 0xf3, 0xf5, 0xbd, 0xef, 0x7b, 0xdf, 0xcc, 0x83, 0x15, 0x16, 0x70,
-	0x29, 0xb9, 0x08, 0xa5, 0x73, 0xb8, 0xe9, 0x1c, 0x89, 0xf8, 0x80, 0xc5, 0xed, 0x28, 0x16, 0x4a,
-	0xe0, 0x7a, 0x7e, 0xd4, 0x3e, 0xdc, 0x5c, 0x5d, 0xec, 0x8b, 0xbe, 0xd0, 0x07, 0x4e, 0xf2, 0x95,
-	0x62, 0x9a, 0x1f, 0x11, 0xcc, 0xbf, 0xe5, 0x01, 0x93, 0x8a, 0x06, 0x11, 0xeb, 0xbe, 0xa3, 0xfe,
-	0x90, 0xe1, 0x7b, 0x50, 0xef, 0xf8, 0xc2, 0x3b, 0x70, 0x07, 0x8c, 0xf7, 0x07, 0xca, 0x42, 0x0d,
-	0xd4, 0x9a, 0x24, 0x73, 0x3a, 0xf7, 0x5c, 0xa7, 0xf0, 0x4b, 0x98, 0x3a, 0x4c, 0xb0, 0xd6, 0x44,
-	0x03, 0xb5, 0x6a, 0x3b, 0xdb, 0x67, 0x17, 0xeb, 0x95, 0x6f, 0x17, 0xeb, 0x4e, 0x9f, 0xab, 0xc1,
-	0xb0, 0xd3, 0xf6, 0x44, 0xe0, 0x50, 0xdf, 0x17, 0x31, 0xbd, 0x1f, 0x32, 0x95, 0xb0, 0xca, 0x42,
-	0x6f, 0x40, 0x79, 0xe8, 0x04, 0x54, 0x0d, 0xda, 0xbb, 0xcc, 0x23, 0x69, 0x95, 0x27, 0xd5, 0x1f,
-	0x9f, 0xd7, 0x51, 0xf3, 0x27, 0x82, 0xda, 0x7e, 0xd8, 0x63, 0x31, 0x0b, 0x3d, 0x86, 0x57, 0x60,
-	0x56, 0x89, 0x88, 0x7b, 0x2e, 0xef, 0x6a, 0x06, 0x55, 0x32, 0xa3, 0xe3, 0xfd, 0xee, 0x0d, 0x82,
-	0x13, 0x37, 0x09, 0x5a, 0x30, 0xc3, 0x75, 0xa9, 0xd8, 0x9a, 0x4c, 0x28, 0x92, 0x2c, 0x2c, 0xa8,
-	0x57, 0x6f, 0x83, 0x3a, 0x5e, 0x03, 0x60, 0xc7, 0x2a, 0xa6, 0x6e, 0x97, 0x2a, 0x6a, 0x4d, 0x35,
-	0x50, 0xab, 0x4e, 0x6a, 0x3a, 0xb3, 0x4b, 0x15, 0xc5, 0x8b, 0x30, 0x15, 0xc5, 0x42, 0xf4, 0xac,
-	0x69, 0xcd, 0x22, 0x0d, 0x8c, 0xde, 0x3d, 0x80, 0x5c, 0xae, 0xc4, 0xdb, 0x00, 0x3c, 0x8f, 0x2c,
-	0xd4, 0x98, 0x6c, 0xcd, 0x6d, 0x2d, 0xb7, 0xaf, 0x7b, 0xd8, 0xce, 0xd1, 0xe4, 0x1a, 0xb4, 0x79,
-	0x8a, 0xe0, 0xce, 0x33, 0x11, 0x33, 0x8f, 0x4a, 0xb5, 0xe7, 0xb3, 0x80, 0x85, 0x23, 0xf2, 0xd1,
-	0x18, 0xf9, 0xb7, 0xe9, 0xdc, 0x57, 0x04, 0xb3, 0x19, 0x85, 0xff, 0x34, 0xce, 0x06, 0xe8, 0x99,
-	0x4a, 0xb9, 0x77, 0xd7, 0x32, 0xf8, 0x05, 0x2c, 0x64, 0x91, 0xcb, 0x52, 0xb5, 0xd2, 0xaa, 0xea,
-	0x69, 0xad, 0x8d, 0x4e, 0xab, 0x34, 0x13, 0x32, 0xdf, 0x1b, 0x4d, 0xc8, 0xbf, 0x78, 0x67, 0xb4,
-	0x3d, 0x85, 0x5a, 0x56, 0x49, 0xe2, 0x87, 0x50, 0xcb, 0xaa, 0x64, 0x1e, 0x2d, 0xfd, 0xbe, 0x2b,
-	0x29, 0x80, 0xc9, 0x2b, 0x5b, 0xce, 0xbd, 0xcb, 0x00, 0x3b, 0xc3, 0xb0, 0xeb, 0x33, 0xfc, 0x08,
-	0x6a, 0xb9, 0x97, 0x7a, 0x5c, 0x7f, 0x70, 0xbd, 0x40, 0xe2, 0x2d, 0x98, 0xcd, 0xea, 0xeb, 0x29,
-	0x8e, 0xe7, 0x91, 0xe3, 0x8c, 0x9e, 0xd3, 0x09, 0x98, 0x7f, 0xaf, 0xf7, 0x44, 0x22, 0xd2, 0xb0,
-	0x58, 0x82, 0xe9, 0x74, 0x77, 0x98, 0xeb, 0x62, 0x22, 0xec, 0xc1, 0x6a, 0xde, 0xd3, 0xcd, 0x05,
-	0xb9, 0x1d, 0xfd, 0x97, 0x69, 0xbc, 0x31, 0x86, 0xee, 0xa8, 0x50, 0x62, 0xf1, 0xf2, 0x81, 0x34,
-	0xcd, 0x5f, 0xc3, 0x46, 0x71, 0x9d, 0x6f, 0x74, 0x71, 0x25, 0xef, 0x87, 0x54, 0x0d, 0x63, 0xa6,
-	0x6f, 0x43, 0x9d, 0x34, 0x0b, 0x70, 0xa9, 0xd2, 0x9b, 0x0c, 0x99, 0xe8, 0x89, 0x86, 0x9d, 0x03,
-	0x76, 0x92, 0xbe, 0x72, 0x62, 0x22, 0x33, 0x02, 0x0f, 0x16, 0xca, 0x13, 0x90, 0xf8, 0x15, 0xdc,
-	0x4d, 0x45, 0xeb, 0xdb, 0x60, 0x9a, 0x67, 0x26, 0xdb, 0xa3, 0x1a, 0xcb, 0x7f, 0x93, 0x85, 0xa3,
-	0x72, 0xbd, 0x1d, 0x72, 0x76, 0x69, 0xa3, 0xf3, 0x4b, 0x1b, 0x7d, 0xbf, 0xb4, 0xd1, 0xa7, 0x2b,
-	0xbb, 0x72, 0x7e, 0x65, 0x57, 0xbe, 0x5c, 0xd9, 0x95, 0x0f, 0x8f, 0xff, 0xf1, 0xad, 0x1d, 0x3b,
-	0xc5, 0x72, 0x57, 0x27, 0x11, 0x93, 0x9d, 0x69, 0xbd, 0xb5, 0x1f, 0xfc, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0x57, 0xf0, 0x7a, 0xf6, 0x05, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0xb4, 0xa5, 0xd0, 0x97, 0xe6, 0xfb, 0xca, 0x40, 0x60, 0x69, 0xc2, 0x52, 0x9a, 0xf0,
+	0xa5, 0xf9, 0x12, 0xdb, 0x80, 0x1a, 0xd4, 0x1b, 0xc8, 0x56, 0x51, 0xa4, 0x3a, 0x34, 0x9a, 0x78,
+	0xd9, 0x6c, 0xb7, 0xd3, 0x76, 0x43, 0xbb, 0xd3, 0xec, 0x4c, 0x81, 0xde, 0x3c, 0x1b, 0x0f, 0xfe,
+	0x09, 0xfe, 0x1f, 0x26, 0x9e, 0x39, 0x72, 0x54, 0x0f, 0xc4, 0xc0, 0xc5, 0x3f, 0xc3, 0xec, 0xec,
+	0x8f, 0x76, 0x8b, 0xa0, 0x09, 0xdc, 0xe6, 0x9d, 0xf7, 0x99, 0x67, 0x9e, 0xf7, 0x99, 0x77, 0xdf,
+	0x85, 0x45, 0xda, 0xb5, 0x38, 0xb7, 0x98, 0xcd, 0xcb, 0x87, 0x6b, 0xe5, 0x23, 0xe6, 0x1c, 0x50,
+	0xa7, 0xd4, 0x73, 0x98, 0x60, 0x38, 0x13, 0xa6, 0x4a, 0x87, 0x6b, 0xb9, 0xb9, 0x16, 0x6b, 0x31,
+	0x99, 0x28, 0xbb, 0x2b, 0x0f, 0x93, 0xcb, 0x45, 0x8e, 0x73, 0xab, 0x65, 0x5b, 0x76, 0xcb, 0xcb,
+	0x15, 0xde, 0x23, 0xc8, 0xd6, 0xac, 0x2e, 0xe5, 0xc2, 0xe8, 0xf6, 0x68, 0xe3, 0xb5, 0xd1, 0xe9,
+	0x53, 0xbc, 0x02, 0x99, 0x7a, 0x87, 0x99, 0x07, 0x7a, 0x9b, 0x5a, 0xad, 0xb6, 0x50, 0x50, 0x1e,
+	0x15, 0x13, 0x64, 0x5a, 0xee, 0x3d, 0x95, 0x5b, 0xf8, 0x05, 0x4c, 0x1c, 0xba, 0x58, 0x25, 0x9e,
+	0x47, 0xc5, 0xf4, 0xd6, 0xc6, 0xc9, 0xd9, 0x72, 0xec, 0xfb, 0xd9, 0x72, 0xb9, 0x65, 0x89, 0x76,
+	0xbf, 0x5e, 0x32, 0x59, 0xb7, 0x6c, 0x74, 0x3a, 0xcc, 0x31, 0xee, 0xd8, 0x54, 0xb8, 0x8a, 0x83,
+	0xd0, 0x6c, 0x1b, 0x96, 0x5d, 0xee, 0x1a, 0xa2, 0x5d, 0xda, 0xa6, 0x26, 0xf1, 0x58, 0x1e, 0x25,
+	0x7f, 0x7e, 0x5a, 0x46, 0x85, 0x2f, 0x71, 0x48, 0xef, 0xd8, 0x4d, 0xea, 0x50, 0xdb, 0xa4, 0x78,
+	0x11, 0xa6, 0x04, 0xeb, 0x59, 0xa6, 0x6e, 0x35, 0xa4, 0x82, 0x24, 0x99, 0x94, 0xf1, 0x4e, 0xe3,
+	0x92, 0xc0, 0xf8, 0x65, 0x81, 0x0a, 0x4c, 0x5a, 0x92, 0xca, 0x51, 0x12, 0xae, 0x44, 0x12, 0x84,
+	0x43, 0xe9, 0xc9, 0xdb, 0x90, 0x8e, 0x97, 0x00, 0xe8, 0xb1, 0x70, 0x0c, 0xbd, 0x61, 0x08, 0x43,
+	0x99, 0xc8, 0xa3, 0x62, 0x86, 0xa4, 0xe5, 0xce, 0xb6, 0x21, 0x0c, 0x3c, 0x07, 0x13, 0x3d, 0x87,
+	0xb1, 0xa6, 0x92, 0x92, 0x2a, 0xbc, 0x00, 0x57, 0x21, 0x25, 0x4f, 0x73, 0x65, 0x32, 0x9f, 0xb8,
+	0x89, 0x08, 0x9f, 0xc6, 0x37, 0x50, 0x03, 0x08, 0xfd, 0xe3, 0x78, 0x03, 0xc0, 0x0a, 0x23, 0x05,
+	0xe5, 0x13, 0xc5, 0xe9, 0xf5, 0x85, 0xd2, 0x68, 0xc3, 0x94, 0x42, 0x34, 0x19, 0x81, 0x16, 0xde,
+	0x21, 0xf8, 0xb7, 0xc2, 0x1c, 0x6a, 0x1a, 0x5c, 0x68, 0x1d, 0xda, 0xa5, 0x76, 0xc4, 0x4f, 0x74,
+	0x85, 0x9f, 0xb7, 0xd9, 0x0a, 0xdf, 0x10, 0x4c, 0x05, 0x12, 0x6e, 0xd8, 0x09, 0x2a, 0x40, 0xd3,
+	0x67, 0x0a, 0x9b, 0x61, 0x64, 0x07, 0x3f, 0x83, 0x99, 0x20, 0xd2, 0xa9, 0x57, 0x2d, 0x57, 0x92,
+	0xd2, 0xad, 0xa5, 0xa8, 0x5b, 0x63, 0x9e, 0x90, 0x6c, 0x33, 0xba, 0xc1, 0xff, 0xd0, 0x0c, 0x7e,
+	0x6d, 0x9b, 0x90, 0x0e, 0x98, 0x38, 0xbe, 0x07, 0xe9, 0x80, 0x25, 0x78, 0xa3, 0xf9, 0xdf, 0xdf,
+	0x4a, 0x86, 0x40, 0xf7, 0xb3, 0x5d, 0x08, 0xdf, 0x2e, 0x00, 0x6c, 0xf5, 0xed, 0x46, 0x87, 0xe2,
+	0xfb, 0x90, 0x0e, 0xdf, 0x52, 0xda, 0x75, 0xcd, 0xab, 0x0f, 0x91, 0x78, 0x1d, 0xa6, 0x02, 0x7e,
+	0xe9, 0xe2, 0xd5, 0x3a, 0x42, 0x9c, 0x5f, 0xcf, 0xe7, 0x38, 0x64, 0xdf, 0xc8, 0xa1, 0xe4, 0x16,
+	0xe9, 0xab, 0x98, 0x87, 0x94, 0x37, 0xa8, 0xfc, 0x76, 0xf1, 0x23, 0x6c, 0x42, 0x2e, 0xbc, 0x53,
+	0x0f, 0x0b, 0xd2, 0xeb, 0xf2, 0x94, 0x7f, 0xf1, 0xea, 0x15, 0x72, 0xa3, 0x85, 0x12, 0xc5, 0x1a,
+	0x4f, 0x70, 0xff, 0xf2, 0x57, 0xb0, 0x1a, 0xe6, 0xf8, 0xa5, 0x5b, 0x74, 0x77, 0x04, 0x1a, 0xa2,
+	0xef, 0x50, 0xd9, 0x0d, 0x19, 0x52, 0x18, 0x82, 0xc7, 0x98, 0xf6, 0x03, 0xa4, 0x5b, 0x4f, 0xaf,
+	0x5f, 0x3f, 0xa0, 0x03, 0x6f, 0x6c, 0x10, 0x3f, 0xc2, 0x0f, 0x61, 0xda, 0x5b, 0xe9, 0x62, 0xd0,
+	0xa3, 0xf2, 0xc9, 0xff, 0x59, 0x57, 0xa2, 0x05, 0xbc, 0xec, 0xd7, 0x9f, 0xd3, 0x41, 0x6d, 0xd0,
+	0xa3, 0x04, 0x3c, 0xb0, 0xbb, 0xf6, 0xdd, 0x33, 0x61, 0x66, 0xdc, 0x3c, 0x8e, 0xf7, 0x60, 0xd6,
+	0xf3, 0x4b, 0x36, 0x92, 0xaf, 0x3b, 0xe8, 0x0f, 0x35, 0xca, 0x3e, 0x7e, 0x9a, 0xcc, 0x1c, 0x8d,
+	0xf3, 0xfd, 0xff, 0x01, 0x01, 0x10, 0xda, 0x72, 0xa8, 0x20, 0xac, 0x43, 0xf1, 0x02, 0xcc, 0x12,
+	0xed, 0x09, 0xd1, 0x6a, 0x3a, 0xa9, 0xee, 0x6a, 0xfa, 0xce, 0x5e, 0x45, 0x23, 0x1a, 0xc9, 0xc6,
+	0x70, 0x0e, 0xe6, 0x47, 0x13, 0x95, 0x2a, 0xd1, 0x1e, 0x6f, 0xee, 0xd7, 0x34, 0x92, 0x45, 0x78,
+	0x05, 0x96, 0x46, 0x73, 0xd5, 0x3d, 0xf7, 0xe0, 0x28, 0x24, 0x8e, 0xff, 0x83, 0xc2, 0xb5, 0x10,
+	0x7d, 0x5f, 0xdb, 0xad, 0x64, 0x13, 0x5b, 0xe4, 0xe4, 0x5c, 0x45, 0xa7, 0xe7, 0x2a, 0xfa, 0x71,
+	0xae, 0xa2, 0x8f, 0x17, 0x6a, 0xec, 0xf4, 0x42, 0x8d, 0x7d, 0xbd, 0x50, 0x63, 0x6f, 0x1f, 0xfc,
+	0xe5, 0xd4, 0x38, 0x2e, 0x0f, 0x7f, 0x6a, 0xae, 0xf1, 0xbc, 0x9e, 0x92, 0x3f, 0xb4, 0xbb, 0xbf,
+	0x06, 0x00, 0xb4, 0x89, 0xdb, 0x2c, 0x2d, 0x07, 0x00, 0x00,
 }
 
 func (this *TimestampedValue) Equal(that interface{}) bool {
@@ -641,6 +695,14 @@ func (this *Inference) Equal(that interface{}) bool {
 	if this.Proof != that1.Proof {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ForecastElement) Equal(that interface{}) bool {
@@ -769,6 +831,9 @@ func (this *WorkerDataBundle) Equal(that interface{}) bool {
 	if this.Pubkey != that1.Pubkey {
 		return false
 	}
+	if this.PubkeyType != that1.PubkeyType {
+		return false
+	}
 	return true
 }
 func (m *TimestampedValue) Marshal() (dAtA []byte, err error) {
@@ -829,6 +894,20 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -1115,6 +1194,11 @@ func (m *WorkerDataBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubkeyType != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.PubkeyType))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Pubkey) > 0 {
 		i -= len(m.Pubkey)
 		copy(dAtA[i:], m.Pubkey)
@@ -1239,6 +1323,12 @@ func (m *Inference) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1355,6 +1445,9 @@ func (m *WorkerDataBundle) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if m.PubkeyType != 0 {
+		n += 1 + sovWorker(uint64(m.PubkeyType))
+	}
 	return n
 }
 
@@ -1681,6 +1774,42 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -2459,6 +2588,25 @@ func (m *WorkerDataBundle) Unmarshal(dAtA []byte) error {
 			}
 			m.Pubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyType", wireType)
+			}
+			m.PubkeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubkeyType |= PubKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
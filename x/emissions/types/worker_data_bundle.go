@@ -1,14 +1,11 @@
 package types
 
 import (
-	"encoding/hex"
-
 	"cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-func (bundle *WorkerDataBundle) Validate() error {
+func (bundle *WorkerDataBundle) Validate(opts BundleSignatureOptions) error {
 	// Validate top level then elements of the bundle
 	if bundle == nil {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker data bundle cannot be nil")
@@ -42,17 +39,43 @@ func (bundle *WorkerDataBundle) Validate() error {
 	}
 
 	// Check signature from the bundle, throw if invalid!
-	pk, err := hex.DecodeString(bundle.Pubkey)
-	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrap(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
-	pubkey := secp256k1.PubKey(pk)
+	topicId, nonce := bundle.InferenceForecastsBundle.topicAndNonce()
+	return verifyBundleSignature(
+		bundle.Pubkey,
+		bundle.PubkeyType,
+		bundle.InferencesForecastsBundleSignature,
+		bundle.InferenceForecastsBundle.payload(),
+		PayloadKind_PAYLOAD_KIND_WORKER_DATA_BUNDLE,
+		topicId,
+		nonce,
+		opts,
+	)
+}
 
-	src := make([]byte, 0)
-	src, _ = bundle.InferenceForecastsBundle.XXX_Marshal(src, true)
-	if !pubkey.VerifySignature(src, bundle.InferencesForecastsBundleSignature) {
-		return errors.Wrap(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
+// Topic and nonce the bundle is submitted for, taken from its inference or else its forecast
+func (bundle *WorkerDataBundle) TopicIdAndNonce() (TopicId, Nonce) {
+	topicId, blockHeight := bundle.InferenceForecastsBundle.topicAndNonce()
+	return topicId, Nonce{BlockHeight: blockHeight}
+}
 
-	return nil
+// Returns the bytes a worker signs to submit the bundle on the given chain
+func (bundle *InferenceForecastBundle) SignBytes(chainId string) ([]byte, error) {
+	topicId, nonce := bundle.topicAndNonce()
+	return BundleSignBytes(chainId, PayloadKind_PAYLOAD_KIND_WORKER_DATA_BUNDLE, topicId, nonce, bundle.payload())
+}
+
+func (bundle *InferenceForecastBundle) payload() []byte {
+	src, _ := bundle.XXX_Marshal(nil, true)
+	return src
+}
+
+// Topic and nonce of the bundle, taken from its inference or else its forecast
+func (bundle *InferenceForecastBundle) topicAndNonce() (uint64, int64) {
+	if bundle.Inference != nil {
+		return bundle.Inference.TopicId, bundle.Inference.BlockHeight
+	}
+	if bundle.Forecast != nil {
+		return bundle.Forecast.TopicId, bundle.Forecast.BlockHeight
+	}
+	return 0, 0
 }
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: ratelimit/v1/query.proto
+
+package ratelimitv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_AllRateLimits_FullMethodName        = "/ratelimit.v1.Query/AllRateLimits"
+	Query_RateLimit_FullMethodName            = "/ratelimit.v1.Query/RateLimit"
+	Query_RateLimitsByChannel_FullMethodName  = "/ratelimit.v1.Query/RateLimitsByChannel"
+	Query_WhitelistedAddresses_FullMethodName = "/ratelimit.v1.Query/WhitelistedAddresses"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// AllRateLimits returns every configured rate limit with its current usage.
+	AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error)
+	// RateLimit returns the rate limit of a single (denom, channel) pair.
+	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
+	// RateLimitsByChannel returns every rate limit of a channel.
+	RateLimitsByChannel(ctx context.Context, in *QueryRateLimitsByChannelRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelResponse, error)
+	// WhitelistedAddresses returns the addresses exempt from rate limiting.
+	WhitelistedAddresses(ctx context.Context, in *QueryWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryWhitelistedAddressesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error) {
+	out := new(QueryAllRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_AllRateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
+	out := new(QueryRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitsByChannel(ctx context.Context, in *QueryRateLimitsByChannelRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelResponse, error) {
+	out := new(QueryRateLimitsByChannelResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimitsByChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhitelistedAddresses(ctx context.Context, in *QueryWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryWhitelistedAddressesResponse, error) {
+	out := new(QueryWhitelistedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_WhitelistedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// AllRateLimits returns every configured rate limit with its current usage.
+	AllRateLimits(context.Context, *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error)
+	// RateLimit returns the rate limit of a single (denom, channel) pair.
+	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
+	// RateLimitsByChannel returns every rate limit of a channel.
+	RateLimitsByChannel(context.Context, *QueryRateLimitsByChannelRequest) (*QueryRateLimitsByChannelResponse, error)
+	// WhitelistedAddresses returns the addresses exempt from rate limiting.
+	WhitelistedAddresses(context.Context, *QueryWhitelistedAddressesRequest) (*QueryWhitelistedAddressesResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) AllRateLimits(context.Context, *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRateLimits not implemented")
+}
+func (UnimplementedQueryServer) RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (UnimplementedQueryServer) RateLimitsByChannel(context.Context, *QueryRateLimitsByChannelRequest) (*QueryRateLimitsByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChannel not implemented")
+}
+func (UnimplementedQueryServer) WhitelistedAddresses(context.Context, *QueryWhitelistedAddressesRequest) (*QueryWhitelistedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedAddresses not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_AllRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRateLimits(ctx, req.(*QueryAllRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsByChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitsByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimitsByChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitsByChannel(ctx, req.(*QueryRateLimitsByChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WhitelistedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedAddresses(ctx, req.(*QueryWhitelistedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ratelimit.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllRateLimits",
+			Handler:    _Query_AllRateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "RateLimitsByChannel",
+			Handler:    _Query_RateLimitsByChannel_Handler,
+		},
+		{
+			MethodName: "WhitelistedAddresses",
+			Handler:    _Query_WhitelistedAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratelimit/v1/query.proto",
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	ratelimitv1 "github.com/allora-network/allora-chain/x/ibc/ratelimit/api/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: ratelimitv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AllRateLimits",
+					Use:       "list-rate-limits",
+					Short:     "Query every rate limit and its usage in the current window",
+				},
+				{
+					RpcMethod: "RateLimit",
+					Use:       "rate-limit [denom] [channel_id]",
+					Short:     "Query the rate limit of a denom on a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "channel_id"},
+					},
+				},
+				{
+					RpcMethod: "RateLimitsByChannel",
+					Use:       "rate-limits-by-channel [channel_id]",
+					Short:     "Query every rate limit of a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel_id"},
+					},
+				},
+				{
+					RpcMethod: "WhitelistedAddresses",
+					Use:       "whitelisted-addresses",
+					Short:     "Query the addresses exempt from rate limiting",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: ratelimitv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AddRateLimit",
+					Skip:      true, // only executable through governance
+				},
+				{
+					RpcMethod: "UpdateRateLimit",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "RemoveRateLimit",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ResetRateLimit",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "AddWhitelistedAddress",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "RemoveWhitelistedAddress",
+					Skip:      true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
+)
+
+// InitGenesis initializes the ratelimit module state from genesis
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	for _, rateLimit := range data.RateLimits {
+		if err := k.SetRateLimit(ctx, rateLimit); err != nil {
+			panic(err)
+		}
+	}
+	for _, address := range data.WhitelistedAddresses {
+		if err := k.AddWhitelistedAddress(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+	for _, packet := range data.PendingSendPackets {
+		if err := k.SetPendingSendPacket(ctx, packet.Denom, packet.ChannelId, packet.Sequence); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	rateLimits, err := k.GetAllRateLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
+	whitelistedAddresses, err := k.GetWhitelistedAddresses(ctx)
+	if err != nil {
+		panic(err)
+	}
+	pendingSendPackets, err := k.GetAllPendingSendPackets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		RateLimits:           rateLimits,
+		WhitelistedAddresses: whitelistedAddresses,
+		PendingSendPackets:   pendingSendPackets,
+	}
+}
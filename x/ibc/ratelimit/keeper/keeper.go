@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	coreStore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	bankKeeper  types.BankKeeper
+	ics4Wrapper porttypes.ICS4Wrapper
+	// address allowed to change rate limits, normally the gov module account
+	authority string
+
+	schema collections.Schema
+	// map of (denom, channel) -> rate limit and its current usage
+	rateLimits collections.Map[collections.Pair[string, string], types.RateLimit]
+	// addresses whose transfers are never counted against a rate limit
+	whitelistedAddresses collections.KeySet[string]
+	// (denom, channel, sequence) of outgoing packets counted against the current window
+	pendingSendPackets collections.KeySet[collections.Triple[string, string, uint64]]
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService coreStore.KVStoreService,
+	bk types.BankKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:                  cdc,
+		bankKeeper:           bk,
+		ics4Wrapper:          ics4Wrapper,
+		authority:            authority,
+		rateLimits:           collections.NewMap(sb, types.RateLimitsKey, "rate_limits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimit](cdc)),
+		whitelistedAddresses: collections.NewKeySet(sb, types.WhitelistedAddressesKey, "whitelisted_addresses", collections.StringKey),
+		pendingSendPackets:   collections.NewKeySet(sb, types.PendingSendPacketsKey, "pending_send_packets", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+/// RATE LIMITS
+
+func (k Keeper) SetRateLimit(ctx context.Context, rateLimit types.RateLimit) error {
+	key := collections.Join(rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+	return k.rateLimits.Set(ctx, key, rateLimit)
+}
+
+// Returns the stored rate limit for a (denom, channel) pair and whether it exists
+func (k Keeper) GetRateLimit(ctx context.Context, denom string, channelId string) (types.RateLimit, bool, error) {
+	rateLimit, err := k.rateLimits.Get(ctx, collections.Join(denom, channelId))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RateLimit{}, false, nil
+		}
+		return types.RateLimit{}, false, err
+	}
+	return rateLimit, true, nil
+}
+
+func (k Keeper) RemoveRateLimit(ctx context.Context, denom string, channelId string) error {
+	return k.rateLimits.Remove(ctx, collections.Join(denom, channelId))
+}
+
+// Returns every rate limit, ordered by denom then channel
+func (k Keeper) GetAllRateLimits(ctx context.Context) ([]types.RateLimit, error) {
+	iter, err := k.rateLimits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// Opens a new window for the rate limit, clearing its flow and snapshotting
+// the current supply of the denom as the channel value.
+func (k Keeper) ResetRateLimitWindow(ctx context.Context, rateLimit types.RateLimit) (types.RateLimit, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	channelValue := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+	if channelValue.IsZero() {
+		return types.RateLimit{}, types.ErrZeroChannelValue
+	}
+	rateLimit.Flow = types.NewFlow(channelValue, sdkCtx.BlockTime().Unix(), rateLimit.Quota)
+
+	// packets sent during the previous window no longer count against this one
+	if err := k.clearPendingSendPackets(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId); err != nil {
+		return types.RateLimit{}, err
+	}
+	return rateLimit, nil
+}
+
+// Returns the rate limit with its window rolled over if it has expired.
+// The result is not persisted.
+func (k Keeper) GetCurrentRateLimit(ctx context.Context, rateLimit types.RateLimit) (types.RateLimit, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !rateLimit.Flow.IsExpired(sdkCtx.BlockTime().Unix()) {
+		return rateLimit, nil
+	}
+	return k.ResetRateLimitWindow(ctx, rateLimit)
+}
+
+/// WHITELIST
+
+func (k Keeper) AddWhitelistedAddress(ctx context.Context, address string) error {
+	return k.whitelistedAddresses.Set(ctx, address)
+}
+
+func (k Keeper) RemoveWhitelistedAddress(ctx context.Context, address string) error {
+	return k.whitelistedAddresses.Remove(ctx, address)
+}
+
+func (k Keeper) IsWhitelistedAddress(ctx context.Context, address string) (bool, error) {
+	return k.whitelistedAddresses.Has(ctx, address)
+}
+
+func (k Keeper) GetWhitelistedAddresses(ctx context.Context) ([]string, error) {
+	iter, err := k.whitelistedAddresses.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
+
+/// PENDING SEND PACKETS
+
+func (k Keeper) SetPendingSendPacket(ctx context.Context, denom string, channelId string, sequence uint64) error {
+	return k.pendingSendPackets.Set(ctx, collections.Join3(denom, channelId, sequence))
+}
+
+func (k Keeper) IsPendingSendPacket(ctx context.Context, denom string, channelId string, sequence uint64) (bool, error) {
+	return k.pendingSendPackets.Has(ctx, collections.Join3(denom, channelId, sequence))
+}
+
+func (k Keeper) RemovePendingSendPacket(ctx context.Context, denom string, channelId string, sequence uint64) error {
+	return k.pendingSendPackets.Remove(ctx, collections.Join3(denom, channelId, sequence))
+}
+
+func (k Keeper) GetAllPendingSendPackets(ctx context.Context) ([]types.PendingSendPacket, error) {
+	iter, err := k.pendingSendPackets.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	packets := make([]types.PendingSendPacket, 0, len(keys))
+	for _, key := range keys {
+		packets = append(packets, types.PendingSendPacket{Denom: key.K1(), ChannelId: key.K2(), Sequence: key.K3()})
+	}
+	return packets, nil
+}
+
+func (k Keeper) clearPendingSendPackets(ctx context.Context, denom string, channelId string) error {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, uint64](denom, channelId)
+	return k.pendingSendPackets.Clear(ctx, rng)
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+func (ms msgServer) checkAuthority(authority string) error {
+	if authority != ms.authority {
+		return errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", ms.authority, authority)
+	}
+	return nil
+}
+
+// AddRateLimit starts rate limiting a (denom, channel) pair, opening its first window.
+func (ms msgServer) AddRateLimit(ctx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	_, found, err := ms.GetRateLimit(ctx, msg.Path.Denom, msg.Path.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, errors.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s on channel %s", msg.Path.Denom, msg.Path.ChannelId)
+	}
+
+	rateLimit, err := ms.ResetRateLimitWindow(ctx, types.RateLimit{Path: msg.Path, Quota: msg.Quota})
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.SetRateLimit(ctx, rateLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit replaces the quota of a rate limit and opens a new window.
+func (ms msgServer) UpdateRateLimit(ctx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	rateLimit, found, err := ms.GetRateLimit(ctx, msg.Path.Denom, msg.Path.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Path.Denom, msg.Path.ChannelId)
+	}
+
+	rateLimit.Quota = msg.Quota
+	rateLimit, err = ms.ResetRateLimitWindow(ctx, rateLimit)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.SetRateLimit(ctx, rateLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit stops rate limiting a (denom, channel) pair.
+func (ms msgServer) RemoveRateLimit(ctx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	_, found, err := ms.GetRateLimit(ctx, msg.Path.Denom, msg.Path.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Path.Denom, msg.Path.ChannelId)
+	}
+	if err := ms.clearPendingSendPackets(ctx, msg.Path.Denom, msg.Path.ChannelId); err != nil {
+		return nil, err
+	}
+	if err := ms.Keeper.RemoveRateLimit(ctx, msg.Path.Denom, msg.Path.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit clears the flow of a rate limit and opens a new window.
+func (ms msgServer) ResetRateLimit(ctx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	rateLimit, found, err := ms.GetRateLimit(ctx, msg.Path.Denom, msg.Path.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Path.Denom, msg.Path.ChannelId)
+	}
+
+	rateLimit, err = ms.ResetRateLimitWindow(ctx, rateLimit)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.SetRateLimit(ctx, rateLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+func (ms msgServer) AddWhitelistedAddress(ctx context.Context, msg *types.MsgAddWhitelistedAddress) (*types.MsgAddWhitelistedAddressResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	isWhitelisted, err := ms.IsWhitelistedAddress(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if isWhitelisted {
+		return nil, errors.Wrap(types.ErrAddressAlreadyWhitelisted, msg.Address)
+	}
+	if err := ms.Keeper.AddWhitelistedAddress(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddWhitelistedAddressResponse{}, nil
+}
+
+func (ms msgServer) RemoveWhitelistedAddress(ctx context.Context, msg *types.MsgRemoveWhitelistedAddress) (*types.MsgRemoveWhitelistedAddressResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	isWhitelisted, err := ms.IsWhitelistedAddress(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !isWhitelisted {
+		return nil, errors.Wrap(types.ErrAddressNotWhitelisted, msg.Address)
+	}
+	if err := ms.Keeper.RemoveWhitelistedAddress(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveWhitelistedAddressResponse{}, nil
+}
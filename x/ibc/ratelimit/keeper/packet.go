@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// transferPacket is the rate limiting view of an ICS-20 packet:
+// the denom as known on this chain, the local channel and the local address.
+type transferPacket struct {
+	denom     string
+	channelId string
+	address   string
+	amount    math.Int
+}
+
+func parseTransferData(data []byte) (transfertypes.FungibleTokenPacketData, math.Int, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return packetData, math.Int{}, errors.Wrapf(types.ErrInvalidPacket, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return packetData, math.Int{}, errors.Wrapf(types.ErrInvalidPacket, "invalid amount %s", packetData.Amount)
+	}
+	return packetData, amount, nil
+}
+
+// Outgoing packets carry the full denom trace of the token on this chain,
+// e.g. "transfer/channel-0/uatom" for a voucher or "uallo" for a native token.
+func parseSendPacket(sourceChannel string, data []byte) (transferPacket, error) {
+	packetData, amount, err := parseTransferData(data)
+	if err != nil {
+		return transferPacket{}, err
+	}
+	return transferPacket{
+		denom:     transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom(),
+		channelId: sourceChannel,
+		address:   packetData.Sender,
+		amount:    amount,
+	}, nil
+}
+
+// Incoming packets carry the denom trace as known on the sender chain.
+// If the token originated here the sender's prefix is stripped, otherwise
+// this chain's prefix is added, mirroring what the transfer module mints.
+func parseRecvPacket(packet channeltypes.Packet) (transferPacket, error) {
+	packetData, amount, err := parseTransferData(packet.GetData())
+	if err != nil {
+		return transferPacket{}, err
+	}
+
+	var denomTrace string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetData.Denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denomTrace = strings.TrimPrefix(packetData.Denom, prefix)
+	} else {
+		denomTrace = transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), packetData.Denom)
+	}
+
+	return transferPacket{
+		denom:     transfertypes.ParseDenomTrace(denomTrace).IBCDenom(),
+		channelId: packet.GetDestChannel(),
+		address:   packetData.Receiver,
+		amount:    amount,
+	}, nil
+}
+
+// Adds the transfer to the flow of its rate limit, if any, rolling the
+// window over first if it has expired. Returns true if the transfer was
+// counted against a rate limit.
+func (k Keeper) checkRateLimit(ctx context.Context, direction types.Direction, transfer transferPacket) (bool, error) {
+	isWhitelisted, err := k.IsWhitelistedAddress(ctx, transfer.address)
+	if err != nil {
+		return false, err
+	}
+	if isWhitelisted {
+		return false, nil
+	}
+
+	rateLimit, found, err := k.GetRateLimit(ctx, transfer.denom, transfer.channelId)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+
+	rateLimit, err = k.GetCurrentRateLimit(ctx, rateLimit)
+	if err != nil {
+		return false, err
+	}
+
+	if err := rateLimit.AddFlow(direction, transfer.amount); err != nil {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferDenied,
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueQuotaExceeded),
+				sdk.NewAttribute(types.AttributeKeyAction, direction.String()),
+				sdk.NewAttribute(types.AttributeKeyDenom, transfer.denom),
+				sdk.NewAttribute(types.AttributeKeyChannel, transfer.channelId),
+				sdk.NewAttribute(types.AttributeKeyAmount, transfer.amount.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, rateLimit.Threshold(direction).String()),
+			),
+		)
+		return false, err
+	}
+
+	return true, k.SetRateLimit(ctx, rateLimit)
+}
+
+// Checks an incoming transfer against its rate limit, recording the inflow
+func (k Keeper) ReceiveRateLimitedPacket(ctx context.Context, packet channeltypes.Packet) error {
+	transfer, err := parseRecvPacket(packet)
+	if err != nil {
+		return err
+	}
+	_, err = k.checkRateLimit(ctx, types.DirectionRecv, transfer)
+	return err
+}
+
+// Checks an outgoing transfer against its rate limit, recording the outflow
+// and remembering the packet so the outflow can be reverted on failure.
+func (k Keeper) SendRateLimitedPacket(ctx context.Context, sourceChannel string, sequence uint64, data []byte) error {
+	transfer, err := parseSendPacket(sourceChannel, data)
+	if err != nil {
+		return err
+	}
+	counted, err := k.checkRateLimit(ctx, types.DirectionSend, transfer)
+	if err != nil {
+		return err
+	}
+	if !counted {
+		return nil
+	}
+	return k.SetPendingSendPacket(ctx, transfer.denom, transfer.channelId, sequence)
+}
+
+// Reverts the outflow of a sent packet that failed or timed out, as long as
+// it was sent during the current window of its rate limit.
+func (k Keeper) UndoSendPacket(ctx context.Context, packet channeltypes.Packet) error {
+	transfer, err := parseSendPacket(packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	isPending, err := k.IsPendingSendPacket(ctx, transfer.denom, transfer.channelId, packet.GetSequence())
+	if err != nil {
+		return err
+	}
+	if !isPending {
+		return nil
+	}
+	if err := k.RemovePendingSendPacket(ctx, transfer.denom, transfer.channelId, packet.GetSequence()); err != nil {
+		return err
+	}
+
+	rateLimit, found, err := k.GetRateLimit(ctx, transfer.denom, transfer.channelId)
+	if err != nil || !found {
+		return err
+	}
+	rateLimit.UndoOutflow(transfer.amount)
+	return k.SetRateLimit(ctx, rateLimit)
+}
+
+// Forgets a sent packet once it has been successfully acknowledged
+func (k Keeper) AcknowledgeSendPacket(ctx context.Context, packet channeltypes.Packet) error {
+	transfer, err := parseSendPacket(packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+	return k.RemovePendingSendPacket(ctx, transfer.denom, transfer.channelId, packet.GetSequence())
+}
+
+/// ICS4Wrapper
+
+// SendPacket implements the ICS4Wrapper interface. The packet is sent first so
+// that its sequence is known; if it breaches a rate limit the error reverts the
+// whole transfer.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.SendRateLimitedPacket(ctx, sourceChannel, sequence, data); err != nil {
+		k.Logger(ctx).Error("ICS20 packet send was denied", "error", err)
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Rate limits are rolled over lazily when a packet is processed, so the stored
+// flow of an idle rate limit may belong to an expired window. Queries report
+// the usage of the window that is current at the queried height.
+func (q queryServer) currentRateLimits(ctx context.Context, rateLimits []types.RateLimit) ([]types.RateLimit, error) {
+	current := make([]types.RateLimit, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		rateLimit, err := q.k.GetCurrentRateLimit(ctx, rateLimit)
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, rateLimit)
+	}
+	return current, nil
+}
+
+func (q queryServer) AllRateLimits(ctx context.Context, _ *types.QueryAllRateLimitsRequest) (*types.QueryAllRateLimitsResponse, error) {
+	rateLimits, err := q.k.GetAllRateLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rateLimits, err = q.currentRateLimits(ctx, rateLimits)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAllRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+func (q queryServer) RateLimit(ctx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	rateLimit, found, err := q.k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &types.QueryRateLimitResponse{}, nil
+	}
+	rateLimit, err = q.k.GetCurrentRateLimit(ctx, rateLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRateLimitResponse{RateLimit: &rateLimit}, nil
+}
+
+func (q queryServer) RateLimitsByChannel(ctx context.Context, req *types.QueryRateLimitsByChannelRequest) (*types.QueryRateLimitsByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	rateLimits, err := q.k.GetAllRateLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byChannel := make([]types.RateLimit, 0)
+	for _, rateLimit := range rateLimits {
+		if rateLimit.Path.ChannelId == req.ChannelId {
+			byChannel = append(byChannel, rateLimit)
+		}
+	}
+	byChannel, err = q.currentRateLimits(ctx, byChannel)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRateLimitsByChannelResponse{RateLimits: byChannel}, nil
+}
+
+func (q queryServer) WhitelistedAddresses(ctx context.Context, _ *types.QueryWhitelistedAddressesRequest) (*types.QueryWhitelistedAddressesResponse, error) {
+	addresses, err := q.k.GetWhitelistedAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryWhitelistedAddressesResponse{Addresses: addresses}, nil
+}
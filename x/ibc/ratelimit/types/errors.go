@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrUnauthorized              = errors.Register(ModuleName, 1, "unauthorized message signer")
+	ErrInvalidQuota              = errors.Register(ModuleName, 2, "invalid quota")
+	ErrInvalidPath               = errors.Register(ModuleName, 3, "invalid rate limit path")
+	ErrRateLimitAlreadyExists    = errors.Register(ModuleName, 4, "rate limit already exists")
+	ErrRateLimitNotFound         = errors.Register(ModuleName, 5, "rate limit not found")
+	ErrZeroChannelValue          = errors.Register(ModuleName, 6, "channel value is zero")
+	ErrQuotaExceeded             = errors.Register(ModuleName, 7, "quota exceeded")
+	ErrInvalidPacket             = errors.Register(ModuleName, 8, "invalid transfer packet")
+	ErrAddressAlreadyWhitelisted = errors.Register(ModuleName, 9, "address already whitelisted")
+	ErrAddressNotWhitelisted     = errors.Register(ModuleName, 10, "address not whitelisted")
+)
@@ -0,0 +1,17 @@
+package types
+
+// ratelimit module event types
+const (
+	EventTypeTransferDenied = "transfer_denied"
+
+	AttributeKeyReason    = "reason"
+	AttributeKeyAction    = "action"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyChannel   = "channel"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyThreshold = "threshold"
+
+	AttributeValueQuotaExceeded = "quota_exceeded"
+	AttributeValueSend          = "send"
+	AttributeValueRecv          = "recv"
+)
@@ -0,0 +1,13 @@
+package types // noalias
+
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the contract needed to read the supply of a denom,
+// which is used as the channel value of a rate limit.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
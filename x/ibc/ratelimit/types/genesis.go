@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState creates a default GenesisState object with no rate limits
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:           []RateLimit{},
+		WhitelistedAddresses: []string{},
+		PendingSendPackets:   []PendingSendPacket{},
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[Path]bool)
+	for _, rateLimit := range data.RateLimits {
+		if err := rateLimit.Path.Validate(); err != nil {
+			return err
+		}
+		if err := rateLimit.Quota.Validate(); err != nil {
+			return err
+		}
+		if seen[rateLimit.Path] {
+			return fmt.Errorf("duplicate rate limit for denom %s on channel %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		seen[rateLimit.Path] = true
+	}
+
+	for _, address := range data.WhitelistedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid whitelisted address %s: %w", address, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	RateLimitsKey           = collections.NewPrefix(0)
+	WhitelistedAddressesKey = collections.NewPrefix(1)
+	PendingSendPacketsKey   = collections.NewPrefix(2)
+)
+
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the default store key for ratelimit
+	StoreKey = ModuleName
+)
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgAddRateLimit) Validate() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if err := msg.Path.Validate(); err != nil {
+		return err
+	}
+	return msg.Quota.Validate()
+}
+
+func (msg *MsgUpdateRateLimit) Validate() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if err := msg.Path.Validate(); err != nil {
+		return err
+	}
+	return msg.Quota.Validate()
+}
+
+func (msg *MsgRemoveRateLimit) Validate() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Path.Validate()
+}
+
+func (msg *MsgResetRateLimit) Validate() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Path.Validate()
+}
+
+func (msg *MsgAddWhitelistedAddress) Validate() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid whitelisted address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgRemoveWhitelistedAddress) Validate() error {
+	if err := validateAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid whitelisted address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Direction of a transfer relative to this chain
+type Direction int
+
+const (
+	DirectionSend Direction = iota
+	DirectionRecv
+)
+
+func (d Direction) String() string {
+	if d == DirectionSend {
+		return AttributeValueSend
+	}
+	return AttributeValueRecv
+}
+
+func (p Path) Validate() error {
+	if p.Denom == "" {
+		return errors.Wrap(ErrInvalidPath, "denom cannot be empty")
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errors.Wrapf(ErrInvalidPath, "invalid channel id: %s", err)
+	}
+	return nil
+}
+
+func (q Quota) Validate() error {
+	hundred := math.NewInt(100)
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(hundred) {
+		return errors.Wrap(ErrInvalidQuota, "max percent send must be between 0 and 100")
+	}
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(hundred) {
+		return errors.Wrap(ErrInvalidQuota, "max percent recv must be between 0 and 100")
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return errors.Wrap(ErrInvalidQuota, "max percent send and recv cannot both be zero")
+	}
+	if q.DurationSeconds == 0 {
+		return errors.Wrap(ErrInvalidQuota, "duration cannot be zero")
+	}
+	return nil
+}
+
+// NewFlow opens a new window starting at blockTime (unix seconds)
+func NewFlow(channelValue math.Int, blockTime int64, quota Quota) Flow {
+	return Flow{
+		Inflow:       math.ZeroInt(),
+		Outflow:      math.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowEnd:    blockTime + int64(quota.DurationSeconds),
+	}
+}
+
+// IsExpired returns true once the window of the flow has closed
+func (f Flow) IsExpired(blockTime int64) bool {
+	return blockTime >= f.WindowEnd
+}
+
+// Threshold returns the maximum net flow allowed in the given direction
+// during the current window
+func (r RateLimit) Threshold(direction Direction) math.Int {
+	percent := r.Quota.MaxPercentRecv
+	if direction == DirectionSend {
+		percent = r.Quota.MaxPercentSend
+	}
+	return r.Flow.ChannelValue.Mul(percent).QuoRaw(100)
+}
+
+// AddFlow adds amount to the flow of the given direction, returning an error
+// if the net flow in that direction would go above the quota threshold.
+// Flows net against each other: tokens sent back out free up inflow capacity
+// and vice versa.
+func (r *RateLimit) AddFlow(direction Direction, amount math.Int) error {
+	inflow, outflow := r.Flow.Inflow, r.Flow.Outflow
+	var netFlow math.Int
+	if direction == DirectionSend {
+		outflow = outflow.Add(amount)
+		netFlow = outflow.Sub(inflow)
+	} else {
+		inflow = inflow.Add(amount)
+		netFlow = inflow.Sub(outflow)
+	}
+
+	threshold := r.Threshold(direction)
+	if netFlow.GT(threshold) {
+		return errors.Wrapf(
+			ErrQuotaExceeded,
+			"%s of %s %s over channel %s would exceed threshold %s",
+			direction, amount, r.Path.Denom, r.Path.ChannelId, threshold,
+		)
+	}
+
+	r.Flow.Inflow = inflow
+	r.Flow.Outflow = outflow
+	return nil
+}
+
+// UndoOutflow removes amount from the outflow, e.g. when a sent packet timed out
+func (r *RateLimit) UndoOutflow(amount math.Int) {
+	if amount.GT(r.Flow.Outflow) {
+		r.Flow.Outflow = math.ZeroInt()
+		return
+	}
+	r.Flow.Outflow = r.Flow.Outflow.Sub(amount)
+}
@@ -0,0 +1,183 @@
+package testing
+
+import (
+	"cosmossdk.io/math"
+	app2 "github.com/allora-network/allora-chain/app"
+	ratelimitkeeper "github.com/allora-network/allora-chain/x/ibc/ratelimit/keeper"
+	ratelimittypes "github.com/allora-network/allora-chain/x/ibc/ratelimit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (s *IBCTestSuite) alloraApp() *app2.AlloraApp {
+	app, _ := s.alloraChain.App.(*app2.AlloraApp)
+	return app
+}
+
+// addAlloraRateLimit adds a rate limit for uallo on the allora side of the transfer channel.
+func (s *IBCTestSuite) addAlloraRateLimit(maxPercentSend, maxPercentRecv int64) ratelimittypes.RateLimit {
+	app := s.alloraApp()
+	ctx := s.alloraChain.GetContext()
+	msgServer := ratelimitkeeper.NewMsgServerImpl(app.RateLimitKeeper)
+
+	path := ratelimittypes.Path{Denom: nativeDenom, ChannelId: s.path.EndpointA.ChannelID}
+	_, err := msgServer.AddRateLimit(ctx, &ratelimittypes.MsgAddRateLimit{
+		Authority: app.RateLimitKeeper.GetAuthority(),
+		Path:      path,
+		Quota: ratelimittypes.Quota{
+			MaxPercentSend:  math.NewInt(maxPercentSend),
+			MaxPercentRecv:  math.NewInt(maxPercentRecv),
+			DurationSeconds: 3600,
+		},
+	})
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.alloraChain)
+
+	return s.getAlloraRateLimit()
+}
+
+func (s *IBCTestSuite) getAlloraRateLimit() ratelimittypes.RateLimit {
+	rateLimit, found, err := s.alloraApp().RateLimitKeeper.GetRateLimit(
+		s.alloraChain.GetContext(),
+		nativeDenom,
+		s.path.EndpointA.ChannelID,
+	)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	return rateLimit
+}
+
+func (s *IBCTestSuite) sendAlloraTransfer(amount math.Int) error {
+	transferMsg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		sdk.NewCoin(nativeDenom, amount),
+		s.alloraAddr.String(),
+		s.providerAddr.String(),
+		clienttypes.NewHeight(1, 110),
+		0,
+		"",
+	)
+	_, err := s.alloraChain.SendMsgs(transferMsg)
+	return err
+}
+
+func (s *IBCTestSuite) TestRateLimitSendUnderQuota() {
+	rateLimit := s.addAlloraRateLimit(10, 10)
+	s.Require().True(rateLimit.Flow.ChannelValue.IsPositive())
+
+	s.IBCTransferAlloraToProvider(s.alloraAddr, s.providerAddr, nativeDenom, ibcTransferAmount, "")
+
+	rateLimit = s.getAlloraRateLimit()
+	s.Require().Equal(ibcTransferAmount, rateLimit.Flow.Outflow)
+	s.Require().True(rateLimit.Flow.Inflow.IsZero())
+
+	// the packet was acknowledged, so nothing is left pending
+	pending, err := s.alloraApp().RateLimitKeeper.GetAllPendingSendPackets(s.alloraChain.GetContext())
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *IBCTestSuite) TestRateLimitSendOverQuotaFails() {
+	rateLimit := s.addAlloraRateLimit(1, 1)
+	threshold := rateLimit.Threshold(ratelimittypes.DirectionSend)
+
+	err := s.sendAlloraTransfer(threshold.AddRaw(1))
+	s.Require().ErrorContains(err, ratelimittypes.ErrQuotaExceeded.Error())
+
+	// the failed transfer must not have been counted
+	rateLimit = s.getAlloraRateLimit()
+	s.Require().True(rateLimit.Flow.Outflow.IsZero())
+}
+
+func (s *IBCTestSuite) TestRateLimitWhitelistedAddressBypassesQuota() {
+	rateLimit := s.addAlloraRateLimit(1, 1)
+	threshold := rateLimit.Threshold(ratelimittypes.DirectionSend)
+
+	app := s.alloraApp()
+	msgServer := ratelimitkeeper.NewMsgServerImpl(app.RateLimitKeeper)
+	_, err := msgServer.AddWhitelistedAddress(s.alloraChain.GetContext(), &ratelimittypes.MsgAddWhitelistedAddress{
+		Authority: app.RateLimitKeeper.GetAuthority(),
+		Address:   s.alloraAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.alloraChain)
+
+	amount := threshold.AddRaw(1)
+	balanceBefore := s.getBalance(app.BankKeeper, s.alloraChain, s.alloraAddr, nativeDenom).Amount
+	s.IBCTransferAlloraToProvider(s.alloraAddr, s.providerAddr, nativeDenom, amount, "")
+	s.assertAlloraBalance(s.alloraAddr, nativeDenom, balanceBefore.Sub(amount))
+}
+
+func (s *IBCTestSuite) TestRateLimitRecvOverQuotaIsRejected() {
+	// move funds to the provider chain before any limit exists
+	amount := genesisWalletAmount.QuoRaw(2)
+	s.IBCTransferAlloraToProvider(s.alloraAddr, s.providerAddr, nativeDenom, amount, "")
+
+	rateLimit := s.addAlloraRateLimit(100, 1)
+	threshold := rateLimit.Threshold(ratelimittypes.DirectionRecv)
+	s.Require().True(threshold.LT(amount))
+
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, s.path.EndpointB.ChannelID, nativeDenom),
+	).IBCDenom()
+	balanceBefore := s.getBalance(s.alloraApp().BankKeeper, s.alloraChain, s.alloraAddr, nativeDenom).Amount
+
+	// the packet is acknowledged with an error, refunding the vouchers on the provider chain
+	s.IBCTransferProviderToAllora(s.providerAddr, s.alloraAddr, voucherDenom, threshold.AddRaw(1), "")
+
+	s.assertAlloraBalance(s.alloraAddr, nativeDenom, balanceBefore)
+	s.assertProviderBalance(s.providerAddr, voucherDenom, amount)
+	s.Require().True(s.getAlloraRateLimit().Flow.Inflow.IsZero())
+}
+
+// setupFeePath opens a second transfer channel with ics29 fees enabled on both ends.
+func (s *IBCTestSuite) setupFeePath() *ibctesting.Path {
+	feeTransferVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+	path := ibctesting.NewPath(s.alloraChain, s.providerChain)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	s.coordinator.Setup(path)
+
+	isFeeEnabled := s.alloraApp().IBCFeeKeeper.IsFeeEnabled(s.alloraChain.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	s.Require().True(isFeeEnabled)
+	return path
+}
+
+func (s *IBCTestSuite) TestRateLimitSendOnFeeEnabledChannelIsAcknowledged() {
+	feePath := s.setupFeePath()
+
+	app := s.alloraApp()
+	msgServer := ratelimitkeeper.NewMsgServerImpl(app.RateLimitKeeper)
+	_, err := msgServer.AddRateLimit(s.alloraChain.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Authority: app.RateLimitKeeper.GetAuthority(),
+		Path:      ratelimittypes.Path{Denom: nativeDenom, ChannelId: feePath.EndpointA.ChannelID},
+		Quota: ratelimittypes.Quota{
+			MaxPercentSend:  math.NewInt(10),
+			MaxPercentRecv:  math.NewInt(10),
+			DurationSeconds: 3600,
+		},
+	})
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.alloraChain)
+
+	s.IBCTransfer(feePath, feePath.EndpointA, s.alloraAddr, s.providerAddr, nativeDenom, ibcTransferAmount, "")
+
+	rateLimit, found, err := app.RateLimitKeeper.GetRateLimit(s.alloraChain.GetContext(), nativeDenom, feePath.EndpointA.ChannelID)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(ibcTransferAmount, rateLimit.Flow.Outflow)
+
+	// the incentivized acknowledgement reached the rate limiter, so nothing is left pending
+	pending, err := app.RateLimitKeeper.GetAllPendingSendPackets(s.alloraChain.GetContext())
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
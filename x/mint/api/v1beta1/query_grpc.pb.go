@@ -19,8 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName    = "/mint.v1beta1.Query/Params"
-	Query_Inflation_FullMethodName = "/mint.v1beta1.Query/Inflation"
+	Query_Params_FullMethodName                                   = "/mint.v1beta1.Query/Params"
+	Query_Inflation_FullMethodName                                = "/mint.v1beta1.Query/Inflation"
+	Query_VestingSchedules_FullMethodName                         = "/mint.v1beta1.Query/VestingSchedules"
+	Query_VestingSchedule_FullMethodName                          = "/mint.v1beta1.Query/VestingSchedule"
+	Query_PreviousBlockEmission_FullMethodName                    = "/mint.v1beta1.Query/PreviousBlockEmission"
+	Query_PreviousRewardEmissionPerUnitStakedToken_FullMethodName = "/mint.v1beta1.Query/PreviousRewardEmissionPerUnitStakedToken"
+	Query_EcosystemTokensMinted_FullMethodName                    = "/mint.v1beta1.Query/EcosystemTokensMinted"
+	Query_EcosystemMintSupplyRemaining_FullMethodName             = "/mint.v1beta1.Query/EcosystemMintSupplyRemaining"
+	Query_Supply_FullMethodName                                   = "/mint.v1beta1.Query/Supply"
+	Query_EmissionProjection_FullMethodName                       = "/mint.v1beta1.Query/EmissionProjection"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +39,23 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Inflation returns the current minting inflation value.
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// VestingSchedules returns all vesting schedules with their unlocked and remaining amounts.
+	VestingSchedules(ctx context.Context, in *QueryVestingSchedulesRequest, opts ...grpc.CallOption) (*QueryVestingSchedulesResponse, error)
+	// VestingSchedule returns a single vesting schedule with its unlocked and remaining amounts.
+	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
+	// PreviousBlockEmission returns the amount of tokens emitted per block at the last emission update.
+	PreviousBlockEmission(ctx context.Context, in *QueryPreviousBlockEmissionRequest, opts ...grpc.CallOption) (*QueryPreviousBlockEmissionResponse, error)
+	// PreviousRewardEmissionPerUnitStakedToken returns the smoothed monthly emission per unit staked token.
+	PreviousRewardEmissionPerUnitStakedToken(ctx context.Context, in *QueryPreviousRewardEmissionPerUnitStakedTokenRequest, opts ...grpc.CallOption) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error)
+	// EcosystemTokensMinted returns the number of tokens minted into the ecosystem treasury so far.
+	EcosystemTokensMinted(ctx context.Context, in *QueryEcosystemTokensMintedRequest, opts ...grpc.CallOption) (*QueryEcosystemTokensMintedResponse, error)
+	// EcosystemMintSupplyRemaining returns the number of tokens the ecosystem treasury may still mint.
+	EcosystemMintSupplyRemaining(ctx context.Context, in *QueryEcosystemMintSupplyRemainingRequest, opts ...grpc.CallOption) (*QueryEcosystemMintSupplyRemainingResponse, error)
+	// Supply returns the total, locked and circulating token supply.
+	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
+	// EmissionProjection replays the monthly emission calculation forward
+	// under the given assumptions.
+	EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +84,78 @@ func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest,
 	return out, nil
 }
 
+func (c *queryClient) VestingSchedules(ctx context.Context, in *QueryVestingSchedulesRequest, opts ...grpc.CallOption) (*QueryVestingSchedulesResponse, error) {
+	out := new(QueryVestingSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_VestingSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error) {
+	out := new(QueryVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_VestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousBlockEmission(ctx context.Context, in *QueryPreviousBlockEmissionRequest, opts ...grpc.CallOption) (*QueryPreviousBlockEmissionResponse, error) {
+	out := new(QueryPreviousBlockEmissionResponse)
+	err := c.cc.Invoke(ctx, Query_PreviousBlockEmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousRewardEmissionPerUnitStakedToken(ctx context.Context, in *QueryPreviousRewardEmissionPerUnitStakedTokenRequest, opts ...grpc.CallOption) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error) {
+	out := new(QueryPreviousRewardEmissionPerUnitStakedTokenResponse)
+	err := c.cc.Invoke(ctx, Query_PreviousRewardEmissionPerUnitStakedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EcosystemTokensMinted(ctx context.Context, in *QueryEcosystemTokensMintedRequest, opts ...grpc.CallOption) (*QueryEcosystemTokensMintedResponse, error) {
+	out := new(QueryEcosystemTokensMintedResponse)
+	err := c.cc.Invoke(ctx, Query_EcosystemTokensMinted_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EcosystemMintSupplyRemaining(ctx context.Context, in *QueryEcosystemMintSupplyRemainingRequest, opts ...grpc.CallOption) (*QueryEcosystemMintSupplyRemainingResponse, error) {
+	out := new(QueryEcosystemMintSupplyRemainingResponse)
+	err := c.cc.Invoke(ctx, Query_EcosystemMintSupplyRemaining_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
+	out := new(QuerySupplyResponse)
+	err := c.cc.Invoke(ctx, Query_Supply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error) {
+	out := new(QueryEmissionProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +164,23 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Inflation returns the current minting inflation value.
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// VestingSchedules returns all vesting schedules with their unlocked and remaining amounts.
+	VestingSchedules(context.Context, *QueryVestingSchedulesRequest) (*QueryVestingSchedulesResponse, error)
+	// VestingSchedule returns a single vesting schedule with its unlocked and remaining amounts.
+	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
+	// PreviousBlockEmission returns the amount of tokens emitted per block at the last emission update.
+	PreviousBlockEmission(context.Context, *QueryPreviousBlockEmissionRequest) (*QueryPreviousBlockEmissionResponse, error)
+	// PreviousRewardEmissionPerUnitStakedToken returns the smoothed monthly emission per unit staked token.
+	PreviousRewardEmissionPerUnitStakedToken(context.Context, *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error)
+	// EcosystemTokensMinted returns the number of tokens minted into the ecosystem treasury so far.
+	EcosystemTokensMinted(context.Context, *QueryEcosystemTokensMintedRequest) (*QueryEcosystemTokensMintedResponse, error)
+	// EcosystemMintSupplyRemaining returns the number of tokens the ecosystem treasury may still mint.
+	EcosystemMintSupplyRemaining(context.Context, *QueryEcosystemMintSupplyRemainingRequest) (*QueryEcosystemMintSupplyRemainingResponse, error)
+	// Supply returns the total, locked and circulating token supply.
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// EmissionProjection replays the monthly emission calculation forward
+	// under the given assumptions.
+	EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +194,30 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
 }
+func (UnimplementedQueryServer) VestingSchedules(context.Context, *QueryVestingSchedulesRequest) (*QueryVestingSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedules not implemented")
+}
+func (UnimplementedQueryServer) VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedule not implemented")
+}
+func (UnimplementedQueryServer) PreviousBlockEmission(context.Context, *QueryPreviousBlockEmissionRequest) (*QueryPreviousBlockEmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousBlockEmission not implemented")
+}
+func (UnimplementedQueryServer) PreviousRewardEmissionPerUnitStakedToken(context.Context, *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousRewardEmissionPerUnitStakedToken not implemented")
+}
+func (UnimplementedQueryServer) EcosystemTokensMinted(context.Context, *QueryEcosystemTokensMintedRequest) (*QueryEcosystemTokensMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EcosystemTokensMinted not implemented")
+}
+func (UnimplementedQueryServer) EcosystemMintSupplyRemaining(context.Context, *QueryEcosystemMintSupplyRemainingRequest) (*QueryEcosystemMintSupplyRemainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EcosystemMintSupplyRemaining not implemented")
+}
+func (UnimplementedQueryServer) Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (UnimplementedQueryServer) EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionProjection not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +267,150 @@ func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedules(ctx, req.(*QueryVestingSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedule(ctx, req.(*QueryVestingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousBlockEmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousBlockEmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousBlockEmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PreviousBlockEmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousBlockEmission(ctx, req.(*QueryPreviousBlockEmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousRewardEmissionPerUnitStakedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousRewardEmissionPerUnitStakedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousRewardEmissionPerUnitStakedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PreviousRewardEmissionPerUnitStakedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousRewardEmissionPerUnitStakedToken(ctx, req.(*QueryPreviousRewardEmissionPerUnitStakedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EcosystemTokensMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEcosystemTokensMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EcosystemTokensMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EcosystemTokensMinted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EcosystemTokensMinted(ctx, req.(*QueryEcosystemTokensMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EcosystemMintSupplyRemaining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEcosystemMintSupplyRemainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EcosystemMintSupplyRemaining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EcosystemMintSupplyRemaining_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EcosystemMintSupplyRemaining(ctx, req.(*QueryEcosystemMintSupplyRemainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Supply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionProjection(ctx, req.(*QueryEmissionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +426,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Inflation",
 			Handler:    _Query_Inflation_Handler,
 		},
+		{
+			MethodName: "VestingSchedules",
+			Handler:    _Query_VestingSchedules_Handler,
+		},
+		{
+			MethodName: "VestingSchedule",
+			Handler:    _Query_VestingSchedule_Handler,
+		},
+		{
+			MethodName: "PreviousBlockEmission",
+			Handler:    _Query_PreviousBlockEmission_Handler,
+		},
+		{
+			MethodName: "PreviousRewardEmissionPerUnitStakedToken",
+			Handler:    _Query_PreviousRewardEmissionPerUnitStakedToken_Handler,
+		},
+		{
+			MethodName: "EcosystemTokensMinted",
+			Handler:    _Query_EcosystemTokensMinted_Handler,
+		},
+		{
+			MethodName: "EcosystemMintSupplyRemaining",
+			Handler:    _Query_EcosystemMintSupplyRemaining_Handler,
+		},
+		{
+			MethodName: "Supply",
+			Handler:    _Query_Supply_Handler,
+		},
+		{
+			MethodName: "EmissionProjection",
+			Handler:    _Query_EmissionProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/v1beta1/query.proto",
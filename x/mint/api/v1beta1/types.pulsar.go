@@ -15,6 +15,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]*EmissionRecipient
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	v := new(EmissionRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := new(EmissionRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                                             protoreflect.MessageDescriptor
 	fd_Params_mint_denom                                  protoreflect.FieldDescriptor
@@ -27,6 +78,8 @@ var (
 	fd_Params_investors_percent_of_total_supply           protoreflect.FieldDescriptor
 	fd_Params_team_percent_of_total_supply                protoreflect.FieldDescriptor
 	fd_Params_maximum_monthly_percentage_yield            protoreflect.FieldDescriptor
+	fd_Params_emission_recipients                         protoreflect.FieldDescriptor
+	fd_Params_emission_curve                              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -42,6 +95,8 @@ func init() {
 	fd_Params_investors_percent_of_total_supply = md_Params.Fields().ByName("investors_percent_of_total_supply")
 	fd_Params_team_percent_of_total_supply = md_Params.Fields().ByName("team_percent_of_total_supply")
 	fd_Params_maximum_monthly_percentage_yield = md_Params.Fields().ByName("maximum_monthly_percentage_yield")
+	fd_Params_emission_recipients = md_Params.Fields().ByName("emission_recipients")
+	fd_Params_emission_curve = md_Params.Fields().ByName("emission_curve")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -169,6 +224,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.EmissionRecipients) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.EmissionRecipients})
+		if !f(fd_Params_emission_recipients, value) {
+			return
+		}
+	}
+	if x.EmissionCurve != nil {
+		value := protoreflect.ValueOfMessage(x.EmissionCurve.ProtoReflect())
+		if !f(fd_Params_emission_curve, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -204,6 +271,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TeamPercentOfTotalSupply != ""
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		return x.MaximumMonthlyPercentageYield != ""
+	case "mint.v1beta1.Params.emission_recipients":
+		return len(x.EmissionRecipients) != 0
+	case "mint.v1beta1.Params.emission_curve":
+		return x.EmissionCurve != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -240,6 +311,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.TeamPercentOfTotalSupply = ""
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		x.MaximumMonthlyPercentageYield = ""
+	case "mint.v1beta1.Params.emission_recipients":
+		x.EmissionRecipients = nil
+	case "mint.v1beta1.Params.emission_curve":
+		x.EmissionCurve = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -286,6 +361,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		value := x.MaximumMonthlyPercentageYield
 		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.emission_recipients":
+		if len(x.EmissionRecipients) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.EmissionRecipients}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.Params.emission_curve":
+		value := x.EmissionCurve
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -326,6 +410,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.TeamPercentOfTotalSupply = value.Interface().(string)
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		x.MaximumMonthlyPercentageYield = value.Interface().(string)
+	case "mint.v1beta1.Params.emission_recipients":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.EmissionRecipients = *clv.list
+	case "mint.v1beta1.Params.emission_curve":
+		x.EmissionCurve = value.Message().Interface().(*EmissionCurve)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -346,6 +436,17 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mint.v1beta1.Params.emission_recipients":
+		if x.EmissionRecipients == nil {
+			x.EmissionRecipients = []*EmissionRecipient{}
+		}
+		value := &_Params_11_list{list: &x.EmissionRecipients}
+		return protoreflect.ValueOfList(value)
+	case "mint.v1beta1.Params.emission_curve":
+		if x.EmissionCurve == nil {
+			x.EmissionCurve = new(EmissionCurve)
+		}
+		return protoreflect.ValueOfMessage(x.EmissionCurve.ProtoReflect())
 	case "mint.v1beta1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message mint.v1beta1.Params is not mutable"))
 	case "mint.v1beta1.Params.max_supply":
@@ -399,6 +500,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.emission_recipients":
+		list := []*EmissionRecipient{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "mint.v1beta1.Params.emission_curve":
+		m := new(EmissionCurve)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -508,6 +615,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.EmissionRecipients) > 0 {
+			for _, e := range x.EmissionRecipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EmissionCurve != nil {
+			l = options.Size(x.EmissionCurve)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -537,6 +654,36 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EmissionCurve != nil {
+			encoded, err := options.Marshal(x.EmissionCurve)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.EmissionRecipients) > 0 {
+			for iNdEx := len(x.EmissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EmissionRecipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if len(x.MaximumMonthlyPercentageYield) > 0 {
 			i -= len(x.MaximumMonthlyPercentageYield)
 			copy(dAtA[i:], x.MaximumMonthlyPercentageYield)
@@ -976,6 +1123,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MaximumMonthlyPercentageYield = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmissionRecipients = append(x.EmissionRecipients, &EmissionRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmissionRecipients[len(x.EmissionRecipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EmissionCurve == nil {
+					x.EmissionCurve = &EmissionCurve{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmissionCurve); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1011,137 +1228,3137 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: mint/v1beta1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EmissionRecipient                protoreflect.MessageDescriptor
+	fd_EmissionRecipient_recipient_type protoreflect.FieldDescriptor
+	fd_EmissionRecipient_address        protoreflect.FieldDescriptor
+	fd_EmissionRecipient_weight         protoreflect.FieldDescriptor
 )
 
-// Params defines the parameters for the x/mint module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_EmissionRecipient = File_mint_v1beta1_types_proto.Messages().ByName("EmissionRecipient")
+	fd_EmissionRecipient_recipient_type = md_EmissionRecipient.Fields().ByName("recipient_type")
+	fd_EmissionRecipient_address = md_EmissionRecipient.Fields().ByName("address")
+	fd_EmissionRecipient_weight = md_EmissionRecipient.Fields().ByName("weight")
+}
 
-	// type of coin to mint
-	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	// maximum total supply of the coin
-	MaxSupply string `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
-	// ecosystem treasury fraction ideally emitted per unit time
-	FEmission string `protobuf:"bytes,3,opt,name=f_emission,json=fEmission,proto3" json:"f_emission,omitempty"`
-	// one month exponential moving average smoothing factor, alpha_e in the paper
-	OneMonthSmoothingDegree string `protobuf:"bytes,4,opt,name=one_month_smoothing_degree,json=oneMonthSmoothingDegree,proto3" json:"one_month_smoothing_degree,omitempty"`
-	// percentage of the total supply is reserved and locked in the ecosystem treasury
-	EcosystemTreasuryPercentOfTotalSupply string `protobuf:"bytes,5,opt,name=ecosystem_treasury_percent_of_total_supply,json=ecosystemTreasuryPercentOfTotalSupply,proto3" json:"ecosystem_treasury_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is unlocked and usable in the foundation treasury
-	FoundationTreasuryPercentOfTotalSupply string `protobuf:"bytes,6,opt,name=foundation_treasury_percent_of_total_supply,json=foundationTreasuryPercentOfTotalSupply,proto3" json:"foundation_treasury_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is unlocked and usable by partipicants at the genesis
-	ParticipantsPercentOfTotalSupply string `protobuf:"bytes,7,opt,name=participants_percent_of_total_supply,json=participantsPercentOfTotalSupply,proto3" json:"participants_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is locked in the investors bucket at the genesis
-	InvestorsPercentOfTotalSupply string `protobuf:"bytes,8,opt,name=investors_percent_of_total_supply,json=investorsPercentOfTotalSupply,proto3" json:"investors_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is locked in the team bucket at the genesis
-	TeamPercentOfTotalSupply string `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3" json:"team_percent_of_total_supply,omitempty"`
-	// The capped max monthly percentage yield (like %APY)
-	MaximumMonthlyPercentageYield string `protobuf:"bytes,10,opt,name=maximum_monthly_percentage_yield,json=maximumMonthlyPercentageYield,proto3" json:"maximum_monthly_percentage_yield,omitempty"`
+var _ protoreflect.Message = (*fastReflection_EmissionRecipient)(nil)
+
+type fastReflection_EmissionRecipient EmissionRecipient
+
+func (x *EmissionRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionRecipient)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mint_v1beta1_types_proto_msgTypes[0]
+func (x *EmissionRecipient) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_EmissionRecipient_messageType fastReflection_EmissionRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionRecipient_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_EmissionRecipient_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_EmissionRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionRecipient)(nil)
 }
-
-func (x *Params) GetMintDenom() string {
-	if x != nil {
-		return x.MintDenom
-	}
-	return ""
+func (x fastReflection_EmissionRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionRecipient)
+}
+func (x fastReflection_EmissionRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionRecipient
 }
 
-func (x *Params) GetMaxSupply() string {
-	if x != nil {
-		return x.MaxSupply
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionRecipient
 }
 
-func (x *Params) GetFEmission() string {
-	if x != nil {
-		return x.FEmission
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionRecipient_messageType
 }
 
-func (x *Params) GetOneMonthSmoothingDegree() string {
-	if x != nil {
-		return x.OneMonthSmoothingDegree
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionRecipient) New() protoreflect.Message {
+	return new(fastReflection_EmissionRecipient)
 }
 
-func (x *Params) GetEcosystemTreasuryPercentOfTotalSupply() string {
-	if x != nil {
-		return x.EcosystemTreasuryPercentOfTotalSupply
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionRecipient) Interface() protoreflect.ProtoMessage {
+	return (*EmissionRecipient)(x)
 }
 
-func (x *Params) GetFoundationTreasuryPercentOfTotalSupply() string {
-	if x != nil {
-		return x.FoundationTreasuryPercentOfTotalSupply
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecipientType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RecipientType))
+		if !f(fd_EmissionRecipient_recipient_type, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EmissionRecipient_address, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_EmissionRecipient_weight, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *Params) GetParticipantsPercentOfTotalSupply() string {
-	if x != nil {
-		return x.ParticipantsPercentOfTotalSupply
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionRecipient) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionRecipient.recipient_type":
+		return x.RecipientType != 0
+	case "mint.v1beta1.EmissionRecipient.address":
+		return x.Address != ""
+	case "mint.v1beta1.EmissionRecipient.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionRecipient"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionRecipient does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *Params) GetInvestorsPercentOfTotalSupply() string {
-	if x != nil {
-		return x.InvestorsPercentOfTotalSupply
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionRecipient) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionRecipient.recipient_type":
+		x.RecipientType = 0
+	case "mint.v1beta1.EmissionRecipient.address":
+		x.Address = ""
+	case "mint.v1beta1.EmissionRecipient.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionRecipient"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionRecipient does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *Params) GetTeamPercentOfTotalSupply() string {
-	if x != nil {
-		return x.TeamPercentOfTotalSupply
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.EmissionRecipient.recipient_type":
+		value := x.RecipientType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mint.v1beta1.EmissionRecipient.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionRecipient.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionRecipient"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionRecipient does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-func (x *Params) GetMaximumMonthlyPercentageYield() string {
-	if x != nil {
-		return x.MaximumMonthlyPercentageYield
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionRecipient.recipient_type":
+		x.RecipientType = (EmissionRecipientType)(value.Enum())
+	case "mint.v1beta1.EmissionRecipient.address":
+		x.Address = value.Interface().(string)
+	case "mint.v1beta1.EmissionRecipient.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionRecipient"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionRecipient.recipient_type":
+		panic(fmt.Errorf("field recipient_type of message mint.v1beta1.EmissionRecipient is not mutable"))
+	case "mint.v1beta1.EmissionRecipient.address":
+		panic(fmt.Errorf("field address of message mint.v1beta1.EmissionRecipient is not mutable"))
+	case "mint.v1beta1.EmissionRecipient.weight":
+		panic(fmt.Errorf("field weight of message mint.v1beta1.EmissionRecipient is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionRecipient"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionRecipient.recipient_type":
+		return protoreflect.ValueOfEnum(0)
+	case "mint.v1beta1.EmissionRecipient.address":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionRecipient.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionRecipient"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.EmissionRecipient", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionRecipient) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionRecipient) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionRecipient) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionRecipient) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionRecipient)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RecipientType != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecipientType))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionRecipient)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.RecipientType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecipientType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionRecipient)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionRecipient: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+				}
+				x.RecipientType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecipientType |= EmissionRecipientType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmissionCurvePoint                protoreflect.MessageDescriptor
+	fd_EmissionCurvePoint_month          protoreflect.FieldDescriptor
+	fd_EmissionCurvePoint_block_emission protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_EmissionCurvePoint = File_mint_v1beta1_types_proto.Messages().ByName("EmissionCurvePoint")
+	fd_EmissionCurvePoint_month = md_EmissionCurvePoint.Fields().ByName("month")
+	fd_EmissionCurvePoint_block_emission = md_EmissionCurvePoint.Fields().ByName("block_emission")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmissionCurvePoint)(nil)
+
+type fastReflection_EmissionCurvePoint EmissionCurvePoint
+
+func (x *EmissionCurvePoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionCurvePoint)(x)
+}
+
+func (x *EmissionCurvePoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmissionCurvePoint_messageType fastReflection_EmissionCurvePoint_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionCurvePoint_messageType{}
+
+type fastReflection_EmissionCurvePoint_messageType struct{}
+
+func (x fastReflection_EmissionCurvePoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionCurvePoint)(nil)
+}
+func (x fastReflection_EmissionCurvePoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionCurvePoint)
+}
+func (x fastReflection_EmissionCurvePoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionCurvePoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionCurvePoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionCurvePoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionCurvePoint) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionCurvePoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionCurvePoint) New() protoreflect.Message {
+	return new(fastReflection_EmissionCurvePoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionCurvePoint) Interface() protoreflect.ProtoMessage {
+	return (*EmissionCurvePoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionCurvePoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Month != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Month)
+		if !f(fd_EmissionCurvePoint_month, value) {
+			return
+		}
+	}
+	if x.BlockEmission != "" {
+		value := protoreflect.ValueOfString(x.BlockEmission)
+		if !f(fd_EmissionCurvePoint_block_emission, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionCurvePoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurvePoint.month":
+		return x.Month != uint64(0)
+	case "mint.v1beta1.EmissionCurvePoint.block_emission":
+		return x.BlockEmission != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurvePoint"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurvePoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurvePoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurvePoint.month":
+		x.Month = uint64(0)
+	case "mint.v1beta1.EmissionCurvePoint.block_emission":
+		x.BlockEmission = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurvePoint"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurvePoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionCurvePoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.EmissionCurvePoint.month":
+		value := x.Month
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.EmissionCurvePoint.block_emission":
+		value := x.BlockEmission
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurvePoint"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurvePoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurvePoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurvePoint.month":
+		x.Month = value.Uint()
+	case "mint.v1beta1.EmissionCurvePoint.block_emission":
+		x.BlockEmission = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurvePoint"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurvePoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurvePoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurvePoint.month":
+		panic(fmt.Errorf("field month of message mint.v1beta1.EmissionCurvePoint is not mutable"))
+	case "mint.v1beta1.EmissionCurvePoint.block_emission":
+		panic(fmt.Errorf("field block_emission of message mint.v1beta1.EmissionCurvePoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurvePoint"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurvePoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionCurvePoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurvePoint.month":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.EmissionCurvePoint.block_emission":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurvePoint"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurvePoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionCurvePoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.EmissionCurvePoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionCurvePoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurvePoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionCurvePoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionCurvePoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionCurvePoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Month != 0 {
+			n += 1 + runtime.Sov(uint64(x.Month))
+		}
+		l = len(x.BlockEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionCurvePoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BlockEmission) > 0 {
+			i -= len(x.BlockEmission)
+			copy(dAtA[i:], x.BlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockEmission)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Month != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Month))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionCurvePoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionCurvePoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionCurvePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+				}
+				x.Month = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Month |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EmissionCurve_5_list)(nil)
+
+type _EmissionCurve_5_list struct {
+	list *[]*EmissionCurvePoint
+}
+
+func (x *_EmissionCurve_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EmissionCurve_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EmissionCurve_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionCurvePoint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EmissionCurve_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionCurvePoint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EmissionCurve_5_list) AppendMutable() protoreflect.Value {
+	v := new(EmissionCurvePoint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EmissionCurve_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EmissionCurve_5_list) NewElement() protoreflect.Value {
+	v := new(EmissionCurvePoint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EmissionCurve_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EmissionCurve                                protoreflect.MessageDescriptor
+	fd_EmissionCurve_curve_type                     protoreflect.FieldDescriptor
+	fd_EmissionCurve_halving_initial_block_emission protoreflect.FieldDescriptor
+	fd_EmissionCurve_halving_interval_months        protoreflect.FieldDescriptor
+	fd_EmissionCurve_constant_block_emission        protoreflect.FieldDescriptor
+	fd_EmissionCurve_points                         protoreflect.FieldDescriptor
+	fd_EmissionCurve_max_block_emission_change      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_EmissionCurve = File_mint_v1beta1_types_proto.Messages().ByName("EmissionCurve")
+	fd_EmissionCurve_curve_type = md_EmissionCurve.Fields().ByName("curve_type")
+	fd_EmissionCurve_halving_initial_block_emission = md_EmissionCurve.Fields().ByName("halving_initial_block_emission")
+	fd_EmissionCurve_halving_interval_months = md_EmissionCurve.Fields().ByName("halving_interval_months")
+	fd_EmissionCurve_constant_block_emission = md_EmissionCurve.Fields().ByName("constant_block_emission")
+	fd_EmissionCurve_points = md_EmissionCurve.Fields().ByName("points")
+	fd_EmissionCurve_max_block_emission_change = md_EmissionCurve.Fields().ByName("max_block_emission_change")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmissionCurve)(nil)
+
+type fastReflection_EmissionCurve EmissionCurve
+
+func (x *EmissionCurve) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionCurve)(x)
+}
+
+func (x *EmissionCurve) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmissionCurve_messageType fastReflection_EmissionCurve_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionCurve_messageType{}
+
+type fastReflection_EmissionCurve_messageType struct{}
+
+func (x fastReflection_EmissionCurve_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionCurve)(nil)
+}
+func (x fastReflection_EmissionCurve_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionCurve)
+}
+func (x fastReflection_EmissionCurve_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionCurve
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionCurve) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionCurve
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionCurve) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionCurve_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionCurve) New() protoreflect.Message {
+	return new(fastReflection_EmissionCurve)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionCurve) Interface() protoreflect.ProtoMessage {
+	return (*EmissionCurve)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionCurve) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurveType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CurveType))
+		if !f(fd_EmissionCurve_curve_type, value) {
+			return
+		}
+	}
+	if x.HalvingInitialBlockEmission != "" {
+		value := protoreflect.ValueOfString(x.HalvingInitialBlockEmission)
+		if !f(fd_EmissionCurve_halving_initial_block_emission, value) {
+			return
+		}
+	}
+	if x.HalvingIntervalMonths != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HalvingIntervalMonths)
+		if !f(fd_EmissionCurve_halving_interval_months, value) {
+			return
+		}
+	}
+	if x.ConstantBlockEmission != "" {
+		value := protoreflect.ValueOfString(x.ConstantBlockEmission)
+		if !f(fd_EmissionCurve_constant_block_emission, value) {
+			return
+		}
+	}
+	if len(x.Points) != 0 {
+		value := protoreflect.ValueOfList(&_EmissionCurve_5_list{list: &x.Points})
+		if !f(fd_EmissionCurve_points, value) {
+			return
+		}
+	}
+	if x.MaxBlockEmissionChange != "" {
+		value := protoreflect.ValueOfString(x.MaxBlockEmissionChange)
+		if !f(fd_EmissionCurve_max_block_emission_change, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionCurve) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurve.curve_type":
+		return x.CurveType != 0
+	case "mint.v1beta1.EmissionCurve.halving_initial_block_emission":
+		return x.HalvingInitialBlockEmission != ""
+	case "mint.v1beta1.EmissionCurve.halving_interval_months":
+		return x.HalvingIntervalMonths != uint64(0)
+	case "mint.v1beta1.EmissionCurve.constant_block_emission":
+		return x.ConstantBlockEmission != ""
+	case "mint.v1beta1.EmissionCurve.points":
+		return len(x.Points) != 0
+	case "mint.v1beta1.EmissionCurve.max_block_emission_change":
+		return x.MaxBlockEmissionChange != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurve"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurve does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurve) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurve.curve_type":
+		x.CurveType = 0
+	case "mint.v1beta1.EmissionCurve.halving_initial_block_emission":
+		x.HalvingInitialBlockEmission = ""
+	case "mint.v1beta1.EmissionCurve.halving_interval_months":
+		x.HalvingIntervalMonths = uint64(0)
+	case "mint.v1beta1.EmissionCurve.constant_block_emission":
+		x.ConstantBlockEmission = ""
+	case "mint.v1beta1.EmissionCurve.points":
+		x.Points = nil
+	case "mint.v1beta1.EmissionCurve.max_block_emission_change":
+		x.MaxBlockEmissionChange = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurve"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurve does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionCurve) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.EmissionCurve.curve_type":
+		value := x.CurveType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mint.v1beta1.EmissionCurve.halving_initial_block_emission":
+		value := x.HalvingInitialBlockEmission
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionCurve.halving_interval_months":
+		value := x.HalvingIntervalMonths
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.EmissionCurve.constant_block_emission":
+		value := x.ConstantBlockEmission
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionCurve.points":
+		if len(x.Points) == 0 {
+			return protoreflect.ValueOfList(&_EmissionCurve_5_list{})
+		}
+		listValue := &_EmissionCurve_5_list{list: &x.Points}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.EmissionCurve.max_block_emission_change":
+		value := x.MaxBlockEmissionChange
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurve"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurve does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurve) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurve.curve_type":
+		x.CurveType = (EmissionCurveType)(value.Enum())
+	case "mint.v1beta1.EmissionCurve.halving_initial_block_emission":
+		x.HalvingInitialBlockEmission = value.Interface().(string)
+	case "mint.v1beta1.EmissionCurve.halving_interval_months":
+		x.HalvingIntervalMonths = value.Uint()
+	case "mint.v1beta1.EmissionCurve.constant_block_emission":
+		x.ConstantBlockEmission = value.Interface().(string)
+	case "mint.v1beta1.EmissionCurve.points":
+		lv := value.List()
+		clv := lv.(*_EmissionCurve_5_list)
+		x.Points = *clv.list
+	case "mint.v1beta1.EmissionCurve.max_block_emission_change":
+		x.MaxBlockEmissionChange = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurve"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurve does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurve) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurve.points":
+		if x.Points == nil {
+			x.Points = []*EmissionCurvePoint{}
+		}
+		value := &_EmissionCurve_5_list{list: &x.Points}
+		return protoreflect.ValueOfList(value)
+	case "mint.v1beta1.EmissionCurve.curve_type":
+		panic(fmt.Errorf("field curve_type of message mint.v1beta1.EmissionCurve is not mutable"))
+	case "mint.v1beta1.EmissionCurve.halving_initial_block_emission":
+		panic(fmt.Errorf("field halving_initial_block_emission of message mint.v1beta1.EmissionCurve is not mutable"))
+	case "mint.v1beta1.EmissionCurve.halving_interval_months":
+		panic(fmt.Errorf("field halving_interval_months of message mint.v1beta1.EmissionCurve is not mutable"))
+	case "mint.v1beta1.EmissionCurve.constant_block_emission":
+		panic(fmt.Errorf("field constant_block_emission of message mint.v1beta1.EmissionCurve is not mutable"))
+	case "mint.v1beta1.EmissionCurve.max_block_emission_change":
+		panic(fmt.Errorf("field max_block_emission_change of message mint.v1beta1.EmissionCurve is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurve"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurve does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionCurve) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionCurve.curve_type":
+		return protoreflect.ValueOfEnum(0)
+	case "mint.v1beta1.EmissionCurve.halving_initial_block_emission":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionCurve.halving_interval_months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.EmissionCurve.constant_block_emission":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionCurve.points":
+		list := []*EmissionCurvePoint{}
+		return protoreflect.ValueOfList(&_EmissionCurve_5_list{list: &list})
+	case "mint.v1beta1.EmissionCurve.max_block_emission_change":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionCurve"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionCurve does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionCurve) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.EmissionCurve", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionCurve) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionCurve) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionCurve) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionCurve) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionCurve)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurveType != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurveType))
+		}
+		l = len(x.HalvingInitialBlockEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HalvingIntervalMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.HalvingIntervalMonths))
+		}
+		l = len(x.ConstantBlockEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Points) > 0 {
+			for _, e := range x.Points {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxBlockEmissionChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionCurve)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxBlockEmissionChange) > 0 {
+			i -= len(x.MaxBlockEmissionChange)
+			copy(dAtA[i:], x.MaxBlockEmissionChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBlockEmissionChange)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Points) > 0 {
+			for iNdEx := len(x.Points) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Points[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ConstantBlockEmission) > 0 {
+			i -= len(x.ConstantBlockEmission)
+			copy(dAtA[i:], x.ConstantBlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantBlockEmission)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.HalvingIntervalMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HalvingIntervalMonths))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.HalvingInitialBlockEmission) > 0 {
+			i -= len(x.HalvingInitialBlockEmission)
+			copy(dAtA[i:], x.HalvingInitialBlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HalvingInitialBlockEmission)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CurveType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurveType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionCurve)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionCurve: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+				}
+				x.CurveType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurveType |= EmissionCurveType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingInitialBlockEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HalvingInitialBlockEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingIntervalMonths", wireType)
+				}
+				x.HalvingIntervalMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HalvingIntervalMonths |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantBlockEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConstantBlockEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Points = append(x.Points, &EmissionCurvePoint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Points[len(x.Points)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockEmissionChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBlockEmissionChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VestingSchedule                 protoreflect.MessageDescriptor
+	fd_VestingSchedule_id              protoreflect.FieldDescriptor
+	fd_VestingSchedule_beneficiary     protoreflect.FieldDescriptor
+	fd_VestingSchedule_total_amount    protoreflect.FieldDescriptor
+	fd_VestingSchedule_released_amount protoreflect.FieldDescriptor
+	fd_VestingSchedule_start_height    protoreflect.FieldDescriptor
+	fd_VestingSchedule_cliff_blocks    protoreflect.FieldDescriptor
+	fd_VestingSchedule_period_blocks   protoreflect.FieldDescriptor
+	fd_VestingSchedule_duration_blocks protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_VestingSchedule = File_mint_v1beta1_types_proto.Messages().ByName("VestingSchedule")
+	fd_VestingSchedule_id = md_VestingSchedule.Fields().ByName("id")
+	fd_VestingSchedule_beneficiary = md_VestingSchedule.Fields().ByName("beneficiary")
+	fd_VestingSchedule_total_amount = md_VestingSchedule.Fields().ByName("total_amount")
+	fd_VestingSchedule_released_amount = md_VestingSchedule.Fields().ByName("released_amount")
+	fd_VestingSchedule_start_height = md_VestingSchedule.Fields().ByName("start_height")
+	fd_VestingSchedule_cliff_blocks = md_VestingSchedule.Fields().ByName("cliff_blocks")
+	fd_VestingSchedule_period_blocks = md_VestingSchedule.Fields().ByName("period_blocks")
+	fd_VestingSchedule_duration_blocks = md_VestingSchedule.Fields().ByName("duration_blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingSchedule)(nil)
+
+type fastReflection_VestingSchedule VestingSchedule
+
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(x)
+}
+
+func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingSchedule_messageType fastReflection_VestingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_VestingSchedule_messageType{}
+
+type fastReflection_VestingSchedule_messageType struct{}
+
+func (x fastReflection_VestingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(nil)
+}
+func (x fastReflection_VestingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
+}
+func (x fastReflection_VestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_VestingSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingSchedule) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*VestingSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_VestingSchedule_id, value) {
+			return
+		}
+	}
+	if x.Beneficiary != "" {
+		value := protoreflect.ValueOfString(x.Beneficiary)
+		if !f(fd_VestingSchedule_beneficiary, value) {
+			return
+		}
+	}
+	if x.TotalAmount != "" {
+		value := protoreflect.ValueOfString(x.TotalAmount)
+		if !f(fd_VestingSchedule_total_amount, value) {
+			return
+		}
+	}
+	if x.ReleasedAmount != "" {
+		value := protoreflect.ValueOfString(x.ReleasedAmount)
+		if !f(fd_VestingSchedule_released_amount, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_VestingSchedule_start_height, value) {
+			return
+		}
+	}
+	if x.CliffBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CliffBlocks)
+		if !f(fd_VestingSchedule_cliff_blocks, value) {
+			return
+		}
+	}
+	if x.PeriodBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PeriodBlocks)
+		if !f(fd_VestingSchedule_period_blocks, value) {
+			return
+		}
+	}
+	if x.DurationBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DurationBlocks)
+		if !f(fd_VestingSchedule_duration_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingSchedule.id":
+		return x.Id != uint64(0)
+	case "mint.v1beta1.VestingSchedule.beneficiary":
+		return x.Beneficiary != ""
+	case "mint.v1beta1.VestingSchedule.total_amount":
+		return x.TotalAmount != ""
+	case "mint.v1beta1.VestingSchedule.released_amount":
+		return x.ReleasedAmount != ""
+	case "mint.v1beta1.VestingSchedule.start_height":
+		return x.StartHeight != int64(0)
+	case "mint.v1beta1.VestingSchedule.cliff_blocks":
+		return x.CliffBlocks != uint64(0)
+	case "mint.v1beta1.VestingSchedule.period_blocks":
+		return x.PeriodBlocks != uint64(0)
+	case "mint.v1beta1.VestingSchedule.duration_blocks":
+		return x.DurationBlocks != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingSchedule.id":
+		x.Id = uint64(0)
+	case "mint.v1beta1.VestingSchedule.beneficiary":
+		x.Beneficiary = ""
+	case "mint.v1beta1.VestingSchedule.total_amount":
+		x.TotalAmount = ""
+	case "mint.v1beta1.VestingSchedule.released_amount":
+		x.ReleasedAmount = ""
+	case "mint.v1beta1.VestingSchedule.start_height":
+		x.StartHeight = int64(0)
+	case "mint.v1beta1.VestingSchedule.cliff_blocks":
+		x.CliffBlocks = uint64(0)
+	case "mint.v1beta1.VestingSchedule.period_blocks":
+		x.PeriodBlocks = uint64(0)
+	case "mint.v1beta1.VestingSchedule.duration_blocks":
+		x.DurationBlocks = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.VestingSchedule.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.VestingSchedule.beneficiary":
+		value := x.Beneficiary
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingSchedule.total_amount":
+		value := x.TotalAmount
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingSchedule.released_amount":
+		value := x.ReleasedAmount
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingSchedule.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.VestingSchedule.cliff_blocks":
+		value := x.CliffBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.VestingSchedule.period_blocks":
+		value := x.PeriodBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.VestingSchedule.duration_blocks":
+		value := x.DurationBlocks
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingSchedule.id":
+		x.Id = value.Uint()
+	case "mint.v1beta1.VestingSchedule.beneficiary":
+		x.Beneficiary = value.Interface().(string)
+	case "mint.v1beta1.VestingSchedule.total_amount":
+		x.TotalAmount = value.Interface().(string)
+	case "mint.v1beta1.VestingSchedule.released_amount":
+		x.ReleasedAmount = value.Interface().(string)
+	case "mint.v1beta1.VestingSchedule.start_height":
+		x.StartHeight = value.Int()
+	case "mint.v1beta1.VestingSchedule.cliff_blocks":
+		x.CliffBlocks = value.Uint()
+	case "mint.v1beta1.VestingSchedule.period_blocks":
+		x.PeriodBlocks = value.Uint()
+	case "mint.v1beta1.VestingSchedule.duration_blocks":
+		x.DurationBlocks = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingSchedule.id":
+		panic(fmt.Errorf("field id of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.total_amount":
+		panic(fmt.Errorf("field total_amount of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.released_amount":
+		panic(fmt.Errorf("field released_amount of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.start_height":
+		panic(fmt.Errorf("field start_height of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.cliff_blocks":
+		panic(fmt.Errorf("field cliff_blocks of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.period_blocks":
+		panic(fmt.Errorf("field period_blocks of message mint.v1beta1.VestingSchedule is not mutable"))
+	case "mint.v1beta1.VestingSchedule.duration_blocks":
+		panic(fmt.Errorf("field duration_blocks of message mint.v1beta1.VestingSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingSchedule.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.VestingSchedule.beneficiary":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingSchedule.total_amount":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingSchedule.released_amount":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingSchedule.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.VestingSchedule.cliff_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.VestingSchedule.period_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.VestingSchedule.duration_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.VestingSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Beneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReleasedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.CliffBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.CliffBlocks))
+		}
+		if x.PeriodBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.PeriodBlocks))
+		}
+		if x.DurationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.DurationBlocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DurationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DurationBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PeriodBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.CliffBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CliffBlocks))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ReleasedAmount) > 0 {
+			i -= len(x.ReleasedAmount)
+			copy(dAtA[i:], x.ReleasedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReleasedAmount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TotalAmount) > 0 {
+			i -= len(x.TotalAmount)
+			copy(dAtA[i:], x.TotalAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Beneficiary) > 0 {
+			i -= len(x.Beneficiary)
+			copy(dAtA[i:], x.Beneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beneficiary)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleasedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffBlocks", wireType)
+				}
+				x.CliffBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CliffBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodBlocks", wireType)
+				}
+				x.PeriodBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PeriodBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+				}
+				x.DurationBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DurationBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: mint/v1beta1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kinds of destinations the block emission can be paid to
+type EmissionRecipientType int32
+
+const (
+	// cosmos validators, through the fee collector
+	EmissionRecipientType_VALIDATORS EmissionRecipientType = 0
+	// reputers and workers, through the allora rewards account
+	EmissionRecipientType_ALLORA_REWARDS EmissionRecipientType = 1
+	// the distribution module community pool
+	EmissionRecipientType_COMMUNITY_POOL EmissionRecipientType = 2
+	// the foundation treasury address
+	EmissionRecipientType_FOUNDATION EmissionRecipientType = 3
+	// tokens are burned
+	EmissionRecipientType_BURN EmissionRecipientType = 4
+)
+
+// Enum value maps for EmissionRecipientType.
+var (
+	EmissionRecipientType_name = map[int32]string{
+		0: "VALIDATORS",
+		1: "ALLORA_REWARDS",
+		2: "COMMUNITY_POOL",
+		3: "FOUNDATION",
+		4: "BURN",
+	}
+	EmissionRecipientType_value = map[string]int32{
+		"VALIDATORS":     0,
+		"ALLORA_REWARDS": 1,
+		"COMMUNITY_POOL": 2,
+		"FOUNDATION":     3,
+		"BURN":           4,
+	}
+)
+
+func (x EmissionRecipientType) Enum() *EmissionRecipientType {
+	p := new(EmissionRecipientType)
+	*p = x
+	return p
+}
+
+func (x EmissionRecipientType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmissionRecipientType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mint_v1beta1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (EmissionRecipientType) Type() protoreflect.EnumType {
+	return &file_mint_v1beta1_types_proto_enumTypes[0]
+}
+
+func (x EmissionRecipientType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmissionRecipientType.Descriptor instead.
+func (EmissionRecipientType) EnumDescriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Curves the block emission can follow
+type EmissionCurveType int32
+
+const (
+	// target emission per unit staked token, smoothed by an exponential moving average
+	EmissionCurveType_TARGET_PER_STAKED_TOKEN EmissionCurveType = 0
+	// the block emission halves at a fixed interval of months
+	EmissionCurveType_HALVING EmissionCurveType = 1
+	// the same block emission every month
+	EmissionCurveType_CONSTANT EmissionCurveType = 2
+	// the block emission is linearly interpolated between points set by governance
+	EmissionCurveType_PIECEWISE_LINEAR EmissionCurveType = 3
+)
+
+// Enum value maps for EmissionCurveType.
+var (
+	EmissionCurveType_name = map[int32]string{
+		0: "TARGET_PER_STAKED_TOKEN",
+		1: "HALVING",
+		2: "CONSTANT",
+		3: "PIECEWISE_LINEAR",
+	}
+	EmissionCurveType_value = map[string]int32{
+		"TARGET_PER_STAKED_TOKEN": 0,
+		"HALVING":                 1,
+		"CONSTANT":                2,
+		"PIECEWISE_LINEAR":        3,
+	}
+)
+
+func (x EmissionCurveType) Enum() *EmissionCurveType {
+	p := new(EmissionCurveType)
+	*p = x
+	return p
+}
+
+func (x EmissionCurveType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmissionCurveType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mint_v1beta1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (EmissionCurveType) Type() protoreflect.EnumType {
+	return &file_mint_v1beta1_types_proto_enumTypes[1]
+}
+
+func (x EmissionCurveType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmissionCurveType.Descriptor instead.
+func (EmissionCurveType) EnumDescriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the parameters for the x/mint module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type of coin to mint
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// maximum total supply of the coin
+	MaxSupply string `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// ecosystem treasury fraction ideally emitted per unit time
+	FEmission string `protobuf:"bytes,3,opt,name=f_emission,json=fEmission,proto3" json:"f_emission,omitempty"`
+	// one month exponential moving average smoothing factor, alpha_e in the paper
+	OneMonthSmoothingDegree string `protobuf:"bytes,4,opt,name=one_month_smoothing_degree,json=oneMonthSmoothingDegree,proto3" json:"one_month_smoothing_degree,omitempty"`
+	// percentage of the total supply is reserved and locked in the ecosystem treasury
+	EcosystemTreasuryPercentOfTotalSupply string `protobuf:"bytes,5,opt,name=ecosystem_treasury_percent_of_total_supply,json=ecosystemTreasuryPercentOfTotalSupply,proto3" json:"ecosystem_treasury_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is unlocked and usable in the foundation treasury
+	FoundationTreasuryPercentOfTotalSupply string `protobuf:"bytes,6,opt,name=foundation_treasury_percent_of_total_supply,json=foundationTreasuryPercentOfTotalSupply,proto3" json:"foundation_treasury_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is unlocked and usable by partipicants at the genesis
+	ParticipantsPercentOfTotalSupply string `protobuf:"bytes,7,opt,name=participants_percent_of_total_supply,json=participantsPercentOfTotalSupply,proto3" json:"participants_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is locked in the investors bucket at the genesis.
+	// Deprecated: locked tokens are tracked by the vesting schedules, this is only
+	// read by the migration that seeds them on networks that predate on-chain vesting
+	//
+	// Deprecated: Do not use.
+	InvestorsPercentOfTotalSupply string `protobuf:"bytes,8,opt,name=investors_percent_of_total_supply,json=investorsPercentOfTotalSupply,proto3" json:"investors_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is locked in the team bucket at the genesis.
+	// Deprecated: locked tokens are tracked by the vesting schedules, this is only
+	// read by the migration that seeds them on networks that predate on-chain vesting
+	//
+	// Deprecated: Do not use.
+	TeamPercentOfTotalSupply string `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3" json:"team_percent_of_total_supply,omitempty"`
+	// The capped max monthly percentage yield (like %APY)
+	MaximumMonthlyPercentageYield string `protobuf:"bytes,10,opt,name=maximum_monthly_percentage_yield,json=maximumMonthlyPercentageYield,proto3" json:"maximum_monthly_percentage_yield,omitempty"`
+	// recipients of the block emission and their share of it, weights must sum to one
+	EmissionRecipients []*EmissionRecipient `protobuf:"bytes,11,rep,name=emission_recipients,json=emissionRecipients,proto3" json:"emission_recipients,omitempty"`
+	// curve used to recompute the block emission at every monthly emission update
+	EmissionCurve *EmissionCurve `protobuf:"bytes,12,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMintDenom() string {
+	if x != nil {
+		return x.MintDenom
+	}
+	return ""
+}
+
+func (x *Params) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Params) GetFEmission() string {
+	if x != nil {
+		return x.FEmission
+	}
+	return ""
+}
+
+func (x *Params) GetOneMonthSmoothingDegree() string {
+	if x != nil {
+		return x.OneMonthSmoothingDegree
+	}
+	return ""
+}
+
+func (x *Params) GetEcosystemTreasuryPercentOfTotalSupply() string {
+	if x != nil {
+		return x.EcosystemTreasuryPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetFoundationTreasuryPercentOfTotalSupply() string {
+	if x != nil {
+		return x.FoundationTreasuryPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetParticipantsPercentOfTotalSupply() string {
+	if x != nil {
+		return x.ParticipantsPercentOfTotalSupply
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Params) GetInvestorsPercentOfTotalSupply() string {
+	if x != nil {
+		return x.InvestorsPercentOfTotalSupply
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Params) GetTeamPercentOfTotalSupply() string {
+	if x != nil {
+		return x.TeamPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetMaximumMonthlyPercentageYield() string {
+	if x != nil {
+		return x.MaximumMonthlyPercentageYield
+	}
+	return ""
+}
+
+func (x *Params) GetEmissionRecipients() []*EmissionRecipient {
+	if x != nil {
+		return x.EmissionRecipients
+	}
+	return nil
+}
+
+func (x *Params) GetEmissionCurve() *EmissionCurve {
+	if x != nil {
+		return x.EmissionCurve
+	}
+	return nil
+}
+
+// EmissionRecipient is a destination of the block emission
+type EmissionRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipientType EmissionRecipientType `protobuf:"varint,1,opt,name=recipient_type,json=recipientType,proto3,enum=mint.v1beta1.EmissionRecipientType" json:"recipient_type,omitempty"`
+	// destination address, only set for the foundation
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// fraction of the block emission paid to this recipient
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *EmissionRecipient) Reset() {
+	*x = EmissionRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionRecipient) ProtoMessage() {}
+
+// Deprecated: Use EmissionRecipient.ProtoReflect.Descriptor instead.
+func (*EmissionRecipient) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmissionRecipient) GetRecipientType() EmissionRecipientType {
+	if x != nil {
+		return x.RecipientType
+	}
+	return EmissionRecipientType_VALIDATORS
+}
+
+func (x *EmissionRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EmissionRecipient) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// EmissionCurvePoint is a point of a piecewise linear emission curve
+type EmissionCurvePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// months since genesis
+	Month uint64 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// block emission at the first block of that month
+	BlockEmission string `protobuf:"bytes,2,opt,name=block_emission,json=blockEmission,proto3" json:"block_emission,omitempty"`
+}
+
+func (x *EmissionCurvePoint) Reset() {
+	*x = EmissionCurvePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionCurvePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionCurvePoint) ProtoMessage() {}
+
+// Deprecated: Use EmissionCurvePoint.ProtoReflect.Descriptor instead.
+func (*EmissionCurvePoint) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmissionCurvePoint) GetMonth() uint64 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *EmissionCurvePoint) GetBlockEmission() string {
+	if x != nil {
+		return x.BlockEmission
+	}
+	return ""
+}
+
+// EmissionCurve selects the emission curve and holds the settings of every curve
+type EmissionCurve struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurveType EmissionCurveType `protobuf:"varint,1,opt,name=curve_type,json=curveType,proto3,enum=mint.v1beta1.EmissionCurveType" json:"curve_type,omitempty"`
+	// block emission of the first month of the chain under the halving curve
+	HalvingInitialBlockEmission string `protobuf:"bytes,2,opt,name=halving_initial_block_emission,json=halvingInitialBlockEmission,proto3" json:"halving_initial_block_emission,omitempty"`
+	// number of months between two halvings
+	HalvingIntervalMonths uint64 `protobuf:"varint,3,opt,name=halving_interval_months,json=halvingIntervalMonths,proto3" json:"halving_interval_months,omitempty"`
+	// block emission of the constant curve
+	ConstantBlockEmission string `protobuf:"bytes,4,opt,name=constant_block_emission,json=constantBlockEmission,proto3" json:"constant_block_emission,omitempty"`
+	// points of the piecewise linear curve, in strictly increasing month order
+	Points []*EmissionCurvePoint `protobuf:"bytes,5,rep,name=points,proto3" json:"points,omitempty"`
+	// maximum relative change of the block emission from one monthly update to the next.
+	// bounds the jump when governance switches curves mid-chain. zero disables the bound, defaults to 0.1
+	MaxBlockEmissionChange string `protobuf:"bytes,6,opt,name=max_block_emission_change,json=maxBlockEmissionChange,proto3" json:"max_block_emission_change,omitempty"`
+}
+
+func (x *EmissionCurve) Reset() {
+	*x = EmissionCurve{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionCurve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionCurve) ProtoMessage() {}
+
+// Deprecated: Use EmissionCurve.ProtoReflect.Descriptor instead.
+func (*EmissionCurve) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EmissionCurve) GetCurveType() EmissionCurveType {
+	if x != nil {
+		return x.CurveType
+	}
+	return EmissionCurveType_TARGET_PER_STAKED_TOKEN
+}
+
+func (x *EmissionCurve) GetHalvingInitialBlockEmission() string {
+	if x != nil {
+		return x.HalvingInitialBlockEmission
+	}
+	return ""
+}
+
+func (x *EmissionCurve) GetHalvingIntervalMonths() uint64 {
+	if x != nil {
+		return x.HalvingIntervalMonths
+	}
+	return 0
+}
+
+func (x *EmissionCurve) GetConstantBlockEmission() string {
+	if x != nil {
+		return x.ConstantBlockEmission
+	}
+	return ""
+}
+
+func (x *EmissionCurve) GetPoints() []*EmissionCurvePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *EmissionCurve) GetMaxBlockEmissionChange() string {
+	if x != nil {
+		return x.MaxBlockEmissionChange
+	}
+	return ""
+}
+
+// VestingSchedule locks tokens held by the vesting escrow module account
+// and unlocks them to a beneficiary in equal installments once the cliff
+// has passed.
+type VestingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unique identifier of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account that receives the tokens as they unlock
+	Beneficiary string `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// total amount of tokens vesting under this schedule
+	TotalAmount string `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// amount of tokens already paid out to the beneficiary
+	ReleasedAmount string `protobuf:"bytes,4,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"`
+	// block height at which vesting starts
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// number of blocks after the start during which nothing unlocks
+	CliffBlocks uint64 `protobuf:"varint,6,opt,name=cliff_blocks,json=cliffBlocks,proto3" json:"cliff_blocks,omitempty"`
+	// number of blocks between two installments
+	PeriodBlocks uint64 `protobuf:"varint,7,opt,name=period_blocks,json=periodBlocks,proto3" json:"period_blocks,omitempty"`
+	// number of blocks after the start at which everything is unlocked
+	DurationBlocks uint64 `protobuf:"varint,8,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty"`
+}
+
+func (x *VestingSchedule) Reset() {
+	*x = VestingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingSchedule) ProtoMessage() {}
+
+// Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VestingSchedule) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VestingSchedule) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetReleasedAmount() string {
+	if x != nil {
+		return x.ReleasedAmount
 	}
 	return ""
 }
 
+func (x *VestingSchedule) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *VestingSchedule) GetCliffBlocks() uint64 {
+	if x != nil {
+		return x.CliffBlocks
+	}
+	return 0
+}
+
+func (x *VestingSchedule) GetPeriodBlocks() uint64 {
+	if x != nil {
+		return x.PeriodBlocks
+	}
+	return 0
+}
+
+func (x *VestingSchedule) GetDurationBlocks() uint64 {
+	if x != nil {
+		return x.DurationBlocks
+	}
+	return 0
+}
+
 var File_mint_v1beta1_types_proto protoreflect.FileDescriptor
 
 var file_mint_v1beta1_types_proto_rawDesc = []byte{
@@ -1151,7 +4368,7 @@ var file_mint_v1beta1_types_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x09, 0x0a,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x0a, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f,
 	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e,
 	0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
@@ -1201,46 +4418,154 @@ var file_mint_v1beta1_types_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
 	0x2a, 0x01, 0x52, 0x20, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
 	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x21, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x21, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f,
 	0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x6f,
 	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x42, 0x3e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
 	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1d,
-	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x7c, 0x0a,
-	0x1c, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66,
-	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x18, 0x74, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x20,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x6f, 0x6e,
-	0x74, 0x68, 0x6c, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x59, 0x69,
-	0x65, 0x6c, 0x64, 0x3a, 0x1f, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x18, 0x01,
+	0x52, 0x1d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x7e, 0x0a, 0x1c, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x66, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x18, 0x01, 0x52, 0x18, 0x74, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x85, 0x01, 0x0a, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x79,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5b, 0x0a, 0x13, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x12, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75,
+	0x72, 0x76, 0x65, 0x3a, 0x1f, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0xbb, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x83, 0x01, 0x0a, 0x12, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72,
+	0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x57, 0x0a,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x04, 0x0a, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x76,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63,
+	0x75, 0x72, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x75, 0x0a, 0x1e, 0x68, 0x61, 0x6c, 0x76,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x1b, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x36, 0x0a, 0x17, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x15, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x77, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0xa1, 0x03, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12,
+	0x53, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2a, 0x69, 0x0a, 0x15, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x41, 0x4c, 0x4c, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x53, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f,
+	0x4f, 0x4c, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x04, 0x2a, 0x61,
+	0x0a, 0x11, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x48, 0x41, 0x4c, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50,
+	0x49, 0x45, 0x43, 0x45, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10,
+	0x03, 0x42, 0xbb, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1255,16 +4580,28 @@ func file_mint_v1beta1_types_proto_rawDescGZIP() []byte {
 	return file_mint_v1beta1_types_proto_rawDescData
 }
 
-var file_mint_v1beta1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mint_v1beta1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mint_v1beta1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_mint_v1beta1_types_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: mint.v1beta1.Params
+	(EmissionRecipientType)(0), // 0: mint.v1beta1.EmissionRecipientType
+	(EmissionCurveType)(0),     // 1: mint.v1beta1.EmissionCurveType
+	(*Params)(nil),             // 2: mint.v1beta1.Params
+	(*EmissionRecipient)(nil),  // 3: mint.v1beta1.EmissionRecipient
+	(*EmissionCurvePoint)(nil), // 4: mint.v1beta1.EmissionCurvePoint
+	(*EmissionCurve)(nil),      // 5: mint.v1beta1.EmissionCurve
+	(*VestingSchedule)(nil),    // 6: mint.v1beta1.VestingSchedule
 }
 var file_mint_v1beta1_types_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: mint.v1beta1.Params.emission_recipients:type_name -> mint.v1beta1.EmissionRecipient
+	5, // 1: mint.v1beta1.Params.emission_curve:type_name -> mint.v1beta1.EmissionCurve
+	0, // 2: mint.v1beta1.EmissionRecipient.recipient_type:type_name -> mint.v1beta1.EmissionRecipientType
+	1, // 3: mint.v1beta1.EmissionCurve.curve_type:type_name -> mint.v1beta1.EmissionCurveType
+	4, // 4: mint.v1beta1.EmissionCurve.points:type_name -> mint.v1beta1.EmissionCurvePoint
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_mint_v1beta1_types_proto_init() }
@@ -1285,19 +4622,68 @@ func file_mint_v1beta1_types_proto_init() {
 				return nil
 			}
 		}
+		file_mint_v1beta1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionCurvePoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionCurve); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mint_v1beta1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mint_v1beta1_types_proto_goTypes,
 		DependencyIndexes: file_mint_v1beta1_types_proto_depIdxs,
+		EnumInfos:         file_mint_v1beta1_types_proto_enumTypes,
 		MessageInfos:      file_mint_v1beta1_types_proto_msgTypes,
 	}.Build()
 	File_mint_v1beta1_types_proto = out.File
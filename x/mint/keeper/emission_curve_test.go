@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+)
+
+func halvingCurve(initial int64, intervalMonths uint64) types.EmissionCurve {
+	curve := types.DefaultEmissionCurve()
+	curve.CurveType = types.EmissionCurveType_HALVING
+	curve.HalvingInitialBlockEmission = math.NewInt(initial)
+	curve.HalvingIntervalMonths = intervalMonths
+	return curve
+}
+
+func constantCurve(blockEmission int64) types.EmissionCurve {
+	curve := types.DefaultEmissionCurve()
+	curve.CurveType = types.EmissionCurveType_CONSTANT
+	curve.ConstantBlockEmission = math.NewInt(blockEmission)
+	return curve
+}
+
+func piecewiseLinearCurve() types.EmissionCurve {
+	curve := types.DefaultEmissionCurve()
+	curve.CurveType = types.EmissionCurveType_PIECEWISE_LINEAR
+	curve.Points = []types.EmissionCurvePoint{
+		{Month: 2, BlockEmission: math.NewInt(100)},
+		{Month: 12, BlockEmission: math.NewInt(200)},
+		{Month: 22, BlockEmission: math.NewInt(50)},
+	}
+	return curve
+}
+
+func (s *IntegrationTestSuite) TestHalvingCurveBlockEmission() {
+	curve := halvingCurve(1000, 12)
+	s.Require().Equal(math.NewInt(1000), curve.BlockEmission(0))
+	s.Require().Equal(math.NewInt(1000), curve.BlockEmission(11))
+	s.Require().Equal(math.NewInt(500), curve.BlockEmission(12))
+	s.Require().Equal(math.NewInt(250), curve.BlockEmission(24))
+	s.Require().True(curve.BlockEmission(12 * 300).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestConstantCurveBlockEmission() {
+	curve := constantCurve(777)
+	s.Require().Equal(math.NewInt(777), curve.BlockEmission(0))
+	s.Require().Equal(math.NewInt(777), curve.BlockEmission(1000))
+}
+
+func (s *IntegrationTestSuite) TestPiecewiseLinearCurveBlockEmission() {
+	curve := piecewiseLinearCurve()
+	s.Require().Equal(math.NewInt(100), curve.BlockEmission(0), "flat before the first point")
+	s.Require().Equal(math.NewInt(100), curve.BlockEmission(2))
+	s.Require().Equal(math.NewInt(150), curve.BlockEmission(7))
+	s.Require().Equal(math.NewInt(200), curve.BlockEmission(12))
+	s.Require().Equal(math.NewInt(125), curve.BlockEmission(17))
+	s.Require().Equal(math.NewInt(50), curve.BlockEmission(40), "flat after the last point")
+}
+
+func (s *IntegrationTestSuite) TestValidateEmissionCurve() {
+	s.Require().NoError(types.DefaultEmissionCurve().Validate())
+	s.Require().NoError(halvingCurve(1000, 12).Validate())
+	s.Require().NoError(constantCurve(0).Validate())
+	s.Require().NoError(piecewiseLinearCurve().Validate())
+
+	s.Require().Error(halvingCurve(0, 12).Validate(), "halving without initial emission")
+	s.Require().Error(halvingCurve(1000, 0).Validate(), "halving without interval")
+	s.Require().Error(constantCurve(-1).Validate(), "negative constant emission")
+
+	curve := piecewiseLinearCurve()
+	curve.Points = nil
+	s.Require().Error(curve.Validate(), "piecewise linear without points")
+	curve = piecewiseLinearCurve()
+	curve.Points[2].Month = curve.Points[1].Month
+	s.Require().Error(curve.Validate(), "points not strictly increasing")
+
+	curve = types.DefaultEmissionCurve()
+	curve.CurveType = types.EmissionCurveType(42)
+	s.Require().Error(curve.Validate(), "unknown curve")
+	curve = types.DefaultEmissionCurve()
+	curve.MaxBlockEmissionChange = math.LegacyMustNewDecFromStr("-0.1")
+	s.Require().Error(curve.Validate(), "negative bound")
+	curve = types.DefaultEmissionCurve()
+	curve.ConstantBlockEmission = math.Int{}
+	s.Require().Error(curve.Validate(), "nil constant emission")
+}
+
+func (s *IntegrationTestSuite) TestBoundBlockEmissionChange() {
+	maxChange := math.LegacyMustNewDecFromStr("0.1")
+	previous := math.NewInt(1000)
+	s.Require().Equal(math.NewInt(1100), types.BoundBlockEmissionChange(previous, math.NewInt(5000), maxChange))
+	s.Require().Equal(math.NewInt(900), types.BoundBlockEmissionChange(previous, math.ZeroInt(), maxChange))
+	s.Require().Equal(math.NewInt(1050), types.BoundBlockEmissionChange(previous, math.NewInt(1050), maxChange))
+	s.Require().Equal(math.NewInt(5000), types.BoundBlockEmissionChange(previous, math.NewInt(5000), math.LegacyZeroDec()), "zero disables the bound")
+	s.Require().Equal(math.NewInt(5000), types.BoundBlockEmissionChange(math.ZeroInt(), math.NewInt(5000), maxChange), "nothing to bound against")
+}
+
+func (s *IntegrationTestSuite) TestSwitchingCurveMidChainIsBounded() {
+	params := types.DefaultParams()
+	params.EmissionCurve = constantCurve(5000)
+	params.EmissionCurve.MaxBlockEmissionChange = math.LegacyMustNewDecFromStr("0.2")
+	blocksPerMonth := uint64(100)
+	staked := math.NewInt(1_000_000)
+
+	previous := math.NewInt(1000)
+	for _, expected := range []int64{1200, 1440, 1728, 2073} {
+		blockEmission, emissionPerUnitStakedToken, err := keeper.CalculateBlockEmission(
+			params,
+			blocksPerMonth,
+			5,
+			previous,
+			math.NewInt(1_000_000_000),
+			staked,
+			math.NewInt(1_000_000_000),
+			math.LegacyMustNewDecFromStr("0.3"),
+			math.LegacyZeroDec(),
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewInt(expected), blockEmission)
+		// the emission per unit staked token follows, so switching back to the target curve is smooth too
+		expectedPerToken := blockEmission.MulRaw(int64(blocksPerMonth)).ToLegacyDec().QuoInt(staked)
+		s.Require().Equal(expectedPerToken, emissionPerUnitStakedToken)
+		previous = blockEmission
+	}
+}
+
+func (s *IntegrationTestSuite) TestSwitchingBackToTargetCurveIsBounded() {
+	params := types.DefaultParams()
+	params.EmissionCurve.MaxBlockEmissionChange = math.LegacyMustNewDecFromStr("0.1")
+	blocksPerMonth := uint64(100)
+	maxSupply := params.MaxSupply
+
+	unbounded := params
+	unbounded.EmissionCurve.MaxBlockEmissionChange = math.LegacyZeroDec()
+	target, _, err := keeper.CalculateBlockEmission(
+		unbounded, blocksPerMonth, 5, math.NewInt(1), maxSupply, maxSupply, maxSupply,
+		math.LegacyMustNewDecFromStr("0.3"), math.LegacyMustNewDecFromStr("0.01"),
+	)
+	s.Require().NoError(err)
+	s.Require().True(target.GT(math.NewInt(1100)))
+
+	bounded, _, err := keeper.CalculateBlockEmission(
+		params, blocksPerMonth, 5, math.NewInt(1000), maxSupply, maxSupply, maxSupply,
+		math.LegacyMustNewDecFromStr("0.3"), math.LegacyMustNewDecFromStr("0.01"),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1100), bounded)
+}
+
+func (s *IntegrationTestSuite) TestMigrate3to4KeepsTargetCurve() {
+	params := types.DefaultParams()
+	params.EmissionCurve = types.EmissionCurve{}
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	migrator := keeper.NewMigrator(s.mintKeeper)
+	s.Require().NoError(migrator.Migrate3to4(s.ctx))
+
+	migrated, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(migrated.Validate())
+	s.Require().Equal(types.EmissionCurveType_TARGET_PER_STAKED_TOKEN, migrated.EmissionCurve.CurveType)
+	s.Require().True(migrated.EmissionCurve.MaxBlockEmissionChange.IsPositive(), "switching curves is bounded from the upgrade on")
+	s.Require().Equal(params.EmissionRecipients, migrated.EmissionRecipients)
+	s.Require().Equal(params.MaxSupply, migrated.MaxSupply)
+}
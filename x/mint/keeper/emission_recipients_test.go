@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+)
+
+func fiveWayEmissionRecipients(foundation string) []types.EmissionRecipient {
+	return []types.EmissionRecipient{
+		{RecipientType: types.EmissionRecipientType_VALIDATORS, Weight: math.LegacyMustNewDecFromStr("0.2")},
+		{RecipientType: types.EmissionRecipientType_COMMUNITY_POOL, Weight: math.LegacyMustNewDecFromStr("0.1")},
+		{RecipientType: types.EmissionRecipientType_FOUNDATION, Address: foundation, Weight: math.LegacyMustNewDecFromStr("0.1")},
+		{RecipientType: types.EmissionRecipientType_BURN, Weight: math.LegacyMustNewDecFromStr("0.05")},
+		{RecipientType: types.EmissionRecipientType_ALLORA_REWARDS, Weight: math.LegacyMustNewDecFromStr("0.55")},
+	}
+}
+
+func (s *IntegrationTestSuite) TestValidateEmissionRecipients() {
+	foundation := sdk.AccAddress("foundation__________").String()
+	params := types.DefaultParams()
+	params.EmissionRecipients = fiveWayEmissionRecipients(foundation)
+	s.Require().NoError(params.Validate())
+
+	params.EmissionRecipients = nil
+	s.Require().Error(params.Validate(), "empty recipients")
+
+	params.EmissionRecipients = fiveWayEmissionRecipients(foundation)
+	params.EmissionRecipients[0].Weight = math.LegacyMustNewDecFromStr("0.3")
+	s.Require().Error(params.Validate(), "weights sum above one")
+
+	params.EmissionRecipients = fiveWayEmissionRecipients(foundation)
+	params.EmissionRecipients[1].RecipientType = types.EmissionRecipientType_VALIDATORS
+	s.Require().Error(params.Validate(), "duplicate recipient")
+
+	params.EmissionRecipients = fiveWayEmissionRecipients("")
+	s.Require().Error(params.Validate(), "foundation without address")
+
+	params.EmissionRecipients = fiveWayEmissionRecipients(foundation)
+	params.EmissionRecipients[0].Address = foundation
+	s.Require().Error(params.Validate(), "validators with address")
+
+	params.EmissionRecipients = fiveWayEmissionRecipients(foundation)
+	params.EmissionRecipients[3].RecipientType = types.EmissionRecipientType(42)
+	s.Require().Error(params.Validate(), "unknown recipient type")
+}
+
+func (s *IntegrationTestSuite) TestSplitEmissionGivesRemainderToLastRecipient() {
+	recipients := types.TwoWayEmissionRecipients(math.LegacyMustNewDecFromStr("0.25"))
+	payouts := types.SplitEmission(math.NewInt(1003), recipients)
+	s.Require().Len(payouts, 2)
+	s.Require().Equal(math.NewInt(250), payouts[0].Amount)
+	s.Require().Equal(math.NewInt(753), payouts[1].Amount)
+}
+
+func (s *IntegrationTestSuite) TestPayEmissionFromEcosystem() {
+	foundation := sdk.AccAddress("foundation__________")
+	ecosystemAddr := authtypes.NewModuleAddress(types.EcosystemModuleName)
+	params := types.DefaultParams()
+	params.EmissionRecipients = fiveWayEmissionRecipients(foundation.String())
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(params.MintDenom, math.NewInt(amount)))
+	}
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.EcosystemModuleName, authtypes.FeeCollectorName, coins(200)).Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.EcosystemModuleName).Return(ecosystemAddr)
+	s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, coins(100), ecosystemAddr).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.EcosystemModuleName, foundation, coins(100)).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.EcosystemModuleName, coins(50)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.EcosystemModuleName, emissionstypes.AlloraRewardsAccountName, coins(550)).Return(nil)
+
+	err := s.mintKeeper.PayEmissionFromEcosystem(s.ctx, params, math.NewInt(1000))
+	s.Require().NoError(err)
+
+	payoutEvents := 0
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEmissionPayout {
+			payoutEvents++
+		}
+	}
+	s.Require().Equal(5, payoutEvents)
+}
+
+func (s *IntegrationTestSuite) TestMigrate2to3SeedsTwoWaySplit() {
+	params := types.DefaultParams()
+	params.EmissionRecipients = nil
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	emissionsParams := emissionstypes.DefaultParams()
+	emissionsParams.ValidatorsVsAlloraPercentReward = alloraMath.MustNewDecFromString("0.3")
+	s.emissionsKeeper.EXPECT().GetParams(s.ctx).Return(emissionsParams, nil)
+
+	migrator := keeper.NewMigrator(s.mintKeeper)
+	s.Require().NoError(migrator.Migrate2to3(s.ctx))
+
+	migrated, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.TwoWayEmissionRecipients(math.LegacyMustNewDecFromStr("0.3")), migrated.EmissionRecipients)
+	s.Require().True(math.LegacyMustNewDecFromStr("0.3").Equal(migrated.EmissionRecipientWeight(types.EmissionRecipientType_VALIDATORS)))
+}
+
+func (s *IntegrationTestSuite) TestMigrateFromV2ParamsToV5() {
+	params := s.setUpPreVestingNetwork(1000)
+	// params as stored by version 2, before the emission recipients and curve existed
+	params.EmissionRecipients = nil
+	params.EmissionCurve = types.EmissionCurve{}
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	investors := sdk.AccAddress("investors___________")
+	team := sdk.AccAddress("team________________")
+	for _, account := range []sdk.AccAddress{investors, team} {
+		s.bankKeeper.EXPECT().GetBalance(s.ctx, account, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, math.NewInt(36_000_000)))
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, account, types.VestingEscrowModuleName, gomock.Any()).Return(nil)
+	}
+
+	migrator := keeper.NewMigrator(s.mintKeeper).WithVestingAccounts(investors.String(), team.String())
+	s.Require().NoError(migrator.Migrate2to3(s.ctx))
+	s.Require().NoError(migrator.Migrate3to4(s.ctx))
+	s.Require().NoError(migrator.Migrate4to5(s.ctx))
+
+	migrated, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(migrated.Validate())
+	s.Require().Equal(types.DefaultEmissionCurve(), migrated.EmissionCurve)
+}
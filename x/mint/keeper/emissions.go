@@ -8,45 +8,6 @@ import (
 	"github.com/allora-network/allora-chain/x/mint/types"
 )
 
-// return the uncirculating supply, i.e. tokens on a vesting schedule
-// these tokens will be custodied by a centralized actor off chain.
-// this function returns the circulating supply based off of what
-// the agreements off chain say were supposed to happen for token lockup
-func GetLockedTokenSupply(
-	blocksPerMonth uint64,
-	blockHeight math.Int,
-	params types.Params,
-) math.Int {
-	// foundation is unlocked from genesis
-	// participants are unlocked from genesis
-	// investors and team tokens are locked on a 1 year cliff three year vesting schedule
-	blocksInAYear := math.NewIntFromUint64(blocksPerMonth * 12)
-	blocksInThreeYears := blocksInAYear.Mul(math.NewInt(3))
-	maxSupply := params.MaxSupply.ToLegacyDec()
-	percentInvestors := params.InvestorsPercentOfTotalSupply
-	percentTeam := params.TeamPercentOfTotalSupply
-	fullInvestors := percentInvestors.Mul(maxSupply).TruncateInt()
-	fullTeam := percentTeam.Mul(maxSupply).TruncateInt()
-	var investors, team math.Int
-	if blockHeight.LT(blocksInAYear) {
-		// less than a year, completely locked
-		investors = fullInvestors
-		team = fullTeam
-	} else if blockHeight.GTE(blocksInAYear) && blockHeight.LT(blocksInThreeYears) {
-		// between 1 and 3 years, investors and team tokens are vesting and partially unlocked
-		thirtySix := math.LegacyNewDec(36)
-		monthsUnlocked := blockHeight.Quo(math.NewIntFromUint64(blocksPerMonth)).ToLegacyDec()
-		monthsLocked := thirtySix.Sub(monthsUnlocked)
-		investors = monthsLocked.Quo(thirtySix).Mul(fullInvestors.ToLegacyDec()).TruncateInt()
-		team = monthsLocked.Quo(thirtySix).Mul(fullTeam.ToLegacyDec()).TruncateInt()
-	} else {
-		// greater than 3 years, all investor, team tokens are unlocked
-		investors = math.ZeroInt()
-		team = math.ZeroInt()
-	}
-	return investors.Add(team)
-}
-
 // helper function to get the number of staked tokens on the network
 // includes both tokens staked by cosmos validators (cosmos staking)
 // and tokens staked by reputers (allora staking)
@@ -62,6 +23,109 @@ func GetNumStakedTokens(ctx context.Context, k Keeper) (math.Int, error) {
 	return cosmosValidatorsStaked.Add(reputersStaked), nil
 }
 
+// The monthly emission given a snapshot of the network state.
+// Computes the capped target emission per unit staked token, smooths it
+// against the previous value and scales it by the number of staked tokens.
+// Returns the emission for the month and the new emission per unit staked token.
+func CalculateEmissionPerMonth(
+	params types.Params,
+	ecosystemMintSupplyRemaining math.Int,
+	networkStaked math.Int,
+	circulatingSupply math.Int,
+	reputersPercent math.LegacyDec,
+	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
+) (
+	emissionPerMonth math.Int,
+	emissionPerUnitStakedToken math.LegacyDec,
+	err error,
+) {
+	targetRewardEmissionPerUnitStakedToken, err := GetTargetRewardEmissionPerUnitStakedToken(
+		params.FEmission,
+		ecosystemMintSupplyRemaining,
+		networkStaked,
+		circulatingSupply,
+		params.MaxSupply,
+	)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+	maximumMonthlyEmissionPerUnitStakedToken := GetMaximumMonthlyEmissionPerUnitStakedToken(
+		params.MaximumMonthlyPercentageYield,
+		reputersPercent,
+		params.EmissionRecipientWeight(types.EmissionRecipientType_VALIDATORS),
+		params.EmissionRecipientWeight(types.EmissionRecipientType_ALLORA_REWARDS),
+	)
+	targetRewardEmissionPerUnitStakedToken = GetCappedTargetEmissionPerUnitStakedToken(
+		targetRewardEmissionPerUnitStakedToken,
+		maximumMonthlyEmissionPerUnitStakedToken,
+	)
+	emissionPerUnitStakedToken = GetExponentialMovingAverage(
+		targetRewardEmissionPerUnitStakedToken,
+		params.OneMonthSmoothingDegree,
+		previousRewardEmissionPerUnitStakedToken,
+	)
+	emissionPerMonth = GetTotalEmissionPerMonth(emissionPerUnitStakedToken, networkStaked)
+	return emissionPerMonth, emissionPerUnitStakedToken, nil
+}
+
+// EmissionUpdateMonth returns the number of months since genesis at an emission update height
+func EmissionUpdateMonth(blockHeight int64, blocksPerMonth uint64) uint64 {
+	return uint64(blockHeight) / blocksPerMonth
+}
+
+// The block emission for the month starting at an emission update, following the
+// governance selected emission curve. Fixed curves only depend on the month, their
+// emission per unit staked token is derived from the resulting monthly emission so
+// that switching back to the target model carries on smoothly from it.
+// The block emission is then bounded to the configured maximum change from the
+// previous block emission, so switching curves mid-chain cannot jump any further.
+// Returns the block emission and the new emission per unit staked token.
+func CalculateBlockEmission(
+	params types.Params,
+	blocksPerMonth uint64,
+	month uint64,
+	previousBlockEmission math.Int,
+	ecosystemMintSupplyRemaining math.Int,
+	networkStaked math.Int,
+	circulatingSupply math.Int,
+	reputersPercent math.LegacyDec,
+	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
+) (
+	blockEmission math.Int,
+	emissionPerUnitStakedToken math.LegacyDec,
+	err error,
+) {
+	bpm := math.NewIntFromUint64(blocksPerMonth)
+	curve := params.EmissionCurve
+	if curve.IsFixed() {
+		blockEmission = curve.BlockEmission(month)
+	} else {
+		var emissionPerMonth math.Int
+		emissionPerMonth, emissionPerUnitStakedToken, err = CalculateEmissionPerMonth(
+			params,
+			ecosystemMintSupplyRemaining,
+			networkStaked,
+			circulatingSupply,
+			reputersPercent,
+			previousRewardEmissionPerUnitStakedToken,
+		)
+		if err != nil {
+			return math.Int{}, math.LegacyDec{}, err
+		}
+		// emission/block = (emission/month) / (block/month)
+		blockEmission = emissionPerMonth.Quo(bpm)
+	}
+	bounded := types.BoundBlockEmissionChange(previousBlockEmission, blockEmission, curve.MaxBlockEmissionChange)
+	if curve.IsFixed() || !bounded.Equal(blockEmission) {
+		blockEmission = bounded
+		emissionPerUnitStakedToken = previousRewardEmissionPerUnitStakedToken
+		if networkStaked.IsPositive() {
+			emissionPerUnitStakedToken = blockEmission.Mul(bpm).ToLegacyDec().QuoInt(networkStaked)
+		}
+	}
+	return blockEmission, emissionPerUnitStakedToken, nil
+}
+
 // The total amount of tokens emitted for a full month
 // E_i = e_i*N_{staked,i}
 // where e_i is the emission per unit staked token
@@ -85,11 +149,12 @@ func GetMaximumMonthlyEmissionPerUnitStakedToken(
 	maximumMonthlyPercentageYield math.LegacyDec,
 	reputersPercentOfTopicRewards math.LegacyDec,
 	validatorsPercent math.LegacyDec,
+	alloraRewardsPercent math.LegacyDec,
 ) math.LegacyDec {
 	// the reputers percent is in terms of the emission to workers and reputers, not including validators
-	// e.g. if 1/4 goes to validators, then of the 3/4 that goes to workers and reputers, reputers got 1/3
-	// so you have to do 1/3 *3/4 = ACTUAL percent to reputers of the total emission
-	reputersPercent := math.LegacyOneDec().Sub(validatorsPercent).Mul(reputersPercentOfTopicRewards)
+	// e.g. if 3/4 goes to workers and reputers, and reputers got 1/3 of that
+	// you have to do 1/3 *3/4 = ACTUAL percent to reputers of the total emission
+	reputersPercent := alloraRewardsPercent.Mul(reputersPercentOfTopicRewards)
 	f_stakers := reputersPercent.Add(validatorsPercent)
 	return maximumMonthlyPercentageYield.Quo(f_stakers)
 }
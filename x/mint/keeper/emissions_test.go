@@ -3,7 +3,6 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/mint/keeper"
-	"github.com/allora-network/allora-chain/x/mint/types"
 )
 
 func (s *IntegrationTestSuite) TestTotalEmissionPerMonthSimple() {
@@ -50,61 +49,6 @@ func (s *IntegrationTestSuite) TestGetExponentialMovingAverageSimple() {
 	s.Require().True(expectedValue.Equal(result))
 }
 
-func (s *IntegrationTestSuite) TestNumberLockedTokensBeforeVest() {
-	defaultParams := types.DefaultParams()
-	fullInvestors := defaultParams.InvestorsPercentOfTotalSupply.
-		Mul(defaultParams.MaxSupply.ToLegacyDec()).TruncateInt()
-	fullTeam := defaultParams.TeamPercentOfTotalSupply.
-		Mul(defaultParams.MaxSupply.ToLegacyDec()).TruncateInt()
-	expectedLocked := fullInvestors.Add(fullTeam)
-
-	s.emissionsKeeper.EXPECT().GetParamsBlocksPerMonth(s.ctx).Return(uint64(525960), nil)
-	bpm, err := s.emissionsKeeper.GetParamsBlocksPerMonth(s.ctx)
-	s.Require().NoError(err)
-	result := keeper.GetLockedTokenSupply(
-		bpm,
-		math.NewInt(int64(bpm*2)),
-		defaultParams,
-	)
-	s.Require().True(result.Equal(expectedLocked), "expected %s, got %s", expectedLocked, result)
-}
-
-func (s *IntegrationTestSuite) TestNumberLockedTokensDuringVest() {
-	defaultParams := types.DefaultParams()
-	// after 13 months investors and team should get 1/3 + 1/36 = 13/36
-	fractionUnlocked := math.LegacyNewDec(13).Quo(math.LegacyNewDec(36))
-	fractionLocked := math.LegacyNewDec(1).Sub(fractionUnlocked)
-	investors := defaultParams.InvestorsPercentOfTotalSupply.
-		Mul(defaultParams.MaxSupply.ToLegacyDec()).
-		Mul(fractionLocked).TruncateInt()
-	team := defaultParams.TeamPercentOfTotalSupply.
-		Mul(defaultParams.MaxSupply.ToLegacyDec()).
-		Mul(fractionLocked).TruncateInt()
-	expectedLocked := investors.Add(team)
-	s.emissionsKeeper.EXPECT().GetParamsBlocksPerMonth(s.ctx).Return(uint64(525960), nil)
-	bpm, err := s.emissionsKeeper.GetParamsBlocksPerMonth(s.ctx)
-	s.Require().NoError(err)
-	result := keeper.GetLockedTokenSupply(
-		bpm,
-		math.NewInt(int64(bpm*13+1)),
-		defaultParams,
-	)
-	s.Require().True(result.Equal(expectedLocked), "expected %s, got %s", expectedLocked, result)
-}
-
-func (s *IntegrationTestSuite) TestNumberLockedTokensAfterVest() {
-	defaultParams := types.DefaultParams()
-	s.emissionsKeeper.EXPECT().GetParamsBlocksPerMonth(s.ctx).Return(uint64(525960), nil)
-	bpm, err := s.emissionsKeeper.GetParamsBlocksPerMonth(s.ctx)
-	s.Require().NoError(err)
-	result := keeper.GetLockedTokenSupply(
-		bpm,
-		math.NewInt(int64(bpm*40)),
-		defaultParams,
-	)
-	s.Require().True(result.Equal(math.ZeroInt()))
-}
-
 func (s *IntegrationTestSuite) TestTargetRewardEmissionPerUnitStakedTokenSimple() {
 	// ^e_i = ((f_e*T_{total,i}) / N_{staked,i}) * (N_{circ,i} / N_{total,i})
 	// using some random sample values
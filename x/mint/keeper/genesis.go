@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	"github.com/allora-network/allora-chain/x/mint/types"
 )
 
@@ -29,7 +32,30 @@ func (keeper Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, da
 		panic(err)
 	}
 
+	unreleased := math.ZeroInt()
+	for _, schedule := range data.VestingSchedules {
+		if err := keeper.VestingSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+			panic(err)
+		}
+		unreleased = unreleased.Add(schedule.UnreleasedAmount())
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
+	ak.GetModuleAccount(ctx, types.VestingEscrowModuleName)
+
+	// the escrow must be funded through bank genesis balances with
+	// every token the schedules have yet to pay out
+	if unreleased.IsPositive() {
+		escrowBalance := keeper.GetLockedTokenSupply(ctx, data.Params.MintDenom)
+		if escrowBalance.LT(unreleased) {
+			panic(errors.Wrapf(
+				types.ErrVestingEscrowUnderfunded,
+				"escrow holds %s, schedules require %s",
+				escrowBalance.String(),
+				unreleased.String(),
+			))
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -54,10 +80,16 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	vestingSchedules, err := keeper.GetVestingSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return types.NewGenesisState(
 		params,
 		previousRewardEmissionPerUnitStakedToken,
 		previousBlockEmission,
 		ecosystemTokensMinted,
+		vestingSchedules,
 	)
 }
@@ -22,7 +22,10 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
-var minterAcc = authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter)
+var (
+	minterAcc = authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter)
+	escrowAcc = authtypes.NewEmptyModuleAccount(types.VestingEscrowModuleName)
+)
 
 type GenesisTestSuite struct {
 	suite.Suite
@@ -30,7 +33,8 @@ type GenesisTestSuite struct {
 	sdkCtx        sdk.Context
 	keeper        keeper.Keeper
 	cdc           codec.BinaryCodec
-	accountKeeper types.AccountKeeper
+	accountKeeper *minttestutil.MockAccountKeeper
+	bankKeeper    *minttestutil.MockBankKeeper
 	key           *storetypes.KVStoreKey
 }
 
@@ -53,11 +57,14 @@ func (s *GenesisTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	emissionsKeeper := minttestutil.NewMockEmissionsKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 	s.accountKeeper = accountKeeper
+	s.bankKeeper = bankKeeper
 	accountKeeper.EXPECT().GetModuleAddress(minterAcc.Name).Return(minterAcc.GetAddress())
 	accountKeeper.EXPECT().GetModuleAccount(s.sdkCtx, minterAcc.Name).Return(minterAcc)
+	accountKeeper.EXPECT().GetModuleAccount(s.sdkCtx, escrowAcc.Name).Return(escrowAcc)
 
-	s.keeper = keeper.NewKeeper(s.cdc, runtime.NewKVStoreService(key), stakingKeeper, accountKeeper, bankKeeper, emissionsKeeper, "")
+	s.keeper = keeper.NewKeeper(s.cdc, runtime.NewKVStoreService(key), stakingKeeper, accountKeeper, bankKeeper, emissionsKeeper, distrKeeper, "")
 }
 
 func (s *GenesisTestSuite) TestImportExportGenesis() {
@@ -78,6 +85,8 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		defaultParams.InvestorsPercentOfTotalSupply,
 		defaultParams.TeamPercentOfTotalSupply,
 		defaultParams.MaximumMonthlyPercentageYield,
+		defaultParams.EmissionRecipients,
+		defaultParams.EmissionCurve,
 	)
 	genesisState.PreviousRewardEmissionPerUnitStakedToken = types.DefaultPreviousRewardEmissionPerUnitStakedToken()
 	genesisState.PreviousBlockEmission = types.DefaultPreviousBlockEmission()
@@ -109,3 +118,58 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 	s.Require().True(genesisState.PreviousRewardEmissionPerUnitStakedToken.Equal(genesisState2.PreviousRewardEmissionPerUnitStakedToken))
 	s.Require().True(genesisState.EcosystemTokensMinted.Equal(genesisState2.EcosystemTokensMinted))
 }
+
+func vestingGenesisState() *types.GenesisState {
+	genesisState := types.DefaultGenesisState()
+	genesisState.VestingSchedules = []types.VestingSchedule{
+		{
+			Id:             0,
+			Beneficiary:    sdk.AccAddress("investor____________").String(),
+			TotalAmount:    math.NewInt(3600),
+			ReleasedAmount: math.NewInt(1200),
+			StartHeight:    1,
+			CliffBlocks:    120,
+			PeriodBlocks:   10,
+			DurationBlocks: 360,
+		},
+		{
+			Id:             1,
+			Beneficiary:    sdk.AccAddress("team________________").String(),
+			TotalAmount:    math.NewInt(1000),
+			ReleasedAmount: math.ZeroInt(),
+			StartHeight:    1,
+			CliffBlocks:    0,
+			PeriodBlocks:   1,
+			DurationBlocks: 100,
+		},
+	}
+	return genesisState
+}
+
+func (s *GenesisTestSuite) TestImportExportGenesisWithVestingSchedules() {
+	genesisState := vestingGenesisState()
+	s.Require().NoError(types.ValidateGenesis(*genesisState))
+
+	escrowAddr := escrowAcc.GetAddress()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.VestingEscrowModuleName).Return(escrowAddr)
+	s.bankKeeper.EXPECT().GetBalance(s.sdkCtx, escrowAddr, genesisState.Params.MintDenom).
+		Return(sdk.NewCoin(genesisState.Params.MintDenom, math.NewInt(3400)))
+
+	s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
+
+	genesisState2 := s.keeper.ExportGenesis(s.sdkCtx)
+	s.Require().Equal(genesisState.VestingSchedules, genesisState2.VestingSchedules)
+}
+
+func (s *GenesisTestSuite) TestInitGenesisPanicsIfEscrowUnderfunded() {
+	genesisState := vestingGenesisState()
+
+	escrowAddr := escrowAcc.GetAddress()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.VestingEscrowModuleName).Return(escrowAddr)
+	s.bankKeeper.EXPECT().GetBalance(s.sdkCtx, escrowAddr, genesisState.Params.MintDenom).
+		Return(sdk.NewCoin(genesisState.Params.MintDenom, math.NewInt(3399)))
+
+	s.Require().Panics(func() {
+		s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
+	})
+}
@@ -25,6 +25,7 @@ type Keeper struct {
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
 	emissionsKeeper  types.EmissionsKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 
 	Schema                                   collections.Schema
@@ -32,6 +33,7 @@ type Keeper struct {
 	PreviousRewardEmissionPerUnitStakedToken collections.Item[math.LegacyDec]
 	PreviousBlockEmission                    collections.Item[math.Int]
 	EcosystemTokensMinted                    collections.Item[math.Int]
+	VestingSchedules                         collections.Map[uint64, types.VestingSchedule]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -42,6 +44,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	ek types.EmissionsKeeper,
+	dk types.DistributionKeeper,
 	feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
@@ -57,11 +60,13 @@ func NewKeeper(
 		accountKeeper:                            ak,
 		bankKeeper:                               bk,
 		emissionsKeeper:                          ek,
+		distrKeeper:                              dk,
 		feeCollectorName:                         feeCollectorName,
 		Params:                                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		PreviousRewardEmissionPerUnitStakedToken: collections.NewItem(sb, types.PreviousRewardEmissionPerUnitStakedTokenKey, "previousrewardsemissionsperunitstakedtoken", alloraMath.LegacyDecValue),
 		PreviousBlockEmission:                    collections.NewItem(sb, types.PreviousBlockEmissionKey, "previousblockemission", sdk.IntValue),
 		EcosystemTokensMinted:                    collections.NewItem(sb, types.EcosystemTokensMintedKey, "ecosystemtokensminted", sdk.IntValue),
+		VestingSchedules:                         collections.NewMap(sb, types.VestingSchedulesKey, "vestingschedules", collections.Uint64Key, codec.CollValue[types.VestingSchedule](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -89,6 +94,21 @@ func (k Keeper) AddEcosystemTokensMinted(ctx context.Context, minted math.Int) e
 	return k.EcosystemTokensMinted.Set(ctx, new)
 }
 
+// How many tokens are left that the ecosystem bucket is allowed to mint?
+// The cap is measured against the tokens ever minted rather than the current supply,
+// so burned tokens never free up room under the max supply to be minted again.
+func (k Keeper) GetEcosystemMintSupplyRemaining(ctx context.Context, params types.Params) (math.Int, error) {
+	// calculate how many tokens left the ecosystem account is allowed to mint
+	ecosystemTokensAlreadyMinted, err := k.EcosystemTokensMinted.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	// check that you are allowed to mint more tokens and we haven't hit the max supply
+	ecosystemMaxSupply := math.LegacyNewDecFromInt(params.MaxSupply).
+		Mul(params.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
+	return ecosystemMaxSupply.Sub(ecosystemTokensAlreadyMinted), nil
+}
+
 /// STAKING KEEPER RELATED FUNCTIONS
 
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
@@ -155,12 +175,65 @@ func (k Keeper) PayAlloraRewardsFromEcosystem(ctx context.Context, rewards sdk.C
 	)
 }
 
+// PayEmissionFromEcosystem splits the block emission between the emission recipients
+// and pays each of them from the ecosystem treasury account.
+// PayEmissionFromEcosystem to be used in BeginBlocker.
+func (k Keeper) PayEmissionFromEcosystem(ctx context.Context, params types.Params, emission math.Int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, payout := range types.SplitEmission(emission, params.EmissionRecipients) {
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, payout.Amount))
+		if coins.Empty() {
+			continue
+		}
+		var err error
+		switch payout.RecipientType {
+		case types.EmissionRecipientType_VALIDATORS:
+			err = k.PayValidatorsFromEcosystem(ctx, coins)
+		case types.EmissionRecipientType_ALLORA_REWARDS:
+			err = k.PayAlloraRewardsFromEcosystem(ctx, coins)
+		case types.EmissionRecipientType_COMMUNITY_POOL:
+			err = k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.EcosystemModuleName))
+		case types.EmissionRecipientType_FOUNDATION:
+			var addr sdk.AccAddress
+			addr, err = sdk.AccAddressFromBech32(payout.Address)
+			if err == nil {
+				err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EcosystemModuleName, addr, coins)
+			}
+		case types.EmissionRecipientType_BURN:
+			err = k.bankKeeper.BurnCoins(ctx, types.EcosystemModuleName, coins)
+		default:
+			err = fmt.Errorf("unknown emission recipient type: %s", payout.RecipientType)
+		}
+		if err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEmissionPayout,
+			sdk.NewAttribute(types.AttributeKeyRecipient, payout.RecipientType.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, payout.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		))
+	}
+	return nil
+}
+
 // GetTotalCurrTokenSupply implements an alias call to the underlying supply keeper's
 // GetTotalCurrTokenSupply to be used in BeginBlocker.
 func (k Keeper) GetTotalCurrTokenSupply(ctx context.Context) sdk.Coin {
 	return k.bankKeeper.GetSupply(ctx, params.BaseCoinUnit)
 }
 
+// GetCirculatingSupply returns the total supply minus the supply locked in the vesting escrow
+func (k Keeper) GetCirculatingSupply(ctx context.Context, mintDenom string) (total, locked, circulating math.Int) {
+	total = k.GetTotalCurrTokenSupply(ctx).Amount
+	locked = k.GetLockedTokenSupply(ctx, mintDenom)
+	circulating = total.Sub(locked)
+	if circulating.IsNegative() {
+		circulating = math.ZeroInt()
+	}
+	return total, locked, circulating
+}
+
 // returns the quantity of tokens currenty stored in the "ecosystem" module account
 // this module account is paid by inference requests and is drained by this mint module
 // when forwarding rewards to fee collector and allorarewards accounts
@@ -174,8 +247,9 @@ func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	return k.Params.Get(ctx)
 }
 
-// What split of the rewards should be given to cosmos validators vs
-// allora participants (reputers, forecaster workers, inferrer workers)
+// What split of the rewards used to be given to cosmos validators vs
+// allora participants (reputers, forecaster workers, inferrer workers).
+// The emissions param is deprecated, it is only read by Migrate2to3.
 func (k Keeper) GetValidatorsVsAlloraPercentReward(ctx context.Context) (alloraMath.Dec, error) {
 	emissionsParams, err := k.emissionsKeeper.GetParams(ctx)
 	if err != nil {
@@ -194,6 +268,20 @@ func (k Keeper) GetPreviousPercentageRewardToStakedReputers(ctx context.Context)
 	return stakedPercent.SdkLegacyDec(), nil
 }
 
+// What fraction of incoming topic revenue is burned before it reaches the ecosystem account
+func (k Keeper) GetTopicRevenueBurnFraction(ctx context.Context) (math.LegacyDec, error) {
+	emissionsParams, err := k.emissionsKeeper.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return emissionsParams.TopicRevenueBurnFraction.SdkLegacyDec(), nil
+}
+
+// wrapper around emissions keeper call to get the cumulative amount of topic revenue burned
+func (k Keeper) GetTopicRevenueBurned(ctx context.Context) (math.Int, error) {
+	return k.emissionsKeeper.GetTopicRevenueBurned(ctx)
+}
+
 // wrapper around emissions keeper call to get the number of blocks expected in a month
 func (k Keeper) GetParamsBlocksPerMonth(ctx context.Context) (uint64, error) {
 	emissionsParams, err := k.emissionsKeeper.GetParams(ctx)
@@ -203,7 +291,7 @@ func (k Keeper) GetParamsBlocksPerMonth(ctx context.Context) (uint64, error) {
 	return emissionsParams.BlocksPerMonth, nil
 }
 
-// wrapper around emissions keeper call to get if whitelist admin
-func (k Keeper) IsWhitelistAdmin(ctx context.Context, admin string) (bool, error) {
-	return k.emissionsKeeper.IsWhitelistAdmin(ctx, admin)
+// wrapper around emissions keeper call to get if the address holds the mint admin role
+func (k Keeper) IsMintAdmin(ctx context.Context, admin string) (bool, error) {
+	return k.emissionsKeeper.HasRole(ctx, emissionstypes.RoleMintAdmin, admin)
 }
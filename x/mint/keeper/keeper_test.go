@@ -28,9 +28,11 @@ type IntegrationTestSuite struct {
 	mintKeeper      keeper.Keeper
 	ctx             sdk.Context
 	msgServer       types.MsgServer
+	accountKeeper   *minttestutil.MockAccountKeeper
 	stakingKeeper   *minttestutil.MockStakingKeeper
 	bankKeeper      *minttestutil.MockBankKeeper
 	emissionsKeeper *minttestutil.MockEmissionsKeeper
+	distrKeeper     *minttestutil.MockDistributionKeeper
 	adminPrivateKey secp256k1.PrivKey
 	adminAddr       string
 }
@@ -52,6 +54,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
 	emissionsKeeper := minttestutil.NewMockEmissionsKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
 
@@ -62,11 +65,14 @@ func (s *IntegrationTestSuite) SetupTest() {
 		accountKeeper,
 		bankKeeper,
 		emissionsKeeper,
+		distrKeeper,
 		authtypes.FeeCollectorName,
 	)
+	s.accountKeeper = accountKeeper
 	s.stakingKeeper = stakingKeeper
 	s.bankKeeper = bankKeeper
 	s.emissionsKeeper = emissionsKeeper
+	s.distrKeeper = distrKeeper
 
 	// Setup a sender address
 	s.adminPrivateKey = secp256k1.GenPrivKey()
@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Migrator is a struct for handling in-place state migrations.
 type Migrator struct {
-	keeper Keeper
+	keeper                  Keeper
+	investorsVestingAccount string
+	teamVestingAccount      string
 }
 
 // NewMigrator returns Migrator instance for the state migration.
@@ -16,6 +21,15 @@ func NewMigrator(k Keeper) Migrator {
 	}
 }
 
+// WithVestingAccounts returns a copy of the migrator that seeds the vesting
+// schedules of the investors and team buckets held by the given accounts.
+// An empty address skips the corresponding bucket.
+func (m Migrator) WithVestingAccounts(investorsAccount, teamAccount string) Migrator {
+	m.investorsVestingAccount = investorsAccount
+	m.teamVestingAccount = teamAccount
+	return m
+}
+
 // Migrate1to2 migrates the x/mint module state from the consensus version 1 to
 // version 2. Specifically, it takes the parameters that are currently stored
 // and managed by the x/params modules and stores them directly into the x/mint
@@ -23,3 +37,140 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates the x/mint module state from the consensus version 2 to
+// version 3. The block emission used to be split in two using the emissions
+// module ValidatorsVsAlloraPercentReward param, it is now split between a list
+// of recipients stored in the mint params. Seed that list with the same split
+// so existing networks keep their behavior.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(params.EmissionRecipients) > 0 {
+		return nil
+	}
+	validatorsPercent, err := m.keeper.GetValidatorsVsAlloraPercentReward(ctx)
+	if err != nil {
+		return err
+	}
+	params.EmissionRecipients = types.TwoWayEmissionRecipients(validatorsPercent.SdkLegacyDec())
+	// the emission curve only exists from version 4, the params are validated as a whole then
+	if err := types.ValidateEmissionRecipients(params.EmissionRecipients); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4. The emission curve is now selected by a param, existing networks
+// keep the target emission per unit staked token curve, and get the default
+// bound on the change of the block emission between monthly updates.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.EmissionCurve = types.DefaultEmissionCurve()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate4to5 migrates the x/mint module state from the consensus version 4 to
+// version 5. Locked investor and team tokens used to be derived from the
+// InvestorsPercentOfTotalSupply and TeamPercentOfTotalSupply params with a one
+// year cliff and 36 monthly installments counted from genesis. Seed one vesting
+// schedule per bucket with that same curve, mark what already unlocked as
+// released, and move the still locked tokens from the bucket account to the
+// vesting escrow so that the circulating supply is unchanged.
+// A bucket with tokens still locked must have its account configured, otherwise
+// its tokens would silently count as circulating after the upgrade.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	schedules, err := m.keeper.GetVestingSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	if len(schedules) > 0 {
+		return nil
+	}
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	blocksPerMonth, err := m.keeper.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return err
+	}
+	buckets := []struct {
+		name    string
+		account string
+		percent math.LegacyDec
+	}{
+		{"investors", m.investorsVestingAccount, params.InvestorsPercentOfTotalSupply},
+		{"team", m.teamVestingAccount, params.TeamPercentOfTotalSupply},
+	}
+	nextId := uint64(0)
+	for _, bucket := range buckets {
+		total := bucket.percent.MulInt(params.MaxSupply).TruncateInt()
+		if !total.IsPositive() {
+			continue
+		}
+		schedule := types.VestingSchedule{
+			Id:             nextId,
+			Beneficiary:    bucket.account,
+			TotalAmount:    total,
+			ReleasedAmount: math.ZeroInt(),
+			StartHeight:    0,
+			CliffBlocks:    12 * blocksPerMonth,
+			PeriodBlocks:   blocksPerMonth,
+			DurationBlocks: 36 * blocksPerMonth,
+		}
+		// tokens unlocked so far were never held back, they already sit in the bucket account
+		schedule.ReleasedAmount = schedule.UnlockedAmount(ctx.BlockHeight())
+		if bucket.account == "" {
+			if schedule.UnreleasedAmount().IsPositive() {
+				return errors.Wrapf(
+					types.ErrVestingAccountNotConfigured,
+					"%s bucket still locks %s%s", bucket.name, schedule.UnreleasedAmount(), params.MintDenom,
+				)
+			}
+			m.keeper.Logger(ctx).Info("no vesting account configured for a fully vested bucket, skipping vesting schedule", "bucket", bucket.name)
+			continue
+		}
+		account, err := sdk.AccAddressFromBech32(bucket.account)
+		if err != nil {
+			return err
+		}
+		// An account holding less than the locked amount cannot fund the escrow in full.
+		// The missing tokens are counted as released, so that the escrow still holds
+		// exactly what the schedule has left to release.
+		balance := m.keeper.bankKeeper.GetBalance(ctx, account, params.MintDenom).Amount
+		if balance.LT(schedule.UnreleasedAmount()) {
+			m.keeper.Logger(ctx).Error(
+				"vesting account holds less than the locked amount, locking what it holds",
+				"bucket", bucket.name,
+				"locked", schedule.UnreleasedAmount().String(),
+				"balance", balance.String(),
+			)
+			schedule.ReleasedAmount = schedule.TotalAmount.Sub(balance)
+		}
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if schedule.UnreleasedAmount().IsPositive() {
+			locked := sdk.NewCoins(sdk.NewCoin(params.MintDenom, schedule.UnreleasedAmount()))
+			err = m.keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.VestingEscrowModuleName, locked)
+			if err != nil {
+				return errors.Wrapf(err, "failed to fund the vesting escrow from the %s account", bucket.name)
+			}
+		}
+		if err := m.keeper.VestingSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+			return err
+		}
+		nextId++
+	}
+	return nil
+}
@@ -23,12 +23,12 @@ func NewMsgServerImpl(k Keeper) types.MsgServer {
 
 // UpdateParams updates the params.
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	isAdmin, err := ms.IsWhitelistAdmin(ctx, msg.Sender)
+	isAdmin, err := ms.IsMintAdmin(ctx, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 	if !isAdmin {
-		return nil, errors.Wrapf(types.ErrUnauthorized, " %s not whitelist admin for mint update params", msg.Sender)
+		return nil, errors.Wrapf(types.ErrUnauthorized, " %s does not hold the mint admin role for mint update params", msg.Sender)
 	}
 
 	if err := msg.Params.Validate(); err != nil {
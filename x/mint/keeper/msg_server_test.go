@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/allora-network/allora-chain/x/mint/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,7 +18,7 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().NoError(err)
 	s.Require().NotNil(resp)
@@ -35,7 +36,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidSigner() {
 		Params: params,
 	}
 
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, nonAdminAddr).Return(false, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, nonAdminAddr).Return(false, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().ErrorIs(
 		err,
@@ -52,7 +53,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsNonAddressSigner() {
 		Sender: notAnAddress,
 		Params: defaultParams,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, notAnAddress).Return(false, fmt.Errorf("error key encode:"))
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, notAnAddress).Return(false, fmt.Errorf("error key encode:"))
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -65,7 +66,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsMintDenom() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -78,7 +79,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsMaxSupply() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -91,7 +92,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsFEmission() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -104,7 +105,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsOneMonthSmoothingDeg
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -117,7 +118,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsEcosystemTreasuryPer
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -130,7 +131,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsFoundationTreasuryPe
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -143,7 +144,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsParticipantsPercentO
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -156,7 +157,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsInvestorsPercentOfTo
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -168,7 +169,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsTeamPercentOfTotalSu
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -180,7 +181,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsMaximumMonthlyPercen
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().HasRole(s.ctx, emissionstypes.RoleMintAdmin, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
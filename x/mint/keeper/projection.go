@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// returns the height of the first emission update strictly after the given height.
+// the emission rate is updated on the first block of every month
+func nextEmissionUpdateHeight(blockHeight int64, blocksPerMonth uint64) int64 {
+	bpm := int64(blocksPerMonth)
+	next := (blockHeight/bpm)*bpm + 1
+	if next <= blockHeight {
+		next += bpm
+	}
+	return next
+}
+
+// projects the escrow balance at a future height by subtracting every
+// installment that will have been released by then
+func projectLockedSupply(escrowBalance math.Int, schedules []types.VestingSchedule, blockHeight int64) math.Int {
+	locked := escrowBalance
+	for _, schedule := range schedules {
+		toRelease := schedule.UnlockedAmount(blockHeight).Sub(schedule.ReleasedAmount)
+		if toRelease.IsPositive() {
+			locked = locked.Sub(toRelease)
+		}
+	}
+	if locked.IsNegative() {
+		return math.ZeroInt()
+	}
+	return locked
+}
+
+// ProjectEmissions replays the monthly emission update forward for the given number of months.
+// Staked tokens and the monthly fee inflow into the ecosystem treasury are assumed constant,
+// as are the emission recipients, the reputers percentage and the topic revenue burn fraction.
+// The emission follows the selected emission curve and the locked supply follows the vesting schedules.
+func (k Keeper) ProjectEmissions(
+	ctx context.Context,
+	months uint64,
+	stakedTokens math.Int,
+	monthlyFeeInflow math.Int,
+) ([]types.EmissionProjectionMonth, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocksPerMonth, err := k.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reputersPercent, err := k.GetPreviousPercentageRewardToStakedReputers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	emissionPerUnitStakedToken, err := k.PreviousRewardEmissionPerUnitStakedToken.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	previousBlockEmission, err := k.PreviousBlockEmission.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ecosystemMintSupplyRemaining, err := k.GetEcosystemMintSupplyRemaining(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	ecosystemBalance, err := k.GetEcosystemBalance(ctx, params.MintDenom)
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := k.GetVestingSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	burnFraction, err := k.GetTopicRevenueBurnFraction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the burned share of the fees never reaches the treasury
+	burnedFees := burnFraction.MulInt(monthlyFeeInflow).TruncateInt()
+	treasuryFeeInflow := monthlyFeeInflow.Sub(burnedFees)
+	totalSupply := k.GetTotalCurrTokenSupply(ctx).Amount
+	escrowBalance := k.GetLockedTokenSupply(ctx, params.MintDenom)
+
+	blockHeight := nextEmissionUpdateHeight(sdk.UnwrapSDKContext(ctx).BlockHeight(), blocksPerMonth)
+	projection := make([]types.EmissionProjectionMonth, 0, months)
+	for month := uint64(1); month <= months; month++ {
+		lockedSupply := projectLockedSupply(escrowBalance, schedules, blockHeight)
+		circulatingSupply := totalSupply.Sub(lockedSupply)
+		if circulatingSupply.IsNegative() {
+			circulatingSupply = math.ZeroInt()
+		}
+		var blockEmission math.Int
+		blockEmission, emissionPerUnitStakedToken, err = CalculateBlockEmission(
+			params,
+			blocksPerMonth,
+			EmissionUpdateMonth(blockHeight, blocksPerMonth),
+			previousBlockEmission,
+			ecosystemMintSupplyRemaining,
+			stakedTokens,
+			circulatingSupply,
+			reputersPercent,
+			emissionPerUnitStakedToken,
+		)
+		if err != nil {
+			return nil, err
+		}
+		previousBlockEmission = blockEmission
+
+		// fees are paid out first, the treasury only mints what they do not cover
+		// and never past its share of the max supply
+		ecosystemBalance = ecosystemBalance.Add(treasuryFeeInflow)
+		emission := blockEmission.Mul(math.NewIntFromUint64(blocksPerMonth))
+		available := ecosystemBalance.Add(math.MaxInt(ecosystemMintSupplyRemaining, math.ZeroInt()))
+		if emission.GT(available) {
+			// the chain pays a fixed amount every block, so the remainder of the division is never emitted
+			blockEmission = available.Quo(math.NewIntFromUint64(blocksPerMonth))
+			emission = blockEmission.Mul(math.NewIntFromUint64(blocksPerMonth))
+		}
+		minted := math.MaxInt(emission.Sub(ecosystemBalance), math.ZeroInt())
+		ecosystemBalance = ecosystemBalance.Add(minted).Sub(emission)
+		totalSupply = totalSupply.Add(minted).Sub(burnedFees)
+		ecosystemMintSupplyRemaining = ecosystemMintSupplyRemaining.Sub(minted)
+
+		// the block emission is split every block, so the monthly payouts are the per block payouts times the blocks
+		payouts := types.SplitEmission(blockEmission, params.EmissionRecipients)
+		validatorEmission := math.ZeroInt()
+		alloraRewards := math.ZeroInt()
+		for i := range payouts {
+			payouts[i].Amount = payouts[i].Amount.Mul(math.NewIntFromUint64(blocksPerMonth))
+			switch payouts[i].RecipientType {
+			case types.EmissionRecipientType_VALIDATORS:
+				validatorEmission = payouts[i].Amount
+			case types.EmissionRecipientType_ALLORA_REWARDS:
+				alloraRewards = payouts[i].Amount
+			case types.EmissionRecipientType_BURN:
+				totalSupply = totalSupply.Sub(payouts[i].Amount)
+			}
+		}
+		reputerEmission := reputersPercent.MulInt(alloraRewards).TruncateInt()
+
+		projection = append(projection, types.EmissionProjectionMonth{
+			Month:                        month,
+			BlockHeight:                  blockHeight,
+			EmissionPerUnitStakedToken:   emissionPerUnitStakedToken,
+			Emission:                     emission,
+			ValidatorEmission:            validatorEmission,
+			ReputerEmission:              reputerEmission,
+			WorkerEmission:               alloraRewards.Sub(reputerEmission),
+			Minted:                       minted,
+			TotalSupply:                  totalSupply,
+			LockedSupply:                 lockedSupply,
+			CirculatingSupply:            circulatingSupply,
+			EcosystemMintSupplyRemaining: ecosystemMintSupplyRemaining,
+			Payouts:                      payouts,
+			BurnedTopicRevenue:           burnedFees,
+		})
+		blockHeight += int64(blocksPerMonth)
+	}
+	return projection, nil
+}
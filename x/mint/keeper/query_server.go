@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/mint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -53,3 +58,152 @@ func (q queryServer) Inflation(ctx context.Context, _ *types.QueryInflationReque
 	}
 	return &ret, nil
 }
+
+// VestingSchedules returns every vesting schedule with its unlocked and remaining amounts
+// at the current block height, along with the total supply still locked in escrow.
+func (q queryServer) VestingSchedules(ctx context.Context, _ *types.QueryVestingSchedulesRequest) (*types.QueryVestingSchedulesResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := q.k.GetVestingSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]types.VestingScheduleStatus, 0, len(schedules))
+	for _, schedule := range schedules {
+		statuses = append(statuses, GetVestingScheduleStatus(ctx, schedule))
+	}
+	return &types.QueryVestingSchedulesResponse{
+		Schedules:    statuses,
+		LockedSupply: q.k.GetLockedTokenSupply(ctx, params.MintDenom),
+	}, nil
+}
+
+// VestingSchedule returns a single vesting schedule with its unlocked and remaining amounts
+// at the current block height.
+func (q queryServer) VestingSchedule(ctx context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "received nil request")
+	}
+	schedule, err := q.k.VestingSchedules.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrVestingScheduleNotFound, "id %d", req.Id).Error())
+		}
+		return nil, err
+	}
+	return &types.QueryVestingScheduleResponse{Schedule: GetVestingScheduleStatus(ctx, schedule)}, nil
+}
+
+// PreviousBlockEmission returns the amount of tokens emitted per block at the last emission update.
+func (q queryServer) PreviousBlockEmission(ctx context.Context, _ *types.QueryPreviousBlockEmissionRequest) (*types.QueryPreviousBlockEmissionResponse, error) {
+	blockEmission, err := q.k.PreviousBlockEmission.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPreviousBlockEmissionResponse{BlockEmission: blockEmission}, nil
+}
+
+// PreviousRewardEmissionPerUnitStakedToken returns the smoothed monthly emission per unit staked token.
+func (q queryServer) PreviousRewardEmissionPerUnitStakedToken(ctx context.Context, _ *types.QueryPreviousRewardEmissionPerUnitStakedTokenRequest) (*types.QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error) {
+	emissionPerUnitStakedToken, err := q.k.PreviousRewardEmissionPerUnitStakedToken.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPreviousRewardEmissionPerUnitStakedTokenResponse{
+		RewardEmissionPerUnitStakedToken: emissionPerUnitStakedToken,
+	}, nil
+}
+
+// EcosystemTokensMinted returns the number of tokens minted into the ecosystem treasury so far.
+func (q queryServer) EcosystemTokensMinted(ctx context.Context, _ *types.QueryEcosystemTokensMintedRequest) (*types.QueryEcosystemTokensMintedResponse, error) {
+	minted, err := q.k.EcosystemTokensMinted.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEcosystemTokensMintedResponse{EcosystemTokensMinted: minted}, nil
+}
+
+// EcosystemMintSupplyRemaining returns the number of tokens the ecosystem treasury may still mint.
+func (q queryServer) EcosystemMintSupplyRemaining(ctx context.Context, _ *types.QueryEcosystemMintSupplyRemainingRequest) (*types.QueryEcosystemMintSupplyRemainingResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := q.k.GetEcosystemMintSupplyRemaining(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEcosystemMintSupplyRemainingResponse{EcosystemMintSupplyRemaining: remaining}, nil
+}
+
+// Supply returns the total, locked and circulating token supply.
+func (q queryServer) Supply(ctx context.Context, _ *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := q.k.GetTopicRevenueBurned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total, locked, circulating := q.k.GetCirculatingSupply(ctx, params.MintDenom)
+	return &types.QuerySupplyResponse{
+		MaxSupply:          params.MaxSupply,
+		TotalSupply:        total,
+		LockedSupply:       locked,
+		CirculatingSupply:  circulating,
+		BurnedTopicRevenue: burned,
+	}, nil
+}
+
+// EmissionProjection replays the monthly emission calculation forward under the given assumptions.
+// Unset assumptions default to the currently staked tokens and no fee inflow.
+func (q queryServer) EmissionProjection(ctx context.Context, req *types.QueryEmissionProjectionRequest) (*types.QueryEmissionProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "received nil request")
+	}
+	if req.Months == 0 || req.Months > types.MaxEmissionProjectionMonths {
+		return nil, status.Errorf(codes.InvalidArgument, "months must be between 1 and %d", types.MaxEmissionProjectionMonths)
+	}
+
+	stakedTokens := req.StakedTokens
+	if stakedTokens.IsNil() || stakedTokens.IsZero() {
+		var err error
+		stakedTokens, err = GetNumStakedTokens(ctx, q.k)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if stakedTokens.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "staked tokens cannot be negative")
+	}
+	monthlyFeeInflow := req.MonthlyFeeInflow
+	if monthlyFeeInflow.IsNil() {
+		monthlyFeeInflow = math.ZeroInt()
+	}
+	if monthlyFeeInflow.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "monthly fee inflow cannot be negative")
+	}
+
+	months, err := q.k.ProjectEmissions(ctx, req.Months, stakedTokens, monthlyFeeInflow)
+	if err != nil {
+		return nil, err
+	}
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reputersPercent, err := q.k.GetPreviousPercentageRewardToStakedReputers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEmissionProjectionResponse{
+		StakedTokens:      stakedTokens,
+		MonthlyFeeInflow:  monthlyFeeInflow,
+		ValidatorsPercent: params.EmissionRecipientWeight(types.EmissionRecipientType_VALIDATORS),
+		ReputersPercent:   reputersPercent,
+		Months:            months,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/allora-network/allora-chain/x/mint/keeper"
 	mint "github.com/allora-network/allora-chain/x/mint/module"
@@ -42,6 +43,7 @@ func (suite *MintTestSuite) SetupTest() {
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
 	emissionsKeeper := minttestutil.NewMockEmissionsKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
 
@@ -52,6 +54,7 @@ func (suite *MintTestSuite) SetupTest() {
 		accountKeeper,
 		bankKeeper,
 		emissionsKeeper,
+		distrKeeper,
 		authtypes.FeeCollectorName,
 	)
 
@@ -71,6 +74,55 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(params.Params, kparams)
 }
 
+func (suite *MintTestSuite) TestGRPCVestingSchedule() {
+	schedule := investorSchedule(10)
+	err := suite.mintKeeper.VestingSchedules.Set(suite.ctx, schedule.Id, schedule)
+	suite.Require().NoError(err)
+
+	// query context is at height 0, before the cliff
+	res, err := suite.queryClient.VestingSchedule(gocontext.Background(), &types.QueryVestingScheduleRequest{Id: schedule.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(schedule, res.Schedule.Schedule)
+	suite.Require().True(res.Schedule.UnlockedAmount.IsZero())
+	suite.Require().Equal(schedule.TotalAmount, res.Schedule.RemainingAmount)
+
+	_, err = suite.queryClient.VestingSchedule(gocontext.Background(), &types.QueryVestingScheduleRequest{Id: 1})
+	suite.Require().ErrorContains(err, types.ErrVestingScheduleNotFound.Error())
+}
+
+func (suite *MintTestSuite) TestGRPCEmissionState() {
+	blockEmission := math.NewInt(1000)
+	emissionPerUnitStakedToken := math.LegacyMustNewDecFromStr("0.01")
+	minted := math.NewInt(5000)
+	suite.Require().NoError(suite.mintKeeper.PreviousBlockEmission.Set(suite.ctx, blockEmission))
+	suite.Require().NoError(suite.mintKeeper.PreviousRewardEmissionPerUnitStakedToken.Set(suite.ctx, emissionPerUnitStakedToken))
+	suite.Require().NoError(suite.mintKeeper.EcosystemTokensMinted.Set(suite.ctx, minted))
+
+	blockEmissionRes, err := suite.queryClient.PreviousBlockEmission(gocontext.Background(), &types.QueryPreviousBlockEmissionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(blockEmission, blockEmissionRes.BlockEmission)
+
+	emissionPerUnitStakedTokenRes, err := suite.queryClient.PreviousRewardEmissionPerUnitStakedToken(
+		gocontext.Background(),
+		&types.QueryPreviousRewardEmissionPerUnitStakedTokenRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(emissionPerUnitStakedToken.Equal(emissionPerUnitStakedTokenRes.RewardEmissionPerUnitStakedToken))
+
+	mintedRes, err := suite.queryClient.EcosystemTokensMinted(gocontext.Background(), &types.QueryEcosystemTokensMintedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(minted, mintedRes.EcosystemTokensMinted)
+
+	params := types.DefaultParams()
+	ecosystemMaxSupply := params.EcosystemTreasuryPercentOfTotalSupply.MulInt(params.MaxSupply).TruncateInt()
+	remainingRes, err := suite.queryClient.EcosystemMintSupplyRemaining(
+		gocontext.Background(),
+		&types.QueryEcosystemMintSupplyRemainingRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ecosystemMaxSupply.Sub(minted), remainingRes.EcosystemMintSupplyRemaining)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
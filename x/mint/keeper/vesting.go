@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetVestingSchedules returns every vesting schedule ordered by id
+func (k Keeper) GetVestingSchedules(ctx context.Context) ([]types.VestingSchedule, error) {
+	iter, err := k.VestingSchedules.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// returns the quantity of tokens currently held by the vesting escrow module account.
+// these tokens are not yet unlocked to their beneficiaries and therefore not circulating
+func (k Keeper) GetLockedTokenSupply(ctx context.Context, mintDenom string) math.Int {
+	escrowAddr := k.accountKeeper.GetModuleAddress(types.VestingEscrowModuleName)
+	return k.bankKeeper.GetBalance(ctx, escrowAddr, mintDenom).Amount
+}
+
+// ReleaseVestedTokens pays out, from the vesting escrow account, every token that
+// has unlocked since the last release for each vesting schedule
+func (k Keeper) ReleaseVestedTokens(ctx context.Context, mintDenom string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	schedules, err := k.GetVestingSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range schedules {
+		toRelease := schedule.UnlockedAmount(sdkCtx.BlockHeight()).Sub(schedule.ReleasedAmount)
+		if !toRelease.IsPositive() {
+			continue
+		}
+		beneficiary, err := sdk.AccAddressFromBech32(schedule.Beneficiary)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(mintDenom, toRelease))
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.VestingEscrowModuleName, beneficiary, coins)
+		if err != nil {
+			return err
+		}
+		schedule.ReleasedAmount = schedule.ReleasedAmount.Add(toRelease)
+		if err := k.VestingSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeVestingRelease,
+			sdk.NewAttribute(types.AttributeKeyScheduleId, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, schedule.Beneficiary),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		))
+	}
+	return nil
+}
+
+// GetVestingScheduleStatus returns the schedule along with its unlocked and
+// remaining amounts at the current block height
+func GetVestingScheduleStatus(ctx context.Context, schedule types.VestingSchedule) types.VestingScheduleStatus {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return types.VestingScheduleStatus{
+		Schedule:        schedule,
+		UnlockedAmount:  schedule.UnlockedAmount(blockHeight),
+		RemainingAmount: schedule.RemainingAmount(blockHeight),
+	}
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+)
+
+// one year cliff, monthly installments over three years
+func investorSchedule(blocksPerMonth uint64) types.VestingSchedule {
+	return types.VestingSchedule{
+		Id:             0,
+		Beneficiary:    sdk.AccAddress("investor____________").String(),
+		TotalAmount:    math.NewInt(36_000_000),
+		ReleasedAmount: math.ZeroInt(),
+		StartHeight:    0,
+		CliffBlocks:    blocksPerMonth * 12,
+		PeriodBlocks:   blocksPerMonth,
+		DurationBlocks: blocksPerMonth * 36,
+	}
+}
+
+func (s *IntegrationTestSuite) TestVestingScheduleBeforeCliff() {
+	bpm := uint64(525960)
+	schedule := investorSchedule(bpm)
+	s.Require().NoError(schedule.Validate())
+
+	s.Require().True(schedule.UnlockedAmount(int64(bpm*12 - 1)).IsZero())
+	s.Require().Equal(schedule.TotalAmount, schedule.RemainingAmount(int64(bpm*2)))
+}
+
+func (s *IntegrationTestSuite) TestVestingScheduleDuringVest() {
+	bpm := uint64(525960)
+	schedule := investorSchedule(bpm)
+
+	// at the cliff the first twelve installments unlock at once
+	s.Require().Equal(math.NewInt(12_000_000), schedule.UnlockedAmount(int64(bpm*12)))
+	// after 13 months investors should get 13/36
+	s.Require().Equal(math.NewInt(13_000_000), schedule.UnlockedAmount(int64(bpm*13+1)))
+	s.Require().Equal(math.NewInt(23_000_000), schedule.RemainingAmount(int64(bpm*13+1)))
+}
+
+func (s *IntegrationTestSuite) TestVestingScheduleAfterVest() {
+	bpm := uint64(525960)
+	schedule := investorSchedule(bpm)
+
+	s.Require().Equal(schedule.TotalAmount, schedule.UnlockedAmount(int64(bpm*40)))
+	s.Require().True(schedule.RemainingAmount(int64(bpm * 40)).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestGetLockedTokenSupplyIsEscrowBalance() {
+	escrowAddr := sdk.AccAddress("escrow______________")
+	s.accountKeeper.EXPECT().GetModuleAddress(types.VestingEscrowModuleName).Return(escrowAddr)
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, escrowAddr, "uallo").Return(sdk.NewCoin("uallo", math.NewInt(1234)))
+
+	s.Require().Equal(math.NewInt(1234), s.mintKeeper.GetLockedTokenSupply(s.ctx, "uallo"))
+}
+
+func (s *IntegrationTestSuite) TestReleaseVestedTokens() {
+	schedule := investorSchedule(10)
+	s.Require().NoError(s.mintKeeper.VestingSchedules.Set(s.ctx, schedule.Id, schedule))
+	beneficiary := sdk.MustAccAddressFromBech32(schedule.Beneficiary)
+
+	// nothing is paid before the cliff
+	ctx := s.ctx.WithBlockHeight(119)
+	s.Require().NoError(s.mintKeeper.ReleaseVestedTokens(ctx, "uallo"))
+
+	// at the cliff the accrued installments are paid at once
+	ctx = s.ctx.WithBlockHeight(120)
+	expected := sdk.NewCoins(sdk.NewCoin("uallo", math.NewInt(12_000_000)))
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.VestingEscrowModuleName, beneficiary, expected).
+		Return(nil)
+	s.Require().NoError(s.mintKeeper.ReleaseVestedTokens(ctx, "uallo"))
+
+	// nothing new unlocks within the same period
+	ctx = s.ctx.WithBlockHeight(129)
+	s.Require().NoError(s.mintKeeper.ReleaseVestedTokens(ctx, "uallo"))
+
+	ctx = s.ctx.WithBlockHeight(130)
+	expected = sdk.NewCoins(sdk.NewCoin("uallo", math.NewInt(1_000_000)))
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.VestingEscrowModuleName, beneficiary, expected).
+		Return(nil)
+	s.Require().NoError(s.mintKeeper.ReleaseVestedTokens(ctx, "uallo"))
+
+	stored, err := s.mintKeeper.VestingSchedules.Get(s.ctx, schedule.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(13_000_000), stored.ReleasedAmount)
+}
+
+func (s *IntegrationTestSuite) TestValidateGenesisRejectsInvalidVestingSchedules() {
+	genesisState := vestingGenesisState()
+	genesisState.VestingSchedules[1].Id = 0
+	s.Require().ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidVestingSchedule)
+
+	genesisState = vestingGenesisState()
+	genesisState.VestingSchedules[0].DurationBlocks = 365
+	s.Require().ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidVestingSchedule)
+
+	genesisState = vestingGenesisState()
+	genesisState.VestingSchedules[0].ReleasedAmount = math.NewInt(3601)
+	s.Require().ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidVestingSchedule)
+}
+
+// State of a network before the upgrade to on-chain vesting, 18 months after genesis:
+// half of the 36 monthly installments of both buckets are unlocked and still sit in
+// the bucket accounts along with the locked half.
+func (s *IntegrationTestSuite) setUpPreVestingNetwork(bpm uint64) types.Params {
+	s.ctx = s.ctx.WithBlockHeight(int64(bpm*18 + 1))
+	params := types.DefaultParams()
+	params.MaxSupply = math.NewInt(100_000_000)
+	params.InvestorsPercentOfTotalSupply = math.LegacyMustNewDecFromStr("0.36")
+	params.TeamPercentOfTotalSupply = math.LegacyMustNewDecFromStr("0.18")
+	params.EcosystemTreasuryPercentOfTotalSupply = math.LegacyMustNewDecFromStr("0.305")
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	emissionsParams := emissionstypes.DefaultParams()
+	emissionsParams.BlocksPerMonth = bpm
+	s.emissionsKeeper.EXPECT().GetParams(s.ctx).Return(emissionsParams, nil).AnyTimes()
+	return params
+}
+
+func (s *IntegrationTestSuite) TestMigrate4to5SeedsVestingSchedulesAndFundsEscrow() {
+	bpm := uint64(1000)
+	params := s.setUpPreVestingNetwork(bpm)
+
+	investors := sdk.AccAddress("investors___________")
+	team := sdk.AccAddress("team________________")
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, investors, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, math.NewInt(36_000_000)))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, team, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, math.NewInt(18_000_000)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(
+		s.ctx, investors, types.VestingEscrowModuleName, sdk.NewCoins(sdk.NewCoin(params.MintDenom, math.NewInt(18_000_000))),
+	).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(
+		s.ctx, team, types.VestingEscrowModuleName, sdk.NewCoins(sdk.NewCoin(params.MintDenom, math.NewInt(9_000_000))),
+	).Return(nil)
+
+	migrator := keeper.NewMigrator(s.mintKeeper).WithVestingAccounts(investors.String(), team.String())
+	s.Require().NoError(migrator.Migrate4to5(s.ctx))
+
+	schedules, err := s.mintKeeper.GetVestingSchedules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(schedules, 2)
+	schedule := schedules[0]
+	s.Require().Equal(investors.String(), schedule.Beneficiary)
+	s.Require().Equal(math.NewInt(36_000_000), schedule.TotalAmount)
+	s.Require().Equal(math.NewInt(18_000_000), schedule.ReleasedAmount)
+	s.Require().Equal(bpm*12, schedule.CliffBlocks)
+	s.Require().Equal(bpm, schedule.PeriodBlocks)
+	s.Require().Equal(bpm*36, schedule.DurationBlocks)
+	s.Require().Equal(team.String(), schedules[1].Beneficiary)
+	s.Require().Equal(math.NewInt(9_000_000), schedules[1].UnreleasedAmount())
+
+	// running it again does not seed nor fund anything twice
+	s.Require().NoError(migrator.Migrate4to5(s.ctx))
+	schedules, err = s.mintKeeper.GetVestingSchedules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(schedules, 2)
+}
+
+func (s *IntegrationTestSuite) TestMigrate4to5FailsWithoutAccountOfLockedBucket() {
+	params := s.setUpPreVestingNetwork(1000)
+
+	investors := sdk.AccAddress("investors___________")
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, investors, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, math.NewInt(36_000_000))).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// the locked team tokens would otherwise count as circulating after the upgrade
+	migrator := keeper.NewMigrator(s.mintKeeper).WithVestingAccounts(investors.String(), "")
+	s.Require().ErrorIs(migrator.Migrate4to5(s.ctx), types.ErrVestingAccountNotConfigured)
+}
+
+func (s *IntegrationTestSuite) TestMigrate4to5LocksWhatAnUnderfundedAccountHolds() {
+	params := s.setUpPreVestingNetwork(1000)
+
+	investors := sdk.AccAddress("investors___________")
+	team := sdk.AccAddress("team________________")
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, investors, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, math.NewInt(36_000_000)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(
+		s.ctx, investors, types.VestingEscrowModuleName, sdk.NewCoins(sdk.NewCoin(params.MintDenom, math.NewInt(18_000_000))),
+	).Return(nil)
+	// the team account moved part of its locked tokens away before the upgrade
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, team, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, math.NewInt(4_000_000)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(
+		s.ctx, team, types.VestingEscrowModuleName, sdk.NewCoins(sdk.NewCoin(params.MintDenom, math.NewInt(4_000_000))),
+	).Return(nil)
+
+	migrator := keeper.NewMigrator(s.mintKeeper).WithVestingAccounts(investors.String(), team.String())
+	s.Require().NoError(migrator.Migrate4to5(s.ctx))
+
+	schedules, err := s.mintKeeper.GetVestingSchedules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(schedules, 2)
+	s.Require().Equal(math.NewInt(18_000_000), schedules[1].TotalAmount)
+	s.Require().Equal(math.NewInt(4_000_000), schedules[1].UnreleasedAmount(), "the escrow holds what the schedule has left to release")
+}
@@ -2,40 +2,36 @@ package mint
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/mint/keeper"
 	"github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func GetEmissionPerMonth(
+// snapshot of the network state the emission calculation depends on
+type emissionInputs struct {
+	networkStaked                            math.Int
+	circulatingSupply                        math.Int
+	reputersPercent                          math.LegacyDec
+	previousRewardEmissionPerUnitStakedToken math.LegacyDec
+}
+
+func getEmissionInputs(
 	ctx sdk.Context,
 	k keeper.Keeper,
-	blocksPerMonth uint64,
 	params types.Params,
 	ecosystemMintSupplyRemaining math.Int,
-	validatorsPercent math.LegacyDec,
-) (
-	emissionPerMonth math.Int,
-	emissionPerUnitStakedToken math.LegacyDec,
-	err error,
-) {
+) (emissionInputs, error) {
 	// Get the expected amount of emissions this block
 	networkStaked, err := keeper.GetNumStakedTokens(ctx, k)
 	if err != nil {
-		return math.Int{}, math.LegacyDec{}, err
-	}
-	totalSupply := k.GetTotalCurrTokenSupply(ctx).Amount
-	lockedSupply := keeper.GetLockedTokenSupply(
-		blocksPerMonth,
-		math.NewIntFromUint64(uint64(ctx.BlockHeight())),
-		params,
-	)
-	circulatingSupply := totalSupply.Sub(lockedSupply)
-	if circulatingSupply.IsNegative() {
-		circulatingSupply = math.ZeroInt()
+		return emissionInputs{}, err
 	}
+	totalSupply, lockedSupply, circulatingSupply := k.GetCirculatingSupply(ctx, params.MintDenom)
 	// T_{total,i} = ecosystemMintableRemaining
 	// N_{staked,i} = networkStaked
 	// N_{circ,i} = circulatingSupply
@@ -48,41 +44,76 @@ func GetEmissionPerMonth(
 		"totalSupply", totalSupply.String(),
 		"lockedSupply", lockedSupply.String(),
 	)
-	targetRewardEmissionPerUnitStakedToken,
-		err := keeper.GetTargetRewardEmissionPerUnitStakedToken(
-		params.FEmission,
-		ecosystemMintSupplyRemaining,
-		networkStaked,
-		circulatingSupply,
-		params.MaxSupply,
-	)
+	reputersPercent, err := k.GetPreviousPercentageRewardToStakedReputers(ctx)
 	if err != nil {
-		return math.Int{}, math.LegacyDec{}, err
+		return emissionInputs{}, err
 	}
-	reputersPercent, err := k.GetPreviousPercentageRewardToStakedReputers(ctx)
+	previousRewardEmissionPerUnitStakedToken, err := k.PreviousRewardEmissionPerUnitStakedToken.Get(ctx)
+	if err != nil {
+		return emissionInputs{}, err
+	}
+	return emissionInputs{
+		networkStaked:                            networkStaked,
+		circulatingSupply:                        circulatingSupply,
+		reputersPercent:                          reputersPercent,
+		previousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
+	}, nil
+}
+
+// The monthly emission under the target emission per unit staked token model
+func GetEmissionPerMonth(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	blocksPerMonth uint64,
+	params types.Params,
+	ecosystemMintSupplyRemaining math.Int,
+) (
+	emissionPerMonth math.Int,
+	emissionPerUnitStakedToken math.LegacyDec,
+	err error,
+) {
+	inputs, err := getEmissionInputs(ctx, k, params, ecosystemMintSupplyRemaining)
 	if err != nil {
 		return math.Int{}, math.LegacyDec{}, err
 	}
-	maximumMonthlyEmissionPerUnitStakedToken := keeper.GetMaximumMonthlyEmissionPerUnitStakedToken(
-		params.MaximumMonthlyPercentageYield,
-		reputersPercent,
-		validatorsPercent,
-	)
-	targetRewardEmissionPerUnitStakedToken = keeper.GetCappedTargetEmissionPerUnitStakedToken(
-		targetRewardEmissionPerUnitStakedToken,
-		maximumMonthlyEmissionPerUnitStakedToken,
+	return keeper.CalculateEmissionPerMonth(
+		params,
+		ecosystemMintSupplyRemaining,
+		inputs.networkStaked,
+		inputs.circulatingSupply,
+		inputs.reputersPercent,
+		inputs.previousRewardEmissionPerUnitStakedToken,
 	)
-	previousRewardEmissionPerUnitStakedToken, err := k.PreviousRewardEmissionPerUnitStakedToken.Get(ctx)
+}
+
+// The block emission for the month starting at this block, following the selected emission curve
+func GetBlockEmission(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	blocksPerMonth uint64,
+	params types.Params,
+	ecosystemMintSupplyRemaining math.Int,
+	previousBlockEmission math.Int,
+) (
+	blockEmission math.Int,
+	emissionPerUnitStakedToken math.LegacyDec,
+	err error,
+) {
+	inputs, err := getEmissionInputs(ctx, k, params, ecosystemMintSupplyRemaining)
 	if err != nil {
 		return math.Int{}, math.LegacyDec{}, err
 	}
-	emissionPerUnitStakedToken = keeper.GetExponentialMovingAverage(
-		targetRewardEmissionPerUnitStakedToken,
-		params.OneMonthSmoothingDegree,
-		previousRewardEmissionPerUnitStakedToken,
+	return keeper.CalculateBlockEmission(
+		params,
+		blocksPerMonth,
+		keeper.EmissionUpdateMonth(ctx.BlockHeight(), blocksPerMonth),
+		previousBlockEmission,
+		ecosystemMintSupplyRemaining,
+		inputs.networkStaked,
+		inputs.circulatingSupply,
+		inputs.reputersPercent,
+		inputs.previousRewardEmissionPerUnitStakedToken,
 	)
-	emissionPerMonth = keeper.GetTotalEmissionPerMonth(emissionPerUnitStakedToken, networkStaked)
-	return emissionPerMonth, emissionPerUnitStakedToken, nil
 }
 
 // How many tokens are left that the ecosystem bucket is allowed to mint?
@@ -91,24 +122,35 @@ func GetEcosystemMintSupplyRemaining(
 	k keeper.Keeper,
 	params types.Params,
 ) (math.Int, error) {
-	// calculate how many tokens left the ecosystem account is allowed to mint
-	ecosystemTokensAlreadyMinted, err := k.EcosystemTokensMinted.Get(ctx)
-	if err != nil {
-		return math.Int{}, err
-	}
-	// check that you are allowed to mint more tokens and we haven't hit the max supply
-	ecosystemMaxSupply := math.LegacyNewDecFromInt(params.MaxSupply).
-		Mul(params.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
-	return ecosystemMaxSupply.Sub(ecosystemTokensAlreadyMinted), nil
+	return k.GetEcosystemMintSupplyRemaining(ctx, params)
+}
+
+// Reports the emission paid out this block and the resulting supply, for validators to alert on
+func setEmissionGauges(ctx sdk.Context, k keeper.Keeper, params types.Params, payout, minted math.Int) {
+	telemetry.ModuleSetGauge(types.ModuleName, alloraMath.SdkIntToFloat32(payout), "block_emission")
+	if minted.IsPositive() {
+		telemetry.IncrCounter(alloraMath.SdkIntToFloat32(minted), types.ModuleName, "minted_tokens")
+	}
+	totalSupply, lockedSupply, circulatingSupply := k.GetCirculatingSupply(ctx, params.MintDenom)
+	telemetry.ModuleSetGauge(types.ModuleName, alloraMath.SdkIntToFloat32(totalSupply), "supply", "total")
+	telemetry.ModuleSetGauge(types.ModuleName, alloraMath.SdkIntToFloat32(lockedSupply), "supply", "locked")
+	telemetry.ModuleSetGauge(types.ModuleName, alloraMath.SdkIntToFloat32(circulatingSupply), "supply", "circulating")
 }
 
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
+	// Pay out vested investor and team tokens first so the locked supply
+	// reflects the escrow balance after this block's unlocks
+	err = k.ReleaseVestedTokens(ctx, params.MintDenom)
+	if err != nil {
+		return err
+	}
 	// Get the balance of the "ecosystem" module account
 	ecosystemBalance, err := k.GetEcosystemBalance(ctx, params.MintDenom)
 	if err != nil {
@@ -131,40 +173,39 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	if err != nil {
 		return err
 	}
-	vPercentADec, err := k.GetValidatorsVsAlloraPercentReward(ctx)
-	if err != nil {
-		return err
-	}
-	vPercent := vPercentADec.SdkLegacyDec()
 	// every month on the first block of the month, update the emissions rate
 	if uint64(blockHeight)%blocksPerMonth == 1 { // easier to test when genesis starts at 1
-		emissionPerMonth, emissionPerUnitStakedToken, err := GetEmissionPerMonth(
+		newBlockEmission, emissionPerUnitStakedToken, err := GetBlockEmission(
 			sdkCtx,
 			k,
 			blocksPerMonth,
 			params,
 			ecosystemMintSupplyRemaining,
-			vPercent,
+			blockEmission,
 		)
 		if err != nil {
 			return err
 		}
-		// emission/block = (emission/month) / (block/month)
-		blockEmission = emissionPerMonth.
-			Quo(math.NewIntFromUint64(blocksPerMonth))
+		blockEmission = newBlockEmission
 		e_i = emissionPerUnitStakedToken
 		updateEmission = true
 		k.Logger(ctx).Info("Emissions Update",
+			"emissionCurve", params.EmissionCurve.CurveType.String(),
 			"emissionPerUnitStakedToken", e_i.String(),
-			"emissionPerMonth", emissionPerMonth.String(),
 			"blockEmission", blockEmission.String(),
 		)
-
 	}
+	// the emission paid this block, the ecosystem treasury never mints past its share of the max supply
+	payout := blockEmission
+	minted := math.ZeroInt()
 	// if the expected amount of emissions is greater than the balance of the ecosystem module account
-	if blockEmission.GT(ecosystemBalance) {
+	if payout.GT(ecosystemBalance) {
 		// mint the amount of tokens required to pay out the emissions
-		tokensToMint := blockEmission.Sub(ecosystemBalance)
+		tokensToMint := payout.Sub(ecosystemBalance)
+		if tokensToMint.GT(ecosystemMintSupplyRemaining) {
+			tokensToMint = math.MaxInt(ecosystemMintSupplyRemaining, math.ZeroInt())
+			payout = ecosystemBalance.Add(tokensToMint)
+		}
 		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, tokensToMint))
 		err = k.MintCoins(sdkCtx, coins)
 		if err != nil {
@@ -179,23 +220,16 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 		if err != nil {
 			return err
 		}
+		minted = tokensToMint
 	}
 	// pay out the computed block emissions from the ecosystem account
 	// if it came from collected fees, great, if it came from minting, also fine
-	// we pay both reputers and cosmos validators, so each payment should be
-	// half as big (divide by two). Integer division truncates, and that's fine.
-	validatorCut := vPercent.Mul(blockEmission.ToLegacyDec()).TruncateInt()
-	coinsValidator := sdk.NewCoins(sdk.NewCoin(params.MintDenom, validatorCut))
-	alloraRewardsCut := blockEmission.Sub(validatorCut)
-	coinsAlloraRewards := sdk.NewCoins(sdk.NewCoin(params.MintDenom, alloraRewardsCut))
-	err = k.PayValidatorsFromEcosystem(sdkCtx, coinsValidator)
-	if err != nil {
-		return err
-	}
-	err = k.PayAlloraRewardsFromEcosystem(sdkCtx, coinsAlloraRewards)
+	// each emission recipient gets its weighted share of the block emission
+	err = k.PayEmissionFromEcosystem(sdkCtx, params, payout)
 	if err != nil {
 		return err
 	}
+	setEmissionGauges(sdkCtx, k, params, payout, minted)
 	if updateEmission {
 		// set the previous emissions to this block's emissions
 		k.PreviousRewardEmissionPerUnitStakedToken.Set(ctx, e_i)
@@ -20,6 +20,55 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "inflation",
 					Short:     "Query the current minting inflation value",
 				},
+				{
+					RpcMethod: "VestingSchedules",
+					Use:       "vesting-schedules",
+					Short:     "Query all vesting schedules with their unlocked and remaining amounts",
+				},
+				{
+					RpcMethod:      "VestingSchedule",
+					Use:            "vesting-schedule [id]",
+					Short:          "Query a vesting schedule with its unlocked and remaining amounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "PreviousBlockEmission",
+					Use:       "previous-block-emission",
+					Short:     "Query the per block emission set at the last emission update",
+				},
+				{
+					RpcMethod: "PreviousRewardEmissionPerUnitStakedToken",
+					Use:       "previous-reward-emission-per-unit-staked-token",
+					Short:     "Query the monthly emission per unit staked token set at the last emission update",
+				},
+				{
+					RpcMethod: "EcosystemTokensMinted",
+					Use:       "ecosystem-tokens-minted",
+					Short:     "Query the number of tokens minted into the ecosystem treasury so far",
+				},
+				{
+					RpcMethod: "EcosystemMintSupplyRemaining",
+					Use:       "ecosystem-mint-supply-remaining",
+					Short:     "Query the number of tokens the ecosystem treasury may still mint",
+				},
+				{
+					RpcMethod: "Supply",
+					Use:       "supply",
+					Short:     "Query the total, locked and circulating token supply",
+				},
+				{
+					RpcMethod: "EmissionProjection",
+					Use:       "emission-projection [months]",
+					Short:     "Project the monthly emissions forward assuming constant stake and fee inflow",
+					Long:      "Project the monthly emissions forward. Staked tokens default to the current stake and the monthly fee inflow defaults to zero.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "months"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"staked_tokens":      {Usage: "number of staked tokens to assume"},
+						"monthly_fee_inflow": {Usage: "fees paid into the ecosystem treasury each month"},
+					},
+				},
 			},
 		},
 	}
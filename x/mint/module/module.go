@@ -21,7 +21,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/mint module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 5
 
 var (
 	_ module.AppModuleBasic = AppModule{}
@@ -81,6 +81,10 @@ type AppModule struct {
 
 	keeper     keeper.Keeper
 	authKeeper types.AccountKeeper
+
+	// accounts holding the investors and team buckets, used to seed their vesting schedules
+	investorsVestingAccount string
+	teamVestingAccount      string
 }
 
 // NewAppModule creates a new AppModule object. If the InflationCalculationFn
@@ -110,11 +114,20 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
-	m := keeper.NewMigrator(am.keeper)
+	m := keeper.NewMigrator(am.keeper).WithVestingAccounts(am.investorsVestingAccount, am.teamVestingAccount)
 
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the mint module. It returns
@@ -167,6 +180,7 @@ type ModuleInputs struct {
 	BankKeeper      types.BankKeeper
 	StakingKeeper   types.StakingKeeper
 	EmissionsKeeper types.EmissionsKeeper
+	DistrKeeper     types.DistributionKeeper
 }
 
 type ModuleOutputs struct {
@@ -189,11 +203,14 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.EmissionsKeeper,
+		in.DistrKeeper,
 		feeCollectorName,
 	)
 
 	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper)
+	m.investorsVestingAccount = in.Config.InvestorsVestingAccount
+	m.teamVestingAccount = in.Config.TeamVestingAccount
 
 	return ModuleOutputs{MintKeeper: k, Module: m}
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 
+	alloraMath "github.com/allora-network/allora-chain/math"
 	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
 	emissions "github.com/allora-network/allora-chain/x/emissions/module"
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
@@ -30,6 +31,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -50,6 +53,7 @@ type MintModuleTestSuite struct {
 	accountKeeper   types.AccountKeeper
 	stakingKeeper   types.StakingKeeper
 	bankKeeper      types.BankKeeper
+	distrKeeper     distrkeeper.Keeper
 	appModule       mint.AppModule
 	emissionsKeeper emissionskeeper.Keeper
 	mintKeeper      keeper.Keeper
@@ -70,6 +74,8 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"fee_collector":                         nil,
 		thirdParty:                              {"minter"},
 		"ecosystem":                             {"burner", "minter", "staking"},
+		types.VestingEscrowModuleName:           nil,
+		distrtypes.ModuleName:                   nil,
 		"mint":                                  {"minter"},
 		emissionstypes.AlloraRewardsAccountName: nil,
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: nil,
@@ -125,6 +131,18 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"fee_collector",
 	)
 
+	distrKeeper := distrkeeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	err := distrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool())
+	s.Require().NoError(err)
+
 	mintKeeper := keeper.NewKeeper(
 		encCfg.Codec,
 		storeService,
@@ -132,6 +150,7 @@ func (s *MintModuleTestSuite) SetupTest() {
 		accountKeeper,
 		bankKeeper,
 		emissionsKeeper,
+		distrKeeper,
 		authtypes.FeeCollectorName,
 	)
 
@@ -140,6 +159,7 @@ func (s *MintModuleTestSuite) SetupTest() {
 	s.bankKeeper = bankKeeper
 	s.stakingKeeper = stakingKeeper
 	s.emissionsKeeper = emissionsKeeper
+	s.distrKeeper = distrKeeper
 	s.mintKeeper = mintKeeper
 
 	emissionsModule := emissions.NewAppModule(encCfg.Codec, s.emissionsKeeper)
@@ -197,7 +217,6 @@ func (s *MintModuleTestSuite) TestTotalStakeGoUpTargetEmissionPerUnitStakeGoDown
 		blocksPerMonth,
 		params,
 		ecosystemMintSupplyRemaining,
-		cosmosMath.LegacyMustNewDecFromStr("0.25"),
 	)
 	s.Require().NoError(err)
 
@@ -218,7 +237,6 @@ func (s *MintModuleTestSuite) TestTotalStakeGoUpTargetEmissionPerUnitStakeGoDown
 		blocksPerMonth,
 		params,
 		ecosystemMintSupplyRemaining,
-		cosmosMath.LegacyMustNewDecFromStr("0.25"),
 	)
 	s.Require().NoError(err)
 
@@ -530,3 +548,260 @@ func (s *MintModuleTestSuite) TestInflationRateAsMorePeopleStakeGoesUp() {
 		ecosystemTokensMintedDelta1.String(),
 	)
 }
+
+func (s *MintModuleTestSuite) TestBeginBlockerReleasesVestedTokensFromEscrow() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	total := cosmosMath.NewInt(36_000_000)
+	coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, total))
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, thirdParty, coins))
+	s.Require().NoError(s.bankKeeper.SendCoinsFromModuleToModule(s.ctx, thirdParty, types.VestingEscrowModuleName, coins))
+
+	beneficiary := sdk.AccAddress(s.PKS[1].Address())
+	schedule := types.VestingSchedule{
+		Id:             0,
+		Beneficiary:    beneficiary.String(),
+		TotalAmount:    total,
+		ReleasedAmount: cosmosMath.ZeroInt(),
+		StartHeight:    0,
+		CliffBlocks:    12,
+		PeriodBlocks:   1,
+		DurationBlocks: 36,
+	}
+	s.Require().NoError(s.mintKeeper.VestingSchedules.Set(s.ctx, schedule.Id, schedule))
+	s.Require().Equal(total, s.mintKeeper.GetLockedTokenSupply(s.ctx, params.MintDenom))
+
+	s.ctx = s.ctx.WithBlockHeight(13)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+
+	unlocked := cosmosMath.NewInt(13_000_000)
+	s.Require().Equal(unlocked, s.bankKeeper.GetBalance(s.ctx, beneficiary, params.MintDenom).Amount)
+	s.Require().Equal(total.Sub(unlocked), s.mintKeeper.GetLockedTokenSupply(s.ctx, params.MintDenom))
+
+	s.ctx = s.ctx.WithBlockHeight(100)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+
+	s.Require().Equal(total, s.bankKeeper.GetBalance(s.ctx, beneficiary, params.MintDenom).Amount)
+	s.Require().True(s.mintKeeper.GetLockedTokenSupply(s.ctx, params.MintDenom).IsZero())
+}
+
+func (s *MintModuleTestSuite) TestEmissionProjectionFirstMonthMatchesEmissionUpdate() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	stake, ok := cosmosMath.NewIntFromString("300000000000000000000000000")
+	s.Require().True(ok)
+	err = s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	spareCoins, ok := cosmosMath.NewIntFromString("1000000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(params.MintDenom, spareCoins)))
+	s.Require().NoError(err)
+
+	emissionsParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	blocksPerMonth := emissionsParams.BlocksPerMonth
+	ecosystemMintSupplyRemaining, err := mint.GetEcosystemMintSupplyRemaining(s.ctx, s.mintKeeper, params)
+	s.Require().NoError(err)
+	emissionPerMonth, emissionPerUnitStakedToken, err := mint.GetEmissionPerMonth(
+		s.ctx,
+		s.mintKeeper,
+		blocksPerMonth,
+		params,
+		ecosystemMintSupplyRemaining,
+	)
+	s.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	res, err := queryServer.EmissionProjection(s.ctx, &types.QueryEmissionProjectionRequest{Months: 3})
+	s.Require().NoError(err)
+	s.Require().Len(res.Months, 3)
+	s.Require().Equal(stake, res.StakedTokens)
+
+	first := res.Months[0]
+	blockEmission := emissionPerMonth.Quo(cosmosMath.NewIntFromUint64(blocksPerMonth))
+	s.Require().Equal(int64(1), first.BlockHeight)
+	s.Require().True(emissionPerUnitStakedToken.Equal(first.EmissionPerUnitStakedToken))
+	s.Require().Equal(blockEmission.Mul(cosmosMath.NewIntFromUint64(blocksPerMonth)), first.Emission)
+	s.Require().Equal(first.Emission, first.ValidatorEmission.Add(first.ReputerEmission).Add(first.WorkerEmission))
+	// the ecosystem treasury is empty so the whole emission is minted
+	s.Require().Equal(first.Emission, first.Minted)
+	s.Require().Equal(spareCoins.Add(first.Minted), first.TotalSupply)
+	s.Require().Equal(ecosystemMintSupplyRemaining.Sub(first.Minted), first.EcosystemMintSupplyRemaining)
+
+	for i := 1; i < len(res.Months); i++ {
+		s.Require().Equal(int64(blocksPerMonth), res.Months[i].BlockHeight-res.Months[i-1].BlockHeight)
+		s.Require().Equal(res.Months[i-1].TotalSupply.Add(res.Months[i].Minted), res.Months[i].TotalSupply)
+	}
+}
+
+func (s *MintModuleTestSuite) TestEmissionProjectionFeesCoverEmission() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	spareCoins, ok := cosmosMath.NewIntFromString("1000000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(params.MintDenom, spareCoins)))
+	s.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	stake, ok := cosmosMath.NewIntFromString("300000000000000000000000000")
+	s.Require().True(ok)
+	res, err := queryServer.EmissionProjection(s.ctx, &types.QueryEmissionProjectionRequest{
+		Months:           2,
+		StakedTokens:     stake,
+		MonthlyFeeInflow: spareCoins,
+	})
+	s.Require().NoError(err)
+	for _, month := range res.Months {
+		s.Require().True(month.Emission.IsPositive())
+		s.Require().True(month.Minted.IsZero())
+		s.Require().Equal(spareCoins, month.TotalSupply)
+	}
+
+	_, err = queryServer.EmissionProjection(s.ctx, &types.QueryEmissionProjectionRequest{Months: 0})
+	s.Require().Error(err)
+	_, err = queryServer.EmissionProjection(s.ctx, &types.QueryEmissionProjectionRequest{Months: types.MaxEmissionProjectionMonths + 1})
+	s.Require().Error(err)
+}
+
+func (s *MintModuleTestSuite) TestSupplyQuery() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	total := cosmosMath.NewInt(1_000_000)
+	locked := cosmosMath.NewInt(400_000)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(params.MintDenom, total)))
+	s.Require().NoError(err)
+	err = s.bankKeeper.SendCoinsFromModuleToModule(
+		s.ctx,
+		thirdParty,
+		types.VestingEscrowModuleName,
+		sdk.NewCoins(sdk.NewCoin(params.MintDenom, locked)),
+	)
+	s.Require().NoError(err)
+
+	res, err := keeper.NewQueryServerImpl(s.mintKeeper).Supply(s.ctx, &types.QuerySupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params.MaxSupply, res.MaxSupply)
+	s.Require().Equal(total, res.TotalSupply)
+	s.Require().Equal(locked, res.LockedSupply)
+	s.Require().Equal(total.Sub(locked), res.CirculatingSupply)
+}
+
+func (s *MintModuleTestSuite) TestTopicRevenueBurnDoesNotFreeEcosystemMintSupply() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	emissionsParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	emissionsParams.TopicRevenueBurnFraction = alloraMath.MustNewDecFromString("0.4")
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, emissionsParams))
+
+	payer := sdk.AccAddress(s.PKS[0].Address())
+	fee := cosmosMath.NewInt(1_000_000)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(params.MintDenom, fee)))
+	s.Require().NoError(err)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(s.ctx, thirdParty, payer, sdk.NewCoins(sdk.NewCoin(params.MintDenom, fee)))
+	s.Require().NoError(err)
+
+	mintSupplyRemainingBefore, err := s.mintKeeper.GetEcosystemMintSupplyRemaining(s.ctx, params)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SendTopicRevenueToEcosystem(s.ctx, payer.String(), fee)
+	s.Require().NoError(err)
+
+	burned := cosmosMath.NewInt(400_000)
+	ecosystemBalance, err := s.mintKeeper.GetEcosystemBalance(s.ctx, params.MintDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(fee.Sub(burned), ecosystemBalance)
+
+	res, err := keeper.NewQueryServerImpl(s.mintKeeper).Supply(s.ctx, &types.QuerySupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(burned, res.BurnedTopicRevenue)
+	s.Require().Equal(fee.Sub(burned), res.TotalSupply)
+
+	// burning shrinks the supply but never lets the ecosystem mint more
+	mintSupplyRemainingAfter, err := s.mintKeeper.GetEcosystemMintSupplyRemaining(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(mintSupplyRemainingBefore, mintSupplyRemainingAfter)
+}
+
+func (s *MintModuleTestSuite) TestEmissionProjectionBurnsTopicRevenue() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	emissionsParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	emissionsParams.TopicRevenueBurnFraction = alloraMath.MustNewDecFromString("0.5")
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, emissionsParams))
+	spareCoins, ok := cosmosMath.NewIntFromString("1000000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(params.MintDenom, spareCoins)))
+	s.Require().NoError(err)
+
+	stake, ok := cosmosMath.NewIntFromString("300000000000000000000000000")
+	s.Require().True(ok)
+	feeInflow := spareCoins.QuoRaw(10)
+	res, err := keeper.NewQueryServerImpl(s.mintKeeper).EmissionProjection(s.ctx, &types.QueryEmissionProjectionRequest{
+		Months:           2,
+		StakedTokens:     stake,
+		MonthlyFeeInflow: feeInflow,
+	})
+	s.Require().NoError(err)
+	totalSupply := spareCoins
+	for _, month := range res.Months {
+		s.Require().Equal(feeInflow.QuoRaw(2), month.BurnedTopicRevenue)
+		totalSupply = totalSupply.Add(month.Minted).Sub(month.BurnedTopicRevenue)
+		s.Require().Equal(totalSupply, month.TotalSupply)
+	}
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerSwitchingToConstantCurveIsBounded() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.EmissionCurve.CurveType = types.EmissionCurveType_CONSTANT
+	params.EmissionCurve.ConstantBlockEmission = cosmosMath.NewInt(10_000)
+	params.EmissionCurve.MaxBlockEmissionChange = cosmosMath.LegacyMustNewDecFromStr("0.5")
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.mintKeeper.PreviousBlockEmission.Set(s.ctx, cosmosMath.NewInt(4_000)))
+
+	// emission update: the jump to the constant curve is halved by the bound
+	s.ctx = s.ctx.WithBlockHeight(1)
+	s.Require().NoError(mint.BeginBlocker(s.ctx, s.mintKeeper))
+	blockEmission, err := s.mintKeeper.PreviousBlockEmission.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(6_000), blockEmission)
+
+	// the next update keeps closing the gap
+	blocksPerMonth, err := s.mintKeeper.GetParamsBlocksPerMonth(s.ctx)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(int64(blocksPerMonth) + 1)
+	s.Require().NoError(mint.BeginBlocker(s.ctx, s.mintKeeper))
+	blockEmission, err = s.mintKeeper.PreviousBlockEmission.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(9_000), blockEmission)
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerNeverMintsPastEcosystemShareOfMaxSupply() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.EmissionCurve.CurveType = types.EmissionCurveType_CONSTANT
+	params.EmissionCurve.ConstantBlockEmission = cosmosMath.NewInt(10_000)
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	remaining, err := s.mintKeeper.GetEcosystemMintSupplyRemaining(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().NoError(s.mintKeeper.AddEcosystemTokensMinted(s.ctx, remaining.SubRaw(2_500)))
+
+	s.ctx = s.ctx.WithBlockHeight(1)
+	supplyBefore := s.bankKeeper.GetSupply(s.ctx, params.MintDenom).Amount
+	s.Require().NoError(mint.BeginBlocker(s.ctx, s.mintKeeper))
+	supplyAfter := s.bankKeeper.GetSupply(s.ctx, params.MintDenom).Amount
+	s.Require().Equal(cosmosMath.NewInt(2_500), supplyAfter.Sub(supplyBefore))
+
+	remaining, err = s.mintKeeper.GetEcosystemMintSupplyRemaining(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().True(remaining.IsZero())
+
+	// once the cap is reached nothing more is minted
+	s.ctx = s.ctx.WithBlockHeight(2)
+	s.Require().NoError(mint.BeginBlocker(s.ctx, s.mintKeeper))
+	s.Require().Equal(supplyAfter, s.bankKeeper.GetSupply(s.ctx, params.MintDenom).Amount)
+}
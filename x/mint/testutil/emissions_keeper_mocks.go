@@ -60,6 +60,19 @@ func (mr *MockEmissionsKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockEmissionsKeeper)(nil).GetParams), ctx)
 }
 
+func (m *MockEmissionsKeeper) GetTopicRevenueBurned(ctx context.Context) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopicRevenueBurned", ctx)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockEmissionsKeeperMockRecorder) GetTopicRevenueBurned(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopicRevenueBurned", reflect.TypeOf((*MockEmissionsKeeper)(nil).GetTopicRevenueBurned), ctx)
+}
+
 func (mr *MockEmissionsKeeperMockRecorder) GetParamsBlocksPerMonth(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParamsBlocksPerMonth", reflect.TypeOf((*MockEmissionsKeeper)(nil).GetParamsBlocksPerMonth), ctx)
@@ -86,14 +99,14 @@ func (m *MockEmissionsKeeper) GetPreviousPercentageRewardToStakedReputers(ctx co
 	return ret0, ret1
 }
 
-func (mr *MockEmissionsKeeperMockRecorder) IsWhitelistAdmin(ctx interface{}, admin interface{}) *gomock.Call {
+func (mr *MockEmissionsKeeperMockRecorder) HasRole(ctx interface{}, role interface{}, address interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWhitelistAdmin", reflect.TypeOf((*MockEmissionsKeeper)(nil).IsWhitelistAdmin), ctx, admin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasRole", reflect.TypeOf((*MockEmissionsKeeper)(nil).HasRole), ctx, role, address)
 }
 
-func (m *MockEmissionsKeeper) IsWhitelistAdmin(ctx context.Context, admin string) (bool, error) {
+func (m *MockEmissionsKeeper) HasRole(ctx context.Context, role string, address string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsWhitelistAdmin", ctx, admin)
+	ret := m.ctrl.Call(m, "HasRole", ctx, role, address)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
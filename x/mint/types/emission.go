@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/math"
+
+// SplitEmission divides an emission between the recipients according to their weights.
+// Each share is truncated and the last recipient receives the rounding remainder,
+// so the payouts always add up to the emission.
+func SplitEmission(emission math.Int, recipients []EmissionRecipient) []EmissionPayout {
+	payouts := make([]EmissionPayout, 0, len(recipients))
+	remaining := emission
+	for i, recipient := range recipients {
+		amount := recipient.Weight.MulInt(emission).TruncateInt()
+		if i == len(recipients)-1 {
+			amount = remaining
+		}
+		remaining = remaining.Sub(amount)
+		payouts = append(payouts, EmissionPayout{
+			RecipientType: recipient.RecipientType,
+			Address:       recipient.Address,
+			Amount:        amount,
+		})
+	}
+	return payouts
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultEmissionCurve keeps the target emission per unit staked token model,
+// with the block emission changing by at most 10% between monthly updates so
+// that a switch to another curve cannot jump the emission.
+func DefaultEmissionCurve() EmissionCurve {
+	return EmissionCurve{
+		CurveType:                   EmissionCurveType_TARGET_PER_STAKED_TOKEN,
+		HalvingInitialBlockEmission: math.ZeroInt(),
+		HalvingIntervalMonths:       48, // 4 years
+		ConstantBlockEmission:       math.ZeroInt(),
+		MaxBlockEmissionChange:      math.LegacyMustNewDecFromStr("0.1"),
+	}
+}
+
+// IsFixed returns whether the block emission only depends on the month,
+// as opposed to the state of the network.
+func (c EmissionCurve) IsFixed() bool {
+	return c.CurveType != EmissionCurveType_TARGET_PER_STAKED_TOKEN
+}
+
+// BlockEmission returns the block emission of a fixed curve for the given month since genesis.
+// The target per staked token curve is not fixed and always returns zero.
+func (c EmissionCurve) BlockEmission(month uint64) math.Int {
+	switch c.CurveType {
+	case EmissionCurveType_HALVING:
+		halvings := month / c.HalvingIntervalMonths
+		if halvings >= uint64(c.HalvingInitialBlockEmission.BigInt().BitLen()) {
+			return math.ZeroInt()
+		}
+		return math.NewIntFromBigInt(c.HalvingInitialBlockEmission.BigInt().Rsh(c.HalvingInitialBlockEmission.BigInt(), uint(halvings)))
+	case EmissionCurveType_CONSTANT:
+		return c.ConstantBlockEmission
+	case EmissionCurveType_PIECEWISE_LINEAR:
+		return c.interpolate(month)
+	default:
+		return math.ZeroInt()
+	}
+}
+
+// linear interpolation between the two points surrounding the month,
+// flat before the first point and after the last one
+func (c EmissionCurve) interpolate(month uint64) math.Int {
+	first := c.Points[0]
+	if month <= first.Month {
+		return first.BlockEmission
+	}
+	for i := 1; i < len(c.Points); i++ {
+		prev, next := c.Points[i-1], c.Points[i]
+		if month > next.Month {
+			continue
+		}
+		elapsed := math.NewIntFromUint64(month - prev.Month)
+		span := math.NewIntFromUint64(next.Month - prev.Month)
+		return prev.BlockEmission.Add(next.BlockEmission.Sub(prev.BlockEmission).Mul(elapsed).Quo(span))
+	}
+	return c.Points[len(c.Points)-1].BlockEmission
+}
+
+// BoundBlockEmissionChange limits the next block emission to within maxChange,
+// relative to the previous block emission. A zero maxChange or a zero previous
+// block emission leaves the next block emission untouched.
+func BoundBlockEmissionChange(previous, next math.Int, maxChange math.LegacyDec) math.Int {
+	if maxChange.IsZero() || !previous.IsPositive() {
+		return next
+	}
+	upper := math.LegacyOneDec().Add(maxChange).MulInt(previous).TruncateInt()
+	lower := math.ZeroInt()
+	if maxChange.LT(math.LegacyOneDec()) {
+		lower = math.LegacyOneDec().Sub(maxChange).MulInt(previous).Ceil().TruncateInt()
+	}
+	if next.GT(upper) {
+		return upper
+	}
+	if next.LT(lower) {
+		return lower
+	}
+	return next
+}
+
+// Validate checks the settings of the selected curve as well as the shared bound.
+func (c EmissionCurve) Validate() error {
+	if _, ok := EmissionCurveType_name[int32(c.CurveType)]; !ok {
+		return fmt.Errorf("unknown emission curve type: %d", c.CurveType)
+	}
+	if c.HalvingInitialBlockEmission.IsNil() || c.HalvingInitialBlockEmission.IsNegative() {
+		return fmt.Errorf("halving initial block emission cannot be nil or negative: %s", c.HalvingInitialBlockEmission)
+	}
+	if c.HalvingIntervalMonths == 0 {
+		return errors.New("halving interval must be at least one month")
+	}
+	if c.ConstantBlockEmission.IsNil() || c.ConstantBlockEmission.IsNegative() {
+		return fmt.Errorf("constant block emission cannot be nil or negative: %s", c.ConstantBlockEmission)
+	}
+	for i, point := range c.Points {
+		if point.BlockEmission.IsNil() || point.BlockEmission.IsNegative() {
+			return fmt.Errorf("emission curve point %d: block emission cannot be nil or negative: %s", i, point.BlockEmission)
+		}
+		if i > 0 && point.Month <= c.Points[i-1].Month {
+			return fmt.Errorf("emission curve point %d: months must be strictly increasing", i)
+		}
+	}
+	if c.MaxBlockEmissionChange.IsNil() || c.MaxBlockEmissionChange.IsNegative() {
+		return fmt.Errorf("max block emission change cannot be nil or negative: %s", c.MaxBlockEmissionChange)
+	}
+	switch c.CurveType {
+	case EmissionCurveType_HALVING:
+		if !c.HalvingInitialBlockEmission.IsPositive() {
+			return errors.New("halving curve needs a positive initial block emission")
+		}
+	case EmissionCurveType_PIECEWISE_LINEAR:
+		if len(c.Points) == 0 {
+			return errors.New("piecewise linear curve needs at least one point")
+		}
+	}
+	return nil
+}
@@ -8,4 +8,8 @@ var (
 	ErrInvalidPreviousRewardEmissionPerUnitStakedToken = errors.Register(ModuleName, 3, "invalid previous reward")
 	ErrInvalidEcosystemTokensMinted                    = errors.Register(ModuleName, 4, "invalid ecosystem tokens minted")
 	ErrZeroDenominator                                 = errors.Register(ModuleName, 5, "zero denominator")
+	ErrInvalidVestingSchedule                          = errors.Register(ModuleName, 6, "invalid vesting schedule")
+	ErrVestingScheduleNotFound                         = errors.Register(ModuleName, 7, "vesting schedule not found")
+	ErrVestingEscrowUnderfunded                        = errors.Register(ModuleName, 8, "vesting escrow account holds less than the locked amount")
+	ErrVestingAccountNotConfigured                     = errors.Register(ModuleName, 9, "no vesting account configured for a bucket with locked tokens")
 )
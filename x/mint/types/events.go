@@ -2,9 +2,16 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint           = ModuleName
+	EventTypeVestingRelease = "vesting_release"
+	EventTypeEmissionPayout = "emission_payout"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyScheduleId       = "schedule_id"
+	AttributeKeyBeneficiary      = "beneficiary"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyAddress          = "address"
 )
@@ -31,14 +31,22 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
+// DistributionKeeper defines the expected distribution keeper, used to fund the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 type EmissionsKeeper interface {
 	GetTotalStake(ctx context.Context) (math.Int, error)
+	GetTopicRevenueBurned(ctx context.Context) (math.Int, error)
 	GetPreviousPercentageRewardToStakedReputers(ctx context.Context) (alloraMath.Dec, error)
 	GetParams(ctx context.Context) (emissionstypes.Params, error)
-	IsWhitelistAdmin(ctx context.Context, admin string) (bool, error)
+	HasRole(ctx context.Context, role string, address string) (bool, error)
 }
@@ -1,6 +1,9 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
@@ -8,12 +11,14 @@ func NewGenesisState(
 	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
 	previousBlockEmission math.Int,
 	ecosystemTokensMinted math.Int,
+	vestingSchedules []VestingSchedule,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                                   params,
 		PreviousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
 		PreviousBlockEmission:                    previousBlockEmission,
 		EcosystemTokensMinted:                    ecosystemTokensMinted,
+		VestingSchedules:                         vestingSchedules,
 	}
 }
 
@@ -24,6 +29,7 @@ func DefaultGenesisState() *GenesisState {
 		PreviousRewardEmissionPerUnitStakedToken: DefaultPreviousRewardEmissionPerUnitStakedToken(),
 		PreviousBlockEmission:                    DefaultPreviousBlockEmission(),
 		EcosystemTokensMinted:                    DefaultEcosystemTokensMinted(),
+		VestingSchedules:                         []VestingSchedule{},
 	}
 }
 
@@ -42,5 +48,16 @@ func ValidateGenesis(data GenesisState) error {
 		return ErrInvalidEcosystemTokensMinted
 	}
 
+	seenIds := make(map[uint64]bool, len(data.VestingSchedules))
+	for _, schedule := range data.VestingSchedules {
+		if seenIds[schedule.Id] {
+			return errors.Wrapf(ErrInvalidVestingSchedule, "duplicate schedule id %d", schedule.Id)
+		}
+		seenIds[schedule.Id] = true
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -35,6 +35,8 @@ type GenesisState struct {
 	PreviousBlockEmission                    cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=previous_block_emission,json=previousBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"previous_block_emission"`
 	// number of tokens minted into the ecosystem treasury
 	EcosystemTokensMinted cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_tokens_minted"`
+	// investor and team vesting schedules paid out of the vesting escrow account
+	VestingSchedules []VestingSchedule `protobuf:"bytes,5,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +79,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mint.v1beta1.GenesisState")
 }
@@ -84,34 +93,36 @@ func init() {
 func init() { proto.RegisterFile("mint/v1beta1/genesis.proto", fileDescriptor_1dfa75836a5d5f23) }
 
 var fileDescriptor_1dfa75836a5d5f23 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x6b, 0x14, 0x41,
-	0x14, 0xc7, 0x6f, 0x8c, 0x04, 0xb2, 0xa6, 0xd0, 0x23, 0xc1, 0xf5, 0x84, 0x4d, 0xb0, 0x3a, 0x84,
-	0x9b, 0x31, 0xa6, 0xb0, 0xb1, 0x3a, 0x14, 0x09, 0x18, 0x08, 0x89, 0x36, 0x36, 0xc3, 0xdc, 0xde,
-	0x63, 0x6f, 0xd8, 0x9b, 0x79, 0xcb, 0xcc, 0xbb, 0xc4, 0xfb, 0x16, 0x7e, 0x02, 0x6b, 0x4b, 0x8b,
-	0x94, 0x7e, 0x80, 0x94, 0x21, 0x95, 0x58, 0x04, 0xb9, 0x2b, 0xfc, 0x1a, 0xb2, 0x33, 0x73, 0x87,
-	0x62, 0x67, 0xb3, 0xec, 0xcc, 0xff, 0xbd, 0xff, 0xef, 0xff, 0x86, 0x97, 0xf5, 0x8c, 0xb6, 0x24,
-	0xce, 0x0f, 0x46, 0x40, 0xea, 0x40, 0x54, 0x60, 0xc1, 0x6b, 0xcf, 0x1b, 0x87, 0x84, 0xdd, 0xed,
-	0x56, 0xe3, 0x49, 0xeb, 0xed, 0x54, 0x58, 0x61, 0x10, 0x44, 0xfb, 0x17, 0x6b, 0x7a, 0xf9, 0x5f,
-	0xfd, 0x34, 0x6f, 0x20, 0x75, 0xf7, 0x1e, 0x95, 0xe8, 0x0d, 0x7a, 0x19, 0x5b, 0xe2, 0x21, 0x49,
-	0x0f, 0x94, 0xd1, 0x16, 0x45, 0xf8, 0xc6, 0xab, 0x27, 0xdf, 0x36, 0xb2, 0xed, 0x37, 0x91, 0x7e,
-	0x46, 0x8a, 0xa0, 0xfb, 0x22, 0xdb, 0x6c, 0x94, 0x53, 0xc6, 0xe7, 0x6c, 0x9f, 0xf5, 0xef, 0x3d,
-	0xdf, 0xe1, 0x7f, 0xa6, 0xe1, 0x27, 0x41, 0x1b, 0x6e, 0x5d, 0xdd, 0xee, 0x75, 0xbe, 0xfc, 0xfa,
-	0xfa, 0x94, 0x9d, 0xa6, 0xf2, 0xee, 0x67, 0x96, 0xf1, 0xc6, 0xc1, 0xb9, 0xc6, 0x99, 0x97, 0x0e,
-	0x2e, 0x94, 0x1b, 0x4b, 0x30, 0xda, 0x7b, 0x8d, 0x56, 0x36, 0xe0, 0xe4, 0xcc, 0x6a, 0x92, 0x9e,
-	0x54, 0x0d, 0x63, 0x49, 0x58, 0x83, 0xcd, 0xef, 0xec, 0xb3, 0xfe, 0xd6, 0xf0, 0x65, 0xeb, 0xf5,
-	0xe3, 0x76, 0xef, 0x71, 0xcc, 0xea, 0xc7, 0x35, 0xd7, 0x28, 0x8c, 0xa2, 0x09, 0x7f, 0x0b, 0x95,
-	0x2a, 0xe7, 0xaf, 0xa0, 0xbc, 0xb9, 0x1c, 0xdc, 0x4f, 0xa3, 0xac, 0xef, 0x22, 0xbe, 0xbf, 0x62,
-	0x9e, 0x06, 0xe4, 0xeb, 0x44, 0x3c, 0x01, 0xf7, 0xde, 0x6a, 0x3a, 0x0b, 0xb8, 0x77, 0x2d, 0xad,
-	0x3b, 0xc9, 0x1e, 0xae, 0xf3, 0x8d, 0xa6, 0x58, 0xd6, 0xeb, 0x78, 0xf9, 0x46, 0x08, 0xf2, 0x2c,
-	0x05, 0xd9, 0xfd, 0x37, 0xc8, 0x91, 0xa5, 0x9b, 0xcb, 0x41, 0x96, 0x22, 0x1c, 0x59, 0x8a, 0xf0,
-	0xdd, 0x95, 0xe1, 0xb0, 0xf5, 0x5b, 0xb1, 0x5b, 0x12, 0x94, 0xe8, 0xe7, 0x9e, 0xc0, 0xc4, 0x51,
-	0xbd, 0x6c, 0x5f, 0x11, 0xc6, 0xf9, 0xdd, 0xff, 0x25, 0xad, 0x0d, 0xc3, 0x30, 0xfe, 0x38, 0xd8,
-	0x0d, 0x8f, 0xaf, 0x16, 0x05, 0xbb, 0x5e, 0x14, 0xec, 0xe7, 0xa2, 0x60, 0x9f, 0x96, 0x45, 0xe7,
-	0x7a, 0x59, 0x74, 0xbe, 0x2f, 0x8b, 0xce, 0x87, 0xc3, 0x4a, 0xd3, 0x64, 0x36, 0xe2, 0x25, 0x1a,
-	0xa1, 0xa6, 0x53, 0x74, 0x6a, 0x60, 0x81, 0x2e, 0xd0, 0xd5, 0xab, 0x63, 0x39, 0x51, 0xda, 0x8a,
-	0x8f, 0x22, 0x6c, 0x52, 0xd8, 0xa0, 0xd1, 0x66, 0x58, 0x8a, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x0b, 0x9a, 0x69, 0x9e, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x6b, 0xdb, 0x40,
+	0x14, 0xc7, 0xad, 0x26, 0x0d, 0x44, 0xc9, 0x90, 0x88, 0x84, 0xaa, 0x2e, 0x55, 0x4c, 0x27, 0x53,
+	0xb0, 0xd4, 0x24, 0x43, 0x97, 0x4e, 0xa6, 0xa5, 0x04, 0x1a, 0x08, 0x71, 0xd3, 0xa1, 0xcb, 0x71,
+	0x96, 0x1e, 0xd2, 0x21, 0xeb, 0x4e, 0xdc, 0x7b, 0x76, 0xea, 0x6f, 0x51, 0xe8, 0xde, 0xb9, 0x63,
+	0x87, 0x7c, 0x88, 0x8c, 0x21, 0x53, 0xe9, 0x10, 0x8a, 0x3d, 0xf4, 0x6b, 0x14, 0xdd, 0x9d, 0x4d,
+	0x4c, 0xb7, 0x2c, 0xc6, 0x77, 0xff, 0xf7, 0xfe, 0xbf, 0xff, 0xd3, 0x3d, 0xbf, 0x5d, 0x09, 0x49,
+	0xc9, 0xe4, 0x70, 0x08, 0xc4, 0x0f, 0x93, 0x1c, 0x24, 0xa0, 0xc0, 0xb8, 0xd6, 0x8a, 0x54, 0xb0,
+	0xdd, 0x68, 0xb1, 0xd3, 0xda, 0x7b, 0xb9, 0xca, 0x95, 0x11, 0x92, 0xe6, 0x9f, 0xad, 0x69, 0x87,
+	0x2b, 0xfd, 0x34, 0xad, 0xc1, 0x75, 0xb7, 0x9f, 0xa6, 0x0a, 0x2b, 0x85, 0xcc, 0xb6, 0xd8, 0x83,
+	0x93, 0x76, 0x79, 0x25, 0xa4, 0x4a, 0xcc, 0xaf, 0xbd, 0x7a, 0xf1, 0x6d, 0xdd, 0xdf, 0x7e, 0x6f,
+	0xe9, 0x03, 0xe2, 0x04, 0xc1, 0x6b, 0x7f, 0xa3, 0xe6, 0x9a, 0x57, 0x18, 0x7a, 0x1d, 0xaf, 0xbb,
+	0x75, 0xb4, 0x17, 0xdf, 0x4f, 0x13, 0x9f, 0x19, 0xad, 0xbf, 0x79, 0x7d, 0x77, 0xd0, 0xfa, 0xf1,
+	0xf7, 0xe7, 0x4b, 0xef, 0xdc, 0x95, 0x07, 0xdf, 0x3d, 0x3f, 0xae, 0x35, 0x4c, 0x84, 0x1a, 0x23,
+	0xd3, 0x70, 0xc9, 0x75, 0xc6, 0xa0, 0x12, 0x88, 0x42, 0x49, 0x56, 0x83, 0x66, 0x63, 0x29, 0x88,
+	0x21, 0xf1, 0x12, 0x32, 0x46, 0xaa, 0x04, 0x19, 0x3e, 0xea, 0x78, 0xdd, 0xcd, 0xfe, 0x9b, 0xc6,
+	0xeb, 0xf7, 0xdd, 0xc1, 0x33, 0x9b, 0x15, 0xb3, 0x32, 0x16, 0x2a, 0xa9, 0x38, 0x15, 0xf1, 0x07,
+	0xc8, 0x79, 0x3a, 0x7d, 0x0b, 0xe9, 0xed, 0x55, 0x6f, 0xc7, 0x8d, 0xb2, 0xbc, 0xb3, 0xf8, 0xee,
+	0x82, 0x79, 0x6e, 0x90, 0xef, 0x1c, 0xf1, 0x0c, 0xf4, 0x85, 0x14, 0x34, 0x30, 0xb8, 0x8f, 0x0d,
+	0x2d, 0x28, 0xfc, 0x27, 0xcb, 0x7c, 0xc3, 0x91, 0x4a, 0xcb, 0x65, 0xbc, 0x70, 0xcd, 0x04, 0x79,
+	0xe5, 0x82, 0xec, 0xff, 0x1f, 0xe4, 0x44, 0xd2, 0xed, 0x55, 0xcf, 0x77, 0x11, 0x4e, 0x24, 0x59,
+	0xf8, 0xfe, 0xc2, 0xb0, 0xdf, 0xf8, 0x2d, 0xd8, 0x0d, 0x09, 0x52, 0x85, 0x53, 0x24, 0xa8, 0xec,
+	0xa8, 0xc8, 0x9a, 0xaf, 0x08, 0x59, 0xb8, 0xfe, 0x50, 0xd2, 0xd2, 0xd0, 0x0c, 0x83, 0xa7, 0xc6,
+	0x2e, 0xb8, 0xf0, 0x77, 0x27, 0x80, 0x24, 0x64, 0xce, 0x30, 0x2d, 0x20, 0x1b, 0x8f, 0x00, 0xc3,
+	0xc7, 0x9d, 0xb5, 0xee, 0xd6, 0xd1, 0xf3, 0xd5, 0x87, 0xfb, 0x64, 0xcb, 0x06, 0xae, 0xea, 0xfe,
+	0x0b, 0xee, 0x4c, 0x56, 0x35, 0xec, 0x9f, 0x5e, 0xcf, 0x22, 0xef, 0x66, 0x16, 0x79, 0x7f, 0x66,
+	0x91, 0xf7, 0x75, 0x1e, 0xb5, 0x6e, 0xe6, 0x51, 0xeb, 0xd7, 0x3c, 0x6a, 0x7d, 0x3e, 0xce, 0x05,
+	0x15, 0xe3, 0x61, 0x9c, 0xaa, 0x2a, 0xe1, 0xa3, 0x91, 0xd2, 0xbc, 0x27, 0x81, 0x2e, 0x95, 0x2e,
+	0x17, 0xc7, 0xb4, 0xe0, 0x42, 0x26, 0x5f, 0x12, 0xb3, 0xa0, 0x66, 0x31, 0x87, 0x1b, 0x66, 0xd7,
+	0x8e, 0xff, 0x0d, 0x00, 0x52, 0x3e, 0x78, 0x3d, 0xf5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +145,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.EcosystemTokensMinted.Size()
 		i -= size
@@ -202,6 +227,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.EcosystemTokensMinted.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -375,6 +406,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
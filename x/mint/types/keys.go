@@ -11,6 +11,7 @@ var (
 	PreviousRewardEmissionPerUnitStakedTokenKey = collections.NewPrefix(139)
 	PreviousBlockEmissionKey                    = collections.NewPrefix(140)
 	EcosystemTokensMintedKey                    = collections.NewPrefix(141)
+	VestingSchedulesKey                         = collections.NewPrefix(142)
 )
 
 const (
@@ -18,6 +19,11 @@ const (
 	ModuleName = "mint"
 	// ecosystem module account name
 	EcosystemModuleName = "ecosystem"
+	// vesting escrow module account name, holds the locked investor and team tokens
+	VestingEscrowModuleName = "vesting_escrow"
+
+	// maximum number of months an emission projection may cover
+	MaxEmissionProjectionMonths = 120
 
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
@@ -22,6 +22,8 @@ func NewParams(
 	investorsPercentOfTotalSupply math.LegacyDec,
 	teamPercentOfTotalSupply math.LegacyDec,
 	maxMonthlyPercentageYield math.LegacyDec,
+	emissionRecipients []EmissionRecipient,
+	emissionCurve EmissionCurve,
 ) Params {
 	return Params{
 		MintDenom:                              mintDenom,
@@ -34,6 +36,8 @@ func NewParams(
 		InvestorsPercentOfTotalSupply:          investorsPercentOfTotalSupply,
 		TeamPercentOfTotalSupply:               teamPercentOfTotalSupply,
 		MaximumMonthlyPercentageYield:          maxMonthlyPercentageYield,
+		EmissionRecipients:                     emissionRecipients,
+		EmissionCurve:                          emissionCurve,
 	}
 }
 
@@ -54,9 +58,38 @@ func DefaultParams() Params {
 		InvestorsPercentOfTotalSupply:          math.LegacyMustNewDecFromStr("0.3105"), // 31.05%
 		TeamPercentOfTotalSupply:               math.LegacyMustNewDecFromStr("0.175"),  // 17.5%
 		MaximumMonthlyPercentageYield:          math.LegacyMustNewDecFromStr("0.0095"), // .95% per month
+		EmissionRecipients:                     DefaultEmissionRecipients(),
+		EmissionCurve:                          DefaultEmissionCurve(),
 	}
 }
 
+// DefaultEmissionRecipients pays 25% of the block emission to cosmos validators
+// and the rest to reputers and workers.
+func DefaultEmissionRecipients() []EmissionRecipient {
+	return TwoWayEmissionRecipients(math.LegacyMustNewDecFromStr("0.25"))
+}
+
+// TwoWayEmissionRecipients splits the block emission between cosmos validators,
+// who get validatorsPercent of it, and reputers and workers, who get the rest.
+func TwoWayEmissionRecipients(validatorsPercent math.LegacyDec) []EmissionRecipient {
+	return []EmissionRecipient{
+		{RecipientType: EmissionRecipientType_VALIDATORS, Weight: validatorsPercent},
+		{RecipientType: EmissionRecipientType_ALLORA_REWARDS, Weight: math.LegacyOneDec().Sub(validatorsPercent)},
+	}
+}
+
+// EmissionRecipientWeight returns the share of the block emission paid to the given
+// kind of recipient, zero if it is not a recipient.
+func (p Params) EmissionRecipientWeight(recipientType EmissionRecipientType) math.LegacyDec {
+	weight := math.LegacyZeroDec()
+	for _, recipient := range p.EmissionRecipients {
+		if recipient.RecipientType == recipientType {
+			weight = weight.Add(recipient.Weight)
+		}
+	}
+	return weight
+}
+
 // Default previous emission per token is zero
 func DefaultPreviousRewardEmissionPerUnitStakedToken() math.LegacyDec {
 	return math.ZeroInt().ToLegacyDec()
@@ -113,6 +146,12 @@ func (p Params) Validate() error {
 	if err := validateAFractionValue(p.MaximumMonthlyPercentageYield); err != nil {
 		return err
 	}
+	if err := ValidateEmissionRecipients(p.EmissionRecipients); err != nil {
+		return err
+	}
+	if err := p.EmissionCurve.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -192,3 +231,36 @@ func validateTokenSupplyAddsTo100Percent(
 	}
 	return nil
 }
+
+// ValidateEmissionRecipients checks the recipients of the block emission and their weights.
+func ValidateEmissionRecipients(recipients []EmissionRecipient) error {
+	if len(recipients) == 0 {
+		return errors.New("emission recipients cannot be empty")
+	}
+	seen := make(map[EmissionRecipientType]bool, len(recipients))
+	total := math.LegacyZeroDec()
+	for _, recipient := range recipients {
+		if _, ok := EmissionRecipientType_name[int32(recipient.RecipientType)]; !ok {
+			return fmt.Errorf("unknown emission recipient type: %d", recipient.RecipientType)
+		}
+		if seen[recipient.RecipientType] {
+			return fmt.Errorf("duplicate emission recipient: %s", recipient.RecipientType)
+		}
+		seen[recipient.RecipientType] = true
+		if recipient.RecipientType == EmissionRecipientType_FOUNDATION {
+			if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+				return fmt.Errorf("invalid foundation address %q: %w", recipient.Address, err)
+			}
+		} else if recipient.Address != "" {
+			return fmt.Errorf("emission recipient %s cannot have an address", recipient.RecipientType)
+		}
+		if err := validateAFractionValue(recipient.Weight); err != nil {
+			return err
+		}
+		total = total.Add(recipient.Weight)
+	}
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("emission recipient weights must sum to one: %s", total)
+	}
+	return nil
+}
@@ -192,363 +192,4861 @@ func (m *QueryInflationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "mint.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "mint.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "mint.v1beta1.QueryInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "mint.v1beta1.QueryInflationResponse")
+// VestingScheduleStatus is a vesting schedule together with its state at the
+// current block height.
+type VestingScheduleStatus struct {
+	Schedule VestingSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// amount of tokens unlocked so far
+	UnlockedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=unlocked_amount,json=unlockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"unlocked_amount"`
+	// amount of tokens still locked
+	RemainingAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_amount"`
 }
 
-func init() { proto.RegisterFile("mint/v1beta1/query.proto", fileDescriptor_b0718dda172d2cb4) }
-
-var fileDescriptor_b0718dda172d2cb4 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x3f, 0xcb, 0xd3, 0x40,
-	0x18, 0x4f, 0x04, 0x0b, 0x3d, 0xdf, 0xc5, 0x33, 0xbe, 0x6f, 0x1b, 0x4b, 0x5a, 0xa3, 0x83, 0x08,
-	0xcd, 0xd1, 0x16, 0x74, 0x2f, 0x5d, 0x04, 0x15, 0x2d, 0x4e, 0x2e, 0x72, 0x8d, 0x67, 0x7a, 0x34,
-	0xb9, 0x27, 0xcd, 0x5d, 0xad, 0x5d, 0xfd, 0x04, 0x82, 0x5f, 0xc2, 0xd1, 0xc1, 0x0f, 0xd1, 0xb1,
-	0xe8, 0x22, 0x0e, 0x45, 0x5a, 0xc1, 0xc9, 0xef, 0x20, 0xb9, 0x4b, 0xaa, 0xa9, 0xd2, 0x25, 0xe4,
-	0x9e, 0xdf, 0x8f, 0xdf, 0x9f, 0xbb, 0x07, 0x35, 0x12, 0x2e, 0x14, 0x79, 0xdd, 0x9b, 0x30, 0x45,
-	0x7b, 0x64, 0xbe, 0x60, 0xd9, 0x2a, 0x48, 0x33, 0x50, 0x80, 0xcf, 0x72, 0x24, 0x28, 0x10, 0xd7,
-	0x89, 0x20, 0x02, 0x0d, 0x90, 0xfc, 0xcf, 0x70, 0xdc, 0x56, 0x04, 0x10, 0xc5, 0x8c, 0xd0, 0x94,
-	0x13, 0x2a, 0x04, 0x28, 0xaa, 0x38, 0x08, 0x59, 0xa0, 0x55, 0x6d, 0xb5, 0x4a, 0x59, 0x89, 0x5c,
-	0xa5, 0x09, 0x17, 0x40, 0xf4, 0xb7, 0x18, 0x35, 0x43, 0x90, 0x09, 0xc8, 0x17, 0xc6, 0xc3, 0x1c,
-	0x0c, 0xe4, 0x3b, 0x08, 0x3f, 0xcd, 0x83, 0x3d, 0xa1, 0x19, 0x4d, 0xe4, 0x98, 0xcd, 0x17, 0x4c,
-	0x2a, 0xff, 0x31, 0xba, 0x56, 0x99, 0xca, 0x14, 0x84, 0x64, 0xf8, 0x3e, 0xaa, 0xa5, 0x7a, 0xd2,
-	0xb0, 0x3b, 0xf6, 0x9d, 0x2b, 0x7d, 0x27, 0xf8, 0xbb, 0x47, 0x60, 0xd8, 0xc3, 0xfa, 0x7a, 0xdb,
-	0xb6, 0x3e, 0xfc, 0xfc, 0x78, 0xd7, 0x1e, 0x17, 0x74, 0xff, 0x02, 0x5d, 0xd7, 0x7a, 0x0f, 0xc4,
-	0xab, 0x58, 0xd7, 0x28, 0x8d, 0x04, 0x3a, 0x3f, 0x06, 0x0a, 0xaf, 0x67, 0xa8, 0xce, 0xcb, 0xa1,
-	0xb6, 0x3b, 0x1b, 0xde, 0xcb, 0x85, 0xbf, 0x6d, 0xdb, 0x37, 0x4c, 0x03, 0xf9, 0x72, 0x16, 0x70,
-	0x20, 0x09, 0x55, 0xd3, 0xe0, 0x21, 0x8b, 0x68, 0xb8, 0x1a, 0xb1, 0xf0, 0xf3, 0xa7, 0x2e, 0x2a,
-	0x0a, 0x8e, 0x58, 0x68, 0x52, 0xfc, 0x11, 0xea, 0xff, 0xb2, 0xd1, 0x65, 0x6d, 0x88, 0x67, 0xa8,
-	0x66, 0xf2, 0xe2, 0x4e, 0xb5, 0xc5, 0xbf, 0xd7, 0xe1, 0xde, 0x3c, 0xc1, 0x30, 0x71, 0xfd, 0xd6,
-	0xdb, 0x2f, 0x3f, 0xde, 0x5f, 0x3a, 0xc7, 0x0e, 0xa9, 0x3c, 0x8c, 0xe9, 0x8f, 0x97, 0xa8, 0x7e,
-	0x68, 0x88, 0x6f, 0xfd, 0x47, 0xed, 0xf8, 0x62, 0xdc, 0xdb, 0xa7, 0x49, 0x85, 0x6b, 0x5b, 0xbb,
-	0x36, 0xf1, 0x45, 0xd5, 0xf5, 0xd0, 0x77, 0xf8, 0x68, 0xbd, 0xf3, 0xec, 0xcd, 0xce, 0xb3, 0xbf,
-	0xef, 0x3c, 0xfb, 0xdd, 0xde, 0xb3, 0x36, 0x7b, 0xcf, 0xfa, 0xba, 0xf7, 0xac, 0xe7, 0x83, 0x88,
-	0xab, 0xe9, 0x62, 0x12, 0x84, 0x90, 0x10, 0x1a, 0xc7, 0x90, 0xd1, 0xae, 0x60, 0x6a, 0x09, 0xd9,
-	0xac, 0x3c, 0x86, 0x53, 0xca, 0x05, 0x79, 0x63, 0xa4, 0xf5, 0x86, 0x4d, 0x6a, 0x7a, 0x69, 0x06,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x9b, 0x57, 0x31, 0xda, 0x02, 0x00, 0x00,
+func (m *VestingScheduleStatus) Reset()         { *m = VestingScheduleStatus{} }
+func (m *VestingScheduleStatus) String() string { return proto.CompactTextString(m) }
+func (*VestingScheduleStatus) ProtoMessage()    {}
+func (*VestingScheduleStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+func (m *VestingScheduleStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *VestingScheduleStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingScheduleStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *VestingScheduleStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingScheduleStatus.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VestingScheduleStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingScheduleStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingScheduleStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
-	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Inflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VestingScheduleStatus proto.InternalMessageInfo
+
+func (m *VestingScheduleStatus) GetSchedule() VestingSchedule {
+	if m != nil {
+		return m.Schedule
 	}
-	return out, nil
+	return VestingSchedule{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+// QueryVestingSchedulesRequest is the request type for the Query/VestingSchedules RPC method.
+type QueryVestingSchedulesRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVestingSchedulesRequest) Reset()         { *m = QueryVestingSchedulesRequest{} }
+func (m *QueryVestingSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSchedulesRequest) ProtoMessage()    {}
+func (*QueryVestingSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{5}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVestingSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+func (m *QueryVestingSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSchedulesRequest.Merge(m, src)
+}
+func (m *QueryVestingSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSchedulesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryVestingSchedulesRequest proto.InternalMessageInfo
+
+// QueryVestingSchedulesResponse is the response type for the Query/VestingSchedules RPC method.
+type QueryVestingSchedulesResponse struct {
+	Schedules []VestingScheduleStatus `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// total amount of tokens held by the vesting escrow account
+	LockedSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked_supply,json=lockedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"locked_supply"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mint.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryVestingSchedulesResponse) Reset()         { *m = QueryVestingSchedulesResponse{} }
+func (m *QueryVestingSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSchedulesResponse) ProtoMessage()    {}
+func (*QueryVestingSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{6}
+}
+func (m *QueryVestingSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVestingSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSchedulesResponse.Merge(m, src)
+}
+func (m *QueryVestingSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSchedulesResponse.DiscardUnknown(m)
 }
 
-func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Inflation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mint.v1beta1.Query/Inflation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+var xxx_messageInfo_QueryVestingSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryVestingSchedulesResponse) GetSchedules() []VestingScheduleStatus {
+	if m != nil {
+		return m.Schedules
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Inflation",
-			Handler:    _Query_Inflation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mint/v1beta1/query.proto",
+// QueryVestingScheduleRequest is the request type for the Query/VestingSchedule RPC method.
+type QueryVestingScheduleRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVestingScheduleRequest) Reset()         { *m = QueryVestingScheduleRequest{} }
+func (m *QueryVestingScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingScheduleRequest) ProtoMessage()    {}
+func (*QueryVestingScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{7}
+}
+func (m *QueryVestingScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVestingScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingScheduleRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVestingScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingScheduleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryVestingScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVestingScheduleResponse is the response type for the Query/VestingSchedule RPC method.
+type QueryVestingScheduleResponse struct {
+	Schedule VestingScheduleStatus `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVestingScheduleResponse) Reset()         { *m = QueryVestingScheduleResponse{} }
+func (m *QueryVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingScheduleResponse) ProtoMessage()    {}
+func (*QueryVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{8}
+}
+func (m *QueryVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingScheduleResponse.Merge(m, src)
 }
-
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryVestingScheduleResponse) GetSchedule() VestingScheduleStatus {
+	if m != nil {
+		return m.Schedule
 	}
-	return dAtA[:n], nil
+	return VestingScheduleStatus{}
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPreviousBlockEmissionRequest is the request type for the Query/PreviousBlockEmission RPC method.
+type QueryPreviousBlockEmissionRequest struct {
 }
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryPreviousBlockEmissionRequest) Reset()         { *m = QueryPreviousBlockEmissionRequest{} }
+func (m *QueryPreviousBlockEmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviousBlockEmissionRequest) ProtoMessage()    {}
+func (*QueryPreviousBlockEmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{9}
+}
+func (m *QueryPreviousBlockEmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviousBlockEmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviousBlockEmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPreviousBlockEmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviousBlockEmissionRequest.Merge(m, src)
+}
+func (m *QueryPreviousBlockEmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviousBlockEmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviousBlockEmissionRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryPreviousBlockEmissionRequest proto.InternalMessageInfo
+
+// QueryPreviousBlockEmissionResponse is the response type for the Query/PreviousBlockEmission RPC method.
+type QueryPreviousBlockEmissionResponse struct {
+	BlockEmission cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=block_emission,json=blockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"block_emission"`
+}
+
+func (m *QueryPreviousBlockEmissionResponse) Reset()         { *m = QueryPreviousBlockEmissionResponse{} }
+func (m *QueryPreviousBlockEmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviousBlockEmissionResponse) ProtoMessage()    {}
+func (*QueryPreviousBlockEmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{10}
+}
+func (m *QueryPreviousBlockEmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviousBlockEmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviousBlockEmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPreviousBlockEmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviousBlockEmissionResponse.Merge(m, src)
+}
+func (m *QueryPreviousBlockEmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviousBlockEmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviousBlockEmissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreviousBlockEmissionResponse proto.InternalMessageInfo
+
+// QueryPreviousRewardEmissionPerUnitStakedTokenRequest is the request type for the
+// Query/PreviousRewardEmissionPerUnitStakedToken RPC method.
+type QueryPreviousRewardEmissionPerUnitStakedTokenRequest struct {
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) Reset() {
+	*m = QueryPreviousRewardEmissionPerUnitStakedTokenRequest{}
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPreviousRewardEmissionPerUnitStakedTokenRequest) ProtoMessage() {}
+func (*QueryPreviousRewardEmissionPerUnitStakedTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{11}
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenRequest.Merge(m, src)
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenRequest proto.InternalMessageInfo
+
+// QueryPreviousRewardEmissionPerUnitStakedTokenResponse is the response type for the
+// Query/PreviousRewardEmissionPerUnitStakedToken RPC method.
+type QueryPreviousRewardEmissionPerUnitStakedTokenResponse struct {
+	RewardEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=reward_emission_per_unit_staked_token,json=rewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_emission_per_unit_staked_token"`
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) Reset() {
+	*m = QueryPreviousRewardEmissionPerUnitStakedTokenResponse{}
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse) ProtoMessage() {}
+func (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{12}
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenResponse.Merge(m, src)
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPreviousRewardEmissionPerUnitStakedTokenResponse proto.InternalMessageInfo
+
+// QueryEcosystemTokensMintedRequest is the request type for the Query/EcosystemTokensMinted RPC method.
+type QueryEcosystemTokensMintedRequest struct {
+}
+
+func (m *QueryEcosystemTokensMintedRequest) Reset()         { *m = QueryEcosystemTokensMintedRequest{} }
+func (m *QueryEcosystemTokensMintedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEcosystemTokensMintedRequest) ProtoMessage()    {}
+func (*QueryEcosystemTokensMintedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{13}
+}
+func (m *QueryEcosystemTokensMintedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEcosystemTokensMintedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEcosystemTokensMintedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEcosystemTokensMintedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEcosystemTokensMintedRequest.Merge(m, src)
+}
+func (m *QueryEcosystemTokensMintedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEcosystemTokensMintedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEcosystemTokensMintedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEcosystemTokensMintedRequest proto.InternalMessageInfo
+
+// QueryEcosystemTokensMintedResponse is the response type for the Query/EcosystemTokensMinted RPC method.
+type QueryEcosystemTokensMintedResponse struct {
+	EcosystemTokensMinted cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_tokens_minted"`
+}
+
+func (m *QueryEcosystemTokensMintedResponse) Reset()         { *m = QueryEcosystemTokensMintedResponse{} }
+func (m *QueryEcosystemTokensMintedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEcosystemTokensMintedResponse) ProtoMessage()    {}
+func (*QueryEcosystemTokensMintedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{14}
+}
+func (m *QueryEcosystemTokensMintedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEcosystemTokensMintedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEcosystemTokensMintedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryEcosystemTokensMintedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEcosystemTokensMintedResponse.Merge(m, src)
+}
+func (m *QueryEcosystemTokensMintedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEcosystemTokensMintedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEcosystemTokensMintedResponse.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_QueryEcosystemTokensMintedResponse proto.InternalMessageInfo
+
+// QueryEcosystemMintSupplyRemainingRequest is the request type for the
+// Query/EcosystemMintSupplyRemaining RPC method.
+type QueryEcosystemMintSupplyRemainingRequest struct {
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryEcosystemMintSupplyRemainingRequest) Reset() {
+	*m = QueryEcosystemMintSupplyRemainingRequest{}
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+func (m *QueryEcosystemMintSupplyRemainingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEcosystemMintSupplyRemainingRequest) ProtoMessage()    {}
+func (*QueryEcosystemMintSupplyRemainingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{15}
+}
+func (m *QueryEcosystemMintSupplyRemainingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEcosystemMintSupplyRemainingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEcosystemMintSupplyRemainingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEcosystemMintSupplyRemainingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEcosystemMintSupplyRemainingRequest.Merge(m, src)
+}
+func (m *QueryEcosystemMintSupplyRemainingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEcosystemMintSupplyRemainingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEcosystemMintSupplyRemainingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEcosystemMintSupplyRemainingRequest proto.InternalMessageInfo
+
+// QueryEcosystemMintSupplyRemainingResponse is the response type for the
+// Query/EcosystemMintSupplyRemaining RPC method.
+type QueryEcosystemMintSupplyRemainingResponse struct {
+	EcosystemMintSupplyRemaining cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_supply_remaining"`
+}
+
+func (m *QueryEcosystemMintSupplyRemainingResponse) Reset() {
+	*m = QueryEcosystemMintSupplyRemainingResponse{}
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryEcosystemMintSupplyRemainingResponse) ProtoMessage() {}
+func (*QueryEcosystemMintSupplyRemainingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{16}
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEcosystemMintSupplyRemainingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEcosystemMintSupplyRemainingResponse.Merge(m, src)
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEcosystemMintSupplyRemainingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEcosystemMintSupplyRemainingResponse proto.InternalMessageInfo
+
+// QuerySupplyRequest is the request type for the Query/Supply RPC method.
+type QuerySupplyRequest struct {
+}
+
+func (m *QuerySupplyRequest) Reset()         { *m = QuerySupplyRequest{} }
+func (m *QuerySupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyRequest) ProtoMessage()    {}
+func (*QuerySupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{17}
+}
+func (m *QuerySupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyRequest.Merge(m, src)
+}
+func (m *QuerySupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyRequest proto.InternalMessageInfo
+
+// QuerySupplyResponse is the response type for the Query/Supply RPC method.
+type QuerySupplyResponse struct {
+	// maximum number of tokens that may ever exist
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// number of tokens that currently exist
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// tokens held in the vesting escrow
+	LockedSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=locked_supply,json=lockedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"locked_supply"`
+	// total supply minus locked supply
+	CirculatingSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=circulating_supply,json=circulatingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"circulating_supply"`
+	// cumulative topic revenue burned. burned tokens no longer count towards the total supply,
+	// but do not free up room under the max supply for the ecosystem treasury to mint
+	BurnedTopicRevenue cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=burned_topic_revenue,json=burnedTopicRevenue,proto3,customtype=cosmossdk.io/math.Int" json:"burned_topic_revenue"`
+}
+
+func (m *QuerySupplyResponse) Reset()         { *m = QuerySupplyResponse{} }
+func (m *QuerySupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyResponse) ProtoMessage()    {}
+func (*QuerySupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{18}
+}
+func (m *QuerySupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyResponse.Merge(m, src)
+}
+func (m *QuerySupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyResponse proto.InternalMessageInfo
+
+// QueryEmissionProjectionRequest is the request type for the Query/EmissionProjection RPC method.
+type QueryEmissionProjectionRequest struct {
+	// number of months to project, starting with the next emission update
+	Months uint64 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	// number of tokens staked by validators and reputers, assumed constant.
+	// defaults to the amount currently staked
+	StakedTokens cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=staked_tokens,json=stakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"staked_tokens"`
+	// fees paid into the ecosystem treasury each month, assumed constant.
+	// defaults to zero. the topic revenue burn fraction is applied before the fees reach the treasury
+	MonthlyFeeInflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=monthly_fee_inflow,json=monthlyFeeInflow,proto3,customtype=cosmossdk.io/math.Int" json:"monthly_fee_inflow"`
+}
+
+func (m *QueryEmissionProjectionRequest) Reset()         { *m = QueryEmissionProjectionRequest{} }
+func (m *QueryEmissionProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionProjectionRequest) ProtoMessage()    {}
+func (*QueryEmissionProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{19}
+}
+func (m *QueryEmissionProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionProjectionRequest.Merge(m, src)
+}
+func (m *QueryEmissionProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionProjectionRequest) GetMonths() uint64 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+// EmissionProjectionMonth is the projected outcome of a single monthly emission update.
+type EmissionProjectionMonth struct {
+	// month index, starting at 1
+	Month uint64 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// block height at which the emission update happens
+	BlockHeight                int64                       `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	EmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=emission_per_unit_staked_token,json=emissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_per_unit_staked_token"`
+	// total tokens emitted during the month
+	Emission cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=emission,proto3,customtype=cosmossdk.io/math.Int" json:"emission"`
+	// part of the emission paid to cosmos validators
+	ValidatorEmission cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=validator_emission,json=validatorEmission,proto3,customtype=cosmossdk.io/math.Int" json:"validator_emission"`
+	// part of the emission paid to reputers
+	ReputerEmission cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=reputer_emission,json=reputerEmission,proto3,customtype=cosmossdk.io/math.Int" json:"reputer_emission"`
+	// part of the emission paid to inferers and forecasters
+	WorkerEmission cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=worker_emission,json=workerEmission,proto3,customtype=cosmossdk.io/math.Int" json:"worker_emission"`
+	// tokens minted because the treasury balance did not cover the emission
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// total supply at the end of the month
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// supply still locked in vesting schedules at the emission update
+	LockedSupply cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=locked_supply,json=lockedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"locked_supply"`
+	// supply in circulation at the emission update
+	CirculatingSupply cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=circulating_supply,json=circulatingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"circulating_supply"`
+	// tokens the ecosystem treasury may still mint at the end of the month
+	EcosystemMintSupplyRemaining cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_supply_remaining"`
+	// emission paid to each recipient during the month
+	Payouts []EmissionPayout `protobuf:"bytes,13,rep,name=payouts,proto3" json:"payouts"`
+	// share of the month's fee inflow burned on its way into the ecosystem treasury
+	BurnedTopicRevenue cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=burned_topic_revenue,json=burnedTopicRevenue,proto3,customtype=cosmossdk.io/math.Int" json:"burned_topic_revenue"`
+}
+
+func (m *EmissionProjectionMonth) Reset()         { *m = EmissionProjectionMonth{} }
+func (m *EmissionProjectionMonth) String() string { return proto.CompactTextString(m) }
+func (*EmissionProjectionMonth) ProtoMessage()    {}
+func (*EmissionProjectionMonth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{20}
+}
+func (m *EmissionProjectionMonth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionProjectionMonth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionProjectionMonth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionProjectionMonth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionProjectionMonth.Merge(m, src)
+}
+func (m *EmissionProjectionMonth) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionProjectionMonth) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionProjectionMonth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionProjectionMonth proto.InternalMessageInfo
+
+func (m *EmissionProjectionMonth) GetMonth() uint64 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *EmissionProjectionMonth) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EmissionProjectionMonth) GetPayouts() []EmissionPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+// EmissionPayout is the amount of the emission paid to a recipient
+type EmissionPayout struct {
+	RecipientType EmissionRecipientType `protobuf:"varint,1,opt,name=recipient_type,json=recipientType,proto3,enum=mint.v1beta1.EmissionRecipientType" json:"recipient_type,omitempty"`
+	Address       string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EmissionPayout) Reset()         { *m = EmissionPayout{} }
+func (m *EmissionPayout) String() string { return proto.CompactTextString(m) }
+func (*EmissionPayout) ProtoMessage()    {}
+func (*EmissionPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{21}
+}
+func (m *EmissionPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionPayout.Merge(m, src)
+}
+func (m *EmissionPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionPayout proto.InternalMessageInfo
+
+func (m *EmissionPayout) GetRecipientType() EmissionRecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return EmissionRecipientType_VALIDATORS
+}
+
+func (m *EmissionPayout) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryEmissionProjectionResponse is the response type for the Query/EmissionProjection RPC method.
+type QueryEmissionProjectionResponse struct {
+	// assumed number of staked tokens
+	StakedTokens cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=staked_tokens,json=stakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"staked_tokens"`
+	// assumed monthly fee inflow
+	MonthlyFeeInflow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=monthly_fee_inflow,json=monthlyFeeInflow,proto3,customtype=cosmossdk.io/math.Int" json:"monthly_fee_inflow"`
+	// share of the emission paid to validators
+	ValidatorsPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=validators_percent,json=validatorsPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_percent"`
+	// share of the non-validator emission paid to reputers
+	ReputersPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=reputers_percent,json=reputersPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reputers_percent"`
+	Months          []EmissionProjectionMonth   `protobuf:"bytes,5,rep,name=months,proto3" json:"months"`
+}
+
+func (m *QueryEmissionProjectionResponse) Reset()         { *m = QueryEmissionProjectionResponse{} }
+func (m *QueryEmissionProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionProjectionResponse) ProtoMessage()    {}
+func (*QueryEmissionProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{22}
+}
+func (m *QueryEmissionProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionProjectionResponse.Merge(m, src)
+}
+func (m *QueryEmissionProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionProjectionResponse) GetMonths() []EmissionProjectionMonth {
+	if m != nil {
+		return m.Months
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "mint.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "mint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "mint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*VestingScheduleStatus)(nil), "mint.v1beta1.VestingScheduleStatus")
+	proto.RegisterType((*QueryVestingSchedulesRequest)(nil), "mint.v1beta1.QueryVestingSchedulesRequest")
+	proto.RegisterType((*QueryVestingSchedulesResponse)(nil), "mint.v1beta1.QueryVestingSchedulesResponse")
+	proto.RegisterType((*QueryVestingScheduleRequest)(nil), "mint.v1beta1.QueryVestingScheduleRequest")
+	proto.RegisterType((*QueryVestingScheduleResponse)(nil), "mint.v1beta1.QueryVestingScheduleResponse")
+	proto.RegisterType((*QueryPreviousBlockEmissionRequest)(nil), "mint.v1beta1.QueryPreviousBlockEmissionRequest")
+	proto.RegisterType((*QueryPreviousBlockEmissionResponse)(nil), "mint.v1beta1.QueryPreviousBlockEmissionResponse")
+	proto.RegisterType((*QueryPreviousRewardEmissionPerUnitStakedTokenRequest)(nil), "mint.v1beta1.QueryPreviousRewardEmissionPerUnitStakedTokenRequest")
+	proto.RegisterType((*QueryPreviousRewardEmissionPerUnitStakedTokenResponse)(nil), "mint.v1beta1.QueryPreviousRewardEmissionPerUnitStakedTokenResponse")
+	proto.RegisterType((*QueryEcosystemTokensMintedRequest)(nil), "mint.v1beta1.QueryEcosystemTokensMintedRequest")
+	proto.RegisterType((*QueryEcosystemTokensMintedResponse)(nil), "mint.v1beta1.QueryEcosystemTokensMintedResponse")
+	proto.RegisterType((*QueryEcosystemMintSupplyRemainingRequest)(nil), "mint.v1beta1.QueryEcosystemMintSupplyRemainingRequest")
+	proto.RegisterType((*QueryEcosystemMintSupplyRemainingResponse)(nil), "mint.v1beta1.QueryEcosystemMintSupplyRemainingResponse")
+	proto.RegisterType((*QuerySupplyRequest)(nil), "mint.v1beta1.QuerySupplyRequest")
+	proto.RegisterType((*QuerySupplyResponse)(nil), "mint.v1beta1.QuerySupplyResponse")
+	proto.RegisterType((*QueryEmissionProjectionRequest)(nil), "mint.v1beta1.QueryEmissionProjectionRequest")
+	proto.RegisterType((*EmissionProjectionMonth)(nil), "mint.v1beta1.EmissionProjectionMonth")
+	proto.RegisterType((*EmissionPayout)(nil), "mint.v1beta1.EmissionPayout")
+	proto.RegisterType((*QueryEmissionProjectionResponse)(nil), "mint.v1beta1.QueryEmissionProjectionResponse")
+}
+
+func init() { proto.RegisterFile("mint/v1beta1/query.proto", fileDescriptor_b0718dda172d2cb4) }
+
+var fileDescriptor_b0718dda172d2cb4 = []byte{
+	// 1575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0xc6, 0x24, 0xe0, 0x13, 0xe7, 0xc2, 0x90, 0x10, 0x63, 0x82, 0x93, 0x2c, 0x50, 0x42,
+	0x20, 0x59, 0x08, 0x05, 0x5e, 0x50, 0x25, 0xd2, 0x50, 0x11, 0x04, 0x6d, 0xea, 0x84, 0x56, 0xb4,
+	0x52, 0x57, 0xeb, 0xf5, 0x60, 0x2f, 0xf6, 0xee, 0x9a, 0x9d, 0xd9, 0x04, 0x0b, 0x21, 0x55, 0x7d,
+	0x6f, 0x55, 0xa9, 0x97, 0x5f, 0xd0, 0x4a, 0xed, 0x5b, 0xa5, 0xf2, 0x13, 0xaa, 0x96, 0xb7, 0x22,
+	0xfa, 0xd0, 0x8a, 0x07, 0x54, 0x41, 0xab, 0xfe, 0x85, 0xf6, 0xad, 0xda, 0x99, 0xd9, 0xf5, 0xae,
+	0x2f, 0x1b, 0x67, 0x09, 0x2f, 0x96, 0x77, 0xe6, 0xcc, 0x77, 0xbe, 0x6f, 0x2e, 0xe7, 0x9c, 0x19,
+	0xc8, 0x9a, 0x86, 0x45, 0x95, 0xcd, 0xb3, 0x45, 0x4c, 0xb5, 0xb3, 0xca, 0x5d, 0x17, 0x3b, 0x8d,
+	0xc5, 0xba, 0x63, 0x53, 0x1b, 0x65, 0xbc, 0x9e, 0x45, 0xd1, 0x93, 0x1b, 0x2f, 0xdb, 0x65, 0x9b,
+	0x75, 0x28, 0xde, 0x3f, 0x6e, 0x93, 0x9b, 0x2a, 0xdb, 0x76, 0xb9, 0x86, 0x15, 0xad, 0x6e, 0x28,
+	0x9a, 0x65, 0xd9, 0x54, 0xa3, 0x86, 0x6d, 0x11, 0xd1, 0x1b, 0xc5, 0xa6, 0x8d, 0x3a, 0xf6, 0x7b,
+	0xf6, 0x6b, 0xa6, 0x61, 0xd9, 0x0a, 0xfb, 0x15, 0x4d, 0x87, 0x74, 0x9b, 0x98, 0x36, 0x51, 0xb9,
+	0x0f, 0xfe, 0xc1, 0xbb, 0xe4, 0x71, 0x40, 0xef, 0x7a, 0xc4, 0xd6, 0x34, 0x47, 0x33, 0x49, 0x01,
+	0xdf, 0x75, 0x31, 0xa1, 0xf2, 0xdb, 0x70, 0x20, 0xd2, 0x4a, 0xea, 0xb6, 0x45, 0x30, 0xba, 0x08,
+	0x83, 0x75, 0xd6, 0x92, 0x95, 0x66, 0xa4, 0xb9, 0xa1, 0xa5, 0xf1, 0xc5, 0xb0, 0x8e, 0x45, 0x6e,
+	0xbd, 0x9c, 0x7e, 0xf4, 0x6c, 0xba, 0xef, 0xbb, 0x7f, 0x7e, 0x98, 0x97, 0x0a, 0xc2, 0x5c, 0x9e,
+	0x84, 0x09, 0x86, 0xb7, 0x6a, 0xdd, 0xae, 0x31, 0x19, 0xbe, 0x23, 0x0b, 0x0e, 0xb6, 0x76, 0x08,
+	0x5f, 0x1b, 0x90, 0x36, 0xfc, 0x46, 0xe6, 0x2e, 0xb3, 0x7c, 0xc1, 0x03, 0x7e, 0xfa, 0x6c, 0xfa,
+	0x30, 0x57, 0x40, 0x4a, 0xd5, 0x45, 0xc3, 0x56, 0x4c, 0x8d, 0x56, 0x16, 0xaf, 0xe3, 0xb2, 0xa6,
+	0x37, 0x56, 0xb0, 0xfe, 0xe4, 0xe1, 0x02, 0x08, 0x81, 0x2b, 0x58, 0xe7, 0x2c, 0x9a, 0x40, 0xf2,
+	0x57, 0xfd, 0x30, 0xf1, 0x1e, 0x26, 0xd4, 0xb0, 0xca, 0xeb, 0x7a, 0x05, 0x97, 0xdc, 0x1a, 0x5e,
+	0xa7, 0x1a, 0x75, 0x09, 0x5a, 0x81, 0x7d, 0x44, 0xb4, 0x08, 0x75, 0x47, 0xa2, 0xea, 0x5a, 0x86,
+	0x85, 0x65, 0x06, 0x23, 0xd1, 0x2d, 0x18, 0x75, 0xad, 0x9a, 0xad, 0x57, 0x71, 0x49, 0xd5, 0x4c,
+	0xdb, 0xb5, 0x68, 0xb6, 0x7f, 0x46, 0x9a, 0x4b, 0x2f, 0x9f, 0x11, 0xdc, 0x27, 0xda, 0xb9, 0xaf,
+	0x5a, 0x34, 0xc4, 0x7a, 0xd5, 0xa2, 0x1c, 0x74, 0xc4, 0x07, 0xba, 0xcc, 0x70, 0xd0, 0x87, 0x30,
+	0xe6, 0x60, 0x53, 0x33, 0x2c, 0xc3, 0x2a, 0xfb, 0xd8, 0xa9, 0x84, 0xd8, 0xa3, 0x01, 0x12, 0x07,
+	0x97, 0xf3, 0x30, 0xc5, 0xd6, 0xa1, 0x45, 0x64, 0xb0, 0x21, 0x7e, 0x92, 0xe0, 0x48, 0x17, 0x03,
+	0xb1, 0x5e, 0xd7, 0x21, 0xed, 0xcf, 0x82, 0xb7, 0x3d, 0x52, 0x73, 0x43, 0x4b, 0x47, 0x63, 0x27,
+	0x90, 0xcf, 0x7b, 0x78, 0x1a, 0x9b, 0x00, 0xe8, 0x26, 0x0c, 0x8b, 0x59, 0x24, 0x6e, 0xbd, 0x5e,
+	0x6b, 0x24, 0x9e, 0xc5, 0x0c, 0x87, 0x59, 0x67, 0x28, 0xf2, 0x02, 0x1c, 0xee, 0xa4, 0x42, 0xa8,
+	0x44, 0x23, 0xd0, 0x6f, 0x94, 0xd8, 0xea, 0xef, 0x29, 0xf4, 0x1b, 0x25, 0xf9, 0x4e, 0xe7, 0x59,
+	0x09, 0x34, 0x5f, 0x6b, 0xdb, 0x33, 0x3b, 0x95, 0x1c, 0x8c, 0x97, 0x8f, 0xc2, 0x2c, 0x3f, 0x72,
+	0x0e, 0xde, 0x34, 0x6c, 0x97, 0x2c, 0x7b, 0xc4, 0xaf, 0x98, 0x06, 0x21, 0xa1, 0xe3, 0xf2, 0x00,
+	0xe4, 0x38, 0x23, 0x41, 0xeb, 0x7d, 0x18, 0x29, 0x7a, 0x1d, 0x2a, 0x16, 0x3d, 0x59, 0x29, 0xe1,
+	0xec, 0x0d, 0x17, 0xc3, 0x0e, 0xe4, 0x0b, 0xf0, 0x7a, 0xc4, 0x7d, 0x01, 0x6f, 0x69, 0x4e, 0xc9,
+	0xef, 0x5e, 0xc3, 0xce, 0x4d, 0xcb, 0xa0, 0xeb, 0x54, 0xab, 0xe2, 0xd2, 0x86, 0x5d, 0xc5, 0x01,
+	0xed, 0x5f, 0x24, 0x38, 0xbf, 0xc3, 0x81, 0x42, 0xca, 0xa7, 0x12, 0x1c, 0x77, 0x98, 0x71, 0x20,
+	0x46, 0xad, 0x63, 0x47, 0x75, 0x2d, 0x83, 0xaa, 0x84, 0x0d, 0x50, 0xa9, 0x37, 0x42, 0x48, 0xbc,
+	0xd4, 0x5b, 0x88, 0x18, 0x13, 0x42, 0x83, 0x36, 0x2e, 0x77, 0xc6, 0xd9, 0x86, 0x57, 0xb0, 0x4a,
+	0x57, 0x74, 0x9b, 0x34, 0x08, 0xc5, 0x26, 0x6b, 0x26, 0x37, 0x0c, 0x8b, 0xe2, 0x92, 0x2f, 0xf7,
+	0x33, 0x09, 0xe4, 0x38, 0x2b, 0xa1, 0xad, 0x02, 0x93, 0xd8, 0x37, 0xe0, 0x22, 0x88, 0x6a, 0x32,
+	0x93, 0xc4, 0xeb, 0x35, 0x81, 0x3b, 0x79, 0x94, 0xe7, 0x61, 0x2e, 0xca, 0xc7, 0x6b, 0xe7, 0x47,
+	0xa2, 0xe0, 0x07, 0x02, 0x9f, 0xfc, 0x37, 0x12, 0x9c, 0xec, 0xc1, 0x58, 0x68, 0xd8, 0x82, 0xe9,
+	0xa6, 0x06, 0x8f, 0xbc, 0x38, 0xaf, 0x6a, 0x10, 0x60, 0x12, 0x6b, 0x99, 0xc2, 0x31, 0x04, 0x82,
+	0xbc, 0xe5, 0xb7, 0x73, 0xf2, 0xbf, 0xa7, 0xe0, 0x40, 0xa4, 0x59, 0xd0, 0x7c, 0x07, 0xc0, 0xd4,
+	0xee, 0xf9, 0xb1, 0x24, 0x29, 0xa3, 0xb4, 0xa9, 0xdd, 0xe3, 0xc0, 0x68, 0x1d, 0x32, 0xd4, 0xa6,
+	0x5a, 0xed, 0x65, 0xc3, 0xd3, 0x10, 0x43, 0x11, 0xa0, 0x6d, 0x41, 0x2f, 0xb5, 0x1b, 0x41, 0x0f,
+	0xa9, 0x80, 0x74, 0xc3, 0xd1, 0x5d, 0x2f, 0x05, 0x5a, 0x65, 0x1f, 0x7b, 0x4f, 0x42, 0xec, 0xfd,
+	0x21, 0x2c, 0xe1, 0xa0, 0x08, 0xe3, 0x45, 0xd7, 0xb1, 0xd8, 0x51, 0xac, 0x1b, 0xba, 0xea, 0xe0,
+	0x4d, 0x6c, 0xb9, 0x38, 0x3b, 0x90, 0xd0, 0x05, 0xe2, 0x68, 0x1b, 0x1e, 0x58, 0x81, 0x63, 0xc9,
+	0x7f, 0x4b, 0x90, 0xe7, 0xdb, 0xd2, 0x3f, 0x9c, 0x8e, 0x7d, 0x07, 0xeb, 0xa1, 0x5a, 0x02, 0x1d,
+	0x84, 0x41, 0xd3, 0xb6, 0x68, 0x85, 0x88, 0x08, 0x2e, 0xbe, 0xd0, 0x1a, 0x0c, 0x87, 0x23, 0x05,
+	0x11, 0x8b, 0x75, 0x6a, 0x07, 0xbc, 0x0a, 0x19, 0xd2, 0x0c, 0x02, 0x04, 0xdd, 0x02, 0xc4, 0xb0,
+	0x6b, 0x0d, 0xf5, 0x36, 0xc6, 0xaa, 0x57, 0x5e, 0xd8, 0x5b, 0xd9, 0xd4, 0xce, 0x61, 0xc7, 0x04,
+	0xcc, 0x5b, 0x18, 0xaf, 0x32, 0x10, 0xf9, 0xc7, 0x34, 0x4c, 0xb6, 0x4b, 0xbc, 0xe1, 0x99, 0xa1,
+	0x71, 0x18, 0x60, 0xf6, 0x42, 0x1f, 0xff, 0x40, 0xb3, 0x90, 0xe1, 0xd1, 0xbe, 0x82, 0x8d, 0x72,
+	0x85, 0xd7, 0x1b, 0xa9, 0xc2, 0x10, 0x6b, 0xbb, 0xca, 0x9a, 0xd0, 0xc7, 0x12, 0xe4, 0xb7, 0x09,
+	0x9f, 0xa9, 0x5d, 0x08, 0x9f, 0x39, 0xdc, 0x35, 0x70, 0xa2, 0xeb, 0xb0, 0x2f, 0xc8, 0x46, 0x49,
+	0xb7, 0x5e, 0x80, 0xe0, 0x6d, 0xe9, 0x4d, 0xad, 0x66, 0x94, 0x34, 0x6a, 0x3b, 0xcd, 0x2c, 0x97,
+	0x74, 0xbf, 0xed, 0x0f, 0xb0, 0xfc, 0x99, 0xe7, 0xc5, 0x56, 0xdd, 0xa5, 0x38, 0x04, 0x3f, 0x98,
+	0xbc, 0xd8, 0x62, 0x48, 0x01, 0xf8, 0x2d, 0x18, 0xdd, 0xb2, 0x9d, 0x6a, 0x18, 0x7b, 0x6f, 0xd2,
+	0x22, 0x91, 0x03, 0x05, 0xd0, 0x57, 0x61, 0x50, 0xa4, 0x90, 0x7d, 0x09, 0x11, 0xc5, 0xf8, 0xb6,
+	0x08, 0x97, 0x7e, 0x25, 0x11, 0x0e, 0x5e, 0x61, 0x84, 0x1b, 0xda, 0xbd, 0x08, 0xd7, 0x43, 0x9a,
+	0xcb, 0xbc, 0x8a, 0x34, 0x87, 0x2e, 0xc3, 0xde, 0xba, 0xd6, 0xb0, 0x5d, 0x4a, 0xb2, 0xc3, 0xac,
+	0xa6, 0x9e, 0x8a, 0x16, 0x98, 0x41, 0xa8, 0x60, 0x46, 0xe1, 0xca, 0xd2, 0x1f, 0xd7, 0x35, 0x3a,
+	0x8f, 0xec, 0x62, 0x74, 0x7e, 0x2a, 0xc1, 0x48, 0x94, 0x0a, 0xba, 0x06, 0x23, 0x0e, 0xd6, 0x8d,
+	0xba, 0x81, 0x2d, 0xaa, 0x7a, 0xf7, 0x53, 0x16, 0xb5, 0x46, 0x5a, 0x2b, 0xe4, 0x66, 0xf1, 0x2a,
+	0x6c, 0x37, 0x1a, 0x75, 0x5c, 0x18, 0x76, 0xc2, 0x9f, 0x68, 0x09, 0xf6, 0x6a, 0xa5, 0x92, 0x83,
+	0x89, 0x1f, 0xbb, 0xb3, 0x4f, 0x1e, 0x2e, 0x8c, 0x0b, 0x62, 0x97, 0x79, 0xcf, 0x3a, 0x75, 0xbc,
+	0xc2, 0xc4, 0x37, 0xf4, 0x4e, 0xc2, 0x4b, 0x5e, 0x92, 0xc4, 0x78, 0xf9, 0xdf, 0x14, 0x4c, 0x77,
+	0x4d, 0x3d, 0xa2, 0xc0, 0xb8, 0xd9, 0x9a, 0x63, 0x92, 0xd6, 0x18, 0xd1, 0x44, 0xf3, 0x51, 0xc7,
+	0x44, 0x93, 0xb4, 0xd8, 0x68, 0xcb, 0x36, 0xa8, 0x1a, 0x8a, 0xa3, 0xc4, 0xcb, 0x0c, 0x3a, 0xb6,
+	0xe8, 0xae, 0xe4, 0x82, 0x66, 0x4c, 0x25, 0x6b, 0x1c, 0x16, 0x95, 0x83, 0x98, 0xda, 0x74, 0xb5,
+	0x67, 0x17, 0x5c, 0xf9, 0xf1, 0x35, 0x70, 0x74, 0x35, 0x28, 0x04, 0x06, 0xd8, 0x99, 0x39, 0xde,
+	0xe5, 0xcc, 0x44, 0xd3, 0x6b, 0xe4, 0xdd, 0x82, 0x8f, 0x5f, 0xfa, 0x35, 0x03, 0x03, 0x6c, 0xe9,
+	0x51, 0x15, 0x06, 0xf9, 0xf3, 0x06, 0x9a, 0x89, 0xa2, 0xb5, 0xbf, 0x9e, 0xe4, 0x66, 0x63, 0x2c,
+	0xf8, 0x7e, 0x91, 0xa7, 0x3e, 0xf9, 0xed, 0xaf, 0x2f, 0xfa, 0x0f, 0xa2, 0x71, 0x25, 0xf2, 0x8e,
+	0xc3, 0x9f, 0x4b, 0xd0, 0x16, 0xa4, 0x83, 0x07, 0x11, 0x74, 0xb4, 0x03, 0x5a, 0xeb, 0x3b, 0x4a,
+	0xee, 0x58, 0xbc, 0x91, 0xf0, 0x3a, 0xcd, 0xbc, 0x1e, 0x42, 0x93, 0x51, 0xaf, 0xc1, 0xf3, 0x08,
+	0xfa, 0x52, 0x82, 0xb1, 0xd6, 0x1b, 0x3e, 0x9a, 0xef, 0x80, 0xdd, 0xe5, 0x9d, 0x20, 0x77, 0xaa,
+	0x27, 0x5b, 0x41, 0xe7, 0x04, 0xa3, 0x33, 0x8b, 0xa6, 0xa3, 0x74, 0x36, 0xb9, 0xbd, 0xda, 0x7c,
+	0x0d, 0xf8, 0x5a, 0x82, 0xd1, 0x16, 0x14, 0x74, 0x72, 0x7b, 0x4f, 0x3e, 0xa9, 0xf9, 0x5e, 0x4c,
+	0x05, 0xa7, 0xd3, 0x8c, 0xd3, 0x6b, 0xe8, 0xd8, 0x36, 0x9c, 0x94, 0xfb, 0x46, 0xe9, 0x01, 0xfa,
+	0x5e, 0x82, 0x89, 0x8e, 0x77, 0x71, 0xa4, 0x74, 0xda, 0x03, 0x31, 0x57, 0xfb, 0xdc, 0x99, 0xde,
+	0x07, 0x08, 0xaa, 0x0b, 0x8c, 0xea, 0x09, 0x74, 0xbc, 0x65, 0x0f, 0x89, 0x41, 0x6a, 0xf4, 0x0d,
+	0x00, 0xfd, 0x27, 0xc1, 0x5c, 0xaf, 0xf7, 0x6f, 0xb4, 0x1c, 0xc3, 0xa6, 0xc7, 0x5b, 0x7f, 0xee,
+	0xcd, 0x97, 0xc2, 0x10, 0x22, 0x57, 0x98, 0xc8, 0x37, 0xd0, 0xa5, 0x2e, 0x22, 0x7b, 0x7a, 0x1c,
+	0x60, 0xeb, 0xd4, 0xf1, 0x32, 0xde, 0x71, 0x9d, 0xe2, 0x2e, 0xf7, 0xb9, 0x33, 0xbd, 0x0f, 0x88,
+	0x5f, 0xa7, 0x2e, 0x77, 0x7f, 0xf4, 0xb3, 0x04, 0x53, 0x71, 0x77, 0x6f, 0x74, 0x21, 0x8e, 0x41,
+	0xf7, 0x9b, 0x7d, 0xee, 0xe2, 0x8e, 0xc7, 0x09, 0x01, 0xe7, 0x99, 0x00, 0x05, 0x2d, 0x74, 0x13,
+	0xd0, 0xb1, 0x22, 0xf2, 0x42, 0xa6, 0x28, 0x9f, 0x3a, 0x85, 0xcc, 0xc8, 0xc5, 0x3d, 0x37, 0x1b,
+	0x63, 0x11, 0x1f, 0x32, 0xb9, 0x5b, 0xf4, 0xad, 0x04, 0xa8, 0x3d, 0xb0, 0xa3, 0xd3, 0x9d, 0x34,
+	0x77, 0xbb, 0x41, 0xe6, 0x16, 0x7a, 0xb4, 0x16, 0x8c, 0x96, 0x18, 0xa3, 0xd3, 0x68, 0xbe, 0x65,
+	0x5e, 0x82, 0xbd, 0x18, 0x0c, 0x51, 0xee, 0xf3, 0x84, 0xf2, 0x60, 0xf9, 0xc6, 0xa3, 0xe7, 0x79,
+	0xe9, 0xf1, 0xf3, 0xbc, 0xf4, 0xe7, 0xf3, 0xbc, 0xf4, 0xf9, 0x8b, 0x7c, 0xdf, 0xe3, 0x17, 0xf9,
+	0xbe, 0x3f, 0x5e, 0xe4, 0xfb, 0x3e, 0x38, 0x57, 0x36, 0x68, 0xc5, 0x2d, 0x2e, 0xea, 0xb6, 0xa9,
+	0x68, 0xb5, 0x9a, 0xed, 0x68, 0x0b, 0x16, 0xa6, 0x5e, 0x7d, 0xef, 0x7f, 0xea, 0x15, 0xcd, 0xb0,
+	0x94, 0x7b, 0xdc, 0x1b, 0x7b, 0xf2, 0x2f, 0x0e, 0xb2, 0x57, 0xfc, 0x73, 0xff, 0x0f, 0x00, 0xf4,
+	0xbf, 0x70, 0x22, 0x6b, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// VestingSchedules returns all vesting schedules with their unlocked and remaining amounts.
+	VestingSchedules(ctx context.Context, in *QueryVestingSchedulesRequest, opts ...grpc.CallOption) (*QueryVestingSchedulesResponse, error)
+	// VestingSchedule returns a single vesting schedule with its unlocked and remaining amounts.
+	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
+	// PreviousBlockEmission returns the amount of tokens emitted per block at the last emission update.
+	PreviousBlockEmission(ctx context.Context, in *QueryPreviousBlockEmissionRequest, opts ...grpc.CallOption) (*QueryPreviousBlockEmissionResponse, error)
+	// PreviousRewardEmissionPerUnitStakedToken returns the smoothed monthly emission per unit staked token.
+	PreviousRewardEmissionPerUnitStakedToken(ctx context.Context, in *QueryPreviousRewardEmissionPerUnitStakedTokenRequest, opts ...grpc.CallOption) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error)
+	// EcosystemTokensMinted returns the number of tokens minted into the ecosystem treasury so far.
+	EcosystemTokensMinted(ctx context.Context, in *QueryEcosystemTokensMintedRequest, opts ...grpc.CallOption) (*QueryEcosystemTokensMintedResponse, error)
+	// EcosystemMintSupplyRemaining returns the number of tokens the ecosystem treasury may still mint.
+	EcosystemMintSupplyRemaining(ctx context.Context, in *QueryEcosystemMintSupplyRemainingRequest, opts ...grpc.CallOption) (*QueryEcosystemMintSupplyRemainingResponse, error)
+	// Supply returns the total, locked and circulating token supply.
+	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
+	// EmissionProjection replays the monthly emission calculation forward
+	// under the given assumptions.
+	EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingSchedules(ctx context.Context, in *QueryVestingSchedulesRequest, opts ...grpc.CallOption) (*QueryVestingSchedulesResponse, error) {
+	out := new(QueryVestingSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/VestingSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error) {
+	out := new(QueryVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/VestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousBlockEmission(ctx context.Context, in *QueryPreviousBlockEmissionRequest, opts ...grpc.CallOption) (*QueryPreviousBlockEmissionResponse, error) {
+	out := new(QueryPreviousBlockEmissionResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/PreviousBlockEmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousRewardEmissionPerUnitStakedToken(ctx context.Context, in *QueryPreviousRewardEmissionPerUnitStakedTokenRequest, opts ...grpc.CallOption) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error) {
+	out := new(QueryPreviousRewardEmissionPerUnitStakedTokenResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/PreviousRewardEmissionPerUnitStakedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EcosystemTokensMinted(ctx context.Context, in *QueryEcosystemTokensMintedRequest, opts ...grpc.CallOption) (*QueryEcosystemTokensMintedResponse, error) {
+	out := new(QueryEcosystemTokensMintedResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/EcosystemTokensMinted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EcosystemMintSupplyRemaining(ctx context.Context, in *QueryEcosystemMintSupplyRemainingRequest, opts ...grpc.CallOption) (*QueryEcosystemMintSupplyRemainingResponse, error) {
+	out := new(QueryEcosystemMintSupplyRemainingResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/EcosystemMintSupplyRemaining", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
+	out := new(QuerySupplyResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Supply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error) {
+	out := new(QueryEmissionProjectionResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/EmissionProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// VestingSchedules returns all vesting schedules with their unlocked and remaining amounts.
+	VestingSchedules(context.Context, *QueryVestingSchedulesRequest) (*QueryVestingSchedulesResponse, error)
+	// VestingSchedule returns a single vesting schedule with its unlocked and remaining amounts.
+	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
+	// PreviousBlockEmission returns the amount of tokens emitted per block at the last emission update.
+	PreviousBlockEmission(context.Context, *QueryPreviousBlockEmissionRequest) (*QueryPreviousBlockEmissionResponse, error)
+	// PreviousRewardEmissionPerUnitStakedToken returns the smoothed monthly emission per unit staked token.
+	PreviousRewardEmissionPerUnitStakedToken(context.Context, *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error)
+	// EcosystemTokensMinted returns the number of tokens minted into the ecosystem treasury so far.
+	EcosystemTokensMinted(context.Context, *QueryEcosystemTokensMintedRequest) (*QueryEcosystemTokensMintedResponse, error)
+	// EcosystemMintSupplyRemaining returns the number of tokens the ecosystem treasury may still mint.
+	EcosystemMintSupplyRemaining(context.Context, *QueryEcosystemMintSupplyRemainingRequest) (*QueryEcosystemMintSupplyRemainingResponse, error)
+	// Supply returns the total, locked and circulating token supply.
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// EmissionProjection replays the monthly emission calculation forward
+	// under the given assumptions.
+	EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) VestingSchedules(ctx context.Context, req *QueryVestingSchedulesRequest) (*QueryVestingSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedules not implemented")
+}
+func (*UnimplementedQueryServer) VestingSchedule(ctx context.Context, req *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedule not implemented")
+}
+func (*UnimplementedQueryServer) PreviousBlockEmission(ctx context.Context, req *QueryPreviousBlockEmissionRequest) (*QueryPreviousBlockEmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousBlockEmission not implemented")
+}
+func (*UnimplementedQueryServer) PreviousRewardEmissionPerUnitStakedToken(ctx context.Context, req *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) (*QueryPreviousRewardEmissionPerUnitStakedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousRewardEmissionPerUnitStakedToken not implemented")
+}
+func (*UnimplementedQueryServer) EcosystemTokensMinted(ctx context.Context, req *QueryEcosystemTokensMintedRequest) (*QueryEcosystemTokensMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EcosystemTokensMinted not implemented")
+}
+func (*UnimplementedQueryServer) EcosystemMintSupplyRemaining(ctx context.Context, req *QueryEcosystemMintSupplyRemainingRequest) (*QueryEcosystemMintSupplyRemainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EcosystemMintSupplyRemaining not implemented")
+}
+func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (*UnimplementedQueryServer) EmissionProjection(ctx context.Context, req *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionProjection not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/VestingSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedules(ctx, req.(*QueryVestingSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/VestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedule(ctx, req.(*QueryVestingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousBlockEmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousBlockEmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousBlockEmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/PreviousBlockEmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousBlockEmission(ctx, req.(*QueryPreviousBlockEmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousRewardEmissionPerUnitStakedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousRewardEmissionPerUnitStakedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousRewardEmissionPerUnitStakedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/PreviousRewardEmissionPerUnitStakedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousRewardEmissionPerUnitStakedToken(ctx, req.(*QueryPreviousRewardEmissionPerUnitStakedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EcosystemTokensMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEcosystemTokensMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EcosystemTokensMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/EcosystemTokensMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EcosystemTokensMinted(ctx, req.(*QueryEcosystemTokensMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EcosystemMintSupplyRemaining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEcosystemMintSupplyRemainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EcosystemMintSupplyRemaining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/EcosystemMintSupplyRemaining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EcosystemMintSupplyRemaining(ctx, req.(*QueryEcosystemMintSupplyRemainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/EmissionProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionProjection(ctx, req.(*QueryEmissionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "VestingSchedules",
+			Handler:    _Query_VestingSchedules_Handler,
+		},
+		{
+			MethodName: "VestingSchedule",
+			Handler:    _Query_VestingSchedule_Handler,
+		},
+		{
+			MethodName: "PreviousBlockEmission",
+			Handler:    _Query_PreviousBlockEmission_Handler,
+		},
+		{
+			MethodName: "PreviousRewardEmissionPerUnitStakedToken",
+			Handler:    _Query_PreviousRewardEmissionPerUnitStakedToken_Handler,
+		},
+		{
+			MethodName: "EcosystemTokensMinted",
+			Handler:    _Query_EcosystemTokensMinted_Handler,
+		},
+		{
+			MethodName: "EcosystemMintSupplyRemaining",
+			Handler:    _Query_EcosystemMintSupplyRemaining_Handler,
+		},
+		{
+			MethodName: "Supply",
+			Handler:    _Query_Supply_Handler,
+		},
+		{
+			MethodName: "EmissionProjection",
+			Handler:    _Query_EmissionProjection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mint/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingScheduleStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingScheduleStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingScheduleStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UnlockedAmount.Size()
+		i -= size
+		if _, err := m.UnlockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LockedSupply.Size()
+		i -= size
+		if _, err := m.LockedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviousBlockEmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviousBlockEmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviousBlockEmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviousBlockEmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviousBlockEmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviousBlockEmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockEmission.Size()
+		i -= size
+		if _, err := m.BlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.RewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEcosystemTokensMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEcosystemTokensMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEcosystemTokensMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEcosystemTokensMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEcosystemTokensMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEcosystemTokensMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EcosystemTokensMinted.Size()
+		i -= size
+		if _, err := m.EcosystemTokensMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEcosystemMintSupplyRemainingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEcosystemMintSupplyRemainingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEcosystemMintSupplyRemainingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEcosystemMintSupplyRemainingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEcosystemMintSupplyRemainingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEcosystemMintSupplyRemainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EcosystemMintSupplyRemaining.Size()
+		i -= size
+		if _, err := m.EcosystemMintSupplyRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedTopicRevenue.Size()
+		i -= size
+		if _, err := m.BurnedTopicRevenue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CirculatingSupply.Size()
+		i -= size
+		if _, err := m.CirculatingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LockedSupply.Size()
+		i -= size
+		if _, err := m.LockedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MonthlyFeeInflow.Size()
+		i -= size
+		if _, err := m.MonthlyFeeInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakedTokens.Size()
+		i -= size
+		if _, err := m.StakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Months != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Months))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionProjectionMonth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionProjectionMonth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionProjectionMonth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedTopicRevenue.Size()
+		i -= size
+		if _, err := m.BurnedTopicRevenue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.EcosystemMintSupplyRemaining.Size()
+		i -= size
+		if _, err := m.EcosystemMintSupplyRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.CirculatingSupply.Size()
+		i -= size
+		if _, err := m.CirculatingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LockedSupply.Size()
+		i -= size
+		if _, err := m.LockedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WorkerEmission.Size()
+		i -= size
+		if _, err := m.WorkerEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ReputerEmission.Size()
+		i -= size
+		if _, err := m.ReputerEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ValidatorEmission.Size()
+		i -= size
+		if _, err := m.ValidatorEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Emission.Size()
+		i -= size
+		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.EmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Month != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecipientType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Months) > 0 {
+		for iNdEx := len(m.Months) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Months[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ReputersPercent.Size()
+		i -= size
+		if _, err := m.ReputersPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ValidatorsPercent.Size()
+		i -= size
+		if _, err := m.ValidatorsPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MonthlyFeeInflow.Size()
+		i -= size
+		if _, err := m.MonthlyFeeInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakedTokens.Size()
+		i -= size
+		if _, err := m.StakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VestingScheduleStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnlockedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVestingSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.LockedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPreviousBlockEmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPreviousBlockEmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEcosystemTokensMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEcosystemTokensMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EcosystemTokensMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEcosystemMintSupplyRemainingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEcosystemMintSupplyRemainingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EcosystemMintSupplyRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BurnedTopicRevenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Months != 0 {
+		n += 1 + sovQuery(uint64(m.Months))
+	}
+	l = m.StakedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MonthlyFeeInflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EmissionProjectionMonth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Month != 0 {
+		n += 1 + sovQuery(uint64(m.Month))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = m.EmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Emission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReputerEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WorkerEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemMintSupplyRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.BurnedTopicRevenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EmissionPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecipientType != 0 {
+		n += 1 + sovQuery(uint64(m.RecipientType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MonthlyFeeInflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorsPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReputersPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Months) > 0 {
+		for _, e := range m.Months {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingScheduleStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingScheduleStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingScheduleStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, VestingScheduleStatus{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousBlockEmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousBlockEmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousBlockEmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousBlockEmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousBlockEmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousBlockEmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousRewardEmissionPerUnitStakedTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousRewardEmissionPerUnitStakedTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousRewardEmissionPerUnitStakedTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousRewardEmissionPerUnitStakedTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousRewardEmissionPerUnitStakedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEcosystemTokensMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEcosystemTokensMintedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEcosystemTokensMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEcosystemTokensMintedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEcosystemTokensMintedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEcosystemTokensMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemTokensMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemTokensMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEcosystemMintSupplyRemainingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEcosystemMintSupplyRemainingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEcosystemMintSupplyRemainingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEcosystemMintSupplyRemainingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEcosystemMintSupplyRemainingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEcosystemMintSupplyRemainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedTopicRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedTopicRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyFeeInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthlyFeeInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionProjectionMonth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionProjectionMonth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionProjectionMonth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkerEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Payouts = append(m.Payouts, EmissionPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedTopicRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedTopicRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -570,7 +5068,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *EmissionPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,17 +5091,36 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+			}
+			m.RecipientType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientType |= EmissionRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -613,22 +5130,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -653,7 +5203,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEmissionProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -676,67 +5226,119 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmissionProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmissionProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyFeeInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.MonthlyFeeInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -746,22 +5348,57 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReputersPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Months = append(m.Months, EmissionProjectionMonth{})
+			if err := m.Months[len(m.Months)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
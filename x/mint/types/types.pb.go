@@ -26,6 +26,82 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Kinds of destinations the block emission can be paid to
+type EmissionRecipientType int32
+
+const (
+	// cosmos validators, through the fee collector
+	EmissionRecipientType_VALIDATORS EmissionRecipientType = 0
+	// reputers and workers, through the allora rewards account
+	EmissionRecipientType_ALLORA_REWARDS EmissionRecipientType = 1
+	// the distribution module community pool
+	EmissionRecipientType_COMMUNITY_POOL EmissionRecipientType = 2
+	// the foundation treasury address
+	EmissionRecipientType_FOUNDATION EmissionRecipientType = 3
+	// tokens are burned
+	EmissionRecipientType_BURN EmissionRecipientType = 4
+)
+
+var EmissionRecipientType_name = map[int32]string{
+	0: "VALIDATORS",
+	1: "ALLORA_REWARDS",
+	2: "COMMUNITY_POOL",
+	3: "FOUNDATION",
+	4: "BURN",
+}
+
+var EmissionRecipientType_value = map[string]int32{
+	"VALIDATORS":     0,
+	"ALLORA_REWARDS": 1,
+	"COMMUNITY_POOL": 2,
+	"FOUNDATION":     3,
+	"BURN":           4,
+}
+
+func (x EmissionRecipientType) String() string {
+	return proto.EnumName(EmissionRecipientType_name, int32(x))
+}
+
+func (EmissionRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{0}
+}
+
+// Curves the block emission can follow
+type EmissionCurveType int32
+
+const (
+	// target emission per unit staked token, smoothed by an exponential moving average
+	EmissionCurveType_TARGET_PER_STAKED_TOKEN EmissionCurveType = 0
+	// the block emission halves at a fixed interval of months
+	EmissionCurveType_HALVING EmissionCurveType = 1
+	// the same block emission every month
+	EmissionCurveType_CONSTANT EmissionCurveType = 2
+	// the block emission is linearly interpolated between points set by governance
+	EmissionCurveType_PIECEWISE_LINEAR EmissionCurveType = 3
+)
+
+var EmissionCurveType_name = map[int32]string{
+	0: "TARGET_PER_STAKED_TOKEN",
+	1: "HALVING",
+	2: "CONSTANT",
+	3: "PIECEWISE_LINEAR",
+}
+
+var EmissionCurveType_value = map[string]int32{
+	"TARGET_PER_STAKED_TOKEN": 0,
+	"HALVING":                 1,
+	"CONSTANT":                2,
+	"PIECEWISE_LINEAR":        3,
+}
+
+func (x EmissionCurveType) String() string {
+	return proto.EnumName(EmissionCurveType_name, int32(x))
+}
+
+func (EmissionCurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{1}
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	// type of coin to mint
@@ -42,12 +118,20 @@ type Params struct {
 	FoundationTreasuryPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=foundation_treasury_percent_of_total_supply,json=foundationTreasuryPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"foundation_treasury_percent_of_total_supply"`
 	// percentage of the total supply that is unlocked and usable by partipicants at the genesis
 	ParticipantsPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=participants_percent_of_total_supply,json=participantsPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"participants_percent_of_total_supply"`
-	// percentage of the total supply that is locked in the investors bucket at the genesis
-	InvestorsPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=investors_percent_of_total_supply,json=investorsPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"investors_percent_of_total_supply"`
-	// percentage of the total supply that is locked in the team bucket at the genesis
-	TeamPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"team_percent_of_total_supply"`
+	// percentage of the total supply that is locked in the investors bucket at the genesis.
+	// Deprecated: locked tokens are tracked by the vesting schedules, this is only
+	// read by the migration that seeds them on networks that predate on-chain vesting
+	InvestorsPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=investors_percent_of_total_supply,json=investorsPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"investors_percent_of_total_supply"` // Deprecated: Do not use.
+	// percentage of the total supply that is locked in the team bucket at the genesis.
+	// Deprecated: locked tokens are tracked by the vesting schedules, this is only
+	// read by the migration that seeds them on networks that predate on-chain vesting
+	TeamPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"team_percent_of_total_supply"` // Deprecated: Do not use.
 	// The capped max monthly percentage yield (like %APY)
 	MaximumMonthlyPercentageYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=maximum_monthly_percentage_yield,json=maximumMonthlyPercentageYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maximum_monthly_percentage_yield"`
+	// recipients of the block emission and their share of it, weights must sum to one
+	EmissionRecipients []EmissionRecipient `protobuf:"bytes,11,rep,name=emission_recipients,json=emissionRecipients,proto3" json:"emission_recipients"`
+	// curve used to recompute the block emission at every monthly emission update
+	EmissionCurve EmissionCurve `protobuf:"bytes,12,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -90,49 +174,380 @@ func (m *Params) GetMintDenom() string {
 	return ""
 }
 
+func (m *Params) GetEmissionRecipients() []EmissionRecipient {
+	if m != nil {
+		return m.EmissionRecipients
+	}
+	return nil
+}
+
+func (m *Params) GetEmissionCurve() EmissionCurve {
+	if m != nil {
+		return m.EmissionCurve
+	}
+	return EmissionCurve{}
+}
+
+// EmissionRecipient is a destination of the block emission
+type EmissionRecipient struct {
+	RecipientType EmissionRecipientType `protobuf:"varint,1,opt,name=recipient_type,json=recipientType,proto3,enum=mint.v1beta1.EmissionRecipientType" json:"recipient_type,omitempty"`
+	// destination address, only set for the foundation
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// fraction of the block emission paid to this recipient
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *EmissionRecipient) Reset()         { *m = EmissionRecipient{} }
+func (m *EmissionRecipient) String() string { return proto.CompactTextString(m) }
+func (*EmissionRecipient) ProtoMessage()    {}
+func (*EmissionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{1}
+}
+func (m *EmissionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionRecipient.Merge(m, src)
+}
+func (m *EmissionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionRecipient proto.InternalMessageInfo
+
+func (m *EmissionRecipient) GetRecipientType() EmissionRecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return EmissionRecipientType_VALIDATORS
+}
+
+func (m *EmissionRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EmissionCurvePoint is a point of a piecewise linear emission curve
+type EmissionCurvePoint struct {
+	// months since genesis
+	Month uint64 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// block emission at the first block of that month
+	BlockEmission cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=block_emission,json=blockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"block_emission"`
+}
+
+func (m *EmissionCurvePoint) Reset()         { *m = EmissionCurvePoint{} }
+func (m *EmissionCurvePoint) String() string { return proto.CompactTextString(m) }
+func (*EmissionCurvePoint) ProtoMessage()    {}
+func (*EmissionCurvePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{2}
+}
+func (m *EmissionCurvePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCurvePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCurvePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCurvePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCurvePoint.Merge(m, src)
+}
+func (m *EmissionCurvePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCurvePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCurvePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCurvePoint proto.InternalMessageInfo
+
+func (m *EmissionCurvePoint) GetMonth() uint64 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+// EmissionCurve selects the emission curve and holds the settings of every curve
+type EmissionCurve struct {
+	CurveType EmissionCurveType `protobuf:"varint,1,opt,name=curve_type,json=curveType,proto3,enum=mint.v1beta1.EmissionCurveType" json:"curve_type,omitempty"`
+	// block emission of the first month of the chain under the halving curve
+	HalvingInitialBlockEmission cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=halving_initial_block_emission,json=halvingInitialBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"halving_initial_block_emission"`
+	// number of months between two halvings
+	HalvingIntervalMonths uint64 `protobuf:"varint,3,opt,name=halving_interval_months,json=halvingIntervalMonths,proto3" json:"halving_interval_months,omitempty"`
+	// block emission of the constant curve
+	ConstantBlockEmission cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=constant_block_emission,json=constantBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"constant_block_emission"`
+	// points of the piecewise linear curve, in strictly increasing month order
+	Points []EmissionCurvePoint `protobuf:"bytes,5,rep,name=points,proto3" json:"points"`
+	// maximum relative change of the block emission from one monthly update to the next.
+	// bounds the jump when governance switches curves mid-chain. zero disables the bound, defaults to 0.1
+	MaxBlockEmissionChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_block_emission_change,json=maxBlockEmissionChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_emission_change"`
+}
+
+func (m *EmissionCurve) Reset()         { *m = EmissionCurve{} }
+func (m *EmissionCurve) String() string { return proto.CompactTextString(m) }
+func (*EmissionCurve) ProtoMessage()    {}
+func (*EmissionCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{3}
+}
+func (m *EmissionCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCurve.Merge(m, src)
+}
+func (m *EmissionCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCurve proto.InternalMessageInfo
+
+func (m *EmissionCurve) GetCurveType() EmissionCurveType {
+	if m != nil {
+		return m.CurveType
+	}
+	return EmissionCurveType_TARGET_PER_STAKED_TOKEN
+}
+
+func (m *EmissionCurve) GetHalvingIntervalMonths() uint64 {
+	if m != nil {
+		return m.HalvingIntervalMonths
+	}
+	return 0
+}
+
+func (m *EmissionCurve) GetPoints() []EmissionCurvePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// VestingSchedule locks tokens held by the vesting escrow module account
+// and unlocks them to a beneficiary in equal installments once the cliff
+// has passed.
+type VestingSchedule struct {
+	// unique identifier of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account that receives the tokens as they unlock
+	Beneficiary string `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// total amount of tokens vesting under this schedule
+	TotalAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_amount"`
+	// amount of tokens already paid out to the beneficiary
+	ReleasedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=released_amount,json=releasedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"released_amount"`
+	// block height at which vesting starts
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// number of blocks after the start during which nothing unlocks
+	CliffBlocks uint64 `protobuf:"varint,6,opt,name=cliff_blocks,json=cliffBlocks,proto3" json:"cliff_blocks,omitempty"`
+	// number of blocks between two installments
+	PeriodBlocks uint64 `protobuf:"varint,7,opt,name=period_blocks,json=periodBlocks,proto3" json:"period_blocks,omitempty"`
+	// number of blocks after the start at which everything is unlocked
+	DurationBlocks uint64 `protobuf:"varint,8,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{4}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetCliffBlocks() uint64 {
+	if m != nil {
+		return m.CliffBlocks
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetPeriodBlocks() uint64 {
+	if m != nil {
+		return m.PeriodBlocks
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetDurationBlocks() uint64 {
+	if m != nil {
+		return m.DurationBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("mint.v1beta1.EmissionRecipientType", EmissionRecipientType_name, EmissionRecipientType_value)
+	proto.RegisterEnum("mint.v1beta1.EmissionCurveType", EmissionCurveType_name, EmissionCurveType_value)
 	proto.RegisterType((*Params)(nil), "mint.v1beta1.Params")
+	proto.RegisterType((*EmissionRecipient)(nil), "mint.v1beta1.EmissionRecipient")
+	proto.RegisterType((*EmissionCurvePoint)(nil), "mint.v1beta1.EmissionCurvePoint")
+	proto.RegisterType((*EmissionCurve)(nil), "mint.v1beta1.EmissionCurve")
+	proto.RegisterType((*VestingSchedule)(nil), "mint.v1beta1.VestingSchedule")
 }
 
 func init() { proto.RegisterFile("mint/v1beta1/types.proto", fileDescriptor_010015e812760429) }
 
 var fileDescriptor_010015e812760429 = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xfc, 0x29, 0xe4, 0xc4, 0x00, 0x16, 0x08, 0x13, 0xa8, 0x1b, 0x10, 0x20, 0x14,
-	0x94, 0x98, 0xaa, 0x1b, 0x62, 0xaa, 0xc2, 0x50, 0x89, 0xa8, 0x51, 0xdb, 0x05, 0x18, 0x4e, 0x17,
-	0xe7, 0xe2, 0x9c, 0xea, 0xbb, 0xd7, 0xf2, 0xbd, 0x29, 0xb1, 0xc4, 0x0a, 0x03, 0x62, 0x60, 0x43,
-	0x7c, 0x03, 0xc6, 0x0e, 0xfd, 0x0a, 0x48, 0x1d, 0xab, 0x4e, 0x88, 0xa1, 0x42, 0xc9, 0xd0, 0xaf,
-	0x81, 0x6c, 0x5f, 0x03, 0x12, 0x09, 0x2c, 0x5e, 0x2c, 0xfb, 0x7d, 0xac, 0xdf, 0xef, 0xd1, 0xc9,
-	0x7e, 0xa9, 0xab, 0xa4, 0x46, 0x7f, 0x6f, 0xb5, 0x27, 0x90, 0xaf, 0xfa, 0x98, 0xc6, 0xc2, 0xb4,
-	0xe2, 0x04, 0x10, 0x9c, 0x2b, 0x59, 0xd2, 0xb2, 0x49, 0xed, 0x7a, 0x08, 0x21, 0xe4, 0x81, 0x9f,
-	0xdd, 0x15, 0xef, 0xd4, 0x6e, 0x05, 0x60, 0x14, 0x18, 0x56, 0x04, 0xc5, 0x83, 0x8d, 0xae, 0x71,
-	0x25, 0x35, 0xf8, 0xf9, 0xb5, 0x18, 0xdd, 0xfb, 0x56, 0xa5, 0x4b, 0x5d, 0x9e, 0x70, 0x65, 0x9c,
-	0x65, 0x4a, 0x33, 0x3c, 0xeb, 0x0b, 0x0d, 0xca, 0x25, 0x75, 0xf2, 0xa8, 0xba, 0x55, 0xcd, 0x26,
-	0xed, 0x6c, 0xe0, 0x6c, 0x52, 0xaa, 0xf8, 0x98, 0x99, 0x51, 0x1c, 0x47, 0xa9, 0x7b, 0x2e, 0x8b,
-	0xd7, 0x9f, 0x1c, 0x9e, 0xac, 0x54, 0x7e, 0x9c, 0xac, 0xdc, 0x28, 0x34, 0xa6, 0xbf, 0xdb, 0x92,
-	0xe0, 0x2b, 0x8e, 0xc3, 0xd6, 0x86, 0xc6, 0xe3, 0x83, 0x26, 0xb5, 0xfe, 0x0d, 0x8d, 0x5f, 0x4f,
-	0xf7, 0x1b, 0x64, 0xab, 0xaa, 0xf8, 0x78, 0x3b, 0x47, 0x38, 0xaf, 0x29, 0x1d, 0x30, 0xa1, 0xa4,
-	0x31, 0x12, 0xb4, 0x7b, 0x3e, 0x07, 0x3e, 0xb3, 0xc0, 0xdb, 0x7f, 0x03, 0x5f, 0x88, 0x90, 0x07,
-	0x69, 0x5b, 0x04, 0xc7, 0x07, 0xcd, 0xab, 0x16, 0x3b, 0x9b, 0x59, 0xf8, 0xe0, 0xb9, 0xc5, 0x39,
-	0x29, 0xad, 0x81, 0x16, 0x4c, 0x81, 0xc6, 0x21, 0x33, 0x0a, 0x00, 0x87, 0x52, 0x87, 0xac, 0x2f,
-	0xc2, 0x44, 0x08, 0xf7, 0x42, 0x09, 0xb2, 0x9b, 0xa0, 0x45, 0x27, 0xc3, 0x6f, 0x9f, 0xd1, 0xdb,
-	0x39, 0xdc, 0xf9, 0x4c, 0x68, 0x43, 0x04, 0x60, 0x52, 0x83, 0x42, 0x31, 0x4c, 0x04, 0x37, 0xa3,
-	0x24, 0x65, 0xb1, 0x48, 0x02, 0xa1, 0x91, 0xc1, 0x80, 0x21, 0x20, 0x8f, 0xce, 0x4e, 0xf2, 0x62,
-	0x09, 0x5d, 0x1e, 0xcc, 0x7c, 0x3b, 0x56, 0xd7, 0x2d, 0x6c, 0x9b, 0x83, 0x9d, 0xcc, 0x65, 0x4f,
-	0xfc, 0x0b, 0xa1, 0x8f, 0x07, 0x30, 0xd2, 0x7d, 0x8e, 0x12, 0xf4, 0xff, 0xab, 0x2d, 0x95, 0x50,
-	0xed, 0xe1, 0x6f, 0xe1, 0x3f, 0xbb, 0x7d, 0x24, 0xf4, 0x7e, 0xcc, 0x13, 0x94, 0x81, 0x8c, 0xb9,
-	0x46, 0xb3, 0xb0, 0xd4, 0xa5, 0x12, 0x4a, 0xd5, 0xff, 0x34, 0xcd, 0xad, 0xf3, 0x9e, 0xd0, 0xbb,
-	0x52, 0xef, 0x09, 0x83, 0x90, 0x2c, 0xee, 0x72, 0xb9, 0x84, 0x2e, 0xcb, 0x33, 0xcd, 0xdc, 0x22,
-	0x6f, 0xe9, 0x1d, 0x14, 0x5c, 0x2d, 0xac, 0x50, 0x2d, 0xa1, 0x82, 0x9b, 0x19, 0xe6, 0xda, 0xdf,
-	0x11, 0x5a, 0x57, 0x7c, 0x2c, 0xd5, 0x48, 0x15, 0xff, 0x52, 0x34, 0xfb, 0x5a, 0x78, 0x28, 0x58,
-	0x2a, 0x45, 0xd4, 0x77, 0x69, 0x19, 0xa7, 0x60, 0x2d, 0x9d, 0x42, 0xd2, 0x9d, 0x39, 0x5e, 0x66,
-	0x8a, 0xa7, 0x2b, 0x1f, 0x4e, 0xf7, 0x1b, 0x35, 0x1e, 0x45, 0x90, 0xf0, 0x66, 0x30, 0xe4, 0x52,
-	0xfb, 0x63, 0x3f, 0x5f, 0x93, 0xc5, 0xf2, 0x5a, 0xef, 0x1c, 0x4e, 0x3c, 0x72, 0x34, 0xf1, 0xc8,
-	0xcf, 0x89, 0x47, 0x3e, 0x4d, 0xbd, 0xca, 0xd1, 0xd4, 0xab, 0x7c, 0x9f, 0x7a, 0x95, 0x57, 0x6b,
-	0xa1, 0xc4, 0xe1, 0xa8, 0xd7, 0x0a, 0x40, 0xf9, 0x16, 0xa0, 0x05, 0xbe, 0x81, 0x64, 0xd7, 0x9f,
-	0xc7, 0xcb, 0xd7, 0x6d, 0x6f, 0x29, 0xdf, 0x8e, 0x6b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8e,
-	0x93, 0xc4, 0x89, 0x8b, 0x05, 0x00, 0x00,
+	// 1196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x26, 0x26, 0x1f, 0xcf, 0x89, 0x31, 0xd3, 0xa4, 0x59, 0x92, 0xe2, 0x84, 0xd0, 0x0f,
+	0x94, 0x2a, 0x76, 0x09, 0x52, 0x0f, 0xa8, 0x42, 0xda, 0xd8, 0x2e, 0xb8, 0xf8, 0x4b, 0xeb, 0x05,
+	0x44, 0x39, 0xac, 0x26, 0xbb, 0x63, 0x7b, 0xc4, 0xee, 0x8c, 0xb5, 0x33, 0x0e, 0xf1, 0xa5, 0x97,
+	0xaa, 0x52, 0x55, 0xf5, 0xd0, 0x5b, 0xd5, 0x63, 0x2f, 0x55, 0x8f, 0x1c, 0xf8, 0x23, 0x38, 0x22,
+	0x4e, 0x55, 0x0f, 0xa8, 0x82, 0x03, 0xea, 0x1f, 0xd0, 0x7b, 0xb5, 0xb3, 0xbb, 0xc6, 0x06, 0x03,
+	0x6a, 0xea, 0x8b, 0xb5, 0xfb, 0xde, 0xdb, 0xdf, 0xef, 0x37, 0xef, 0xcd, 0x7b, 0x33, 0x06, 0xdd,
+	0xa7, 0x4c, 0x16, 0x8e, 0x2e, 0x1d, 0x12, 0x89, 0x2f, 0x15, 0xe4, 0xa0, 0x47, 0x44, 0xbe, 0x17,
+	0x70, 0xc9, 0xd1, 0x72, 0xe8, 0xc9, 0xc7, 0x9e, 0x8d, 0xd5, 0x0e, 0xef, 0x70, 0xe5, 0x28, 0x84,
+	0x4f, 0x51, 0xcc, 0xc6, 0x59, 0x87, 0x0b, 0x9f, 0x0b, 0x3b, 0x72, 0x44, 0x2f, 0xb1, 0xeb, 0x0c,
+	0xf6, 0x29, 0xe3, 0x05, 0xf5, 0x1b, 0x99, 0x76, 0xfe, 0x01, 0x98, 0x6f, 0xe2, 0x00, 0xfb, 0x02,
+	0x9d, 0x03, 0x08, 0xe1, 0x6d, 0x97, 0x30, 0xee, 0xeb, 0xda, 0xb6, 0x76, 0x71, 0xc9, 0x5c, 0x0a,
+	0x2d, 0xa5, 0xd0, 0x80, 0x1a, 0x00, 0x3e, 0x3e, 0xb6, 0x45, 0xbf, 0xd7, 0xf3, 0x06, 0xfa, 0x6c,
+	0xe8, 0x3e, 0xf8, 0xec, 0xd1, 0xd3, 0xad, 0x99, 0x3f, 0x9f, 0x6e, 0xad, 0x45, 0x34, 0xc2, 0xbd,
+	0x97, 0xa7, 0xbc, 0xe0, 0x63, 0xd9, 0xcd, 0x57, 0x98, 0x7c, 0xf2, 0x70, 0x0f, 0x62, 0xfe, 0x0a,
+	0x93, 0xbf, 0xbf, 0x78, 0xb0, 0xab, 0x99, 0x4b, 0x3e, 0x3e, 0x6e, 0x29, 0x08, 0x74, 0x17, 0xa0,
+	0x6d, 0x13, 0x9f, 0x0a, 0x41, 0x39, 0xd3, 0xe7, 0x14, 0xe0, 0x17, 0x31, 0xe0, 0xe6, 0xeb, 0x80,
+	0x55, 0xd2, 0xc1, 0xce, 0xa0, 0x44, 0x9c, 0x27, 0x0f, 0xf7, 0xb2, 0x31, 0xec, 0xd0, 0x16, 0x83,
+	0xb7, 0xcb, 0x31, 0x1c, 0x1a, 0xc0, 0x06, 0x67, 0xc4, 0xf6, 0x39, 0x93, 0x5d, 0x5b, 0xf8, 0x9c,
+	0xcb, 0x2e, 0x65, 0x1d, 0xdb, 0x25, 0x9d, 0x80, 0x10, 0x3d, 0x35, 0x05, 0xb2, 0x75, 0xce, 0x48,
+	0x2d, 0x84, 0x6f, 0x25, 0xe8, 0x25, 0x05, 0x8e, 0x7e, 0xd6, 0x60, 0x97, 0x38, 0x5c, 0x0c, 0x84,
+	0x24, 0xbe, 0x2d, 0x03, 0x82, 0x45, 0x3f, 0x18, 0xd8, 0x3d, 0x12, 0x38, 0x84, 0x49, 0x9b, 0xb7,
+	0x6d, 0xc9, 0x25, 0xf6, 0x92, 0x4c, 0x9e, 0x9a, 0x82, 0x96, 0x8f, 0x86, 0x7c, 0x56, 0x4c, 0xd7,
+	0x8c, 0xd8, 0x1a, 0x6d, 0x2b, 0xe4, 0x8a, 0x33, 0xfe, 0x8b, 0x06, 0x9f, 0xb6, 0x79, 0x9f, 0xb9,
+	0x58, 0x52, 0xce, 0xde, 0x2d, 0x6d, 0x7e, 0x0a, 0xd2, 0x3e, 0x7e, 0x49, 0xf8, 0x56, 0x6d, 0x3f,
+	0x6a, 0xf0, 0x61, 0x0f, 0x07, 0x92, 0x3a, 0xb4, 0x87, 0x99, 0x14, 0x6f, 0x14, 0xb5, 0x30, 0x05,
+	0x51, 0xdb, 0xa3, 0x4c, 0x13, 0xe5, 0x7c, 0xaf, 0xc1, 0x79, 0xca, 0x8e, 0x88, 0x90, 0x3c, 0x78,
+	0xb3, 0x96, 0x45, 0xa5, 0xe5, 0xea, 0xff, 0xd1, 0xa2, 0x6b, 0xe6, 0xb9, 0x21, 0xd1, 0x44, 0x29,
+	0xdf, 0xc0, 0x07, 0x92, 0x60, 0xff, 0x8d, 0x22, 0x96, 0xa6, 0x22, 0x42, 0x0f, 0x39, 0x26, 0xf2,
+	0x7f, 0xa7, 0xc1, 0xb6, 0x8f, 0x8f, 0xa9, 0xdf, 0xf7, 0xa3, 0x7e, 0xf2, 0x86, 0x3b, 0x06, 0x77,
+	0x88, 0x3d, 0xa0, 0xc4, 0x73, 0x75, 0x98, 0x42, 0x55, 0xce, 0xc5, 0x2c, 0xb5, 0x88, 0xa4, 0x39,
+	0xe4, 0xb8, 0x13, 0x52, 0xa0, 0xbb, 0xf0, 0x5e, 0x32, 0x2d, 0xec, 0x80, 0x38, 0xb4, 0x47, 0x09,
+	0x93, 0x42, 0x4f, 0x6f, 0xcf, 0x5d, 0x4c, 0xef, 0x6f, 0xe5, 0x47, 0x47, 0x63, 0x3e, 0x99, 0x03,
+	0x66, 0x12, 0x77, 0xb0, 0x14, 0x4a, 0x8b, 0x78, 0x10, 0x79, 0xd5, 0x2b, 0x50, 0x0d, 0x32, 0x43,
+	0x70, 0xa7, 0x1f, 0x1c, 0x11, 0x7d, 0x79, 0x5b, 0xbb, 0x98, 0xde, 0xdf, 0x9c, 0x8c, 0x5b, 0x0c,
+	0x43, 0x46, 0x31, 0x57, 0xc8, 0xa8, 0xe7, 0xca, 0xd6, 0x0f, 0x2f, 0x1e, 0xec, 0x6e, 0x60, 0xcf,
+	0xe3, 0x01, 0xde, 0x73, 0xba, 0x98, 0xb2, 0xc2, 0x71, 0x41, 0x8d, 0xf5, 0x68, 0xd8, 0xee, 0xfc,
+	0xad, 0xc1, 0x99, 0xd7, 0x44, 0xa2, 0xaf, 0x20, 0x33, 0x5c, 0x99, 0x1d, 0x0e, 0x7e, 0x35, 0x86,
+	0x33, 0xfb, 0x17, 0xde, 0xb1, 0x3a, 0x6b, 0xd0, 0x23, 0xe6, 0x4a, 0x30, 0xfa, 0x8a, 0xf6, 0x61,
+	0x01, 0xbb, 0x6e, 0x40, 0x84, 0x88, 0x87, 0xb5, 0xfe, 0xe4, 0xe1, 0xde, 0x6a, 0x9c, 0x79, 0x23,
+	0xf2, 0xb4, 0x64, 0x40, 0x59, 0xc7, 0x4c, 0x02, 0x91, 0x05, 0xf3, 0xf7, 0x09, 0xed, 0x74, 0xe5,
+	0x54, 0xc6, 0x71, 0x8c, 0xb5, 0xf3, 0xad, 0x06, 0x68, 0x2c, 0x71, 0x4d, 0x4e, 0x99, 0x44, 0xab,
+	0x70, 0x4a, 0x6d, 0x27, 0xb5, 0xc6, 0x94, 0x19, 0xbd, 0xa0, 0xdb, 0x90, 0x39, 0xf4, 0xb8, 0x73,
+	0xef, 0xe5, 0xc9, 0x70, 0xd2, 0xa3, 0x66, 0x45, 0xe1, 0x24, 0xc4, 0x3b, 0xbf, 0xa5, 0x60, 0x65,
+	0x4c, 0x05, 0xba, 0x0a, 0xa0, 0x4a, 0x3d, 0x9a, 0xe9, 0xad, 0xb7, 0xd4, 0x5b, 0x65, 0x79, 0xc9,
+	0x49, 0x1e, 0x51, 0x1f, 0x72, 0x5d, 0xec, 0x1d, 0x85, 0xe7, 0x0a, 0x65, 0x54, 0x52, 0xec, 0xd9,
+	0x53, 0x92, 0xbe, 0x19, 0xe3, 0x56, 0x22, 0xd8, 0x83, 0xd1, 0x85, 0xa0, 0xcf, 0x61, 0xfd, 0x25,
+	0xad, 0x24, 0xc1, 0x11, 0xf6, 0xa2, 0xbe, 0x14, 0xaa, 0x6a, 0x29, 0x73, 0x6d, 0xf8, 0x75, 0xe4,
+	0x55, 0xfd, 0x24, 0x50, 0x17, 0xd6, 0x1d, 0xce, 0x84, 0xc4, 0x4c, 0xbe, 0xaa, 0x33, 0x75, 0x42,
+	0x9d, 0x6b, 0x09, 0xe0, 0xb8, 0xc2, 0x22, 0xcc, 0xf7, 0xc2, 0x12, 0x0b, 0xfd, 0x94, 0x6a, 0xce,
+	0xed, 0xb7, 0x24, 0x55, 0xed, 0x85, 0xd1, 0x4e, 0x8a, 0x3f, 0x45, 0xf7, 0xe1, 0x6c, 0x78, 0xdf,
+	0x18, 0x57, 0x6a, 0x3b, 0x5d, 0xcc, 0x3a, 0x64, 0x2a, 0x27, 0xd3, 0xfb, 0x3e, 0x3e, 0x1e, 0xd3,
+	0x5d, 0x54, 0xd8, 0x3b, 0xbf, 0xce, 0xc1, 0xe9, 0x5b, 0x44, 0x48, 0xca, 0x3a, 0x2d, 0xa7, 0x4b,
+	0xdc, 0xbe, 0x47, 0x50, 0x06, 0x66, 0xa9, 0x1b, 0x6f, 0xd4, 0x59, 0xea, 0xa2, 0x2b, 0x90, 0x3e,
+	0x24, 0x8c, 0xb4, 0xa9, 0x43, 0x71, 0x30, 0x78, 0x67, 0x83, 0x8d, 0x06, 0xa3, 0x16, 0x2c, 0x47,
+	0xf3, 0x1b, 0xfb, 0xbc, 0xcf, 0x92, 0x56, 0xfb, 0xef, 0xc9, 0x4f, 0x2b, 0x14, 0x43, 0x81, 0xa0,
+	0x3b, 0x70, 0x3a, 0x20, 0x1e, 0xc1, 0x82, 0xb8, 0x09, 0xee, 0x49, 0x8b, 0x9a, 0x49, 0x80, 0x62,
+	0xe8, 0xf3, 0xb0, 0x2c, 0x24, 0x0e, 0xa4, 0xdd, 0x8d, 0x46, 0x43, 0x78, 0x61, 0x99, 0x33, 0xd3,
+	0xca, 0x76, 0x5d, 0x99, 0xc2, 0x10, 0xc7, 0xa3, 0xed, 0x76, 0x54, 0x2d, 0xa1, 0xca, 0x93, 0x32,
+	0xd3, 0xca, 0xa6, 0x52, 0x2c, 0xd0, 0x05, 0x58, 0xe9, 0x91, 0x80, 0x72, 0x37, 0x89, 0x59, 0x50,
+	0x31, 0xcb, 0x91, 0x31, 0x0e, 0xfa, 0x04, 0x4e, 0xbb, 0xfd, 0x20, 0xba, 0x9d, 0xc4, 0x61, 0x8b,
+	0x2a, 0x2c, 0x93, 0x98, 0xa3, 0xc0, 0x5d, 0x0a, 0x6b, 0x13, 0x87, 0x20, 0xca, 0x00, 0xdc, 0x32,
+	0xaa, 0x95, 0x92, 0x61, 0x35, 0xcc, 0x56, 0x76, 0x06, 0x21, 0xc8, 0x18, 0xd5, 0x6a, 0xc3, 0x34,
+	0x6c, 0xb3, 0x7c, 0xdb, 0x30, 0x4b, 0xad, 0xac, 0x16, 0xda, 0x8a, 0x8d, 0x5a, 0xed, 0x66, 0xbd,
+	0x62, 0xdd, 0xb1, 0x9b, 0x8d, 0x46, 0x35, 0x3b, 0x1b, 0x7e, 0xf7, 0x65, 0xe3, 0x66, 0xbd, 0x64,
+	0x58, 0x95, 0x46, 0x3d, 0x3b, 0x87, 0x16, 0x21, 0x75, 0x70, 0xd3, 0xac, 0x67, 0x53, 0xbb, 0x18,
+	0xce, 0x8c, 0x6d, 0x58, 0x45, 0xb3, 0x09, 0xeb, 0x96, 0x61, 0x5e, 0x2b, 0x5b, 0x76, 0xb3, 0x6c,
+	0xda, 0x2d, 0xcb, 0xb8, 0x51, 0x2e, 0xd9, 0x56, 0xe3, 0x46, 0xb9, 0x9e, 0x9d, 0x41, 0x69, 0x58,
+	0xb8, 0x6e, 0x54, 0x6f, 0x55, 0xea, 0xd7, 0xb2, 0x1a, 0x5a, 0x86, 0xc5, 0x62, 0xa3, 0xde, 0xb2,
+	0x8c, 0xba, 0x95, 0x9d, 0x45, 0xab, 0x90, 0x6d, 0x56, 0xca, 0xc5, 0xf2, 0xed, 0x4a, 0xab, 0x6c,
+	0x57, 0x2b, 0xf5, 0xb2, 0x61, 0x66, 0xe7, 0x0e, 0x6a, 0x8f, 0x9e, 0xe5, 0xb4, 0xc7, 0xcf, 0x72,
+	0xda, 0x5f, 0xcf, 0x72, 0xda, 0x4f, 0xcf, 0x73, 0x33, 0x8f, 0x9f, 0xe7, 0x66, 0xfe, 0x78, 0x9e,
+	0x9b, 0xf9, 0xfa, 0x72, 0x87, 0xca, 0x6e, 0xff, 0x30, 0xef, 0x70, 0xbf, 0x10, 0x9f, 0x26, 0x8c,
+	0xc8, 0xfb, 0x3c, 0xb8, 0x57, 0x98, 0x74, 0xb8, 0xa8, 0xff, 0x0a, 0x87, 0xf3, 0xea, 0x6a, 0x7f,
+	0xf9, 0xdf, 0x01, 0x00, 0xbd, 0x59, 0xc0, 0x95, 0x48, 0x0c, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -155,6 +570,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.EmissionCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.EmissionRecipients) > 0 {
+		for iNdEx := len(m.EmissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	{
 		size := m.MaximumMonthlyPercentageYield.Size()
 		i -= size
@@ -255,55 +694,979 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EmissionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EmissionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.FEmission.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.OneMonthSmoothingDegree.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.EcosystemTreasuryPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.FoundationTreasuryPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ParticipantsPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.InvestorsPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.TeamPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.MaximumMonthlyPercentageYield.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecipientType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EmissionCurvePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EmissionCurvePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCurvePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockEmission.Size()
+		i -= size
+		if _, err := m.BlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Month != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBlockEmissionChange.Size()
+		i -= size
+		if _, err := m.MaxBlockEmissionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ConstantBlockEmission.Size()
+		i -= size
+		if _, err := m.ConstantBlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HalvingIntervalMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HalvingIntervalMonths))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.HalvingInitialBlockEmission.Size()
+		i -= size
+		if _, err := m.HalvingInitialBlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CurveType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CurveType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeriodBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PeriodBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CliffBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CliffBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ReleasedAmount.Size()
+		i -= size
+		if _, err := m.ReleasedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FEmission.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.OneMonthSmoothingDegree.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.EcosystemTreasuryPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FoundationTreasuryPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ParticipantsPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.InvestorsPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TeamPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaximumMonthlyPercentageYield.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.EmissionRecipients) > 0 {
+		for _, e := range m.EmissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.EmissionCurve.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *EmissionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecipientType != 0 {
+		n += 1 + sovTypes(uint64(m.RecipientType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *EmissionCurvePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Month != 0 {
+		n += 1 + sovTypes(uint64(m.Month))
+	}
+	l = m.BlockEmission.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *EmissionCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurveType != 0 {
+		n += 1 + sovTypes(uint64(m.CurveType))
+	}
+	l = m.HalvingInitialBlockEmission.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.HalvingIntervalMonths != 0 {
+		n += 1 + sovTypes(uint64(m.HalvingIntervalMonths))
+	}
+	l = m.ConstantBlockEmission.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.MaxBlockEmissionChange.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.CliffBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.CliffBlocks))
+	}
+	if m.PeriodBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.PeriodBlocks))
+	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.DurationBlocks))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneMonthSmoothingDegree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OneMonthSmoothingDegree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemTreasuryPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FoundationTreasuryPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FoundationTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipantsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvestorsPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvestorsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TeamPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyPercentageYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumMonthlyPercentageYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionRecipients = append(m.EmissionRecipients, EmissionRecipient{})
+			if err := m.EmissionRecipients[len(m.EmissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+			}
+			m.RecipientType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientType |= EmissionRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EmissionCurvePoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -326,17 +1689,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionCurvePoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionCurvePoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
 			}
-			var stringLen uint64
+			m.Month = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -346,27 +1709,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Month |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -394,13 +1744,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			m.CurveType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurveType |= EmissionCurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInitialBlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -428,13 +1847,32 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HalvingInitialBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingIntervalMonths", wireType)
+			}
+			m.HalvingIntervalMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingIntervalMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OneMonthSmoothingDegree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantBlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -462,15 +1900,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OneMonthSmoothingDegree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConstantBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemTreasuryPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -480,29 +1918,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Points = append(m.Points, EmissionCurvePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FoundationTreasuryPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockEmissionChange", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -530,13 +1968,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FoundationTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBlockEmissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -564,13 +2071,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ParticipantsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvestorsPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -598,13 +2103,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InvestorsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -632,15 +2137,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TeamPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyPercentageYield", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -650,26 +2155,68 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffBlocks", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.CliffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodBlocks", wireType)
 			}
-			if err := m.MaximumMonthlyPercentageYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+			}
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
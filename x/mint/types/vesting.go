@@ -0,0 +1,61 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the schedule is well formed
+func (v VestingSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Beneficiary); err != nil {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: invalid beneficiary: %s", v.Id, err)
+	}
+	if v.TotalAmount.IsNil() || !v.TotalAmount.IsPositive() {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: total amount must be positive", v.Id)
+	}
+	if v.ReleasedAmount.IsNil() || v.ReleasedAmount.IsNegative() || v.ReleasedAmount.GT(v.TotalAmount) {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: released amount must be between zero and the total amount", v.Id)
+	}
+	if v.StartHeight < 0 {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: start height cannot be negative", v.Id)
+	}
+	if v.PeriodBlocks == 0 || v.DurationBlocks == 0 {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: period and duration must be positive", v.Id)
+	}
+	if v.DurationBlocks%v.PeriodBlocks != 0 {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: duration must be a multiple of the period", v.Id)
+	}
+	if v.CliffBlocks > v.DurationBlocks {
+		return errors.Wrapf(ErrInvalidVestingSchedule, "schedule %d: cliff cannot be longer than the duration", v.Id)
+	}
+	return nil
+}
+
+// UnlockedAmount returns how many tokens of the schedule are unlocked at the given height.
+// Installments accrue every period from the start, but none are unlocked before the cliff.
+func (v VestingSchedule) UnlockedAmount(blockHeight int64) math.Int {
+	if blockHeight < v.StartHeight {
+		return math.ZeroInt()
+	}
+	elapsed := uint64(blockHeight - v.StartHeight)
+	if elapsed < v.CliffBlocks {
+		return math.ZeroInt()
+	}
+	if elapsed >= v.DurationBlocks {
+		return v.TotalAmount
+	}
+	periodsElapsed := math.NewIntFromUint64(elapsed / v.PeriodBlocks)
+	totalPeriods := math.NewIntFromUint64(v.DurationBlocks / v.PeriodBlocks)
+	return v.TotalAmount.Mul(periodsElapsed).Quo(totalPeriods)
+}
+
+// RemainingAmount returns how many tokens of the schedule are still locked at the given height.
+func (v VestingSchedule) RemainingAmount(blockHeight int64) math.Int {
+	return v.TotalAmount.Sub(v.UnlockedAmount(blockHeight))
+}
+
+// UnreleasedAmount returns how many tokens the escrow still has to pay out for this schedule.
+func (v VestingSchedule) UnreleasedAmount() math.Int {
+	return v.TotalAmount.Sub(v.ReleasedAmount)
+}